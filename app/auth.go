@@ -1,12 +1,12 @@
 package app
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/coocood/freecache"
 	"github.com/coschain/contentos-go/app/table"
 	"github.com/coschain/contentos-go/iservices"
 	"github.com/coschain/contentos-go/prototype"
+	"github.com/gogo/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	"math/big"
 	"sync"
@@ -14,18 +14,18 @@ import (
 )
 
 const (
-	// maximum cache size (in bytes) for {accountName, publicKey} pairs.
-	// assuming that average length of account names is 10 and 33-byte compressed ECC public keys are used,
-	// a 16MB cache can hold 384,000 most recently used keys.
+	// maximum cache size (in bytes) for {accountName, authorities} pairs.
+	// assuming that average length of account names is 10 and single-key authorities of 33-byte compressed
+	// ECC public keys are used, a 16MB cache can hold about 100,000 most recently used accounts.
 	sAuthCacheMaxSize = 16 * 1024 * 1024
 )
 
-// AuthFetcher queries the public key of specified account.
+// AuthFetcher queries the authorities of specified account.
 // It's designed for best performance by using a memory cache.
 type AuthFetcher struct {
 	db                     iservices.IDatabaseRW 		// the database
 	log                    *logrus.Logger				// the logger
-	cache                  *freecache.Cache				// accountName -> publicKey cache
+	cache                  *freecache.Cache				// accountName -> authorities cache
 	changes                map[uint64][]string			// block -> accounts changed by this block
 	last, commit           uint64						// latest and last committed block
 	lock                   sync.RWMutex					// for thread safety
@@ -51,9 +51,11 @@ func (f *AuthFetcher) Discard(account string) {
 	f.cache.Del([]byte(account))
 }
 
-// GetPublicKey returns the public key of given account.
-// It returns a nil-key and an error if given account not found.
-func (f *AuthFetcher) GetPublicKey(account string) (*prototype.PublicKeyType, error) {
+// GetAuthorities returns the owner, active and posting authorities of given account.
+// Accounts without a multi-signature owner authority get a single-key authority of their public key.
+// Missing active or posting authorities are inherited from the next higher permission level.
+// It returns nil and an error if given account not found.
+func (f *AuthFetcher) GetAuthorities(account string) (*prototype.AccountAuthorities, error) {
 	f.lock.RLock()
 	defer f.lock.RUnlock()
 
@@ -70,14 +72,20 @@ func (f *AuthFetcher) GetPublicKey(account string) (*prototype.PublicKeyType, er
 		if auth.GetFreeze() != 0 {
 			return nil, fmt.Errorf("account %s is frozen", account)
 		}
-		key := auth.GetPubKey()
+		auths := effectiveAuthorities(auth.GetPubKey(), auth.GetAuthority(), auth.GetActiveAuthority(), auth.GetPostingAuthority())
 		// update cache
-		_ = f.cache.Set([]byte(account), key.Data, 0)
-		return key, nil
+		if data, err = proto.Marshal(auths); err == nil {
+			_ = f.cache.Set([]byte(account), data, 0)
+		}
+		return auths, nil
 	}
 	// count the cache hit
 	atomic.AddInt64(&f.totalHit, 1)
-	return &prototype.PublicKeyType{Data: data}, nil
+	auths := new(prototype.AccountAuthorities)
+	if err = proto.Unmarshal(data, auths); err != nil {
+		return nil, err
+	}
+	return auths, nil
 }
 
 // HitRate returns cache hit rate, in range [0, 1].
@@ -91,17 +99,17 @@ func (f *AuthFetcher) HitRate() (rate float64) {
 	return
 }
 
-// CacheCount returns number of cached {accountName, publicKey} pairs.
+// CacheCount returns number of cached {accountName, authorities} pairs.
 func (f *AuthFetcher) CacheCount() int64 {
 	return f.cache.EntryCount()
 }
 
-// CheckPublicKey checks if given account and its public key are matched.
-func (f *AuthFetcher) CheckPublicKey(account string, key *prototype.PublicKeyType) error {
-	if expected, err := f.GetPublicKey(account); err != nil {
+// CheckAuthority checks if given public keys carry enough weight to sign for given account at given permission level.
+func (f *AuthFetcher) CheckAuthority(account string, keys []*prototype.PublicKeyType, permission prototype.Permission) error {
+	if auths, err := f.GetAuthorities(account); err != nil {
 		return err
-	} else if bytes.Compare(expected.Data, key.Data) != 0 {
-		return fmt.Errorf("key mismatch, expecting %x, given %x", expected.Data, key.Data)
+	} else if err = auths.Check(keys, permission); err != nil {
+		return fmt.Errorf("authority of %s not satisfied: %s", account, err.Error())
 	}
 	return nil
 }
@@ -122,11 +130,13 @@ func (f *AuthFetcher) BlockApplied(b *prototype.SignedBlock) {
 					// account creation
 					case *prototype.Operation_Op1:
 						createAccOp := op.GetOp1()
-						f.newAccount(blockNum, createAccOp.GetNewAccountName().GetValue(), createAccOp.GetPubKey())
+						f.newAccount(blockNum, createAccOp.GetNewAccountName().GetValue(),
+							effectiveAuthorities(createAccOp.GetPubKey(), createAccOp.GetAuthority(), nil, nil))
 					// account update
 					case *prototype.Operation_Op20:
 						accUpdateOp := op.GetOp20()
-						f.newAccount(blockNum, accUpdateOp.GetOwner().GetValue(), accUpdateOp.GetPubKey())
+						f.newAccount(blockNum, accUpdateOp.GetOwner().GetValue(),
+							effectiveAuthorities(accUpdateOp.GetPubKey(), accUpdateOp.GetAuthority(), accUpdateOp.GetActiveAuthority(), accUpdateOp.GetPostingAuthority()))
 					}
 				}
 			}
@@ -172,10 +182,28 @@ func (f *AuthFetcher) BlockCommitted(blockNum uint64) {
 	}
 }
 
-// newAccount deals with AccountCreateOperation in a block.
-func (f *AuthFetcher) newAccount(blockNum uint64, name string, key *prototype.PublicKeyType) {
-	// cache the key of newly created account
-	_ = f.cache.Set([]byte(name), key.GetData(), 0)
+// newAccount deals with AccountCreateOperation and AccountUpdateOperation in a block.
+func (f *AuthFetcher) newAccount(blockNum uint64, name string, auths *prototype.AccountAuthorities) {
+	// cache the authorities of newly created/updated account
+	if data, err := proto.Marshal(auths); err == nil {
+		_ = f.cache.Set([]byte(name), data, 0)
+	} else {
+		f.cache.Del([]byte(name))
+	}
 	// remember the change
 	f.changes[blockNum] = append(f.changes[blockNum], name)
 }
+
+// effectiveAuthorities returns the authorities that actually control an account.
+func effectiveAuthorities(key *prototype.PublicKeyType, owner, active, posting *prototype.Authority) *prototype.AccountAuthorities {
+	if owner == nil {
+		owner = prototype.NewSingleKeyAuthority(key)
+	}
+	if active == nil {
+		active = owner
+	}
+	if posting == nil {
+		posting = active
+	}
+	return &prototype.AccountAuthorities{Owner: owner, Active: active, Posting: posting}
+}
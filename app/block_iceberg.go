@@ -1,9 +1,12 @@
 package app
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/db/smt"
+	"github.com/coschain/contentos-go/db/storage"
 	"github.com/coschain/contentos-go/iservices"
 	"github.com/sirupsen/logrus"
 	"strconv"
@@ -45,10 +48,11 @@ type BlockIceberg struct {
 	highWM, lowWM uint64                     // the high/low watermark of in-memory block count
 	lastBlockApplyHash uint64
 	enableBAH  bool
+	stateTree  func(blockNum uint64) bool    // returns true if the state tree must be updated at the end of given block
 }
 
 // NewBlockIceberg() returns an instance of block iceberg.
-func NewBlockIceberg(db iservices.IDatabaseService, logger *logrus.Logger, enableBAH bool) *BlockIceberg {
+func NewBlockIceberg(db iservices.IDatabaseService, logger *logrus.Logger, enableBAH bool, stateTree func(blockNum uint64) bool) *BlockIceberg {
 	var (
 		hasBlock, hasFinalized, latest, finalized = false, false, uint64(0), uint64(0)
 		err error
@@ -75,6 +79,7 @@ func NewBlockIceberg(db iservices.IDatabaseService, logger *logrus.Logger, enabl
 		highWM:       defaultBlockIcebergHighWM,
 		lowWM:        defaultBlockIcebergLowWM,
 		enableBAH:    enableBAH,
+		stateTree:    stateTree,
 	}
 	berg.loadBlockApplyHash()
 	return berg
@@ -124,6 +129,16 @@ func (b *BlockIceberg) EndBlock(commit bool) error {
 		if b.enableBAH {
 			b.saveBlockApplyHash(common.PackBlockApplyHash(b.db.HashOfTopTransaction()))
 		}
+		if err := b.updateStateTree(); err != nil {
+			b.log.Errorf("ICEBERG: EndBlock state tree update error: %s", err.Error())
+			// a block can't be committed without its state root, discard it.
+			if dErr := b.db.EndTransaction(false); dErr != nil {
+				b.log.Errorf("ICEBERG: EndBlock discard error: %s", dErr.Error())
+			}
+			b.next--
+			b.inProgress = false
+			return err
+		}
 	}
 	b.inProgress = false
 	b.log.Debugf("ICEBERG: EndBlock(%v) end. finalized=%d, sealevel=%d, next=%d", commit, b.finalized, b.seaLevel, b.next)
@@ -310,3 +325,76 @@ func (b *BlockIceberg) LatestBlockApplyHash() uint64 {
 func (b *BlockIceberg) LatestBlockApplyHashUnpacked() (version, hash uint32) {
 	return common.UnpackBlockApplyHash(b.LatestBlockApplyHash())
 }
+
+// isStateKey returns true if given key is a part of the chain state committed by the state tree.
+func isStateKey(key []byte) bool {
+	return !smt.IsNodeKey(key) && !storage.IsReversionInfoKey(key) && !bytes.Equal(key, keyLatestBlockApplyChecksum)
+}
+
+// updateStateTree applies changes of the current block to the state tree.
+// The tree is left empty until the next block has to commit a state root. An empty tree means that we're ending
+// the first block after genesis, or the first block since the state root feature. The tree is built from the whole
+// state in both cases.
+func (b *BlockIceberg) updateStateTree() error {
+	if !b.stateTree(b.next - 1) {
+		return nil
+	}
+	tree := smt.New(b.db)
+	var keys [][]byte
+	if tree.Empty() {
+		b.db.Iterate(nil, nil, false, func(key, value []byte) bool {
+			keys = append(keys, common.CopyBytes(key))
+			return true
+		})
+	} else {
+		keys = b.db.ChangedKeysOfTopTransaction()
+	}
+	for _, k := range keys {
+		if !isStateKey(k) {
+			continue
+		}
+		v, err := b.db.Get(k)
+		if err != nil {
+			v = nil
+		} else if v == nil {
+			v = []byte{}
+		}
+		if err = tree.Update(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LatestStateRoot returns the state root after applying the latest block.
+func (b *BlockIceberg) LatestStateRoot() ([]byte, error) {
+	return smt.New(b.db).Root()
+}
+
+// BuildStateTree builds the state tree of db from its whole state and returns the state root.
+// The tree must be empty, e.g. the database was just filled with a snapshot.
+func BuildStateTree(db iservices.IDatabaseRW) ([]byte, error) {
+	tree := smt.New(db)
+	if !tree.Empty() {
+		return nil, errors.New("state tree already exists")
+	}
+	var keys [][]byte
+	db.Iterate(nil, nil, false, func(key, value []byte) bool {
+		if isStateKey(key) {
+			keys = append(keys, common.CopyBytes(key))
+		}
+		return true
+	})
+	for _, k := range keys {
+		v, err := db.Get(k)
+		if err != nil {
+			return nil, err
+		} else if v == nil {
+			v = []byte{}
+		}
+		if err = tree.Update(k, v); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"github.com/coschain/contentos-go/db/smt"
 	"github.com/coschain/contentos-go/db/storage"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,7 @@ func TestBlockIceberg(t *testing.T) {
 	logger.SetOutput(ioutil.Discard)
 
 	// create instance based on an empty db
-	berg := NewBlockIceberg(db, logger, false)
+	berg := NewBlockIceberg(db, logger, false, withStateTree)
 	a.NotNil(berg, "iceberg creation failed")
 
 	// only BeginBlock(1) is allowed for an empty db. everything else must returns error.
@@ -171,7 +172,7 @@ func TestBlockIceberg(t *testing.T) {
 	// re-create block iceberg
 	a.NoError(db.Stop())
 	a.NoError(db.Start(nil))
-	berg = NewBlockIceberg(db, logger, false)
+	berg = NewBlockIceberg(db, logger, false, withStateTree)
 	a.NotNil(berg)
 
 	// check latest & finalized block number
@@ -199,3 +200,87 @@ func TestBlockIceberg(t *testing.T) {
 		a.NoErrorf(err, "k%d should survive RevertBlock(1000)", i)
 	}
 }
+
+// withStateTree keeps the state tree all the time.
+func withStateTree(blockNum uint64) bool {
+	return true
+}
+
+func TestBlockIcebergStateRoot(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "block_iceberg")
+	a.NoError(err, "temp directory creation failed")
+	defer os.RemoveAll(dir)
+
+	db, _ := storage.NewDatabase(filepath.Join(dir, "db"))
+	a.NoError(db.Start(nil), "database service start failed")
+	defer db.Stop()
+
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	// the state tree is needed since block 20
+	const treeSince = 20
+	berg := NewBlockIceberg(db, logger, false, func(blockNum uint64) bool {
+		return blockNum >= treeSince
+	})
+
+	// state of genesis
+	a.NoError(db.Put([]byte("g"), []byte("genesis")))
+
+	// expectedRoot builds a new tree from the whole state
+	expectedRoot := func() []byte {
+		tree := smt.New(storage.NewMemoryDatabase())
+		db.Iterate(nil, nil, false, func(key, value []byte) bool {
+			if isStateKey(key) {
+				a.NoError(tree.Update(key, value))
+			}
+			return true
+		})
+		root, _ := tree.Root()
+		return root
+	}
+
+	roots := make(map[int][]byte)
+	for i := 1; i <= 200; i++ {
+		a.NoError(berg.BeginBlock(uint64(i)))
+		a.NoError(db.Put([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i))))
+		a.NoError(db.Put([]byte(fmt.Sprintf("k%d", i/2)), []byte(fmt.Sprintf("v%d", i))))
+		if i%3 == 0 {
+			a.NoError(db.Delete([]byte(fmt.Sprintf("k%d", i/3))))
+		}
+		a.NoError(berg.EndBlock(true))
+		a.Equal(i < treeSince, smt.New(db).Empty(), "block %d", i)
+		roots[i], err = berg.LatestStateRoot()
+		a.NoError(err)
+		if i == treeSince || i%50 == 0 {
+			a.Equal(expectedRoot(), roots[i])
+		}
+	}
+	a.NoError(berg.FinalizeBlock(50))
+
+	// state root reverts along with blocks
+	for _, n := range []int{190, 150, 70} {
+		a.NoError(berg.RevertBlock(uint64(n)))
+		root, err := berg.LatestStateRoot()
+		a.NoError(err)
+		a.Equal(roots[n-1], root)
+		a.Equal(expectedRoot(), root)
+	}
+
+	// a tree built from a copy of the state has the same root
+	copied, _ := storage.NewDatabase(filepath.Join(dir, "copy"))
+	a.NoError(copied.Start(nil), "database service start failed")
+	defer copied.Stop()
+	db.Iterate(nil, nil, false, func(key, value []byte) bool {
+		if !smt.IsNodeKey(key) && !storage.IsReversionInfoKey(key) {
+			a.NoError(copied.Put(key, value))
+		}
+		return true
+	})
+	root, err := BuildStateTree(copied)
+	a.NoError(err)
+	a.Equal(roots[69], root)
+	_, err = BuildStateTree(copied)
+	a.Error(err, "tree can't be built twice")
+}
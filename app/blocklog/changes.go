@@ -80,6 +80,28 @@ var sInterestedChanges = []InterestedChange{
 			}
 		},
 	},
+	{
+		Table: table.ScheduledTransferTable,
+		Field: "",
+		Maker: func(id, before, after interface{}) *GenericChange {
+			return &GenericChange{
+				Id: 	id,
+				Before: before,
+				After: 	after,
+			}
+		},
+	},
+	{
+		Table: table.EscrowTable,
+		Field: "",
+		Maker: func(id, before, after interface{}) *GenericChange {
+			return &GenericChange{
+				Id: 	id,
+				Before: before,
+				After: 	after,
+			}
+		},
+	},
 	{
 		Table: table.VoteTable,
 		Field: "WeightedVp",
@@ -55,6 +55,16 @@ func (w *Watcher) GetOrCreateStateChangeContext(branch string, trxId string, op
 	return w.getOrCreateStateChangeContext(branch, trxId, op, cause)
 }
 
+// DiscardBranchChanges drops state changes recorded on given branch.
+func (w *Watcher) DiscardBranchChanges(branch string) {
+	w.Lock()
+	defer w.Unlock()
+
+	if ctx := w.changeCtxsByBranch[branch]; ctx != nil {
+		ctx.ClearChanges()
+	}
+}
+
 func (w *Watcher) CurrentBlockContext() (ctx *StateChangeContext) {
 	w.RLock()
 	defer w.RUnlock()
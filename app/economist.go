@@ -96,18 +96,32 @@ func SumItemsWvp(items []IItem) *big.Int {
 }
 
 type Economist struct {
-	db       iservices.IDatabaseService
+	db       iservices.IDatabaseRW
 	noticer  EventBus.Bus
 	log *logrus.Logger
 	dgp *DynamicGlobalPropsRW
 	stateChange *blocklog.StateChangeContext
 	hardFork func()uint64
+	policy RewardPolicy
+	rewardObserver func(account string, reward *big.Int)
 }
 
-func NewEconomist(db iservices.IDatabaseService, noticer EventBus.Bus, log *logrus.Logger, hardForkFunc func()uint64) *Economist {
+func NewEconomist(db iservices.IDatabaseRW, noticer EventBus.Bus, log *logrus.Logger, hardForkFunc func()uint64) *Economist {
 	return &Economist{db: db, noticer:noticer, log: log, dgp: &DynamicGlobalPropsRW{db: db}, hardFork:hardForkFunc}
 }
 
+// SetRewardPolicy forces a reward policy regardless of the one on chain. It's for simulations only, nil to restore.
+func (e *Economist) SetRewardPolicy(policy RewardPolicy) {
+	e.policy = policy
+}
+
+func (e *Economist) rewardPolicy() RewardPolicy {
+	if e.policy != nil {
+		return e.policy
+	}
+	return NewRewardPolicy(e.dgp.GetProps().GetRewardPolicy())
+}
+
 func (e *Economist) getAccount(account *prototype.AccountName) (*table.SoAccountWrap, error) {
 	accountWrap := table.NewSoAccountWrap(e.db, account)
 	if !accountWrap.CheckExist() {
@@ -153,13 +167,14 @@ func (e *Economist) Mint() {
 		})
 	}
 
-	creatorReward := blockCurrent * constants.RewardRateCreator / constants.PERCENT
-	dappReward := blockCurrent * constants.RewardRateDapp / constants.PERCENT
+	splits := e.rewardPolicy().Splits()
+	creatorReward := blockCurrent * uint64(splits.GetCreatorRate()) / constants.PERCENT
+	dappReward := blockCurrent * uint64(splits.GetDappRate()) / constants.PERCENT
 	bpReward := blockCurrent - creatorReward - dappReward
 
 	// merge author rewards and reply rewards
-	postReward := creatorReward * constants.RewardRateAuthor / constants.PERCENT
-	replyReward := creatorReward * constants.RewardRateReply / constants.PERCENT
+	postReward := creatorReward * uint64(splits.GetAuthorRate()) / constants.PERCENT
+	replyReward := creatorReward * uint64(splits.GetReplyRate()) / constants.PERCENT
 	voteReward := creatorReward - postReward - replyReward
 
 	e.stateChange.PopCause()
@@ -250,16 +265,16 @@ func (e *Economist) Distribute() {
 	})
 }
 
-func (e *Economist) decayGlobalWvp() {
+func (e *Economist) decayGlobalWvp(policy RewardPolicy) {
 	e.dgp.ModifyProps(func(props *prototype.DynamicProperties) {
 		postWeightedVps := StringToBigInt(props.GetWeightedVpsPost())
 		replyWeightedVps := StringToBigInt(props.GetWeightedVpsReply())
 		voteWeightedVps := StringToBigInt(props.GetWeightedVpsVote())
 		dappWeightedVps := StringToBigInt(props.GetWeightedVpsDapp())
-		Decay(postWeightedVps)
-		Decay(replyWeightedVps)
-		Decay(voteWeightedVps)
-		Decay(dappWeightedVps)
+		policy.Decay(postWeightedVps)
+		policy.Decay(replyWeightedVps)
+		policy.Decay(voteWeightedVps)
+		policy.Decay(dappWeightedVps)
 		props.WeightedVpsPost = postWeightedVps.String()
 		props.WeightedVpsReply = replyWeightedVps.String()
 		props.WeightedVpsVote = voteWeightedVps.String()
@@ -275,7 +290,9 @@ func (e *Economist) Do() {
 	if !globalProps.GetBlockProducerBootCompleted() {
 		return
 	}
-	e.decayGlobalWvp()
+	policy := e.rewardPolicy()
+	splits := policy.Splits()
+	e.decayGlobalWvp(policy)
 	iterator := table.NewPostCashoutBlockNumWrap(e.db)
 	//end := globalProps.HeadBlockNumber
 	// in iterator, the right is open.
@@ -315,6 +332,7 @@ func (e *Economist) Do() {
 			weightedVp = new(big.Int).SetUint64(0)
 			e.log.Warnf("ignored post %d due to invalid copyright,author %s", *pid, authorName.Value)
 		}
+		weightedVp = policy.Weight(weightedVp)
 		//postItem := &PostItem{postId: post.GetPostId(), Item{beneficiary: post.GetAuthor().Value, wvp: weightedVp}}
 		postItem := &PostItem{Item{beneficiary: post.GetAuthor().Value, wvp: weightedVp}, post.GetPostId()}
 		if post.GetParentId() == constants.PostInvalidId {
@@ -333,15 +351,15 @@ func (e *Economist) Do() {
 				dappsRoutes = append(dappsRoutes, dappRoute)
 			} else {
 				// 15 / 75 * routeWvp
-				equalRouteWvp := ProportionAlgorithm(new(big.Int).SetUint64(uint64(constants.RewardRateReply)), new(big.Int).SetUint64(uint64(constants.RewardRateAuthor)), routeWvp)
+				equalRouteWvp := ProportionAlgorithm(new(big.Int).SetUint64(uint64(splits.GetReplyRate())), new(big.Int).SetUint64(uint64(splits.GetAuthorRate())), routeWvp)
 				dappRoute := &DappItem{Item{beneficiary: name, wvp: equalRouteWvp}, post.GetPostId()}
 				dappsRoutes = append(dappsRoutes, dappRoute)
 			}
 		}
 	}
-	e.cashoutPosts(posts)
-	e.cashoutReplies(replies)
-	e.cashoutDapps(dappsRoutes)
+	e.cashoutPosts(policy, posts)
+	e.cashoutReplies(policy, replies)
+	e.cashoutDapps(policy, dappsRoutes)
 
 	voteCashoutWrap := table.NewSoVoteCashoutWrap(e.db, &globalProps.HeadBlockNumber)
 	if !voteCashoutWrap.CheckExist() {
@@ -368,13 +386,13 @@ func (e *Economist) Do() {
 			voteWvp.SetUint64(0)
 			e.log.Warnf("ignored post %d due to invalid copyright,author %s", postId, authorName.Value)
 		}
-		voteItem := &VoteItem{Item{wvp: voteWvp, beneficiary: voter}, postId}
+		voteItem := &VoteItem{Item{wvp: policy.Weight(voteWvp), beneficiary: voter}, postId}
 		voteItems = append(voteItems, voteItem)
 	}
-	e.cashoutVotes(voteItems)
+	e.cashoutVotes(policy, voteItems)
 }
 
-func (e *Economist) cashoutPosts(postsItems []*PostItem) {
+func (e *Economist) cashoutPosts(policy RewardPolicy, postsItems []*PostItem) {
 	e.stateChange.PushCause("post_author")
 	defer e.stateChange.PopCause()
 
@@ -386,7 +404,7 @@ func (e *Economist) cashoutPosts(postsItems []*PostItem) {
 	for _, postItem := range postsItems {
 		items = append(items, postItem)
 	}
-	currentBlockPostsWvp := SumItemsWvp(items)
+	currentBlockPostsWvp := policy.SumWvp(items)
 	globalPostsWvps := StringToBigInt(globalProps.GetWeightedVpsPost())
 	globalPostRewards := globalProps.GetPoolPostRewards()
 
@@ -428,7 +446,7 @@ func (e *Economist) cashoutPosts(postsItems []*PostItem) {
 	})
 }
 
-func (e *Economist) cashoutReplies(repliesItems []*PostItem) {
+func (e *Economist) cashoutReplies(policy RewardPolicy, repliesItems []*PostItem) {
 	e.stateChange.PushCause("reply_author")
 	defer e.stateChange.PopCause()
 
@@ -440,7 +458,7 @@ func (e *Economist) cashoutReplies(repliesItems []*PostItem) {
 	for _, replyItem := range repliesItems {
 		items = append(items, replyItem)
 	}
-	currentBlockRepliesWvp := SumItemsWvp(items)
+	currentBlockRepliesWvp := policy.SumWvp(items)
 	globalRepliesWvps := StringToBigInt(globalProps.GetWeightedVpsReply())
 	globalRepliesRewards := globalProps.GetPoolReplyRewards()
 
@@ -479,7 +497,7 @@ func (e *Economist) cashoutReplies(repliesItems []*PostItem) {
 	})
 }
 
-func (e *Economist) cashoutDapps(dappsItems []*DappItem) {
+func (e *Economist) cashoutDapps(policy RewardPolicy, dappsItems []*DappItem) {
 	e.stateChange.PushCause("dapp")
 	defer e.stateChange.PopCause()
 
@@ -491,7 +509,7 @@ func (e *Economist) cashoutDapps(dappsItems []*DappItem) {
 	for _, dappItem := range dappsItems {
 		items = append(items, dappItem)
 	}
-	currentBlockDappsWvp := SumItemsWvp(items)
+	currentBlockDappsWvp := policy.SumWvp(items)
 	globalDappsWvps := StringToBigInt(globalProps.GetWeightedVpsDapp())
 	globalDappsRewards := globalProps.GetPoolDappRewards()
 
@@ -526,7 +544,7 @@ func (e *Economist) cashoutDapps(dappsItems []*DappItem) {
 	})
 }
 
-func (e *Economist) cashoutVotes(votesItems []*VoteItem) {
+func (e *Economist) cashoutVotes(policy RewardPolicy, votesItems []*VoteItem) {
 	e.stateChange.PushCause("voter")
 	defer e.stateChange.PopCause()
 
@@ -538,7 +556,7 @@ func (e *Economist) cashoutVotes(votesItems []*VoteItem) {
 	for _, voteItem := range votesItems {
 		items = append(items, voteItem)
 	}
-	currentBlockVotesWvp := SumItemsWvp(items)
+	currentBlockVotesWvp := policy.SumWvp(items)
 	globalVotesWvps := StringToBigInt(globalProps.GetWeightedVpsVote())
 	globalVotesRewards := globalProps.GetPoolVoteRewards()
 
@@ -596,6 +614,9 @@ func (e *Economist) processRewardForAccount(accountName string, reward *big.Int)
 	newVest := rewardVest.Add(oldVest)
 	account.SetVest(newVest)
 	updateBpVoteValue(e.db, &prototype.AccountName{Value: accountName}, oldVest, newVest)
+	if e.rewardObserver != nil {
+		e.rewardObserver(accountName, reward)
+	}
 	return true
 }
 
@@ -786,3 +807,138 @@ func (e *Economist) DeliverDelegatedVests() {
 	timing.End()
 	e.log.Debugf("deliver_vest: %s", timing.String())
 }
+
+func (e *Economist) ReleaseScheduledTransfers() {
+	e.stateChange.PushCause("scheduled_transfer")
+	defer e.stateChange.PopCause()
+
+	timing := common.NewTiming()
+	timing.Begin()
+
+	// fetch due transfers, earlier ones first
+	end := &prototype.TimePointSec{UtcSeconds: e.dgp.HeadBlockTime().GetUtcSeconds() + 1}
+	var transfers []uint64
+	err := table.NewScheduledTransferDueTimeWrap(e.db).
+		ForEachByOrder(nil, end, nil, nil, func(mVal *uint64, sVal *prototype.TimePointSec, idx uint32) bool {
+			transfers = append(transfers, *mVal)
+			return len(transfers) < constants.MaxScheduledTransfersPerBlock
+		})
+	if err != nil {
+		panic("economist failed fetching scheduled transfers")
+	}
+	e.log.Debugf("scheduled_transfer: %d transfers", len(transfers))
+	timing.Mark()
+
+	// for each transfer, delete it after paying the receiver
+	for _, transferId := range transfers {
+		rec := table.NewSoScheduledTransferWrap(e.db, &transferId)
+		amount := rec.GetAmount()
+		e.stateChange.PutCauseExtra("id", transferId)
+		e.stateChange.PutCauseExtra("from", rec.GetFromAccount().GetValue())
+		table.NewSoAccountWrap(e.db, rec.GetToAccount()).Modify(func(r *table.SoAccount) {
+			r.Balance.Add(amount)
+		})
+		rec.RemoveScheduledTransfer()
+	}
+	timing.End()
+	e.log.Debugf("scheduled_transfer: %s", timing.String())
+}
+
+func (e *Economist) ProcessProposals() {
+	e.stateChange.PushCause("proposal")
+	defer e.stateChange.PopCause()
+
+	globalProps := e.dgp.GetProps()
+	current := globalProps.GetHeadBlockNumber()
+	// Distribute() starts a new epoch at each epoch boundary, which is the time to tally pending proposals
+	if globalProps.GetCurrentEpochStartBlock() == current {
+		e.tallyProposals(current)
+	}
+	e.activateProposals(current)
+}
+
+// tallyProposals approves pending proposals voted by more than 2/3 of current block producers
+func (e *Economist) tallyProposals(current uint64) {
+	bpNames := table.NewSoBlockProducerScheduleObjectWrap(e.db, &SingleId).GetCurrentShuffledBlockProducer()
+	if len(bpNames) == 0 {
+		return
+	}
+	scheduled := make(map[string]bool)
+	for _, name := range bpNames {
+		scheduled[name] = true
+	}
+
+	status, end := uint32(constants.ProposalStatusPending), uint32(constants.ProposalStatusPending + 1)
+	var proposals []uint64
+	err := table.NewProposalStatusWrap(e.db).ForEachByOrder(&status, &end, nil, nil, func(mVal *uint64, sVal *uint32, idx uint32) bool {
+		proposals = append(proposals, *mVal)
+		return true
+	})
+	if err != nil {
+		panic("economist failed fetching pending proposals")
+	}
+	for _, proposalId := range proposals {
+		rec := table.NewSoProposalWrap(e.db, &proposalId)
+		// proposals not approved before activation will be rejected
+		if rec.GetActivationBlockNum() <= current {
+			continue
+		}
+		approvals := 0
+		for _, name := range rec.GetApprovals() {
+			if scheduled[name] {
+				approvals++
+			}
+		}
+		if approvals * 3 > len(bpNames) * 2 {
+			rec.SetStatus(constants.ProposalStatusApproved)
+			e.log.Infof("proposal %d approved by %d of %d block producers", proposalId, approvals, len(bpNames))
+		}
+	}
+}
+
+// activateProposals applies approved proposals scheduled at current block, and rejects pending ones
+func (e *Economist) activateProposals(current uint64) {
+	start, end := current, current + 1
+	var proposals []uint64
+	err := table.NewProposalActivationBlockNumWrap(e.db).ForEachByOrder(&start, &end, nil, nil, func(mVal *uint64, sVal *uint64, idx uint32) bool {
+		proposals = append(proposals, *mVal)
+		return true
+	})
+	if err != nil {
+		panic("economist failed fetching proposals to activate")
+	}
+	for _, proposalId := range proposals {
+		rec := table.NewSoProposalWrap(e.db, &proposalId)
+		switch rec.GetStatus() {
+		case constants.ProposalStatusApproved:
+			if props := rec.GetProps(); props != nil {
+				e.dgp.ModifyProps(func(dgpo *prototype.DynamicProperties) {
+					dgpo.StaminaFree = props.StaminaFree
+					dgpo.TpsExpected = props.TpsExpected
+					dgpo.AccountCreateFee = props.AccountCreationFee
+					dgpo.EpochDuration = props.EpochDuration
+					dgpo.TopNAcquireFreeToken = props.TopNAcquireFreeToken
+					dgpo.PerTicketPrice = props.PerTicketPrice
+					dgpo.PerTicketWeight = props.PerTicketWeight
+					dgpo.PropsGoverned = true
+				})
+			}
+			// activated features are recorded for direct lookups
+			if feature := rec.GetFeature(); len(feature) > 0 {
+				e.dgp.ModifyProps(func(dgpo *prototype.DynamicProperties) {
+					dgpo.ActivatedFeatures = append(dgpo.ActivatedFeatures, &prototype.ActivatedFeature{Id: feature, Height: current})
+				})
+			}
+			// global weighted vps are kept as they are, and weights of the new policy dominate after a few decay periods
+			if policy := rec.GetRewardPolicy(); policy != nil {
+				e.dgp.ModifyProps(func(dgpo *prototype.DynamicProperties) {
+					dgpo.RewardPolicy = policy
+				})
+			}
+			rec.SetStatus(constants.ProposalStatusActivated)
+			e.log.Infof("proposal %d activated at block %d", proposalId, current)
+		case constants.ProposalStatusPending:
+			rec.SetStatus(constants.ProposalStatusRejected)
+		}
+	}
+}
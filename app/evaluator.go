@@ -17,6 +17,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"unicode/utf8"
 )
 
 func mustSuccess(b bool, val string) {
@@ -174,6 +175,66 @@ type UnDelegateVestEvaluator struct {
 	op *prototype.UnDelegateVestOperation
 }
 
+type ScheduledTransferEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.ScheduledTransferOperation
+}
+
+type CancelScheduledTransferEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.CancelScheduledTransferOperation
+}
+
+type EscrowTransferEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.EscrowTransferOperation
+}
+
+type EscrowApproveEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.EscrowApproveOperation
+}
+
+type EscrowDisputeEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.EscrowDisputeOperation
+}
+
+type EscrowReleaseEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.EscrowReleaseOperation
+}
+
+type ProposalCreateEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.ProposalCreateOperation
+}
+
+type ProposalVoteEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.ProposalVoteOperation
+}
+
+type PostEditEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.PostEditOperation
+}
+
+type PostDeleteEvaluator struct {
+	BaseEvaluator
+	BaseDelegate
+	op *prototype.PostDeleteOperation
+}
+
 func init() {
 	RegisterEvaluator((*prototype.AccountCreateOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
 		return &AccountCreateEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.AccountCreateOperation)}
@@ -235,12 +296,42 @@ func init() {
 	RegisterEvaluator((*prototype.VoteByTicketOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
 		return &VoteByTicketEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.VoteByTicketOperation)}
 	})
-	RegisterEvaluatorWithMinHardFork((*prototype.DelegateVestOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+	RegisterEvaluatorWithFeature((*prototype.DelegateVestOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
 		return &DelegateVestEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.DelegateVestOperation)}
-	}, constants.HardFork3)
-	RegisterEvaluatorWithMinHardFork((*prototype.UnDelegateVestOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+	}, constants.FeatureVestDelegation)
+	RegisterEvaluatorWithFeature((*prototype.UnDelegateVestOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
 		return &UnDelegateVestEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.UnDelegateVestOperation)}
-	}, constants.HardFork3)
+	}, constants.FeatureVestDelegation)
+	RegisterEvaluatorWithFeature((*prototype.ScheduledTransferOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &ScheduledTransferEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.ScheduledTransferOperation)}
+	}, constants.FeatureScheduledTransfer)
+	RegisterEvaluatorWithFeature((*prototype.CancelScheduledTransferOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &CancelScheduledTransferEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.CancelScheduledTransferOperation)}
+	}, constants.FeatureScheduledTransfer)
+	RegisterEvaluatorWithFeature((*prototype.EscrowTransferOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &EscrowTransferEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.EscrowTransferOperation)}
+	}, constants.FeatureEscrow)
+	RegisterEvaluatorWithFeature((*prototype.EscrowApproveOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &EscrowApproveEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.EscrowApproveOperation)}
+	}, constants.FeatureEscrow)
+	RegisterEvaluatorWithFeature((*prototype.EscrowDisputeOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &EscrowDisputeEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.EscrowDisputeOperation)}
+	}, constants.FeatureEscrow)
+	RegisterEvaluatorWithFeature((*prototype.EscrowReleaseOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &EscrowReleaseEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.EscrowReleaseOperation)}
+	}, constants.FeatureEscrow)
+	RegisterEvaluatorWithFeature((*prototype.ProposalCreateOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &ProposalCreateEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.ProposalCreateOperation)}
+	}, constants.FeatureGovernance)
+	RegisterEvaluatorWithFeature((*prototype.ProposalVoteOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &ProposalVoteEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.ProposalVoteOperation)}
+	}, constants.FeatureGovernance)
+	RegisterEvaluatorWithFeature((*prototype.PostEditOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &PostEditEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.PostEditOperation)}
+	}, constants.FeaturePostEdit)
+	RegisterEvaluatorWithFeature((*prototype.PostDeleteOperation)(nil), func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator {
+		return &PostDeleteEvaluator {BaseDelegate: BaseDelegate{delegate:delegate}, op: op.(*prototype.PostDeleteOperation)}
+	}, constants.FeaturePostEdit)
 }
 
 func (ev *AccountCreateEvaluator) Apply() {
@@ -255,6 +346,7 @@ func (ev *AccountCreateEvaluator) Apply() {
 
 	accountCreateFee := op.Fee
 	opAssert(creatorWrap.GetBalance().Value >= accountCreateFee.Value, "Insufficient balance to create account.")
+	opAssert(op.Authority == nil || ev.FeatureActive(constants.FeatureMultiSig), "multi-signature authority not activated")
 
 	// sub creator's fee
 	//originBalance := creatorWrap.GetBalance()
@@ -281,6 +373,7 @@ func (ev *AccountCreateEvaluator) Apply() {
 		tInfo.ToPowerdown = &prototype.Vest{Value: 0}
 		tInfo.HasPowerdown = &prototype.Vest{Value: 0}
 		tInfo.PubKey = op.PubKey
+		tInfo.Authority = op.Authority
 		tInfo.StakeVestForMe = prototype.NewVest(0)
 		tInfo.StakeVestFromMe = prototype.NewVest(0)
 		tInfo.Reputation = constants.DefaultReputation
@@ -304,8 +397,19 @@ func (ev *AccountUpdateEvaluator) Apply() {
 
 	updaterWrap := table.NewSoAccountWrap(ev.Database(), op.Owner)
 	updaterWrap.MustExist("update account not exist ")
+	opAssert(op.Authority == nil || ev.FeatureActive(constants.FeatureMultiSig), "multi-signature authority not activated")
+	opAssert(op.ActiveAuthority == nil && op.PostingAuthority == nil || ev.FeatureActive(constants.FeaturePermissionLevels),
+		"active and posting authorities not activated")
 
-	updaterWrap.SetPubKey(op.PubKey)
+	// pub_key is unique, re-setting the same key would fail
+	if !op.PubKey.Equal(updaterWrap.GetPubKey()) {
+		updaterWrap.SetPubKey(op.PubKey)
+	}
+	// a nil authority falls back to single signature of pub_key
+	updaterWrap.SetAuthority(op.Authority)
+	// nil active/posting authorities fall back to the next higher permission level
+	updaterWrap.SetActiveAuthority(op.ActiveAuthority)
+	updaterWrap.SetPostingAuthority(op.PostingAuthority)
 }
 
 func (ev *TransferEvaluator) Apply() {
@@ -319,7 +423,7 @@ func (ev *TransferEvaluator) Apply() {
 
 	opAssert(op.From.Value != op.To.Value, "Transfer must between two different accounts")
 
-	opAssert(!isBadGuy(ev.HardFork(), op.From), "from account forbidden")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
 
 	//fBalance := fromWrap.GetBalance()
 	//tBalance := toWrap.GetBalance()
@@ -420,6 +524,7 @@ func (ev *ReplyEvaluator) Apply() {
 
 	cidWrap.MustNotExist("post uuid exist")
 	pidWrap.MustExist("parent uuid do not exist")
+	opAssert(!pidWrap.GetDeleted(), "parent has been deleted")
 
 	opAssert(pidWrap.GetDepth()+1 < constants.PostMaxDepth, "reply depth error")
 
@@ -497,6 +602,7 @@ func (ev *VoteEvaluator) Apply() {
 	opAssert( postWrap.GetAuthor().Value != op.Voter.Value, "cant vote self")
 
 	opAssert( postWrap.GetCashoutBlockNum() != math.MaxUint64, "cant vote cashouted post")
+	opAssert( !postWrap.GetDeleted(), "cant vote deleted post")
 
 	regeneratedPower := constants.FullVP * elapsedSeconds / constants.VoteRegenerateTime
 	var currentVp uint32
@@ -513,7 +619,7 @@ func (ev *VoteEvaluator) Apply() {
 
 	vest := voterWrap.GetVest().Value
 	weightedVp := new(big.Int).SetUint64(vest)
-	if ev.HardFork() < constants.HardFork1 {
+	if !ev.FeatureActive(constants.FeatureLinearVoteWeight) {
 		weightedVp.Sqrt(weightedVp)
 	}
 	weightedVp.Mul(weightedVp, new(big.Int).SetUint64(uint64(usedVp)))
@@ -588,36 +694,14 @@ func (ev *BpRegisterEvaluator) Apply() {
 	opAssert(accountWrap.GetReputation() > constants.MinReputation,
 		fmt.Sprintf("reputation too low"))
 
-	staminaFree := op.Props.StaminaFree
-	opAssert(staminaFree >= constants.MinStaminaFree,
-		fmt.Sprintf("proposed stamina free too low min value %d", constants.MinStaminaFree))
-	opAssert(staminaFree <= constants.MaxStaminaFree,
-		fmt.Sprintf("proposed stamina free too high max value %d", constants.MaxStaminaFree))
+	checkChainProperties(op.Props)
 
+	staminaFree := op.Props.StaminaFree
 	tpsExpected := op.Props.TpsExpected
-	opAssert(tpsExpected >= constants.MinTPSExpected,
-		fmt.Sprintf("expected tps too low min value %d", constants.MinTPSExpected))
-	opAssert(tpsExpected <= constants.MaxTPSExpected,
-		fmt.Sprintf("expected tps too high max value %d", constants.MaxTPSExpected))
-
 	accountCreateFee := op.Props.AccountCreationFee
-	opAssert(accountCreateFee.Value >= constants.MinAccountCreateFee,
-		fmt.Sprintf("account create fee too low min value %d", constants.MinAccountCreateFee))
-	opAssert(accountCreateFee.Value <= constants.MaxAccountCreateFee,
-		fmt.Sprintf("account create fee too high max value %d", constants.MaxAccountCreateFee))
-
 	topNAcquireFreeToken := op.Props.TopNAcquireFreeToken
-	opAssert(topNAcquireFreeToken <= constants.MaxTopN, fmt.Sprintf("top N VEST holders, the N is too big, " +
-		"which should lower than %d", constants.MaxTopN))
-
 	epochDuration := op.Props.EpochDuration
-	//opAssert(epochDuration >= constants.MinEpochDuration, fmt.Sprintf("the epoch duration should greater than %d",
-	//	constants.MinEpochDuration))
-
 	perTicketPrice := op.Props.PerTicketPrice
-	opAssert(perTicketPrice.Value >= constants.MinTicketPrice, fmt.Sprintf("the ticket price should greater than %d",
-		constants.MinTicketPrice))
-
 	perTicketWeight := op.Props.PerTicketWeight
 	bpVest := &prototype.BpVestId{Active:true, VoteVest:&prototype.Vest{Value: 0}}
 
@@ -731,55 +815,62 @@ func (ev *BpVoteEvaluator) Apply() {
 func (ev *BpUpdateEvaluator) Apply() {
 	op := ev.op
 
-	staminaFree := op.Props.StaminaFree
+	checkChainProperties(op.Props)
+	// medians of bp proposed values are no longer used once chain properties are governed by proposals
+	opAssert(!ev.GlobalProp().GetProps().GetPropsGoverned(), "chain properties are governed by proposals")
+
+	bpWrap := table.NewSoBlockProducerWrap(ev.Database(), op.Owner)
+	//opAssert(bpWrap.SetProposedStaminaFree(staminaFree), "update bp proposed stamina free error")
+	//opAssert(bpWrap.SetTpsExpected(tpsExpected), "update bp tps expected error")
+	//opAssert(bpWrap.SetAccountCreateFee(accountCreateFee), "update account create fee error")
+	//opAssert(bpWrap.SetTopNAcquireFreeToken(topNAcquireFreeToken), "update topna error")
+	//opAssert(bpWrap.SetEpochDuration(epochDuration), "update epoch duration error")
+	//opAssert(bpWrap.SetPerTicketPrice(perTicketPrice), "update per ticket price error")
+	//opAssert(bpWrap.SetPerTicketWeight(perTicketWeight), "update per ticket weight error")
+
+	bpWrap.Modify(func(tInfo *table.SoBlockProducer) {
+		tInfo.ProposedStaminaFree = op.Props.StaminaFree
+		tInfo.TpsExpected = op.Props.TpsExpected
+		tInfo.AccountCreateFee = op.Props.AccountCreationFee
+		tInfo.TopNAcquireFreeToken = op.Props.TopNAcquireFreeToken
+		tInfo.EpochDuration = op.Props.EpochDuration
+		tInfo.PerTicketPrice = op.Props.PerTicketPrice
+		tInfo.PerTicketWeight = op.Props.PerTicketWeight
+	})
+
+}
+
+// checkChainProperties asserts that proposed chain properties are in the valid ranges
+func checkChainProperties(props *prototype.ChainProperties) {
+	staminaFree := props.StaminaFree
 	opAssert(staminaFree >= constants.MinStaminaFree,
 		fmt.Sprintf("proposed stamina free too low min value %d", constants.MinStaminaFree))
 	opAssert(staminaFree <= constants.MaxStaminaFree,
 		fmt.Sprintf("proposed stamina free too high max value %d", constants.MaxStaminaFree))
 
-	tpsExpected := op.Props.TpsExpected
+	tpsExpected := props.TpsExpected
 	opAssert(tpsExpected >= constants.MinTPSExpected,
 		fmt.Sprintf("expected tps too low min value %d", constants.MinTPSExpected))
 	opAssert(tpsExpected <= constants.MaxTPSExpected,
 		fmt.Sprintf("expected tps too high max value %d", constants.MaxTPSExpected))
 
-	accountCreateFee := op.Props.AccountCreationFee
+	accountCreateFee := props.AccountCreationFee
 	opAssert(accountCreateFee.Value >= constants.MinAccountCreateFee,
 		fmt.Sprintf("account create fee too low min value %d", constants.MinAccountCreateFee))
 	opAssert(accountCreateFee.Value <= constants.MaxAccountCreateFee,
 		fmt.Sprintf("account create fee too high max value %d", constants.MaxAccountCreateFee))
 
-	topNAcquireFreeToken := op.Props.TopNAcquireFreeToken
+	topNAcquireFreeToken := props.TopNAcquireFreeToken
 	opAssert(topNAcquireFreeToken <= constants.MaxTopN, fmt.Sprintf("top N VEST holders, the N is too big, " +
 		"which should lower than %d", constants.MaxTopN))
 
-	//epochDuration := op.EpochDuration
+	//epochDuration := props.EpochDuration
+	//opAssert(epochDuration >= constants.MinEpochDuration, fmt.Sprintf("the epoch duration should greater than %d",
+	//	constants.MinEpochDuration))
 
-	perTicketPrice := op.Props.PerTicketPrice
+	perTicketPrice := props.PerTicketPrice
 	opAssert(perTicketPrice.Value >= constants.MinTicketPrice, fmt.Sprintf("the ticket price should greater than %d",
 		constants.MinTicketPrice))
-
-	//perTicketWeight := op.PerTicketWeight
-
-	bpWrap := table.NewSoBlockProducerWrap(ev.Database(), op.Owner)
-	//opAssert(bpWrap.SetProposedStaminaFree(staminaFree), "update bp proposed stamina free error")
-	//opAssert(bpWrap.SetTpsExpected(tpsExpected), "update bp tps expected error")
-	//opAssert(bpWrap.SetAccountCreateFee(accountCreateFee), "update account create fee error")
-	//opAssert(bpWrap.SetTopNAcquireFreeToken(topNAcquireFreeToken), "update topna error")
-	//opAssert(bpWrap.SetEpochDuration(epochDuration), "update epoch duration error")
-	//opAssert(bpWrap.SetPerTicketPrice(perTicketPrice), "update per ticket price error")
-	//opAssert(bpWrap.SetPerTicketWeight(perTicketWeight), "update per ticket weight error")
-
-	bpWrap.Modify(func(tInfo *table.SoBlockProducer) {
-		tInfo.ProposedStaminaFree = op.Props.StaminaFree
-		tInfo.TpsExpected = op.Props.TpsExpected
-		tInfo.AccountCreateFee = op.Props.AccountCreationFee
-		tInfo.TopNAcquireFreeToken = op.Props.TopNAcquireFreeToken
-		tInfo.EpochDuration = op.Props.EpochDuration
-		tInfo.PerTicketPrice = op.Props.PerTicketPrice
-		tInfo.PerTicketWeight = op.Props.PerTicketWeight
-	})
-
 }
 
 func (ev *FollowEvaluator) Apply() {
@@ -802,7 +893,7 @@ func (ev *TransferToVestEvaluator) Apply() {
 
 	tidWrap.MustExist("to account do not exist")
 
-	opAssert(!isBadGuy(ev.HardFork(), op.From), "from account forbidden")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
 
 	//fBalance := fidWrap.GetBalance()
 	oldVest := tidWrap.GetVest()
@@ -852,7 +943,7 @@ func (ev *ConvertVestEvaluator) Apply() {
 	opAssert(accWrap.GetVest().Sub( accWrap.GetBorrowedVest() ).Sub( globalProps.AccountCreateFee.ToVest() ).Value >= op.Amount.Value, "VEST balance not enough")
 	currentBlock := globalProps.HeadBlockNumber
 	var eachRate uint64
-	if ev.HardFork() < constants.HardFork2 {
+	if !ev.FeatureActive(constants.FeatureFastPowerDown) {
 		eachRate = op.Amount.Value / (constants.ConvertWeeks - 1)
 	} else {
 		eachRate = op.Amount.Value / (constants.HardFork2ConvertWeeks - 1)
@@ -902,7 +993,7 @@ func (ev *ContractDeployEvaluator) Apply() {
 
 	opAssertE(cosVM.Validate(), "validate code failed")
 
-	opAssert(!isBadGuy(ev.HardFork(), op.Owner), "owner account forbidden")
+	opAssert(!isBadGuy(ev, op.Owner), "owner account forbidden")
 
 	if scid.CheckExist() {
 		//scid.SetAbi( abiString )
@@ -942,8 +1033,8 @@ func (ev *ContractApplyEvaluator) Apply() {
 	acc := table.NewSoAccountWrap(ev.Database(), op.Caller)
 	acc.MustExist("caller account doesn't exist")
 
-	opAssert(!isBadGuy(ev.HardFork(), op.Owner), "owner account forbidden")
-	opAssert(!isBadGuy(ev.HardFork(), op.Caller), "caller account forbidden")
+	opAssert(!isBadGuy(ev, op.Owner), "owner account forbidden")
+	opAssert(!isBadGuy(ev, op.Caller), "caller account forbidden")
 
 	balance := acc.GetBalance().Value
 
@@ -1088,7 +1179,7 @@ func (ev *StakeEvaluator) Apply() {
 	fidWrap.MustExist("from account do not exist")
 	tidWrap.MustExist("to account do not exist")
 
-	opAssert(!isBadGuy(ev.HardFork(), op.From), "from account forbidden")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
 
 	//fBalance := fidWrap.GetBalance()
 	//tVests := tidWrap.GetStakeVest()
@@ -1255,6 +1346,7 @@ func (ev *VoteByTicketEvaluator) Apply() {
 	postWrap := table.NewSoPostWrap(ev.Database(), &op.Idx)
 	postWrap.MustExist("post does not exist")
 	opAssert(postWrap.GetCashoutBlockNum() != math.MaxUint64, "post already cashed out")
+	opAssert(!postWrap.GetDeleted(), "post has been deleted")
 
 	originTicketCount := postWrap.GetTicket()
 
@@ -1347,7 +1439,7 @@ func (ev *DelegateVestEvaluator) Apply() {
 	toAccount := table.NewSoAccountWrap(ev.Database(), op.GetTo()).MustExist()
 	amount := op.GetAmount()
 
-	opAssert(!isBadGuy(ev.HardFork(), op.From), "from account forbidden")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
 
 	// reputation check
 	opAssert(fromAccount.GetReputation() > constants.MinReputation, "reputation too low")
@@ -1429,7 +1521,338 @@ func (ev *UnDelegateVestEvaluator) Apply() {
 	updateBpVoteValue(ev.Database(), toAccount.GetName(), oldVest, toAccount.GetVest())
 }
 
+func (ev *ScheduledTransferEvaluator) Apply() {
+	op := ev.op
+
+	fromWrap := table.NewSoAccountWrap(ev.Database(), op.GetFrom()).MustExist()
+	table.NewSoAccountWrap(ev.Database(), op.GetTo()).MustExist("To account do not exist ")
+
+	opAssert(op.GetFrom().GetValue() != op.GetTo().GetValue(), "Transfer must between two different accounts")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
+
+	// the due time must be later than current block, and not too far away.
+	now := ev.GlobalProp().HeadBlockTime()
+	due := op.GetDueTime().GetUtcSeconds()
+	opAssert(due > now.GetUtcSeconds(), "due time already passed")
+	opAssert(due - now.GetUtcSeconds() <= constants.MaxScheduledTransferDelay, "due time too far")
+
+	// escrow the amount until due time
+	fromWrap.Modify(func(tInfo *table.SoAccount) {
+		tInfo.Balance.Sub(op.GetAmount())
+	})
+	transferId := ev.VMInjector().NewRecordID()
+	table.NewSoScheduledTransferWrap(ev.Database(), &transferId).Create(func(r *table.SoScheduledTransfer) {
+		r.Id = transferId
+		r.FromAccount = op.GetFrom()
+		r.ToAccount = op.GetTo()
+		r.Amount = op.GetAmount()
+		r.Memo = op.GetMemo()
+		r.CreatedTime = now
+		r.DueTime = op.GetDueTime()
+	})
+}
+
+func (ev *CancelScheduledTransferEvaluator) Apply() {
+	op := ev.op
+
+	// a scheduled transfer is removed once it's due, so any existing one can be cancelled.
+	rec := table.NewSoScheduledTransferWrap(ev.Database(), &op.TransferId).MustExist("transfer id not found")
+	opAssert(rec.GetFromAccount().GetValue() == op.GetAccount().GetValue(), "transfer owner mismatch")
+
+	// return the escrowed amount
+	amount := rec.GetAmount()
+	table.NewSoAccountWrap(ev.Database(), op.GetAccount()).MustExist().Modify(func(tInfo *table.SoAccount) {
+		tInfo.Balance.Add(amount)
+	})
+	rec.RemoveScheduledTransfer()
+}
+func (ev *EscrowTransferEvaluator) Apply() {
+	op := ev.op
+
+	fromWrap := table.NewSoAccountWrap(ev.Database(), op.GetFrom()).MustExist()
+	table.NewSoAccountWrap(ev.Database(), op.GetTo()).MustExist("To account do not exist ")
+	table.NewSoAccountWrap(ev.Database(), op.GetAgent()).MustExist("Agent account do not exist ")
+
+	opAssert(op.GetFrom().GetValue() != op.GetTo().GetValue(), "Transfer must between two different accounts")
+	opAssert(!isBadGuy(ev, op.From), "from account forbidden")
+
+	now := ev.GlobalProp().HeadBlockTime()
+	opAssert(op.GetRatificationDeadline().GetUtcSeconds() > now.GetUtcSeconds(), "ratification deadline already passed")
+	opAssert(op.GetEscrowExpiration().GetUtcSeconds() - now.GetUtcSeconds() <= constants.MaxEscrowDuration, "escrow expiration too far")
+
+	// hold both the amount and agent fee until the escrow is ratified
+	fromWrap.Modify(func(tInfo *table.SoAccount) {
+		tInfo.Balance.Sub(op.GetAmount())
+		tInfo.Balance.Sub(op.GetAgentFee())
+	})
+	escrowId := ev.VMInjector().NewRecordID()
+	table.NewSoEscrowWrap(ev.Database(), &escrowId).Create(func(r *table.SoEscrow) {
+		r.Id = escrowId
+		r.FromAccount = op.GetFrom()
+		r.ToAccount = op.GetTo()
+		r.Agent = op.GetAgent()
+		r.Amount = op.GetAmount()
+		r.AgentFee = op.GetAgentFee()
+		r.Memo = op.GetMemo()
+		r.CreatedTime = now
+		r.RatificationDeadline = op.GetRatificationDeadline()
+		r.EscrowExpiration = op.GetEscrowExpiration()
+	})
+}
+
+func (ev *EscrowApproveEvaluator) Apply() {
+	op := ev.op
+
+	rec := table.NewSoEscrowWrap(ev.Database(), &op.EscrowId).MustExist("escrow id not found")
+	account := op.GetAccount().GetValue()
+	isTo, isAgent := account == rec.GetToAccount().GetValue(), account == rec.GetAgent().GetValue()
+	opAssert(isTo || isAgent, "only receiver and agent can approve")
+	opAssert(!(isTo && rec.GetToApproved()) && !(isAgent && rec.GetAgentApproved()), "escrow already approved")
+	opAssert(ev.GlobalProp().HeadBlockTime().GetUtcSeconds() < rec.GetRatificationDeadline().GetUtcSeconds(), "ratification deadline passed")
+
+	// a rejection refunds everything to the sender
+	if !op.GetApprove() {
+		refund := rec.GetAmount().Add(rec.GetAgentFee())
+		table.NewSoAccountWrap(ev.Database(), rec.GetFromAccount()).MustExist().Modify(func(tInfo *table.SoAccount) {
+			tInfo.Balance.Add(refund)
+		})
+		rec.RemoveEscrow()
+		return
+	}
+	rec.Modify(func(r *table.SoEscrow) {
+		if isTo {
+			r.ToApproved = true
+		} else {
+			r.AgentApproved = true
+		}
+	})
+	// the agent is paid once the escrow is ratified by both the receiver and agent
+	if rec.GetToApproved() && rec.GetAgentApproved() {
+		fee := rec.GetAgentFee()
+		table.NewSoAccountWrap(ev.Database(), rec.GetAgent()).MustExist().Modify(func(tInfo *table.SoAccount) {
+			tInfo.Balance.Add(fee)
+		})
+		rec.SetAgentFee(prototype.NewCoin(0))
+	}
+}
+
+func (ev *EscrowDisputeEvaluator) Apply() {
+	op := ev.op
+
+	rec := table.NewSoEscrowWrap(ev.Database(), &op.EscrowId).MustExist("escrow id not found")
+	account := op.GetAccount().GetValue()
+	opAssert(account == rec.GetFromAccount().GetValue() || account == rec.GetToAccount().GetValue(), "only sender and receiver can dispute")
+	opAssert(rec.GetToApproved() && rec.GetAgentApproved(), "escrow not approved")
+	opAssert(ev.GlobalProp().HeadBlockTime().GetUtcSeconds() < rec.GetEscrowExpiration().GetUtcSeconds(), "escrow expired")
+	opAssert(!rec.GetDisputed(), "escrow already disputed")
+
+	rec.SetDisputed(true)
+}
+
+func (ev *EscrowReleaseEvaluator) Apply() {
+	op := ev.op
+
+	rec := table.NewSoEscrowWrap(ev.Database(), &op.EscrowId).MustExist("escrow id not found")
+	account, receiver := op.GetAccount().GetValue(), op.GetReceiver().GetValue()
+	from, to := rec.GetFromAccount().GetValue(), rec.GetToAccount().GetValue()
+	opAssert(receiver == from || receiver == to, "receiver must be sender or receiver of the escrow")
+	opAssert(op.GetAmount().GetValue() <= rec.GetAmount().GetValue(), "insufficient escrow balance")
+
+	now := ev.GlobalProp().HeadBlockTime().GetUtcSeconds()
+	if !rec.GetToApproved() || !rec.GetAgentApproved() {
+		// an escrow not ratified before the deadline can be reclaimed by the sender
+		opAssert(now >= rec.GetRatificationDeadline().GetUtcSeconds(), "escrow not approved")
+		opAssert(account == from && receiver == from, "only sender can reclaim an unratified escrow")
+	} else if rec.GetDisputed() {
+		// a disputed escrow is arbitrated by the agent
+		opAssert(account == rec.GetAgent().GetValue(), "only agent can release a disputed escrow")
+	} else {
+		opAssert(account == from || account == to, "only sender and receiver can release an undisputed escrow")
+		// before expiration, each party can only release funds to the counterparty
+		if now < rec.GetEscrowExpiration().GetUtcSeconds() {
+			opAssert(receiver != account, "funds can only be released to the counterparty before expiration")
+		}
+	}
+
+	amount := op.GetAmount()
+	table.NewSoAccountWrap(ev.Database(), op.GetReceiver()).MustExist().Modify(func(tInfo *table.SoAccount) {
+		tInfo.Balance.Add(amount)
+	})
+	rec.Modify(func(r *table.SoEscrow) {
+		r.Amount.Sub(amount)
+	})
+	// remove the escrow when it's drained, returning the unpaid agent fee to the sender
+	if !rec.GetAmount().NonZero() {
+		if fee := rec.GetAgentFee(); fee.NonZero() {
+			table.NewSoAccountWrap(ev.Database(), rec.GetFromAccount()).MustExist().Modify(func(tInfo *table.SoAccount) {
+				tInfo.Balance.Add(fee)
+			})
+		}
+		rec.RemoveEscrow()
+	}
+}
+
+func (ev *ProposalCreateEvaluator) Apply() {
+	op := ev.op
+
+	bpWrap := table.NewSoBlockProducerWrap(ev.Database(), op.GetProposer())
+	bpWrap.MustExist("only block producers can create proposals")
+	opAssert(bpWrap.GetBpVest().Active, "only enabled block producers can create proposals")
+
+	current := ev.GlobalProp().GetProps().GetHeadBlockNumber()
+	opAssert(op.GetActivationBlockNum() > current, "activation block number already passed")
+	opAssert(op.GetActivationBlockNum() - current <= constants.MaxProposalActivationDelay, "activation block number too far")
+
+	if op.GetProps() != nil {
+		checkChainProperties(op.GetProps())
+	}
+	// a feature can't be proposed again unless former proposals of it were rejected
+	if feature := op.GetFeature(); len(feature) > 0 {
+		opAssert(common.KnownFeature(feature), fmt.Sprintf("unknown feature %s", feature))
+		var proposals []uint64
+		_ = table.NewProposalFeatureWrap(ev.Database()).ForEachByOrder(&feature, nil, nil, nil, func(mVal *uint64, sVal *string, idx uint32) bool {
+			if *sVal != feature {
+				return false
+			}
+			proposals = append(proposals, *mVal)
+			return true
+		})
+		for _, proposalId := range proposals {
+			opAssert(table.NewSoProposalWrap(ev.Database(), &proposalId).GetStatus() == constants.ProposalStatusRejected,
+				fmt.Sprintf("feature %s already proposed", feature))
+		}
+	}
+
+	proposalId := ev.VMInjector().NewRecordID()
+	table.NewSoProposalWrap(ev.Database(), &proposalId).Create(func(r *table.SoProposal) {
+		r.Id = proposalId
+		r.Proposer = op.GetProposer()
+		r.Title = op.GetTitle()
+		r.Description = op.GetDescription()
+		r.Props = op.GetProps()
+		r.Feature = op.GetFeature()
+		r.ActivationBlockNum = op.GetActivationBlockNum()
+		r.RewardPolicy = op.GetRewardPolicy()
+		r.Status = constants.ProposalStatusPending
+		r.CreatedTime = ev.GlobalProp().HeadBlockTime()
+	})
+}
+
+func (ev *ProposalVoteEvaluator) Apply() {
+	op := ev.op
+
+	rec := table.NewSoProposalWrap(ev.Database(), &op.ProposalId).MustExist("proposal id not found")
+	opAssert(rec.GetStatus() == constants.ProposalStatusPending, "proposal is not pending")
+	table.NewSoBlockProducerWrap(ev.Database(), op.GetVoter()).MustExist("only block producers can vote for proposals")
+
+	voter := op.GetVoter().GetValue()
+	approvals := rec.GetApprovals()
+	idx := -1
+	for i, name := range approvals {
+		if name == voter {
+			idx = i
+			break
+		}
+	}
+	if op.GetApprove() {
+		opAssert(idx < 0, "proposal already approved")
+		approvals = append(approvals, voter)
+	} else {
+		opAssert(idx >= 0, "proposal not approved yet")
+		approvals = append(approvals[:idx], approvals[idx+1:]...)
+	}
+	rec.SetApprovals(approvals)
+}
+
+// checkPostEditable makes sure a post can be changed by owner, which must happen before the post cashes out.
+func checkPostEditable(delegate ApplyDelegate, postWrap *table.SoPostWrap, owner *prototype.AccountName) {
+	postWrap.MustExist("post does not exist")
+	opAssert(postWrap.GetAuthor().Value == owner.Value, "only author can change the post")
+	opAssert(!postWrap.GetDeleted(), "post has been deleted")
+	cashout := postWrap.GetCashoutBlockNum()
+	opAssert(cashout != CashoutCompleted && delegate.GlobalProp().GetProps().HeadBlockNumber < cashout, "post can't be changed after cashout")
+}
+
+// savePostRevision keeps current title and body of a post as a revision, before they're replaced.
+// Only the latest constants.MaxPostRevisions revisions are kept, older ones are dropped.
+func savePostRevision(delegate ApplyDelegate, postWrap *table.SoPostWrap) {
+	version := postWrap.GetVersion()
+	created := postWrap.GetLastEdited()
+	if version == 0 {
+		created = postWrap.GetCreated()
+	}
+	revisionWrap := table.NewSoPostRevisionWrap(delegate.Database(), &prototype.PostRevisionId{PostId: postWrap.GetPostId(), Version: version})
+	revisionWrap.MustNotExist("post revision exist")
+	revisionWrap.Create(func(t *table.SoPostRevision) {
+		t.Id = &prototype.PostRevisionId{PostId: postWrap.GetPostId(), Version: version}
+		t.Title = postWrap.GetTitle()
+		t.Body = postWrap.GetBody()
+		t.Created = created
+	})
+	if version >= constants.MaxPostRevisions {
+		staleWrap := table.NewSoPostRevisionWrap(delegate.Database(), &prototype.PostRevisionId{PostId: postWrap.GetPostId(), Version: version - constants.MaxPostRevisions})
+		if staleWrap.CheckExist() {
+			staleWrap.RemovePostRevision("failed to remove stale post revision")
+		}
+	}
+}
+
+func (ev *PostEditEvaluator) Apply() {
+	op := ev.op
+
+	postWrap := table.NewSoPostWrap(ev.Database(), &op.Uuid)
+	checkPostEditable(ev, postWrap, op.Owner)
+
+	title, body := postWrap.GetTitle(), postWrap.GetBody()
+	if len(op.Title) > 0 {
+		opAssert(postWrap.GetParentId() == constants.PostInvalidId, "replies have no titles")
+		title = op.Title
+	}
+	if len(op.Content) > 0 {
+		body = op.Content
+	} else if len(op.Patches) > 0 {
+		patched, err := op.ApplyPatches(body)
+		opAssertE(err, "invalid patches")
+		body = patched
+	}
+	opAssert(len(body) > 0, "content cant be null")
+	opAssert(utf8.ValidString(body), "content must be valid utf-8")
+	opAssert(title != postWrap.GetTitle() || body != postWrap.GetBody(), "nothing changed")
+
+	savePostRevision(ev, postWrap)
+	postWrap.Modify(func(t *table.SoPost) {
+		t.Title = title
+		t.Body = body
+		t.Version++
+		t.LastEdited = ev.GlobalProp().HeadBlockTime()
+	})
+}
+
+func (ev *PostDeleteEvaluator) Apply() {
+	op := ev.op
+
+	postWrap := table.NewSoPostWrap(ev.Database(), &op.Uuid)
+	checkPostEditable(ev, postWrap, op.Owner)
+	// others' votes and replies rely on the post
+	opAssert(postWrap.GetVoteCnt() == 0 && postWrap.GetTicket() == 0, "cant delete voted post")
+	opAssert(postWrap.GetChildren() == 0, "cant delete replied post")
+
+	savePostRevision(ev, postWrap)
+	postWrap.Modify(func(t *table.SoPost) {
+		t.Title = ""
+		t.Body = ""
+		t.Version++
+		t.LastEdited = ev.GlobalProp().HeadBlockTime()
+		t.Deleted = true
+	})
+
+	if parentId := postWrap.GetParentId(); parentId != constants.PostInvalidId {
+		table.NewSoPostWrap(ev.Database(), &parentId).Modify(func(t *table.SoPost) {
+			t.Children--
+		})
+	}
+}
 
-func isBadGuy(currentHardFork uint64, account *prototype.AccountName) bool {
-	return currentHardFork >= constants.HardFork4 && (account.Value == "iuaghdfkjan" || account.Value == "woerujdfsdf")
+func isBadGuy(delegate ApplyDelegate, account *prototype.AccountName) bool {
+	return (account.Value == "iuaghdfkjan" || account.Value == "woerujdfsdf") && delegate.FeatureActive(constants.FeatureForbidBadAccounts)
 }
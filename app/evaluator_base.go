@@ -2,7 +2,6 @@ package app
 
 import (
 	"fmt"
-	"github.com/coschain/contentos-go/common/constants"
 	"github.com/coschain/contentos-go/iservices"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/coschain/contentos-go/vm/injector"
@@ -32,7 +31,7 @@ type ApplyDelegate interface {
 	GlobalProp() iservices.IGlobalPropRW
 	VMInjector() vminjector.Injector
 	Logger() *logrus.Logger
-	HardFork() uint64
+	FeatureActive(id string) bool
 }
 
 type BaseDelegate struct {
@@ -55,8 +54,8 @@ func (d *BaseDelegate) Logger() *logrus.Logger {
 	return d.delegate.Logger()
 }
 
-func (d *BaseDelegate) HardFork() uint64 {
-	return d.delegate.HardFork()
+func (d *BaseDelegate) FeatureActive(id string) bool {
+	return d.delegate.FeatureActive(id)
 }
 
 type BaseEvaluator interface {
@@ -66,13 +65,13 @@ type BaseEvaluator interface {
 type EvaluatorCreator func(delegate ApplyDelegate, op prototype.BaseOperation) BaseEvaluator
 
 const sMetaKeyEvaluatorCreator = "op_meta_evaluator_creator"
-const sMetaKeyEvaluatorHardFork = "op_meta_evaluator_hard_fork"
+const sMetaKeyEvaluatorFeature = "op_meta_evaluator_feature"
 
-func GetBaseEvaluator(delegate ApplyDelegate, op *prototype.Operation, currentHardfork uint64) BaseEvaluator {
-	if value := prototype.GetGenericOperationMeta(op, sMetaKeyEvaluatorHardFork); value != nil {
-		minHardFork := value.(uint64)
-		if currentHardfork < minHardFork {
-			panic(fmt.Sprintf("evaluator only works after hard_fork = %d", minHardFork))
+func GetBaseEvaluator(delegate ApplyDelegate, op *prototype.Operation) BaseEvaluator {
+	if value := prototype.GetGenericOperationMeta(op, sMetaKeyEvaluatorFeature); value != nil {
+		feature := value.(string)
+		if !delegate.FeatureActive(feature) {
+			panic(fmt.Sprintf("evaluator only works after feature %s activated", feature))
 		}
 	}
 	if value := prototype.GetGenericOperationMeta(op, sMetaKeyEvaluatorCreator); value != nil {
@@ -83,10 +82,11 @@ func GetBaseEvaluator(delegate ApplyDelegate, op *prototype.Operation, currentHa
 }
 
 func RegisterEvaluator(opPtr interface{}, evalCreator EvaluatorCreator) {
-	RegisterEvaluatorWithMinHardFork(opPtr, evalCreator, constants.Original)
+	prototype.RegisterOperationMeta(opPtr, sMetaKeyEvaluatorCreator, evalCreator)
 }
 
-func RegisterEvaluatorWithMinHardFork(opPtr interface{}, evalCreator EvaluatorCreator, minHardFork uint64) {
-	prototype.RegisterOperationMeta(opPtr, sMetaKeyEvaluatorCreator, evalCreator)
-	prototype.RegisterOperationMeta(opPtr, sMetaKeyEvaluatorHardFork, minHardFork)
+// RegisterEvaluatorWithFeature registers an evaluator which only works after given feature activated.
+func RegisterEvaluatorWithFeature(opPtr interface{}, evalCreator EvaluatorCreator, feature string) {
+	RegisterEvaluator(opPtr, evalCreator)
+	prototype.RegisterOperationMeta(opPtr, sMetaKeyEvaluatorFeature, feature)
 }
@@ -17,6 +17,9 @@ package app
 // transactions. The benefit is that we can load and save these transactions in block level instead of transaction
 // level, eliminating database I/O by hundreds of times.
 //
+// Since multi-signature, a transaction can be signed by different sets of signatures, so we record transaction
+// digests, which don't depend on signatures, instead of the primary signatures.
+//
 
 import (
 	"bytes"
@@ -59,6 +62,8 @@ func NewInBlockEntry(data []byte) *InBlockTrxEntry {
 
 // InBlockTrxChecker checks if a given transaction can be found in latest blocks.
 type InBlockTrxChecker struct {
+	chainId  prototype.ChainId				// the chain
+	features TrxFeatureFunc					// activation status of features
 	db   iservices.IDatabaseRW				// the database
 	trxs map[uint64]*InBlockTrxEntry		// transactions of latest blocks: block number -> transactions in the block
 	last uint64								// latest block number
@@ -67,8 +72,10 @@ type InBlockTrxChecker struct {
 }
 
 // NewInBlockTrxChecker creates an instance of InBlockTrxChecker.
-func NewInBlockTrxChecker(db iservices.IDatabaseRW, logger *logrus.Logger, last uint64) *InBlockTrxChecker {
+func NewInBlockTrxChecker(chainId prototype.ChainId, db iservices.IDatabaseRW, logger *logrus.Logger, last uint64, features TrxFeatureFunc) *InBlockTrxChecker {
 	c := &InBlockTrxChecker{
+		chainId:  chainId,
+		features: features,
 		db:   db,
 		log:  logger,
 		trxs: make(map[uint64]*InBlockTrxEntry),
@@ -79,20 +86,29 @@ func NewInBlockTrxChecker(db iservices.IDatabaseRW, logger *logrus.Logger, last
 	return c
 }
 
-// Has checks if a given transaction can be found in latest blocks.
-func (c *InBlockTrxChecker) Has(trx *prototype.SignedTransaction) bool {
-	s := string(trx.Signature.Sig)
+// Has checks if a given transaction of given digest can be found in latest blocks.
+func (c *InBlockTrxChecker) Has(trx *prototype.SignedTransaction, digest string) bool {
+	s, d := string(trx.Signature.Sig), string(inBlockDigestRecord([]byte(digest)))
 
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 	for _, e := range c.trxs {
-		if e.trxs[s] {
+		if e.trxs[s] || e.trxs[d] {
 			return true
 		}
 	}
 	return false
 }
 
+// inBlockDigestRecord returns the record of a transaction digest.
+// The record has the size of a signature, and begins with a zero r value that no valid signature has, so
+// digest records never collide with signature records.
+func inBlockDigestRecord(digest []byte) []byte {
+	record := make([]byte, sTrxSignatureSize)
+	copy(record[sTrxSignatureSize-len(digest):], digest)
+	return record
+}
+
 // BlockApplied *MUST* be called *AFTER* a block was successfully applied.
 func (c *InBlockTrxChecker) BlockApplied(b *prototype.SignedBlock) {
 	blockNum := b.SignedHeader.Number()
@@ -101,9 +117,15 @@ func (c *InBlockTrxChecker) BlockApplied(b *prototype.SignedBlock) {
 	defer c.lock.Unlock()
 	if blockNum > c.last {
 		// get all transactions from the applied block
+		multiSig := c.features(constants.FeatureMultiSig)
 		sigs := make([][]byte, len(b.Transactions))
 		for i, w := range b.Transactions {
 			sigs[i] = w.SigTrx.Signature.Sig
+			if multiSig {
+				if digest, err := w.SigTrx.Digest(c.chainId); err == nil {
+					sigs[i] = inBlockDigestRecord(digest)
+				}
+			}
 		}
 		// save them into in-memory map
 		c.trxs[blockNum] = NewInBlockEntry(bytes.Join(sigs, nil))
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/prototype"
+	"math/big"
+)
+
+// RewardPolicy decides how weighted vps turn into rewards, and how minted coins are split into rewarding pools.
+type RewardPolicy interface {
+	// Name returns the name of the weighting curve
+	Name() string
+	// Weight maps the weighted vp of a post, reply, vote or dapp route to its rewarding weight
+	Weight(wvp *big.Int) *big.Int
+	// Decay decays a global sum of rewarding weights by one block, in place
+	Decay(rawValue *big.Int) *big.Int
+	// SumWvp sums up rewarding weights of items cashed out in one block
+	SumWvp(items []IItem) *big.Int
+	// Splits returns rates of rewarding pools
+	Splits() *prototype.RewardPolicy
+}
+
+// NewRewardPolicy returns the policy of given parameters, nil for the default policy.
+func NewRewardPolicy(params *prototype.RewardPolicy) RewardPolicy {
+	if params == nil {
+		params = prototype.DefaultRewardPolicy()
+	}
+	linear := &LinearRewardPolicy{splits: params}
+	switch params.GetWeighting() {
+	case constants.RewardWeightingQuadratic:
+		return &QuadraticRewardPolicy{LinearRewardPolicy: linear}
+	default:
+		return linear
+	}
+}
+
+// LinearRewardPolicy rewards in proportion to weighted vps.
+type LinearRewardPolicy struct {
+	splits *prototype.RewardPolicy
+}
+
+func (p *LinearRewardPolicy) Name() string {
+	return constants.RewardWeightingLinear
+}
+
+func (p *LinearRewardPolicy) Weight(wvp *big.Int) *big.Int {
+	return wvp
+}
+
+func (p *LinearRewardPolicy) Decay(rawValue *big.Int) *big.Int {
+	return Decay(rawValue)
+}
+
+func (p *LinearRewardPolicy) SumWvp(items []IItem) *big.Int {
+	return SumItemsWvp(items)
+}
+
+func (p *LinearRewardPolicy) Splits() *prototype.RewardPolicy {
+	return p.splits
+}
+
+// QuadraticRewardPolicy rewards in proportion to square roots of weighted vps,
+// so that the cost of rewarding weight grows quadratically.
+type QuadraticRewardPolicy struct {
+	*LinearRewardPolicy
+}
+
+func (p *QuadraticRewardPolicy) Name() string {
+	return constants.RewardWeightingQuadratic
+}
+
+func (p *QuadraticRewardPolicy) Weight(wvp *big.Int) *big.Int {
+	return new(big.Int).Sqrt(wvp)
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/sirupsen/logrus"
+	"io/ioutil"
+	"math/big"
+)
+
+// RewardSimulation mints and cashes out blocks on patches of the state db with a reward policy, and collects
+// cashout rewards of accounts. Patches are always discarded, so the state db is never changed.
+//
+// Reward pools and global weighted vps of a simulation evolve by themselves, starting from those on chain at the first
+// simulated block, as if the policy took effect at that block. Other states, e.g. vests of accounts, always follow the
+// chain, so the simulation is accurate only if rewards have little impact on voting powers in the simulated window.
+type RewardSimulation struct {
+	policy RewardPolicy
+	hardFork func()uint64
+	log *logrus.Logger
+	pools *prototype.DynamicProperties
+	rewards map[string]*big.Int
+	blocks uint64
+}
+
+// NewRewardSimulation creates a simulation of given policy, nil for the policies on chain.
+func NewRewardSimulation(policy RewardPolicy, hardFork func()uint64, log *logrus.Logger) *RewardSimulation {
+	if log == nil {
+		log = logrus.New()
+		log.SetOutput(ioutil.Discard)
+	}
+	return &RewardSimulation{
+		policy: policy,
+		hardFork: hardFork,
+		log: log,
+		rewards: make(map[string]*big.Int),
+	}
+}
+
+// Simulate runs the economist on a patch of db. It must be called right before the economist runs for a block.
+func (s *RewardSimulation) Simulate(db iservices.IDatabaseService) {
+	patch := db.NewPatch()
+	e := NewEconomist(patch, nil, s.log, s.hardFork)
+	e.SetRewardPolicy(s.policy)
+	e.rewardObserver = s.addReward
+	if s.pools != nil {
+		e.dgp.ModifyProps(func(props *prototype.DynamicProperties) {
+			copyRewardPools(props, s.pools)
+		})
+	}
+	e.Mint()
+	e.Do()
+
+	s.pools = &prototype.DynamicProperties{}
+	copyRewardPools(s.pools, e.dgp.GetProps())
+	s.blocks++
+}
+
+func (s *RewardSimulation) addReward(account string, reward *big.Int) {
+	if sum, ok := s.rewards[account]; ok {
+		sum.Add(sum, reward)
+	} else {
+		s.rewards[account] = new(big.Int).Set(reward)
+	}
+}
+
+// Rewards returns total cashout rewards of accounts so far.
+func (s *RewardSimulation) Rewards() map[string]*big.Int {
+	return s.rewards
+}
+
+// Blocks returns the number of simulated blocks.
+func (s *RewardSimulation) Blocks() uint64 {
+	return s.blocks
+}
+
+func copyRewardPools(to, from *prototype.DynamicProperties) {
+	to.PoolPostRewards = prototype.NewVest(from.GetPoolPostRewards().GetValue())
+	to.PoolReplyRewards = prototype.NewVest(from.GetPoolReplyRewards().GetValue())
+	to.PoolVoteRewards = prototype.NewVest(from.GetPoolVoteRewards().GetValue())
+	to.PoolDappRewards = prototype.NewVest(from.GetPoolDappRewards().GetValue())
+	to.ClaimedPostRewards = prototype.NewVest(from.GetClaimedPostRewards().GetValue())
+	to.ClaimedReplyRewards = prototype.NewVest(from.GetClaimedReplyRewards().GetValue())
+	to.ClaimedVoteRewards = prototype.NewVest(from.GetClaimedVoteRewards().GetValue())
+	to.ClaimedDappRewards = prototype.NewVest(from.GetClaimedDappRewards().GetValue())
+	to.WeightedVpsPost = from.GetWeightedVpsPost()
+	to.WeightedVpsReply = from.GetWeightedVpsReply()
+	to.WeightedVpsVote = from.GetWeightedVpsVote()
+	to.WeightedVpsDapp = from.GetWeightedVpsDapp()
+}
@@ -256,6 +256,26 @@ func (s *SoAccountWrap) Modify(f func(tInfo *SoAccount), errArgs ...interface{})
 	return s
 }
 
+func (s *SoAccountWrap) SetActiveAuthority(p *prototype.Authority, errArgs ...interface{}) *SoAccountWrap {
+	err := s.modify(func(r *SoAccount) {
+		r.ActiveAuthority = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoAccountWrap.SetActiveAuthority( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoAccountWrap) SetAuthority(p *prototype.Authority, errArgs ...interface{}) *SoAccountWrap {
+	err := s.modify(func(r *SoAccount) {
+		r.Authority = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoAccountWrap.SetAuthority( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoAccountWrap) SetBalance(p *prototype.Coin, errArgs ...interface{}) *SoAccountWrap {
 	err := s.modify(func(r *SoAccount) {
 		r.Balance = p
@@ -436,6 +456,16 @@ func (s *SoAccountWrap) SetPostCount(p uint32, errArgs ...interface{}) *SoAccoun
 	return s
 }
 
+func (s *SoAccountWrap) SetPostingAuthority(p *prototype.Authority, errArgs ...interface{}) *SoAccountWrap {
+	err := s.modify(func(r *SoAccount) {
+		r.PostingAuthority = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoAccountWrap.SetPostingAuthority( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoAccountWrap) SetPubKey(p *prototype.PublicKeyType, errArgs ...interface{}) *SoAccountWrap {
 	err := s.modify(func(r *SoAccount) {
 		r.PubKey = p
@@ -609,6 +639,16 @@ func (s *SoAccountWrap) getModifiedFields(oriTable *SoAccount, curTable *SoAccou
 	hasWatcher := false
 	fields := make(map[string]bool)
 
+	if !reflect.DeepEqual(oriTable.ActiveAuthority, curTable.ActiveAuthority) {
+		fields["ActiveAuthority"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasActiveAuthorityWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Authority, curTable.Authority) {
+		fields["Authority"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAuthorityWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.Balance, curTable.Balance) {
 		fields["Balance"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasBalanceWatcher
@@ -699,6 +739,11 @@ func (s *SoAccountWrap) getModifiedFields(oriTable *SoAccount, curTable *SoAccou
 		hasWatcher = hasWatcher || s.watcherFlag.HasPostCountWatcher
 	}
 
+	if !reflect.DeepEqual(oriTable.PostingAuthority, curTable.PostingAuthority) {
+		fields["PostingAuthority"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasPostingAuthorityWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.PubKey, curTable.PubKey) {
 		fields["PubKey"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasPubKeyWatcher
@@ -780,6 +825,40 @@ func (s *SoAccountWrap) handleFieldMd(t FieldMdHandleType, so *SoAccount, fields
 
 	errStr := ""
 
+	if fields["ActiveAuthority"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldActiveAuthority(so.ActiveAuthority, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "ActiveAuthority")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldActiveAuthority(so.ActiveAuthority, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "ActiveAuthority")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldActiveAuthority(so.ActiveAuthority, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "ActiveAuthority")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Authority"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAuthority(so.Authority, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Authority")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAuthority(so.Authority, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Authority")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAuthority(so.Authority, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Authority")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["Balance"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -1086,6 +1165,23 @@ func (s *SoAccountWrap) handleFieldMd(t FieldMdHandleType, so *SoAccount, fields
 		}
 	}
 
+	if fields["PostingAuthority"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldPostingAuthority(so.PostingAuthority, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "PostingAuthority")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldPostingAuthority(so.PostingAuthority, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "PostingAuthority")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldPostingAuthority(so.PostingAuthority, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "PostingAuthority")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["PubKey"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -1886,6 +1982,170 @@ func (s *SoAccountWrap) RemoveAccount(errMsgs ...interface{}) *SoAccountWrap {
 
 ////////////// SECTION Members Get/Modify ///////////////
 
+func (s *SoAccountWrap) GetActiveAuthority() *prototype.Authority {
+	res := true
+	msg := &SoAccount{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.ActiveAuthority
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.ActiveAuthority
+}
+
+func (s *SoAccountWrap) mdFieldActiveAuthority(p *prototype.Authority, isCheck bool, isDel bool, isInsert bool,
+	so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkActiveAuthorityIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldActiveAuthority(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldActiveAuthority(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoAccountWrap) delFieldActiveAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) insertFieldActiveAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) checkActiveAuthorityIsMetMdCondition(p *prototype.Authority) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) GetAuthority() *prototype.Authority {
+	res := true
+	msg := &SoAccount{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Authority
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Authority
+}
+
+func (s *SoAccountWrap) mdFieldAuthority(p *prototype.Authority, isCheck bool, isDel bool, isInsert bool,
+	so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAuthorityIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAuthority(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAuthority(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoAccountWrap) delFieldAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) insertFieldAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) checkAuthorityIsMetMdCondition(p *prototype.Authority) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoAccountWrap) GetBalance() *prototype.Coin {
 	res := true
 	msg := &SoAccount{}
@@ -3463,6 +3723,88 @@ func (s *SoAccountWrap) checkPostCountIsMetMdCondition(p uint32) bool {
 	return true
 }
 
+func (s *SoAccountWrap) GetPostingAuthority() *prototype.Authority {
+	res := true
+	msg := &SoAccount{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.PostingAuthority
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.PostingAuthority
+}
+
+func (s *SoAccountWrap) mdFieldPostingAuthority(p *prototype.Authority, isCheck bool, isDel bool, isInsert bool,
+	so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkPostingAuthorityIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldPostingAuthority(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldPostingAuthority(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoAccountWrap) delFieldPostingAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) insertFieldPostingAuthority(so *SoAccount) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoAccountWrap) checkPostingAuthorityIsMetMdCondition(p *prototype.Authority) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoAccountWrap) GetPubKey() *prototype.PublicKeyType {
 	res := true
 	msg := &SoAccount{}
@@ -6241,6 +6583,10 @@ func (s *UniAccountPubKeyWrap) UniQueryPubKey(start *prototype.PublicKeyType) *S
 ////////////// SECTION Watchers ///////////////
 
 type AccountWatcherFlag struct {
+	HasActiveAuthorityWatcher bool
+
+	HasAuthorityWatcher bool
+
 	HasBalanceWatcher bool
 
 	HasBorrowedVestWatcher bool
@@ -6277,6 +6623,8 @@ type AccountWatcherFlag struct {
 
 	HasPostCountWatcher bool
 
+	HasPostingAuthorityWatcher bool
+
 	HasPubKeyWatcher bool
 
 	HasReputationWatcher bool
@@ -6328,6 +6676,12 @@ func AccountRecordWatcherChanged(dbSvcId uint32) {
 	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "")
 	flag.AnyWatcher = flag.WholeWatcher
 
+	flag.HasActiveAuthorityWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "ActiveAuthority")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasActiveAuthorityWatcher
+
+	flag.HasAuthorityWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "Authority")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAuthorityWatcher
+
 	flag.HasBalanceWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "Balance")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasBalanceWatcher
 
@@ -6382,6 +6736,9 @@ func AccountRecordWatcherChanged(dbSvcId uint32) {
 	flag.HasPostCountWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "PostCount")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasPostCountWatcher
 
+	flag.HasPostingAuthorityWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "PostingAuthority")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasPostingAuthorityWatcher
+
 	flag.HasPubKeyWatcher = HasTableRecordWatcher(dbSvcId, AccountTable.Record, "PubKey")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasPubKeyWatcher
 
@@ -54,6 +54,9 @@ type SoAccount struct {
 	BorrowedVest           *prototype.Vest          `protobuf:"bytes,30,opt,name=borrowed_vest,json=borrowedVest,proto3" json:"borrowed_vest,omitempty"`
 	LentVest               *prototype.Vest          `protobuf:"bytes,31,opt,name=lent_vest,json=lentVest,proto3" json:"lent_vest,omitempty"`
 	DeliveringVest         *prototype.Vest          `protobuf:"bytes,32,opt,name=delivering_vest,json=deliveringVest,proto3" json:"delivering_vest,omitempty"`
+	Authority              *prototype.Authority     `protobuf:"bytes,33,opt,name=authority,proto3" json:"authority,omitempty"`
+	ActiveAuthority        *prototype.Authority     `protobuf:"bytes,34,opt,name=active_authority,json=activeAuthority,proto3" json:"active_authority,omitempty"`
+	PostingAuthority       *prototype.Authority     `protobuf:"bytes,35,opt,name=posting_authority,json=postingAuthority,proto3" json:"posting_authority,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
 	XXX_unrecognized       []byte                   `json:"-"`
 	XXX_sizecache          int32                    `json:"-"`
@@ -308,6 +311,27 @@ func (m *SoAccount) GetDeliveringVest() *prototype.Vest {
 	return nil
 }
 
+func (m *SoAccount) GetAuthority() *prototype.Authority {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *SoAccount) GetActiveAuthority() *prototype.Authority {
+	if m != nil {
+		return m.ActiveAuthority
+	}
+	return nil
+}
+
+func (m *SoAccount) GetPostingAuthority() *prototype.Authority {
+	if m != nil {
+		return m.PostingAuthority
+	}
+	return nil
+}
+
 type SoListAccountByCreatedTime struct {
 	CreatedTime          *prototype.TimePointSec `protobuf:"bytes,1,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	Name                 *prototype.AccountName  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -883,66 +907,69 @@ func init() {
 func init() { proto.RegisterFile("app/table/so_account.proto", fileDescriptor_246c6b96a0e2a331) }
 
 var fileDescriptor_246c6b96a0e2a331 = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0xc6, 0xa6, 0x8e, 0x15, 0x8f, 0x5e, 0xf5, 0x3a, 0xb1, 0xd7, 0x6a, 0x1c, 0xab, 0x6a, 0x8b,
-	0xba, 0x49, 0x6a, 0xa1, 0x71, 0x80, 0x3e, 0x10, 0x20, 0x68, 0x0a, 0x04, 0x05, 0x0a, 0x17, 0x86,
-	0x9a, 0xe6, 0xd0, 0xcb, 0x62, 0x49, 0x4f, 0x2c, 0x42, 0x22, 0x97, 0xe5, 0x2e, 0x6d, 0xab, 0x97,
-	0xe6, 0xd2, 0x53, 0x7b, 0xeb, 0x1f, 0x0e, 0x76, 0x28, 0x4a, 0xb4, 0x45, 0xcb, 0xe2, 0xc5, 0xd0,
-	0xce, 0x7c, 0xdf, 0xbc, 0x96, 0x9c, 0x8f, 0x86, 0x8e, 0x8a, 0xe3, 0xbe, 0x55, 0xde, 0x18, 0xfb,
-	0x46, 0x4b, 0xe5, 0xfb, 0x3a, 0x8d, 0xec, 0x61, 0x9c, 0x68, 0xab, 0xf9, 0x5d, 0xb2, 0x77, 0xee,
-	0xd3, 0xc9, 0x4e, 0x62, 0xec, 0xbb, 0x3f, 0x99, 0xb3, 0xf7, 0xbe, 0x01, 0x30, 0x67, 0xf0, 0x27,
-	0xb0, 0x16, 0xa9, 0x10, 0x05, 0xeb, 0xb2, 0x83, 0xfa, 0xb3, 0x9d, 0xc3, 0x19, 0xe7, 0x70, 0x8a,
-	0x90, 0xce, 0x3d, 0x20, 0x10, 0x7f, 0x01, 0x0d, 0x3f, 0x41, 0x65, 0xf1, 0x54, 0xda, 0x20, 0x44,
-	0x71, 0x87, 0x48, 0xbb, 0x05, 0x92, 0x33, 0xcb, 0x58, 0x07, 0x91, 0x95, 0x06, 0xfd, 0x41, 0x7d,
-	0x0a, 0x7f, 0x13, 0x84, 0xc8, 0xbf, 0x81, 0x1a, 0x1d, 0x75, 0x22, 0x3e, 0x5a, 0x9e, 0x2d, 0xc7,
-	0xf1, 0xaf, 0xa0, 0xe6, 0xa9, 0xb1, 0x8a, 0x7c, 0x14, 0x6b, 0x44, 0x69, 0x17, 0x28, 0xbe, 0x0e,
-	0xa2, 0x41, 0xee, 0xe7, 0x9f, 0xc1, 0xda, 0x39, 0x1a, 0x2b, 0xee, 0x2e, 0xe0, 0x9c, 0x79, 0x40,
-	0x4e, 0xde, 0x83, 0xa6, 0x17, 0xcb, 0x73, 0x6d, 0x51, 0x52, 0x3a, 0xb1, 0xde, 0x65, 0x07, 0xcd,
-	0x41, 0xdd, 0x8b, 0xdf, 0x6a, 0x8b, 0x3f, 0xd1, 0x44, 0x5e, 0x42, 0x6b, 0xac, 0x8c, 0xcd, 0x50,
-	0xd4, 0x66, 0xed, 0xb6, 0x36, 0x1b, 0x8e, 0xe0, 0x22, 0x50, 0x9f, 0x79, 0x80, 0x58, 0x1b, 0x9b,
-	0x05, 0xb8, 0xb7, 0x52, 0x80, 0x13, 0x6d, 0x2c, 0x05, 0xd8, 0x03, 0xa0, 0xe4, 0xb1, 0xbe, 0xc0,
-	0x44, 0x6c, 0x50, 0x89, 0x1b, 0xce, 0x72, 0xe2, 0x0c, 0xce, 0x4d, 0xa1, 0xb3, 0x0e, 0x20, 0x73,
-	0x3b, 0x4b, 0x56, 0xff, 0x63, 0xd8, 0x9c, 0x5d, 0x52, 0x72, 0x39, 0x45, 0xd5, 0x09, 0xd5, 0xce,
-	0xaf, 0x23, 0xb9, 0xcc, 0xb0, 0xdf, 0xc3, 0xae, 0xb1, 0x2a, 0xb1, 0x59, 0xaa, 0x53, 0x7d, 0x11,
-	0x49, 0x6f, 0xac, 0xfd, 0x91, 0x8c, 0xd2, 0x50, 0x34, 0xba, 0xec, 0x60, 0x6d, 0xb0, 0x4d, 0x80,
-	0x93, 0xdc, 0xff, 0xca, 0xb9, 0x7f, 0x4d, 0x43, 0xfe, 0x2d, 0x88, 0x08, 0x2f, 0xcb, 0x99, 0x4d,
-	0x62, 0x3e, 0x70, 0xfe, 0x45, 0xe2, 0x4b, 0xd8, 0x42, 0xe5, 0x0f, 0x0b, 0xc4, 0x44, 0x59, 0x14,
-	0xad, 0xf2, 0x7b, 0xdb, 0x74, 0xd8, 0x59, 0x90, 0x81, 0xb2, 0xc8, 0x9f, 0x41, 0xc3, 0xea, 0x39,
-	0x5d, 0xb4, 0xcb, 0x99, 0x75, 0xab, 0x67, 0x3c, 0xfe, 0x1c, 0x9a, 0x43, 0x65, 0x0a, 0xa4, 0x8f,
-	0xcb, 0x49, 0x8d, 0xa1, 0x32, 0x73, 0xd6, 0x11, 0xd4, 0xe2, 0xd4, 0x93, 0x23, 0x9c, 0x88, 0x4d,
-	0xc2, 0x77, 0x0a, 0xf8, 0x38, 0xf5, 0xc6, 0x81, 0xef, 0x9c, 0xd2, 0x9d, 0x07, 0xeb, 0x71, 0xea,
-	0xfd, 0x82, 0x13, 0x2e, 0xa0, 0x66, 0xac, 0x0a, 0x83, 0x48, 0x09, 0x4e, 0x73, 0xc8, 0x8f, 0xee,
-	0x66, 0xa6, 0x3f, 0x65, 0x6a, 0x30, 0x9b, 0x97, 0xd8, 0x22, 0x4c, 0x7b, 0xea, 0xf8, 0xdd, 0x20,
-	0x0d, 0x8a, 0x7f, 0x0a, 0x8d, 0x1c, 0xfb, 0x2e, 0x41, 0x14, 0xf7, 0x09, 0x56, 0x9f, 0xda, 0x5e,
-	0x27, 0x88, 0xfc, 0x08, 0xb6, 0x8b, 0x90, 0x42, 0xcc, 0x07, 0x04, 0xde, 0x2a, 0x80, 0x67, 0x71,
-	0x5f, 0x00, 0x37, 0x56, 0x8d, 0x50, 0xba, 0x76, 0xe5, 0xbb, 0x44, 0x87, 0x32, 0x44, 0xb1, 0x5d,
-	0x3e, 0x8d, 0x36, 0x41, 0xdf, 0xa2, 0xb1, 0xaf, 0x13, 0x1d, 0x1e, 0x23, 0xff, 0x81, 0x3a, 0x98,
-	0xb1, 0x75, 0xe2, 0xc8, 0x3b, 0xe5, 0xe4, 0xd6, 0x9c, 0xac, 0x93, 0x63, 0xe4, 0x3f, 0x42, 0x9b,
-	0x5e, 0x8b, 0x2c, 0x00, 0xbd, 0x17, 0xe2, 0xb6, 0xf7, 0xa2, 0xe9, 0x18, 0xbf, 0x39, 0x02, 0xbd,
-	0x18, 0x8f, 0x00, 0x12, 0x8c, 0x53, 0xab, 0x6c, 0xa0, 0x23, 0xb1, 0x4b, 0xcf, 0x74, 0xc1, 0xc2,
-	0xbf, 0x84, 0xf6, 0xfc, 0x24, 0x43, 0x0c, 0xb5, 0xe8, 0x74, 0xd9, 0xc1, 0xc6, 0xa0, 0x35, 0x37,
-	0x1f, 0x63, 0xa8, 0xf9, 0x17, 0xd0, 0xf2, 0x87, 0x2a, 0x39, 0xa3, 0x45, 0xe6, 0x8f, 0xd0, 0x8a,
-	0x4f, 0x28, 0x58, 0x73, 0x6a, 0x7d, 0x43, 0x46, 0xbe, 0x0d, 0xeb, 0x6e, 0xb2, 0x7f, 0xa1, 0x78,
-	0x48, 0xee, 0xe9, 0x89, 0xef, 0x43, 0x3d, 0xfb, 0x95, 0xe5, 0xd8, 0xa3, 0x1c, 0x90, 0x99, 0x28,
-	0xfe, 0x73, 0x68, 0x7a, 0x3a, 0x49, 0xf4, 0x05, 0x9e, 0xd2, 0xa8, 0xc4, 0xa3, 0x1b, 0x1e, 0xb7,
-	0x1c, 0xe5, 0xc6, 0xc4, 0x9f, 0xc2, 0xc6, 0x18, 0x23, 0x9b, 0x31, 0xf6, 0xcb, 0x19, 0xf7, 0x1c,
-	0x82, 0xd0, 0xdf, 0x41, 0xfb, 0x14, 0xc7, 0xc1, 0x39, 0x26, 0x41, 0x74, 0x96, 0x71, 0xba, 0x37,
-	0xdc, 0xc4, 0x1c, 0xe7, 0x98, 0xbd, 0xff, 0x18, 0xec, 0x1b, 0x2d, 0xc7, 0x81, 0xb1, 0xb9, 0x0e,
-	0x48, 0x6f, 0x22, 0x8b, 0xab, 0x7d, 0x61, 0xd5, 0xb3, 0x4a, 0xab, 0x3e, 0x57, 0x95, 0x3b, 0x2b,
-	0xa8, 0x4a, 0xcf, 0x42, 0xa7, 0xa4, 0x9a, 0x7c, 0xaf, 0x17, 0x24, 0x80, 0xdd, 0x22, 0x01, 0x95,
-	0xb2, 0x8e, 0x60, 0xa7, 0x24, 0x2b, 0xa9, 0x44, 0x2e, 0x25, 0x6c, 0x99, 0x94, 0x54, 0x4a, 0x66,
-	0xa0, 0x5b, 0xd6, 0x62, 0x51, 0x8a, 0x16, 0xb5, 0x89, 0x2d, 0x6a, 0x53, 0xc5, 0x0e, 0xf7, 0x4a,
-	0x92, 0xce, 0xa5, 0xe3, 0x9a, 0x90, 0xb0, 0xeb, 0x42, 0x52, 0x29, 0xd9, 0xdf, 0xf0, 0xf9, 0xb2,
-	0x47, 0x2a, 0x17, 0xa2, 0x72, 0x75, 0x62, 0xe5, 0xea, 0x54, 0xa9, 0x80, 0xff, 0x19, 0x3c, 0x29,
-	0xa9, 0xe0, 0x26, 0x8d, 0x5a, 0xaa, 0x5f, 0x6c, 0x99, 0x7e, 0x55, 0xaa, 0xea, 0x1f, 0x56, 0x7e,
-	0xf3, 0xc5, 0xe5, 0xb0, 0xb8, 0x2d, 0xd8, 0x2a, 0xdb, 0xa2, 0x52, 0x1d, 0x13, 0x78, 0x58, 0x52,
-	0xc6, 0x6c, 0xdb, 0x5c, 0x5d, 0x3d, 0xec, 0xb6, 0xd5, 0x53, 0x29, 0xf5, 0xbf, 0x0c, 0x7a, 0x25,
-	0xb9, 0xaf, 0xed, 0xae, 0xb2, 0x75, 0xc6, 0x56, 0x5a, 0x67, 0xd5, 0xaa, 0xf9, 0x19, 0x76, 0x8d,
-	0x96, 0x69, 0x14, 0xfc, 0x99, 0xe2, 0x95, 0xe7, 0x44, 0x15, 0xd6, 0xd6, 0x2a, 0x1f, 0xc3, 0xbd,
-	0xf7, 0x8c, 0x66, 0xba, 0x18, 0x6a, 0xfa, 0xc9, 0x50, 0xfc, 0x7a, 0x60, 0x2b, 0x7f, 0x3d, 0x54,
-	0x69, 0xe6, 0xd5, 0xd3, 0x3f, 0x1e, 0x9f, 0x05, 0x76, 0x98, 0x7a, 0x87, 0xbe, 0x0e, 0xfb, 0xbe,
-	0x36, 0xfe, 0x50, 0x05, 0x51, 0xdf, 0xd7, 0x91, 0xc5, 0xc8, 0x6a, 0xf3, 0xf5, 0x99, 0xee, 0xcf,
-	0xfe, 0x4f, 0xf0, 0xd6, 0x29, 0xd6, 0xd1, 0x87, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x35, 0x5c,
-	0x70, 0x3b, 0x0c, 0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x5f, 0x4f, 0x1b, 0xc7,
+	0x17, 0xd5, 0xe4, 0x47, 0x20, 0x5c, 0xdb, 0x18, 0x06, 0x02, 0x03, 0x3f, 0x08, 0x8e, 0xd3, 0xaa,
+	0x34, 0x49, 0xb1, 0x0a, 0x91, 0xfa, 0x47, 0x91, 0x10, 0xa9, 0x14, 0x55, 0xaa, 0xa8, 0x90, 0x9b,
+	0xe6, 0xa1, 0x2f, 0xa3, 0xd9, 0xe5, 0x06, 0xaf, 0xec, 0xdd, 0xd9, 0xee, 0xce, 0x02, 0xee, 0x4b,
+	0xfb, 0xd2, 0xa7, 0xf6, 0xad, 0x5f, 0xa6, 0x1f, 0xaf, 0x9a, 0xbb, 0xde, 0x3f, 0xc1, 0x8b, 0xf1,
+	0xbe, 0x20, 0xcf, 0xbd, 0xe7, 0x9c, 0x7b, 0x67, 0x66, 0xf7, 0x9e, 0x05, 0x76, 0x54, 0x18, 0xf6,
+	0x8c, 0x72, 0x46, 0xd8, 0x8b, 0xb5, 0x54, 0xae, 0xab, 0x93, 0xc0, 0x1c, 0x86, 0x91, 0x36, 0x9a,
+	0x3f, 0xa4, 0xf8, 0xce, 0x06, 0xad, 0xcc, 0x38, 0xc4, 0x9e, 0xfd, 0x93, 0x26, 0xbb, 0xff, 0xb6,
+	0x00, 0x0a, 0x06, 0x7f, 0x01, 0x0b, 0x81, 0xf2, 0x51, 0xb0, 0x0e, 0x3b, 0x68, 0x1c, 0x6d, 0x1d,
+	0xe6, 0x9c, 0xc3, 0x09, 0x42, 0xda, 0x74, 0x9f, 0x40, 0xfc, 0x35, 0x34, 0xdd, 0x08, 0x95, 0xc1,
+	0x0b, 0x69, 0x3c, 0x1f, 0xc5, 0x03, 0x22, 0x6d, 0x97, 0x48, 0x36, 0x2c, 0x43, 0xed, 0x05, 0x46,
+	0xc6, 0xe8, 0xf6, 0x1b, 0x13, 0xf8, 0x3b, 0xcf, 0x47, 0xfe, 0x25, 0x2c, 0xd1, 0x52, 0x47, 0xe2,
+	0x7f, 0xb3, 0xab, 0x65, 0x38, 0xfe, 0x39, 0x2c, 0x39, 0x6a, 0xa4, 0x02, 0x17, 0xc5, 0x02, 0x51,
+	0xda, 0x25, 0x8a, 0xab, 0xbd, 0xa0, 0x9f, 0xe5, 0xf9, 0x33, 0x58, 0xb8, 0xc2, 0xd8, 0x88, 0x87,
+	0x53, 0x38, 0x1b, 0xee, 0x53, 0x92, 0x77, 0xa1, 0xe5, 0x84, 0xf2, 0x4a, 0x1b, 0x94, 0x54, 0x4e,
+	0x2c, 0x76, 0xd8, 0x41, 0xab, 0xdf, 0x70, 0xc2, 0xf7, 0xda, 0xe0, 0x77, 0x74, 0x22, 0x27, 0xb0,
+	0x32, 0x52, 0xb1, 0x49, 0x51, 0xb4, 0xcd, 0xa5, 0xfb, 0xb6, 0xd9, 0xb4, 0x04, 0xab, 0x40, 0xfb,
+	0xcc, 0x04, 0x42, 0x1d, 0x9b, 0x54, 0xe0, 0xd1, 0x5c, 0x02, 0xe7, 0x3a, 0x36, 0x24, 0xb0, 0x07,
+	0x40, 0xc5, 0x43, 0x7d, 0x8d, 0x91, 0x58, 0xa6, 0x16, 0x97, 0x6d, 0xe4, 0xdc, 0x06, 0x6c, 0x9a,
+	0xa4, 0xd3, 0x1d, 0x40, 0x9a, 0xb6, 0x91, 0xb4, 0xff, 0xe7, 0xb0, 0x96, 0x5f, 0x52, 0x74, 0x33,
+	0x41, 0x35, 0x08, 0xd5, 0xce, 0xae, 0x23, 0xba, 0x49, 0xb1, 0xdf, 0xc0, 0x76, 0x6c, 0x54, 0x64,
+	0xd2, 0x52, 0x17, 0xfa, 0x3a, 0x90, 0xce, 0x48, 0xbb, 0x43, 0x19, 0x24, 0xbe, 0x68, 0x76, 0xd8,
+	0xc1, 0x42, 0x7f, 0x93, 0x00, 0xe7, 0x59, 0xfe, 0x8d, 0x4d, 0xff, 0x98, 0xf8, 0xfc, 0x2b, 0x10,
+	0x01, 0xde, 0x54, 0x33, 0x5b, 0xc4, 0x7c, 0x6c, 0xf3, 0xd3, 0xc4, 0x13, 0x58, 0x47, 0xe5, 0x0e,
+	0x4a, 0xc4, 0x48, 0x19, 0x14, 0x2b, 0xd5, 0xf7, 0xb6, 0x66, 0xb1, 0xb9, 0x48, 0x5f, 0x19, 0xe4,
+	0x47, 0xd0, 0x34, 0xba, 0xa0, 0x8b, 0x76, 0x35, 0xb3, 0x61, 0x74, 0xce, 0xe3, 0xaf, 0xa0, 0x35,
+	0x50, 0x71, 0x89, 0xb4, 0x5a, 0x4d, 0x6a, 0x0e, 0x54, 0x5c, 0xb0, 0x8e, 0x61, 0x29, 0x4c, 0x1c,
+	0x39, 0xc4, 0xb1, 0x58, 0x23, 0xfc, 0x4e, 0x09, 0x1f, 0x26, 0xce, 0xc8, 0x73, 0x6d, 0x52, 0xda,
+	0x75, 0x7f, 0x31, 0x4c, 0x9c, 0x1f, 0x70, 0xcc, 0x05, 0x2c, 0xc5, 0x46, 0xf9, 0x5e, 0xa0, 0x04,
+	0xa7, 0x73, 0xc8, 0x96, 0xf6, 0x66, 0x26, 0x3f, 0x65, 0x12, 0x63, 0x7a, 0x5e, 0x62, 0x9d, 0x30,
+	0xed, 0x49, 0xe2, 0xe7, 0x18, 0xe9, 0xa0, 0xf8, 0x53, 0x68, 0x66, 0xd8, 0x0f, 0x11, 0xa2, 0xd8,
+	0x20, 0x58, 0x63, 0x12, 0x7b, 0x1b, 0x21, 0xf2, 0x63, 0xd8, 0x2c, 0x43, 0x4a, 0x9a, 0x8f, 0x09,
+	0xbc, 0x5e, 0x02, 0xe7, 0xba, 0xaf, 0x81, 0xc7, 0x46, 0x0d, 0x51, 0xda, 0xed, 0xca, 0x0f, 0x91,
+	0xf6, 0xa5, 0x8f, 0x62, 0xb3, 0xfa, 0x34, 0xda, 0x04, 0x7d, 0x8f, 0xb1, 0x79, 0x1b, 0x69, 0xff,
+	0x0c, 0xf9, 0xb7, 0xb0, 0x56, 0x66, 0xeb, 0xc8, 0x92, 0xb7, 0xaa, 0xc9, 0x2b, 0x05, 0x59, 0x47,
+	0x67, 0xc8, 0x4f, 0xa1, 0x4d, 0xaf, 0x45, 0x2a, 0x40, 0xef, 0x85, 0xb8, 0xef, 0xbd, 0x68, 0x59,
+	0xc6, 0x4f, 0x96, 0x40, 0x2f, 0xc6, 0x13, 0x80, 0x08, 0xc3, 0xc4, 0x28, 0xe3, 0xe9, 0x40, 0x6c,
+	0xd3, 0x33, 0x5d, 0x8a, 0xf0, 0xcf, 0xa0, 0x5d, 0xac, 0xa4, 0x8f, 0xbe, 0x16, 0x3b, 0x1d, 0x76,
+	0xb0, 0xdc, 0x5f, 0x29, 0xc2, 0x67, 0xe8, 0x6b, 0xfe, 0x29, 0xac, 0xb8, 0x03, 0x15, 0x5d, 0xd2,
+	0x20, 0x73, 0x87, 0x68, 0xc4, 0xff, 0x49, 0xac, 0x35, 0x89, 0xbe, 0xa3, 0x20, 0xdf, 0x84, 0x45,
+	0x7b, 0xb2, 0xbf, 0xa1, 0xd8, 0xa5, 0xf4, 0x64, 0xc5, 0xf7, 0xa1, 0x91, 0xfe, 0x4a, 0x6b, 0xec,
+	0x51, 0x0d, 0x48, 0x43, 0xa4, 0xff, 0x0a, 0x5a, 0x8e, 0x8e, 0x22, 0x7d, 0x8d, 0x17, 0x74, 0x54,
+	0xe2, 0xc9, 0x1d, 0x8f, 0x5b, 0x86, 0xb2, 0xc7, 0xc4, 0x5f, 0xc2, 0xf2, 0x08, 0x03, 0x93, 0x32,
+	0xf6, 0xab, 0x19, 0x8f, 0x2c, 0x82, 0xd0, 0x5f, 0x43, 0xfb, 0x02, 0x47, 0xde, 0x15, 0x46, 0x5e,
+	0x70, 0x99, 0x72, 0x3a, 0x77, 0xdc, 0x44, 0x81, 0x23, 0xe6, 0x11, 0x2c, 0xab, 0xc4, 0x0c, 0x74,
+	0xe4, 0x99, 0xb1, 0x78, 0x4a, 0x9c, 0x8d, 0x12, 0x27, 0xcf, 0xf5, 0x0b, 0x18, 0x3f, 0x81, 0x55,
+	0xe5, 0x1a, 0xef, 0x0a, 0x65, 0x41, 0xed, 0xce, 0xa0, 0xb6, 0x53, 0xf4, 0x69, 0x2e, 0x70, 0x0a,
+	0x6b, 0x76, 0x46, 0xd9, 0x5e, 0x0b, 0x85, 0x67, 0x33, 0x14, 0x56, 0x27, 0xf0, 0x5c, 0xa2, 0xfb,
+	0x37, 0x83, 0xfd, 0x58, 0xcb, 0x91, 0x17, 0x9b, 0xcc, 0xbf, 0xa4, 0x33, 0x96, 0x65, 0x4b, 0x9a,
+	0xb2, 0x28, 0x56, 0xcb, 0xa2, 0x32, 0x37, 0x7c, 0x30, 0x87, 0x1b, 0x76, 0x0d, 0xec, 0x54, 0x74,
+	0x93, 0xf9, 0x51, 0xc9, 0xba, 0xd8, 0x3d, 0xd6, 0x55, 0xab, 0xea, 0x10, 0xb6, 0x2a, 0xaa, 0x92,
+	0xbb, 0x65, 0x16, 0xc8, 0x66, 0x59, 0x60, 0xad, 0x62, 0x31, 0x74, 0xaa, 0xb6, 0x58, 0xb6, 0xd0,
+	0x69, 0x4f, 0x65, 0xd3, 0x9e, 0x5a, 0x73, 0x87, 0x7b, 0x15, 0x45, 0x0b, 0xcb, 0xbb, 0x65, 0x80,
+	0xec, 0xb6, 0x01, 0xd6, 0x2a, 0xf6, 0x3b, 0x7c, 0x32, 0xeb, 0x91, 0xca, 0x0c, 0xb4, 0xda, 0x55,
+	0x59, 0xb5, 0xab, 0xd6, 0x6a, 0xe0, 0x1f, 0x06, 0x2f, 0x2a, 0x3a, 0xb8, 0xcb, 0x5b, 0x67, 0xfa,
+	0x2e, 0x9b, 0xe5, 0xbb, 0xb5, 0xba, 0xfa, 0x93, 0x55, 0xdf, 0x7c, 0x79, 0xa8, 0x4d, 0x4f, 0x39,
+	0x36, 0xcf, 0x94, 0xab, 0xd5, 0xc7, 0x18, 0x76, 0x2b, 0xda, 0xc8, 0xa7, 0xe4, 0xc7, 0x23, 0x93,
+	0xdd, 0x37, 0x32, 0x6b, 0x95, 0xfe, 0x8b, 0x41, 0xb7, 0xa2, 0xf6, 0xad, 0x99, 0x5b, 0x35, 0x86,
+	0xd9, 0x7c, 0x63, 0xb8, 0x56, 0x37, 0xdf, 0xc3, 0x76, 0xac, 0x65, 0x12, 0x78, 0xbf, 0x26, 0xf8,
+	0xd1, 0x73, 0xa2, 0x4a, 0x63, 0x6b, 0x9e, 0x8f, 0xf8, 0xee, 0x1f, 0x0c, 0x76, 0x2b, 0xa5, 0x26,
+	0x9f, 0x3a, 0xe5, 0xaf, 0x1e, 0x36, 0xf7, 0x57, 0x4f, 0x9d, 0xcd, 0xbc, 0x79, 0xf9, 0xcb, 0xf3,
+	0x4b, 0xcf, 0x0c, 0x12, 0xe7, 0xd0, 0xd5, 0x7e, 0xcf, 0xd5, 0xb1, 0x3b, 0x50, 0x5e, 0xd0, 0x73,
+	0x75, 0x60, 0x30, 0x30, 0x3a, 0xfe, 0xe2, 0x52, 0xf7, 0xf2, 0xff, 0x6f, 0x9c, 0x45, 0xd2, 0x3a,
+	0xfe, 0x6f, 0x00, 0x24, 0xfb, 0x5a, 0x03, 0xf3, 0x0c, 0x00, 0x00,
 }
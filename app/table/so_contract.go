@@ -307,6 +307,16 @@ func (s *SoContractWrap) SetDescribe(p string, errArgs ...interface{}) *SoContra
 	return s
 }
 
+func (s *SoContractWrap) SetEventCount(p uint64, errArgs ...interface{}) *SoContractWrap {
+	err := s.modify(func(r *SoContract) {
+		r.EventCount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractWrap.SetEventCount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoContractWrap) SetHash(p *prototype.Sha256, errArgs ...interface{}) *SoContractWrap {
 	err := s.modify(func(r *SoContract) {
 		r.Hash = p
@@ -386,6 +396,11 @@ func (s *SoContractWrap) getModifiedFields(oriTable *SoContract, curTable *SoCon
 		hasWatcher = hasWatcher || s.watcherFlag.HasDescribeWatcher
 	}
 
+	if !reflect.DeepEqual(oriTable.EventCount, curTable.EventCount) {
+		fields["EventCount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasEventCountWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.Hash, curTable.Hash) {
 		fields["Hash"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasHashWatcher
@@ -519,6 +534,23 @@ func (s *SoContractWrap) handleFieldMd(t FieldMdHandleType, so *SoContract, fiel
 		}
 	}
 
+	if fields["EventCount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldEventCount(so.EventCount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "EventCount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldEventCount(so.EventCount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "EventCount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldEventCount(so.EventCount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "EventCount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["Hash"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -1257,6 +1289,88 @@ func (s *SoContractWrap) checkDescribeIsMetMdCondition(p string) bool {
 	return true
 }
 
+func (s *SoContractWrap) GetEventCount() uint64 {
+	res := true
+	msg := &SoContract{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.EventCount
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint64
+		return tmpValue
+	}
+	return msg.EventCount
+}
+
+func (s *SoContractWrap) mdFieldEventCount(p uint64, isCheck bool, isDel bool, isInsert bool,
+	so *SoContract) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkEventCountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldEventCount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldEventCount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoContractWrap) delFieldEventCount(so *SoContract) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractWrap) insertFieldEventCount(so *SoContract) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractWrap) checkEventCountIsMetMdCondition(p uint64) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoContractWrap) GetHash() *prototype.Sha256 {
 	res := true
 	msg := &SoContract{}
@@ -2047,6 +2161,8 @@ type ContractWatcherFlag struct {
 
 	HasDescribeWatcher bool
 
+	HasEventCountWatcher bool
+
 	HasHashWatcher bool
 
 	HasUpgradeableWatcher bool
@@ -2096,6 +2212,9 @@ func ContractRecordWatcherChanged(dbSvcId uint32) {
 	flag.HasDescribeWatcher = HasTableRecordWatcher(dbSvcId, ContractTable.Record, "Describe")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasDescribeWatcher
 
+	flag.HasEventCountWatcher = HasTableRecordWatcher(dbSvcId, ContractTable.Record, "EventCount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasEventCountWatcher
+
 	flag.HasHashWatcher = HasTableRecordWatcher(dbSvcId, ContractTable.Record, "Hash")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasHashWatcher
 
@@ -32,6 +32,7 @@ type SoContract struct {
 	Hash                 *prototype.Sha256       `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash,omitempty"`
 	Url                  string                  `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
 	Describe             string                  `protobuf:"bytes,10,opt,name=describe,proto3" json:"describe,omitempty"`
+	EventCount           uint64                  `protobuf:"varint,11,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -132,6 +133,13 @@ func (m *SoContract) GetDescribe() string {
 	return ""
 }
 
+func (m *SoContract) GetEventCount() uint64 {
+	if m != nil {
+		return m.EventCount
+	}
+	return 0
+}
+
 type SoListContractByCreatedTime struct {
 	CreatedTime          *prototype.TimePointSec `protobuf:"bytes,1,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	Id                   *prototype.ContractId   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -275,30 +283,31 @@ func init() {
 func init() { proto.RegisterFile("app/table/so_contract.proto", fileDescriptor_6ccfb8b33a116ac5) }
 
 var fileDescriptor_6ccfb8b33a116ac5 = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
+	// 410 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
 	0x10, 0x95, 0xd3, 0x6d, 0xbb, 0x9d, 0x14, 0x01, 0x16, 0x42, 0x66, 0x39, 0x34, 0x5a, 0x09, 0x14,
-	0x10, 0x6c, 0xa4, 0x22, 0x38, 0x71, 0x2a, 0x7f, 0x60, 0x71, 0xe2, 0x62, 0x39, 0xb6, 0xb5, 0x19,
-	0x94, 0xb5, 0x4d, 0x6c, 0x1f, 0xf6, 0x0f, 0xf8, 0x48, 0x3e, 0x06, 0xc5, 0x4b, 0x43, 0x4a, 0x91,
-	0x58, 0x2e, 0xd1, 0xcc, 0x7b, 0x33, 0xf3, 0x46, 0x6f, 0x62, 0x78, 0x2e, 0xbd, 0x6f, 0xa2, 0x6c,
-	0x7b, 0xd3, 0x04, 0x27, 0x94, 0xb3, 0x71, 0x90, 0x2a, 0x6e, 0xfc, 0xe0, 0xa2, 0xa3, 0xa7, 0x99,
-	0x58, 0x3d, 0xc9, 0x59, 0xdc, 0x7b, 0xd3, 0x8c, 0x9f, 0x03, 0xb9, 0x62, 0xbf, 0xd1, 0x5d, 0xea,
-	0x23, 0x0a, 0xd4, 0x07, 0x66, 0xfd, 0xa3, 0x80, 0x72, 0x36, 0x8c, 0xbe, 0x84, 0x02, 0x35, 0x23,
-	0x15, 0xa9, 0xcb, 0xeb, 0xa7, 0x9b, 0xa9, 0x6d, 0x73, 0x5b, 0x20, 0x50, 0xf3, 0x02, 0x35, 0xfd,
-	0x08, 0x97, 0x6a, 0x30, 0x32, 0x1a, 0x2d, 0x22, 0xee, 0x0c, 0x2b, 0x72, 0xc7, 0xb3, 0x59, 0xc7,
-	0x08, 0x0b, 0xef, 0xd0, 0x46, 0x11, 0x8c, 0xe2, 0xe5, 0xaf, 0xf2, 0xcf, 0xb8, 0x33, 0xf4, 0x11,
-	0x9c, 0xc8, 0x16, 0xd9, 0x49, 0x45, 0xea, 0x0b, 0x3e, 0x86, 0xf4, 0x15, 0x9c, 0xb7, 0xb2, 0x97,
-	0x56, 0x19, 0xb6, 0xc8, 0xa3, 0x1e, 0xde, 0x11, 0x47, 0xcb, 0x6f, 0x79, 0x4a, 0x61, 0xa1, 0x9c,
-	0x36, 0xec, 0xb4, 0x22, 0xf5, 0x25, 0xcf, 0x31, 0xbd, 0x82, 0x52, 0x7a, 0xdf, 0xef, 0x85, 0x72,
-	0xc9, 0x46, 0x76, 0x56, 0x91, 0xfa, 0x01, 0x87, 0x0c, 0x7d, 0x1a, 0x11, 0x5a, 0x41, 0x99, 0xfc,
-	0x76, 0x90, 0xda, 0x8c, 0x36, 0xb1, 0xf3, 0x8a, 0xd4, 0x4b, 0x3e, 0x87, 0xe8, 0x0b, 0x58, 0x74,
-	0x32, 0x74, 0x6c, 0x99, 0xe5, 0x1f, 0xcf, 0xe4, 0x43, 0x27, 0xaf, 0xdf, 0x7f, 0xe0, 0x99, 0x1e,
-	0x57, 0x4f, 0x43, 0xcf, 0x2e, 0x0e, 0xab, 0xa7, 0xa1, 0xa7, 0x2b, 0x58, 0x6a, 0x13, 0xd4, 0x80,
-	0xad, 0x61, 0x90, 0xe1, 0x29, 0x5f, 0x7f, 0x27, 0x50, 0x05, 0x27, 0x7a, 0x0c, 0x71, 0xf2, 0x58,
-	0xb4, 0x7b, 0x31, 0xf7, 0xee, 0x9e, 0x97, 0xe4, 0xbf, 0xbc, 0x3c, 0x5c, 0xac, 0xf8, 0xd7, 0xc5,
-	0xd6, 0x5f, 0xe1, 0xea, 0x6f, 0x9b, 0xcc, 0x6c, 0xfb, 0xd3, 0x45, 0x72, 0xcf, 0xc5, 0x63, 0xb5,
-	0x6e, 0x80, 0x05, 0x27, 0x92, 0xc5, 0x6f, 0xc9, 0xdc, 0x51, 0x43, 0x7d, 0xec, 0x1f, 0x76, 0xf3,
-	0xe6, 0xcb, 0xeb, 0x2d, 0xc6, 0x2e, 0xb5, 0x1b, 0xe5, 0x76, 0x8d, 0x72, 0x41, 0x75, 0x12, 0x6d,
-	0x33, 0x96, 0x19, 0x1b, 0x5d, 0x78, 0xbb, 0x75, 0xcd, 0xf4, 0x20, 0xda, 0xb3, 0x3c, 0xe8, 0xdd,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x75, 0xc7, 0xf8, 0x24, 0x03, 0x00, 0x00,
+	0x10, 0x6c, 0xa4, 0x22, 0x38, 0x71, 0x2a, 0x7f, 0x60, 0x71, 0xe2, 0x62, 0x39, 0xf6, 0x68, 0x33,
+	0x28, 0x6b, 0x87, 0xd8, 0x41, 0xda, 0x3f, 0xe0, 0xa7, 0x91, 0x50, 0xbc, 0x6d, 0x48, 0x29, 0x12,
+	0xe5, 0x12, 0xd9, 0x6f, 0xde, 0xcc, 0x7b, 0x7a, 0x13, 0xc3, 0x73, 0xdd, 0x75, 0x55, 0xd4, 0x75,
+	0x8b, 0x55, 0xf0, 0xca, 0x78, 0x17, 0x7b, 0x6d, 0xe2, 0xa6, 0xeb, 0x7d, 0xf4, 0xfc, 0x38, 0x15,
+	0x56, 0x22, 0xdd, 0xe2, 0xbe, 0xc3, 0x6a, 0x37, 0xb4, 0x91, 0x14, 0xd9, 0x03, 0x61, 0xf5, 0xe4,
+	0x77, 0x65, 0xfc, 0x1c, 0xd0, 0xf5, 0xcf, 0x0c, 0xf2, 0xd9, 0x30, 0xfe, 0x12, 0x32, 0xb2, 0x82,
+	0x15, 0xac, 0xcc, 0x2f, 0x9f, 0x6e, 0xa6, 0x96, 0xcd, 0x0d, 0x41, 0x91, 0x95, 0x19, 0x59, 0xfe,
+	0x11, 0xce, 0x4d, 0x8f, 0x3a, 0xa2, 0x55, 0x91, 0x76, 0x28, 0xb2, 0xd4, 0xf1, 0x6c, 0xd6, 0x31,
+	0xc2, 0xaa, 0xf3, 0xe4, 0xa2, 0x0a, 0x68, 0x64, 0x7e, 0x4d, 0xff, 0x4c, 0x3b, 0xe4, 0x8f, 0xe0,
+	0x48, 0xd7, 0x24, 0x8e, 0x0a, 0x56, 0x9e, 0xc9, 0xf1, 0xc8, 0x5f, 0xc1, 0x69, 0xad, 0x5b, 0xed,
+	0x0c, 0x8a, 0x45, 0x1a, 0xf5, 0xf0, 0x96, 0x38, 0x39, 0x79, 0x53, 0xe7, 0x1c, 0x16, 0xc6, 0x5b,
+	0x14, 0xc7, 0x05, 0x2b, 0xcf, 0x65, 0x3a, 0xf3, 0x0b, 0xc8, 0x75, 0xd7, 0xb5, 0x7b, 0x65, 0xfc,
+	0xe0, 0xa2, 0x38, 0x29, 0x58, 0xf9, 0x40, 0x42, 0x82, 0x3e, 0x8d, 0x08, 0x2f, 0x20, 0x1f, 0xba,
+	0x6d, 0xaf, 0x2d, 0x8e, 0x31, 0x89, 0xd3, 0x82, 0x95, 0x4b, 0x39, 0x87, 0xf8, 0x0b, 0x58, 0x34,
+	0x3a, 0x34, 0x62, 0x99, 0xe4, 0x1f, 0xcf, 0xe4, 0x43, 0xa3, 0x2f, 0xdf, 0x7f, 0x90, 0xa9, 0x3c,
+	0x5a, 0x1f, 0xfa, 0x56, 0x9c, 0x1d, 0xac, 0x0f, 0x7d, 0xcb, 0x57, 0xb0, 0xb4, 0x18, 0x4c, 0x4f,
+	0x35, 0x0a, 0x48, 0xf0, 0x74, 0x1f, 0x7d, 0xe1, 0x77, 0x74, 0xf1, 0xda, 0x57, 0x5e, 0xb0, 0x72,
+	0x21, 0x21, 0x41, 0xc9, 0xd7, 0xfa, 0x07, 0x83, 0x22, 0x78, 0xd5, 0x52, 0x88, 0xd3, 0x12, 0x54,
+	0xbd, 0x57, 0xf3, 0x70, 0xef, 0x84, 0xcd, 0xfe, 0x2b, 0xec, 0xc3, 0x4a, 0xb3, 0x7f, 0xad, 0x74,
+	0xfd, 0x15, 0x2e, 0xfe, 0xe6, 0x64, 0x96, 0xeb, 0x9f, 0x31, 0xb3, 0x3b, 0x31, 0xdf, 0x57, 0xeb,
+	0x0a, 0x44, 0xf0, 0x6a, 0x70, 0xf4, 0x6d, 0xc0, 0x5b, 0x6a, 0x64, 0xef, 0xfb, 0x0b, 0x5e, 0xbd,
+	0xf9, 0xf2, 0x7a, 0x4b, 0xb1, 0x19, 0xea, 0x8d, 0xf1, 0xbb, 0xca, 0xf8, 0x60, 0x1a, 0x4d, 0xae,
+	0x1a, 0x69, 0xe8, 0xa2, 0x0f, 0x6f, 0xb7, 0xbe, 0x9a, 0x5e, 0x4c, 0x7d, 0x92, 0x06, 0xbd, 0xfb,
+	0x35, 0x00, 0xdd, 0x90, 0x35, 0x54, 0x45, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,1477 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	ContractEventIdTable        uint32 = 3789411805
+	ContractEventNameOrderTable uint32 = 926106332
+	ContractEventIdUniTable     uint32 = 2414389813
+
+	ContractEventIdRow uint32 = 151061171
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoContractEventWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *prototype.ContractEventId
+	watcherFlag *ContractEventWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoContractEventWrap(dba iservices.IDatabaseRW, key *prototype.ContractEventId) *SoContractEventWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoContractEventWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoContractEventWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoContractEventWrap) MustExist(errMsgs ...interface{}) *SoContractEventWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) MustNotExist(errMsgs ...interface{}) *SoContractEventWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(ContractEventWatcherFlag)
+		*(s.watcherFlag) = ContractEventWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoContractEventWrap) create(f func(tInfo *SoContractEvent)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoContractEvent{}
+	f(val)
+	if val.Id == nil {
+		val.Id = s.mainKey
+	}
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoContractEventWrap) Create(f func(tInfo *SoContractEvent), errArgs ...interface{}) *SoContractEventWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoContractEventWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoContractEventWrap) modify(f func(tInfo *SoContractEvent)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoContractEvent table does not exist. Please create a table first")
+	}
+	oriTable := s.getContractEvent()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoContractEvent")
+	}
+
+	curTable := s.getContractEvent()
+	if curTable == nil {
+		return errors.New("fail to create current table SoContractEvent")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updateContractEvent(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoContractEventWrap) Modify(f func(tInfo *SoContractEvent), errArgs ...interface{}) *SoContractEventWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) SetData(p string, errArgs ...interface{}) *SoContractEventWrap {
+	err := s.modify(func(r *SoContractEvent) {
+		r.Data = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.SetData( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) SetName(p string, errArgs ...interface{}) *SoContractEventWrap {
+	err := s.modify(func(r *SoContractEvent) {
+		r.Name = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.SetName( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) SetNameOrder(p *prototype.ContractEventNameOrder, errArgs ...interface{}) *SoContractEventWrap {
+	err := s.modify(func(r *SoContractEvent) {
+		r.NameOrder = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.SetNameOrder( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) SetTrxId(p *prototype.Sha256, errArgs ...interface{}) *SoContractEventWrap {
+	err := s.modify(func(r *SoContractEvent) {
+		r.TrxId = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.SetTrxId( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoContractEventWrap) checkSortAndUniFieldValidity(curTable *SoContractEvent, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+		if fields["NameOrder"] && curTable.NameOrder == nil {
+			return errors.New("sort field NameOrder can't be modified to nil")
+		}
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoContractEventWrap) getModifiedFields(oriTable *SoContractEvent, curTable *SoContractEvent) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.Data, curTable.Data) {
+		fields["Data"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasDataWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Name, curTable.Name) {
+		fields["Name"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasNameWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.NameOrder, curTable.NameOrder) {
+		fields["NameOrder"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasNameOrderWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.TrxId, curTable.TrxId) {
+		fields["TrxId"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasTrxIdWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoContractEventWrap) handleFieldMd(t FieldMdHandleType, so *SoContractEvent, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["Data"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldData(so.Data, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Data")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldData(so.Data, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Data")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldData(so.Data, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Data")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Name"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldName(so.Name, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Name")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldName(so.Name, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Name")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldName(so.Name, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Name")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["NameOrder"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldNameOrder(so.NameOrder, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "NameOrder")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldNameOrder(so.NameOrder, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "NameOrder")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldNameOrder(so.NameOrder, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "NameOrder")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["TrxId"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldTrxId(so.TrxId, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "TrxId")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldTrxId(so.TrxId, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "TrxId")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldTrxId(so.TrxId, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "TrxId")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoContractEventWrap) delSortKeyId(sa *SoContractEvent) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListContractEventById{}
+	if sa == nil {
+		val.Id = s.GetId()
+	} else {
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoContractEventWrap) insertSortKeyId(sa *SoContractEvent) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListContractEventById{}
+	val.Id = sa.Id
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoContractEventWrap) delSortKeyNameOrder(sa *SoContractEvent) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListContractEventByNameOrder{}
+	if sa == nil {
+		val.NameOrder = s.GetNameOrder()
+		val.Id = s.mainKey
+
+	} else {
+		val.NameOrder = sa.NameOrder
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoContractEventWrap) insertSortKeyNameOrder(sa *SoContractEvent) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListContractEventByNameOrder{}
+	val.Id = sa.Id
+	val.NameOrder = sa.NameOrder
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoContractEventWrap) delAllSortKeys(br bool, val *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyNameOrder(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoContractEventWrap) insertAllSortKeys(val *SoContractEvent) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoContractEvent fail ")
+	}
+	if !s.insertSortKeyId(val) {
+		return errors.New("insert sort Field Id fail while insert table ")
+	}
+	if !s.insertSortKeyNameOrder(val) {
+		return errors.New("insert sort Field NameOrder fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoContractEventWrap) removeContractEvent() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoContractEvent
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getContractEvent()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoContractEventWrap) RemoveContractEvent(errMsgs ...interface{}) *SoContractEventWrap {
+	err := s.removeContractEvent()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoContractEventWrap.RemoveContractEvent failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoContractEventWrap) GetData() string {
+	res := true
+	msg := &SoContractEvent{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Data
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Data
+}
+
+func (s *SoContractEventWrap) mdFieldData(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkDataIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldData(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldData(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoContractEventWrap) delFieldData(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) insertFieldData(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) checkDataIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) GetId() *prototype.ContractEventId {
+	res := true
+	msg := &SoContractEvent{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Id
+}
+
+func (s *SoContractEventWrap) GetName() string {
+	res := true
+	msg := &SoContractEvent{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Name
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Name
+}
+
+func (s *SoContractEventWrap) mdFieldName(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkNameIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldName(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldName(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoContractEventWrap) delFieldName(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) insertFieldName(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) checkNameIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) GetNameOrder() *prototype.ContractEventNameOrder {
+	res := true
+	msg := &SoContractEvent{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.NameOrder
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.NameOrder
+}
+
+func (s *SoContractEventWrap) mdFieldNameOrder(p *prototype.ContractEventNameOrder, isCheck bool, isDel bool, isInsert bool,
+	so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkNameOrderIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldNameOrder(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldNameOrder(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoContractEventWrap) delFieldNameOrder(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyNameOrder(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) insertFieldNameOrder(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyNameOrder(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) checkNameOrderIsMetMdCondition(p *prototype.ContractEventNameOrder) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) GetTrxId() *prototype.Sha256 {
+	res := true
+	msg := &SoContractEvent{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.TrxId
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.TrxId
+}
+
+func (s *SoContractEventWrap) mdFieldTrxId(p *prototype.Sha256, isCheck bool, isDel bool, isInsert bool,
+	so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkTrxIdIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldTrxId(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldTrxId(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoContractEventWrap) delFieldTrxId(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) insertFieldTrxId(so *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoContractEventWrap) checkTrxIdIsMetMdCondition(p *prototype.Sha256) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type SContractEventIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewContractEventIdWrap(db iservices.IDatabaseRW) *SContractEventIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SContractEventIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SContractEventIdWrap) GetMainVal(val []byte) *prototype.ContractEventId {
+	res := &SoListContractEventById{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (s *SContractEventIdWrap) GetSubVal(val []byte) *prototype.ContractEventId {
+	res := &SoListContractEventById{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (m *SoListContractEventById) OpeEncode() ([]byte, error) {
+	pre := ContractEventIdTable
+	sub := m.Id
+	if sub == nil {
+		return nil, errors.New("the pro Id is nil")
+	}
+	sub1 := m.Id
+	if sub1 == nil {
+		return nil, errors.New("the mainkey Id is nil")
+	}
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SContractEventIdWrap) ForEachByOrder(start *prototype.ContractEventId, end *prototype.ContractEventId, lastMainKey *prototype.ContractEventId,
+	lastSubVal *prototype.ContractEventId, f func(mVal *prototype.ContractEventId, sVal *prototype.ContractEventId, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ContractEventIdTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SContractEventNameOrderWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewContractEventNameOrderWrap(db iservices.IDatabaseRW) *SContractEventNameOrderWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SContractEventNameOrderWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SContractEventNameOrderWrap) GetMainVal(val []byte) *prototype.ContractEventId {
+	res := &SoListContractEventByNameOrder{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (s *SContractEventNameOrderWrap) GetSubVal(val []byte) *prototype.ContractEventNameOrder {
+	res := &SoListContractEventByNameOrder{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.NameOrder
+
+}
+
+func (m *SoListContractEventByNameOrder) OpeEncode() ([]byte, error) {
+	pre := ContractEventNameOrderTable
+	sub := m.NameOrder
+	if sub == nil {
+		return nil, errors.New("the pro NameOrder is nil")
+	}
+	sub1 := m.Id
+	if sub1 == nil {
+		return nil, errors.New("the mainkey Id is nil")
+	}
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SContractEventNameOrderWrap) ForEachByOrder(start *prototype.ContractEventNameOrder, end *prototype.ContractEventNameOrder, lastMainKey *prototype.ContractEventId,
+	lastSubVal *prototype.ContractEventNameOrder, f func(mVal *prototype.ContractEventId, sVal *prototype.ContractEventNameOrder, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ContractEventNameOrderTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoContractEventWrap) update(sa *SoContractEvent) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoContractEventWrap) getContractEvent() *SoContractEvent {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoContractEvent{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoContractEventWrap) updateContractEvent(so *SoContractEvent) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoContractEvent is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoContractEventWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := ContractEventIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoContractEventWrap) delAllUniKeys(br bool, val *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoContractEventWrap) delUniKeysWithNames(names map[string]string, val *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoContractEventWrap) insertAllUniKeys(val *SoContractEvent) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoContractEvent fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoContractEventWrap) delUniKeyId(sa *SoContractEvent) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := ContractEventIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+		if sa.Id == nil {
+			return false
+		}
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+		if sub == nil {
+			return true
+		}
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoContractEventWrap) insertUniKeyId(sa *SoContractEvent) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := ContractEventIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniqueContractEventById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniContractEventIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniContractEventIdWrap(db iservices.IDatabaseRW) *UniContractEventIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniContractEventIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniContractEventIdWrap) UniQueryId(start *prototype.ContractEventId) *SoContractEventWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := ContractEventIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniqueContractEventById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoContractEventWrap(s.Dba, res.Id)
+
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type ContractEventWatcherFlag struct {
+	HasDataWatcher bool
+
+	HasNameWatcher bool
+
+	HasNameOrderWatcher bool
+
+	HasTrxIdWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	ContractEventTable = &TableInfo{
+		Name:    "ContractEvent",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoContractEvent)(nil)).Elem(),
+	}
+	ContractEventWatcherFlags     = make(map[uint32]ContractEventWatcherFlag)
+	ContractEventWatcherFlagsLock sync.RWMutex
+)
+
+func ContractEventWatcherFlagOfDb(dbSvcId uint32) ContractEventWatcherFlag {
+	ContractEventWatcherFlagsLock.RLock()
+	defer ContractEventWatcherFlagsLock.RUnlock()
+	return ContractEventWatcherFlags[dbSvcId]
+}
+
+func ContractEventRecordWatcherChanged(dbSvcId uint32) {
+	var flag ContractEventWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, ContractEventTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasDataWatcher = HasTableRecordWatcher(dbSvcId, ContractEventTable.Record, "Data")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasDataWatcher
+
+	flag.HasNameWatcher = HasTableRecordWatcher(dbSvcId, ContractEventTable.Record, "Name")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasNameWatcher
+
+	flag.HasNameOrderWatcher = HasTableRecordWatcher(dbSvcId, ContractEventTable.Record, "NameOrder")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasNameOrderWatcher
+
+	flag.HasTrxIdWatcher = HasTableRecordWatcher(dbSvcId, ContractEventTable.Record, "TrxId")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasTrxIdWatcher
+
+	ContractEventWatcherFlagsLock.Lock()
+	ContractEventWatcherFlags[dbSvcId] = flag
+	ContractEventWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func ContractEventQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(prototype.ContractEventId)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoContractEventWrap(db, k).getContractEvent(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(ContractEventTable.Record, ContractEventRecordWatcherChanged)
+	RegisterTableJsonQuery("ContractEvent", ContractEventQuery)
+}
@@ -0,0 +1,2763 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	EscrowFromAccountTable uint32 = 4009686186
+	EscrowToAccountTable   uint32 = 3580815636
+	EscrowAgentTable       uint32 = 1959296313
+	EscrowIdUniTable       uint32 = 1679246128
+
+	EscrowIdRow uint32 = 363048113
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoEscrowWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *uint64
+	watcherFlag *EscrowWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoEscrowWrap(dba iservices.IDatabaseRW, key *uint64) *SoEscrowWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoEscrowWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoEscrowWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoEscrowWrap) MustExist(errMsgs ...interface{}) *SoEscrowWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) MustNotExist(errMsgs ...interface{}) *SoEscrowWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(EscrowWatcherFlag)
+		*(s.watcherFlag) = EscrowWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoEscrowWrap) create(f func(tInfo *SoEscrow)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoEscrow{}
+	f(val)
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoEscrowWrap) Create(f func(tInfo *SoEscrow), errArgs ...interface{}) *SoEscrowWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoEscrowWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoEscrowWrap) modify(f func(tInfo *SoEscrow)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoEscrow table does not exist. Please create a table first")
+	}
+	oriTable := s.getEscrow()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoEscrow")
+	}
+
+	curTable := s.getEscrow()
+	if curTable == nil {
+		return errors.New("fail to create current table SoEscrow")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updateEscrow(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoEscrowWrap) Modify(f func(tInfo *SoEscrow), errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetAgent(p *prototype.AccountName, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.Agent = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetAgent( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetAgentApproved(p bool, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.AgentApproved = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetAgentApproved( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetAgentFee(p *prototype.Coin, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.AgentFee = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetAgentFee( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetAmount(p *prototype.Coin, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.Amount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetAmount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetCreatedTime(p *prototype.TimePointSec, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.CreatedTime = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetCreatedTime( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetDisputed(p bool, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.Disputed = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetDisputed( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetEscrowExpiration(p *prototype.TimePointSec, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.EscrowExpiration = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetEscrowExpiration( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetFromAccount(p *prototype.AccountName, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.FromAccount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetFromAccount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetMemo(p string, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.Memo = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetMemo( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetRatificationDeadline(p *prototype.TimePointSec, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.RatificationDeadline = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetRatificationDeadline( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetToAccount(p *prototype.AccountName, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.ToAccount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetToAccount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) SetToApproved(p bool, errArgs ...interface{}) *SoEscrowWrap {
+	err := s.modify(func(r *SoEscrow) {
+		r.ToApproved = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.SetToApproved( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoEscrowWrap) checkSortAndUniFieldValidity(curTable *SoEscrow, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+		if fields["FromAccount"] && curTable.FromAccount == nil {
+			return errors.New("sort field FromAccount can't be modified to nil")
+		}
+
+		if fields["ToAccount"] && curTable.ToAccount == nil {
+			return errors.New("sort field ToAccount can't be modified to nil")
+		}
+
+		if fields["Agent"] && curTable.Agent == nil {
+			return errors.New("sort field Agent can't be modified to nil")
+		}
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoEscrowWrap) getModifiedFields(oriTable *SoEscrow, curTable *SoEscrow) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.Agent, curTable.Agent) {
+		fields["Agent"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAgentWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.AgentApproved, curTable.AgentApproved) {
+		fields["AgentApproved"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAgentApprovedWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.AgentFee, curTable.AgentFee) {
+		fields["AgentFee"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAgentFeeWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Amount, curTable.Amount) {
+		fields["Amount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAmountWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.CreatedTime, curTable.CreatedTime) {
+		fields["CreatedTime"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasCreatedTimeWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Disputed, curTable.Disputed) {
+		fields["Disputed"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasDisputedWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.EscrowExpiration, curTable.EscrowExpiration) {
+		fields["EscrowExpiration"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasEscrowExpirationWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.FromAccount, curTable.FromAccount) {
+		fields["FromAccount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasFromAccountWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Memo, curTable.Memo) {
+		fields["Memo"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasMemoWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.RatificationDeadline, curTable.RatificationDeadline) {
+		fields["RatificationDeadline"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasRatificationDeadlineWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.ToAccount, curTable.ToAccount) {
+		fields["ToAccount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasToAccountWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.ToApproved, curTable.ToApproved) {
+		fields["ToApproved"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasToApprovedWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoEscrowWrap) handleFieldMd(t FieldMdHandleType, so *SoEscrow, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["Agent"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAgent(so.Agent, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Agent")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAgent(so.Agent, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Agent")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAgent(so.Agent, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Agent")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["AgentApproved"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAgentApproved(so.AgentApproved, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "AgentApproved")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAgentApproved(so.AgentApproved, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "AgentApproved")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAgentApproved(so.AgentApproved, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "AgentApproved")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["AgentFee"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAgentFee(so.AgentFee, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "AgentFee")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAgentFee(so.AgentFee, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "AgentFee")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAgentFee(so.AgentFee, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "AgentFee")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Amount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAmount(so.Amount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Amount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAmount(so.Amount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Amount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAmount(so.Amount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Amount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["CreatedTime"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldCreatedTime(so.CreatedTime, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "CreatedTime")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Disputed"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldDisputed(so.Disputed, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Disputed")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldDisputed(so.Disputed, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Disputed")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldDisputed(so.Disputed, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Disputed")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["EscrowExpiration"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldEscrowExpiration(so.EscrowExpiration, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "EscrowExpiration")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldEscrowExpiration(so.EscrowExpiration, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "EscrowExpiration")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldEscrowExpiration(so.EscrowExpiration, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "EscrowExpiration")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["FromAccount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldFromAccount(so.FromAccount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "FromAccount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldFromAccount(so.FromAccount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "FromAccount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldFromAccount(so.FromAccount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "FromAccount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Memo"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldMemo(so.Memo, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Memo")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldMemo(so.Memo, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Memo")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldMemo(so.Memo, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Memo")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["RatificationDeadline"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldRatificationDeadline(so.RatificationDeadline, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "RatificationDeadline")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldRatificationDeadline(so.RatificationDeadline, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "RatificationDeadline")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldRatificationDeadline(so.RatificationDeadline, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "RatificationDeadline")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["ToAccount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldToAccount(so.ToAccount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "ToAccount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldToAccount(so.ToAccount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "ToAccount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldToAccount(so.ToAccount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "ToAccount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["ToApproved"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldToApproved(so.ToApproved, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "ToApproved")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldToApproved(so.ToApproved, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "ToApproved")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldToApproved(so.ToApproved, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "ToApproved")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoEscrowWrap) delSortKeyFromAccount(sa *SoEscrow) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListEscrowByFromAccount{}
+	if sa == nil {
+		val.FromAccount = s.GetFromAccount()
+		val.Id = *s.mainKey
+	} else {
+		val.FromAccount = sa.FromAccount
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) insertSortKeyFromAccount(sa *SoEscrow) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListEscrowByFromAccount{}
+	val.Id = sa.Id
+	val.FromAccount = sa.FromAccount
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) delSortKeyToAccount(sa *SoEscrow) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListEscrowByToAccount{}
+	if sa == nil {
+		val.ToAccount = s.GetToAccount()
+		val.Id = *s.mainKey
+	} else {
+		val.ToAccount = sa.ToAccount
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) insertSortKeyToAccount(sa *SoEscrow) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListEscrowByToAccount{}
+	val.Id = sa.Id
+	val.ToAccount = sa.ToAccount
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) delSortKeyAgent(sa *SoEscrow) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListEscrowByAgent{}
+	if sa == nil {
+		val.Agent = s.GetAgent()
+		val.Id = *s.mainKey
+	} else {
+		val.Agent = sa.Agent
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) insertSortKeyAgent(sa *SoEscrow) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListEscrowByAgent{}
+	val.Id = sa.Id
+	val.Agent = sa.Agent
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoEscrowWrap) delAllSortKeys(br bool, val *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyFromAccount(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyToAccount(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyAgent(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoEscrowWrap) insertAllSortKeys(val *SoEscrow) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoEscrow fail ")
+	}
+	if !s.insertSortKeyFromAccount(val) {
+		return errors.New("insert sort Field FromAccount fail while insert table ")
+	}
+	if !s.insertSortKeyToAccount(val) {
+		return errors.New("insert sort Field ToAccount fail while insert table ")
+	}
+	if !s.insertSortKeyAgent(val) {
+		return errors.New("insert sort Field Agent fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoEscrowWrap) removeEscrow() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoEscrow
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getEscrow()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoEscrowWrap) RemoveEscrow(errMsgs ...interface{}) *SoEscrowWrap {
+	err := s.removeEscrow()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoEscrowWrap.RemoveEscrow failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoEscrowWrap) GetAgent() *prototype.AccountName {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Agent
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Agent
+}
+
+func (s *SoEscrowWrap) mdFieldAgent(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAgentIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAgent(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAgent(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldAgent(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyAgent(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldAgent(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyAgent(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkAgentIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetAgentApproved() bool {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.AgentApproved
+			}
+		}
+	}
+	if !res {
+		var tmpValue bool
+		return tmpValue
+	}
+	return msg.AgentApproved
+}
+
+func (s *SoEscrowWrap) mdFieldAgentApproved(p bool, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAgentApprovedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAgentApproved(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAgentApproved(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldAgentApproved(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldAgentApproved(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkAgentApprovedIsMetMdCondition(p bool) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetAgentFee() *prototype.Coin {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.AgentFee
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.AgentFee
+}
+
+func (s *SoEscrowWrap) mdFieldAgentFee(p *prototype.Coin, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAgentFeeIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAgentFee(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAgentFee(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldAgentFee(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldAgentFee(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkAgentFeeIsMetMdCondition(p *prototype.Coin) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetAmount() *prototype.Coin {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Amount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Amount
+}
+
+func (s *SoEscrowWrap) mdFieldAmount(p *prototype.Coin, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAmountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAmount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAmount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldAmount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldAmount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkAmountIsMetMdCondition(p *prototype.Coin) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetCreatedTime() *prototype.TimePointSec {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.CreatedTime
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.CreatedTime
+}
+
+func (s *SoEscrowWrap) mdFieldCreatedTime(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkCreatedTimeIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldCreatedTime(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldCreatedTime(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkCreatedTimeIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetDisputed() bool {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Disputed
+			}
+		}
+	}
+	if !res {
+		var tmpValue bool
+		return tmpValue
+	}
+	return msg.Disputed
+}
+
+func (s *SoEscrowWrap) mdFieldDisputed(p bool, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkDisputedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldDisputed(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldDisputed(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldDisputed(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldDisputed(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkDisputedIsMetMdCondition(p bool) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetEscrowExpiration() *prototype.TimePointSec {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.EscrowExpiration
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.EscrowExpiration
+}
+
+func (s *SoEscrowWrap) mdFieldEscrowExpiration(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkEscrowExpirationIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldEscrowExpiration(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldEscrowExpiration(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldEscrowExpiration(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldEscrowExpiration(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkEscrowExpirationIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetFromAccount() *prototype.AccountName {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.FromAccount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.FromAccount
+}
+
+func (s *SoEscrowWrap) mdFieldFromAccount(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkFromAccountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldFromAccount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldFromAccount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldFromAccount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyFromAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldFromAccount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyFromAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkFromAccountIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetId() uint64 {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint64
+		return tmpValue
+	}
+	return msg.Id
+}
+
+func (s *SoEscrowWrap) GetMemo() string {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Memo
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Memo
+}
+
+func (s *SoEscrowWrap) mdFieldMemo(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkMemoIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldMemo(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldMemo(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldMemo(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldMemo(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkMemoIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetRatificationDeadline() *prototype.TimePointSec {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.RatificationDeadline
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.RatificationDeadline
+}
+
+func (s *SoEscrowWrap) mdFieldRatificationDeadline(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkRatificationDeadlineIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldRatificationDeadline(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldRatificationDeadline(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldRatificationDeadline(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldRatificationDeadline(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkRatificationDeadlineIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetToAccount() *prototype.AccountName {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.ToAccount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.ToAccount
+}
+
+func (s *SoEscrowWrap) mdFieldToAccount(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkToAccountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldToAccount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldToAccount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldToAccount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyToAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldToAccount(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyToAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkToAccountIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) GetToApproved() bool {
+	res := true
+	msg := &SoEscrow{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.ToApproved
+			}
+		}
+	}
+	if !res {
+		var tmpValue bool
+		return tmpValue
+	}
+	return msg.ToApproved
+}
+
+func (s *SoEscrowWrap) mdFieldToApproved(p bool, isCheck bool, isDel bool, isInsert bool,
+	so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkToApprovedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldToApproved(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldToApproved(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoEscrowWrap) delFieldToApproved(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) insertFieldToApproved(so *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoEscrowWrap) checkToApprovedIsMetMdCondition(p bool) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type SEscrowFromAccountWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewEscrowFromAccountWrap(db iservices.IDatabaseRW) *SEscrowFromAccountWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SEscrowFromAccountWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SEscrowFromAccountWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListEscrowByFromAccount{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SEscrowFromAccountWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListEscrowByFromAccount{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.FromAccount
+
+}
+
+func (m *SoListEscrowByFromAccount) OpeEncode() ([]byte, error) {
+	pre := EscrowFromAccountTable
+	sub := m.FromAccount
+	if sub == nil {
+		return nil, errors.New("the pro FromAccount is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowFromAccountWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowFromAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowFromAccountWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowFromAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SEscrowToAccountWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewEscrowToAccountWrap(db iservices.IDatabaseRW) *SEscrowToAccountWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SEscrowToAccountWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SEscrowToAccountWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListEscrowByToAccount{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SEscrowToAccountWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListEscrowByToAccount{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.ToAccount
+
+}
+
+func (m *SoListEscrowByToAccount) OpeEncode() ([]byte, error) {
+	pre := EscrowToAccountTable
+	sub := m.ToAccount
+	if sub == nil {
+		return nil, errors.New("the pro ToAccount is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowToAccountWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowToAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowToAccountWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowToAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SEscrowAgentWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewEscrowAgentWrap(db iservices.IDatabaseRW) *SEscrowAgentWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SEscrowAgentWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SEscrowAgentWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListEscrowByAgent{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SEscrowAgentWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListEscrowByAgent{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.Agent
+
+}
+
+func (m *SoListEscrowByAgent) OpeEncode() ([]byte, error) {
+	pre := EscrowAgentTable
+	sub := m.Agent
+	if sub == nil {
+		return nil, errors.New("the pro Agent is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowAgentWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowAgentTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SEscrowAgentWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := EscrowAgentTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoEscrowWrap) update(sa *SoEscrow) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoEscrowWrap) getEscrow() *SoEscrow {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoEscrow{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoEscrowWrap) updateEscrow(so *SoEscrow) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoEscrow is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoEscrowWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := EscrowIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoEscrowWrap) delAllUniKeys(br bool, val *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoEscrowWrap) delUniKeysWithNames(names map[string]string, val *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoEscrowWrap) insertAllUniKeys(val *SoEscrow) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoEscrow fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoEscrowWrap) delUniKeyId(sa *SoEscrow) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := EscrowIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoEscrowWrap) insertUniKeyId(sa *SoEscrow) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := EscrowIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniqueEscrowById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniEscrowIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniEscrowIdWrap(db iservices.IDatabaseRW) *UniEscrowIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniEscrowIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniEscrowIdWrap) UniQueryId(start *uint64) *SoEscrowWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := EscrowIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniqueEscrowById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoEscrowWrap(s.Dba, &res.Id)
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type EscrowWatcherFlag struct {
+	HasAgentWatcher bool
+
+	HasAgentApprovedWatcher bool
+
+	HasAgentFeeWatcher bool
+
+	HasAmountWatcher bool
+
+	HasCreatedTimeWatcher bool
+
+	HasDisputedWatcher bool
+
+	HasEscrowExpirationWatcher bool
+
+	HasFromAccountWatcher bool
+
+	HasMemoWatcher bool
+
+	HasRatificationDeadlineWatcher bool
+
+	HasToAccountWatcher bool
+
+	HasToApprovedWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	EscrowTable = &TableInfo{
+		Name:    "Escrow",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoEscrow)(nil)).Elem(),
+	}
+	EscrowWatcherFlags     = make(map[uint32]EscrowWatcherFlag)
+	EscrowWatcherFlagsLock sync.RWMutex
+)
+
+func EscrowWatcherFlagOfDb(dbSvcId uint32) EscrowWatcherFlag {
+	EscrowWatcherFlagsLock.RLock()
+	defer EscrowWatcherFlagsLock.RUnlock()
+	return EscrowWatcherFlags[dbSvcId]
+}
+
+func EscrowRecordWatcherChanged(dbSvcId uint32) {
+	var flag EscrowWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasAgentWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "Agent")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAgentWatcher
+
+	flag.HasAgentApprovedWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "AgentApproved")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAgentApprovedWatcher
+
+	flag.HasAgentFeeWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "AgentFee")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAgentFeeWatcher
+
+	flag.HasAmountWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "Amount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAmountWatcher
+
+	flag.HasCreatedTimeWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "CreatedTime")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasCreatedTimeWatcher
+
+	flag.HasDisputedWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "Disputed")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasDisputedWatcher
+
+	flag.HasEscrowExpirationWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "EscrowExpiration")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasEscrowExpirationWatcher
+
+	flag.HasFromAccountWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "FromAccount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasFromAccountWatcher
+
+	flag.HasMemoWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "Memo")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasMemoWatcher
+
+	flag.HasRatificationDeadlineWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "RatificationDeadline")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasRatificationDeadlineWatcher
+
+	flag.HasToAccountWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "ToAccount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasToAccountWatcher
+
+	flag.HasToApprovedWatcher = HasTableRecordWatcher(dbSvcId, EscrowTable.Record, "ToApproved")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasToApprovedWatcher
+
+	EscrowWatcherFlagsLock.Lock()
+	EscrowWatcherFlags[dbSvcId] = flag
+	EscrowWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func EscrowQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(uint64)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoEscrowWrap(db, k).getEscrow(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(EscrowTable.Record, EscrowRecordWatcherChanged)
+	RegisterTableJsonQuery("Escrow", EscrowQuery)
+}
@@ -345,6 +345,16 @@ func (s *SoPostWrap) SetDappRewards(p *prototype.Vest, errArgs ...interface{}) *
 	return s
 }
 
+func (s *SoPostWrap) SetDeleted(p bool, errArgs ...interface{}) *SoPostWrap {
+	err := s.modify(func(r *SoPost) {
+		r.Deleted = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostWrap.SetDeleted( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoPostWrap) SetDepth(p uint32, errArgs ...interface{}) *SoPostWrap {
 	err := s.modify(func(r *SoPost) {
 		r.Depth = p
@@ -355,6 +365,16 @@ func (s *SoPostWrap) SetDepth(p uint32, errArgs ...interface{}) *SoPostWrap {
 	return s
 }
 
+func (s *SoPostWrap) SetLastEdited(p *prototype.TimePointSec, errArgs ...interface{}) *SoPostWrap {
+	err := s.modify(func(r *SoPost) {
+		r.LastEdited = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostWrap.SetLastEdited( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoPostWrap) SetLastPayout(p *prototype.TimePointSec, errArgs ...interface{}) *SoPostWrap {
 	err := s.modify(func(r *SoPost) {
 		r.LastPayout = p
@@ -425,6 +445,16 @@ func (s *SoPostWrap) SetTitle(p string, errArgs ...interface{}) *SoPostWrap {
 	return s
 }
 
+func (s *SoPostWrap) SetVersion(p uint32, errArgs ...interface{}) *SoPostWrap {
+	err := s.modify(func(r *SoPost) {
+		r.Version = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostWrap.SetVersion( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
 func (s *SoPostWrap) SetVoteCnt(p uint64, errArgs ...interface{}) *SoPostWrap {
 	err := s.modify(func(r *SoPost) {
 		r.VoteCnt = p
@@ -518,11 +548,21 @@ func (s *SoPostWrap) getModifiedFields(oriTable *SoPost, curTable *SoPost) (map[
 		hasWatcher = hasWatcher || s.watcherFlag.HasDappRewardsWatcher
 	}
 
+	if !reflect.DeepEqual(oriTable.Deleted, curTable.Deleted) {
+		fields["Deleted"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasDeletedWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.Depth, curTable.Depth) {
 		fields["Depth"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasDepthWatcher
 	}
 
+	if !reflect.DeepEqual(oriTable.LastEdited, curTable.LastEdited) {
+		fields["LastEdited"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasLastEditedWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.LastPayout, curTable.LastPayout) {
 		fields["LastPayout"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasLastPayoutWatcher
@@ -558,6 +598,11 @@ func (s *SoPostWrap) getModifiedFields(oriTable *SoPost, curTable *SoPost) (map[
 		hasWatcher = hasWatcher || s.watcherFlag.HasTitleWatcher
 	}
 
+	if !reflect.DeepEqual(oriTable.Version, curTable.Version) {
+		fields["Version"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasVersionWatcher
+	}
+
 	if !reflect.DeepEqual(oriTable.VoteCnt, curTable.VoteCnt) {
 		fields["VoteCnt"] = true
 		hasWatcher = hasWatcher || s.watcherFlag.HasVoteCntWatcher
@@ -754,6 +799,23 @@ func (s *SoPostWrap) handleFieldMd(t FieldMdHandleType, so *SoPost, fields map[s
 		}
 	}
 
+	if fields["Deleted"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldDeleted(so.Deleted, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Deleted")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldDeleted(so.Deleted, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Deleted")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldDeleted(so.Deleted, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Deleted")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["Depth"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -771,6 +833,23 @@ func (s *SoPostWrap) handleFieldMd(t FieldMdHandleType, so *SoPost, fields map[s
 		}
 	}
 
+	if fields["LastEdited"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldLastEdited(so.LastEdited, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "LastEdited")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldLastEdited(so.LastEdited, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "LastEdited")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldLastEdited(so.LastEdited, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "LastEdited")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["LastPayout"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -890,6 +969,23 @@ func (s *SoPostWrap) handleFieldMd(t FieldMdHandleType, so *SoPost, fields map[s
 		}
 	}
 
+	if fields["Version"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldVersion(so.Version, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Version")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldVersion(so.Version, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Version")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldVersion(so.Version, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Version")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
 	if fields["VoteCnt"] {
 		res := true
 		if t == FieldMdHandleTypeCheck {
@@ -1986,6 +2082,88 @@ func (s *SoPostWrap) checkDappRewardsIsMetMdCondition(p *prototype.Vest) bool {
 	return true
 }
 
+func (s *SoPostWrap) GetDeleted() bool {
+	res := true
+	msg := &SoPost{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Deleted
+			}
+		}
+	}
+	if !res {
+		var tmpValue bool
+		return tmpValue
+	}
+	return msg.Deleted
+}
+
+func (s *SoPostWrap) mdFieldDeleted(p bool, isCheck bool, isDel bool, isInsert bool,
+	so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkDeletedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldDeleted(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldDeleted(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostWrap) delFieldDeleted(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) insertFieldDeleted(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) checkDeletedIsMetMdCondition(p bool) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoPostWrap) GetDepth() uint32 {
 	res := true
 	msg := &SoPost{}
@@ -2068,6 +2246,88 @@ func (s *SoPostWrap) checkDepthIsMetMdCondition(p uint32) bool {
 	return true
 }
 
+func (s *SoPostWrap) GetLastEdited() *prototype.TimePointSec {
+	res := true
+	msg := &SoPost{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.LastEdited
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.LastEdited
+}
+
+func (s *SoPostWrap) mdFieldLastEdited(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkLastEditedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldLastEdited(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldLastEdited(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostWrap) delFieldLastEdited(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) insertFieldLastEdited(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) checkLastEditedIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoPostWrap) GetLastPayout() *prototype.TimePointSec {
 	res := true
 	msg := &SoPost{}
@@ -2679,6 +2939,88 @@ func (s *SoPostWrap) checkTitleIsMetMdCondition(p string) bool {
 	return true
 }
 
+func (s *SoPostWrap) GetVersion() uint32 {
+	res := true
+	msg := &SoPost{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Version
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint32
+		return tmpValue
+	}
+	return msg.Version
+}
+
+func (s *SoPostWrap) mdFieldVersion(p uint32, isCheck bool, isDel bool, isInsert bool,
+	so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkVersionIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldVersion(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldVersion(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostWrap) delFieldVersion(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) insertFieldVersion(so *SoPost) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostWrap) checkVersionIsMetMdCondition(p uint32) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
 func (s *SoPostWrap) GetVoteCnt() uint64 {
 	res := true
 	msg := &SoPost{}
@@ -3459,8 +3801,12 @@ type PostWatcherFlag struct {
 
 	HasDappRewardsWatcher bool
 
+	HasDeletedWatcher bool
+
 	HasDepthWatcher bool
 
+	HasLastEditedWatcher bool
+
 	HasLastPayoutWatcher bool
 
 	HasParentIdWatcher bool
@@ -3475,6 +3821,8 @@ type PostWatcherFlag struct {
 
 	HasTitleWatcher bool
 
+	HasVersionWatcher bool
+
 	HasVoteCntWatcher bool
 
 	HasWeightedVpWatcher bool
@@ -3534,9 +3882,15 @@ func PostRecordWatcherChanged(dbSvcId uint32) {
 	flag.HasDappRewardsWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "DappRewards")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasDappRewardsWatcher
 
+	flag.HasDeletedWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "Deleted")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasDeletedWatcher
+
 	flag.HasDepthWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "Depth")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasDepthWatcher
 
+	flag.HasLastEditedWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "LastEdited")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasLastEditedWatcher
+
 	flag.HasLastPayoutWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "LastPayout")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasLastPayoutWatcher
 
@@ -3558,6 +3912,9 @@ func PostRecordWatcherChanged(dbSvcId uint32) {
 	flag.HasTitleWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "Title")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasTitleWatcher
 
+	flag.HasVersionWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "Version")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasVersionWatcher
+
 	flag.HasVoteCntWatcher = HasTableRecordWatcher(dbSvcId, PostTable.Record, "VoteCnt")
 	flag.AnyWatcher = flag.AnyWatcher || flag.HasVoteCntWatcher
 
@@ -43,6 +43,9 @@ type SoPost struct {
 	Ticket               uint32                            `protobuf:"varint,19,opt,name=ticket,proto3" json:"ticket,omitempty"`
 	Copyright            uint32                            `protobuf:"varint,20,opt,name=copyright,proto3" json:"copyright,omitempty"`
 	CopyrightMemo        string                            `protobuf:"bytes,21,opt,name=copyright_memo,json=copyrightMemo,proto3" json:"copyright_memo,omitempty"`
+	Version              uint32                            `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`
+	LastEdited           *prototype.TimePointSec           `protobuf:"bytes,23,opt,name=last_edited,json=lastEdited,proto3" json:"last_edited,omitempty"`
+	Deleted              bool                              `protobuf:"varint,24,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -220,6 +223,27 @@ func (m *SoPost) GetCopyrightMemo() string {
 	return ""
 }
 
+func (m *SoPost) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SoPost) GetLastEdited() *prototype.TimePointSec {
+	if m != nil {
+		return m.LastEdited
+	}
+	return nil
+}
+
+func (m *SoPost) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type SoListPostByCreated struct {
 	Created              *prototype.TimePointSec `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
 	PostId               uint64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -411,42 +435,44 @@ func init() {
 func init() { proto.RegisterFile("app/table/so_post.proto", fileDescriptor_aaea493edfbbad11) }
 
 var fileDescriptor_aaea493edfbbad11 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5f, 0x6f, 0xd3, 0x30,
-	0x10, 0x57, 0xb6, 0xf5, 0x9f, 0xbb, 0x6e, 0xcc, 0x0c, 0xea, 0x0d, 0x24, 0xba, 0x49, 0x48, 0x65,
-	0x82, 0x46, 0xda, 0x78, 0xe2, 0x71, 0x3c, 0xa0, 0x3d, 0x80, 0x50, 0x1e, 0x78, 0x40, 0x42, 0x96,
-	0xe3, 0x1c, 0x8d, 0xb5, 0x26, 0x67, 0x92, 0xcb, 0xa6, 0x7c, 0x6e, 0xbe, 0x00, 0xb2, 0xdb, 0x66,
-	0x81, 0xad, 0x1a, 0x2f, 0x6d, 0x7e, 0x7f, 0xec, 0x3b, 0xdf, 0x9d, 0xcd, 0xc6, 0xca, 0xda, 0x90,
-	0x54, 0xbc, 0x80, 0xb0, 0x44, 0x69, 0xb1, 0xa4, 0x99, 0x2d, 0x90, 0x90, 0x77, 0x3c, 0x79, 0x7c,
-	0xe8, 0x11, 0xd5, 0x16, 0x42, 0xf7, 0xb3, 0x14, 0x4f, 0x7f, 0x77, 0x58, 0x6f, 0x65, 0xe7, 0x63,
-	0xd6, 0x73, 0xff, 0xd2, 0x24, 0x22, 0x98, 0x04, 0xd3, 0x9d, 0xa8, 0xeb, 0xe0, 0x55, 0xc2, 0x8f,
-	0x59, 0x5f, 0x2b, 0x82, 0x39, 0x16, 0xb5, 0xd8, 0x9a, 0x04, 0xd3, 0x41, 0xd4, 0x60, 0x1e, 0xb2,
-	0xae, 0xaa, 0x28, 0xc5, 0x42, 0x6c, 0x4f, 0x82, 0xe9, 0xf0, 0x7c, 0x3c, 0x6b, 0xe2, 0xcc, 0x94,
-	0xd6, 0x58, 0xe5, 0x24, 0x73, 0x95, 0x41, 0xb4, 0xb2, 0xf1, 0x43, 0xd6, 0x21, 0x43, 0x0b, 0x10,
-	0x3b, 0x7e, 0xa7, 0x25, 0xe0, 0x9c, 0xed, 0xc4, 0x98, 0xd4, 0xa2, 0xe3, 0x49, 0xff, 0xed, 0x38,
-	0x52, 0xf3, 0x52, 0x74, 0x27, 0xdb, 0x8e, 0x73, 0xdf, 0xfc, 0x82, 0xf5, 0x74, 0x01, 0x8a, 0x20,
-	0x11, 0x3d, 0x1f, 0xef, 0xa8, 0x15, 0x8f, 0x4c, 0x06, 0xd2, 0xa2, 0xc9, 0x49, 0x96, 0xa0, 0xa3,
-	0xb5, 0x93, 0x7f, 0x60, 0xc3, 0x85, 0x2a, 0x49, 0x5a, 0x55, 0x63, 0x45, 0xa2, 0xff, 0xd8, 0x42,
-	0xe6, 0xdc, 0x5f, 0xbd, 0xd9, 0xa5, 0x9b, 0x80, 0xa5, 0x54, 0x0c, 0x26, 0xc1, 0x74, 0x14, 0x2d,
-	0x81, 0xaf, 0x48, 0x6a, 0x16, 0x49, 0x01, 0xb9, 0x60, 0x5e, 0x68, 0xb0, 0x2b, 0x63, 0x81, 0xe8,
-	0xcb, 0x38, 0x5c, 0x96, 0xd1, 0xc1, 0xab, 0x84, 0xbf, 0x60, 0x03, 0xab, 0x0a, 0xc8, 0xbd, 0xb4,
-	0xeb, 0xa5, 0xfe, 0x92, 0xb8, 0x4a, 0xf8, 0x11, 0xeb, 0xdf, 0x20, 0x81, 0xd4, 0x39, 0x89, 0x91,
-	0xd7, 0x7a, 0x0e, 0x7f, 0xcc, 0x89, 0x7f, 0x62, 0xa3, 0x18, 0x72, 0xf8, 0x69, 0xb4, 0x51, 0x85,
-	0x81, 0x52, 0xec, 0x4d, 0xb6, 0xa7, 0xc3, 0xf3, 0x93, 0xd6, 0x01, 0xee, 0xf4, 0x5a, 0x16, 0x58,
-	0x11, 0x48, 0x47, 0x47, 0x7f, 0xaf, 0xe3, 0x67, 0xec, 0x40, 0xab, 0x32, 0xc5, 0x8a, 0x64, 0xbc,
-	0x40, 0x7d, 0x2d, 0xf3, 0x2a, 0x13, 0xfb, 0x3e, 0xd8, 0xfe, 0x4a, 0xb8, 0x74, 0xfc, 0x97, 0x2a,
-	0xe3, 0xaf, 0xd8, 0xf0, 0x16, 0xcc, 0x3c, 0x25, 0x48, 0xe4, 0x8d, 0x15, 0x4f, 0x7c, 0x5f, 0xd8,
-	0x9a, 0xfa, 0x66, 0xf9, 0x1b, 0xd6, 0x2b, 0xe0, 0x56, 0x15, 0x49, 0x29, 0x0e, 0x7c, 0x41, 0xf7,
-	0x5b, 0xf9, 0xdc, 0x40, 0x49, 0xd1, 0x5a, 0xe7, 0xe7, 0x6c, 0x37, 0x51, 0xd6, 0xca, 0xb5, 0x9f,
-	0x3f, 0xec, 0x1f, 0x3a, 0x53, 0xb4, 0x5a, 0xf3, 0x9c, 0x75, 0xc9, 0xe8, 0x6b, 0x20, 0xf1, 0xd4,
-	0xd7, 0x77, 0x85, 0xf8, 0x4b, 0x36, 0xd0, 0x68, 0xeb, 0xc2, 0xe5, 0x21, 0x0e, 0xbd, 0x74, 0x47,
-	0xf0, 0xd7, 0x6c, 0xaf, 0x01, 0x32, 0x83, 0x0c, 0xc5, 0x33, 0x9f, 0xf8, 0xa8, 0x61, 0x3f, 0x43,
-	0x86, 0xa7, 0x73, 0x36, 0x2e, 0x51, 0x2e, 0x8c, 0x9b, 0x09, 0x37, 0xf1, 0x71, 0x2d, 0xd7, 0xb3,
-	0xd2, 0x1a, 0xb0, 0xe0, 0xbf, 0x07, 0xac, 0x75, 0x73, 0xb6, 0xda, 0x37, 0xe7, 0x34, 0x65, 0x27,
-	0xf7, 0x02, 0xfd, 0xdb, 0x81, 0x87, 0xdb, 0x12, 0x3c, 0xdc, 0x96, 0x8d, 0x91, 0x7e, 0xdc, 0x3f,
-	0xd2, 0xba, 0xfc, 0xad, 0x4e, 0x05, 0x8f, 0x74, 0x6a, 0xe3, 0xf6, 0xef, 0xd9, 0x51, 0x89, 0xb2,
-	0xca, 0xcd, 0xaf, 0x0a, 0x9a, 0x00, 0x2b, 0xeb, 0xc6, 0x87, 0xe3, 0xf2, 0xed, 0xf7, 0xb3, 0xb9,
-	0xa1, 0xb4, 0x8a, 0x67, 0x1a, 0xb3, 0x50, 0x63, 0xa9, 0x53, 0x65, 0xf2, 0x50, 0x63, 0x4e, 0x90,
-	0x13, 0x96, 0xef, 0xe6, 0x18, 0x36, 0xcf, 0x56, 0xdc, 0xf5, 0x59, 0x5d, 0xfc, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xa4, 0xc0, 0x57, 0xd9, 0xca, 0x04, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0x56, 0xf6, 0xd1, 0x0f, 0x77, 0xdd, 0xde, 0xf9, 0x1d, 0xab, 0x37, 0x90, 0xe8, 0x26, 0x21,
+	0x95, 0x09, 0x5a, 0x69, 0xe3, 0x8a, 0xcb, 0x21, 0x84, 0x76, 0x01, 0x42, 0xb9, 0xe0, 0x02, 0x09,
+	0x59, 0xae, 0x73, 0x68, 0xac, 0x25, 0xb6, 0x89, 0x4f, 0x3a, 0xe5, 0xf7, 0xf2, 0x47, 0x90, 0xdd,
+	0x26, 0x0b, 0x6c, 0xd3, 0x76, 0xd3, 0xe6, 0xf9, 0xb0, 0xcf, 0xc9, 0xf1, 0xe3, 0x90, 0x91, 0xb0,
+	0x76, 0x86, 0x62, 0x9e, 0xc1, 0xcc, 0x19, 0x6e, 0x8d, 0xc3, 0xa9, 0x2d, 0x0c, 0x1a, 0xba, 0x1d,
+	0xc8, 0xe3, 0x83, 0x80, 0xb0, 0xb2, 0x30, 0xf3, 0x3f, 0x2b, 0xf1, 0xf4, 0x77, 0x87, 0x74, 0xd7,
+	0x76, 0x3a, 0x22, 0x5d, 0xff, 0xcf, 0x55, 0xc2, 0xa2, 0x71, 0x34, 0xd9, 0x8a, 0x3b, 0x1e, 0x5e,
+	0x25, 0xf4, 0x98, 0xf4, 0xa4, 0x40, 0x58, 0x98, 0xa2, 0x62, 0x1b, 0xe3, 0x68, 0xd2, 0x8f, 0x1b,
+	0x4c, 0x67, 0xa4, 0x23, 0x4a, 0x4c, 0x4d, 0xc1, 0x36, 0xc7, 0xd1, 0x64, 0x70, 0x3e, 0x9a, 0x36,
+	0x75, 0xa6, 0x42, 0x4a, 0x53, 0x6a, 0xe4, 0x5a, 0xe4, 0x10, 0xaf, 0x6d, 0xf4, 0x80, 0x6c, 0xa3,
+	0xc2, 0x0c, 0xd8, 0x56, 0xd8, 0x69, 0x05, 0x28, 0x25, 0x5b, 0x73, 0x93, 0x54, 0x6c, 0x3b, 0x90,
+	0xe1, 0xd9, 0x73, 0x28, 0x16, 0x8e, 0x75, 0xc6, 0x9b, 0x9e, 0xf3, 0xcf, 0xf4, 0x82, 0x74, 0x65,
+	0x01, 0x02, 0x21, 0x61, 0xdd, 0x50, 0xef, 0xa8, 0x55, 0x0f, 0x55, 0x0e, 0xdc, 0x1a, 0xa5, 0x91,
+	0x3b, 0x90, 0x71, 0xed, 0xa4, 0xef, 0xc9, 0x20, 0x13, 0x0e, 0xb9, 0x15, 0x95, 0x29, 0x91, 0xf5,
+	0x1e, 0x5b, 0x48, 0xbc, 0xfb, 0x6b, 0x30, 0xfb, 0x76, 0x13, 0xb0, 0x98, 0xb2, 0xfe, 0x38, 0x9a,
+	0x0c, 0xe3, 0x15, 0x08, 0x13, 0x49, 0x55, 0x96, 0x14, 0xa0, 0x19, 0x09, 0x42, 0x83, 0xfd, 0x18,
+	0x0b, 0x63, 0xc2, 0x18, 0x07, 0xab, 0x31, 0x7a, 0x78, 0x95, 0xd0, 0xe7, 0xa4, 0x6f, 0x45, 0x01,
+	0x3a, 0x48, 0x3b, 0x41, 0xea, 0xad, 0x88, 0xab, 0x84, 0x1e, 0x91, 0xde, 0xd2, 0x20, 0x70, 0xa9,
+	0x91, 0x0d, 0x83, 0xd6, 0xf5, 0xf8, 0x83, 0x46, 0xfa, 0x89, 0x0c, 0xe7, 0xa0, 0xe1, 0xa7, 0x92,
+	0x4a, 0x14, 0x0a, 0x1c, 0xdb, 0x1d, 0x6f, 0x4e, 0x06, 0xe7, 0x27, 0xad, 0x17, 0xb8, 0xd5, 0x2b,
+	0x5e, 0x98, 0x12, 0x81, 0x7b, 0x3a, 0xfe, 0x7b, 0x1d, 0x3d, 0x23, 0xfb, 0x52, 0xb8, 0xd4, 0x94,
+	0xc8, 0xe7, 0x99, 0x91, 0xd7, 0x5c, 0x97, 0x39, 0xdb, 0x0b, 0xc5, 0xf6, 0xd6, 0xc2, 0xa5, 0xe7,
+	0xbf, 0x94, 0x39, 0x7d, 0x49, 0x06, 0x37, 0xa0, 0x16, 0x29, 0x42, 0xc2, 0x97, 0x96, 0xfd, 0x17,
+	0xce, 0x85, 0xd4, 0xd4, 0x37, 0x4b, 0x5f, 0x93, 0x6e, 0x01, 0x37, 0xa2, 0x48, 0x1c, 0xdb, 0x0f,
+	0x03, 0xdd, 0x6b, 0xf5, 0xb3, 0x04, 0x87, 0x71, 0xad, 0xd3, 0x73, 0xb2, 0x93, 0x08, 0x6b, 0x79,
+	0xed, 0xa7, 0xf7, 0xfb, 0x07, 0xde, 0x14, 0xaf, 0xd7, 0x1c, 0x92, 0x0e, 0x2a, 0x79, 0x0d, 0xc8,
+	0xfe, 0x0f, 0xf3, 0x5d, 0x23, 0xfa, 0x82, 0xf4, 0xa5, 0xb1, 0x55, 0xe1, 0xfb, 0x60, 0x07, 0x41,
+	0xba, 0x25, 0xe8, 0x2b, 0xb2, 0xdb, 0x00, 0x9e, 0x43, 0x6e, 0xd8, 0xb3, 0xd0, 0xf8, 0xb0, 0x61,
+	0x3f, 0x43, 0x6e, 0x28, 0x23, 0xdd, 0x25, 0x14, 0x4e, 0x19, 0xcd, 0x0e, 0xc3, 0x16, 0x35, 0x6c,
+	0xa2, 0x02, 0x89, 0xf2, 0x19, 0x1b, 0x3d, 0x29, 0x2a, 0x1f, 0x83, 0xd9, 0xef, 0x9a, 0x40, 0x06,
+	0x7e, 0x1d, 0x1b, 0x47, 0x93, 0x5e, 0x5c, 0xc3, 0xd3, 0x05, 0x19, 0x39, 0xc3, 0x33, 0xe5, 0x33,
+	0xe8, 0x6f, 0xd8, 0xbc, 0xe2, 0x75, 0x36, 0x5b, 0x81, 0x8e, 0x9e, 0x1c, 0xe8, 0xd6, 0x4d, 0xdd,
+	0x68, 0xdf, 0xd4, 0xd3, 0x94, 0x9c, 0xdc, 0x29, 0xf4, 0xef, 0x89, 0xdf, 0x1f, 0x83, 0xe8, 0xfe,
+	0x18, 0x3c, 0x58, 0xe9, 0xc7, 0xdd, 0x57, 0xaa, 0x8f, 0xbb, 0x95, 0x8c, 0xe8, 0x91, 0x64, 0x3c,
+	0xb8, 0xfd, 0x3b, 0x72, 0xe4, 0x0c, 0x2f, 0xb5, 0xfa, 0x55, 0x42, 0x53, 0x60, 0x6d, 0x7d, 0xf0,
+	0x43, 0x75, 0xf9, 0xe6, 0xfb, 0xd9, 0x42, 0x61, 0x5a, 0xce, 0xa7, 0xd2, 0xe4, 0x33, 0x69, 0x9c,
+	0x4c, 0x85, 0xd2, 0x33, 0x69, 0x34, 0x82, 0x46, 0xe3, 0xde, 0x2e, 0xcc, 0xac, 0xf9, 0x4c, 0xce,
+	0x3b, 0xa1, 0xab, 0x8b, 0x3f, 0x03, 0x00, 0xcc, 0x71, 0x12, 0x72, 0x3a, 0x05, 0x00, 0x00,
 }
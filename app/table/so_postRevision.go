@@ -0,0 +1,1186 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	PostRevisionIdTable    uint32 = 2973098530
+	PostRevisionIdUniTable uint32 = 262558966
+
+	PostRevisionIdRow uint32 = 4148253235
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoPostRevisionWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *prototype.PostRevisionId
+	watcherFlag *PostRevisionWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoPostRevisionWrap(dba iservices.IDatabaseRW, key *prototype.PostRevisionId) *SoPostRevisionWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoPostRevisionWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoPostRevisionWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoPostRevisionWrap) MustExist(errMsgs ...interface{}) *SoPostRevisionWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) MustNotExist(errMsgs ...interface{}) *SoPostRevisionWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(PostRevisionWatcherFlag)
+		*(s.watcherFlag) = PostRevisionWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoPostRevisionWrap) create(f func(tInfo *SoPostRevision)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoPostRevision{}
+	f(val)
+	if val.Id == nil {
+		val.Id = s.mainKey
+	}
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoPostRevisionWrap) Create(f func(tInfo *SoPostRevision), errArgs ...interface{}) *SoPostRevisionWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoPostRevisionWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoPostRevisionWrap) modify(f func(tInfo *SoPostRevision)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoPostRevision table does not exist. Please create a table first")
+	}
+	oriTable := s.getPostRevision()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoPostRevision")
+	}
+
+	curTable := s.getPostRevision()
+	if curTable == nil {
+		return errors.New("fail to create current table SoPostRevision")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updatePostRevision(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoPostRevisionWrap) Modify(f func(tInfo *SoPostRevision), errArgs ...interface{}) *SoPostRevisionWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) SetBody(p string, errArgs ...interface{}) *SoPostRevisionWrap {
+	err := s.modify(func(r *SoPostRevision) {
+		r.Body = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.SetBody( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) SetCreated(p *prototype.TimePointSec, errArgs ...interface{}) *SoPostRevisionWrap {
+	err := s.modify(func(r *SoPostRevision) {
+		r.Created = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.SetCreated( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) SetTitle(p string, errArgs ...interface{}) *SoPostRevisionWrap {
+	err := s.modify(func(r *SoPostRevision) {
+		r.Title = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.SetTitle( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoPostRevisionWrap) checkSortAndUniFieldValidity(curTable *SoPostRevision, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoPostRevisionWrap) getModifiedFields(oriTable *SoPostRevision, curTable *SoPostRevision) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.Body, curTable.Body) {
+		fields["Body"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasBodyWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Created, curTable.Created) {
+		fields["Created"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasCreatedWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Title, curTable.Title) {
+		fields["Title"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasTitleWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoPostRevisionWrap) handleFieldMd(t FieldMdHandleType, so *SoPostRevision, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["Body"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldBody(so.Body, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Body")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldBody(so.Body, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Body")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldBody(so.Body, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Body")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Created"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldCreated(so.Created, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Created")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldCreated(so.Created, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Created")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldCreated(so.Created, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Created")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Title"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldTitle(so.Title, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Title")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldTitle(so.Title, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Title")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldTitle(so.Title, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Title")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoPostRevisionWrap) delSortKeyId(sa *SoPostRevision) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListPostRevisionById{}
+	if sa == nil {
+		val.Id = s.GetId()
+	} else {
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoPostRevisionWrap) insertSortKeyId(sa *SoPostRevision) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListPostRevisionById{}
+	val.Id = sa.Id
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoPostRevisionWrap) delAllSortKeys(br bool, val *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoPostRevisionWrap) insertAllSortKeys(val *SoPostRevision) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoPostRevision fail ")
+	}
+	if !s.insertSortKeyId(val) {
+		return errors.New("insert sort Field Id fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoPostRevisionWrap) removePostRevision() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoPostRevision
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getPostRevision()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoPostRevisionWrap) RemovePostRevision(errMsgs ...interface{}) *SoPostRevisionWrap {
+	err := s.removePostRevision()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoPostRevisionWrap.RemovePostRevision failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoPostRevisionWrap) GetBody() string {
+	res := true
+	msg := &SoPostRevision{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Body
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Body
+}
+
+func (s *SoPostRevisionWrap) mdFieldBody(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkBodyIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldBody(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldBody(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostRevisionWrap) delFieldBody(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) insertFieldBody(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) checkBodyIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) GetCreated() *prototype.TimePointSec {
+	res := true
+	msg := &SoPostRevision{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Created
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Created
+}
+
+func (s *SoPostRevisionWrap) mdFieldCreated(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkCreatedIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldCreated(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldCreated(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostRevisionWrap) delFieldCreated(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) insertFieldCreated(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) checkCreatedIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) GetId() *prototype.PostRevisionId {
+	res := true
+	msg := &SoPostRevision{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Id
+}
+
+func (s *SoPostRevisionWrap) GetTitle() string {
+	res := true
+	msg := &SoPostRevision{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Title
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Title
+}
+
+func (s *SoPostRevisionWrap) mdFieldTitle(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkTitleIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldTitle(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldTitle(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoPostRevisionWrap) delFieldTitle(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) insertFieldTitle(so *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoPostRevisionWrap) checkTitleIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type SPostRevisionIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewPostRevisionIdWrap(db iservices.IDatabaseRW) *SPostRevisionIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SPostRevisionIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SPostRevisionIdWrap) GetMainVal(val []byte) *prototype.PostRevisionId {
+	res := &SoListPostRevisionById{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (s *SPostRevisionIdWrap) GetSubVal(val []byte) *prototype.PostRevisionId {
+	res := &SoListPostRevisionById{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (m *SoListPostRevisionById) OpeEncode() ([]byte, error) {
+	pre := PostRevisionIdTable
+	sub := m.Id
+	if sub == nil {
+		return nil, errors.New("the pro Id is nil")
+	}
+	sub1 := m.Id
+	if sub1 == nil {
+		return nil, errors.New("the mainkey Id is nil")
+	}
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SPostRevisionIdWrap) ForEachByOrder(start *prototype.PostRevisionId, end *prototype.PostRevisionId, lastMainKey *prototype.PostRevisionId,
+	lastSubVal *prototype.PostRevisionId, f func(mVal *prototype.PostRevisionId, sVal *prototype.PostRevisionId, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := PostRevisionIdTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoPostRevisionWrap) update(sa *SoPostRevision) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoPostRevisionWrap) getPostRevision() *SoPostRevision {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoPostRevision{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoPostRevisionWrap) updatePostRevision(so *SoPostRevision) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoPostRevision is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoPostRevisionWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := PostRevisionIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoPostRevisionWrap) delAllUniKeys(br bool, val *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoPostRevisionWrap) delUniKeysWithNames(names map[string]string, val *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoPostRevisionWrap) insertAllUniKeys(val *SoPostRevision) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoPostRevision fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoPostRevisionWrap) delUniKeyId(sa *SoPostRevision) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := PostRevisionIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+		if sa.Id == nil {
+			return false
+		}
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+		if sub == nil {
+			return true
+		}
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoPostRevisionWrap) insertUniKeyId(sa *SoPostRevision) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := PostRevisionIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniquePostRevisionById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniPostRevisionIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniPostRevisionIdWrap(db iservices.IDatabaseRW) *UniPostRevisionIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniPostRevisionIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniPostRevisionIdWrap) UniQueryId(start *prototype.PostRevisionId) *SoPostRevisionWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := PostRevisionIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniquePostRevisionById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoPostRevisionWrap(s.Dba, res.Id)
+
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type PostRevisionWatcherFlag struct {
+	HasBodyWatcher bool
+
+	HasCreatedWatcher bool
+
+	HasTitleWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	PostRevisionTable = &TableInfo{
+		Name:    "PostRevision",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoPostRevision)(nil)).Elem(),
+	}
+	PostRevisionWatcherFlags     = make(map[uint32]PostRevisionWatcherFlag)
+	PostRevisionWatcherFlagsLock sync.RWMutex
+)
+
+func PostRevisionWatcherFlagOfDb(dbSvcId uint32) PostRevisionWatcherFlag {
+	PostRevisionWatcherFlagsLock.RLock()
+	defer PostRevisionWatcherFlagsLock.RUnlock()
+	return PostRevisionWatcherFlags[dbSvcId]
+}
+
+func PostRevisionRecordWatcherChanged(dbSvcId uint32) {
+	var flag PostRevisionWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, PostRevisionTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasBodyWatcher = HasTableRecordWatcher(dbSvcId, PostRevisionTable.Record, "Body")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasBodyWatcher
+
+	flag.HasCreatedWatcher = HasTableRecordWatcher(dbSvcId, PostRevisionTable.Record, "Created")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasCreatedWatcher
+
+	flag.HasTitleWatcher = HasTableRecordWatcher(dbSvcId, PostRevisionTable.Record, "Title")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasTitleWatcher
+
+	PostRevisionWatcherFlagsLock.Lock()
+	PostRevisionWatcherFlags[dbSvcId] = flag
+	PostRevisionWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func PostRevisionQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(prototype.PostRevisionId)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoPostRevisionWrap(db, k).getPostRevision(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(PostRevisionTable.Record, PostRevisionRecordWatcherChanged)
+	RegisterTableJsonQuery("PostRevision", PostRevisionQuery)
+}
@@ -0,0 +1,2623 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	ProposalProposerTable           uint32 = 2830067410
+	ProposalFeatureTable            uint32 = 3347042326
+	ProposalActivationBlockNumTable uint32 = 1173915855
+	ProposalStatusTable             uint32 = 3308138772
+	ProposalIdUniTable              uint32 = 3759932856
+
+	ProposalIdRow uint32 = 436052280
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoProposalWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *uint64
+	watcherFlag *ProposalWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoProposalWrap(dba iservices.IDatabaseRW, key *uint64) *SoProposalWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoProposalWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoProposalWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoProposalWrap) MustExist(errMsgs ...interface{}) *SoProposalWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) MustNotExist(errMsgs ...interface{}) *SoProposalWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(ProposalWatcherFlag)
+		*(s.watcherFlag) = ProposalWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoProposalWrap) create(f func(tInfo *SoProposal)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoProposal{}
+	f(val)
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoProposalWrap) Create(f func(tInfo *SoProposal), errArgs ...interface{}) *SoProposalWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoProposalWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoProposalWrap) modify(f func(tInfo *SoProposal)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoProposal table does not exist. Please create a table first")
+	}
+	oriTable := s.getProposal()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoProposal")
+	}
+
+	curTable := s.getProposal()
+	if curTable == nil {
+		return errors.New("fail to create current table SoProposal")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updateProposal(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoProposalWrap) Modify(f func(tInfo *SoProposal), errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetActivationBlockNum(p uint64, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.ActivationBlockNum = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetActivationBlockNum( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetApprovals(p []string, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Approvals = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetApprovals( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetCreatedTime(p *prototype.TimePointSec, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.CreatedTime = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetCreatedTime( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetDescription(p string, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Description = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetDescription( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetFeature(p string, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Feature = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetFeature( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetProposer(p *prototype.AccountName, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Proposer = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetProposer( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetProps(p *prototype.ChainProperties, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Props = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetProps( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetRewardPolicy(p *prototype.RewardPolicy, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.RewardPolicy = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetRewardPolicy( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetStatus(p uint32, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Status = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetStatus( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) SetTitle(p string, errArgs ...interface{}) *SoProposalWrap {
+	err := s.modify(func(r *SoProposal) {
+		r.Title = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.SetTitle( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoProposalWrap) checkSortAndUniFieldValidity(curTable *SoProposal, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+		if fields["Proposer"] && curTable.Proposer == nil {
+			return errors.New("sort field Proposer can't be modified to nil")
+		}
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoProposalWrap) getModifiedFields(oriTable *SoProposal, curTable *SoProposal) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.ActivationBlockNum, curTable.ActivationBlockNum) {
+		fields["ActivationBlockNum"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasActivationBlockNumWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Approvals, curTable.Approvals) {
+		fields["Approvals"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasApprovalsWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.CreatedTime, curTable.CreatedTime) {
+		fields["CreatedTime"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasCreatedTimeWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Description, curTable.Description) {
+		fields["Description"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasDescriptionWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Feature, curTable.Feature) {
+		fields["Feature"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasFeatureWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Proposer, curTable.Proposer) {
+		fields["Proposer"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasProposerWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Props, curTable.Props) {
+		fields["Props"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasPropsWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.RewardPolicy, curTable.RewardPolicy) {
+		fields["RewardPolicy"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasRewardPolicyWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Status, curTable.Status) {
+		fields["Status"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasStatusWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Title, curTable.Title) {
+		fields["Title"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasTitleWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoProposalWrap) handleFieldMd(t FieldMdHandleType, so *SoProposal, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["ActivationBlockNum"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldActivationBlockNum(so.ActivationBlockNum, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "ActivationBlockNum")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldActivationBlockNum(so.ActivationBlockNum, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "ActivationBlockNum")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldActivationBlockNum(so.ActivationBlockNum, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "ActivationBlockNum")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Approvals"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldApprovals(so.Approvals, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Approvals")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldApprovals(so.Approvals, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Approvals")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldApprovals(so.Approvals, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Approvals")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["CreatedTime"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldCreatedTime(so.CreatedTime, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "CreatedTime")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Description"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldDescription(so.Description, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Description")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldDescription(so.Description, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Description")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldDescription(so.Description, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Description")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Feature"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldFeature(so.Feature, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Feature")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldFeature(so.Feature, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Feature")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldFeature(so.Feature, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Feature")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Proposer"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldProposer(so.Proposer, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Proposer")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldProposer(so.Proposer, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Proposer")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldProposer(so.Proposer, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Proposer")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Props"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldProps(so.Props, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Props")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldProps(so.Props, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Props")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldProps(so.Props, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Props")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["RewardPolicy"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldRewardPolicy(so.RewardPolicy, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "RewardPolicy")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldRewardPolicy(so.RewardPolicy, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "RewardPolicy")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldRewardPolicy(so.RewardPolicy, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "RewardPolicy")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Status"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldStatus(so.Status, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Status")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldStatus(so.Status, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Status")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldStatus(so.Status, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Status")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Title"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldTitle(so.Title, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Title")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldTitle(so.Title, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Title")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldTitle(so.Title, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Title")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoProposalWrap) delSortKeyProposer(sa *SoProposal) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListProposalByProposer{}
+	if sa == nil {
+		val.Proposer = s.GetProposer()
+		val.Id = *s.mainKey
+	} else {
+		val.Proposer = sa.Proposer
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) insertSortKeyProposer(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListProposalByProposer{}
+	val.Id = sa.Id
+	val.Proposer = sa.Proposer
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) delSortKeyFeature(sa *SoProposal) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListProposalByFeature{}
+	if sa == nil {
+		val.Feature = s.GetFeature()
+		val.Id = *s.mainKey
+	} else {
+		val.Feature = sa.Feature
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) insertSortKeyFeature(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListProposalByFeature{}
+	val.Id = sa.Id
+	val.Feature = sa.Feature
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) delSortKeyActivationBlockNum(sa *SoProposal) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListProposalByActivationBlockNum{}
+	if sa == nil {
+		val.ActivationBlockNum = s.GetActivationBlockNum()
+		val.Id = *s.mainKey
+	} else {
+		val.ActivationBlockNum = sa.ActivationBlockNum
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) insertSortKeyActivationBlockNum(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListProposalByActivationBlockNum{}
+	val.Id = sa.Id
+	val.ActivationBlockNum = sa.ActivationBlockNum
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) delSortKeyStatus(sa *SoProposal) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListProposalByStatus{}
+	if sa == nil {
+		val.Status = s.GetStatus()
+		val.Id = *s.mainKey
+	} else {
+		val.Status = sa.Status
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) insertSortKeyStatus(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListProposalByStatus{}
+	val.Id = sa.Id
+	val.Status = sa.Status
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoProposalWrap) delAllSortKeys(br bool, val *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyProposer(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyFeature(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyActivationBlockNum(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyStatus(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoProposalWrap) insertAllSortKeys(val *SoProposal) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoProposal fail ")
+	}
+	if !s.insertSortKeyProposer(val) {
+		return errors.New("insert sort Field Proposer fail while insert table ")
+	}
+	if !s.insertSortKeyFeature(val) {
+		return errors.New("insert sort Field Feature fail while insert table ")
+	}
+	if !s.insertSortKeyActivationBlockNum(val) {
+		return errors.New("insert sort Field ActivationBlockNum fail while insert table ")
+	}
+	if !s.insertSortKeyStatus(val) {
+		return errors.New("insert sort Field Status fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoProposalWrap) removeProposal() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoProposal
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getProposal()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoProposalWrap) RemoveProposal(errMsgs ...interface{}) *SoProposalWrap {
+	err := s.removeProposal()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoProposalWrap.RemoveProposal failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoProposalWrap) GetActivationBlockNum() uint64 {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.ActivationBlockNum
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint64
+		return tmpValue
+	}
+	return msg.ActivationBlockNum
+}
+
+func (s *SoProposalWrap) mdFieldActivationBlockNum(p uint64, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkActivationBlockNumIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldActivationBlockNum(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldActivationBlockNum(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldActivationBlockNum(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyActivationBlockNum(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldActivationBlockNum(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyActivationBlockNum(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkActivationBlockNumIsMetMdCondition(p uint64) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetApprovals() []string {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Approvals
+			}
+		}
+	}
+	if !res {
+		var tmpValue []string
+		return tmpValue
+	}
+	return msg.Approvals
+}
+
+func (s *SoProposalWrap) mdFieldApprovals(p []string, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkApprovalsIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldApprovals(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldApprovals(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldApprovals(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldApprovals(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkApprovalsIsMetMdCondition(p []string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetCreatedTime() *prototype.TimePointSec {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.CreatedTime
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.CreatedTime
+}
+
+func (s *SoProposalWrap) mdFieldCreatedTime(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkCreatedTimeIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldCreatedTime(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldCreatedTime(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkCreatedTimeIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetDescription() string {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Description
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Description
+}
+
+func (s *SoProposalWrap) mdFieldDescription(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkDescriptionIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldDescription(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldDescription(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldDescription(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldDescription(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkDescriptionIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetFeature() string {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Feature
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Feature
+}
+
+func (s *SoProposalWrap) mdFieldFeature(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkFeatureIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldFeature(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldFeature(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldFeature(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyFeature(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldFeature(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyFeature(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkFeatureIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetId() uint64 {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint64
+		return tmpValue
+	}
+	return msg.Id
+}
+
+func (s *SoProposalWrap) GetProposer() *prototype.AccountName {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Proposer
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Proposer
+}
+
+func (s *SoProposalWrap) mdFieldProposer(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkProposerIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldProposer(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldProposer(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldProposer(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyProposer(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldProposer(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyProposer(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkProposerIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetProps() *prototype.ChainProperties {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Props
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Props
+}
+
+func (s *SoProposalWrap) mdFieldProps(p *prototype.ChainProperties, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkPropsIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldProps(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldProps(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldProps(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldProps(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkPropsIsMetMdCondition(p *prototype.ChainProperties) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetRewardPolicy() *prototype.RewardPolicy {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.RewardPolicy
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.RewardPolicy
+}
+
+func (s *SoProposalWrap) mdFieldRewardPolicy(p *prototype.RewardPolicy, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkRewardPolicyIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldRewardPolicy(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldRewardPolicy(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldRewardPolicy(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldRewardPolicy(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkRewardPolicyIsMetMdCondition(p *prototype.RewardPolicy) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetStatus() uint32 {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Status
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint32
+		return tmpValue
+	}
+	return msg.Status
+}
+
+func (s *SoProposalWrap) mdFieldStatus(p uint32, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkStatusIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldStatus(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldStatus(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldStatus(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyStatus(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldStatus(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyStatus(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkStatusIsMetMdCondition(p uint32) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) GetTitle() string {
+	res := true
+	msg := &SoProposal{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Title
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Title
+}
+
+func (s *SoProposalWrap) mdFieldTitle(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkTitleIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldTitle(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldTitle(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoProposalWrap) delFieldTitle(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) insertFieldTitle(so *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoProposalWrap) checkTitleIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type SProposalProposerWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewProposalProposerWrap(db iservices.IDatabaseRW) *SProposalProposerWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SProposalProposerWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SProposalProposerWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListProposalByProposer{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SProposalProposerWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListProposalByProposer{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.Proposer
+
+}
+
+func (m *SoListProposalByProposer) OpeEncode() ([]byte, error) {
+	pre := ProposalProposerTable
+	sub := m.Proposer
+	if sub == nil {
+		return nil, errors.New("the pro Proposer is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalProposerWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalProposerTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalProposerWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalProposerTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SProposalFeatureWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewProposalFeatureWrap(db iservices.IDatabaseRW) *SProposalFeatureWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SProposalFeatureWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SProposalFeatureWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListProposalByFeature{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SProposalFeatureWrap) GetSubVal(val []byte) *string {
+	res := &SoListProposalByFeature{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return &res.Feature
+
+}
+
+func (m *SoListProposalByFeature) OpeEncode() ([]byte, error) {
+	pre := ProposalFeatureTable
+	sub := m.Feature
+
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalFeatureWrap) ForEachByOrder(start *string, end *string, lastMainKey *uint64,
+	lastSubVal *string, f func(mVal *uint64, sVal *string, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalFeatureTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SProposalActivationBlockNumWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewProposalActivationBlockNumWrap(db iservices.IDatabaseRW) *SProposalActivationBlockNumWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SProposalActivationBlockNumWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SProposalActivationBlockNumWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListProposalByActivationBlockNum{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SProposalActivationBlockNumWrap) GetSubVal(val []byte) *uint64 {
+	res := &SoListProposalByActivationBlockNum{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return &res.ActivationBlockNum
+
+}
+
+func (m *SoListProposalByActivationBlockNum) OpeEncode() ([]byte, error) {
+	pre := ProposalActivationBlockNumTable
+	sub := m.ActivationBlockNum
+
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalActivationBlockNumWrap) ForEachByOrder(start *uint64, end *uint64, lastMainKey *uint64,
+	lastSubVal *uint64, f func(mVal *uint64, sVal *uint64, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalActivationBlockNumTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SProposalStatusWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewProposalStatusWrap(db iservices.IDatabaseRW) *SProposalStatusWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SProposalStatusWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SProposalStatusWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListProposalByStatus{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SProposalStatusWrap) GetSubVal(val []byte) *uint32 {
+	res := &SoListProposalByStatus{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return &res.Status
+
+}
+
+func (m *SoListProposalByStatus) OpeEncode() ([]byte, error) {
+	pre := ProposalStatusTable
+	sub := m.Status
+
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalStatusWrap) ForEachByOrder(start *uint32, end *uint32, lastMainKey *uint64,
+	lastSubVal *uint32, f func(mVal *uint64, sVal *uint32, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalStatusTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SProposalStatusWrap) ForEachByRevOrder(start *uint32, end *uint32, lastMainKey *uint64,
+	lastSubVal *uint32, f func(mVal *uint64, sVal *uint32, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ProposalStatusTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoProposalWrap) update(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoProposalWrap) getProposal() *SoProposal {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoProposal{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoProposalWrap) updateProposal(so *SoProposal) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoProposal is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoProposalWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := ProposalIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoProposalWrap) delAllUniKeys(br bool, val *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoProposalWrap) delUniKeysWithNames(names map[string]string, val *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoProposalWrap) insertAllUniKeys(val *SoProposal) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoProposal fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoProposalWrap) delUniKeyId(sa *SoProposal) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := ProposalIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoProposalWrap) insertUniKeyId(sa *SoProposal) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := ProposalIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniqueProposalById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniProposalIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniProposalIdWrap(db iservices.IDatabaseRW) *UniProposalIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniProposalIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniProposalIdWrap) UniQueryId(start *uint64) *SoProposalWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := ProposalIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniqueProposalById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoProposalWrap(s.Dba, &res.Id)
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type ProposalWatcherFlag struct {
+	HasActivationBlockNumWatcher bool
+
+	HasApprovalsWatcher bool
+
+	HasCreatedTimeWatcher bool
+
+	HasDescriptionWatcher bool
+
+	HasFeatureWatcher bool
+
+	HasProposerWatcher bool
+
+	HasPropsWatcher bool
+
+	HasRewardPolicyWatcher bool
+
+	HasStatusWatcher bool
+
+	HasTitleWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	ProposalTable = &TableInfo{
+		Name:    "Proposal",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoProposal)(nil)).Elem(),
+	}
+	ProposalWatcherFlags     = make(map[uint32]ProposalWatcherFlag)
+	ProposalWatcherFlagsLock sync.RWMutex
+)
+
+func ProposalWatcherFlagOfDb(dbSvcId uint32) ProposalWatcherFlag {
+	ProposalWatcherFlagsLock.RLock()
+	defer ProposalWatcherFlagsLock.RUnlock()
+	return ProposalWatcherFlags[dbSvcId]
+}
+
+func ProposalRecordWatcherChanged(dbSvcId uint32) {
+	var flag ProposalWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasActivationBlockNumWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "ActivationBlockNum")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasActivationBlockNumWatcher
+
+	flag.HasApprovalsWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Approvals")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasApprovalsWatcher
+
+	flag.HasCreatedTimeWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "CreatedTime")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasCreatedTimeWatcher
+
+	flag.HasDescriptionWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Description")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasDescriptionWatcher
+
+	flag.HasFeatureWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Feature")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasFeatureWatcher
+
+	flag.HasProposerWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Proposer")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasProposerWatcher
+
+	flag.HasPropsWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Props")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasPropsWatcher
+
+	flag.HasRewardPolicyWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "RewardPolicy")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasRewardPolicyWatcher
+
+	flag.HasStatusWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Status")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasStatusWatcher
+
+	flag.HasTitleWatcher = HasTableRecordWatcher(dbSvcId, ProposalTable.Record, "Title")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasTitleWatcher
+
+	ProposalWatcherFlagsLock.Lock()
+	ProposalWatcherFlags[dbSvcId] = flag
+	ProposalWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func ProposalQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(uint64)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoProposalWrap(db, k).getProposal(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(ProposalTable.Record, ProposalRecordWatcherChanged)
+	RegisterTableJsonQuery("Proposal", ProposalQuery)
+}
@@ -0,0 +1,1995 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	ScheduledTransferFromAccountTable uint32 = 698189634
+	ScheduledTransferToAccountTable   uint32 = 93103592
+	ScheduledTransferDueTimeTable     uint32 = 2318415459
+	ScheduledTransferIdUniTable       uint32 = 1890482235
+
+	ScheduledTransferIdRow uint32 = 1042821612
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoScheduledTransferWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *uint64
+	watcherFlag *ScheduledTransferWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoScheduledTransferWrap(dba iservices.IDatabaseRW, key *uint64) *SoScheduledTransferWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoScheduledTransferWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoScheduledTransferWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoScheduledTransferWrap) MustExist(errMsgs ...interface{}) *SoScheduledTransferWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) MustNotExist(errMsgs ...interface{}) *SoScheduledTransferWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(ScheduledTransferWatcherFlag)
+		*(s.watcherFlag) = ScheduledTransferWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoScheduledTransferWrap) create(f func(tInfo *SoScheduledTransfer)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoScheduledTransfer{}
+	f(val)
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoScheduledTransferWrap) Create(f func(tInfo *SoScheduledTransfer), errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoScheduledTransferWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoScheduledTransferWrap) modify(f func(tInfo *SoScheduledTransfer)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoScheduledTransfer table does not exist. Please create a table first")
+	}
+	oriTable := s.getScheduledTransfer()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoScheduledTransfer")
+	}
+
+	curTable := s.getScheduledTransfer()
+	if curTable == nil {
+		return errors.New("fail to create current table SoScheduledTransfer")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updateScheduledTransfer(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoScheduledTransferWrap) Modify(f func(tInfo *SoScheduledTransfer), errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetAmount(p *prototype.Coin, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.Amount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetAmount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetCreatedTime(p *prototype.TimePointSec, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.CreatedTime = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetCreatedTime( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetDueTime(p *prototype.TimePointSec, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.DueTime = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetDueTime( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetFromAccount(p *prototype.AccountName, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.FromAccount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetFromAccount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetMemo(p string, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.Memo = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetMemo( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) SetToAccount(p *prototype.AccountName, errArgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.modify(func(r *SoScheduledTransfer) {
+		r.ToAccount = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.SetToAccount( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoScheduledTransferWrap) checkSortAndUniFieldValidity(curTable *SoScheduledTransfer, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+		if fields["FromAccount"] && curTable.FromAccount == nil {
+			return errors.New("sort field FromAccount can't be modified to nil")
+		}
+
+		if fields["ToAccount"] && curTable.ToAccount == nil {
+			return errors.New("sort field ToAccount can't be modified to nil")
+		}
+
+		if fields["DueTime"] && curTable.DueTime == nil {
+			return errors.New("sort field DueTime can't be modified to nil")
+		}
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoScheduledTransferWrap) getModifiedFields(oriTable *SoScheduledTransfer, curTable *SoScheduledTransfer) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.Amount, curTable.Amount) {
+		fields["Amount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasAmountWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.CreatedTime, curTable.CreatedTime) {
+		fields["CreatedTime"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasCreatedTimeWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.DueTime, curTable.DueTime) {
+		fields["DueTime"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasDueTimeWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.FromAccount, curTable.FromAccount) {
+		fields["FromAccount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasFromAccountWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.Memo, curTable.Memo) {
+		fields["Memo"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasMemoWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.ToAccount, curTable.ToAccount) {
+		fields["ToAccount"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasToAccountWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoScheduledTransferWrap) handleFieldMd(t FieldMdHandleType, so *SoScheduledTransfer, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["Amount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldAmount(so.Amount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Amount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldAmount(so.Amount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Amount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldAmount(so.Amount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Amount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["CreatedTime"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldCreatedTime(so.CreatedTime, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "CreatedTime")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldCreatedTime(so.CreatedTime, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "CreatedTime")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["DueTime"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldDueTime(so.DueTime, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "DueTime")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldDueTime(so.DueTime, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "DueTime")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldDueTime(so.DueTime, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "DueTime")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["FromAccount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldFromAccount(so.FromAccount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "FromAccount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldFromAccount(so.FromAccount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "FromAccount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldFromAccount(so.FromAccount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "FromAccount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["Memo"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldMemo(so.Memo, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Memo")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldMemo(so.Memo, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Memo")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldMemo(so.Memo, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Memo")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["ToAccount"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldToAccount(so.ToAccount, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "ToAccount")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldToAccount(so.ToAccount, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "ToAccount")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldToAccount(so.ToAccount, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "ToAccount")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoScheduledTransferWrap) delSortKeyFromAccount(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListScheduledTransferByFromAccount{}
+	if sa == nil {
+		val.FromAccount = s.GetFromAccount()
+		val.Id = *s.mainKey
+	} else {
+		val.FromAccount = sa.FromAccount
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) insertSortKeyFromAccount(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListScheduledTransferByFromAccount{}
+	val.Id = sa.Id
+	val.FromAccount = sa.FromAccount
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) delSortKeyToAccount(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListScheduledTransferByToAccount{}
+	if sa == nil {
+		val.ToAccount = s.GetToAccount()
+		val.Id = *s.mainKey
+	} else {
+		val.ToAccount = sa.ToAccount
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) insertSortKeyToAccount(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListScheduledTransferByToAccount{}
+	val.Id = sa.Id
+	val.ToAccount = sa.ToAccount
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) delSortKeyDueTime(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListScheduledTransferByDueTime{}
+	if sa == nil {
+		val.DueTime = s.GetDueTime()
+		val.Id = *s.mainKey
+	} else {
+		val.DueTime = sa.DueTime
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) insertSortKeyDueTime(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListScheduledTransferByDueTime{}
+	val.Id = sa.Id
+	val.DueTime = sa.DueTime
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoScheduledTransferWrap) delAllSortKeys(br bool, val *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyFromAccount(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyToAccount(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+	if !s.delSortKeyDueTime(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoScheduledTransferWrap) insertAllSortKeys(val *SoScheduledTransfer) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoScheduledTransfer fail ")
+	}
+	if !s.insertSortKeyFromAccount(val) {
+		return errors.New("insert sort Field FromAccount fail while insert table ")
+	}
+	if !s.insertSortKeyToAccount(val) {
+		return errors.New("insert sort Field ToAccount fail while insert table ")
+	}
+	if !s.insertSortKeyDueTime(val) {
+		return errors.New("insert sort Field DueTime fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoScheduledTransferWrap) removeScheduledTransfer() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoScheduledTransfer
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getScheduledTransfer()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoScheduledTransferWrap) RemoveScheduledTransfer(errMsgs ...interface{}) *SoScheduledTransferWrap {
+	err := s.removeScheduledTransfer()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoScheduledTransferWrap.RemoveScheduledTransfer failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoScheduledTransferWrap) GetAmount() *prototype.Coin {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Amount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Amount
+}
+
+func (s *SoScheduledTransferWrap) mdFieldAmount(p *prototype.Coin, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkAmountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldAmount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldAmount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldAmount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldAmount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkAmountIsMetMdCondition(p *prototype.Coin) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) GetCreatedTime() *prototype.TimePointSec {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.CreatedTime
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.CreatedTime
+}
+
+func (s *SoScheduledTransferWrap) mdFieldCreatedTime(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkCreatedTimeIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldCreatedTime(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldCreatedTime(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldCreatedTime(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkCreatedTimeIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) GetDueTime() *prototype.TimePointSec {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.DueTime
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.DueTime
+}
+
+func (s *SoScheduledTransferWrap) mdFieldDueTime(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkDueTimeIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldDueTime(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldDueTime(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldDueTime(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyDueTime(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldDueTime(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyDueTime(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkDueTimeIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) GetFromAccount() *prototype.AccountName {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.FromAccount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.FromAccount
+}
+
+func (s *SoScheduledTransferWrap) mdFieldFromAccount(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkFromAccountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldFromAccount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldFromAccount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldFromAccount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyFromAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldFromAccount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyFromAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkFromAccountIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) GetId() uint64 {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		var tmpValue uint64
+		return tmpValue
+	}
+	return msg.Id
+}
+
+func (s *SoScheduledTransferWrap) GetMemo() string {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Memo
+			}
+		}
+	}
+	if !res {
+		var tmpValue string
+		return tmpValue
+	}
+	return msg.Memo
+}
+
+func (s *SoScheduledTransferWrap) mdFieldMemo(p string, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkMemoIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldMemo(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldMemo(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldMemo(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldMemo(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkMemoIsMetMdCondition(p string) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) GetToAccount() *prototype.AccountName {
+	res := true
+	msg := &SoScheduledTransfer{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.ToAccount
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.ToAccount
+}
+
+func (s *SoScheduledTransferWrap) mdFieldToAccount(p *prototype.AccountName, isCheck bool, isDel bool, isInsert bool,
+	so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkToAccountIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldToAccount(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldToAccount(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoScheduledTransferWrap) delFieldToAccount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyToAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) insertFieldToAccount(so *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyToAccount(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoScheduledTransferWrap) checkToAccountIsMetMdCondition(p *prototype.AccountName) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type SScheduledTransferFromAccountWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewScheduledTransferFromAccountWrap(db iservices.IDatabaseRW) *SScheduledTransferFromAccountWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SScheduledTransferFromAccountWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SScheduledTransferFromAccountWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListScheduledTransferByFromAccount{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SScheduledTransferFromAccountWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListScheduledTransferByFromAccount{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.FromAccount
+
+}
+
+func (m *SoListScheduledTransferByFromAccount) OpeEncode() ([]byte, error) {
+	pre := ScheduledTransferFromAccountTable
+	sub := m.FromAccount
+	if sub == nil {
+		return nil, errors.New("the pro FromAccount is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SScheduledTransferFromAccountWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ScheduledTransferFromAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SScheduledTransferFromAccountWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ScheduledTransferFromAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SScheduledTransferToAccountWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewScheduledTransferToAccountWrap(db iservices.IDatabaseRW) *SScheduledTransferToAccountWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SScheduledTransferToAccountWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SScheduledTransferToAccountWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListScheduledTransferByToAccount{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SScheduledTransferToAccountWrap) GetSubVal(val []byte) *prototype.AccountName {
+	res := &SoListScheduledTransferByToAccount{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.ToAccount
+
+}
+
+func (m *SoListScheduledTransferByToAccount) OpeEncode() ([]byte, error) {
+	pre := ScheduledTransferToAccountTable
+	sub := m.ToAccount
+	if sub == nil {
+		return nil, errors.New("the pro ToAccount is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SScheduledTransferToAccountWrap) ForEachByOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ScheduledTransferToAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+//Query srt by reverse order
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SScheduledTransferToAccountWrap) ForEachByRevOrder(start *prototype.AccountName, end *prototype.AccountName, lastMainKey *uint64,
+	lastSubVal *prototype.AccountName, f func(mVal *uint64, sVal *prototype.AccountName, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ScheduledTransferToAccountTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey)
+		}
+		skeyList = append(skeyList, kope.MaximumKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(eBuf, sBuf, true, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+////////////// SECTION List Keys ///////////////
+type SScheduledTransferDueTimeWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewScheduledTransferDueTimeWrap(db iservices.IDatabaseRW) *SScheduledTransferDueTimeWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := SScheduledTransferDueTimeWrap{Dba: db}
+	return &wrap
+}
+
+func (s *SScheduledTransferDueTimeWrap) GetMainVal(val []byte) *uint64 {
+	res := &SoListScheduledTransferByDueTime{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+
+	return &res.Id
+
+}
+
+func (s *SScheduledTransferDueTimeWrap) GetSubVal(val []byte) *prototype.TimePointSec {
+	res := &SoListScheduledTransferByDueTime{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.DueTime
+
+}
+
+func (m *SoListScheduledTransferByDueTime) OpeEncode() ([]byte, error) {
+	pre := ScheduledTransferDueTimeTable
+	sub := m.DueTime
+	if sub == nil {
+		return nil, errors.New("the pro DueTime is nil")
+	}
+	sub1 := m.Id
+
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *SScheduledTransferDueTimeWrap) ForEachByOrder(start *prototype.TimePointSec, end *prototype.TimePointSec, lastMainKey *uint64,
+	lastSubVal *prototype.TimePointSec, f func(mVal *uint64, sVal *prototype.TimePointSec, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := ScheduledTransferDueTimeTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoScheduledTransferWrap) update(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoScheduledTransferWrap) getScheduledTransfer() *SoScheduledTransfer {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoScheduledTransfer{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoScheduledTransferWrap) updateScheduledTransfer(so *SoScheduledTransfer) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoScheduledTransfer is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoScheduledTransferWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := ScheduledTransferIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoScheduledTransferWrap) delAllUniKeys(br bool, val *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoScheduledTransferWrap) delUniKeysWithNames(names map[string]string, val *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoScheduledTransferWrap) insertAllUniKeys(val *SoScheduledTransfer) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoScheduledTransfer fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoScheduledTransferWrap) delUniKeyId(sa *SoScheduledTransfer) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := ScheduledTransferIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoScheduledTransferWrap) insertUniKeyId(sa *SoScheduledTransfer) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := ScheduledTransferIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniqueScheduledTransferById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniScheduledTransferIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniScheduledTransferIdWrap(db iservices.IDatabaseRW) *UniScheduledTransferIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniScheduledTransferIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniScheduledTransferIdWrap) UniQueryId(start *uint64) *SoScheduledTransferWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := ScheduledTransferIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniqueScheduledTransferById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoScheduledTransferWrap(s.Dba, &res.Id)
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type ScheduledTransferWatcherFlag struct {
+	HasAmountWatcher bool
+
+	HasCreatedTimeWatcher bool
+
+	HasDueTimeWatcher bool
+
+	HasFromAccountWatcher bool
+
+	HasMemoWatcher bool
+
+	HasToAccountWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	ScheduledTransferTable = &TableInfo{
+		Name:    "ScheduledTransfer",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoScheduledTransfer)(nil)).Elem(),
+	}
+	ScheduledTransferWatcherFlags     = make(map[uint32]ScheduledTransferWatcherFlag)
+	ScheduledTransferWatcherFlagsLock sync.RWMutex
+)
+
+func ScheduledTransferWatcherFlagOfDb(dbSvcId uint32) ScheduledTransferWatcherFlag {
+	ScheduledTransferWatcherFlagsLock.RLock()
+	defer ScheduledTransferWatcherFlagsLock.RUnlock()
+	return ScheduledTransferWatcherFlags[dbSvcId]
+}
+
+func ScheduledTransferRecordWatcherChanged(dbSvcId uint32) {
+	var flag ScheduledTransferWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasAmountWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "Amount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasAmountWatcher
+
+	flag.HasCreatedTimeWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "CreatedTime")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasCreatedTimeWatcher
+
+	flag.HasDueTimeWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "DueTime")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasDueTimeWatcher
+
+	flag.HasFromAccountWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "FromAccount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasFromAccountWatcher
+
+	flag.HasMemoWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "Memo")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasMemoWatcher
+
+	flag.HasToAccountWatcher = HasTableRecordWatcher(dbSvcId, ScheduledTransferTable.Record, "ToAccount")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasToAccountWatcher
+
+	ScheduledTransferWatcherFlagsLock.Lock()
+	ScheduledTransferWatcherFlags[dbSvcId] = flag
+	ScheduledTransferWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func ScheduledTransferQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(uint64)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoScheduledTransferWrap(db, k).getScheduledTransfer(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(ScheduledTransferTable.Record, ScheduledTransferRecordWatcherChanged)
+	RegisterTableJsonQuery("ScheduledTransfer", ScheduledTransferQuery)
+}
@@ -0,0 +1,1083 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/iservices"
+	prototype "github.com/coschain/contentos-go/prototype"
+	proto "github.com/golang/protobuf/proto"
+)
+
+////////////// SECTION Prefix Mark ///////////////
+var (
+	TrxNonceExpirationTable uint32 = 1316347088
+	TrxNonceIdUniTable      uint32 = 3051604815
+
+	TrxNonceIdRow uint32 = 2141047926
+)
+
+////////////// SECTION Wrap Define ///////////////
+type SoTrxNonceWrap struct {
+	dba         iservices.IDatabaseRW
+	mainKey     *prototype.TrxNonceId
+	watcherFlag *TrxNonceWatcherFlag
+	mKeyFlag    int    //the flag of the main key exist state in db, -1:has not judged; 0:not exist; 1:already exist
+	mKeyBuf     []byte //the buffer after the main key is encoded with prefix
+	mBuf        []byte //the value after the main key is encoded
+	mdFuncMap   map[string]interface{}
+}
+
+func NewSoTrxNonceWrap(dba iservices.IDatabaseRW, key *prototype.TrxNonceId) *SoTrxNonceWrap {
+	if dba == nil || key == nil {
+		return nil
+	}
+	result := &SoTrxNonceWrap{dba, key, nil, -1, nil, nil, nil}
+	result.initWatcherFlag()
+	return result
+}
+
+func (s *SoTrxNonceWrap) CheckExist() bool {
+	if s.dba == nil {
+		return false
+	}
+	if s.mKeyFlag != -1 {
+		//if you have already obtained the existence status of the primary key, use it directly
+		if s.mKeyFlag == 0 {
+			return false
+		}
+		return true
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	res, err := s.dba.Has(keyBuf)
+	if err != nil {
+		return false
+	}
+	if res == false {
+		s.mKeyFlag = 0
+	} else {
+		s.mKeyFlag = 1
+	}
+	return res
+}
+
+func (s *SoTrxNonceWrap) MustExist(errMsgs ...interface{}) *SoTrxNonceWrap {
+	if !s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.MustExist: %v not found", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) MustNotExist(errMsgs ...interface{}) *SoTrxNonceWrap {
+	if s.CheckExist() {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.MustNotExist: %v already exists", s.mainKey), errMsgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) initWatcherFlag() {
+	if s.watcherFlag == nil {
+		s.watcherFlag = new(TrxNonceWatcherFlag)
+		*(s.watcherFlag) = TrxNonceWatcherFlagOfDb(s.dba.ServiceId())
+	}
+}
+
+func (s *SoTrxNonceWrap) create(f func(tInfo *SoTrxNonce)) error {
+	if s.dba == nil {
+		return errors.New("the db is nil")
+	}
+	if s.mainKey == nil {
+		return errors.New("the main key is nil")
+	}
+	val := &SoTrxNonce{}
+	f(val)
+	if val.Id == nil {
+		val.Id = s.mainKey
+	}
+	if s.CheckExist() {
+		return errors.New("the main key is already exist")
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return err
+
+	}
+
+	buf, err := proto.Marshal(val)
+	if err != nil {
+		return err
+	}
+	err = s.dba.Put(keyBuf, buf)
+	if err != nil {
+		return err
+	}
+
+	// update srt list keys
+	if err = s.insertAllSortKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	//update unique list
+	if sucNames, err := s.insertAllUniKeys(val); err != nil {
+		s.delAllSortKeys(false, val)
+		s.delUniKeysWithNames(sucNames, val)
+		s.dba.Delete(keyBuf)
+		return err
+	}
+
+	s.mKeyFlag = 1
+
+	// call watchers
+	s.initWatcherFlag()
+	if s.watcherFlag.AnyWatcher {
+		ReportTableRecordInsert(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, val)
+	}
+
+	return nil
+}
+
+func (s *SoTrxNonceWrap) Create(f func(tInfo *SoTrxNonce), errArgs ...interface{}) *SoTrxNonceWrap {
+	err := s.create(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Errorf("SoTrxNonceWrap.Create failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) getMainKeyBuf() ([]byte, error) {
+	if s.mainKey == nil {
+		return nil, errors.New("the main key is nil")
+	}
+	if s.mBuf == nil {
+		var err error = nil
+		s.mBuf, err = kope.Encode(s.mainKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.mBuf, nil
+}
+
+func (s *SoTrxNonceWrap) modify(f func(tInfo *SoTrxNonce)) error {
+	if !s.CheckExist() {
+		return errors.New("the SoTrxNonce table does not exist. Please create a table first")
+	}
+	oriTable := s.getTrxNonce()
+	if oriTable == nil {
+		return errors.New("fail to get origin table SoTrxNonce")
+	}
+
+	curTable := s.getTrxNonce()
+	if curTable == nil {
+		return errors.New("fail to create current table SoTrxNonce")
+	}
+	f(curTable)
+
+	//the main key is not support modify
+	if !reflect.DeepEqual(curTable.Id, oriTable.Id) {
+		return errors.New("primary key does not support modification")
+	}
+
+	s.initWatcherFlag()
+	modifiedFields, hasWatcher, err := s.getModifiedFields(oriTable, curTable)
+	if err != nil {
+		return err
+	}
+
+	if modifiedFields == nil || len(modifiedFields) < 1 {
+		return nil
+	}
+
+	//check whether modify sort and unique field to nil
+	err = s.checkSortAndUniFieldValidity(curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//check unique
+	err = s.handleFieldMd(FieldMdHandleTypeCheck, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//delete sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeDel, oriTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	//update table
+	err = s.updateTrxNonce(curTable)
+	if err != nil {
+		return err
+	}
+
+	//insert sort and unique key
+	err = s.handleFieldMd(FieldMdHandleTypeInsert, curTable, modifiedFields)
+	if err != nil {
+		return err
+	}
+
+	// call watchers
+	if hasWatcher {
+		ReportTableRecordUpdate(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oriTable, curTable, modifiedFields)
+	}
+
+	return nil
+
+}
+
+func (s *SoTrxNonceWrap) Modify(f func(tInfo *SoTrxNonce), errArgs ...interface{}) *SoTrxNonceWrap {
+	err := s.modify(f)
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.Modify failed: %s", err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) SetExpiration(p *prototype.TimePointSec, errArgs ...interface{}) *SoTrxNonceWrap {
+	err := s.modify(func(r *SoTrxNonce) {
+		r.Expiration = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.SetExpiration( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) SetTrxId(p *prototype.Sha256, errArgs ...interface{}) *SoTrxNonceWrap {
+	err := s.modify(func(r *SoTrxNonce) {
+		r.TrxId = p
+	})
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.SetTrxId( %v ) failed: %s", p, err.Error()), errArgs...))
+	}
+	return s
+}
+
+func (s *SoTrxNonceWrap) checkSortAndUniFieldValidity(curTable *SoTrxNonce, fields map[string]bool) error {
+	if curTable != nil && fields != nil && len(fields) > 0 {
+
+		if fields["Expiration"] && curTable.Expiration == nil {
+			return errors.New("sort field Expiration can't be modified to nil")
+		}
+
+	}
+	return nil
+}
+
+//Get all the modified fields in the table
+func (s *SoTrxNonceWrap) getModifiedFields(oriTable *SoTrxNonce, curTable *SoTrxNonce) (map[string]bool, bool, error) {
+	if oriTable == nil {
+		return nil, false, errors.New("table info is nil, can't get modified fields")
+	}
+	hasWatcher := false
+	fields := make(map[string]bool)
+
+	if !reflect.DeepEqual(oriTable.Expiration, curTable.Expiration) {
+		fields["Expiration"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasExpirationWatcher
+	}
+
+	if !reflect.DeepEqual(oriTable.TrxId, curTable.TrxId) {
+		fields["TrxId"] = true
+		hasWatcher = hasWatcher || s.watcherFlag.HasTrxIdWatcher
+	}
+
+	hasWatcher = hasWatcher || s.watcherFlag.WholeWatcher
+	return fields, hasWatcher, nil
+}
+
+func (s *SoTrxNonceWrap) handleFieldMd(t FieldMdHandleType, so *SoTrxNonce, fields map[string]bool) error {
+	if so == nil {
+		return errors.New("fail to modify empty table")
+	}
+
+	//there is no field need to modify
+	if fields == nil || len(fields) < 1 {
+		return nil
+	}
+
+	errStr := ""
+
+	if fields["Expiration"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldExpiration(so.Expiration, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "Expiration")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldExpiration(so.Expiration, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "Expiration")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldExpiration(so.Expiration, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "Expiration")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	if fields["TrxId"] {
+		res := true
+		if t == FieldMdHandleTypeCheck {
+			res = s.mdFieldTrxId(so.TrxId, true, false, false, so)
+			errStr = fmt.Sprintf("fail to modify exist value of %v", "TrxId")
+		} else if t == FieldMdHandleTypeDel {
+			res = s.mdFieldTrxId(so.TrxId, false, true, false, so)
+			errStr = fmt.Sprintf("fail to delete  sort or unique field  %v", "TrxId")
+		} else if t == FieldMdHandleTypeInsert {
+			res = s.mdFieldTrxId(so.TrxId, false, false, true, so)
+			errStr = fmt.Sprintf("fail to insert  sort or unique field  %v", "TrxId")
+		}
+		if !res {
+			return errors.New(errStr)
+		}
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert ///////////////
+
+func (s *SoTrxNonceWrap) delSortKeyExpiration(sa *SoTrxNonce) bool {
+	if s.dba == nil || s.mainKey == nil {
+		return false
+	}
+	val := SoListTrxNonceByExpiration{}
+	if sa == nil {
+		val.Expiration = s.GetExpiration()
+		val.Id = s.mainKey
+
+	} else {
+		val.Expiration = sa.Expiration
+		val.Id = sa.Id
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Delete(subBuf)
+	return ordErr == nil
+}
+
+func (s *SoTrxNonceWrap) insertSortKeyExpiration(sa *SoTrxNonce) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	val := SoListTrxNonceByExpiration{}
+	val.Id = sa.Id
+	val.Expiration = sa.Expiration
+	buf, err := proto.Marshal(&val)
+	if err != nil {
+		return false
+	}
+	subBuf, err := val.OpeEncode()
+	if err != nil {
+		return false
+	}
+	ordErr := s.dba.Put(subBuf, buf)
+	return ordErr == nil
+}
+
+func (s *SoTrxNonceWrap) delAllSortKeys(br bool, val *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delSortKeyExpiration(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoTrxNonceWrap) insertAllSortKeys(val *SoTrxNonce) error {
+	if s.dba == nil {
+		return errors.New("insert sort Field fail,the db is nil ")
+	}
+	if val == nil {
+		return errors.New("insert sort Field fail,get the SoTrxNonce fail ")
+	}
+	if !s.insertSortKeyExpiration(val) {
+		return errors.New("insert sort Field Expiration fail while insert table ")
+	}
+
+	return nil
+}
+
+////////////// SECTION LKeys delete/insert //////////////
+
+func (s *SoTrxNonceWrap) removeTrxNonce() error {
+	if s.dba == nil {
+		return errors.New("database is nil")
+	}
+
+	s.initWatcherFlag()
+
+	var oldVal *SoTrxNonce
+	if s.watcherFlag.AnyWatcher {
+		oldVal = s.getTrxNonce()
+	}
+
+	//delete sort list key
+	if res := s.delAllSortKeys(true, nil); !res {
+		return errors.New("delAllSortKeys failed")
+	}
+
+	//delete unique list
+	if res := s.delAllUniKeys(true, nil); !res {
+		return errors.New("delAllUniKeys failed")
+	}
+
+	//delete table
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return fmt.Errorf("encodeMainKey failed: %s", err.Error())
+	}
+	err = s.dba.Delete(key)
+	if err == nil {
+		s.mKeyBuf = nil
+		s.mKeyFlag = -1
+
+		// call watchers
+		if s.watcherFlag.AnyWatcher && oldVal != nil {
+			ReportTableRecordDelete(s.dba.ServiceId(), s.dba.BranchId(), s.mainKey, oldVal)
+		}
+		return nil
+	} else {
+		return fmt.Errorf("database.Delete failed: %s", err.Error())
+	}
+}
+
+func (s *SoTrxNonceWrap) RemoveTrxNonce(errMsgs ...interface{}) *SoTrxNonceWrap {
+	err := s.removeTrxNonce()
+	if err != nil {
+		panic(bindErrorInfo(fmt.Sprintf("SoTrxNonceWrap.RemoveTrxNonce failed: %s", err.Error()), errMsgs...))
+	}
+	return s
+}
+
+////////////// SECTION Members Get/Modify ///////////////
+
+func (s *SoTrxNonceWrap) GetExpiration() *prototype.TimePointSec {
+	res := true
+	msg := &SoTrxNonce{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Expiration
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Expiration
+}
+
+func (s *SoTrxNonceWrap) mdFieldExpiration(p *prototype.TimePointSec, isCheck bool, isDel bool, isInsert bool,
+	so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkExpirationIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldExpiration(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldExpiration(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoTrxNonceWrap) delFieldExpiration(so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.delSortKeyExpiration(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoTrxNonceWrap) insertFieldExpiration(so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if !s.insertSortKeyExpiration(so) {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoTrxNonceWrap) checkExpirationIsMetMdCondition(p *prototype.TimePointSec) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoTrxNonceWrap) GetId() *prototype.TrxNonceId {
+	res := true
+	msg := &SoTrxNonce{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.Id
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.Id
+}
+
+func (s *SoTrxNonceWrap) GetTrxId() *prototype.Sha256 {
+	res := true
+	msg := &SoTrxNonce{}
+	if s.dba == nil {
+		res = false
+	} else {
+		key, err := s.encodeMainKey()
+		if err != nil {
+			res = false
+		} else {
+			buf, err := s.dba.Get(key)
+			if err != nil {
+				res = false
+			}
+			err = proto.Unmarshal(buf, msg)
+			if err != nil {
+				res = false
+			} else {
+				return msg.TrxId
+			}
+		}
+	}
+	if !res {
+		return nil
+
+	}
+	return msg.TrxId
+}
+
+func (s *SoTrxNonceWrap) mdFieldTrxId(p *prototype.Sha256, isCheck bool, isDel bool, isInsert bool,
+	so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	if isCheck {
+		res := s.checkTrxIdIsMetMdCondition(p)
+		if !res {
+			return false
+		}
+	}
+
+	if isDel {
+		res := s.delFieldTrxId(so)
+		if !res {
+			return false
+		}
+	}
+
+	if isInsert {
+		res := s.insertFieldTrxId(so)
+		if !res {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SoTrxNonceWrap) delFieldTrxId(so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoTrxNonceWrap) insertFieldTrxId(so *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+func (s *SoTrxNonceWrap) checkTrxIdIsMetMdCondition(p *prototype.Sha256) bool {
+	if s.dba == nil {
+		return false
+	}
+
+	return true
+}
+
+////////////// SECTION List Keys ///////////////
+type STrxNonceExpirationWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewTrxNonceExpirationWrap(db iservices.IDatabaseRW) *STrxNonceExpirationWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := STrxNonceExpirationWrap{Dba: db}
+	return &wrap
+}
+
+func (s *STrxNonceExpirationWrap) GetMainVal(val []byte) *prototype.TrxNonceId {
+	res := &SoListTrxNonceByExpiration{}
+	err := proto.Unmarshal(val, res)
+
+	if err != nil {
+		return nil
+	}
+	return res.Id
+
+}
+
+func (s *STrxNonceExpirationWrap) GetSubVal(val []byte) *prototype.TimePointSec {
+	res := &SoListTrxNonceByExpiration{}
+	err := proto.Unmarshal(val, res)
+	if err != nil {
+		return nil
+	}
+	return res.Expiration
+
+}
+
+func (m *SoListTrxNonceByExpiration) OpeEncode() ([]byte, error) {
+	pre := TrxNonceExpirationTable
+	sub := m.Expiration
+	if sub == nil {
+		return nil, errors.New("the pro Expiration is nil")
+	}
+	sub1 := m.Id
+	if sub1 == nil {
+		return nil, errors.New("the mainkey Id is nil")
+	}
+	kList := []interface{}{pre, sub, sub1}
+	kBuf, cErr := kope.EncodeSlice(kList)
+	return kBuf, cErr
+}
+
+//Query srt by order
+//
+//start = nil  end = nil (query the db from start to end)
+//start = nil (query from start the db)
+//end = nil (query to the end of db)
+//
+//f: callback for each traversal , primary 、sub key、idx(the number of times it has been iterated)
+//as arguments to the callback function
+//if the return value of f is true,continue iterating until the end iteration;
+//otherwise stop iteration immediately
+//
+//lastMainKey: the main key of the last one of last page
+//lastSubVal: the value  of the last one of last page
+//
+func (s *STrxNonceExpirationWrap) ForEachByOrder(start *prototype.TimePointSec, end *prototype.TimePointSec, lastMainKey *prototype.TrxNonceId,
+	lastSubVal *prototype.TimePointSec, f func(mVal *prototype.TrxNonceId, sVal *prototype.TimePointSec, idx uint32) bool) error {
+	if s.Dba == nil {
+		return errors.New("the db is nil")
+	}
+	if (lastSubVal != nil && lastMainKey == nil) || (lastSubVal == nil && lastMainKey != nil) {
+		return errors.New("last query param error")
+	}
+	if f == nil {
+		return nil
+	}
+	pre := TrxNonceExpirationTable
+	skeyList := []interface{}{pre}
+	if start != nil {
+		skeyList = append(skeyList, start)
+		if lastMainKey != nil {
+			skeyList = append(skeyList, lastMainKey, kope.MinimalKey)
+		}
+	} else {
+		if lastMainKey != nil && lastSubVal != nil {
+			skeyList = append(skeyList, lastSubVal, lastMainKey, kope.MinimalKey)
+		}
+		skeyList = append(skeyList, kope.MinimalKey)
+	}
+	sBuf, cErr := kope.EncodeSlice(skeyList)
+	if cErr != nil {
+		return cErr
+	}
+	eKeyList := []interface{}{pre}
+	if end != nil {
+		eKeyList = append(eKeyList, end)
+	} else {
+		eKeyList = append(eKeyList, kope.MaximumKey)
+	}
+	eBuf, cErr := kope.EncodeSlice(eKeyList)
+	if cErr != nil {
+		return cErr
+	}
+	var idx uint32 = 0
+	s.Dba.Iterate(sBuf, eBuf, false, func(key, value []byte) bool {
+		idx++
+		return f(s.GetMainVal(value), s.GetSubVal(value), idx)
+	})
+	return nil
+}
+
+/////////////// SECTION Private function ////////////////
+
+func (s *SoTrxNonceWrap) update(sa *SoTrxNonce) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+	buf, err := proto.Marshal(sa)
+	if err != nil {
+		return false
+	}
+
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(keyBuf, buf) == nil
+}
+
+func (s *SoTrxNonceWrap) getTrxNonce() *SoTrxNonce {
+	if s.dba == nil {
+		return nil
+	}
+	keyBuf, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+	resBuf, err := s.dba.Get(keyBuf)
+
+	if err != nil {
+		return nil
+	}
+
+	res := &SoTrxNonce{}
+	if proto.Unmarshal(resBuf, res) != nil {
+		return nil
+	}
+	return res
+}
+
+func (s *SoTrxNonceWrap) updateTrxNonce(so *SoTrxNonce) error {
+	if s.dba == nil {
+		return errors.New("update fail:the db is nil")
+	}
+
+	if so == nil {
+		return errors.New("update fail: the SoTrxNonce is nil")
+	}
+
+	key, err := s.encodeMainKey()
+	if err != nil {
+		return nil
+	}
+
+	buf, err := proto.Marshal(so)
+	if err != nil {
+		return err
+	}
+
+	err = s.dba.Put(key, buf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SoTrxNonceWrap) encodeMainKey() ([]byte, error) {
+	if s.mKeyBuf != nil {
+		return s.mKeyBuf, nil
+	}
+	pre := TrxNonceIdRow
+	sub := s.mainKey
+	if sub == nil {
+		return nil, errors.New("the mainKey is nil")
+	}
+	preBuf, err := kope.Encode(pre)
+	if err != nil {
+		return nil, err
+	}
+	mBuf, err := s.getMainKeyBuf()
+	if err != nil {
+		return nil, err
+	}
+	list := make([][]byte, 2)
+	list[0] = preBuf
+	list[1] = mBuf
+	s.mKeyBuf = kope.PackList(list)
+	return s.mKeyBuf, nil
+}
+
+////////////// Unique Query delete/insert/query ///////////////
+
+func (s *SoTrxNonceWrap) delAllUniKeys(br bool, val *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if !s.delUniKeyId(val) {
+		if br {
+			return false
+		} else {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoTrxNonceWrap) delUniKeysWithNames(names map[string]string, val *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+	res := true
+	if len(names["Id"]) > 0 {
+		if !s.delUniKeyId(val) {
+			res = false
+		}
+	}
+
+	return res
+}
+
+func (s *SoTrxNonceWrap) insertAllUniKeys(val *SoTrxNonce) (map[string]string, error) {
+	if s.dba == nil {
+		return nil, errors.New("insert uniuqe Field fail,the db is nil ")
+	}
+	if val == nil {
+		return nil, errors.New("insert uniuqe Field fail,get the SoTrxNonce fail ")
+	}
+	sucFields := map[string]string{}
+	if !s.insertUniKeyId(val) {
+		return sucFields, errors.New("insert unique Field Id fail while insert table ")
+	}
+	sucFields["Id"] = "Id"
+
+	return sucFields, nil
+}
+
+func (s *SoTrxNonceWrap) delUniKeyId(sa *SoTrxNonce) bool {
+	if s.dba == nil {
+		return false
+	}
+	pre := TrxNonceIdUniTable
+	kList := []interface{}{pre}
+	if sa != nil {
+		if sa.Id == nil {
+			return false
+		}
+
+		sub := sa.Id
+		kList = append(kList, sub)
+	} else {
+		sub := s.GetId()
+		if sub == nil {
+			return true
+		}
+
+		kList = append(kList, sub)
+
+	}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	return s.dba.Delete(kBuf) == nil
+}
+
+func (s *SoTrxNonceWrap) insertUniKeyId(sa *SoTrxNonce) bool {
+	if s.dba == nil || sa == nil {
+		return false
+	}
+
+	pre := TrxNonceIdUniTable
+	sub := sa.Id
+	kList := []interface{}{pre, sub}
+	kBuf, err := kope.EncodeSlice(kList)
+	if err != nil {
+		return false
+	}
+	res, err := s.dba.Has(kBuf)
+	if err == nil && res == true {
+		//the unique key is already exist
+		return false
+	}
+	val := SoUniqueTrxNonceById{}
+	val.Id = sa.Id
+
+	buf, err := proto.Marshal(&val)
+
+	if err != nil {
+		return false
+	}
+
+	return s.dba.Put(kBuf, buf) == nil
+
+}
+
+type UniTrxNonceIdWrap struct {
+	Dba iservices.IDatabaseRW
+}
+
+func NewUniTrxNonceIdWrap(db iservices.IDatabaseRW) *UniTrxNonceIdWrap {
+	if db == nil {
+		return nil
+	}
+	wrap := UniTrxNonceIdWrap{Dba: db}
+	return &wrap
+}
+
+func (s *UniTrxNonceIdWrap) UniQueryId(start *prototype.TrxNonceId) *SoTrxNonceWrap {
+	if start == nil || s.Dba == nil {
+		return nil
+	}
+	pre := TrxNonceIdUniTable
+	kList := []interface{}{pre, start}
+	bufStartkey, err := kope.EncodeSlice(kList)
+	val, err := s.Dba.Get(bufStartkey)
+	if err == nil {
+		res := &SoUniqueTrxNonceById{}
+		rErr := proto.Unmarshal(val, res)
+		if rErr == nil {
+			wrap := NewSoTrxNonceWrap(s.Dba, res.Id)
+
+			return wrap
+		}
+	}
+	return nil
+}
+
+////////////// SECTION Watchers ///////////////
+
+type TrxNonceWatcherFlag struct {
+	HasExpirationWatcher bool
+
+	HasTrxIdWatcher bool
+
+	WholeWatcher bool
+	AnyWatcher   bool
+}
+
+var (
+	TrxNonceTable = &TableInfo{
+		Name:    "TrxNonce",
+		Primary: "Id",
+		Record:  reflect.TypeOf((*SoTrxNonce)(nil)).Elem(),
+	}
+	TrxNonceWatcherFlags     = make(map[uint32]TrxNonceWatcherFlag)
+	TrxNonceWatcherFlagsLock sync.RWMutex
+)
+
+func TrxNonceWatcherFlagOfDb(dbSvcId uint32) TrxNonceWatcherFlag {
+	TrxNonceWatcherFlagsLock.RLock()
+	defer TrxNonceWatcherFlagsLock.RUnlock()
+	return TrxNonceWatcherFlags[dbSvcId]
+}
+
+func TrxNonceRecordWatcherChanged(dbSvcId uint32) {
+	var flag TrxNonceWatcherFlag
+	flag.WholeWatcher = HasTableRecordWatcher(dbSvcId, TrxNonceTable.Record, "")
+	flag.AnyWatcher = flag.WholeWatcher
+
+	flag.HasExpirationWatcher = HasTableRecordWatcher(dbSvcId, TrxNonceTable.Record, "Expiration")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasExpirationWatcher
+
+	flag.HasTrxIdWatcher = HasTableRecordWatcher(dbSvcId, TrxNonceTable.Record, "TrxId")
+	flag.AnyWatcher = flag.AnyWatcher || flag.HasTrxIdWatcher
+
+	TrxNonceWatcherFlagsLock.Lock()
+	TrxNonceWatcherFlags[dbSvcId] = flag
+	TrxNonceWatcherFlagsLock.Unlock()
+}
+
+////////////// SECTION Json query ///////////////
+
+func TrxNonceQuery(db iservices.IDatabaseRW, keyJson string) (valueJson string, err error) {
+	k := new(prototype.TrxNonceId)
+	d := json.NewDecoder(bytes.NewReader([]byte(keyJson)))
+	d.UseNumber()
+	if err = d.Decode(k); err != nil {
+		return
+	}
+	if v := NewSoTrxNonceWrap(db, k).getTrxNonce(); v == nil {
+		err = errors.New("not found")
+	} else {
+		var jbytes []byte
+		if jbytes, err = json.Marshal(v); err == nil {
+			valueJson = string(jbytes)
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterTableWatcherChangedCallback(TrxNonceTable.Record, TrxNonceRecordWatcherChanged)
+	RegisterTableJsonQuery("TrxNonce", TrxNonceQuery)
+}
@@ -230,6 +230,32 @@ func (p *TrxContext) Log(msg string) {
 	p.output.VmConsole += msg
 }
 
+func (p *TrxContext) EmitEvent(owner, contract, name, data string) {
+	cid := &prototype.ContractId{Owner: &prototype.AccountName{Value: owner}, Cname: contract}
+	c := table.NewSoContractWrap(p.db, cid)
+	seq := c.GetEventCount() + 1
+	c.SetEventCount(seq)
+
+	// events are indexed by the block containing current transaction.
+	block := p.GetProps().HeadBlockNumber + 1
+	trxId, _ := p.Wrapper.SigTrx.Id()
+	table.NewSoContractEventWrap(p.db, &prototype.ContractEventId{Contract: cid, Block: block, Seq: seq}).
+		Create(func(tInfo *table.SoContractEvent) {
+			tInfo.NameOrder = &prototype.ContractEventNameOrder{Contract: cid, Name: name, Block: block, Seq: seq}
+			tInfo.TrxId = trxId
+			tInfo.Name = name
+			tInfo.Data = data
+		})
+
+	p.output.Events = append(p.output.Events, &prototype.ContractEvent{
+		Owner:    &prototype.AccountName{Value: owner},
+		Contract: contract,
+		Name:     name,
+		Data:     data,
+		Seq:      seq,
+	})
+}
+
 func (p *TrxContext) RequireAuth(name string) (err error) {
 	if name != p.signer {
 		return fmt.Errorf("requireAuth('%s') failed, signed by '%s'", name, p.signer)
@@ -382,8 +408,8 @@ func (p *TrxContext) StateChangeContext() *blocklog.StateChangeContext {
 	return p.stateChangeCtx
 }
 
-func (p *TrxContext) HardFork() uint64 {
-	return p.control.HardFork()
+func (p *TrxContext) FeatureActive(id string) bool {
+	return p.control.featureActive(p.db, id)
 }
 
 func (p *TrxContext) NewRecordID() (rid uint64) {
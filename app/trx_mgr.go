@@ -22,10 +22,14 @@ type TrxEntry struct {
 	chainId   prototype.ChainId	                    // id of block chain to which the transaction is sent
 	result    *prototype.TransactionWrapperWithInfo	// process result involving the transaction
 	trxId     string								// transaction id
+	digest    string								// hash signed by the transaction's signatures
 	size      int									// transaction size
 	signer    string								// requested account to sign the transaction
-	signerKey *prototype.PublicKeyType				// the actual public key which signed the transaction
+	signerKeys []*prototype.PublicKeyType			// the actual public keys which signed the transaction
+	permission prototype.Permission					// permission level required by operations of the transaction
 	callback  TrxCallback							// callback function
+	priority  uint64								// priority in the waiting pool
+	seq       uint64								// arrival sequence number in the waiting pool
 }
 
 // NewTrxMgrEntry creates an instance of TrxEntry.
@@ -68,6 +72,11 @@ func (e *TrxEntry) InitCheck() error {
 	} else {
 		e.trxId = string(trxId.Hash)
 	}
+	if digest, err := trx.Digest(e.chainId); err != nil {
+		return e.SetError(err)
+	} else {
+		e.digest = string(digest)
+	}
 
 	// transaction size limit check
 	e.size = proto.Size(trx)
@@ -84,11 +93,12 @@ func (e *TrxEntry) InitCheck() error {
 		}
 	}
 	e.signer = creator
-	// recover the signing public key from signature
-	if signKey, err := trx.ExportPubKeys(e.chainId); err != nil {
+	e.permission = trx.Trx.RequiredPermission()
+	// recover the signing public keys from signatures
+	if signKeys, err := trx.ExportAllPubKeys(e.chainId); err != nil {
 		return e.SetError(fmt.Errorf("cannot export signing key: %s", err.Error()))
 	} else {
-		e.signerKey = signKey
+		e.signerKeys = signKeys
 	}
 	return nil
 }
@@ -113,22 +123,64 @@ func (e *TrxEntry) CheckTapos(checker *TaposChecker) error {
 	return nil
 }
 
-// CheckSignerKey checks if the transaction is signed by correct public key.
-func (e *TrxEntry) CheckSignerKey(fetcher *AuthFetcher) error {
-	if err := fetcher.CheckPublicKey(e.signer, e.signerKey); err != nil {
+// CheckSignerKey checks if the transaction is signed by enough keys of the signer's authority.
+// Without permission levels, every transaction requires the owner permission.
+func (e *TrxEntry) CheckSignerKey(fetcher *AuthFetcher, permissionLevels bool) error {
+	permission := prototype.PermissionOwner
+	if permissionLevels {
+		permission = e.permission
+	}
+	if err := fetcher.CheckAuthority(e.signer, e.signerKeys, permission); err != nil {
 		return e.SetError(fmt.Errorf("signature failed: %s", err.Error()))
 	}
 	return nil
 }
 
+// CheckCoSignatures checks if the transaction carries co-signatures only when they are allowed.
+func (e *TrxEntry) CheckCoSignatures(allowed bool) error {
+	if !allowed && len(e.result.SigTrx.GetSignatures()) > 0 {
+		return e.SetError(errors.New("co-signatures not allowed before multi-signature activated"))
+	}
+	return nil
+}
+
 // CheckInBlockTrxs checks if the transaction is a duplicate of any old transaction.
 func (e *TrxEntry) CheckInBlockTrxs(checker *InBlockTrxChecker) error {
-	if checker.Has(e.result.SigTrx) {
+	if checker.Has(e.result.SigTrx, e.digest) {
 		return e.SetError(errors.New("found duplicate in-block trx"))
 	}
 	return nil
 }
 
+// CheckNonceAllowed checks if the transaction neither sets a nonce nor cancels, unless nonces are allowed.
+func (e *TrxEntry) CheckNonceAllowed(allowed bool) error {
+	trx := e.result.SigTrx.GetTrx()
+	if !allowed && (trx.GetNonce() != 0 || trx.GetCancel()) {
+		return e.SetError(errors.New("nonces and cancellations not allowed before trx nonce activated"))
+	}
+	return nil
+}
+
+// CheckNonceUnused checks if the transaction's nonce is not reserved by any unexpired in-block transaction.
+func (e *TrxEntry) CheckNonceUnused(db iservices.IDatabaseRW, blockTime uint32) error {
+	if nonceReserved(db, e.signer, e.result.SigTrx.GetTrx().GetNonce(), blockTime) {
+		return e.SetError(fmt.Errorf("nonce %s already used by an in-block trx", e.nonceKey()))
+	}
+	return nil
+}
+
+// nonceKey returns the key of the transaction's signer and nonce, or an empty string if the nonce is not set.
+func (e *TrxEntry) nonceKey() string {
+	return trxNonceKey(e.signer, e.result.SigTrx.GetTrx().GetNonce())
+}
+
+func trxNonceKey(signer string, nonce uint64) string {
+	if nonce == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", signer, nonce)
+}
+
 func (e *TrxEntry) GetTrxResult() *prototype.TransactionWrapperWithInfo {
 	return e.result
 }
@@ -138,15 +190,22 @@ func (e *TrxEntry) GetTrxSize() int {
 func (e *TrxEntry) GetTrxSigner() string {
 	return e.signer
 }
-func (e *TrxEntry) GetTrxSigningKey() *prototype.PublicKeyType {
-	return e.signerKey
+func (e *TrxEntry) GetTrxSigningKeys() []*prototype.PublicKeyType {
+	return e.signerKeys
 }
 
 const (
 	// maximum count of transactions that are waiting to be packed to blocks.
-	// if this limit is reached, any incoming transaction will be refused directly.
+	// if this limit is reached, waiting transactions of lowest priorities will be evicted for incoming ones.
 	sMaxWaitingCount  = constants.TrxMaxExpirationTime * 2000
 
+	// maximum total size of transactions that are waiting to be packed to blocks.
+	// if this limit is reached, waiting transactions of lowest priorities will be evicted for incoming ones.
+	sMaxWaitingSize = 64 * 1024 * 1024
+
+	// maximum count of waiting transactions signed by a single account.
+	sMaxWaitingPerSigner = sMaxWaitingCount / 100
+
 	// threshold over which cleanings are necessary
 	sWaitingCountWaterMark  = sMaxWaitingCount / 10
 
@@ -157,6 +216,16 @@ const (
 	sShrinkCountWaterMark = 100000
 )
 
+// trxNonce records the transaction owning a signer's nonce.
+type trxNonce struct {
+	trxId      string			// id of the owner transaction
+	expiration uint32			// expiration of the owner transaction
+	packed     bool				// whether the owner transaction was packed into a block
+}
+
+// TrxFeatureFunc returns whether given feature is active.
+type TrxFeatureFunc func(id string) bool
+
 // ITrxMgrPlugin is an interface of manager plugins.
 type ITrxMgrPlugin interface {
 	BlockApplied(b *prototype.SignedBlock)				// called once after a block is successfully applied.
@@ -170,7 +239,7 @@ type TrxMgr struct {
 	db 				iservices.IDatabaseRW				// the database
 	log             *logrus.Logger						// the logger
 	headTime		uint32								// timestamp of head block, in seconds
-	waiting 		map[string]*TrxEntry				// transactions waiting to be packed to blocks, trxId -> entry
+	waiting 		*waitingPool						// transactions waiting to be packed to blocks, in priority order
 	waitingLock 	sync.RWMutex						// lock of waiting transactions
 	fetched 		map[string]*TrxEntry				// transactions being packed to a block, trxId -> entry
 	fetchedLock 	sync.RWMutex						// lock of fetched transactions
@@ -180,25 +249,35 @@ type TrxMgr struct {
 	plugins         []ITrxMgrPlugin						// manager plugins, consisting of above checkers
 	lastCleanTime	time.Time							// last time we clean up expired waiting transactions
 	shrinkCounter   uint64								// a counter to determine when to shrink pools
+	priority        TrxPriorityFunc						// priority of incoming transactions
+	features        TrxFeatureFunc						// activation status of features
+	evicted         uint64								// number of waiting transactions evicted by higher priority ones
+	rejected        uint64								// number of transactions refused by the waiting pool
+	nonces          map[string]*trxNonce				// latest transactions of signer nonces, signer/nonce -> owner
 }
 
 // NewTrxMgr creates an instance of TrxMgr.
-func NewTrxMgr(chainId prototype.ChainId, db iservices.IDatabaseRW, logger *logrus.Logger, lastBlock, commitBlock uint64) *TrxMgr {
+// Waiting transactions are ranked by @priority of their signers, and then their arrival time.
+// Protocol changes of transaction checks are enabled according to @features.
+func NewTrxMgr(chainId prototype.ChainId, db iservices.IDatabaseRW, logger *logrus.Logger, lastBlock, commitBlock uint64, priority TrxPriorityFunc, features TrxFeatureFunc) *TrxMgr {
 	auth := NewAuthFetcher(db, logger, lastBlock, commitBlock)
 	tapos := NewTaposChecker(db, logger, lastBlock)
-	history := NewInBlockTrxChecker(db, logger, lastBlock)
+	history := NewInBlockTrxChecker(chainId, db, logger, lastBlock, features)
 	return &TrxMgr{
 		chainId:  chainId,
 		db:       db,
 		log:      logger,
 		headTime: (&DynamicGlobalPropsRW{db:db}).GetProps().GetTime().GetUtcSeconds(),
-		waiting:  make(map[string]*TrxEntry),
+		waiting:  newWaitingPool(),
+		nonces:   make(map[string]*trxNonce),
 		fetched:  make(map[string]*TrxEntry),
 		auth: auth,
 		tapos: tapos,
 		history: history,
 		plugins: []ITrxMgrPlugin{ auth, tapos, history },
 		lastCleanTime: time.Now(),
+		priority: priority,
+		features: features,
 	}
 }
 
@@ -227,6 +306,10 @@ func (m *TrxMgr) AddTrx(trx *prototype.SignedTransaction, callback TrxCallback)
 			// deliver if failed
 			m.deliverEntry(entry)
 		} else {
+			// rank the transaction by its signer
+			if m.priority != nil {
+				entry.priority = m.priority(entry.signer)
+			}
 			// if passed, try adding it to the waiting pool
 			m.waitingLock.Lock()
 			m.fetchedLock.RLock()
@@ -249,10 +332,63 @@ func (m *TrxMgr) AddTrx(trx *prototype.SignedTransaction, callback TrxCallback)
 func (m *TrxMgr) WaitingCount() int {
 	m.waitingLock.RLock()
 	defer m.waitingLock.RUnlock()
-	return len(m.waiting)
+	return m.waiting.Len()
+}
+
+// GetPendingTrxStats returns statistics of the waiting pool, including at most topSigners busiest signers.
+func (m *TrxMgr) GetPendingTrxStats(topSigners int) *iservices.PendingTrxStats {
+	m.waitingLock.RLock()
+	defer m.waitingLock.RUnlock()
+	m.fetchedLock.RLock()
+	defer m.fetchedLock.RUnlock()
+
+	return &iservices.PendingTrxStats{
+		WaitingCount:        m.waiting.Len(),
+		WaitingSize:         m.waiting.Size(),
+		FetchedCount:        len(m.fetched),
+		MaxWaitingCount:     sMaxWaitingCount,
+		MaxWaitingSize:      sMaxWaitingSize,
+		MaxWaitingPerSigner: sMaxWaitingPerSigner,
+		Evicted:             atomic.LoadUint64(&m.evicted),
+		Rejected:            atomic.LoadUint64(&m.rejected),
+		TopSigners:          m.waiting.TopSigners(topSigners),
+	}
+}
+
+// LookupTrxs looks up transactions of given short ids in waiting and fetched pools.
+// Short ids are salted per block, so both pools are scanned computing short ids by key.
+// The result has the same length as shortIds, and transactions not found are nil.
+// If a short id matches multiple transactions, any one of them may be returned.
+func (m *TrxMgr) LookupTrxs(key prototype.ShortTrxIdKey, shortIds []uint64) []*prototype.SignedTransaction {
+	result := make([]*prototype.SignedTransaction, len(shortIds))
+	wanted := make(map[uint64][]int)
+	for i, id := range shortIds {
+		wanted[id] = append(wanted[id], i)
+	}
+	if len(wanted) == 0 {
+		return result
+	}
+	match := func(e *TrxEntry) {
+		for _, i := range wanted[key.ShortTrxId([]byte(e.trxId))] {
+			if result[i] == nil {
+				result[i] = e.result.SigTrx
+			}
+		}
+	}
+
+	m.waitingLock.RLock()
+	m.waiting.ForEach(match)
+	m.waitingLock.RUnlock()
+
+	m.fetchedLock.RLock()
+	for _, e := range m.fetched {
+		match(e)
+	}
+	m.fetchedLock.RUnlock()
+	return result
 }
 
-// FetchTrx fetches a batch of transactions from waiting pool.
+// FetchTrx fetches a batch of transactions from waiting pool, in priority order.
 // Block producer should call FetchTrx to collect transactions of new blocks.
 func (m *TrxMgr) FetchTrx(blockTime uint32, maxCount, maxSize int) (entries []*TrxEntry) {
 	m.waitingLock.Lock()
@@ -262,8 +398,8 @@ func (m *TrxMgr) FetchTrx(blockTime uint32, maxCount, maxSize int) (entries []*T
 	defer m.fetchedLock.Unlock()
 
 	counter, size := 0, 0
-	// traverse the waiting pool
-	for s, e := range m.waiting {
+	// traverse the waiting pool from the highest rank
+	for e := m.waiting.Best(); e != nil; e = m.waiting.Best() {
 		// check count limit
 		if maxCount > 0 && counter >= maxCount {
 			break
@@ -283,12 +419,12 @@ func (m *TrxMgr) FetchTrx(blockTime uint32, maxCount, maxSize int) (entries []*T
 			// if passed, pick it
 			entries = append(entries, e)
 			// add it to the fetched pool
-			m.fetched[s] = e
+			m.fetched[e.trxId] = e
 			counter++
 			size += e.size
 		}
 		// remove from waiting pool
-		delete(m.waiting, s)
+		m.waiting.Remove(e.trxId)
 	}
 	return
 }
@@ -347,9 +483,11 @@ func (m *TrxMgr) CheckBlockTrxs(b *prototype.SignedBlock) (entries []*TrxEntry,
 				if ptrx := m.isProcessingTrx(trx); ptrx != nil {
 					needInitCheck = false
 					e.trxId = ptrx.trxId
+					e.digest = ptrx.digest
 					e.size = ptrx.size
 					e.signer = ptrx.signer
-					e.signerKey = ptrx.signerKey
+					e.signerKeys = ptrx.signerKeys
+					e.permission = ptrx.permission
 				}
 				// do initial check if necessary
 				if needInitCheck {
@@ -378,13 +516,19 @@ func (m *TrxMgr) CheckBlockTrxs(b *prototype.SignedBlock) (entries []*TrxEntry,
 		// check duplicate transactions inside the block.
 		// it's a must to prevent malicious block producers from replay attacking.
 		// m.history won't help here coz it updates in block level instead of transaction level.
+		// since multi-signature, transactions of the same digest are duplicates no matter how they were signed.
+		multiSig := m.features(constants.FeatureMultiSig)
 		trxSigs, dupTrx := make(map[string]bool), -1
 		for idx, e := range entries {
-			if trxSigs[e.trxId] {
+			key := e.trxId
+			if multiSig {
+				key = e.digest
+			}
+			if trxSigs[key] {
 				dupTrx = idx
 				break
 			}
-			trxSigs[e.trxId] = true
+			trxSigs[key] = true
 		}
 		if dupTrx >= 0 {
 			entries = nil
@@ -417,11 +561,12 @@ func (m *TrxMgr) BlockApplied(b *prototype.SignedBlock) {
 			m.deliverEntry(e)
 			delete(m.fetched, s)
 		}
-		if e := m.waiting[s]; e != nil {
+		if e := m.waiting.Remove(s); e != nil {
 			m.deliverEntry(e)
-			delete(m.waiting, s)
 		}
+		m.nonceUsed(txw.SigTrx, s)
 	}
+	m.cleanExpiredNonces()
 
 	// clean expired waiting trxs if necessary
 	m.cleanExpiredWaiting()
@@ -466,25 +611,47 @@ func (m *TrxMgr) BlockReverted(blockNum uint64) {
 	m.log.Debugf("TRXMGR: BlockReverted end %d: %v", blockNum, t0)
 }
 
-// addToWaiting adds given transaction entries to the waiting pool, and returns the actual number added.
+// addToWaiting adds given transaction entries to the waiting pool, and returns the actual number accepted.
+// Accepted cancellations are delivered at once instead of being added.
 func (m *TrxMgr) addToWaiting(entries...*TrxEntry) (count int) {
 	// clean expired waiting trxs if necessary
 	m.cleanExpiredWaiting()
 
 	for _, e := range entries {
-		// check the max waiting count limit
-		if len(m.waiting) > sMaxWaitingCount {
-			_ = e.SetError(errors.New("too many waiting trxs"))
+		// check duplication
+		if m.isProcessingNoLock(e.result.SigTrx) != nil || m.isDigestProcessingNoLock(e.digest) {
+			_ = e.SetError(errors.New("trx already in process"))
 			m.deliverEntry(e)
 			continue
 		}
-		// check duplication
-		if m.isProcessingNoLock(e.result.SigTrx) != nil {
-			_ = e.SetError(errors.New("trx already in process"))
+		// replace or cancel the pending transaction of the same signer and nonce
+		if err := m.replaceWaiting(e); err != nil {
+			_ = e.SetError(err)
+			m.deliverEntry(e)
+			continue
+		}
+		// cancellations never wait for packing
+		if e.result.SigTrx.GetTrx().GetCancel() {
+			m.deliverEntry(e)
+			count++
+			continue
+		}
+		// check the per-signer limit
+		if m.waiting.SignerCount(e.signer) >= sMaxWaitingPerSigner {
+			atomic.AddUint64(&m.rejected, 1)
+			_ = e.SetError(fmt.Errorf("too many waiting trxs signed by %s", e.signer))
 			m.deliverEntry(e)
 			continue
 		}
-		m.waiting[e.trxId] = e
+		// make room for the new transaction by evicting lower ranked ones
+		m.waiting.Arrive(e)
+		if !m.makeWaitingRoom(e) {
+			atomic.AddUint64(&m.rejected, 1)
+			_ = e.SetError(errors.New("too many waiting trxs"))
+			m.deliverEntry(e)
+			continue
+		}
+		m.waiting.Add(e)
 		count++
 	}
 
@@ -492,6 +659,81 @@ func (m *TrxMgr) addToWaiting(entries...*TrxEntry) (count int) {
 	return
 }
 
+// replaceWaiting makes given transaction the owner of its signer's nonce, and removes the pending transaction
+// it supersedes from the waiting pool.
+// Among transactions of the same signer and nonce, the one of the latest expiration wins, so that all nodes agree on
+// the same winner no matter in which order they receive them.
+func (m *TrxMgr) replaceWaiting(e *TrxEntry) error {
+	key := e.nonceKey()
+	if len(key) == 0 {
+		return nil
+	}
+	expiration := e.result.SigTrx.GetTrx().GetExpiration().GetUtcSeconds()
+	if n := m.nonces[key]; n != nil {
+		if n.packed {
+			return fmt.Errorf("nonce %s already used by an in-block trx", key)
+		}
+		if expiration <= n.expiration {
+			return fmt.Errorf("nonce %s already used by a trx of later expiration", key)
+		}
+		if m.fetched[n.trxId] != nil {
+			return fmt.Errorf("trx of nonce %s is being packed", key)
+		}
+		if old := m.waiting.Remove(n.trxId); old != nil {
+			_ = old.SetError(fmt.Errorf("trx replaced by %x", []byte(e.trxId)))
+			m.deliverEntry(old)
+		}
+	}
+	m.nonces[key] = &trxNonce{trxId: e.trxId, expiration: expiration}
+	return nil
+}
+
+// nonceUsed marks the nonce of given in-block transaction as used, and drops the waiting transaction competing
+// for the same nonce.
+func (m *TrxMgr) nonceUsed(trx *prototype.SignedTransaction, trxId string) {
+	nonce := trx.GetTrx().GetNonce()
+	if nonce == 0 {
+		return
+	}
+	for signer := range trx.GetOpCreatorsMap() {
+		key := trxNonceKey(signer, nonce)
+		if n := m.nonces[key]; n != nil && n.trxId != trxId {
+			if e := m.waiting.Remove(n.trxId); e != nil {
+				_ = e.SetError(fmt.Errorf("nonce %s already used by an in-block trx", key))
+				m.deliverEntry(e)
+			}
+		}
+		m.nonces[key] = &trxNonce{trxId: trxId, expiration: trx.GetTrx().GetExpiration().GetUtcSeconds(), packed: true}
+	}
+}
+
+// cleanExpiredNonces forgets nonces of expired transactions.
+func (m *TrxMgr) cleanExpiredNonces() {
+	headBlockTime := atomic.LoadUint32(&m.headTime)
+	for k, n := range m.nonces {
+		if n.expiration < headBlockTime {
+			delete(m.nonces, k)
+		}
+	}
+}
+
+// makeWaitingRoom evicts waiting transactions ranked lower than given one until both the count limit and
+// size limit of the waiting pool allow given transaction in.
+// It returns false if the room can't be made.
+func (m *TrxMgr) makeWaitingRoom(e *TrxEntry) bool {
+	for m.waiting.Len() >= sMaxWaitingCount || m.waiting.Size() + e.size > sMaxWaitingSize {
+		worst := m.waiting.Worst()
+		if worst == nil || !(waitingItem{e}).Less(waitingItem{worst}) {
+			return false
+		}
+		m.waiting.Remove(worst.trxId)
+		atomic.AddUint64(&m.evicted, 1)
+		_ = worst.SetError(errors.New("evicted from waiting pool by higher priority trxs"))
+		m.deliverEntry(worst)
+	}
+	return true
+}
+
 // isProcessingTrx is a thread safe version of isProcessingNoLock.
 func (m *TrxMgr) isProcessingTrx(trx *prototype.SignedTransaction) *TrxEntry {
 	m.waitingLock.RLock()
@@ -510,7 +752,7 @@ func (m *TrxMgr) isProcessingNoLock(trx *prototype.SignedTransaction) *TrxEntry
 	}
 	if trxId, err := trx.Id(); err == nil {
 		s := string(trxId.Hash)
-		if e := m.waiting[s]; e != nil {
+		if e := m.waiting.Get(s); e != nil {
 			return e
 		}
 		return m.fetched[s]
@@ -519,6 +761,21 @@ func (m *TrxMgr) isProcessingNoLock(trx *prototype.SignedTransaction) *TrxEntry
 	}
 }
 
+// isDigestProcessingNoLock checks if any transaction of given digest is being processed by TrxMgr.
+// It catches the same transaction signed by another signature set.
+func (m *TrxMgr) isDigestProcessingNoLock(digest string) bool {
+	if m.waiting.GetByDigest(digest) != nil {
+		return true
+	}
+	// the fetched pool holds transactions of at most one block being produced, which is small enough to scan.
+	for _, e := range m.fetched {
+		if e.digest == digest {
+			return true
+		}
+	}
+	return false
+}
+
 // checkTrx does state-dependent checks on given transaction.
 func (m *TrxMgr) checkTrx(e *TrxEntry, blockTime uint32, checkTapos bool) (err error) {
 	if err = e.CheckExpiration(blockTime); err != nil {
@@ -529,12 +786,21 @@ func (m *TrxMgr) checkTrx(e *TrxEntry, blockTime uint32, checkTapos bool) (err e
 			return err
 		}
 	}
-	if err = e.CheckSignerKey(m.auth); err != nil {
+	if err = e.CheckCoSignatures(m.features(constants.FeatureMultiSig)); err != nil {
+		return err
+	}
+	if err = e.CheckSignerKey(m.auth, m.features(constants.FeaturePermissionLevels)); err != nil {
 		return err
 	}
 	if err = e.CheckInBlockTrxs(m.history); err != nil {
 		return err
 	}
+	if err = e.CheckNonceAllowed(m.features(constants.FeatureTrxNonce)); err != nil {
+		return err
+	}
+	if err = e.CheckNonceUnused(m.db, blockTime); err != nil {
+		return err
+	}
 	return
 }
 
@@ -571,20 +837,24 @@ func (m *TrxMgr) DiscardAccountCache(name string) {
 // be packed into blocks. This can eventually fill up the waiting pool, leading to huge memory consumption and
 // DoS for new transactions.
 //
+// Only expired transactions are removed. Unexpired ones stay in the pool and keep their ranks, since the pool is
+// already bounded by count, size and per-signer limits, and dropping them would discard valid transactions of
+// everybody whenever the pool grows past the water mark.
+//
 func (m *TrxMgr) cleanExpiredWaiting() {
 	// when the waiting pool is small, we don't need cleaning
-	if len(m.waiting) < sWaitingCountWaterMark {
+	if m.waiting.Len() < sWaitingCountWaterMark {
 		return
 	}
 	// we avoid frequent cleaning
 	if headBlockTime := atomic.LoadUint32(&m.headTime); headBlockTime > 0 && time.Since(m.lastCleanTime) > sMinCleanupInterval {
 		m.lastCleanTime = time.Now()
-		for k, e := range m.waiting {
+		m.waiting.ForEach(func(e *TrxEntry) {
 			if err := e.CheckExpiration(headBlockTime); err != nil {
+				m.waiting.Remove(e.trxId)
 				m.deliverEntry(e)
 			}
-			delete(m.waiting, k)
-		}
+		})
 	}
 }
 
@@ -594,13 +864,11 @@ func (m *TrxMgr) shrinkPoolMemories() {
 	if atomic.LoadUint64(&m.shrinkCounter) > sShrinkCountWaterMark {
 		atomic.StoreUint64(&m.shrinkCounter, 0)
 
-		waiting, fetched := make(map[string]*TrxEntry), make(map[string]*TrxEntry)
-		for k, e := range m.waiting {
-			waiting[k] = e
-		}
+		fetched := make(map[string]*TrxEntry)
 		for k, e := range m.fetched {
 			fetched[k] = e
 		}
-		m.waiting, m.fetched = waiting, fetched
+		m.fetched = fetched
+		m.waiting.Shrink()
 	}
 }
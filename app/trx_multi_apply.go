@@ -9,6 +9,20 @@ import (
 
 type SingleTrxApplier func(iservices.IDatabasePatch, *TrxEntry, uint64)
 
+// ITrxsApplier applies multiple transactions.
+type ITrxsApplier interface {
+	Apply(trxs []*TrxEntry)
+}
+
+// TrxApplyMode selects an ITrxsApplier.
+type TrxApplyMode int
+
+const (
+	TrxApplyGrouped TrxApplyMode = iota // concurrently in groups scheduled by PropBasedTrxScheduler
+	TrxApplySequential                  // one by one
+	TrxApplyOptimistic                  // speculatively and concurrently, see OptimisticTrxsApplier
+)
+
 // MultiTrxsApplier concurrently applies multiple transactions.
 type MultiTrxsApplier struct {
 	db            iservices.IDatabaseService
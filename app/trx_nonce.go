@@ -0,0 +1,74 @@
+package app
+
+import (
+	"fmt"
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+)
+
+// This file implements on-chain reservation of transaction nonces.
+//
+// A pending transaction of a non-zero nonce can be replaced or cancelled by another one of the same signer and nonce.
+// Once one of them gets into a block, the nonce is reserved in state until that transaction expires, so that no
+// competing transaction of the same nonce can be packed later, even by nodes which never saw the pending ones.
+
+// nonceReserved checks if the nonce of given signer is reserved by an in-block transaction unexpired at blockTime.
+func nonceReserved(db iservices.IDatabaseRW, signer string, nonce uint64, blockTime uint32) bool {
+	if nonce == 0 {
+		return false
+	}
+	wrap := table.NewSoTrxNonceWrap(db, &prototype.TrxNonceId{Signer: prototype.NewAccountName(signer), Nonce: nonce})
+	return wrap.CheckExist() && wrap.GetExpiration().GetUtcSeconds() >= blockTime
+}
+
+// trxSigner returns the signer of given transaction, which must have a unique operation creator.
+func trxSigner(trx *prototype.SignedTransaction) (signer string) {
+	for signer = range trx.GetOpCreatorsMap() {
+		break
+	}
+	return
+}
+
+// checkBlockNonces panics if the block contains a cancellation, or transactions of the same signer and nonce.
+func (c *TrxPool) checkBlockNonces(blk *prototype.SignedBlock) {
+	nonces := make(map[string]bool)
+	for i, w := range blk.Transactions {
+		trx := w.SigTrx.GetTrx()
+		mustSuccess(!trx.GetCancel(), fmt.Sprintf("cancellation trx can't be packed, trxs[%d]", i))
+		if key := trxNonceKey(trxSigner(w.SigTrx), trx.GetNonce()); len(key) > 0 {
+			mustSuccess(!nonces[key], fmt.Sprintf("nonce %s used by multiple trxs in block", key))
+			nonces[key] = true
+		}
+	}
+}
+
+// reserveBlockNonces reserves nonces of in-block transactions until they expire, and releases expired reservations.
+func (c *TrxPool) reserveBlockNonces(blk *prototype.SignedBlock) {
+	blockTime := blk.SignedHeader.Header.Timestamp.GetUtcSeconds()
+	var expired []*prototype.TrxNonceId
+	err := table.NewTrxNonceExpirationWrap(c.db).ForEachByOrder(nil, &prototype.TimePointSec{UtcSeconds: blockTime}, nil, nil,
+		func(mVal *prototype.TrxNonceId, sVal *prototype.TimePointSec, idx uint32) bool {
+			expired = append(expired, mVal)
+			return true
+		})
+	mustNoError(err, "failed fetching expired trx nonces")
+	for _, id := range expired {
+		table.NewSoTrxNonceWrap(c.db, id).RemoveTrxNonce("failed removing expired trx nonce")
+	}
+
+	for _, w := range blk.Transactions {
+		nonce := w.SigTrx.GetTrx().GetNonce()
+		if nonce == 0 {
+			continue
+		}
+		trxId, err := w.SigTrx.Id()
+		mustNoError(err, "failed getting trx id")
+		id := &prototype.TrxNonceId{Signer: prototype.NewAccountName(trxSigner(w.SigTrx)), Nonce: nonce}
+		table.NewSoTrxNonceWrap(c.db, id).Create(func(tInfo *table.SoTrxNonce) {
+			tInfo.Id = id
+			tInfo.TrxId = trxId
+			tInfo.Expiration = w.SigTrx.GetTrx().GetExpiration()
+		}, "failed reserving trx nonce")
+	}
+}
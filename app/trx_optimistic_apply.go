@@ -0,0 +1,129 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/golang/protobuf/proto"
+)
+
+// TrxDiscarder drops side effects, other than database changes, of applying a transaction on given branch.
+type TrxDiscarder func(branchId string)
+
+// TrxCommitter is called when the result of a transaction is final.
+type TrxCommitter func(*TrxEntry, uint64)
+
+// OptimisticTrxsApplier concurrently applies multiple transactions without knowing their dependencies in advance.
+//
+// All transactions are first applied speculatively and concurrently, each on its own database patch which records
+// keys the transaction reads and writes. Then, in the original order, changes of a transaction are committed if it
+// read nothing written by transactions committed before it. Otherwise, its speculative result is discarded and the
+// transaction is applied again on the committed state. So the final result is always the same as applying
+// transactions one by one, which is essential for consensus.
+type OptimisticTrxsApplier struct {
+	db            iservices.IDatabaseService
+	singleApplier SingleTrxApplier
+	discarder     TrxDiscarder
+	committer     TrxCommitter
+	blockNum      uint64
+	branchSeq     int
+
+	// statistics
+	applied   int
+	reapplied int
+}
+
+func NewOptimisticTrxsApplier(db iservices.IDatabaseService, singleApplier SingleTrxApplier, discarder TrxDiscarder, committer TrxCommitter, blockNum uint64) *OptimisticTrxsApplier {
+	return &OptimisticTrxsApplier{
+		db:            db,
+		singleApplier: singleApplier,
+		discarder:     discarder,
+		committer:     committer,
+		blockNum:      blockNum,
+	}
+}
+
+// speculation is a speculative application of a transaction.
+type speculation struct {
+	trx     *TrxEntry
+	db      iservices.IDatabasePatch
+	rw      *storage.AccessSet
+	receipt *prototype.TransactionReceiptWithInfo // the receipt before application
+	err     error
+}
+
+func (a *OptimisticTrxsApplier) newBranchId() string {
+	a.branchSeq++
+	return fmt.Sprintf("%sspec%d", iservices.DbTrunk, a.branchSeq)
+}
+
+func (a *OptimisticTrxsApplier) Apply(trxs []*TrxEntry) {
+	if len(trxs) == 0 {
+		return
+	}
+	specs := make([]*speculation, len(trxs))
+	for i, trx := range trxs {
+		rw := storage.NewAccessSet()
+		specs[i] = &speculation{
+			trx:     trx,
+			db:      a.db.NewTrackedPatch(rw, a.newBranchId()),
+			rw:      rw,
+			receipt: proto.Clone(trx.GetTrxResult().GetReceipt()).(*prototype.TransactionReceiptWithInfo),
+		}
+	}
+
+	// speculative applications
+	var wg sync.WaitGroup
+	wg.Add(len(specs))
+	for i := range specs {
+		go func(s *speculation) {
+			defer wg.Done()
+			s.err = a.applySingle(s.db, s.trx)
+		}(specs[i])
+	}
+	wg.Wait()
+
+	// commit in order
+	committed := storage.NewAccessSet()
+	for _, s := range specs {
+		if s.rw.DependsOn(committed) {
+			// the speculation read stale data, apply the transaction again.
+			a.discarder(s.db.BranchId())
+			s.trx.GetTrxResult().Receipt = s.receipt
+			rw := storage.NewAccessSet()
+			db := a.db.NewTrackedPatch(rw, iservices.DbTrunk)
+			if a.applySingle(db, s.trx) == nil {
+				_ = db.Apply()
+			}
+			committed.MergeWrites(rw)
+			a.reapplied++
+		} else {
+			if s.err == nil {
+				_ = s.db.Apply()
+			}
+			committed.MergeWrites(s.rw)
+		}
+		a.applied++
+		a.committer(s.trx, a.blockNum)
+	}
+}
+
+func (a *OptimisticTrxsApplier) applySingle(db iservices.IDatabasePatch, trx *TrxEntry) (err error) {
+	defer func() {
+		// recover from panic and return an error
+		if e := recover(); e != nil {
+			err = fmt.Errorf("%v", e)
+		}
+	}()
+	// singleApplier is not panic-free
+	a.singleApplier(db, trx, a.blockNum)
+	return
+}
+
+// Stats returns the number of applied transactions and how many of them were applied again due to conflicts.
+func (a *OptimisticTrxsApplier) Stats() (applied, reapplied int) {
+	return a.applied, a.reapplied
+}
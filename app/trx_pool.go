@@ -50,6 +50,10 @@ type TrxPool struct {
 
 	vmCache *vmcache.VmCache
 	blockLogWatcher *blocklog.Watcher
+	trxApplyMode TrxApplyMode
+	features *common.FeatureRegistry
+	genesisRewardPolicy *prototype.RewardPolicy
+	economistHook func(db iservices.IDatabaseService)
 }
 
 func (c *TrxPool) getDb() (iservices.IDatabaseService, error) {
@@ -65,6 +69,25 @@ func (c *TrxPool) SetShuffle(s common.ShuffleFunc) {
 	c.shuffle = s
 }
 
+// SetTrxApplyMode selects how transactions of a block are applied.
+// Block producers and validators may use different modes, because all modes make the same result.
+func (c *TrxPool) SetTrxApplyMode(mode TrxApplyMode) {
+	c.trxApplyMode = mode
+}
+
+func (c *TrxPool) newTrxsApplier(blockNum uint64) ITrxsApplier {
+	switch c.trxApplyMode {
+	case TrxApplyOptimistic:
+		return NewOptimisticTrxsApplier(c.db, c.applyTransaction, c.blockLogWatcher.DiscardBranchChanges, c.notifyTrxEntryApplied, blockNum)
+	case TrxApplySequential:
+		ma := NewMultiTrxsApplier(c.db, c.applyTransactionOnDb, blockNum)
+		ma.sched = DefaultTrxScheduler{}
+		return ma
+	default:
+		return NewMultiTrxsApplier(c.db, c.applyTransactionOnDb, blockNum)
+	}
+}
+
 // service constructor
 func NewController(ctx *node.ServiceContext, lg *logrus.Logger) (*TrxPool, error) {
 	if lg == nil {
@@ -82,10 +105,62 @@ func (c *TrxPool) Start(node *node.Node) error {
 	c.db = db
 	c.evLoop = node.MainLoop
 	c.noticer = node.EvBus
+	if err = c.loadFeatures(); err != nil {
+		return err
+	}
+	if err = c.loadGenesisRewardPolicy(); err != nil {
+		return err
+	}
+	if c.ctx.Config().Consensus.OptimisticTrxApply {
+		c.trxApplyMode = TrxApplyOptimistic
+	}
 	c.Open()
 	return nil
 }
 
+// loadGenesisRewardPolicy reads the initial reward policy from genesis config, which is not allowed on main net.
+func (c *TrxPool) loadGenesisRewardPolicy() error {
+	genesis := c.ctx.Config().P2P.Genesis
+	if genesis == nil || genesis.RewardPolicy == nil {
+		return nil
+	}
+	if c.ctx.ChainId().Value == common.ChainIdMainNet {
+		return errors.New("reward policy of main net can't be changed")
+	}
+	cfg := genesis.RewardPolicy
+	policy := &prototype.RewardPolicy{
+		Weighting: cfg.Weighting,
+		CreatorRate: cfg.CreatorRate,
+		DappRate: cfg.DappRate,
+		AuthorRate: cfg.AuthorRate,
+		ReplyRate: cfg.ReplyRate,
+		VoterRate: cfg.VoterRate,
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.WithMessage(err, "invalid genesis reward policy")
+	}
+	c.genesisRewardPolicy = policy
+	return nil
+}
+
+// loadFeatures sets up the feature schedule, which can be customized by genesis config except on main net.
+func (c *TrxPool) loadFeatures() error {
+	c.features = common.NewFeatureRegistry()
+	genesis := c.ctx.Config().P2P.Genesis
+	if genesis == nil || len(genesis.Features) == 0 {
+		return nil
+	}
+	if c.ctx.ChainId().Value == common.ChainIdMainNet {
+		return errors.New("feature schedule of main net can't be changed")
+	}
+	for id, height := range genesis.Features {
+		if err := c.features.Schedule(id, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *TrxPool) Open() {
 	c.blockLogWatcher = blocklog.NewWatcher(c.db.ServiceId(), c.notifyBlockLog)
 	dgpWrap := table.NewSoGlobalWrap(c.db, &SingleId)
@@ -99,13 +174,13 @@ func (c *TrxPool) Open() {
 		mustNoError(c.db.TagRevision(c.db.GetRevision(), GENESIS_TAG), "genesis tagging failed")
 		//c.log.Info("finish initGenesis")
 	}
-	c.iceberg = NewBlockIceberg(c.db, c.log, c.enableBAH)
+	c.iceberg = NewBlockIceberg(c.db, c.log, c.enableBAH, c.stateTreeActive)
 	c.economist = NewEconomist(c.db, c.noticer, c.log, c.HardFork)
 
 	commit, _ := c.iceberg.LastFinalizedBlock()
 	latest, _, _ := c.iceberg.LatestBlock()
-	c.tm = NewTrxMgr(c.ctx.ChainId(), c.db, c.log, latest, commit)
 	c.resourceLimiter = utils.NewResourceLimiter()
+	c.tm = NewTrxMgr(c.ctx.ChainId(), c.db, c.log, latest, commit, c.trxPriority, c.FeatureActive)
 }
 
 func (c *TrxPool) Stop() error {
@@ -180,13 +255,13 @@ func (c *TrxPool) pushBlockNoLock(blk *prototype.SignedBlock, skip prototype.Ski
 		_ = c.blockLogWatcher.BeginBlock(blkNum)
 		c.applyBlock(blk, skip)
 		c.log.Debug("ICEBERG: EndBlock TRUE")
-		_ = c.iceberg.EndBlock(true)
+		mustNoError(c.iceberg.EndBlock(true), "end block failed")
 		_ = c.blockLogWatcher.EndBlock(true, blk)
 	} else {
 		// we have do a BeginTransaction at GenerateBlock
 		c.applyBlock(blk, skip)
 		c.log.Debug("ICEBERG: EndBlock TRUE")
-		_ = c.iceberg.EndBlock(true)
+		mustNoError(c.iceberg.EndBlock(true), "end block failed")
 		_ = c.blockLogWatcher.EndBlock(true, blk)
 	}
 
@@ -278,6 +353,8 @@ func (c *TrxPool) generateBlockNoLock(bpName string, pre *prototype.Sha256, time
 	c.log.Debugf("ICEBERG: BeginBlock %d", blkNum)
 	_ = c.iceberg.BeginBlock(blkNum)
 	_ = c.blockLogWatcher.BeginBlock(blkNum)
+	// whether the block commits a state root is decided on the state before applying it, the same as validation.
+	withStateRoot := c.FeatureActive(constants.FeatureStateRoot)
 
 	timeOut := maxTimeout - time.Since(entryTime)
 	if timeOut < minTimeout {
@@ -289,7 +366,7 @@ func (c *TrxPool) generateBlockNoLock(bpName string, pre *prototype.Sha256, time
 	})
 
 	const batchCount = 64
-	ma := NewMultiTrxsApplier(c.db, c.applyTransactionOnDb, blkNum)
+	ma := c.newTrxsApplier(blkNum)
 
 	timing.Mark()
 
@@ -328,6 +405,11 @@ func (c *TrxPool) generateBlockNoLock(bpName string, pre *prototype.Sha256, time
 
 	signBlock.SignedHeader.Header.Previous = &prototype.Sha256{ Hash: prevBlockId.Data[:]}
 	signBlock.SignedHeader.Header.PrevApplyHash = c.iceberg.LatestBlockApplyHash()
+	if withStateRoot {
+		stateRoot, err := c.iceberg.LatestStateRoot()
+		mustNoError(err, "get state root error")
+		signBlock.SignedHeader.Header.PrevStateRoot = &prototype.Sha256{Hash: stateRoot}
+	}
 	signBlock.SignedHeader.Header.Timestamp = &prototype.TimePointSec{UtcSeconds: timestamp}
 	id := signBlock.CalculateMerkleRoot()
 	signBlock.SignedHeader.Header.TransactionMerkleRoot = &prototype.Sha256{Hash: id.Data[:]}
@@ -418,7 +500,20 @@ func (c *TrxPool) notifyBlockLog(blockLog *blocklog.BlockLog, blockProducer stri
 	c.noticer.Publish(constants.NoticeBlockLog, blockLog, blockProducer)
 }
 
+// applyTransactionOnDb applies a transaction and publishes its result.
 func (c *TrxPool) applyTransactionOnDb(db iservices.IDatabasePatch, entry *TrxEntry, blockNum uint64) {
+	defer c.notifyTrxEntryApplied(entry, blockNum)
+	c.applyTransaction(db, entry, blockNum)
+}
+
+func (c *TrxPool) notifyTrxEntryApplied(entry *TrxEntry, blockNum uint64) {
+	result := entry.GetTrxResult()
+	receipt := result.GetReceipt()
+	c.notifyTrxApplyResult(result.GetSigTrx(), receipt.GetStatus() != prototype.StatusError, receipt, blockNum)
+}
+
+// applyTransaction applies a transaction without publishing its result.
+func (c *TrxPool) applyTransaction(db iservices.IDatabasePatch, entry *TrxEntry, blockNum uint64) {
 	result := entry.GetTrxResult()
 	receipt, sigTrx := result.GetReceipt(), result.GetSigTrx()
 
@@ -436,19 +531,20 @@ func (c *TrxPool) applyTransactionOnDb(db iservices.IDatabasePatch, entry *TrxEn
 			receipt.ErrorInfo = fmt.Sprintf("applyTransaction failed : %v", err)
 			c.log.Warnf("applyTransaction failed : %v", err)
 			trxStateChange.Restore(restorePoint)
+			// events of a failed transaction never happened.
+			for _, opResult := range receipt.OpResults {
+				opResult.Events = nil
+			}
 			if useGas && constants.EnableResourceControl {
 				receipt.Status = prototype.StatusFailDeductStamina
-				c.notifyTrxApplyResult(sigTrx, true, receipt, blockNum)
 			} else {
 				receipt.Status = prototype.StatusError
-				c.notifyTrxApplyResult(sigTrx, false, receipt, blockNum)
 				panic(receipt.ErrorInfo)
 			}
 		} else {
 			// commit changes to db
 			_ = trxDB.Apply()
 			receipt.Status = prototype.StatusSuccess
-			c.notifyTrxApplyResult(sigTrx, true, receipt, blockNum)
 		}
 		trxStateChange.SetOperation(-1)
 		trxStateChange.SetCause("pay_gas")
@@ -479,7 +575,7 @@ func (c *TrxPool) applyOperation(trxCtx *TrxContext, op *prototype.Operation) {
 }
 
 func (c *TrxPool) getEvaluator(trxCtx *TrxContext, op *prototype.Operation) BaseEvaluator {
-	return GetBaseEvaluator(trxCtx, op, c.HardFork())
+	return GetBaseEvaluator(trxCtx, op)
 }
 
 func (c *TrxPool) applyBlock(blk *prototype.SignedBlock, skip prototype.SkipFlag) {
@@ -503,11 +599,14 @@ func (c *TrxPool) applyBlock(blk *prototype.SignedBlock, skip prototype.SkipFlag
 		pushTiming := common.NewTiming()
 		pushTiming.Begin()
 
+		if c.FeatureActive(constants.FeatureTrxNonce) {
+			c.checkBlockNonces(blk)
+		}
 		entries, err := c.tm.CheckBlockTrxs(blk)
 		mustNoError(err, "block trxs check failed")
 
 		applyTime := int64(0)
-		ma := NewMultiTrxsApplier(c.db, c.applyTransactionOnDb, blockNum)
+		ma := c.newTrxsApplier(blockNum)
 		batchCount := 64
 		totalCount := len(entries)
 		for i := 0; i < totalCount; i += batchCount {
@@ -574,6 +673,9 @@ func (c *TrxPool) applyBlock(blk *prototype.SignedBlock, skip prototype.SkipFlag
 
 	afterTiming.Begin()
 
+	if c.FeatureActive(constants.FeatureTrxNonce) {
+		c.reserveBlockNonces(blk)
+	}
 	c.createBlockSummary(blk)
 
 	afterTiming.Mark()
@@ -582,6 +684,9 @@ func (c *TrxPool) applyBlock(blk *prototype.SignedBlock, skip prototype.SkipFlag
 
 	afterTiming.Mark()
 
+	if c.economistHook != nil {
+		c.economistHook(c.db)
+	}
 	c.blockLogWatcher.CurrentBlockContext().SetCause("esys")
 	c.economist.SetStateChangeContext(c.blockLogWatcher.CurrentBlockContext())
 	eTiming.Begin()
@@ -594,6 +699,12 @@ func (c *TrxPool) applyBlock(blk *prototype.SignedBlock, skip prototype.SkipFlag
 	c.economist.PowerDown()
 	eTiming.Mark()
 	c.economist.DeliverDelegatedVests()
+	eTiming.Mark()
+	if c.FeatureActive(constants.FeatureScheduledTransfer) {
+		c.economist.ReleaseScheduledTransfers()
+	}
+	eTiming.Mark()
+	c.economist.ProcessProposals()
 	eTiming.End()
 	c.economist.SetStateChangeContext(nil)
 	c.blockLogWatcher.CurrentBlockContext().SetCause("")
@@ -731,6 +842,7 @@ func (c *TrxPool) initGenesis() {
 		tInfo.Props.TicketsIncome = prototype.NewVest(0)
 		tInfo.Props.ChargedTicketsNum = 0
 		tInfo.Props.TicketsBpBonus = prototype.NewVest(0)
+		tInfo.Props.RewardPolicy = c.genesisRewardPolicy
 	})
 
 	// create block summary buffer 2048
@@ -790,6 +902,22 @@ func (c *TrxPool) validateBlockHeader(blk *prototype.SignedBlock) {
 			panic("block apply hash not equal")
 		}
 	}
+
+	// blocks produced before the state root feature have no state root.
+	// blocks after it must commit the state after applying their previous blocks.
+	bRoot := blk.SignedHeader.Header.PrevStateRoot
+	if !c.FeatureActive(constants.FeatureStateRoot) {
+		mustSuccess(bRoot == nil, "block has state root before the feature")
+	} else {
+		mustSuccess(bRoot != nil, "block has no state root")
+		root, err := c.iceberg.LatestStateRoot()
+		mustNoError(err, "get state root error")
+		if !bytes.Equal(root, bRoot.Hash) {
+			c.log.Errorf("StateRootError: block %d (by %s): %x, me: %x",
+				blk.SignedHeader.Number(), blk.SignedHeader.Header.BlockProducer.Value, bRoot.Hash, root)
+			panic("state root not equal")
+		}
+	}
 }
 
 func (c *TrxPool) headBlockID() *prototype.Sha256 {
@@ -877,6 +1005,11 @@ func (c *TrxPool) updateGlobalBpBootMark() {
 }
 
 func (c *TrxPool) updateGlobalResourceParam(bpNameList []string) {
+	// once set by governance proposals, chain properties are no longer medians of bp proposed values
+	if table.NewSoGlobalWrap(c.db, &SingleId).GetProps().GetPropsGoverned() {
+		return
+	}
+
 	var tpsExpectedList  []uint64
 	var staminaFreeList  []uint64
 	var accountCreationFee []uint64
@@ -1076,6 +1209,10 @@ func (c *TrxPool) GetFinalizedNum() (uint64, error) {
 	return c.iceberg.LastFinalizedBlock()
 }
 
+func (c *TrxPool) GetStateRoot() ([]byte, error) {
+	return c.iceberg.LatestStateRoot()
+}
+
 func (c *TrxPool) calculateUserMaxStamina(db iservices.IDatabaseRW,name string) uint64 {
 	dgpWrap := table.NewSoGlobalWrap(db, &SingleId)
 	accountWrap := table.NewSoAccountWrap(db, &prototype.AccountName{Value:name})
@@ -1103,6 +1240,27 @@ func (c *TrxPool) CalculateUserMaxStamina(db iservices.IDatabaseRW,name string)
 	return c.calculateUserMaxStamina(db,name)
 }
 
+// trxPriority ranks pending transactions by the remaining stamina of their signers.
+func (c *TrxPool) trxPriority(signer string) uint64 {
+	accountWrap := table.NewSoAccountWrap(c.db, &prototype.AccountName{Value:signer})
+	if !accountWrap.CheckExist() {
+		return 0
+	}
+	props := table.NewSoGlobalWrap(c.db, &constants.GlobalId).GetProps()
+	maxStamina := c.calculateUserMaxStamina(c.db, signer)
+	_,freeLeft := c.resourceLimiter.GetFreeLeft(props.GetStaminaFree(), accountWrap.GetStaminaFree(), accountWrap.GetStaminaFreeUseBlock(), props.GetHeadBlockNumber())
+	_,stakeLeft := c.resourceLimiter.GetStakeLeft(accountWrap.GetStamina(), accountWrap.GetStaminaUseBlock(), props.GetHeadBlockNumber(), maxStamina)
+	return freeLeft + stakeLeft
+}
+
+func (c *TrxPool) GetPendingTrxStats(topSigners int) *iservices.PendingTrxStats {
+	return c.tm.GetPendingTrxStats(topSigners)
+}
+
+func (c *TrxPool) LookupTrxs(key prototype.ShortTrxIdKey, shortIds []uint64) []*prototype.SignedTransaction {
+	return c.tm.LookupTrxs(key, shortIds)
+}
+
 func (c *TrxPool) CheckNetForRPC(name string, db iservices.IDatabaseRW, sizeInBytes uint64) (bool,uint64,uint64) {
 	netUse := sizeInBytes * constants.NetConsumePointNum
 	accountWrap := table.NewSoAccountWrap(db, &prototype.AccountName{Value:name})
@@ -1183,5 +1341,63 @@ func (c *TrxPool) ShareTicketBonus() (err error) {
 
 func (c *TrxPool) HardFork() uint64 {
 	blockNum, _, _ := c.iceberg.LatestBlock()
-	return common.GetHardFork(blockNum)
+	return c.features.HardFork(blockNum)
+}
+
+// SetRewardPolicy forces a reward policy regardless of the one on chain. It's for simulations only and must be called after Start().
+func (c *TrxPool) SetRewardPolicy(policy RewardPolicy) {
+	c.economist.SetRewardPolicy(policy)
+}
+
+// SetEconomistHook sets a function to be called right before the economist runs for each block. It's for simulations only.
+func (c *TrxPool) SetEconomistHook(hook func(db iservices.IDatabaseService)) {
+	c.economistHook = hook
+}
+
+func (c *TrxPool) FeatureActive(id string) bool {
+	return c.featureActive(c.db, id)
+}
+
+// featureActive checks a feature on given database state.
+// A feature is active after its scheduled height, or after a governance proposal of it got activated.
+func (c *TrxPool) featureActive(db iservices.IDatabaseRW, id string) bool {
+	blockNum, _, _ := c.iceberg.LatestBlock()
+	return c.featureActiveAt(db, id, blockNum)
+}
+
+// featureActiveAt checks a feature of given block on given database state.
+func (c *TrxPool) featureActiveAt(db iservices.IDatabaseRW, id string, blockNum uint64) bool {
+	if c.features.IsActive(id, blockNum) {
+		return true
+	}
+	_, activated := proposalActivatedFeature(table.NewSoGlobalWrap(db, &SingleId).GetProps(), id)
+	return activated
+}
+
+// stateTreeActive returns true if the state tree must be updated at the end of given block,
+// i.e. the next block has to commit a state root.
+func (c *TrxPool) stateTreeActive(blockNum uint64) bool {
+	return c.featureActiveAt(c.db, constants.FeatureStateRoot, blockNum + 1)
+}
+
+// proposalActivatedFeature returns the activation block of given feature if a proposal of it has been activated
+func proposalActivatedFeature(props *prototype.DynamicProperties, id string) (height uint64, activated bool) {
+	for _, f := range props.GetActivatedFeatures() {
+		if f.GetId() == id {
+			return f.GetHeight(), true
+		}
+	}
+	return 0, false
+}
+
+// Features returns all builtin features with their effective activation heights.
+func (c *TrxPool) Features() []common.Feature {
+	features := c.features.Features()
+	props := table.NewSoGlobalWrap(c.db, &SingleId).GetProps()
+	for i := range features {
+		if height, activated := proposalActivatedFeature(props, features[i].Id); activated && height < features[i].Height {
+			features[i].Height = height
+		}
+	}
+	return features
 }
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/petar/GoLLRB/llrb"
+	"sort"
+)
+
+// TrxPriorityFunc returns the priority of transactions signed by given account.
+// Transactions of higher priorities are packed into blocks earlier.
+type TrxPriorityFunc func(signer string) uint64
+
+// waitingItem is the item type of waiting queue.
+type waitingItem struct {
+	e *TrxEntry
+}
+
+// Less defines the order of waiting transactions.
+// A transaction goes before another if it has a higher priority, or it arrived earlier with the same priority.
+func (item waitingItem) Less(than llrb.Item) bool {
+	other := than.(waitingItem).e
+	if item.e.priority != other.priority {
+		return item.e.priority > other.priority
+	}
+	return item.e.seq < other.seq
+}
+
+// waitingSigner is the pending statistics of a signer.
+type waitingSigner struct {
+	count int			// number of waiting transactions signed by the signer
+	size  int			// total size of waiting transactions signed by the signer
+}
+
+// waitingPool is a priority-ordered pool of transactions waiting to be packed to blocks.
+// waitingPool is not thread safe.
+type waitingPool struct {
+	entries map[string]*TrxEntry			// trxId -> entry
+	digests map[string]*TrxEntry			// trx digest -> entry
+	queue   *llrb.LLRB						// entries in priority order, the best one first
+	signers map[string]*waitingSigner		// signer -> pending statistics
+	size    int								// total size of waiting transactions
+	seq     uint64							// arrival sequence number of the latest transaction
+}
+
+func newWaitingPool() *waitingPool {
+	return &waitingPool{
+		entries: make(map[string]*TrxEntry),
+		digests: make(map[string]*TrxEntry),
+		queue:   llrb.New(),
+		signers: make(map[string]*waitingSigner),
+	}
+}
+
+// Len returns number of waiting transactions.
+func (p *waitingPool) Len() int {
+	return len(p.entries)
+}
+
+// Size returns total size of waiting transactions.
+func (p *waitingPool) Size() int {
+	return p.size
+}
+
+// Get returns the waiting transaction of given id, or nil if not found.
+func (p *waitingPool) Get(trxId string) *TrxEntry {
+	return p.entries[trxId]
+}
+
+// GetByDigest returns the waiting transaction of given digest, or nil if not found.
+func (p *waitingPool) GetByDigest(digest string) *TrxEntry {
+	return p.digests[digest]
+}
+
+// SignerCount returns number of waiting transactions signed by given account.
+func (p *waitingPool) SignerCount(signer string) int {
+	if s := p.signers[signer]; s != nil {
+		return s.count
+	}
+	return 0
+}
+
+// Arrive assigns an arrival sequence number to given entry, which must be called before Add.
+func (p *waitingPool) Arrive(e *TrxEntry) {
+	p.seq++
+	e.seq = p.seq
+}
+
+// Add adds an entry to the pool.
+func (p *waitingPool) Add(e *TrxEntry) {
+	p.entries[e.trxId] = e
+	p.digests[e.digest] = e
+	p.queue.ReplaceOrInsert(waitingItem{e})
+	s := p.signers[e.signer]
+	if s == nil {
+		s = new(waitingSigner)
+		p.signers[e.signer] = s
+	}
+	s.count++
+	s.size += e.size
+	p.size += e.size
+}
+
+// Remove removes the transaction of given id from the pool, and returns the removed entry.
+func (p *waitingPool) Remove(trxId string) *TrxEntry {
+	e := p.entries[trxId]
+	if e == nil {
+		return nil
+	}
+	delete(p.entries, trxId)
+	if p.digests[e.digest] == e {
+		delete(p.digests, e.digest)
+	}
+	p.queue.Delete(waitingItem{e})
+	if s := p.signers[e.signer]; s != nil {
+		if s.count--; s.count <= 0 {
+			delete(p.signers, e.signer)
+		} else {
+			s.size -= e.size
+		}
+	}
+	p.size -= e.size
+	return e
+}
+
+// Best returns the waiting transaction of the highest rank, or nil if the pool is empty.
+func (p *waitingPool) Best() *TrxEntry {
+	if item := p.queue.Min(); item != nil {
+		return item.(waitingItem).e
+	}
+	return nil
+}
+
+// Worst returns the waiting transaction of the lowest rank, or nil if the pool is empty.
+func (p *waitingPool) Worst() *TrxEntry {
+	if item := p.queue.Max(); item != nil {
+		return item.(waitingItem).e
+	}
+	return nil
+}
+
+// ForEach calls f with each waiting transaction in arbitrary order.
+// It's safe to remove the transaction being visited inside f.
+func (p *waitingPool) ForEach(f func(e *TrxEntry)) {
+	for _, e := range p.entries {
+		f(e)
+	}
+}
+
+// TopSigners returns pending statistics of at most n signers having most waiting transactions.
+func (p *waitingPool) TopSigners(n int) (stats []iservices.PendingSignerStats) {
+	for name, s := range p.signers {
+		stats = append(stats, iservices.PendingSignerStats{Signer: name, Count: s.count, Size: s.size})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Signer < stats[j].Signer
+	})
+	if n >= 0 && len(stats) > n {
+		stats = stats[:n]
+	}
+	return
+}
+
+// Shrink re-copies internal maps to release memory occupied by deleted keys.
+func (p *waitingPool) Shrink() {
+	entries, digests, signers := make(map[string]*TrxEntry), make(map[string]*TrxEntry), make(map[string]*waitingSigner)
+	for k, e := range p.entries {
+		entries[k] = e
+	}
+	for k, e := range p.digests {
+		digests[k] = e
+	}
+	for k, s := range p.signers {
+		signers[k] = s
+	}
+	p.entries, p.digests, p.signers = entries, digests, signers
+}
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"fmt"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestWaitingEntry(id, signer string, priority uint64, size int) *TrxEntry {
+	e := NewTrxMgrEntry(prototype.ChainId{}, nil, nil)
+	e.trxId, e.digest, e.signer, e.priority, e.size = id, "digest of " + id, signer, priority, size
+	return e
+}
+
+func TestWaitingPool(t *testing.T) {
+	a := assert.New(t)
+	p := newWaitingPool()
+
+	add := func(id, signer string, priority uint64) {
+		e := newTestWaitingEntry(id, signer, priority, 100)
+		p.Arrive(e)
+		p.Add(e)
+	}
+	add("t1", "alice", 10)
+	add("t2", "bob", 20)
+	add("t3", "alice", 10)
+	add("t4", "carol", 5)
+	add("t5", "bob", 20)
+
+	a.Equal(5, p.Len())
+	a.Equal(500, p.Size())
+	a.Equal(2, p.SignerCount("alice"))
+	a.Equal(0, p.SignerCount("dave"))
+	a.Equal("t4", p.Worst().trxId)
+	a.Equal("t3", p.GetByDigest("digest of t3").trxId)
+	a.Nil(p.GetByDigest("digest of t6"))
+
+	// higher priorities first, then earlier arrivals
+	var order []string
+	for e := p.Best(); e != nil; e = p.Best() {
+		order = append(order, e.trxId)
+		a.Equal(e, p.Remove(e.trxId))
+	}
+	a.Equal([]string{"t2", "t5", "t1", "t3", "t4"}, order)
+	a.Equal(0, p.Len())
+	a.Equal(0, p.Size())
+	a.Equal(0, p.SignerCount("alice"))
+	a.Nil(p.Remove("t1"))
+	a.Nil(p.Best())
+
+	// busiest signers
+	for i := 0; i < 3; i++ {
+		add(fmt.Sprintf("a%d", i), "alice", 1)
+	}
+	add("b0", "bob", 1)
+	add("c0", "carol", 1)
+	top := p.TopSigners(2)
+	a.Len(top, 2)
+	a.Equal("alice", top[0].Signer)
+	a.Equal(3, top[0].Count)
+	a.Equal(300, top[0].Size)
+	a.Equal("bob", top[1].Signer)
+	a.Len(p.TopSigners(10), 3)
+}
+
+func TestWaitingPoolEviction(t *testing.T) {
+	a := assert.New(t)
+	m := &TrxMgr{waiting: newWaitingPool(), fetched: make(map[string]*TrxEntry)}
+
+	// each entry takes a quarter of the size limit
+	const size = sMaxWaitingSize / 4
+	for i := 0; i < 4; i++ {
+		a.Equal(1, m.addToWaiting(newTestWaitingEntry(fmt.Sprintf("t%d", i), fmt.Sprintf("user%d", i), uint64(10 + i), size)))
+	}
+	a.Equal(4, m.waiting.Len())
+
+	// lower or equal priorities are refused when the pool is full
+	a.Equal(0, m.addToWaiting(newTestWaitingEntry("low", "someone", 10, size)))
+	a.Equal(uint64(1), m.rejected)
+
+	// higher priorities evict the lowest ones
+	a.Equal(1, m.addToWaiting(newTestWaitingEntry("high", "someone", 100, size)))
+	a.Equal(uint64(1), m.evicted)
+	a.Nil(m.waiting.Get("t0"))
+	a.Equal("high", m.waiting.Best().trxId)
+	a.Equal("t1", m.waiting.Worst().trxId)
+	a.Equal(sMaxWaitingSize, m.waiting.Size())
+}
+
+func newTestNonceEntry(id, signer string, nonce uint64, expiration uint32, cancel bool) *TrxEntry {
+	e := newTestWaitingEntry(id, signer, 10, 100)
+	e.result.SigTrx = &prototype.SignedTransaction{
+		Trx: &prototype.Transaction{
+			Expiration: prototype.NewTimePointSec(expiration),
+			Nonce:      nonce,
+			Cancel:     cancel,
+		},
+	}
+	return e
+}
+
+func TestWaitingPoolReplacement(t *testing.T) {
+	a := assert.New(t)
+	m := &TrxMgr{waiting: newWaitingPool(), fetched: make(map[string]*TrxEntry), nonces: make(map[string]*trxNonce)}
+
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t1", "alice", 1, 100, false)))
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t2", "bob", 1, 100, false)))
+
+	// a trx of the same nonce but no later expiration is refused
+	a.Equal(0, m.addToWaiting(newTestNonceEntry("t3", "alice", 1, 100, false)))
+	a.NotNil(m.waiting.Get("t1"))
+
+	// a trx of later expiration replaces the pending one
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t4", "alice", 1, 200, false)))
+	a.Nil(m.waiting.Get("t1"))
+	a.NotNil(m.waiting.Get("t4"))
+
+	// a cancellation removes the pending one, and never waits itself
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t5", "alice", 1, 300, true)))
+	a.Nil(m.waiting.Get("t4"))
+	a.Nil(m.waiting.Get("t5"))
+	a.Equal(1, m.waiting.Len())
+
+	// a trx being packed can't be replaced
+	m.fetched["t2"] = m.waiting.Remove("t2")
+	a.Equal(0, m.addToWaiting(newTestNonceEntry("t6", "bob", 1, 200, false)))
+
+	// trxs without nonces are never replaced
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t7", "carol", 0, 100, false)))
+	a.Equal(1, m.addToWaiting(newTestNonceEntry("t8", "carol", 0, 200, false)))
+	a.Equal(2, m.waiting.SignerCount("carol"))
+}
+
+func TestWaitingPoolDigest(t *testing.T) {
+	a := assert.New(t)
+	m := &TrxMgr{waiting: newWaitingPool(), fetched: make(map[string]*TrxEntry), nonces: make(map[string]*trxNonce)}
+
+	// the same trx under another signature set has another id, but the same digest
+	resigned := func(e *TrxEntry, id string) *TrxEntry {
+		r := newTestNonceEntry(id, e.signer, 0, 100, false)
+		r.digest = e.digest
+		return r
+	}
+	w := newTestNonceEntry("t1", "alice", 0, 100, false)
+	a.Equal(1, m.addToWaiting(w))
+	a.Equal(0, m.addToWaiting(resigned(w, "t2")))
+
+	f := newTestNonceEntry("t3", "bob", 0, 100, false)
+	m.fetched[f.trxId] = f
+	a.Equal(0, m.addToWaiting(resigned(f, "t4")))
+
+	// the digest is released with the trx
+	m.waiting.Remove(w.trxId)
+	a.Equal(1, m.addToWaiting(resigned(w, "t5")))
+}
+
+func TestLookupTrxs(t *testing.T) {
+	a := assert.New(t)
+	m := &TrxMgr{waiting: newWaitingPool(), fetched: make(map[string]*TrxEntry), nonces: make(map[string]*trxNonce)}
+
+	w := newTestNonceEntry("waiting-trx-id-1", "alice", 0, 100, false)
+	f := newTestNonceEntry("fetched-trx-id-2", "bob", 0, 100, false)
+	m.waiting.Add(w)
+	m.fetched[f.trxId] = f
+
+	key := prototype.NewShortTrxIdKey([]byte("block-id"), 1)
+	shortId := func(id string) uint64 {
+		return key.ShortTrxId([]byte(id))
+	}
+	trxs := m.LookupTrxs(key, []uint64{shortId("fetched-trx-id-2"), shortId("missing-trx-id-3"), shortId("waiting-trx-id-1")})
+	a.Equal(3, len(trxs))
+	a.Equal(f.result.SigTrx, trxs[0])
+	a.Nil(trxs[1])
+	a.Equal(w.result.SigTrx, trxs[2])
+}
+
+func TestCleanExpiredWaiting(t *testing.T) {
+	a := assert.New(t)
+	m := &TrxMgr{waiting: newWaitingPool(), fetched: make(map[string]*TrxEntry), nonces: make(map[string]*trxNonce)}
+	m.headTime = 1000
+
+	// nothing is cleaned if the pool is small
+	m.waiting.Add(newTestNonceEntry("expired", "alice", 0, 999, false))
+	m.cleanExpiredWaiting()
+	a.Equal(1, m.waiting.Len())
+
+	// once the pool is large enough, expired trxs are removed and unexpired ones stay
+	for i := 1; i < sWaitingCountWaterMark; i++ {
+		expiration := uint32(1000)
+		if i % 2 == 0 {
+			expiration = 999
+		}
+		m.waiting.Add(newTestNonceEntry(fmt.Sprintf("t%d", i), fmt.Sprintf("signer%d", i % 7), 0, expiration, false))
+	}
+	a.Equal(sWaitingCountWaterMark, m.waiting.Len())
+	m.cleanExpiredWaiting()
+	a.Equal(sWaitingCountWaterMark / 2, m.waiting.Len())
+	a.Nil(m.waiting.Get("expired"))
+	a.Nil(m.waiting.Get("t2"))
+	a.NotNil(m.waiting.Get("t1"))
+	m.waiting.ForEach(func(e *TrxEntry) {
+		a.NoError(e.CheckExpiration(m.headTime))
+	})
+}
@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/coschain/cobra"
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/dandelion/core"
+	"github.com/coschain/contentos-go/db/blocklog"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+)
+
+var economySimulateFrom, economySimulateTo uint64
+var economySimulateFormat, economySimulateOutput string
+var economySimulatePolicy = prototype.DefaultRewardPolicy()
+
+var EconomyCmd = func() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "economy",
+		Short: "economy tools",
+	}
+
+	simulateCmd := &cobra.Command{
+		Use:     "simulate",
+		Short:   "simulate cashouts of a block range of a stopped node with an alternative reward policy, and output reward differences of accounts",
+		Long:    "The chain is replayed up to the last block of the range, and cashouts of each block in the range are run on discarded " +
+			"database patches, with both the current and the alternative reward policy. The alternative policy takes effect from " +
+			"the first block of the range, its reward pools and global weighted vps evolve by themselves, while other states follow the chain.",
+		Example: "economy simulate --from 100000 --to 200000 --weighting quadratic --format json --output rewards.json",
+		Args:    cobra.NoArgs,
+		Run:     simulateEconomy,
+	}
+	simulateCmd.Flags().StringVarP(&cfgName, "name", "n", "", "node name (default is cosd)")
+	simulateCmd.Flags().Uint64VarP(&economySimulateFrom, "from", "", 1, "the first block to simulate")
+	simulateCmd.Flags().Uint64VarP(&economySimulateTo, "to", "", 0, "the last block to simulate (default is the head of block log)")
+	simulateCmd.Flags().StringVarP(&economySimulateFormat, "format", "f", "csv", "output format, csv or json")
+	simulateCmd.Flags().StringVarP(&economySimulateOutput, "output", "o", "", "output file (default is stdout)")
+	addRewardPolicyFlags(simulateCmd, economySimulatePolicy)
+
+	cmd.AddCommand(simulateCmd)
+	return cmd
+}
+
+type economyRewardDiff struct {
+	Account     string   `json:"account"`
+	Current     *big.Int `json:"current"`
+	Alternative *big.Int `json:"alternative"`
+	Diff        *big.Int `json:"diff"`
+}
+
+type economySimulationReport struct {
+	From              uint64                  `json:"from"`
+	To                uint64                  `json:"to"`
+	AlternativePolicy *prototype.RewardPolicy `json:"alternative_policy"`
+	Accounts          []*economyRewardDiff    `json:"accounts"`
+}
+
+func simulateEconomy(cmd *cobra.Command, args []string) {
+	cfg := readConfig()
+	if err := economySimulatePolicy.Validate(); err != nil {
+		common.Fatalf("invalid reward policy: %v", err)
+	}
+	if economySimulateFormat != "csv" && economySimulateFormat != "json" {
+		common.Fatalf("unknown output format %s", economySimulateFormat)
+	}
+
+	var blog blocklog.BLog
+	if err := blog.Open(cfg.ResolvePath("blog")); err != nil {
+		common.Fatalf("failed to open block log: %v", err)
+	}
+	defer blog.Close()
+	if blog.Base() > 0 {
+		common.Fatalf("block log starts after block %d, a node bootstrapped from snapshot can't be replayed", blog.Base())
+	}
+	from, to := economySimulateFrom, uint64(blog.Size())
+	if economySimulateTo > 0 && economySimulateTo < to {
+		to = economySimulateTo
+	}
+	if from == 0 {
+		from = 1
+	}
+	if from > to {
+		common.Fatalf("empty block range [%d, %d]", from, to)
+	}
+
+	replayer := core.NewReplayer(cfg, nil)
+	if err := replayer.Start(); err != nil {
+		common.Fatalf("failed to start replayer: %v", err)
+	}
+	defer replayer.Stop()
+
+	current := app.NewRewardSimulation(nil, replayer.HardFork, nil)
+	alternative := app.NewRewardSimulation(app.NewRewardPolicy(economySimulatePolicy), replayer.HardFork, nil)
+	num := uint64(0)
+	replayer.SetEconomistHook(func(db iservices.IDatabaseService) {
+		if num >= from {
+			current.Simulate(db)
+			alternative.Simulate(db)
+		}
+	})
+	for num = 1; num <= to; num++ {
+		block := &prototype.SignedBlock{}
+		if err := blog.ReadBlock(block, int64(num)-1); err != nil {
+			common.Fatalf("failed to read block %d: %v", num, err)
+		}
+		if err := replayer.PushBlock(block); err != nil {
+			common.Fatalf("%v", err)
+		}
+		if num % rewardsReplayProgressInterval == 0 {
+			fmt.Fprintf(os.Stderr, "replayed %d/%d blocks\n", num, to)
+		}
+	}
+
+	report := &economySimulationReport{
+		From: from,
+		To: to,
+		AlternativePolicy: economySimulatePolicy,
+		Accounts: diffSimulatedRewards(current.Rewards(), alternative.Rewards()),
+	}
+	out := io.Writer(os.Stdout)
+	if len(economySimulateOutput) > 0 {
+		f, err := os.Create(economySimulateOutput)
+		if err != nil {
+			common.Fatalf("failed to create output file: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	var err error
+	if economySimulateFormat == "json" {
+		err = writeSimulationJson(out, report)
+	} else {
+		err = writeSimulationCsv(out, report)
+	}
+	if err != nil {
+		common.Fatalf("failed to write output: %v", err)
+	}
+}
+
+// diffSimulatedRewards returns reward differences of all rewarded accounts, the largest differences first.
+func diffSimulatedRewards(current, alternative map[string]*big.Int) []*economyRewardDiff {
+	diffs := make(map[string]*economyRewardDiff)
+	get := func(account string) *economyRewardDiff {
+		d := diffs[account]
+		if d == nil {
+			d = &economyRewardDiff{Account: account, Current: new(big.Int), Alternative: new(big.Int)}
+			diffs[account] = d
+		}
+		return d
+	}
+	for account, reward := range current {
+		get(account).Current.Set(reward)
+	}
+	for account, reward := range alternative {
+		get(account).Alternative.Set(reward)
+	}
+	var result []*economyRewardDiff
+	for _, d := range diffs {
+		d.Diff = new(big.Int).Sub(d.Alternative, d.Current)
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if c := new(big.Int).Abs(result[i].Diff).Cmp(new(big.Int).Abs(result[j].Diff)); c != 0 {
+			return c > 0
+		}
+		return result[i].Account < result[j].Account
+	})
+	return result
+}
+
+func writeSimulationJson(w io.Writer, report *economySimulationReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func writeSimulationCsv(w io.Writer, report *economySimulationReport) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"account", "current", "alternative", "diff"})
+	for _, d := range report.Accounts {
+		_ = cw.Write([]string{d.Account, d.Current.String(), d.Alternative.String(), d.Diff.String()})
+	}
+	cw.Flush()
+	return cw.Error()
+}
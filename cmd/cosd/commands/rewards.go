@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/coschain/cobra"
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/dandelion/core"
+	"github.com/coschain/contentos-go/db/blocklog"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+)
+
+const rewardsReplayProgressInterval = 10000
+
+var rewardsReplayTo uint64
+var rewardsTopN int
+var rewardsPolicy = prototype.DefaultRewardPolicy()
+
+var RewardsCmd = func() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "reward policy tools",
+	}
+
+	compareCmd := &cobra.Command{
+		Use:     "compare",
+		Short:   "replay blocks of a stopped node with a candidate reward policy, and compare rewards with the current one",
+		Example: "rewards compare --weighting quadratic --author-rate 5000 --voter-rate 3500 --to 100000",
+		Args:    cobra.NoArgs,
+		Run:     compareRewards,
+	}
+	compareCmd.Flags().StringVarP(&cfgName, "name", "n", "", "node name (default is cosd)")
+	compareCmd.Flags().Uint64VarP(&rewardsReplayTo, "to", "", 0, "the last block to replay (default is the head of block log)")
+	compareCmd.Flags().IntVarP(&rewardsTopN, "top", "", 20, "number of accounts of the largest reward differences to show")
+	addRewardPolicyFlags(compareCmd, rewardsPolicy)
+
+	cmd.AddCommand(compareCmd)
+	return cmd
+}
+
+// addRewardPolicyFlags adds flags of a candidate reward policy to cmd, defaults are taken from policy.
+func addRewardPolicyFlags(cmd *cobra.Command, policy *prototype.RewardPolicy) {
+	cmd.Flags().StringVarP(&policy.Weighting, "weighting", "", policy.Weighting, "weighting curve, linear or quadratic")
+	cmd.Flags().Uint32VarP(&policy.CreatorRate, "creator-rate", "", policy.CreatorRate, "creator rate of block rewards, in 1/10000")
+	cmd.Flags().Uint32VarP(&policy.DappRate, "dapp-rate", "", policy.DappRate, "dapp rate of block rewards, in 1/10000")
+	cmd.Flags().Uint32VarP(&policy.AuthorRate, "author-rate", "", policy.AuthorRate, "author rate of creator rewards, in 1/10000")
+	cmd.Flags().Uint32VarP(&policy.ReplyRate, "reply-rate", "", policy.ReplyRate, "reply rate of creator rewards, in 1/10000")
+	cmd.Flags().Uint32VarP(&policy.VoterRate, "voter-rate", "", policy.VoterRate, "voter rate of creator rewards, in 1/10000")
+}
+
+func compareRewards(cmd *cobra.Command, args []string) {
+	cfg := readConfig()
+	if err := rewardsPolicy.Validate(); err != nil {
+		common.Fatalf("invalid reward policy: %v", err)
+	}
+
+	var blog blocklog.BLog
+	if err := blog.Open(cfg.ResolvePath("blog")); err != nil {
+		common.Fatalf("failed to open block log: %v", err)
+	}
+	defer blog.Close()
+	if blog.Base() > 0 {
+		common.Fatalf("block log starts after block %d, a node bootstrapped from snapshot can't be replayed", blog.Base())
+	}
+	to := uint64(blog.Size())
+	if rewardsReplayTo > 0 && rewardsReplayTo < to {
+		to = rewardsReplayTo
+	}
+
+	baseline, candidate := core.NewReplayer(cfg, nil), core.NewReplayer(cfg, nil)
+	if err := baseline.Start(); err != nil {
+		common.Fatalf("failed to start replayer: %v", err)
+	}
+	defer baseline.Stop()
+	if err := candidate.Start(); err != nil {
+		common.Fatalf("failed to start replayer: %v", err)
+	}
+	defer candidate.Stop()
+	candidate.SetRewardPolicy(app.NewRewardPolicy(rewardsPolicy))
+
+	// the candidate chain stops at the first block it fails to apply, e.g. a transaction running out of stamina
+	// because of different rewards. rewards are compared at the last block both chains have applied.
+	replayed := uint64(0)
+	for num := uint64(1); num <= to; num++ {
+		block := &prototype.SignedBlock{}
+		if err := blog.ReadBlock(block, int64(num)-1); err != nil {
+			common.Fatalf("failed to read block %d: %v", num, err)
+		}
+		if err := baseline.PushBlock(block); err != nil {
+			common.Fatalf("%v", err)
+		}
+		if err := candidate.PushBlock(block); err != nil {
+			fmt.Printf("candidate policy diverged: %v\n", err)
+			break
+		}
+		replayed = num
+		if num % rewardsReplayProgressInterval == 0 {
+			fmt.Printf("replayed %d/%d blocks\n", num, to)
+		}
+	}
+	if replayed == 0 {
+		return
+	}
+	fmt.Printf("compared at block %d\n\n", replayed)
+	printRewardsComparison(baseline.Database(), candidate.Database(), rewardsTopN)
+}
+
+func printRewardsComparison(baseline, candidate iservices.IDatabaseRW, topN int) {
+	bp := table.NewSoGlobalWrap(baseline, &app.SingleId).GetProps()
+	cp := table.NewSoGlobalWrap(candidate, &app.SingleId).GetProps()
+
+	fmt.Printf("%-24s%24s%24s\n", "", "current", "candidate")
+	fmt.Printf("%-24s%24s%24s\n", "weighting", app.NewRewardPolicy(bp.GetRewardPolicy()).Name(), app.NewRewardPolicy(rewardsPolicy).Name())
+	for _, row := range []struct {
+		name string
+		current, candidate *prototype.Vest
+	}{
+		{"claimed post rewards", bp.GetClaimedPostRewards(), cp.GetClaimedPostRewards()},
+		{"claimed reply rewards", bp.GetClaimedReplyRewards(), cp.GetClaimedReplyRewards()},
+		{"claimed vote rewards", bp.GetClaimedVoteRewards(), cp.GetClaimedVoteRewards()},
+		{"claimed dapp rewards", bp.GetClaimedDappRewards(), cp.GetClaimedDappRewards()},
+		{"pool post rewards", bp.GetPoolPostRewards(), cp.GetPoolPostRewards()},
+		{"pool reply rewards", bp.GetPoolReplyRewards(), cp.GetPoolReplyRewards()},
+		{"pool vote rewards", bp.GetPoolVoteRewards(), cp.GetPoolVoteRewards()},
+		{"pool dapp rewards", bp.GetPoolDappRewards(), cp.GetPoolDappRewards()},
+	} {
+		fmt.Printf("%-24s%24d%24d\n", row.name, row.current.GetValue(), row.candidate.GetValue())
+	}
+
+	// accounts are the same in both chains, and differences of their vests come from rewards.
+	type accountDiff struct {
+		name string
+		current, candidate uint64
+		diff *big.Int
+	}
+	var diffs []*accountDiff
+	_ = table.NewAccountCreatedTimeWrap(baseline).ForEachByOrder(nil, nil, nil, nil,
+		func(name *prototype.AccountName, created *prototype.TimePointSec, idx uint32) bool {
+			currentVest := table.NewSoAccountWrap(baseline, name).GetVest().GetValue()
+			candidateVest := table.NewSoAccountWrap(candidate, name).GetVest().GetValue()
+			if currentVest != candidateVest {
+				diff := new(big.Int).Sub(new(big.Int).SetUint64(candidateVest), new(big.Int).SetUint64(currentVest))
+				diffs = append(diffs, &accountDiff{name: name.GetValue(), current: currentVest, candidate: candidateVest, diff: diff})
+			}
+			return true
+		})
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return new(big.Int).Abs(diffs[i].diff).Cmp(new(big.Int).Abs(diffs[j].diff)) > 0
+	})
+	fmt.Printf("\n%d accounts got different rewards\n", len(diffs))
+	if len(diffs) > topN {
+		diffs = diffs[:topN]
+	}
+	for _, d := range diffs {
+		sign := ""
+		if d.diff.Sign() > 0 {
+			sign = "+"
+		}
+		fmt.Printf("%-24s%24d%24d%24s\n", d.name, d.current, d.candidate, sign + d.diff.String())
+	}
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/coschain/cobra"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/consensus"
+)
+
+var snapshotAt uint64
+var snapshotOutput string
+var snapshotHash string
+
+var SnapshotCmd = func() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "export or import state snapshots",
+	}
+
+	exportCmd := &cobra.Command{
+		Use:     "export",
+		Short:   "export the state at the last irreversible block, the node must be stopped",
+		Example: "snapshot export --at 1000 -o state.snapshot",
+		Args:    cobra.NoArgs,
+		Run:     exportSnapshot,
+	}
+	exportCmd.Flags().StringVarP(&cfgName, "name", "n", "", "node name (default is cosd)")
+	exportCmd.Flags().Uint64VarP(&snapshotAt, "at", "", 0, "the last irreversible block number (default is the current one)")
+	exportCmd.Flags().StringVarP(&snapshotOutput, "output", "o", "", "output file (default is snapshot_<block number>)")
+
+	importCmd := &cobra.Command{
+		Use:     "import",
+		Short:   "bootstrap an empty node from a state snapshot",
+		Example: "snapshot import --hash 5f2c...e1 state.snapshot",
+		Args:    cobra.ExactArgs(1),
+		Run:     importSnapshot,
+	}
+	importCmd.Flags().StringVarP(&cfgName, "name", "n", "", "node name (default is cosd)")
+	importCmd.Flags().StringVarP(&snapshotHash, "hash", "", "", "expected content hash in hex, from a trusted source (required)")
+
+	cmd.AddCommand(exportCmd)
+	cmd.AddCommand(importCmd)
+	return cmd
+}
+
+func exportSnapshot(cmd *cobra.Command, args []string) {
+	cfg := readConfig()
+
+	output := snapshotOutput
+	if output == "" {
+		if snapshotAt == 0 {
+			common.Fatalf("either --at or --output must be specified")
+		}
+		output = fmt.Sprintf("snapshot_%d", snapshotAt)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		common.Fatalf("failed to create %s: %v", output, err)
+	}
+	defer f.Close()
+
+	header, contentHash, err := consensus.ExportSnapshot(cfg, snapshotAt, f)
+	if err != nil {
+		_ = os.Remove(output)
+		common.Fatalf("failed to export snapshot: %v", err)
+	}
+	fmt.Printf("exported state at block %d to %s\nstate root: %s\ncontent hash: %s\n", header.BlockNum, output, hex.EncodeToString(header.StateRoot), hex.EncodeToString(contentHash))
+}
+
+func importSnapshot(cmd *cobra.Command, args []string) {
+	cfg := readConfig()
+
+	if snapshotHash == "" {
+		common.Fatalf("--hash is required, get the content hash of the snapshot from a trusted source")
+	}
+	trustedHash, err := hex.DecodeString(snapshotHash)
+	if err != nil {
+		common.Fatalf("invalid content hash: %v", err)
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		common.Fatalf("failed to open %s: %v", args[0], err)
+	}
+	defer f.Close()
+
+	header, contentHash, err := consensus.ImportSnapshot(cfg, f, trustedHash)
+	if err != nil {
+		common.Fatalf("failed to import snapshot: %v", err)
+	}
+	fmt.Printf("imported state at block %d\nstate root: %s\ncontent hash: %s\n", header.BlockNum, hex.EncodeToString(header.StateRoot), hex.EncodeToString(contentHash))
+}
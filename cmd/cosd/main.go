@@ -19,6 +19,9 @@ func addCommands() {
 	rootCmd.AddCommand(commands.StartCmd())
 	rootCmd.AddCommand(commands.DbCmd())
 	rootCmd.AddCommand(commands.FastSyncCmd())
+	rootCmd.AddCommand(commands.SnapshotCmd())
+	rootCmd.AddCommand(commands.RewardsCmd())
+	rootCmd.AddCommand(commands.EconomyCmd())
 }
 
 func main() {
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/coschain/cobra"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/commands/utils"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/wallet"
+)
+
+var keystoreImportForce bool
+
+var KeystoreCmd = func() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keystore",
+		Short: "import and export accounts in standard scrypt/pbkdf2 json keystore format",
+	}
+
+	importCmd := &cobra.Command{
+		Use:     "import",
+		Short:   "import an account from a keystore file",
+		Long:    "import an account from a scrypt or pbkdf2 json keystore file, the key is encrypted again with the wallet passphrase",
+		Example: "keystore import [name] [file]",
+		Args:    cobra.ExactArgs(2),
+		Run:     importKeystore,
+	}
+	importCmd.Flags().BoolVarP(&keystoreImportForce, "force", "f", false, "keystore import --force")
+
+	exportCmd := &cobra.Command{
+		Use:     "export",
+		Short:   "export an account to a keystore file",
+		Long:    "export an account to a keystore file. accounts in legacy format should be unlocked once to migrate first",
+		Example: "keystore export [name] [file]",
+		Args:    cobra.ExactArgs(2),
+		Run:     exportKeystore,
+	}
+
+	cmd.AddCommand(importCmd)
+	cmd.AddCommand(exportCmd)
+	return cmd
+}
+
+func importKeystore(cmd *cobra.Command, args []string) {
+	defer func() {
+		keystoreImportForce = false
+	}()
+	w := cmd.Context["wallet"]
+	mywallet := w.(wallet.Wallet)
+	r := cmd.Context["preader"]
+	preader := r.(utils.PasswordReader)
+	name := args[0]
+
+	if !keystoreImportForce {
+		_ = mywallet.Load(name)
+		if mywallet.IsExist(name) {
+			fmt.Println(fmt.Sprintf("the account: %s has been in your local keychain, please load it or you can keystore import -f",
+				name))
+			return
+		}
+	}
+	keyjson, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	passphrase, err := utils.GetPassphrase(preader)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err = mywallet.ImportKeystore(name, keyjson, passphrase); err != nil {
+		fmt.Println(fmt.Sprintf("error: %v", err))
+		return
+	}
+	fmt.Println(fmt.Sprintf("import account %s success", name))
+}
+
+func exportKeystore(cmd *cobra.Command, args []string) {
+	w := cmd.Context["wallet"]
+	mywallet := w.(wallet.Wallet)
+	name := args[0]
+
+	keyjson, err := mywallet.ExportKeystore(name)
+	if err != nil {
+		fmt.Println(fmt.Sprintf("error: %v", err))
+		return
+	}
+	if err = ioutil.WriteFile(args[1], keyjson, 0600); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(fmt.Sprintf("export account %s to %s success", name, args[1]))
+}
@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/coschain/cobra"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/commands/utils"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/wallet"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+)
+
+var txExpiration uint32
+var txNonce uint64
+var txCancel bool
+var txSignOutput string
+
+var TxCmd = func() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "build, sign and broadcast transactions in separate steps",
+		Long: "offline signing workflow: build an unsigned transaction on a networked machine, " +
+			"sign it on an offline machine holding the keys, then broadcast it on a networked machine",
+	}
+
+	buildCmd := &cobra.Command{
+		Use:   "build",
+		Short: "build an unsigned transaction file from operations",
+		Long: "build an unsigned transaction file referring to the head block. operations are read from a json file " +
+			"holding an array of operations, e.g. " +
+			`[{"op2":{"from":{"value":"alice"},"to":{"value":"bob"},"amount":{"value":"1000000"},"memo":""}}]. ` +
+			fmt.Sprintf("the chain only accepts transactions expiring in %d seconds, so set --expiration ", constants.TrxMaxExpirationTime) +
+			"to cover the time needed to sign offline, and broadcast the transaction shortly before it expires. " +
+			"with --nonce, the transaction can be replaced by a later one of the same signer and nonce before it gets into a block, " +
+			"or be cancelled by one built with both --nonce and --cancel",
+		Example: "tx build ops.json tx.json --expiration 3600 --nonce 1",
+		Args:    cobra.ExactArgs(2),
+		Run:     txBuild,
+	}
+	buildCmd.Flags().Uint32VarP(&txExpiration, "expiration", "e", 30, `tx build ops.json tx.json --expiration 3600`)
+	buildCmd.Flags().Uint64VarP(&txNonce, "nonce", "n", 0, `tx build ops.json tx.json --nonce 1`)
+	buildCmd.Flags().BoolVarP(&txCancel, "cancel", "c", false, `tx build ops.json tx.json --nonce 1 --cancel`)
+
+	signCmd := &cobra.Command{
+		Use:     "sign",
+		Short:   "sign a transaction file offline",
+		Long: "sign a transaction file with the key of an account, which should be unlocked first. no network access is needed. " +
+			"a transaction requiring signatures of several keys can be signed repeatedly, and signatures are collected in the file",
+		Example: "tx sign alice tx.json",
+		Args:    cobra.ExactArgs(2),
+		Run:     txSign,
+	}
+	signCmd.Flags().StringVarP(&txSignOutput, "output", "o", "", `tx sign alice tx.json --output signed.json`)
+
+	broadcastCmd := &cobra.Command{
+		Use:     "broadcast",
+		Short:   "broadcast a signed transaction file",
+		Example: "tx broadcast signed.json",
+		Args:    cobra.ExactArgs(1),
+		Run:     txBroadcast,
+	}
+
+	cmd.AddCommand(buildCmd)
+	cmd.AddCommand(signCmd)
+	cmd.AddCommand(broadcastCmd)
+	return cmd
+}
+
+func txBuild(cmd *cobra.Command, args []string) {
+	defer func() {
+		txExpiration = 30
+		txNonce = 0
+		txCancel = false
+	}()
+	c := cmd.Context["rpcclient"]
+	client := c.(grpcpb.ApiServiceClient)
+	chainId := cmd.Context["chain_id"].(prototype.ChainId)
+
+	ops, err := readOperations(args[0])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	chainState, err := utils.GetChainState(client)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	tx := utils.GenerateUnsignedTx(chainState.Dgpo, txExpiration, nil)
+	tx.Operations = ops
+	tx.Nonce = txNonce
+	tx.Cancel = txCancel
+	if err = tx.Validate(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	f, err := utils.NewTxFile(tx, chainId)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err = f.Write(args[1]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(fmt.Sprintf("unsigned transaction written to %s, expiring at %s", args[1], txTimeString(tx.Expiration.UtcSeconds)))
+}
+
+func txSign(cmd *cobra.Command, args []string) {
+	defer func() {
+		txSignOutput = ""
+	}()
+	w := cmd.Context["wallet"]
+	mywallet := w.(wallet.Wallet)
+	signer := args[0]
+	path := args[1]
+	output := path
+	if len(txSignOutput) > 0 {
+		output = txSignOutput
+	}
+
+	signerAccount, ok := mywallet.GetUnlockedAccount(signer)
+	if !ok {
+		fmt.Println(fmt.Sprintf("account: %s should be unlocked or created first", signer))
+		return
+	}
+	privKey, err := prototype.PrivateKeyFromWIF(signerAccount.PrivKey)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	f, err := utils.ReadTxFile(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	opsJson, _ := json.MarshalIndent(f.Operations, "", "  ")
+	fmt.Println(fmt.Sprintf("signing transaction of chain %d, expiring at %s, nonce %d, cancel %v, signed %d time(s), operations:\n%s",
+		f.ChainId, txTimeString(f.Expiration), f.Nonce, f.Cancel, txSignatureCount(f), string(opsJson)))
+
+	if err = f.Sign(privKey); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err = f.Write(output); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(fmt.Sprintf("signed transaction written to %s, signed %d time(s)", output, txSignatureCount(f)))
+}
+
+//txSignatureCount returns the number of signatures collected in given transaction file
+func txSignatureCount(f *utils.TxFile) int {
+	if len(f.Signature) == 0 {
+		return 0
+	}
+	return 1 + len(f.Signatures)
+}
+
+func txBroadcast(cmd *cobra.Command, args []string) {
+	c := cmd.Context["rpcclient"]
+	client := c.(grpcpb.ApiServiceClient)
+	chainId := cmd.Context["chain_id"].(prototype.ChainId)
+
+	f, err := utils.ReadTxFile(args[0])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if f.ChainId != chainId.Value {
+		fmt.Println(fmt.Sprintf("transaction is built for chain %d, but connected to chain %d", f.ChainId, chainId.Value))
+		return
+	}
+	signTx, err := f.SignedTrx()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	chainState, err := utils.GetChainState(client)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	headTime := chainState.Dgpo.Time.UtcSeconds
+	if f.Expiration <= headTime {
+		fmt.Println(fmt.Sprintf("transaction expired at %s", txTimeString(f.Expiration)))
+		return
+	}
+	if f.Expiration > headTime+constants.TrxMaxExpirationTime {
+		fmt.Println(fmt.Sprintf("transaction can't be broadcast until %s", txTimeString(f.Expiration-constants.TrxMaxExpirationTime)))
+		return
+	}
+
+	req := &grpcpb.BroadcastTrxRequest{Transaction: signTx}
+	resp, err := client.BroadcastTrx(context.Background(), req)
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Println(fmt.Sprintf("Result: %v", resp))
+	}
+}
+
+//readOperations reads operations from a json file holding an array of operations
+func readOperations(path string) ([]*prototype.Operation, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ops []*prototype.Operation
+	if err = json.Unmarshal(data, &ops); err != nil {
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, errors.New("no operations found")
+	}
+	for i, op := range ops {
+		if op == nil || op.Op == nil {
+			return nil, fmt.Errorf("invalid operation at index %d", i)
+		}
+	}
+	return ops, nil
+}
+
+func txTimeString(utcSeconds uint32) string {
+	return time.Unix(int64(utcSeconds), 0).UTC().Format(time.RFC3339)
+}
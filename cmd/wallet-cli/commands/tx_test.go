@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coschain/contentos-go/cmd/wallet-cli/commands/utils"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/commands/utils/mock"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/wallet"
+	"github.com/coschain/contentos-go/cmd/wallet-cli/wallet/mock"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/mock_grpcpb"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_grpcpb.NewMockApiServiceClient(ctrl)
+	mywallet := mock_wallet.NewMockWallet(ctrl)
+	myassert := assert.New(t)
+	cmd := TxCmd()
+	cmd.SetContext("wallet", mywallet)
+	cmd.SetContext("rpcclient", client)
+	cmd.SetContext("chain_id", prototype.ChainId{})
+	for _, child := range cmd.Commands() {
+		child.Context = cmd.Context
+	}
+
+	dir, err := ioutil.TempDir("", "offline_signing")
+	myassert.NoError(err)
+	defer os.RemoveAll(dir)
+	opsFile := filepath.Join(dir, "ops.json")
+	txFile := filepath.Join(dir, "tx.json")
+	signedFile := filepath.Join(dir, "signed.json")
+	myassert.NoError(ioutil.WriteFile(opsFile, []byte(
+		`[{"op2":{"from":{"value":"initminer"},"to":{"value":"kochiya"},"amount":{"value":"500"},"memo":"offline"}}]`), 0644))
+
+	// build
+	mock_utils.NeedChainState(client)
+	cmd.SetArgs([]string{"build", opsFile, txFile})
+	_, err = cmd.ExecuteC()
+	myassert.NoError(err)
+	f, err := utils.ReadTxFile(txFile)
+	myassert.NoError(err)
+	myassert.Empty(f.Signature)
+	myassert.Equal(uint32(30), f.Expiration)
+	myassert.Equal("kochiya", f.Operations[0].GetOp2().To.Value)
+
+	// sign
+	priv_account := &wallet.PrivAccount{
+		Account: wallet.Account{
+			Name:   "initminer",
+			PubKey: "COS5JVLLcTPhq4Unr194JzWPDNSYGoMcam8yxnsjgRVo3Nb7ioyFW",
+		},
+		PrivKey: "4DjYx2KAGh1NP3dai7MZTLUBMMhMBPmwouKE8jhVSESywccpVZ",
+	}
+	mywallet.EXPECT().GetUnlockedAccount("initminer").Return(priv_account, true)
+	cmd.SetArgs([]string{"sign", "initminer", txFile, "--output", signedFile})
+	_, err = cmd.ExecuteC()
+	myassert.NoError(err)
+	f, err = utils.ReadTxFile(signedFile)
+	myassert.NoError(err)
+	signTx, err := f.SignedTrx()
+	myassert.NoError(err)
+	pubKey, _ := prototype.PublicKeyFromWIF(priv_account.PubKey)
+	myassert.True(signTx.VerifySig(pubKey, prototype.ChainId{}))
+
+	// broadcast
+	resp := &grpcpb.BroadcastTrxResponse{Status: 1, Msg: "success"}
+	client.EXPECT().BroadcastTrx(gomock.Any(), gomock.Any()).Return(resp, nil).Do(func(context interface{}, req *grpcpb.BroadcastTrxRequest) {
+		transfer_op := req.Transaction.Trx.Operations[0].GetOp2()
+		myassert.Equal("initminer", transfer_op.From.Value)
+		myassert.Equal(uint64(500), transfer_op.Amount.Value)
+		myassert.Equal(signTx.Signature.Sig, req.Transaction.Signature.Sig)
+	})
+	cmd.SetArgs([]string{"broadcast", signedFile})
+	_, err = cmd.ExecuteC()
+	myassert.NoError(err)
+}
+
+func TestTxFileTampered(t *testing.T) {
+	myassert := assert.New(t)
+	dir, err := ioutil.TempDir("", "offline_signing")
+	myassert.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tx.json")
+
+	tx := &prototype.Transaction{Expiration: &prototype.TimePointSec{UtcSeconds: 30}}
+	tx.AddOperation(&prototype.TransferOperation{
+		From:   &prototype.AccountName{Value: "initminer"},
+		To:     &prototype.AccountName{Value: "kochiya"},
+		Amount: prototype.NewCoin(500),
+	})
+	f, err := utils.NewTxFile(tx, prototype.ChainId{})
+	myassert.NoError(err)
+
+	// the readable operations must match the encoded transaction
+	f.Operations[0].GetOp2().To.Value = "mallory"
+	myassert.NoError(f.Write(path))
+	_, err = utils.ReadTxFile(path)
+	myassert.Error(err)
+}
+
+func TestTxFileMultiSig(t *testing.T) {
+	myassert := assert.New(t)
+	tx := &prototype.Transaction{Expiration: &prototype.TimePointSec{UtcSeconds: 30}, Nonce: 7}
+	tx.AddOperation(&prototype.TransferOperation{
+		From:   &prototype.AccountName{Value: "initminer"},
+		To:     &prototype.AccountName{Value: "kochiya"},
+		Amount: prototype.NewCoin(500),
+	})
+	f, err := utils.NewTxFile(tx, prototype.ChainId{})
+	myassert.NoError(err)
+	myassert.Equal(uint64(7), f.Nonce)
+
+	// signatures of different keys are collected
+	key0, _ := prototype.GenerateNewKey()
+	key1, _ := prototype.GenerateNewKey()
+	myassert.NoError(f.Sign(key0))
+	myassert.NoError(f.Sign(key1))
+	myassert.Len(f.Signatures, 1)
+
+	// signing twice with the same key is rejected, and the file is left untouched
+	myassert.Error(f.Sign(key1))
+	myassert.Len(f.Signatures, 1)
+
+	signTx, err := f.SignedTrx()
+	myassert.NoError(err)
+	keys, err := signTx.ExportAllPubKeys(prototype.ChainId{})
+	myassert.NoError(err)
+	pub0, _ := key0.PubKey()
+	pub1, _ := key1.PubKey()
+	myassert.Len(keys, 2)
+	myassert.True(keys[0].Equal(pub0))
+	myassert.True(keys[1].Equal(pub1))
+	myassert.Equal(uint64(7), signTx.Trx.Nonce)
+}
+
+func TestTxFileNonce(t *testing.T) {
+	myassert := assert.New(t)
+	dir, err := ioutil.TempDir("", "offline_signing")
+	myassert.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tx.json")
+
+	tx := &prototype.Transaction{Expiration: &prototype.TimePointSec{UtcSeconds: 30}, Nonce: 1, Cancel: true}
+	tx.AddOperation(&prototype.TransferOperation{
+		From:   &prototype.AccountName{Value: "initminer"},
+		To:     &prototype.AccountName{Value: "kochiya"},
+		Amount: prototype.NewCoin(500),
+	})
+	f, err := utils.NewTxFile(tx, prototype.ChainId{})
+	myassert.NoError(err)
+	myassert.NoError(f.Write(path))
+	f, err = utils.ReadTxFile(path)
+	myassert.NoError(err)
+	myassert.Equal(uint64(1), f.Nonce)
+	myassert.True(f.Cancel)
+
+	// the readable nonce and cancellation flag must match the encoded transaction
+	f.Cancel = false
+	myassert.NoError(f.Write(path))
+	_, err = utils.ReadTxFile(path)
+	myassert.Error(err)
+
+	// cancellations without nonce are invalid
+	tx.Nonce = 0
+	f, err = utils.NewTxFile(tx, prototype.ChainId{})
+	myassert.NoError(err)
+	myassert.NoError(f.Write(path))
+	_, err = utils.ReadTxFile(path)
+	myassert.Error(err)
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/golang/protobuf/proto"
+)
+
+const TxFileVersion = 1
+
+//TxFile is the file format of transactions in the offline signing workflow.
+//The transaction is stored in protobuf encoding, so that its hash is stable across builds of wallet-cli.
+//Expiration, Nonce, Cancel and Operations are readable copies for review, which must match the encoded transaction.
+//Signatures are collected one by one, so that a multi-signature transaction can be signed on separate machines.
+type TxFile struct {
+	Version     int                    `json:"version"`
+	ChainId     uint32                 `json:"chain_id"`
+	Transaction string                 `json:"transaction"`          // hex of protobuf encoded prototype.Transaction
+	Signature   string                 `json:"signature,omitempty"`  // hex of the primary signature, empty if unsigned
+	Signatures  []string               `json:"signatures,omitempty"` // hex of co-signatures
+	Expiration  uint32                 `json:"expiration"`           // readable copy of the expiration
+	Nonce       uint64                 `json:"nonce,omitempty"`      // readable copy of the nonce
+	Cancel      bool                   `json:"cancel,omitempty"`     // readable copy of the cancellation flag
+	Operations  []*prototype.Operation `json:"operations"`
+}
+
+//NewTxFile returns an unsigned TxFile of given transaction
+func NewTxFile(tx *prototype.Transaction, chainId prototype.ChainId) (*TxFile, error) {
+	data, err := proto.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &TxFile{
+		Version:     TxFileVersion,
+		ChainId:     chainId.Value,
+		Transaction: hex.EncodeToString(data),
+		Expiration:  tx.Expiration.UtcSeconds,
+		Nonce:       tx.Nonce,
+		Cancel:      tx.Cancel,
+		Operations:  tx.Operations,
+	}, nil
+}
+
+//ReadTxFile reads a TxFile from given path, and checks its readable parts against the encoded transaction
+func ReadTxFile(path string) (*TxFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := new(TxFile)
+	if err = json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	if f.Version != TxFileVersion {
+		return nil, fmt.Errorf("unsupported transaction file version %d", f.Version)
+	}
+	tx, err := f.Trx()
+	if err != nil {
+		return nil, err
+	}
+	if tx.Expiration == nil || tx.Expiration.UtcSeconds != f.Expiration || tx.Nonce != f.Nonce || tx.Cancel != f.Cancel ||
+		len(tx.Operations) != len(f.Operations) {
+		return nil, errors.New("transaction file is inconsistent with the encoded transaction")
+	}
+	for i, op := range tx.Operations {
+		if !proto.Equal(op, f.Operations[i]) {
+			return nil, errors.New("transaction file is inconsistent with the encoded transaction")
+		}
+	}
+	if err = tx.Validate(); err != nil {
+		return nil, err
+	}
+	if len(f.Signature) > 0 {
+		if _, err = f.SignedTrx(); err != nil {
+			return nil, err
+		}
+	} else if len(f.Signatures) > 0 {
+		return nil, errors.New("co-signatures found without a primary signature")
+	}
+	return f, nil
+}
+
+//Write writes the TxFile to given path
+func (f *TxFile) Write(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+//Trx decodes the transaction
+func (f *TxFile) Trx() (*prototype.Transaction, error) {
+	data, err := hex.DecodeString(f.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	tx := new(prototype.Transaction)
+	if err = proto.Unmarshal(data, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+//Sign signs the transaction with given key.
+//The first signature becomes the primary one, and later signatures of other keys are added as co-signatures.
+func (f *TxFile) Sign(privKey *prototype.PrivateKeyType) error {
+	tx, err := f.Trx()
+	if err != nil {
+		return err
+	}
+	signTx := &prototype.SignedTransaction{Trx: tx}
+	sig := signTx.Sign(privKey, prototype.ChainId{Value: f.ChainId})
+	sigHex := hex.EncodeToString(sig)
+	if len(f.Signature) == 0 {
+		f.Signature = sigHex
+	} else {
+		f.Signatures = append(f.Signatures, sigHex)
+	}
+	if _, err = f.SignedTrx(); err != nil {
+		if len(f.Signatures) > 0 {
+			f.Signatures = f.Signatures[:len(f.Signatures)-1]
+		} else {
+			f.Signature = ""
+		}
+		return err
+	}
+	return nil
+}
+
+//SignedTrx returns the signed transaction
+func (f *TxFile) SignedTrx() (*prototype.SignedTransaction, error) {
+	if len(f.Signature) == 0 {
+		return nil, errors.New("transaction is not signed")
+	}
+	tx, err := f.Trx()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(f.Signature)
+	if err != nil {
+		return nil, err
+	}
+	signTx := &prototype.SignedTransaction{Trx: tx, Signature: &prototype.SignatureType{Sig: sig}}
+	for _, s := range f.Signatures {
+		if sig, err = hex.DecodeString(s); err != nil {
+			return nil, err
+		}
+		signTx.Signatures = append(signTx.Signatures, &prototype.SignatureType{Sig: sig})
+	}
+	if err = signTx.Validate(); err != nil {
+		return nil, err
+	}
+	return signTx, nil
+}
@@ -59,13 +59,7 @@ func GetChainState(client grpcpb.ApiServiceClient) (*grpcpb.ChainState, error) {
 }
 
 func GenerateSignedTxAndValidate4(dgp *prototype.DynamicProperties, expiration uint32, ops []interface{}, privKey *prototype.PrivateKeyType, chainId prototype.ChainId) (*prototype.SignedTransaction, error) {
-	refBlockPrefix := common.TaposRefBlockPrefix(dgp.HeadBlockId.Hash)
-	// occupant implement
-	refBlockNum := common.TaposRefBlockNum(dgp.HeadBlockNumber)
-	tx := &prototype.Transaction{RefBlockNum: refBlockNum, RefBlockPrefix: refBlockPrefix, Expiration: &prototype.TimePointSec{UtcSeconds: dgp.Time.UtcSeconds + expiration}}
-	for _, op := range ops {
-		tx.AddOperation(op)
-	}
+	tx := GenerateUnsignedTx(dgp, expiration, ops)
 
 	signTx := prototype.SignedTransaction{Trx: tx}
 
@@ -79,6 +73,18 @@ func GenerateSignedTxAndValidate4(dgp *prototype.DynamicProperties, expiration u
 	return &signTx, nil
 }
 
+//GenerateUnsignedTx returns a transaction referring to the head block, which expires in expiration seconds from the head block time
+func GenerateUnsignedTx(dgp *prototype.DynamicProperties, expiration uint32, ops []interface{}) *prototype.Transaction {
+	refBlockPrefix := common.TaposRefBlockPrefix(dgp.HeadBlockId.Hash)
+	// occupant implement
+	refBlockNum := common.TaposRefBlockNum(dgp.HeadBlockNumber)
+	tx := &prototype.Transaction{RefBlockNum: refBlockNum, RefBlockPrefix: refBlockPrefix, Expiration: &prototype.TimePointSec{UtcSeconds: dgp.Time.UtcSeconds + expiration}}
+	for _, op := range ops {
+		tx.AddOperation(op)
+	}
+	return tx
+}
+
 func GenerateUUID(content string) uint64 {
 	crc32q := crc32.MakeTable(0xD5828281)
 	source := rand.NewSource(time.Now().UnixNano())
@@ -21,6 +21,8 @@ var rootCmd = &cobra.Command{
 }
 
 var datadir string
+var scryptN int
+var scryptP int
 
 func pcFromCommands(parent readline.PrefixCompleterInterface, c *cobra.Command) {
 	pc := readline.PcItem(c.Use)
@@ -171,14 +173,21 @@ func addCommands() {
 	rootCmd.AddCommand(commands.StakerListCmd())
 	rootCmd.AddCommand(commands.QueryCmd())
 	rootCmd.AddCommand(commands.DelegateCmd())
+	rootCmd.AddCommand(commands.TxCmd())
+	rootCmd.AddCommand(commands.KeystoreCmd())
 }
 
 func init() {
 	rootCmd.PersistentFlags().StringVar(&datadir, "datadir", DefaultDataDir(), "--datadir path")
+	rootCmd.PersistentFlags().IntVar(&scryptN, "scrypt-n", wallet.StandardScryptN, "--scrypt-n 262144, scrypt cost of key encryption")
+	rootCmd.PersistentFlags().IntVar(&scryptP, "scrypt-p", wallet.StandardScryptP, "--scrypt-p 1, scrypt parallelization of key encryption")
 	addCommands()
 	rootCmd.Run = func(cmd *cobra.Command, args []string) {
 		if _, ok := rootCmd.Context["wallet"]; !ok {
 			localWallet := wallet.NewBaseHDWallet("default", datadir)
+			if err := localWallet.SetScryptParams(scryptN, scryptP); err != nil {
+				common.Fatalf("invalid scrypt parameters: %v", err)
+			}
 			_ = localWallet.LoadAll()
 			_ = localWallet.Start()
 			rootCmd.SetContext("wallet", localWallet)
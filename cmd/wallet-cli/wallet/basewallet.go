@@ -3,11 +3,10 @@ package wallet
 import (
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
-	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/coschain/contentos-go/prototype"
 	"io"
@@ -36,6 +35,9 @@ type BaseWallet struct {
 
 	ticker *time.Ticker
 
+	scryptN int
+	scryptP int
+
 	mu sync.RWMutex
 }
 
@@ -45,7 +47,23 @@ func NewBaseWallet(name string, path string) *BaseWallet {
 		unlocked: make(map[string]*PrivAccount),
 		locked:   make(map[string]*EncryptAccount),
 		dirPath:  path,
+		scryptN:  StandardScryptN,
+		scryptP:  StandardScryptP,
+	}
+}
+
+// SetScryptParams sets the scrypt cost parameters of keys encrypted from now on
+func (w *BaseWallet) SetScryptParams(n, p int) error {
+	if n <= 1 || n&(n-1) != 0 {
+		return errors.New("scrypt N must be a power of 2 greater than 1")
+	}
+	if p <= 0 {
+		return errors.New("scrypt P must be positive")
 	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.scryptN, w.scryptP = n, p
+	return nil
 }
 
 func selectAESAlgorithm(length int) string {
@@ -175,23 +193,12 @@ func (w *BaseWallet) GenerateNewKey() (string, string, error) {
 }
 
 func (w *BaseWallet) Create(name, passphrase, pubKeyStr, privKeyStr string) error {
-	cipher_data, iv, err := EncryptData([]byte(privKeyStr), []byte(passphrase))
+	encrypt_account, err := NewKeystoreAccount(name, privKeyStr, passphrase, w.scryptN, w.scryptP)
 	if err != nil {
 		return err
 	}
-	cipher_text := base64.StdEncoding.EncodeToString(cipher_data)
-	iv_text := base64.StdEncoding.EncodeToString(iv)
-	mac := hmac.New(sha256.New, []byte(passphrase))
-	mac.Write([]byte(privKeyStr))
-	calcMac := mac.Sum(nil)
-	mac_text := base64.StdEncoding.EncodeToString(calcMac)
-	encrypt_account := &EncryptAccount{
-		Account:    Account{Name: name, PubKey: pubKeyStr},
-		Cipher:     selectAESAlgorithm(PasswordLength),
-		CipherText: cipher_text,
-		Iv:         iv_text,
-		Mac:        mac_text,
-		Version:    1,
+	if encrypt_account.PubKey != pubKeyStr {
+		return errors.New("private key is not matched with the public key")
 	}
 	priv_account := &PrivAccount{
 		Account: Account{Name: name, PubKey: pubKeyStr},
@@ -200,8 +207,7 @@ func (w *BaseWallet) Create(name, passphrase, pubKeyStr, privKeyStr string) erro
 	priv_account.Expire = time.Now().Unix() + ExpirationSeconds
 	w.locked[name] = encrypt_account
 	w.unlocked[name] = priv_account
-	w.seal(encrypt_account)
-	return nil
+	return w.seal(encrypt_account)
 }
 
 func (w *BaseWallet) GetUnlockedAccount(name string) (*PrivAccount, bool) {
@@ -265,32 +271,24 @@ func (w *BaseWallet) Unlock(name, passphrase string) error {
 	if encrypt_acc, ok := w.locked[name]; !ok {
 		return &UnknownLockedAccountError{Name: name}
 	} else {
-		key := []byte(passphrase)
-		iv, err := base64.StdEncoding.DecodeString(encrypt_acc.Iv)
-		if err != nil {
-			return err
-		}
-		cipher_data, err := base64.StdEncoding.DecodeString(encrypt_acc.CipherText)
+		priv_key, err := encrypt_acc.Decrypt(passphrase)
 		if err != nil {
 			return err
 		}
-		mac_data, err := base64.StdEncoding.DecodeString(encrypt_acc.Mac)
-		if err != nil {
-			return err
-		}
-		priv_key, err := DecryptData(cipher_data, key, iv)
-		if err != nil {
-			return err
-		}
-		mac := hmac.New(sha256.New, []byte(passphrase))
-		mac.Write(priv_key)
-		calcMac := mac.Sum(nil)
-		if !hmac.Equal(mac_data, calcMac) {
-			return &UnmatchedPassphraseError{}
+		// the passphrase is only available on unlocking, so it's the time to migrate legacy accounts
+		if encrypt_acc.NeedMigration() {
+			migrated, err := NewKeystoreAccount(name, priv_key, passphrase, w.scryptN, w.scryptP)
+			if err != nil {
+				return err
+			}
+			if err = w.writeAccount(migrated); err != nil {
+				return err
+			}
+			w.locked[name] = migrated
 		}
 		expiredTime := time.Now().Unix() + ExpirationSeconds
 		acc := &PrivAccount{Account{Name: name, PubKey: encrypt_acc.PubKey},
-			string(priv_key), expiredTime}
+			priv_key, expiredTime}
 		w.unlocked[name] = acc
 		return nil
 	}
@@ -314,9 +312,48 @@ func (w *BaseWallet) updateAccountExpiredTime(name string) {
 	}
 }
 
+// ExportKeystore returns the keystore json of an account, which can be imported by other keystore compatible wallets
+func (w *BaseWallet) ExportKeystore(name string) ([]byte, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	acc, ok := w.locked[name]
+	if !ok {
+		return nil, &UnknownLockedAccountError{Name: name}
+	}
+	if acc.NeedMigration() {
+		return nil, errors.New("account is in legacy format, unlock it once to migrate to keystore format")
+	}
+	return json.MarshalIndent(acc, "", "  ")
+}
+
+// ImportKeystore decrypts a scrypt or pbkdf2 keystore json, and saves the key as given account
+func (w *BaseWallet) ImportKeystore(name string, keyjson []byte, passphrase string) error {
+	acc, err := ParseKeystore(keyjson)
+	if err != nil {
+		return err
+	}
+	privKeyStr, err := acc.Decrypt(passphrase)
+	if err != nil {
+		return err
+	}
+	privKey, err := prototype.PrivateKeyFromWIF(privKeyStr)
+	if err != nil {
+		return err
+	}
+	pubKey, err := privKey.PubKey()
+	if err != nil {
+		return err
+	}
+	return w.Create(name, passphrase, pubKey.ToWIF(), privKeyStr)
+}
+
 func (w *BaseWallet) seal(account *EncryptAccount) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
+	return w.writeAccount(account)
+}
+
+func (w *BaseWallet) writeAccount(account *EncryptAccount) error {
 	name := account.Name
 
 	// I knew there is a problem when user create a pair key but using a name which have been occupied.
@@ -328,8 +365,10 @@ func (w *BaseWallet) seal(account *EncryptAccount) error {
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, keyjson, 0600)
-	return nil
+	if err = os.MkdirAll(w.dirPath, 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, keyjson, 0600)
 }
 
 func (w *BaseWallet) List() []string {
@@ -0,0 +1,268 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// versions of EncryptAccount
+const (
+	LegacyVersion   uint8 = 1 // aes with sha256 hashed passphrase
+	KeystoreVersion uint8 = 3 // web3 secret storage, the standard scrypt/pbkdf2 json keystore
+)
+
+// scrypt parameters, the same as the standard and light ones of geth
+const (
+	StandardScryptN = 1 << 18
+	StandardScryptP = 1
+	LightScryptN    = 1 << 12
+	LightScryptP    = 6
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	keystoreCipher = "aes-128-ctr"
+)
+
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// CryptoJSON is the crypto section of a web3 secret storage keystore
+type CryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams CipherParams           `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+// EncryptKey encrypts a raw private key by a key derived from passphrase with scrypt
+func EncryptKey(key []byte, passphrase string, scryptN, scryptP int) (*CryptoJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], key, iv)
+	if err != nil {
+		return nil, err
+	}
+	return &CryptoJSON{
+		Cipher:       keystoreCipher,
+		CipherText:   hex.EncodeToString(cipherText),
+		CipherParams: CipherParams{IV: hex.EncodeToString(iv)},
+		KDF:          "scrypt",
+		KDFParams: map[string]interface{}{
+			"n":     scryptN,
+			"r":     scryptR,
+			"p":     scryptP,
+			"dklen": scryptDKLen,
+			"salt":  hex.EncodeToString(salt),
+		},
+		MAC: hex.EncodeToString(crypto.Keccak256(derivedKey[16:32], cipherText)),
+	}, nil
+}
+
+// DecryptKey verifies the mac and decrypts the raw private key. Both scrypt and pbkdf2 are supported.
+func DecryptKey(c *CryptoJSON, passphrase string) ([]byte, error) {
+	if c.Cipher != keystoreCipher {
+		return nil, fmt.Errorf("unsupported cipher %s", c.Cipher)
+	}
+	mac, err := hex.DecodeString(c.MAC)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(c.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(c.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	derivedKey, err := deriveKey(c, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(crypto.Keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, &UnmatchedPassphraseError{}
+	}
+	return aesCTRXOR(derivedKey[:16], cipherText, iv)
+}
+
+func deriveKey(c *CryptoJSON, passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(kdfString(c.KDFParams, "salt"))
+	if err != nil {
+		return nil, err
+	}
+	dkLen := kdfInt(c.KDFParams, "dklen")
+	if dkLen < 32 {
+		return nil, errors.New("invalid kdf key length")
+	}
+	switch c.KDF {
+	case "scrypt":
+		return scrypt.Key([]byte(passphrase), salt,
+			kdfInt(c.KDFParams, "n"), kdfInt(c.KDFParams, "r"), kdfInt(c.KDFParams, "p"), dkLen)
+	case "pbkdf2":
+		if prf := kdfString(c.KDFParams, "prf"); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported pbkdf2 prf %s", prf)
+		}
+		iterations := kdfInt(c.KDFParams, "c")
+		if iterations <= 0 {
+			return nil, errors.New("invalid pbkdf2 iterations")
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, iterations, dkLen, sha256.New), nil
+	}
+	return nil, fmt.Errorf("unsupported kdf %s", c.KDF)
+}
+
+func kdfInt(params map[string]interface{}, name string) int {
+	switch v := params[name].(type) {
+	case float64: // parsed from json
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+func kdfString(params map[string]interface{}, name string) string {
+	s, _ := params[name].(string)
+	return s
+}
+
+func aesCTRXOR(key, in, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// newUUID returns a random uuid, which is the id of a keystore
+func newUUID() string {
+	u := make([]byte, 16)
+	_, _ = io.ReadFull(rand.Reader, u)
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// NewKeystoreAccount encrypts a private key in keystore format
+func NewKeystoreAccount(name, privKeyStr, passphrase string, scryptN, scryptP int) (*EncryptAccount, error) {
+	privKey, err := prototype.PrivateKeyFromWIF(privKeyStr)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := privKey.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	c, err := EncryptKey(privKey.Data, passphrase, scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptAccount{
+		Account: Account{Name: name, PubKey: pubKey.ToWIF()},
+		Version: KeystoreVersion,
+		Id:      newUUID(),
+		Crypto:  c,
+	}, nil
+}
+
+// ParseKeystore parses a keystore json, which may come from other wallets and have no account name
+func ParseKeystore(keyjson []byte) (*EncryptAccount, error) {
+	acc := new(EncryptAccount)
+	if err := json.Unmarshal(keyjson, acc); err != nil {
+		return nil, err
+	}
+	if acc.Version != KeystoreVersion || acc.Crypto == nil {
+		return nil, fmt.Errorf("unsupported keystore version %d", acc.Version)
+	}
+	return acc, nil
+}
+
+// Decrypt returns the private key in WIF
+func (acc *EncryptAccount) Decrypt(passphrase string) (string, error) {
+	switch acc.Version {
+	case LegacyVersion:
+		return acc.decryptLegacy(passphrase)
+	case KeystoreVersion:
+		if acc.Crypto == nil {
+			return "", errors.New("missing crypto section of keystore")
+		}
+		key, err := DecryptKey(acc.Crypto, passphrase)
+		if err != nil {
+			return "", err
+		}
+		ecdsaKey, err := crypto.ToECDSA(key)
+		if err != nil {
+			return "", err
+		}
+		privKey := prototype.PrivateKeyFromECDSA(ecdsaKey)
+		pubKey, err := privKey.PubKey()
+		if err != nil {
+			return "", err
+		}
+		if len(acc.PubKey) > 0 && acc.PubKey != pubKey.ToWIF() {
+			return "", errors.New("private key is not matched with the public key of the account")
+		}
+		return privKey.ToWIF(), nil
+	}
+	return "", fmt.Errorf("unsupported keystore version %d", acc.Version)
+}
+
+func (acc *EncryptAccount) decryptLegacy(passphrase string) (string, error) {
+	iv, err := base64.StdEncoding.DecodeString(acc.Iv)
+	if err != nil {
+		return "", err
+	}
+	cipherData, err := base64.StdEncoding.DecodeString(acc.CipherText)
+	if err != nil {
+		return "", err
+	}
+	macData, err := base64.StdEncoding.DecodeString(acc.Mac)
+	if err != nil {
+		return "", err
+	}
+	privKey, err := DecryptData(cipherData, []byte(passphrase), iv)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, []byte(passphrase))
+	mac.Write(privKey)
+	if !hmac.Equal(macData, mac.Sum(nil)) {
+		return "", &UnmatchedPassphraseError{}
+	}
+	return string(privKey), nil
+}
+
+// NeedMigration returns whether the account is in the legacy format
+func (acc *EncryptAccount) NeedMigration() bool {
+	return acc.Version != KeystoreVersion
+}
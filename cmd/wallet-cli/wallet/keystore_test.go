@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// test vectors of web3 secret storage
+const (
+	testVectorKey      = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	testVectorPassword = "testpassword"
+	testVectorPBKDF2   = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	testVectorScrypt   = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+type legacyKeystore struct {
+	Username   string         `json:"username"`
+	Passphrase string         `json:"passphrase"`
+	PrivateKey string         `json:"privateKey"`
+	Keystore   EncryptAccount `json:"keystore"`
+}
+
+func TestEncryptKeyAndDecryptKey(t *testing.T) {
+	myassert := assert.New(t)
+	key := []byte("0123456789abcdef0123456789abcdef")
+	c, err := EncryptKey(key, "123456", LightScryptN, LightScryptP)
+	myassert.NoError(err)
+	d, err := DecryptKey(c, "123456")
+	myassert.NoError(err)
+	myassert.Equal(key, d)
+	_, err = DecryptKey(c, "111111")
+	myassert.IsType(&UnmatchedPassphraseError{}, err)
+}
+
+func TestDecryptStandardKeystore(t *testing.T) {
+	myassert := assert.New(t)
+	raw, _ := hex.DecodeString(testVectorKey)
+	ecdsaKey, err := crypto.ToECDSA(raw)
+	myassert.NoError(err)
+	expected := prototype.PrivateKeyFromECDSA(ecdsaKey).ToWIF()
+
+	for _, keyjson := range []string{testVectorPBKDF2, testVectorScrypt} {
+		acc, err := ParseKeystore([]byte(keyjson))
+		myassert.NoError(err)
+		privKey, err := acc.Decrypt(testVectorPassword)
+		myassert.NoError(err)
+		myassert.Equal(expected, privKey)
+	}
+}
+
+func TestMigrateLegacyAccount(t *testing.T) {
+	myassert := assert.New(t)
+	dir, err := ioutil.TempDir("", "keystore")
+	myassert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	data, err := ioutil.ReadFile("testdata/keystore.json")
+	myassert.NoError(err)
+	var legacies []legacyKeystore
+	myassert.NoError(json.Unmarshal(data, &legacies))
+	legacy := legacies[0]
+	keyjson, _ := json.Marshal(legacy.Keystore)
+	myassert.NoError(ioutil.WriteFile(filepath.Join(dir, generateFilename(legacy.Username)), keyjson, 0600))
+
+	w := NewBaseWallet("test", dir)
+	myassert.NoError(w.SetScryptParams(LightScryptN, LightScryptP))
+	myassert.NoError(w.LoadAll())
+	_, err = w.ExportKeystore(legacy.Username)
+	myassert.Error(err)
+	myassert.Error(w.Unlock(legacy.Username, "wrong passphrase"))
+	myassert.NoError(w.Unlock(legacy.Username, legacy.Passphrase))
+	acc, ok := w.GetUnlockedAccount(legacy.Username)
+	myassert.True(ok)
+	myassert.Equal(legacy.PrivateKey, acc.PrivKey)
+
+	// the account file is in keystore format after unlocking
+	w = NewBaseWallet("test", dir)
+	myassert.NoError(w.LoadAll())
+	myassert.False(w.locked[legacy.Username].NeedMigration())
+	myassert.Empty(w.locked[legacy.Username].CipherText)
+	myassert.NoError(w.Unlock(legacy.Username, legacy.Passphrase))
+	acc, _ = w.GetUnlockedAccount(legacy.Username)
+	myassert.Equal(legacy.PrivateKey, acc.PrivKey)
+}
+
+func TestImportAndExportKeystore(t *testing.T) {
+	myassert := assert.New(t)
+	dir1, _ := ioutil.TempDir("", "keystore")
+	dir2, _ := ioutil.TempDir("", "keystore")
+	defer os.RemoveAll(dir1)
+	defer os.RemoveAll(dir2)
+
+	w1 := NewBaseWallet("test", dir1)
+	myassert.NoError(w1.SetScryptParams(LightScryptN, LightScryptP))
+	pubKey, privKey, err := w1.GenerateNewKey()
+	myassert.NoError(err)
+	myassert.NoError(w1.Create("alice", "123456", pubKey, privKey))
+	keyjson, err := w1.ExportKeystore("alice")
+	myassert.NoError(err)
+
+	w2 := NewBaseWallet("test", dir2)
+	myassert.NoError(w2.SetScryptParams(LightScryptN, LightScryptP))
+	myassert.Error(w2.ImportKeystore("bob", keyjson, "111111"))
+	myassert.NoError(w2.ImportKeystore("bob", keyjson, "123456"))
+	acc, ok := w2.GetUnlockedAccount("bob")
+	myassert.True(ok)
+	myassert.Equal(privKey, acc.PrivKey)
+	myassert.Equal(pubKey, acc.PubKey)
+
+	myassert.NoError(w2.ImportKeystore("carol", []byte(testVectorPBKDF2), testVectorPassword))
+	myassert.Error(w2.SetScryptParams(1000, 1))
+}
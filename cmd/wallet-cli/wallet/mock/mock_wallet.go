@@ -189,3 +189,28 @@ func (m *MockWallet) IsExist(name string) bool {
 func (mr *MockWalletMockRecorder) IsExist(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExist", reflect.TypeOf((*MockWallet)(nil).IsExist), name)
 }
+
+// ExportKeystore mocks base method
+func (m *MockWallet) ExportKeystore(name string) ([]byte, error) {
+	ret := m.ctrl.Call(m, "ExportKeystore", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportKeystore indicates an expected call of ExportKeystore
+func (mr *MockWalletMockRecorder) ExportKeystore(name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportKeystore", reflect.TypeOf((*MockWallet)(nil).ExportKeystore), name)
+}
+
+// ImportKeystore mocks base method
+func (m *MockWallet) ImportKeystore(name string, keyjson []byte, passphrase string) error {
+	ret := m.ctrl.Call(m, "ImportKeystore", name, keyjson, passphrase)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportKeystore indicates an expected call of ImportKeystore
+func (mr *MockWalletMockRecorder) ImportKeystore(name, keyjson, passphrase interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKeystore", reflect.TypeOf((*MockWallet)(nil).ImportKeystore), name, keyjson, passphrase)
+}
@@ -15,9 +15,11 @@ type PrivAccount struct {
 
 type EncryptAccount struct {
 	Account
-	Cipher     string // a cipher algorithm from aes
-	CipherText string // encrypted privkey
-	Iv         string // the iv
-	Mac        string // the mac of passphrase
-	Version    uint8  // version of format
+	Cipher     string      `json:",omitempty"`       // a cipher algorithm from aes, legacy format only
+	CipherText string      `json:",omitempty"`       // encrypted privkey, legacy format only
+	Iv         string      `json:",omitempty"`       // the iv, legacy format only
+	Mac        string      `json:",omitempty"`       // the mac of passphrase, legacy format only
+	Version    uint8       `json:"version"`          // version of format
+	Id         string      `json:"id,omitempty"`     // uuid of the keystore
+	Crypto     *CryptoJSON `json:"crypto,omitempty"` // encrypted privkey in keystore format
 }
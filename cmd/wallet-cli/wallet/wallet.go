@@ -26,6 +26,10 @@ type Wallet interface {
 	IsLocked(name string) bool
 
 	IsExist(name string) bool
+
+	ExportKeystore(name string) ([]byte, error)
+
+	ImportKeystore(name string, keyjson []byte, passphrase string) error
 }
 
 type HDWallet interface {
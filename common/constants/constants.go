@@ -57,6 +57,9 @@ const (
 	MaxAccountNameLength     = 16
 	MinAccountNameLength     = 6
 
+	// multi-signature authority limit
+	MaxAuthorityKeyCount     = 10
+
 	// resource limit
 	MinStaminaFree       = 0
 	DefaultStaminaFree   = 100000
@@ -90,6 +93,10 @@ const (
 	RewardRateReply = 1500
 	RewardRateVoter = 1000
 
+	// weighting curves of rewards
+	RewardWeightingLinear = "linear"
+	RewardWeightingQuadratic = "quadratic"
+
 	ConvertWeeks = 13
 	HardFork2ConvertWeeks = 7
 
@@ -103,6 +110,7 @@ const (
     CpuConsumePointNum   = 1
     CpuConsumePointDen   = 100
     MaxGasPerCall        = 20000 * CpuConsumePointDen
+    MaxContractEventSize = 1024 * 4
     MaxStaminaPerBlock   = 100000
     //WindowSize           = 60 * 60 * 24
     FreeStamina          = 100000
@@ -144,6 +152,41 @@ const (
 	MinVestDelegationAmount = 1
 	MinVestDelegationInBlocks = 1
 	MaxVestDelegationInBlocks = 1000 * 365 * 60 * 60 * 24 / BlockInterval	// ~forever, say 1000 years
+
+	// scheduled transfer
+	MaxScheduledTransferDelay = 365 * 60 * 60 * 24	// in seconds, 1 year
+	MaxScheduledTransfersPerBlock = 1000
+
+	// escrow
+	MaxEscrowDuration = 365 * 60 * 60 * 24	// in seconds, 1 year
+
+	// post editing
+	MaxPostRevisions = 10
+
+	// governance proposals
+	ProposalStatusPending = 0
+	ProposalStatusApproved = 1
+	ProposalStatusActivated = 2
+	ProposalStatusRejected = 3
+	MaxProposalActivationDelay = 365 * 60 * 60 * 24 / BlockInterval	// in blocks, 1 year
+)
+
+// features, activated by hard forks or governance proposals
+const (
+	FeatureLinearVoteWeight = "linear_vote_weight"
+	FeatureFastPowerDown = "fast_power_down"
+	FeatureVestDelegation = "vest_delegation"
+	FeatureForbidBadAccounts = "forbid_bad_accounts"
+	FeaturePostEdit = "post_edit"
+	FeatureGovernance = "governance"
+	FeatureScheduledTransfer = "scheduled_transfer"
+	FeatureEscrow = "escrow"
+	FeatureStateRoot = "state_root"
+	FeatureTrxNonce = "trx_nonce"
+	FeatureMultiSig = "multi_sig"
+	FeaturePermissionLevels = "permission_levels"
+	FeatureContractEvent = "contract_event"
+	FeatureTableIterator = "table_iterator"
 )
 
 var GlobalId int32 = 1
\ No newline at end of file
@@ -27,5 +27,5 @@ const (
 	HardFork1 uint64 = 1000
 	HardFork2 uint64 = 2000
 	HardFork3 uint64 = 3000
-	HardFork3 uint64 = 3100
+	HardFork4 uint64 = 3100
 )
@@ -1,21 +1,113 @@
 package common
 
-import "github.com/coschain/contentos-go/common/constants"
+import (
+	"fmt"
+	"github.com/coschain/contentos-go/common/constants"
+	"math"
+	"sort"
+)
 
-var hardForks = []uint64{
-	constants.Original,
-	constants.HardFork1,
-	constants.HardFork2,
-	constants.HardFork3,
-	constants.HardFork4,
+// Feature is a named protocol change which takes effect since its activation height.
+type Feature struct {
+	Id          string
+	Height      uint64
+	Description string
 }
 
-func GetHardFork(blockNum uint64) uint64 {
-	r := constants.Original
-	for _, hf := range hardForks {
-		if blockNum >= hf {
-			r = hf
+// builtin features and their default activation heights
+var defaultFeatures = []Feature{
+	{constants.FeatureLinearVoteWeight, constants.HardFork1, "vote weight is linear to voting power instead of its square root"},
+	{constants.FeatureFastPowerDown, constants.HardFork2, fmt.Sprintf("vest conversion finishes in %d weeks", constants.HardFork2ConvertWeeks)},
+	{constants.FeatureVestDelegation, constants.HardFork3, "accounts can delegate vests to others"},
+	{constants.FeatureForbidBadAccounts, constants.HardFork4, "forbid accounts abusing the chain"},
+	{constants.FeaturePostEdit, FeatureUnscheduled, "authors can edit and delete posts before cashout"},
+	{constants.FeatureScheduledTransfer, FeatureUnscheduled, "transfers can be scheduled to be released at a future time"},
+	{constants.FeatureEscrow, FeatureUnscheduled, "transfers can be escrowed with agents arbitrating disputes"},
+	{constants.FeatureGovernance, FeatureUnscheduled, "block producers can vote for proposals of chain properties, features and reward policies"},
+	{constants.FeatureStateRoot, FeatureUnscheduled, "blocks must commit the state root after applying their previous blocks"},
+	{constants.FeatureTrxNonce, FeatureUnscheduled, "nonces of in-block transactions are reserved until the transactions expire, and cancellations can't be packed"},
+	{constants.FeatureMultiSig, FeatureUnscheduled, "accounts can be controlled by weighted multi-signature authorities, and transactions are deduplicated regardless of their signatures"},
+	{constants.FeaturePermissionLevels, FeatureUnscheduled, "operations require owner, active or posting permissions, and accounts can set active and posting authorities"},
+	{constants.FeatureContractEvent, FeatureUnscheduled, "contracts can emit events, which are logged and indexed by blocks"},
+	{constants.FeatureTableIterator, FeatureUnscheduled, "contracts can scan their tables in the order of indexed fields"},
+}
+
+// FeatureUnscheduled is the activation height of features waiting for governance proposals or genesis configs.
+const FeatureUnscheduled uint64 = math.MaxUint64
+
+// KnownFeature returns whether the feature id refers to a builtin feature.
+func KnownFeature(id string) bool {
+	for _, f := range defaultFeatures {
+		if f.Id == id {
+			return true
 		}
 	}
+	return false
+}
+
+// FeatureRegistry holds the activation schedule of builtin features.
+// Nodes of the same chain must have the same schedule, otherwise they fork.
+type FeatureRegistry struct {
+	features map[string]*Feature
+}
+
+// NewFeatureRegistry returns a registry of the default schedule.
+func NewFeatureRegistry() *FeatureRegistry {
+	r := &FeatureRegistry{features: make(map[string]*Feature)}
+	for _, f := range defaultFeatures {
+		feature := f
+		r.features[f.Id] = &feature
+	}
 	return r
 }
+
+// Schedule changes the activation height of a builtin feature.
+func (r *FeatureRegistry) Schedule(id string, height uint64) error {
+	f, ok := r.features[id]
+	if !ok {
+		return fmt.Errorf("unknown feature %s", id)
+	}
+	f.Height = height
+	return nil
+}
+
+// Feature returns the feature of given id, or nil if not found.
+func (r *FeatureRegistry) Feature(id string) *Feature {
+	if f, ok := r.features[id]; ok {
+		feature := *f
+		return &feature
+	}
+	return nil
+}
+
+// IsActive returns whether a feature is active at given block. Unknown features are never active.
+func (r *FeatureRegistry) IsActive(id string, blockNum uint64) bool {
+	f, ok := r.features[id]
+	return ok && blockNum >= f.Height
+}
+
+// Features returns all features sorted by activation height.
+func (r *FeatureRegistry) Features() []Feature {
+	list := make([]Feature, 0, len(r.features))
+	for _, f := range r.features {
+		list = append(list, *f)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Height != list[j].Height {
+			return list[i].Height < list[j].Height
+		}
+		return list[i].Id < list[j].Id
+	})
+	return list
+}
+
+// HardFork returns the highest activation height of features active at given block.
+func (r *FeatureRegistry) HardFork(blockNum uint64) uint64 {
+	hf := constants.Original
+	for _, f := range r.features {
+		if blockNum >= f.Height && f.Height > hf {
+			hf = f.Height
+		}
+	}
+	return hf
+}
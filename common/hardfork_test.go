@@ -0,0 +1,28 @@
+package common
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFeatureRegistry(t *testing.T) {
+	a := assert.New(t)
+	r := NewFeatureRegistry()
+
+	a.Equal(constants.Original, r.HardFork(0))
+	a.Equal(constants.HardFork1, r.HardFork(constants.HardFork1))
+	a.False(r.IsActive(constants.FeatureVestDelegation, constants.HardFork3 - 1))
+	a.True(r.IsActive(constants.FeatureVestDelegation, constants.HardFork3))
+	a.False(r.IsActive("unknown_feature", constants.HardFork4))
+	a.False(r.IsActive(constants.FeaturePostEdit, constants.HardFork4))
+
+	a.Error(r.Schedule("unknown_feature", 100))
+	a.NoError(r.Schedule(constants.FeatureForbidBadAccounts, 100))
+	a.True(r.IsActive(constants.FeatureForbidBadAccounts, 100))
+	a.Equal(uint64(100), r.HardFork(100))
+	a.Equal(constants.FeatureForbidBadAccounts, r.Features()[0].Id)
+
+	// schedules of registries are independent
+	a.False(NewFeatureRegistry().IsActive(constants.FeatureForbidBadAccounts, 100))
+}
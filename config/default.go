@@ -30,6 +30,7 @@ const (
 	DEFAULT_MAX_CONN_IN_BOUND               = uint(23)
 	DEFAULT_MAX_CONN_OUT_BOUND              = uint(23)
 	DEFAULT_MAX_CONN_IN_BOUND_FOR_SINGLE_IP = uint(23)
+	DEFAULT_TARGET_PEER_COUNT               = uint(16)
 )
 
 var TestNetConfig = &service_configs.GenesisConfig{
@@ -68,6 +69,9 @@ var DefaultNodeConfig = node.Config{
 		MaxConnInBound:            DEFAULT_MAX_CONN_IN_BOUND,
 		MaxConnOutBound:           DEFAULT_MAX_CONN_OUT_BOUND,
 		MaxConnInBoundForSingleIP: DEFAULT_MAX_CONN_IN_BOUND_FOR_SINGLE_IP,
+		EnableDiscovery:           true,
+		TargetPeerCount:           DEFAULT_TARGET_PEER_COUNT,
+		AllowLegacyPeers:          false,
 	},
 
 	GRPC: service_configs.GRPCConfig{
@@ -76,6 +80,7 @@ var DefaultNodeConfig = node.Config{
 		HTTPListen: DefaultHTTPEndPoint,
 		HTTPCors:   []string{"*"},
 		HTTPLimit:  100,
+		WebSocketLimit: 100,
 	},
 	Consensus: service_configs.ConsensusConfig{
 		Type:              "SABFT",
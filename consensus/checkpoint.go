@@ -257,3 +257,61 @@ func (cp *BFTCheckPoint) GetIth(i uint64) (*message.Commit, error) {
 	}
 	return commit.(*message.Commit), nil
 }
+
+// LoadCheckPoint reads the commit of given block from the checkpoint database in dir.
+// It works on a stopped node, without a running SABFT.
+func LoadCheckPoint(dir string, blockNum uint64) (*message.Commit, error) {
+	db, err := storage.NewLevelDatabase(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, blockNum)
+	val, err := db.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint of block %d not found: %v", blockNum, err)
+	}
+	commit, err := message.DecodeConsensusMsg(val)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := commit.(*message.Commit)
+	if !ok {
+		return nil, ErrInvalidCheckPoint
+	}
+	if err = c.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SaveCheckPoint writes a flushed checkpoint into the checkpoint database in dir.
+// It works on a stopped node, without a running SABFT.
+func SaveCheckPoint(dir string, commit *message.Commit) error {
+	if err := commit.ValidateBasic(); err != nil {
+		return err
+	}
+	db, err := storage.NewLevelDatabase(dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, ExtractBlockID(commit).BlockNum())
+	idxKey := make([]byte, 16)
+	copy(idxKey, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	binary.BigEndian.PutUint64(idxKey[8:], uint64(commit.Height()))
+
+	batch := db.NewBatch()
+	defer db.DeleteBatch(batch)
+	if err = batch.Put(key, commit.Bytes()); err != nil {
+		return err
+	}
+	if err = batch.Put(idxKey, key); err != nil {
+		return err
+	}
+	return batch.Write()
+}
@@ -253,6 +253,19 @@ func (sabft *SABFT) ActiveValidators() []string {
 	return v
 }
 
+// ActiveValidatorByKey returns the active validator whose signing key is the given public key
+func (sabft *SABFT) ActiveValidatorByKey(key *prototype.PublicKeyType) (string, bool) {
+	if key == nil || sabft.dynasties.Empty() {
+		return "", false
+	}
+	for _, v := range sabft.dynasties.Front().validators {
+		if v.pubKey != nil && v.pubKey.Equal(key) {
+			return v.accountName, true
+		}
+	}
+	return "", false
+}
+
 func (sabft *SABFT) Start(node *node.Node) error {
 	if !atomic.CompareAndSwapUint32(&sabft.inStartOrStop, 0, 1) {
 		return fmt.Errorf("consensus in the process of start or stop")
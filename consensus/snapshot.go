@@ -0,0 +1,343 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/db/blocklog"
+	"github.com/coschain/contentos-go/db/smt"
+	"github.com/coschain/contentos-go/db/snapshot"
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/node"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/lightclient"
+	"github.com/coschain/gobft/message"
+	"github.com/golang/protobuf/proto"
+)
+
+const snapshotBatchSize = 10000
+
+// ExportSnapshot writes the state at the last irreversible block to w, along with the block, its BFT commit and
+// the header of the next block, which commits to the state root. The node must be stopped, so that the next
+// block can be found in the forkdb snapshot. The state database is left untouched even if it's ahead of the
+// last irreversible block.
+func ExportSnapshot(cfg *node.Config, at uint64, w io.Writer) (header *snapshot.Header, contentHash []byte, err error) {
+	db, err := storage.NewDatabase(cfg.ResolvePath("db"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = db.Start(nil); err != nil {
+		return nil, nil, err
+	}
+	defer db.Close()
+
+	_, base := db.GetRevisionAndBase()
+	lib, err := strconv.ParseUint(db.GetRevisionTag(base), 10, 64)
+	if err != nil {
+		return nil, nil, errors.New("state db has no irreversible block")
+	}
+	if at == 0 {
+		at = lib
+	}
+	if at != lib {
+		return nil, nil, fmt.Errorf("only the last irreversible block %d can be exported", lib)
+	}
+	state, err := db.NewRevisionPatch(base)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blog blocklog.BLog
+	if err = blog.Open(cfg.ResolvePath("blog")); err != nil {
+		return nil, nil, err
+	}
+	defer blog.Close()
+	block := &prototype.SignedBlock{}
+	if err = blog.ReadBlock(block, int64(at)-1); err != nil {
+		return nil, nil, err
+	}
+	commit, err := LoadCheckPoint(cfg.ResolvePath(constants.CheckPoint), at)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ExtractBlockID(commit) != block.Id() {
+		return nil, nil, fmt.Errorf("checkpoint of block %d doesn't match the block log", at)
+	}
+	stateRoot, err := smt.New(state).Root()
+	if err != nil {
+		return nil, nil, err
+	}
+	next, err := findNextHeader(cfg.ResolvePath(constants.ForkDBSnapshot), block.Id(), stateRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	// refuse to export a snapshot that can't be imported
+	if err = verifySnapshotState(state, commit, next); err != nil {
+		return nil, nil, err
+	}
+
+	header = &snapshot.Header{BlockNum: at, StateRoot: stateRoot, Commit: commit.Bytes()}
+	if header.Block, err = block.Marshall(); err != nil {
+		return nil, nil, err
+	}
+	if header.Next, err = proto.Marshal(next); err != nil {
+		return nil, nil, err
+	}
+	sw, err := snapshot.NewWriter(w, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	state.Iterate(nil, nil, false, func(key, value []byte) bool {
+		if !isSnapshotKey(key) {
+			return true
+		}
+		err = sw.Write(key, value)
+		return err == nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if contentHash, err = sw.Finish(); err != nil {
+		return nil, nil, err
+	}
+	return header, contentHash, nil
+}
+
+// ImportSnapshot bootstraps an empty node from a snapshot read from r.
+// The snapshot is verified against trustedHash, which must come from a trusted source since an empty node has no
+// validator set to trust yet, the BFT commit of its block signed by validators of the snapshot state, and the state
+// root committed by the next block.
+// SABFT starts from the snapshot block as the last committed block on next start of the node.
+func ImportSnapshot(cfg *node.Config, r io.Reader, trustedHash []byte) (header *snapshot.Header, contentHash []byte, err error) {
+	if len(trustedHash) == 0 {
+		return nil, nil, errors.New("a trusted content hash is required to import snapshots")
+	}
+	dbPath, blogPath, cpPath := cfg.ResolvePath("db"), cfg.ResolvePath("blog"), cfg.ResolvePath(constants.CheckPoint)
+	for _, p := range []string{dbPath, blogPath, cpPath, cfg.ResolvePath(constants.ForkDBSnapshot)} {
+		if _, err := os.Stat(p); err == nil {
+			return nil, nil, fmt.Errorf("%s already exists, remove it before importing", p)
+		}
+	}
+
+	sr, header, err := snapshot.NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, commit, next, err := verifySnapshotHeader(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := storage.NewDatabase(dbPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = db.Start(nil); err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		db.Close()
+		if err != nil {
+			_ = os.RemoveAll(dbPath)
+		}
+	}()
+	if err = db.EnableReversion(false); err != nil {
+		return nil, nil, err
+	}
+	if contentHash, err = importSnapshotRecords(db, sr); err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(trustedHash, contentHash) {
+		return nil, nil, snapshot.ErrContentMismatch
+	}
+
+	// the state must be the one right after applying the committed block
+	singleId := int32(constants.SingletonId)
+	headId := &prototype.Sha256{}
+	headId.FromBlockID(block.Id())
+	if !table.NewSoGlobalWrap(db, &singleId).GetProps().GetHeadBlockId().Equal(headId) {
+		return nil, nil, fmt.Errorf("snapshot state is not at block %d", header.BlockNum)
+	}
+	if err = verifySnapshotState(db, commit, next); err != nil {
+		return nil, nil, err
+	}
+	// the state tree isn't a part of snapshots, rebuild it and check the root against the one committed
+	// by the next block
+	stateRoot, err := app.BuildStateTree(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(stateRoot, header.StateRoot) {
+		return nil, nil, fmt.Errorf("state root of snapshot mismatch, expected %x, got %x", header.StateRoot, stateRoot)
+	}
+	if err = db.EnableReversion(true); err != nil {
+		return nil, nil, err
+	}
+	if err = db.TagRevision(db.GetRevision(), strconv.FormatUint(header.BlockNum, 10)); err != nil {
+		return nil, nil, err
+	}
+
+	// the block log starts at the snapshot block, which is also where SABFT starts from
+	var blog blocklog.BLog
+	if err = blog.Open(blogPath); err != nil {
+		return nil, nil, err
+	}
+	defer blog.Close()
+	if err = blog.SetBase(int64(header.BlockNum) - 1); err == nil {
+		err = blog.Append(block)
+	}
+	if err != nil {
+		blog.Remove(blogPath)
+		return nil, nil, err
+	}
+	if err = SaveCheckPoint(cpPath, commit); err != nil {
+		blog.Remove(blogPath)
+		_ = os.RemoveAll(cpPath)
+		return nil, nil, err
+	}
+	return header, contentHash, nil
+}
+
+// isSnapshotKey returns true if given key of the state database goes into snapshots.
+// Reversion data is local to a node, and the state tree is rebuilt on import.
+func isSnapshotKey(key []byte) bool {
+	return !storage.IsReversionInfoKey(key) && !smt.IsNodeKey(key)
+}
+
+// findNextHeader returns the header of a block in the forkdb snapshot at dir, which follows the block of prevId
+// and commits to stateRoot.
+func findNextHeader(dir string, prevId common.BlockID, stateRoot []byte) (*prototype.SignedBlockHeader, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("no forkdb snapshot, block %d must have a child block to be exported", prevId.BlockNum())
+	}
+	var blog blocklog.BLog
+	if err := blog.Open(dir); err != nil {
+		return nil, err
+	}
+	defer blog.Close()
+	for i := int64(0); i < blog.Size(); i++ {
+		b := &prototype.SignedBlock{}
+		if err := blog.ReadBlock(b, i); err != nil {
+			return nil, err
+		}
+		if b.Previous() != prevId {
+			continue
+		}
+		if root := b.SignedHeader.Header.PrevStateRoot; root != nil && bytes.Equal(root.Hash, stateRoot) {
+			return b.SignedHeader, nil
+		}
+	}
+	return nil, fmt.Errorf("no block after %d commits to its state root", prevId.BlockNum())
+}
+
+// verifySnapshotHeader checks the header of a snapshot by itself, i.e. the block is committed, and the next block
+// follows it and commits to the state root. Signatures are checked against validators of the snapshot state by
+// verifySnapshotState.
+func verifySnapshotHeader(header *snapshot.Header) (*prototype.SignedBlock, *message.Commit, *prototype.SignedBlockHeader, error) {
+	block := &prototype.SignedBlock{}
+	if err := block.Unmarshall(header.Block); err != nil {
+		return nil, nil, nil, err
+	}
+	if block.SignedHeader == nil || block.SignedHeader.Header == nil || block.SignedHeader.BlockProducerSignature == nil {
+		return nil, nil, nil, fmt.Errorf("invalid snapshot block")
+	}
+	if block.Id().BlockNum() != header.BlockNum || !block.Validate() {
+		return nil, nil, nil, fmt.Errorf("invalid snapshot block")
+	}
+	msg, err := message.DecodeConsensusMsg(header.Commit)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	commit, ok := msg.(*message.Commit)
+	if !ok {
+		return nil, nil, nil, ErrInvalidCheckPoint
+	}
+	if err = commit.ValidateBasic(); err != nil {
+		return nil, nil, nil, err
+	}
+	if ExtractBlockID(commit) != block.Id() {
+		return nil, nil, nil, fmt.Errorf("BFT commit of snapshot is not for block %d", header.BlockNum)
+	}
+
+	next := &prototype.SignedBlockHeader{}
+	if err = proto.Unmarshal(header.Next, next); err != nil {
+		return nil, nil, nil, err
+	}
+	if next.Header == nil || next.Header.BlockProducer == nil || next.BlockProducerSignature == nil {
+		return nil, nil, nil, fmt.Errorf("invalid next block header of snapshot")
+	}
+	if (&prototype.SignedBlock{SignedHeader: next}).Previous() != block.Id() {
+		return nil, nil, nil, fmt.Errorf("next block header of snapshot doesn't follow block %d", header.BlockNum)
+	}
+	if root := next.Header.PrevStateRoot; root == nil || len(header.StateRoot) == 0 || !bytes.Equal(root.Hash, header.StateRoot) {
+		return nil, nil, nil, fmt.Errorf("state root of snapshot isn't committed by the next block")
+	}
+	return block, commit, next, nil
+}
+
+// verifySnapshotState checks that the commit and the next block header are signed by validators in the
+// block producer schedule of the snapshot state.
+func verifySnapshotState(state iservices.IDatabaseRW, commit *message.Commit, next *prototype.SignedBlockHeader) error {
+	singleId := int32(constants.SingletonId)
+	schedule := table.NewSoBlockProducerScheduleObjectWrap(state, &singleId)
+	names, keys := schedule.GetCurrentShuffledBlockProducer(), schedule.GetPubKey()
+	if len(names) == 0 || len(names) != len(keys) {
+		return errors.New("snapshot state has no valid block producer schedule")
+	}
+	if err := lightclient.NewValidatorSet(keys).VerifyCommit(commit); err != nil {
+		return fmt.Errorf("BFT commit of snapshot isn't signed by its validators: %v", err)
+	}
+	for i := range names {
+		if names[i] != next.Header.BlockProducer.Value {
+			continue
+		}
+		if ok, err := next.ValidateSig(keys[i]); err != nil || !ok {
+			break
+		}
+		return nil
+	}
+	return fmt.Errorf("next block header of snapshot isn't signed by its producer %s", next.Header.BlockProducer.Value)
+}
+
+// importSnapshotRecords writes all records of sr into db, which must have reversion disabled.
+func importSnapshotRecords(db *storage.DatabaseService, sr *snapshot.Reader) ([]byte, error) {
+	batch := db.NewBatch()
+	defer func() {
+		db.DeleteBatch(batch)
+	}()
+	n := 0
+	for {
+		key, value, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if !isSnapshotKey(key) {
+			return nil, fmt.Errorf("unexpected key %x in snapshot", key)
+		}
+		if err = batch.Put(key, value); err != nil {
+			return nil, err
+		}
+		if n++; n%snapshotBatchSize == 0 {
+			if err = batch.Write(); err != nil {
+				return nil, err
+			}
+			db.DeleteBatch(batch)
+			batch = db.NewBatch()
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	return sr.ContentHash(), nil
+}
@@ -0,0 +1,348 @@
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/db/blocklog"
+	"github.com/coschain/contentos-go/db/smt"
+	"github.com/coschain/contentos-go/db/snapshot"
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/coschain/contentos-go/node"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/gobft/message"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const snapshotTestBlockNum = 3
+
+type snapshotTestNode struct {
+	cfg     *node.Config
+	names   []string
+	privs   []*prototype.PrivateKeyType
+	blocks  []*prototype.SignedBlock // blocks[i] is block #i+1, the last one is a child of the LIB
+	commit  *message.Commit
+	lastKey []byte // a key changed after the LIB
+}
+
+func makeSnapshotKeys(t *testing.T, n int) (privs []*prototype.PrivateKeyType, pubs []*prototype.PublicKeyType) {
+	for i := 0; i < n; i++ {
+		priv, err := prototype.GenerateNewKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, _ := priv.PubKey()
+		privs, pubs = append(privs, priv), append(pubs, pub)
+	}
+	return
+}
+
+func signDigest(t *testing.T, priv *prototype.PrivateKeyType, digest []byte) []byte {
+	sig, err := secp256k1.Sign(digest, priv.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// makeSnapshotCommit returns a commit of block id, precommitted by given validators and signed by the first one.
+func makeSnapshotCommit(t *testing.T, id common.BlockID, prev common.BlockID, privs []*prototype.PrivateKeyType) *message.Commit {
+	c := &message.Commit{
+		ProposedData: id.Data,
+		Prev:         prev.Data,
+		CommitTime:   time.Unix(1000, 0).UTC(),
+	}
+	for _, priv := range privs {
+		pub, _ := priv.PubKey()
+		v := &message.Vote{
+			Type:      message.PrecommitType,
+			Height:    int64(id.BlockNum()),
+			Timestamp: time.Unix(1000, 0).UTC(),
+			Proposed:  id.Data,
+			Prev:      prev.Data,
+			Address:   message.PubKey(pub.ToWIF()),
+		}
+		v.Signature = signDigest(t, priv, v.Digest())
+		c.Precommits = append(c.Precommits, v)
+	}
+	pub, _ := privs[0].PubKey()
+	c.Address = message.PubKey(pub.ToWIF())
+	c.Signature = signDigest(t, privs[0], c.Digest())
+	return c
+}
+
+func makeSnapshotBlock(t *testing.T, prev common.BlockID, producer string, priv *prototype.PrivateKeyType, stateRoot []byte) *prototype.SignedBlock {
+	b := &prototype.SignedBlock{
+		SignedHeader: &prototype.SignedBlockHeader{
+			Header: &prototype.BlockHeader{
+				Previous:              &prototype.Sha256{Hash: append([]byte{}, prev.Data[:]...)},
+				Timestamp:             &prototype.TimePointSec{UtcSeconds: uint32(prev.BlockNum() + 1)},
+				BlockProducer:         &prototype.AccountName{Value: producer},
+				TransactionMerkleRoot: &prototype.Sha256{Hash: make([]byte, 32)},
+			},
+			BlockProducerSignature: &prototype.SignatureType{},
+		},
+	}
+	if stateRoot != nil {
+		b.SignedHeader.Header.PrevStateRoot = &prototype.Sha256{Hash: stateRoot}
+	}
+	if err := b.SignedHeader.Sign(priv); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// newSnapshotTestNode makes a stopped node whose last irreversible block is snapshotTestBlockNum, and whose state
+// database is ahead of it.
+func newSnapshotTestNode(t *testing.T, dir string) *snapshotTestNode {
+	a := assert.New(t)
+	n := &snapshotTestNode{
+		cfg:   &node.Config{DataDir: dir, Name: "cosd"},
+		names: []string{"bp1", "bp2", "bp3", "bp4"},
+	}
+	privs, pubs := makeSnapshotKeys(t, len(n.names))
+	n.privs = privs
+
+	db, err := storage.NewDatabase(n.cfg.ResolvePath("db"))
+	a.NoError(err)
+	a.NoError(db.Start(nil))
+	defer db.Close()
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	berg := app.NewBlockIceberg(db, logger, false, func(uint64) bool { return true })
+
+	singleId := int32(constants.SingletonId)
+	var prev common.BlockID
+	var stateRoot []byte
+	for i := 1; i <= snapshotTestBlockNum; i++ {
+		block := makeSnapshotBlock(t, prev, n.names[i%len(n.names)], privs[i%len(privs)], stateRoot)
+		prev = block.Id()
+		n.blocks = append(n.blocks, block)
+
+		a.NoError(berg.BeginBlock(uint64(i)))
+		a.NoError(db.Put([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("v%d", i))))
+		headId := &prototype.Sha256{}
+		headId.FromBlockID(block.Id())
+		if i == 1 {
+			table.NewSoGlobalWrap(db, &singleId).Create(func(tInfo *table.SoGlobal) {
+				tInfo.Id = singleId
+				tInfo.Props = &prototype.DynamicProperties{HeadBlockId: headId}
+			})
+			table.NewSoBlockProducerScheduleObjectWrap(db, &singleId).Create(func(tInfo *table.SoBlockProducerScheduleObject) {
+				tInfo.Id = singleId
+				tInfo.CurrentShuffledBlockProducer = n.names
+				tInfo.PubKey = pubs
+				tInfo.Seq = 1
+			})
+		} else {
+			table.NewSoGlobalWrap(db, &singleId).SetProps(&prototype.DynamicProperties{HeadBlockId: headId})
+		}
+		a.NoError(berg.EndBlock(true))
+		stateRoot, err = berg.LatestStateRoot()
+		a.NoError(err)
+	}
+	a.NoError(berg.FinalizeBlock(snapshotTestBlockNum))
+
+	// the next block, and a fork of it, which doesn't commit to the state
+	next := makeSnapshotBlock(t, prev, n.names[1], privs[1], stateRoot)
+	fork := makeSnapshotBlock(t, prev, n.names[2], privs[2], smt.EmptyHash)
+	n.blocks = append(n.blocks, next)
+
+	// changes of the next block, which went into the reversible database
+	n.lastKey = []byte("k1")
+	a.NoError(db.Put(n.lastKey, []byte("changed")))
+	a.NoError(db.Put([]byte("k_next"), []byte("v_next")))
+	a.NoError(smt.New(db).Update(n.lastKey, []byte("changed")))
+	current, base := db.GetRevisionAndBase()
+	a.True(current > base)
+
+	var blog blocklog.BLog
+	a.NoError(blog.Open(n.cfg.ResolvePath("blog")))
+	a.NoError(blog.SetBase(snapshotTestBlockNum - 1))
+	a.NoError(blog.Append(n.blocks[snapshotTestBlockNum-1]))
+	blog.Close()
+
+	var forks blocklog.BLog
+	a.NoError(forks.Open(n.cfg.ResolvePath(constants.ForkDBSnapshot)))
+	for _, b := range []*prototype.SignedBlock{n.blocks[snapshotTestBlockNum-1], fork, next} {
+		a.NoError(forks.Append(b))
+	}
+	forks.Close()
+
+	n.commit = makeSnapshotCommit(t, n.blocks[snapshotTestBlockNum-1].Id(), n.blocks[snapshotTestBlockNum-2].Id(), privs[:3])
+	a.NoError(SaveCheckPoint(n.cfg.ResolvePath(constants.CheckPoint), n.commit))
+	return n
+}
+
+// resignSnapshot re-writes a snapshot with its header modified by f.
+// resignSnapshot rewrites the header of a snapshot by f, and returns the new snapshot and its content hash.
+func resignSnapshot(t *testing.T, data []byte, f func(h *snapshot.Header)) ([]byte, []byte) {
+	sr, header, err := snapshot.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f(header)
+	var buf bytes.Buffer
+	sw, err := snapshot.NewWriter(&buf, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		key, value, err := sr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if err = sw.Write(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	contentHash, err := sw.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), contentHash
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	dir, err := ioutil.TempDir("", "snapshot")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+
+	src := newSnapshotTestNode(t, filepath.Join(dir, "src"))
+	var buf bytes.Buffer
+	header, contentHash, err := ExportSnapshot(src.cfg, 0, &buf)
+	a.NoError(err)
+	a.Equal(uint64(snapshotTestBlockNum), header.BlockNum)
+	_, _, err = ExportSnapshot(src.cfg, snapshotTestBlockNum+1, ioutil.Discard)
+	a.Error(err, "only the LIB can be exported")
+
+	// the source database isn't reverted by exporting
+	srcDb, err := storage.NewDatabase(src.cfg.ResolvePath("db"))
+	a.NoError(err)
+	a.NoError(srcDb.Start(nil))
+	current, base := srcDb.GetRevisionAndBase()
+	a.True(current > base)
+	v, err := srcDb.Get(src.lastKey)
+	a.NoError(err)
+	a.Equal([]byte("changed"), v)
+	srcState, err := srcDb.NewRevisionPatch(base)
+	a.NoError(err)
+
+	dst := &node.Config{DataDir: filepath.Join(dir, "dst"), Name: "cosd"}
+	imported, importedHash, err := ImportSnapshot(dst, bytes.NewReader(buf.Bytes()), contentHash)
+	a.NoError(err)
+	a.Equal(contentHash, importedHash)
+	a.Equal(header.StateRoot, imported.StateRoot)
+
+	// the imported state is the one of the LIB, with a rebuilt state tree
+	dstDb, err := storage.NewDatabase(dst.ResolvePath("db"))
+	a.NoError(err)
+	a.NoError(dstDb.Start(nil))
+	defer dstDb.Close()
+	srcState.Iterate(nil, nil, false, func(key, value []byte) bool {
+		if isSnapshotKey(key) {
+			v, err := dstDb.Get(key)
+			a.NoError(err)
+			a.Equal(value, v)
+		}
+		return true
+	})
+	srcDb.Close()
+	_, err = dstDb.Get([]byte("k_next"))
+	a.Error(err)
+	root, err := smt.New(dstDb).Root()
+	a.NoError(err)
+	a.Equal(header.StateRoot, root)
+	current, base = dstDb.GetRevisionAndBase()
+	a.Equal(strconv.Itoa(snapshotTestBlockNum), dstDb.GetRevisionTag(current))
+	a.True(dstDb.ReversionEnabled())
+
+	var blog blocklog.BLog
+	a.NoError(blog.Open(dst.ResolvePath("blog")))
+	block := &prototype.SignedBlock{}
+	a.NoError(blog.ReadBlock(block, snapshotTestBlockNum-1))
+	a.Equal(src.blocks[snapshotTestBlockNum-1].Id(), block.Id())
+	blog.Close()
+	commit, err := LoadCheckPoint(dst.ResolvePath(constants.CheckPoint), snapshotTestBlockNum)
+	a.NoError(err)
+	a.Equal(src.commit.Bytes(), commit.Bytes())
+}
+
+func TestSnapshotImportVerification(t *testing.T) {
+	a := assert.New(t)
+	dir, err := ioutil.TempDir("", "snapshot")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+
+	src := newSnapshotTestNode(t, filepath.Join(dir, "src"))
+	var buf bytes.Buffer
+	_, contentHash, err := ExportSnapshot(src.cfg, 0, &buf)
+	a.NoError(err)
+	data := buf.Bytes()
+	strangers, _ := makeSnapshotKeys(t, 3)
+	lib, parent := src.blocks[snapshotTestBlockNum-1], src.blocks[snapshotTestBlockNum-2]
+
+	importFails := func(name string, data, trustedHash []byte) {
+		cfg := &node.Config{DataDir: filepath.Join(dir, name), Name: "cosd"}
+		_, _, err := ImportSnapshot(cfg, bytes.NewReader(data), trustedHash)
+		a.Error(err, name)
+		_, err = os.Stat(cfg.ResolvePath("db"))
+		a.True(os.IsNotExist(err), name)
+	}
+
+	importFails("untrusted", data, []byte("untrusted hash"))
+	importFails("no hash", data, nil)
+
+	// commits and next headers aren't covered by content hashes, so they must be verified against the state
+	importForged := func(name string, keepsHash bool, f func(h *snapshot.Header)) {
+		forged, forgedHash := resignSnapshot(t, data, f)
+		a.Equal(keepsHash, bytes.Equal(contentHash, forgedHash), name)
+		importFails(name, forged, forgedHash)
+	}
+
+	importForged("strangers", true, func(h *snapshot.Header) {
+		h.Commit = makeSnapshotCommit(t, lib.Id(), parent.Id(), strangers).Bytes()
+	})
+
+	importForged("minority", true, func(h *snapshot.Header) {
+		h.Commit = makeSnapshotCommit(t, lib.Id(), parent.Id(), src.privs[:2]).Bytes()
+	})
+
+	importForged("forged producer", true, func(h *snapshot.Header) {
+		next := makeSnapshotBlock(t, lib.Id(), src.names[1], strangers[0], h.StateRoot)
+		h.Next, _ = proto.Marshal(next.SignedHeader)
+	})
+
+	// a consistent but wrong state root is refused, since the state tree is rebuilt from the records
+	importForged("forged root", false, func(h *snapshot.Header) {
+		h.StateRoot = smt.Hash([]byte("forged"))
+		next := makeSnapshotBlock(t, lib.Id(), src.names[1], src.privs[1], h.StateRoot)
+		h.Next, _ = proto.Marshal(next.SignedHeader)
+	})
+
+	importForged("no next", true, func(h *snapshot.Header) {
+		h.Next = nil
+	})
+
+	// the next block is required for exporting
+	a.NoError(os.RemoveAll(src.cfg.ResolvePath(constants.ForkDBSnapshot)))
+	_, _, err = ExportSnapshot(src.cfg, 0, ioutil.Discard)
+	a.Error(err)
+}
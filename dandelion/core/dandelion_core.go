@@ -15,6 +15,7 @@ import (
 	"github.com/coschain/contentos-go/config"
 	"github.com/coschain/contentos-go/db/storage"
 	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/iservices/service-configs"
 	"github.com/coschain/contentos-go/node"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/gogo/protobuf/proto"
@@ -35,7 +36,11 @@ type DandelionCore struct {
 	cfg node.Config
 	chainId prototype.ChainId
 	timeStamp uint32
+	genesisTime uint32
 	prevHash *prototype.Sha256
+	keepBlocks bool
+	signBlocks bool
+	blocks []*prototype.SignedBlock
 	accounts map[string]*prototype.PrivateKeyType
 	trxReceipts *lru.Cache
 	beforePreshuffle, afterPreshuffle map[string]func()
@@ -53,6 +58,9 @@ func NewDandelionCore(logger *logrus.Logger, enablePlugins bool, sqlPlugins []st
 	buf := make([]byte, 8)
 	_, _ = rand.Reader.Read(buf)
 	cfg.DataDir = filepath.Join(os.TempDir(), hex.EncodeToString(buf))
+	genesis := *cfg.P2P.Genesis
+	genesis.Features = make(map[string]uint64)
+	cfg.P2P.Genesis = &genesis
 
 	n, _ := node.New(&cfg)
 	n.Log = logger
@@ -114,6 +122,7 @@ func (d *DandelionCore) Start() (err error) {
 	_ = os.Mkdir(d.cfg.DataDir, 0777)
 	_ = os.Mkdir(filepath.Join(d.cfg.DataDir, d.cfg.Name), 0777)
 
+	d.genesisTime = d.timeStamp
 	if err = d.node.Start(); err == nil {
 		// produce the first block with no transactions.
 		// this will set correct head timestamp in state db.
@@ -181,7 +190,10 @@ func (d *DandelionCore) GetAccountKey(name string) *prototype.PrivateKeyType {
 }
 
 func (d *DandelionCore) produceBlock() (block *prototype.SignedBlock, err error) {
-	const skip = prototype.Skip_block_signatures
+	skip := prototype.Skip_block_signatures
+	if d.signBlocks {
+		skip = prototype.Skip_nothing
+	}
 	var blockId common.BlockID
 
 	copy(blockId.Data[:], d.prevHash.Hash)
@@ -195,12 +207,65 @@ func (d *DandelionCore) produceBlock() (block *prototype.SignedBlock, err error)
 	if block, err = d.TrxPool().GenerateAndApplyBlock(bp, d.prevHash, d.timeStamp, bpKey, skip); err != nil {
 		return
 	}
-	blockId = block.Id()
-	d.TrxPool().Commit(num)
+	d.blockApplied(block)
+	return
+}
+
+func (d *DandelionCore) blockApplied(block *prototype.SignedBlock) {
+	blockId := block.Id()
+	d.TrxPool().Commit(blockId.BlockNum())
 	copy(d.prevHash.Hash, blockId.Data[:])
-	d.timeStamp += constants.BlockInterval
+	d.timeStamp = block.SignedHeader.Header.Timestamp.UtcSeconds + constants.BlockInterval
+	if d.keepBlocks {
+		d.blocks = append(d.blocks, block)
+	}
 	d.node.EvBus.Publish(constants.NoticeLibChange, []common.ISignedBlock{block})
-	return
+}
+
+// SetGenesisTime sets timestamp of the first block. It must be called before Start().
+// Dandelions of the same genesis time start with the same state, so that they can push blocks produced by each other.
+func (d *DandelionCore) SetGenesisTime(t uint32) {
+	d.timeStamp = t
+}
+
+func (d *DandelionCore) GenesisTime() uint32 {
+	return d.genesisTime
+}
+
+// SetFeatureHeight changes activation height of a builtin feature. It must be called before Start().
+// Dandelions of different feature schedules can't push blocks produced by each other.
+func (d *DandelionCore) SetFeatureHeight(id string, height uint64) {
+	d.cfg.P2P.Genesis.Features[id] = height
+}
+
+// SetGenesisRewardPolicy sets the initial reward policy. It must be called before Start().
+func (d *DandelionCore) SetGenesisRewardPolicy(policy *service_configs.RewardPolicyConfig) {
+	d.cfg.P2P.Genesis.RewardPolicy = policy
+}
+
+// SignBlocks sets whether produced blocks are signed, so that they can be pushed to other dandelions.
+// Dandelions pushing blocks to each other must have the same setting. It must be called before Start().
+func (d *DandelionCore) SignBlocks(sign bool) {
+	d.signBlocks = sign
+}
+
+// KeepBlocks sets whether blocks should be kept in memory for Blocks(). It must be called before Start().
+func (d *DandelionCore) KeepBlocks(keep bool) {
+	d.keepBlocks = keep
+}
+
+// Blocks returns all blocks since the first one if KeepBlocks(true) was called.
+func (d *DandelionCore) Blocks() []*prototype.SignedBlock {
+	return d.blocks
+}
+
+// PushSignedBlock pushes a block, which is usually produced by another dandelion of the same genesis time.
+func (d *DandelionCore) PushSignedBlock(block *prototype.SignedBlock) error {
+	if err := d.TrxPool().PushBlock(block, 0); err != nil {
+		return err
+	}
+	d.blockApplied(block)
+	return nil
 }
 
 func (d *DandelionCore) ProduceBlocks(count int) error {
@@ -230,7 +295,11 @@ func (d *DandelionCore) SendRawTrx(signedTrx *prototype.SignedTransaction) (*pro
 }
 
 func (d *DandelionCore) sendTrx(privateKey *prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, error) {
-	signedTrx, err := d.NewSignedTransaction(privateKey, operations...)
+	return d.sendMultiSigTrx([]*prototype.PrivateKeyType{privateKey}, operations...)
+}
+
+func (d *DandelionCore) sendMultiSigTrx(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, error) {
+	signedTrx, err := d.NewMultiSignedTransaction(privateKeys, operations...)
 	if err == nil {
 		err = d.TrxPool().PushTrxToPending(signedTrx)
 	}
@@ -238,6 +307,15 @@ func (d *DandelionCore) sendTrx(privateKey *prototype.PrivateKeyType, operations
 }
 
 func (d *DandelionCore) NewSignedTransaction(privateKey *prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, error) {
+	return d.NewMultiSignedTransaction([]*prototype.PrivateKeyType{privateKey}, operations...)
+}
+
+// NewMultiSignedTransaction creates a transaction signed by all given keys.
+// The first key makes the primary signature, and the rest make co-signatures.
+func (d *DandelionCore) NewMultiSignedTransaction(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, error) {
+	if len(privateKeys) == 0 {
+		return nil, errors.New("no signing keys")
+	}
 	data, err := proto.Marshal(&prototype.Transaction{
 		RefBlockNum: common.TaposRefBlockNum(d.Head().BlockNum()),
 		RefBlockPrefix: common.TaposRefBlockPrefix(d.prevHash.Hash),
@@ -255,12 +333,19 @@ func (d *DandelionCore) NewSignedTransaction(privateKey *prototype.PrivateKeyTyp
 		Trx: trx,
 		Signature: new(prototype.SignatureType),
 	}
-	signedTrx.Signature.Sig = signedTrx.Sign(privateKey, d.chainId)
+	signedTrx.Signature.Sig = signedTrx.Sign(privateKeys[0], d.chainId)
+	for _, k := range privateKeys[1:] {
+		signedTrx.Signatures = append(signedTrx.Signatures, &prototype.SignatureType{Sig: signedTrx.Sign(k, d.chainId)})
+	}
 	return signedTrx, nil
 }
 
 func (d *DandelionCore) sendTrxAndProduceBlock(privateKey *prototype.PrivateKeyType, operations...*prototype.Operation) (trx *prototype.SignedTransaction, block *prototype.SignedBlock, err error) {
-	if trx, err = d.sendTrx(privateKey, operations...); err != nil {
+	return d.sendMultiSigTrxAndProduceBlock([]*prototype.PrivateKeyType{privateKey}, operations...)
+}
+
+func (d *DandelionCore) sendMultiSigTrxAndProduceBlock(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) (trx *prototype.SignedTransaction, block *prototype.SignedBlock, err error) {
+	if trx, err = d.sendMultiSigTrx(privateKeys, operations...); err != nil {
 		return
 	}
 	block, err = d.produceBlock()
@@ -281,7 +366,11 @@ func (d *DandelionCore) SendTrxByAccount(name string, operations...*prototype.Op
 }
 
 func (d *DandelionCore) SendTrxEx2(privateKey *prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, *prototype.TransactionReceiptWithInfo, error) {
-	trx, block, err := d.sendTrxAndProduceBlock(privateKey, operations...)
+	return d.SendMultiSigTrxEx2([]*prototype.PrivateKeyType{privateKey}, operations...)
+}
+
+func (d *DandelionCore) SendMultiSigTrxEx2(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.SignedTransaction, *prototype.TransactionReceiptWithInfo, error) {
+	trx, block, err := d.sendMultiSigTrxAndProduceBlock(privateKeys, operations...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -301,6 +390,16 @@ func (d *DandelionCore) SendTrxEx(privateKey *prototype.PrivateKeyType, operatio
 	return r, err
 }
 
+func (d *DandelionCore) SendMultiSigTrx(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) error {
+	_, err := d.sendMultiSigTrx(privateKeys, operations...)
+	return err
+}
+
+func (d *DandelionCore) SendMultiSigTrxEx(privateKeys []*prototype.PrivateKeyType, operations...*prototype.Operation) (*prototype.TransactionReceiptWithInfo, error) {
+	_, r, err := d.SendMultiSigTrxEx2(privateKeys, operations...)
+	return r, err
+}
+
 func (d *DandelionCore) SendTrxByAccountEx(name string, operations...*prototype.Operation) (*prototype.TransactionReceiptWithInfo, error) {
 	key, ok := d.accounts[name]
 	if !ok {
@@ -358,6 +457,10 @@ func (d *DandelionCore) UnsubscribePreShuffle(beforeOrAfter bool, f interface{})
 }
 
 func (d *DandelionCore) PushBlock(trxWrappers...*prototype.TransactionWrapper) (block *prototype.SignedBlock, err error) {
+	return d.PushBlockWithStateRoot(nil, trxWrappers...)
+}
+
+func (d *DandelionCore) PushBlockWithStateRoot(stateRoot []byte, trxWrappers...*prototype.TransactionWrapper) (block *prototype.SignedBlock, err error) {
 	var blockId common.BlockID
 	copy(blockId.Data[:], d.prevHash.Hash)
 	num := blockId.BlockNum() + 1
@@ -370,7 +473,7 @@ func (d *DandelionCore) PushBlock(trxWrappers...*prototype.TransactionWrapper) (
 	block = &prototype.SignedBlock{
 		SignedHeader:         &prototype.SignedBlockHeader{
 			Header:                 &prototype.BlockHeader{
-				Previous:              &prototype.Sha256{ Hash: blockId.Data[:]},
+				Previous:              &prototype.Sha256{ Hash: common.CopyBytes(blockId.Data[:])},
 				Timestamp:             &prototype.TimePointSec{UtcSeconds: d.timeStamp},
 				BlockProducer:          &prototype.AccountName{Value: bp},
 			},
@@ -378,16 +481,15 @@ func (d *DandelionCore) PushBlock(trxWrappers...*prototype.TransactionWrapper) (
 		},
 		Transactions:         trxWrappers,
 	}
+	if stateRoot != nil {
+		block.SignedHeader.Header.PrevStateRoot = &prototype.Sha256{Hash: stateRoot}
+	}
 	id := block.CalculateMerkleRoot()
 	block.SignedHeader.Header.TransactionMerkleRoot = &prototype.Sha256{Hash: id.Data[:]}
 	_ = block.SignedHeader.Sign(bpKey)
 	if err = d.TrxPool().PushBlock(block, 0); err != nil {
 		return
 	}
-	blockId = block.Id()
-	d.TrxPool().Commit(num)
-	copy(d.prevHash.Hash, blockId.Data[:])
-	d.timeStamp += constants.BlockInterval
-	d.node.EvBus.Publish(constants.NoticeLibChange, []common.ISignedBlock{block})
+	d.blockApplied(block)
 	return
 }
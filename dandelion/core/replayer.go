@@ -0,0 +1,121 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/asaskevich/EventBus"
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/common/eventloop"
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/node"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/sirupsen/logrus"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// replaySkip skips verifications that have been done when blocks were first applied.
+const replaySkip = prototype.Skip_block_check | prototype.Skip_block_signatures | prototype.Skip_transaction_signatures
+
+// Replayer re-applies blocks of an existing chain to a fresh state db in a temporary data dir.
+// It shares the genesis config of the original node, so that the replayed state is the same as the original one,
+// unless the replayer is customized, e.g. by SetRewardPolicy().
+type Replayer struct {
+	node *node.Node
+	cfg node.Config
+}
+
+func NewReplayer(cfg *node.Config, logger *logrus.Logger) *Replayer {
+	if logger == nil {
+		logger = logrus.New()
+		logger.SetOutput(ioutil.Discard)
+	}
+
+	rcfg := *cfg
+	rcfg.Name = "replayer"
+	buf := make([]byte, 8)
+	_, _ = rand.Reader.Read(buf)
+	rcfg.DataDir = filepath.Join(os.TempDir(), hex.EncodeToString(buf))
+
+	n, _ := node.New(&rcfg)
+	n.Log = logger
+	n.MainLoop = eventloop.NewEventLoop()
+	n.EvBus = EventBus.New()
+
+	_ = n.Register(iservices.DbServerName, func(ctx *node.ServiceContext) (node.Service, error) {
+		return storage.NewGuardedDatabaseService(ctx, "./db/")
+	})
+	_ = n.Register(iservices.TxPoolServerName, func(ctx *node.ServiceContext) (node.Service, error) {
+		return app.NewController(ctx, n.Log)
+	})
+	// block producers are shuffled in the same way as SABFT
+	_ = n.Register(DummyConsensusName, func(ctx *node.ServiceContext) (node.Service, error) {
+		return NewDummyConsensus(ctx)
+	})
+	return &Replayer{node: n, cfg: rcfg}
+}
+
+func (r *Replayer) cleanup() {
+	_ = os.RemoveAll(r.cfg.DataDir)
+}
+
+func (r *Replayer) Start() (err error) {
+	defer func() {
+		if err != nil {
+			r.cleanup()
+		}
+	}()
+	_ = os.RemoveAll(r.cfg.DataDir)
+	if err = os.MkdirAll(filepath.Join(r.cfg.DataDir, r.cfg.Name), 0777); err != nil {
+		return err
+	}
+	return r.node.Start()
+}
+
+func (r *Replayer) Stop() error {
+	defer r.cleanup()
+	return r.node.Stop()
+}
+
+func (r *Replayer) Database() iservices.IDatabaseService {
+	if s, err := r.node.Service(iservices.DbServerName); err != nil {
+		return nil
+	} else {
+		return s.(iservices.IDatabaseService)
+	}
+}
+
+func (r *Replayer) TrxPool() iservices.ITrxPool {
+	if s, err := r.node.Service(iservices.TxPoolServerName); err != nil {
+		return nil
+	} else {
+		return s.(iservices.ITrxPool)
+	}
+}
+
+// SetRewardPolicy forces a reward policy regardless of the one on chain. It must be called after Start().
+func (r *Replayer) SetRewardPolicy(policy app.RewardPolicy) {
+	r.TrxPool().(*app.TrxPool).SetRewardPolicy(policy)
+}
+
+// SetEconomistHook sets a function to be called right before the economist runs for each block. It must be called after Start().
+func (r *Replayer) SetEconomistHook(hook func(db iservices.IDatabaseService)) {
+	r.TrxPool().(*app.TrxPool).SetEconomistHook(hook)
+}
+
+// HardFork returns the hard fork number of current head block.
+func (r *Replayer) HardFork() uint64 {
+	return r.TrxPool().HardFork()
+}
+
+// PushBlock applies and finalizes a block. Blocks must be pushed in order, starting from block 1.
+func (r *Replayer) PushBlock(block *prototype.SignedBlock) error {
+	if err := r.TrxPool().PushBlock(block, replaySkip); err != nil {
+		return fmt.Errorf("failed to replay block %d: %v", block.Id().BlockNum(), err)
+	}
+	r.TrxPool().Commit(block.Id().BlockNum())
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/coschain/contentos-go/app/table"
 	"github.com/coschain/contentos-go/common/constants"
 	"github.com/coschain/contentos-go/dandelion/core"
+	"github.com/coschain/contentos-go/iservices/service-configs"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/coschain/contentos-go/vm/contract/abi"
 	table2 "github.com/coschain/contentos-go/vm/contract/table"
@@ -36,11 +37,35 @@ func NewDandelionTest(f DandelionTestFunc, actors int) func(*testing.T) {
 }
 
 func NewDandelionTestWithPlugins(enablePlugins bool, sqlPlugins []string, f DandelionTestFunc, actors int) func(*testing.T) {
+	return newDandelionTest(enablePlugins, sqlPlugins, nil, f, actors)
+}
+
+// NewDandelionTestWithFeatures creates a dandelion test on a customized schedule of features.
+func NewDandelionTestWithFeatures(features map[string]uint64, f DandelionTestFunc, actors int) func(*testing.T) {
+	return newDandelionTest(false, nil, func(d *Dandelion) {
+		for id, height := range features {
+			d.SetFeatureHeight(id, height)
+		}
+	}, f, actors)
+}
+
+// NewDandelionTestWithRewardPolicy creates a dandelion test of given genesis reward policy.
+func NewDandelionTestWithRewardPolicy(policy *service_configs.RewardPolicyConfig, f DandelionTestFunc, actors int) func(*testing.T) {
+	return newDandelionTest(false, nil, func(d *Dandelion) {
+		d.SetGenesisRewardPolicy(policy)
+	}, f, actors)
+}
+
+// newDandelionTest creates a dandelion test. setup, if not nil, is called before the dandelion starts.
+func newDandelionTest(enablePlugins bool, sqlPlugins []string, setup func(*Dandelion), f DandelionTestFunc, actors int) func(*testing.T) {
 	return func(t *testing.T) {
 		d := NewDandelionWithPlugins(nil, enablePlugins, sqlPlugins)
 		if d == nil {
 			t.Fatal("dandelion creation failed")
 		}
+		if setup != nil {
+			setup(d)
+		}
 		err := d.Start()
 		if err != nil {
 			t.Fatalf("dandelion start failed: %s", err.Error())
@@ -119,6 +144,16 @@ func (d *Dandelion) Test(f DandelionTestFunc) func(*testing.T) {
 	}
 }
 
+// TestFeatureInactive checks that given feature is inactive, and that try, which needs the feature, fails.
+func (d *Dandelion) TestFeatureInactive(t *testing.T, feature string, try func() error) {
+	if d.TrxPool().FeatureActive(feature) {
+		t.Fatalf("feature %s is active", feature)
+	}
+	if err := try(); err == nil {
+		t.Errorf("succeeded while feature %s is inactive", feature)
+	}
+}
+
 //
 // Table Record Retrieval by Primary keys
 //
@@ -237,6 +272,26 @@ func (d *Dandelion) VestDelegation(orderId uint64) *table.SoVestDelegationWrap {
 	return table.NewSoVestDelegationWrap(d.Database(), &orderId)
 }
 
+func (d *Dandelion) ScheduledTransfer(transferId uint64) *table.SoScheduledTransferWrap {
+	return table.NewSoScheduledTransferWrap(d.Database(), &transferId)
+}
+
+func (d *Dandelion) Escrow(escrowId uint64) *table.SoEscrowWrap {
+	return table.NewSoEscrowWrap(d.Database(), &escrowId)
+}
+
+func (d *Dandelion) Proposal(proposalId uint64) *table.SoProposalWrap {
+	return table.NewSoProposalWrap(d.Database(), &proposalId)
+}
+
+func (d *Dandelion) PostRevision(postId uint64, version uint32) *table.SoPostRevisionWrap {
+	return table.NewSoPostRevisionWrap(d.Database(), &prototype.PostRevisionId{PostId: postId, Version: version})
+}
+
+func (d *Dandelion) TrxNonce(signer string, nonce uint64) *table.SoTrxNonceWrap {
+	return table.NewSoTrxNonceWrap(d.Database(), &prototype.TrxNonceId{Signer: prototype.NewAccountName(signer), Nonce: nonce})
+}
+
 func (d *Dandelion) CurrentRecordID() uint64 {
 	return table.NewSoIncIdWrap(d.Database(), &app.SingleId).GetCounter()
 }
@@ -31,6 +31,24 @@ func AccountUpdate(name string, pubkey *prototype.PublicKeyType) *prototype.Oper
 	})
 }
 
+func AccountUpdateWithAuthority(name string, pubkey *prototype.PublicKeyType, authority *prototype.Authority) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.AccountUpdateOperation{
+		Owner: prototype.NewAccountName(name),
+		PubKey: pubkey,
+		Authority: authority,
+	})
+}
+
+func AccountUpdateWithAuthorities(name string, pubkey *prototype.PublicKeyType, owner, active, posting *prototype.Authority) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.AccountUpdateOperation{
+		Owner: prototype.NewAccountName(name),
+		PubKey: pubkey,
+		Authority: owner,
+		ActiveAuthority: active,
+		PostingAuthority: posting,
+	})
+}
+
 func TransferToVest(from, to string, amount uint64, memo string) *prototype.Operation {
 	return prototype.GetPbOperation(&prototype.TransferToVestOperation{
 		From: prototype.NewAccountName(from),
@@ -183,6 +201,30 @@ func Reply(postId, parentId uint64, author, content string, beneficiaries []map[
 	})
 }
 
+func PostEdit(postId uint64, author, title, content string) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.PostEditOperation{
+		Uuid: postId,
+		Owner: prototype.NewAccountName(author),
+		Title: title,
+		Content: content,
+	})
+}
+
+func PostPatch(postId uint64, author string, patches ...*prototype.BodyPatch) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.PostEditOperation{
+		Uuid: postId,
+		Owner: prototype.NewAccountName(author),
+		Patches: patches,
+	})
+}
+
+func PostDelete(postId uint64, author string) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.PostDeleteOperation{
+		Uuid: postId,
+		Owner: prototype.NewAccountName(author),
+	})
+}
+
 //func Report(reporter string, postId uint64, reason []prototype.ReportOperationTag, arbitration, approved bool) *prototype.Operation {
 //	return prototype.GetPbOperation(&prototype.ReportOperation{
 //		Reporter: prototype.NewAccountName(reporter),
@@ -246,3 +288,86 @@ func UnDelegateVest(name string, orderId uint64) *prototype.Operation {
 		Account: prototype.NewAccountName(name),
 	})
 }
+
+func ScheduledTransfer(from, to string, amount uint64, memo string, dueTime uint32) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.ScheduledTransferOperation{
+		From: prototype.NewAccountName(from),
+		To: prototype.NewAccountName(to),
+		Amount: prototype.NewCoin(amount),
+		Memo: memo,
+		DueTime: prototype.NewTimePointSec(dueTime),
+	})
+}
+
+func CancelScheduledTransfer(name string, transferId uint64) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.CancelScheduledTransferOperation{
+		Account: prototype.NewAccountName(name),
+		TransferId: transferId,
+	})
+}
+
+func EscrowTransfer(from, to, agent string, amount, fee uint64, memo string, ratificationDeadline, expiration uint32) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.EscrowTransferOperation{
+		From: prototype.NewAccountName(from),
+		To: prototype.NewAccountName(to),
+		Agent: prototype.NewAccountName(agent),
+		Amount: prototype.NewCoin(amount),
+		AgentFee: prototype.NewCoin(fee),
+		Memo: memo,
+		RatificationDeadline: prototype.NewTimePointSec(ratificationDeadline),
+		EscrowExpiration: prototype.NewTimePointSec(expiration),
+	})
+}
+
+func EscrowApprove(name string, escrowId uint64, approve bool) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.EscrowApproveOperation{
+		Account: prototype.NewAccountName(name),
+		EscrowId: escrowId,
+		Approve: approve,
+	})
+}
+
+func EscrowDispute(name string, escrowId uint64) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.EscrowDisputeOperation{
+		Account: prototype.NewAccountName(name),
+		EscrowId: escrowId,
+	})
+}
+
+func EscrowRelease(name string, escrowId uint64, receiver string, amount uint64) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.EscrowReleaseOperation{
+		Account: prototype.NewAccountName(name),
+		EscrowId: escrowId,
+		Receiver: prototype.NewAccountName(receiver),
+		Amount: prototype.NewCoin(amount),
+	})
+}
+
+func ProposalCreate(name, title, description string, props *prototype.ChainProperties, feature string, activationBlockNum uint64) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.ProposalCreateOperation{
+		Proposer: prototype.NewAccountName(name),
+		Title: title,
+		Description: description,
+		Props: props,
+		Feature: feature,
+		ActivationBlockNum: activationBlockNum,
+	})
+}
+
+func RewardPolicyProposal(name, title, description string, policy *prototype.RewardPolicy, activationBlockNum uint64) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.ProposalCreateOperation{
+		Proposer: prototype.NewAccountName(name),
+		Title: title,
+		Description: description,
+		RewardPolicy: policy,
+		ActivationBlockNum: activationBlockNum,
+	})
+}
+
+func ProposalVote(name string, proposalId uint64, approve bool) *prototype.Operation {
+	return prototype.GetPbOperation(&prototype.ProposalVoteOperation{
+		Voter: prototype.NewAccountName(name),
+		ProposalId: proposalId,
+		Approve: approve,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sync"
 
@@ -34,10 +35,15 @@ const maxPayloadLen = 1024 * 1024 * 256
  *
  * The main file is the only file that needs to persist. The index file can be reconstructed during a
  * linear scan of the main file.
+ *
+ * A log bootstrapped from a state snapshot doesn't start at block 1. The number of blocks before its first block
+ * is kept in an optional base file, and blocks below the base are not readable.
  */
 type BLog struct {
 	logFile   *os.File
 	indexFile *os.File
+	baseFile  string
+	base      int64
 
 	sync.RWMutex
 }
@@ -61,6 +67,14 @@ func (bl *BLog) Open(dir string) (err error) {
 	if err != nil {
 		return
 	}
+	bl.baseFile = dir + "/block.base"
+	bl.base = 0
+	if baseByte, err := ioutil.ReadFile(bl.baseFile); err == nil {
+		if len(baseByte) != indexSize {
+			return fmt.Errorf("blog open: malformed base file %s", bl.baseFile)
+		}
+		bl.base = int64(binary.LittleEndian.Uint64(baseByte))
+	}
 
 	logInfo, err := bl.logFile.Stat()
 	if err != nil {
@@ -95,6 +109,15 @@ func (bl *BLog) Open(dir string) (err error) {
 	return
 }
 
+// Close closes log and index file
+func (bl *BLog) Close() {
+	bl.Lock()
+	defer bl.Unlock()
+
+	bl.logFile.Close()
+	bl.indexFile.Close()
+}
+
 // Remove remove log and index file
 func (bl *BLog) Remove(dir string) {
 	bl.Lock()
@@ -104,6 +127,39 @@ func (bl *BLog) Remove(dir string) {
 	bl.indexFile.Close()
 	os.Remove(dir + "/block.bin")
 	os.Remove(dir + "/block.index")
+	os.Remove(dir + "/block.base")
+}
+
+// SetBase makes an empty BLog start right after block number base.
+func (bl *BLog) SetBase(base int64) error {
+	bl.Lock()
+	defer bl.Unlock()
+
+	logInfo, err := bl.logFile.Stat()
+	if err != nil {
+		return err
+	}
+	if logInfo.Size() != 0 {
+		return errors.New("blog set base: log is not empty")
+	}
+	if base < 0 {
+		return fmt.Errorf("blog set base: invalid base %d", base)
+	}
+	baseByte := make([]byte, indexSize)
+	binary.LittleEndian.PutUint64(baseByte, uint64(base))
+	if err = ioutil.WriteFile(bl.baseFile, baseByte, 0755); err != nil {
+		return err
+	}
+	bl.base = base
+	return nil
+}
+
+// Base returns the number of blocks before the first block in the log.
+func (bl *BLog) Base() int64 {
+	bl.RLock()
+	defer bl.RUnlock()
+
+	return bl.base
 }
 
 // Append appends a common.SignedBlock to the BLog
@@ -158,7 +214,7 @@ func (bl *BLog) Size() int64 {
 		panic(err)
 	}
 
-	return idxInfo.Size() / indexSize
+	return bl.base + idxInfo.Size()/indexSize
 }
 
 // Empty returns true if it contains no block
@@ -178,8 +234,11 @@ func (bl *BLog) Empty() bool {
 func (bl *BLog) ReadBlock(sb common.ISignedBlock, blockNum int64) error {
 	bl.RLock()
 	defer bl.RUnlock()
-	
-	indexOffset := blockNum * indexSize
+
+	if blockNum < bl.base {
+		return fmt.Errorf("BLOG ReadBlock: block %d is before the base %d", blockNum, bl.base)
+	}
+	indexOffset := (blockNum - bl.base) * indexSize
 	// read index
 	indexByte := make([]byte, indexSize)
 	_, err := bl.indexFile.ReadAt(indexByte, indexOffset)
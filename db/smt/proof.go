@@ -0,0 +1,65 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+)
+
+// Proof is a Merkle proof of a key.
+// Siblings are hashes of sibling subtrees on the path of the key, from the root down. The path ends at either
+// an empty subtree, or a leaf given by LeafKeyHash and LeafValueHash. If the leaf is of the key, the proof
+// proves its value, otherwise it proves the absence of the key.
+type Proof struct {
+	Siblings      [][]byte
+	LeafKeyHash   []byte
+	LeafValueHash []byte
+}
+
+var ErrInvalidProof = errors.New("smt: invalid proof")
+
+// Verify checks if the proof proves the value of key under given root.
+// A nil value means the key is absent.
+func (p *Proof) Verify(root, key, value []byte) error {
+	if p == nil || len(p.Siblings) > MaxDepth || len(root) != HashSize {
+		return ErrInvalidProof
+	}
+	keyHash := Hash(key)
+	hasLeaf := p.LeafKeyHash != nil || p.LeafValueHash != nil
+	if hasLeaf && (len(p.LeafKeyHash) != HashSize || len(p.LeafValueHash) != HashSize) {
+		return ErrInvalidProof
+	}
+	if value != nil {
+		if !hasLeaf || !bytes.Equal(p.LeafKeyHash, keyHash) || !bytes.Equal(p.LeafValueHash, Hash(value)) {
+			return ErrInvalidProof
+		}
+	} else if hasLeaf {
+		if bytes.Equal(p.LeafKeyHash, keyHash) {
+			return ErrInvalidProof
+		}
+		// the leaf must sit on the path of key
+		for i := range p.Siblings {
+			if bit(p.LeafKeyHash, i) != bit(keyHash, i) {
+				return ErrInvalidProof
+			}
+		}
+	}
+
+	h := EmptyHash
+	if hasLeaf {
+		h = LeafHash(p.LeafKeyHash, p.LeafValueHash)
+	}
+	for i := len(p.Siblings) - 1; i >= 0; i-- {
+		if len(p.Siblings[i]) != HashSize {
+			return ErrInvalidProof
+		}
+		if bit(keyHash, i) == 0 {
+			h = InternalHash(h, p.Siblings[i])
+		} else {
+			h = InternalHash(p.Siblings[i], h)
+		}
+	}
+	if !bytes.Equal(h, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+/*Tree is a compact sparse Merkle tree, mapping sha256(key) to sha256(value).
+ *
+ * The tree is a binary trie of depth 256 along the bits of key hashes. A subtree holding a single leaf is
+ * represented by the leaf itself, and an empty subtree has an all-zero hash, so only O(log(n)) nodes exist
+ * on any path. The root hash only depends on the key-value pairs, not on the order they were inserted in.
+ *
+ *   empty    = 0x00 * 32
+ *   leaf     = sha256(0x00 | keyHash | valueHash)
+ *   internal = sha256(0x01 | leftHash | rightHash)
+ *
+ * Nodes are stored in the backing store by their positions, so that a tree living in the state database is
+ * reverted along with the state itself.
+ */
+type Tree struct {
+	store Store
+}
+
+// Store is the backing key-value store of a tree.
+type Store interface {
+	Has(key []byte) (bool, error)
+	Get(key []byte) ([]byte, error)
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+}
+
+const (
+	HashSize = sha256.Size
+	MaxDepth = HashSize * 8
+
+	leafNode     = byte(0)
+	internalNode = byte(1)
+)
+
+// NodeKeyPrefix is the prefix of all node keys in the backing store.
+var NodeKeyPrefix = []byte("__smt_")
+
+var EmptyHash = make([]byte, HashSize)
+
+var ErrCorruptedNode = errors.New("smt: corrupted node")
+
+type node struct {
+	kind        byte
+	left, right []byte // for internal node
+	keyHash     []byte // for leaf node
+	valueHash   []byte // for leaf node
+}
+
+func (n *node) hash() []byte {
+	if n == nil {
+		return EmptyHash
+	}
+	if n.kind == leafNode {
+		return LeafHash(n.keyHash, n.valueHash)
+	}
+	return InternalHash(n.left, n.right)
+}
+
+func (n *node) encode() []byte {
+	if n.kind == leafNode {
+		return append(append([]byte{leafNode}, n.keyHash...), n.valueHash...)
+	}
+	return append(append([]byte{internalNode}, n.left...), n.right...)
+}
+
+func decodeNode(data []byte) (*node, error) {
+	if len(data) != 1+2*HashSize {
+		return nil, ErrCorruptedNode
+	}
+	a, b := data[1:1+HashSize], data[1+HashSize:]
+	switch data[0] {
+	case leafNode:
+		return &node{kind: leafNode, keyHash: a, valueHash: b}, nil
+	case internalNode:
+		return &node{kind: internalNode, left: a, right: b}, nil
+	}
+	return nil, ErrCorruptedNode
+}
+
+// LeafHash returns the hash of a leaf node.
+func LeafHash(keyHash, valueHash []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafNode})
+	h.Write(keyHash)
+	h.Write(valueHash)
+	return h.Sum(nil)
+}
+
+// InternalHash returns the hash of an internal node.
+func InternalHash(left, right []byte) []byte {
+	if bytes.Equal(left, EmptyHash) && bytes.Equal(right, EmptyHash) {
+		return EmptyHash
+	}
+	h := sha256.New()
+	h.Write([]byte{internalNode})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// Hash returns the hash of given key or value.
+func Hash(data []byte) []byte {
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// bit returns the i-th bit of given hash, from the most significant one.
+func bit(hash []byte, i int) int {
+	return int(hash[i/8]>>(7-uint(i%8))) & 1
+}
+
+// nodeKey returns the store key of the node at given depth on the path of given key hash.
+func nodeKey(depth int, keyHash []byte) []byte {
+	key := make([]byte, len(NodeKeyPrefix)+2+HashSize)
+	n := copy(key, NodeKeyPrefix)
+	binary.BigEndian.PutUint16(key[n:], uint16(depth))
+	path := key[n+2:]
+	copy(path[:depth/8], keyHash)
+	if r := depth % 8; r > 0 {
+		path[depth/8] = keyHash[depth/8] & ^byte(0xff>>uint(r))
+	}
+	return key
+}
+
+// IsNodeKey returns true if given store key belongs to tree nodes.
+func IsNodeKey(key []byte) bool {
+	return bytes.HasPrefix(key, NodeKeyPrefix)
+}
+
+// New returns a tree in given store.
+func New(store Store) *Tree {
+	return &Tree{store: store}
+}
+
+func (t *Tree) getNode(depth int, keyHash []byte) (*node, error) {
+	key := nodeKey(depth, keyHash)
+	data, err := t.store.Get(key)
+	if err != nil {
+		// stores report missing keys as errors too, tell them from real failures
+		if found, hasErr := t.store.Has(key); hasErr != nil {
+			return nil, hasErr
+		} else if found {
+			return nil, err
+		}
+		return nil, nil
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return decodeNode(data)
+}
+
+func (t *Tree) putNode(depth int, keyHash []byte, n *node) error {
+	return t.store.Put(nodeKey(depth, keyHash), n.encode())
+}
+
+func (t *Tree) deleteNode(depth int, keyHash []byte) error {
+	return t.store.Delete(nodeKey(depth, keyHash))
+}
+
+// Root returns the root hash.
+func (t *Tree) Root() ([]byte, error) {
+	n, err := t.getNode(0, EmptyHash)
+	if err != nil {
+		return nil, err
+	}
+	return n.hash(), nil
+}
+
+// Empty returns true if the tree has no leaves.
+func (t *Tree) Empty() bool {
+	n, err := t.getNode(0, EmptyHash)
+	return err == nil && n == nil
+}
+
+// Update sets the value of given key. A nil value removes the key.
+func (t *Tree) Update(key, value []byte) error {
+	var valueHash []byte
+	if value != nil {
+		valueHash = Hash(value)
+	}
+	_, _, err := t.update(0, Hash(key), valueHash)
+	return err
+}
+
+// Delete removes given key.
+func (t *Tree) Delete(key []byte) error {
+	return t.Update(key, nil)
+}
+
+// update sets the value hash of keyHash in the subtree at given depth, and returns the new root node of
+// the subtree, nil if the subtree becomes empty.
+func (t *Tree) update(depth int, keyHash, valueHash []byte) (*node, []byte, error) {
+	n, err := t.getNode(depth, keyHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n == nil {
+		if valueHash == nil {
+			return nil, EmptyHash, nil
+		}
+		n = &node{kind: leafNode, keyHash: keyHash, valueHash: valueHash}
+		return n, n.hash(), t.putNode(depth, keyHash, n)
+	}
+	if n.kind == leafNode {
+		if bytes.Equal(n.keyHash, keyHash) {
+			if valueHash == nil {
+				return nil, EmptyHash, t.deleteNode(depth, keyHash)
+			}
+			n = &node{kind: leafNode, keyHash: keyHash, valueHash: valueHash}
+			return n, n.hash(), t.putNode(depth, keyHash, n)
+		}
+		if valueHash == nil {
+			return n, n.hash(), nil
+		}
+		if depth >= MaxDepth {
+			return nil, nil, errors.New("smt: key hash collision")
+		}
+		// push the existing leaf one level down, and continue as an internal node.
+		if err = t.putNode(depth+1, n.keyHash, n); err != nil {
+			return nil, nil, err
+		}
+		leaf := n
+		n = &node{kind: internalNode, left: EmptyHash, right: EmptyHash}
+		if bit(leaf.keyHash, depth) == 0 {
+			n.left = leaf.hash()
+		} else {
+			n.right = leaf.hash()
+		}
+	}
+
+	child, childHash, err := t.update(depth+1, keyHash, valueHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	side := bit(keyHash, depth)
+	if side == 0 {
+		n.left = childHash
+	} else {
+		n.right = childHash
+	}
+
+	// compact the subtree if it holds only one leaf
+	var single *node
+	var singlePath []byte
+	switch {
+	case bytes.Equal(n.left, EmptyHash) && bytes.Equal(n.right, EmptyHash):
+		return nil, EmptyHash, t.deleteNode(depth, keyHash)
+	case bytes.Equal(n.left, EmptyHash) || bytes.Equal(n.right, EmptyHash):
+		if bytes.Equal(n.left, EmptyHash) == (side == 0) {
+			// the updated child is empty, check the other one
+			otherPath := siblingPath(depth, keyHash)
+			other, err := t.getNode(depth+1, otherPath)
+			if err != nil {
+				return nil, nil, err
+			}
+			single, singlePath = other, otherPath
+		} else {
+			single, singlePath = child, keyHash
+		}
+	}
+	if single != nil && single.kind == leafNode {
+		if err = t.deleteNode(depth+1, singlePath); err != nil {
+			return nil, nil, err
+		}
+		return single, single.hash(), t.putNode(depth, singlePath, single)
+	}
+	return n, n.hash(), t.putNode(depth, keyHash, n)
+}
+
+// siblingPath returns a key hash leading to the sibling of the node at depth+1 on the path of keyHash.
+func siblingPath(depth int, keyHash []byte) []byte {
+	p := append([]byte(nil), keyHash...)
+	p[depth/8] ^= 0x80 >> uint(depth%8)
+	return p
+}
+
+// Prove returns a proof of given key, which proves either the value of key or the absence of key.
+func (t *Tree) Prove(key []byte) (*Proof, error) {
+	keyHash := Hash(key)
+	proof := &Proof{}
+	for depth := 0; depth <= MaxDepth; depth++ {
+		n, err := t.getNode(depth, keyHash)
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			return proof, nil
+		}
+		if n.kind == leafNode {
+			proof.LeafKeyHash, proof.LeafValueHash = n.keyHash, n.valueHash
+			return proof, nil
+		}
+		if bit(keyHash, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, n.right)
+		} else {
+			proof.Siblings = append(proof.Siblings, n.left)
+		}
+	}
+	return nil, ErrCorruptedNode
+}
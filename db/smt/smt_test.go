@@ -0,0 +1,163 @@
+package smt
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type pair struct {
+	keyHash, valueHash []byte
+}
+
+// refRoot computes the root hash and node count of given leaves from scratch.
+func refRoot(depth int, pairs []pair) ([]byte, int) {
+	switch len(pairs) {
+	case 0:
+		return EmptyHash, 0
+	case 1:
+		return LeafHash(pairs[0].keyHash, pairs[0].valueHash), 1
+	}
+	var left, right []pair
+	for _, p := range pairs {
+		if bit(p.keyHash, depth) == 0 {
+			left = append(left, p)
+		} else {
+			right = append(right, p)
+		}
+	}
+	l, ln := refRoot(depth+1, left)
+	r, rn := refRoot(depth+1, right)
+	return InternalHash(l, r), ln + rn + 1
+}
+
+func countNodes(db storage.Database) int {
+	n := 0
+	db.Iterate(nil, nil, false, func(key, value []byte) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+func checkTree(t *testing.T, db storage.Database, tree *Tree, kv map[string]string) {
+	var pairs []pair
+	for k, v := range kv {
+		pairs = append(pairs, pair{Hash([]byte(k)), Hash([]byte(v))})
+	}
+	expected, nodes := refRoot(0, pairs)
+	root, err := tree.Root()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, root)
+	assert.Equal(t, nodes, countNodes(db))
+}
+
+func TestTree(t *testing.T) {
+	a := assert.New(t)
+	db := storage.NewMemoryDatabase()
+	tree := New(db)
+	a.True(tree.Empty())
+	root, _ := tree.Root()
+	a.Equal(EmptyHash, root)
+
+	r := rand.New(rand.NewSource(1))
+	kv := make(map[string]string)
+	for i := 0; i < 2000; i++ {
+		k := fmt.Sprintf("key%d", r.Intn(500))
+		if r.Intn(3) == 0 {
+			a.NoError(tree.Delete([]byte(k)))
+			delete(kv, k)
+		} else {
+			v := fmt.Sprintf("value%d", i)
+			a.NoError(tree.Update([]byte(k), []byte(v)))
+			kv[k] = v
+		}
+		if i%100 == 0 {
+			checkTree(t, db, tree, kv)
+		}
+	}
+	checkTree(t, db, tree, kv)
+
+	// history independence
+	db2 := storage.NewMemoryDatabase()
+	tree2 := New(db2)
+	for k, v := range kv {
+		a.NoError(tree2.Update([]byte(k), []byte(v)))
+	}
+	root, _ = tree.Root()
+	root2, _ := tree2.Root()
+	a.Equal(root, root2)
+
+	// remove everything
+	for k := range kv {
+		a.NoError(tree.Delete([]byte(k)))
+	}
+	a.True(tree.Empty())
+	a.Equal(0, countNodes(db))
+}
+
+func TestProof(t *testing.T) {
+	a := assert.New(t)
+	tree := New(storage.NewMemoryDatabase())
+	for i := 0; i < 300; i++ {
+		a.NoError(tree.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	root, _ := tree.Root()
+
+	for i := 0; i < 400; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+		proof, err := tree.Prove(key)
+		a.NoError(err)
+		if i < 300 {
+			a.NoError(proof.Verify(root, key, value))
+			a.Error(proof.Verify(root, key, []byte("wrong")))
+			a.Error(proof.Verify(root, key, nil))
+		} else {
+			a.NoError(proof.Verify(root, key, nil))
+			a.Error(proof.Verify(root, key, value))
+		}
+		a.Error(proof.Verify(EmptyHash, key, value))
+	}
+
+	// a proof doesn't work for other keys
+	proof, _ := tree.Prove([]byte("key1"))
+	a.Error(proof.Verify(root, []byte("key2"), []byte("value2")))
+	a.Error(proof.Verify(root, []byte("key2"), nil))
+}
+
+// brokenStore fails reads of existing keys once broken.
+type brokenStore struct {
+	storage.Database
+	broken bool
+}
+
+func (s *brokenStore) Get(key []byte) ([]byte, error) {
+	if s.broken {
+		return nil, errors.New("broken store")
+	}
+	return s.Database.Get(key)
+}
+
+func TestStoreErrors(t *testing.T) {
+	a := assert.New(t)
+	store := &brokenStore{Database: storage.NewMemoryDatabase()}
+	tree := New(store)
+	a.NoError(tree.Update([]byte("key"), []byte("value")))
+
+	// read failures aren't taken as missing nodes
+	store.broken = true
+	_, err := tree.Root()
+	a.Error(err)
+	a.Error(tree.Update([]byte("key2"), []byte("value2")))
+	_, err = tree.Prove([]byte("key"))
+	a.Error(err)
+
+	store.broken = false
+	a.NoError(tree.Update([]byte("key2"), []byte("value2")))
+	checkTree(t, store.Database, tree, map[string]string{"key": "value", "key2": "value2"})
+}
@@ -0,0 +1,301 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+/*A snapshot is a deterministic dump of the state database at an irreversible block.
+ *
+ * +-------+---------+-----------+------------+-------+--------+------+----------+-----+----------+-----+-------+--------------+
+ * | Magic | Version | Block Num | State Root | Block | Commit | Next | Record 1 | ... | Record N | End | Count | Content Hash |
+ * +-------+---------+-----------+------------+-------+--------+------+----------+-----+----------+-----+-------+--------------+
+ *
+ * All integers are little-endian. State Root, Block, Commit and Next are uint32 length prefixed byte strings,
+ * holding the state root after applying the block, the serialized signed block, its BFT commit and the
+ * serialized signed header of the next block, which commits to the state root. A record is a 1 byte flag (1)
+ * followed by the uvarint length prefixed key and value, records are sorted by key in ascending order.
+ * End is a 0 byte.
+ *
+ * The content hash is the sha256 of block number, state root, block and all records. It binds the state to the
+ * block, but not to the BFT commit, whose precommits vary between nodes, so every node exports the same content hash
+ * at the same block. A commit is verified by checking that it commits to the bound block.
+ */
+
+const (
+	magic   = "COSSNAP\x00"
+	Version = uint32(3)
+
+	recordFlag = byte(1)
+	endFlag    = byte(0)
+
+	maxChunkLen = 1024 * 1024 * 256
+)
+
+var (
+	ErrBadMagic        = errors.New("snapshot: not a snapshot file")
+	ErrBadVersion      = errors.New("snapshot: unsupported version")
+	ErrUnsortedKeys    = errors.New("snapshot: keys must be written in ascending order")
+	ErrFinished        = errors.New("snapshot: writer already finished")
+	ErrCountMismatch   = errors.New("snapshot: record count mismatch")
+	ErrContentMismatch = errors.New("snapshot: content hash mismatch")
+)
+
+// Header holds the block a snapshot was taken at.
+type Header struct {
+	BlockNum  uint64
+	StateRoot []byte // state root after applying the block
+	Block     []byte // serialized signed block
+	Commit    []byte // serialized BFT commit of the block
+	Next      []byte // serialized signed header of the next block
+}
+
+func newContentHash(header *Header) hash.Hash {
+	h := sha256.New()
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, header.BlockNum)
+	h.Write(buf)
+	for _, chunk := range [][]byte{header.StateRoot, header.Block} {
+		binary.LittleEndian.PutUint32(buf[:4], uint32(len(chunk)))
+		h.Write(buf[:4])
+		h.Write(chunk)
+	}
+	return h
+}
+
+// Writer writes a snapshot.
+type Writer struct {
+	w        *bufio.Writer
+	hash     hash.Hash
+	count    uint64
+	lastKey  []byte
+	finished bool
+}
+
+// NewWriter writes the header to w and returns a Writer for records.
+func NewWriter(w io.Writer, header *Header) (*Writer, error) {
+	sw := &Writer{
+		w:    bufio.NewWriter(w),
+		hash: newContentHash(header),
+	}
+	buf := make([]byte, 8)
+	if _, err := sw.w.WriteString(magic); err != nil {
+		return nil, err
+	}
+	binary.LittleEndian.PutUint32(buf, Version)
+	if _, err := sw.w.Write(buf[:4]); err != nil {
+		return nil, err
+	}
+	binary.LittleEndian.PutUint64(buf, header.BlockNum)
+	if _, err := sw.w.Write(buf); err != nil {
+		return nil, err
+	}
+	for _, chunk := range [][]byte{header.StateRoot, header.Block, header.Commit, header.Next} {
+		if err := sw.writeChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	return sw, nil
+}
+
+func (sw *Writer) writeChunk(data []byte) error {
+	if len(data) > maxChunkLen {
+		return fmt.Errorf("snapshot: header chunk too big, %d bytes", len(data))
+	}
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(len(data)))
+	if _, err := sw.w.Write(buf); err != nil {
+		return err
+	}
+	_, err := sw.w.Write(data)
+	return err
+}
+
+// Write appends a record. Keys must be strictly ascending.
+func (sw *Writer) Write(key, value []byte) error {
+	if sw.finished {
+		return ErrFinished
+	}
+	if sw.count > 0 && bytes.Compare(sw.lastKey, key) >= 0 {
+		return ErrUnsortedKeys
+	}
+	record := encodeRecord(key, value)
+	if err := sw.w.WriteByte(recordFlag); err != nil {
+		return err
+	}
+	if _, err := sw.w.Write(record); err != nil {
+		return err
+	}
+	sw.hash.Write(record)
+	sw.lastKey = append(sw.lastKey[:0], key...)
+	sw.count++
+	return nil
+}
+
+// Finish writes the trailer and returns the content hash.
+func (sw *Writer) Finish() ([]byte, error) {
+	if sw.finished {
+		return nil, ErrFinished
+	}
+	sw.finished = true
+	contentHash := sw.hash.Sum(nil)
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, sw.count)
+	if err := sw.w.WriteByte(endFlag); err != nil {
+		return nil, err
+	}
+	if _, err := sw.w.Write(buf); err != nil {
+		return nil, err
+	}
+	if _, err := sw.w.Write(contentHash); err != nil {
+		return nil, err
+	}
+	if err := sw.w.Flush(); err != nil {
+		return nil, err
+	}
+	return contentHash, nil
+}
+
+// Count returns the number of records written so far.
+func (sw *Writer) Count() uint64 {
+	return sw.count
+}
+
+func encodeRecord(key, value []byte) []byte {
+	buf := make([]byte, 0, 2*binary.MaxVarintLen64+len(key)+len(value))
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	buf = append(buf, lenBuf[:binary.PutUvarint(lenBuf, uint64(len(key)))]...)
+	buf = append(buf, key...)
+	buf = append(buf, lenBuf[:binary.PutUvarint(lenBuf, uint64(len(value)))]...)
+	buf = append(buf, value...)
+	return buf
+}
+
+// Reader reads a snapshot.
+type Reader struct {
+	r           *bufio.Reader
+	hash        hash.Hash
+	count       uint64
+	lastKey     []byte
+	contentHash []byte
+}
+
+// NewReader reads the header from r and returns a Reader for records.
+func NewReader(r io.Reader) (*Reader, *Header, error) {
+	sr := &Reader{r: bufio.NewReader(r)}
+	buf := make([]byte, len(magic))
+	if _, err := io.ReadFull(sr.r, buf); err != nil {
+		return nil, nil, err
+	}
+	if string(buf) != magic {
+		return nil, nil, ErrBadMagic
+	}
+	if _, err := io.ReadFull(sr.r, buf[:4]); err != nil {
+		return nil, nil, err
+	}
+	if binary.LittleEndian.Uint32(buf[:4]) != Version {
+		return nil, nil, ErrBadVersion
+	}
+	if _, err := io.ReadFull(sr.r, buf); err != nil {
+		return nil, nil, err
+	}
+	header := &Header{BlockNum: binary.LittleEndian.Uint64(buf)}
+	var err error
+	for _, chunk := range []*[]byte{&header.StateRoot, &header.Block, &header.Commit, &header.Next} {
+		if *chunk, err = sr.readChunk(); err != nil {
+			return nil, nil, err
+		}
+	}
+	sr.hash = newContentHash(header)
+	return sr, header, nil
+}
+
+func (sr *Reader) readChunk() ([]byte, error) {
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(sr.r, buf); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(buf)
+	if size > maxChunkLen {
+		return nil, fmt.Errorf("snapshot: header chunk too big, %d bytes", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(sr.r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (sr *Reader) readBytes() ([]byte, error) {
+	size, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxChunkLen {
+		return nil, fmt.Errorf("snapshot: record too big, %d bytes", size)
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(sr.r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Next returns the next record. It returns io.EOF after the last record, when the record count and
+// the content hash have been verified against the trailer.
+func (sr *Reader) Next() (key, value []byte, err error) {
+	if sr.contentHash != nil {
+		return nil, nil, io.EOF
+	}
+	flag, err := sr.r.ReadByte()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch flag {
+	case recordFlag:
+		if key, err = sr.readBytes(); err != nil {
+			return nil, nil, err
+		}
+		if value, err = sr.readBytes(); err != nil {
+			return nil, nil, err
+		}
+		if sr.count > 0 && bytes.Compare(sr.lastKey, key) >= 0 {
+			return nil, nil, ErrUnsortedKeys
+		}
+		sr.hash.Write(encodeRecord(key, value))
+		sr.lastKey = key
+		sr.count++
+		return key, value, nil
+	case endFlag:
+		buf := make([]byte, 8+sha256.Size)
+		if _, err = io.ReadFull(sr.r, buf); err != nil {
+			return nil, nil, err
+		}
+		if binary.LittleEndian.Uint64(buf) != sr.count {
+			return nil, nil, ErrCountMismatch
+		}
+		contentHash := sr.hash.Sum(nil)
+		if !bytes.Equal(contentHash, buf[8:]) {
+			return nil, nil, ErrContentMismatch
+		}
+		sr.contentHash = contentHash
+		return nil, nil, io.EOF
+	}
+	return nil, nil, fmt.Errorf("snapshot: unknown record flag %d", flag)
+}
+
+// ContentHash returns the verified content hash, it's only available after Next returned io.EOF.
+func (sr *Reader) ContentHash() []byte {
+	return sr.contentHash
+}
+
+// Count returns the number of records read so far.
+func (sr *Reader) Count() uint64 {
+	return sr.count
+}
@@ -0,0 +1,112 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSnapshot(t *testing.T, blockNum uint64, stateRoot string, count int) ([]byte, []byte) {
+	return writeSnapshotOf(t, blockNum, stateRoot, "block", "commit", count)
+}
+
+func writeSnapshotOf(t *testing.T, blockNum uint64, stateRoot, block, commit string, count int) ([]byte, []byte) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, &Header{
+		BlockNum:  blockNum,
+		StateRoot: []byte(stateRoot),
+		Block:     []byte(block),
+		Commit:    []byte(commit),
+		Next:      []byte("next"),
+	})
+	assert.NoError(t, err)
+	for i := 0; i < count; i++ {
+		assert.NoError(t, w.Write([]byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	h, err := w.Finish()
+	assert.NoError(t, err)
+	return buf.Bytes(), h
+}
+
+func readSnapshot(data []byte) (*Header, int, []byte, error) {
+	r, header, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	n := 0
+	for {
+		if _, _, err = r.Next(); err == io.EOF {
+			return header, n, r.ContentHash(), nil
+		} else if err != nil {
+			return header, n, nil, err
+		}
+		n++
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	data, h := writeSnapshot(t, 100, "root", 1000)
+
+	header, n, h2, err := readSnapshot(data)
+	a.NoError(err)
+	a.Equal(uint64(100), header.BlockNum)
+	a.Equal([]byte("block"), header.Block)
+	a.Equal([]byte("commit"), header.Commit)
+	a.Equal([]byte("root"), header.StateRoot)
+	a.Equal([]byte("next"), header.Next)
+	a.Equal(1000, n)
+	a.Equal(h, h2)
+
+	// same state, same content hash
+	_, h3 := writeSnapshot(t, 100, "root", 1000)
+	a.Equal(h, h3)
+	// different block number, different content hash
+	_, h4 := writeSnapshot(t, 101, "root", 1000)
+	a.NotEqual(h, h4)
+	// different state root, different content hash
+	_, h5 := writeSnapshot(t, 100, "another root", 1000)
+	a.NotEqual(h, h5)
+	// different block, different content hash
+	_, h6 := writeSnapshotOf(t, 100, "root", "another block", "commit", 1000)
+	a.NotEqual(h, h6)
+	// commits of the same block vary between nodes, and don't affect the content hash
+	_, h7 := writeSnapshotOf(t, 100, "root", "block", "another commit", 1000)
+	a.Equal(h, h7)
+}
+
+func TestTampered(t *testing.T) {
+	a := assert.New(t)
+	data, _ := writeSnapshot(t, 100, "root", 10)
+
+	tampered := bytes.Replace(data, []byte("value5"), []byte("value6"), 1)
+	_, _, _, err := readSnapshot(tampered)
+	a.Equal(ErrContentMismatch, err)
+
+	tampered = bytes.Replace(data, []byte("root"), []byte("fake"), 1)
+	_, _, _, err = readSnapshot(tampered)
+	a.Equal(ErrContentMismatch, err)
+
+	tampered = bytes.Replace(data, []byte("block"), []byte("forge"), 1)
+	_, _, _, err = readSnapshot(tampered)
+	a.Equal(ErrContentMismatch, err)
+
+	_, _, _, err = readSnapshot(data[:len(data)-1])
+	a.Error(err)
+
+	_, _, _, err = readSnapshot(append([]byte("X"), data[1:]...))
+	a.Equal(ErrBadMagic, err)
+}
+
+func TestUnsortedKeys(t *testing.T) {
+	a := assert.New(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, &Header{BlockNum: 1})
+	a.NoError(err)
+	a.NoError(w.Write([]byte("b"), nil))
+	a.Equal(ErrUnsortedKeys, w.Write([]byte("a"), nil))
+	a.Equal(ErrUnsortedKeys, w.Write([]byte("b"), nil))
+}
@@ -433,6 +433,56 @@ func TestRevertibleDatabaseFeature(t *testing.T) {
 	doTestRevertFeature(t, rdb)
 }
 
+func TestRevisionPatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lvldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fn := filepath.Join(dir, randomString(8))
+	db, err := NewLevelDatabase(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rdb := NewRevertibleDatabase(db)
+	defer rdb.Close()
+
+	requireSuccessPut(t, rdb, []byte("key_one"), []byte("value_one"))
+	requireSuccessPut(t, rdb, []byte("key_two"), []byte("value_two"))
+	r1 := rdb.GetRevision()
+	requireSuccessPut(t, rdb, []byte("key_one"), []byte("value_one_changed"))
+	requireSuccessPut(t, rdb, []byte("key_one"), []byte("value_one_changed_again"))
+	requireSuccessPut(t, rdb, []byte("key_three"), []byte("value_three"))
+	if err = rdb.Delete([]byte("key_two")); err != nil {
+		t.Fatal(err)
+	}
+	r2 := rdb.GetRevision()
+
+	patch := NewDatabasePatch(0, "", rdb)
+	if err = rdb.RevisionPatch(r1, patch); err != nil {
+		t.Fatal(err)
+	}
+	if err = rdb.RevisionPatch(r2+1, patch); err == nil {
+		t.Fatalf("patched to a future revision")
+	}
+
+	// the patch holds the old revision
+	requireSuccessGet(t, patch.s, []byte("key_one"), []byte("value_one"))
+	requireSuccessGet(t, patch.s, []byte("key_two"), []byte("value_two"))
+	requireErrorGet(t, patch.s, []byte("key_three"))
+
+	// while the database stays at the current one
+	if rdb.GetRevision() != r2 {
+		t.Fatalf("database revision changed")
+	}
+	requireSuccessGet(t, rdb, []byte("key_one"), []byte("value_one_changed_again"))
+	requireErrorGet(t, rdb, []byte("key_two"))
+	requireSuccessGet(t, rdb, []byte("key_three"), []byte("value_three"))
+}
+
 func dbTestSquashFeature(t *testing.T, db SquashDatabase, dirtyRead bool) {
 	db.BeginTransactionWithTag("block1")
 	requireSuccessPut(t, db, []byte("key_one"), []byte("value_one"))
@@ -198,6 +198,16 @@ func (dq *dbDeque) hashOfSession(idx int) (hash uint32) {
 	return
 }
 
+func (dq *dbDeque) ChangedKeysOfTopSession() (keys [][]byte) {
+	dq.lock.RLock()
+	defer dq.lock.RUnlock()
+
+	if count := len(dq.sessions); count > 0 {
+		keys = dq.sessions[count-1].ChangedKeys()
+	}
+	return
+}
+
 func (dq *dbDeque) HashOfTopSession() (hash uint32) {
 	dq.lock.RLock()
 	defer dq.lock.RUnlock()
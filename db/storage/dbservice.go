@@ -151,6 +151,10 @@ func (s *DatabaseService) HashOfTopTransaction() uint32 {
 	return s.tdb.HashOfTopTransaction()
 }
 
+func (s *DatabaseService) ChangedKeysOfTopTransaction() [][]byte {
+	return s.tdb.ChangedKeysOfTopTransaction()
+}
+
 func (s *DatabaseService) TransactionHeight() uint {
 	return s.tdb.TransactionHeight()
 }
@@ -245,3 +249,20 @@ func (s *DatabaseService) NewPatch(branchId...string) iservices.IDatabasePatch {
 	}
 	return NewDatabasePatch(s.sid, branchId[0], s.tdb)
 }
+
+func (s *DatabaseService) NewTrackedPatch(tracker iservices.IDatabaseAccessTracker, branchId...string) iservices.IDatabasePatch {
+	db := NewTrackedDatabase(s.tdb, tracker)
+	if len(branchId) == 0 {
+		return NewDatabasePatch(s.sid, s.BranchId(), db)
+	}
+	return NewDatabasePatch(s.sid, branchId[0], db)
+}
+
+// NewRevisionPatch returns a patch holding the database at revision r, the database itself is not reverted.
+func (s *DatabaseService) NewRevisionPatch(r uint64) (iservices.IDatabasePatch, error) {
+	patch := s.NewPatch()
+	if err := s.rdb.RevisionPatch(r, patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
@@ -176,6 +176,21 @@ func (db *dbSession) Hash() (hash uint32) {
 	return
 }
 
+// ChangedKeys returns keys written or deleted in the session, without duplicates.
+func (db *dbSession) ChangedKeys() (keys [][]byte) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	seen := make(map[string]bool)
+	for _, op := range db.changes {
+		if k := string(op.Key); !seen[k] {
+			seen[k] = true
+			keys = append(keys, op.Key)
+		}
+	}
+	return
+}
+
 func (db *dbSession) NewBatch() Batch {
 	return &dbSessionBatch{ db: db }
 }
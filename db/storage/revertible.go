@@ -53,6 +53,11 @@ func decodeRevNumber(data []byte) *revNumber {
 	return nil
 }
 
+// IsReversionInfoKey returns true if key is used by RevertibleDatabase to keep its own reversion data.
+func IsReversionInfoKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(info_prefix))
+}
+
 func (db *RevertibleDatabase) loadRevNum() {
 	data, err := db.db.Get([]byte(key_rev_num))
 	if err == nil {
@@ -177,6 +182,44 @@ func (db *RevertibleDatabase) RevertToRevision(r uint64) error {
 	return db.revertToRevision(r)
 }
 
+// RevisionPatch writes to w the changes that bring the database back to revision r.
+// Unlike RevertToRevision, the database itself is left untouched.
+func (db *RevertibleDatabase) RevisionPatch(r uint64, w DatabaseWriter) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if r > db.rev.Current || r < db.rev.Base {
+		return errors.New(fmt.Sprintf("revision %d is out of range [%d, %d]", r, db.rev.Base, db.rev.Current))
+	}
+	if r == db.rev.Current {
+		return nil
+	}
+	limit := []byte(max_op_key)
+	if r > 0 {
+		limit = keyOfReversionOp(r - 1)
+	}
+	var err error
+	db.db.Iterate([]byte(min_op_key), limit, false, func(key, value []byte) bool {
+		opSlice := decodeWriteOpSlice(value)
+		if opSlice == nil {
+			err = errors.New("invalid revision log")
+			return false
+		}
+		for _, op := range opSlice {
+			if op.Del {
+				err = w.Delete(op.Key)
+			} else {
+				err = w.Put(op.Key, op.Value)
+			}
+			if err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
 func (db *RevertibleDatabase) rebaseToRevision(r uint64) error {
 	if r > db.rev.Current {
 		return errors.New(fmt.Sprintf("cannot rebase to a future revision %d. current revision is %d",
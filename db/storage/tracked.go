@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/iservices"
+)
+
+// TrackedDatabase is a database reporting accessed keys to a tracker.
+type TrackedDatabase struct {
+	db      Database
+	tracker iservices.IDatabaseAccessTracker
+}
+
+func NewTrackedDatabase(db Database, tracker iservices.IDatabaseAccessTracker) *TrackedDatabase {
+	return &TrackedDatabase{db: db, tracker: tracker}
+}
+
+func (db *TrackedDatabase) Close() {
+
+}
+
+func (db *TrackedDatabase) Has(key []byte) (bool, error) {
+	db.tracker.AddRead(key)
+	return db.db.Has(key)
+}
+
+func (db *TrackedDatabase) Get(key []byte) ([]byte, error) {
+	db.tracker.AddRead(key)
+	return db.db.Get(key)
+}
+
+func (db *TrackedDatabase) Put(key []byte, value []byte) error {
+	db.tracker.AddWrite(key)
+	return db.db.Put(key, value)
+}
+
+func (db *TrackedDatabase) Delete(key []byte) error {
+	db.tracker.AddWrite(key)
+	return db.db.Delete(key)
+}
+
+func (db *TrackedDatabase) Iterate(start, limit []byte, reverse bool, callback func(key, value []byte) bool) {
+	// the whole range is reported even if callback stops the iteration early.
+	db.tracker.AddRange(start, limit)
+	db.db.Iterate(start, limit, reverse, callback)
+}
+
+func (db *TrackedDatabase) NewBatch() Batch {
+	return &trackedBatch{Batch: db.db.NewBatch(), tracker: db.tracker}
+}
+
+func (db *TrackedDatabase) DeleteBatch(b Batch) {
+	if tb, ok := b.(*trackedBatch); ok {
+		db.db.DeleteBatch(tb.Batch)
+	}
+}
+
+type trackedBatch struct {
+	Batch
+	tracker iservices.IDatabaseAccessTracker
+	keys    [][]byte
+}
+
+func (b *trackedBatch) Put(key []byte, value []byte) error {
+	b.keys = append(b.keys, common.CopyBytes(key))
+	return b.Batch.Put(key, value)
+}
+
+func (b *trackedBatch) Delete(key []byte) error {
+	b.keys = append(b.keys, common.CopyBytes(key))
+	return b.Batch.Delete(key)
+}
+
+func (b *trackedBatch) Write() error {
+	// keys are reported when they are really written.
+	for _, k := range b.keys {
+		b.tracker.AddWrite(k)
+	}
+	return b.Batch.Write()
+}
+
+func (b *trackedBatch) Reset() {
+	b.keys = b.keys[:0]
+	b.Batch.Reset()
+}
+
+type keyRange struct {
+	start, limit []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	// an empty limit is taken as unlimited, to be conservative.
+	return bytes.Compare(key, r.start) >= 0 && (len(r.limit) == 0 || bytes.Compare(key, r.limit) < 0)
+}
+
+// AccessSet is a set of keys read and written.
+// It implements iservices.IDatabaseAccessTracker.
+type AccessSet struct {
+	reads  map[string]bool
+	ranges []keyRange
+	writes map[string]bool
+	lock   sync.RWMutex
+}
+
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		reads:  make(map[string]bool),
+		writes: make(map[string]bool),
+	}
+}
+
+func (s *AccessSet) AddRead(key []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reads[string(key)] = true
+}
+
+func (s *AccessSet) AddRange(start, limit []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ranges = append(s.ranges, keyRange{start: common.CopyBytes(start), limit: common.CopyBytes(limit)})
+}
+
+func (s *AccessSet) AddWrite(key []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.writes[string(key)] = true
+}
+
+// MergeWrites adds keys written in other set.
+func (s *AccessSet) MergeWrites(other *AccessSet) {
+	other.lock.RLock()
+	defer other.lock.RUnlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k := range other.writes {
+		s.writes[k] = true
+	}
+}
+
+// DependsOn checks if anything read in s was written in other set.
+func (s *AccessSet) DependsOn(other *AccessSet) bool {
+	other.lock.RLock()
+	defer other.lock.RUnlock()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for k := range other.writes {
+		if s.reads[k] {
+			return true
+		}
+		for _, r := range s.ranges {
+			if r.contains([]byte(k)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WriteCount returns the number of keys written.
+func (s *AccessSet) WriteCount() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.writes)
+}
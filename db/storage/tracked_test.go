@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackedPatch(t *testing.T) {
+	a := assert.New(t)
+	db := NewMemoryDatabase()
+	_ = db.Put([]byte("a"), []byte("1"))
+	_ = db.Put([]byte("b"), []byte("2"))
+	_ = db.Put([]byte("m1"), []byte("3"))
+
+	rw := NewAccessSet()
+	patch := NewDatabasePatch(0, "", NewTrackedDatabase(db, rw))
+	_, _ = patch.Get([]byte("a"))
+	_, _ = patch.Has([]byte("x"))
+	_ = patch.Put([]byte("c"), []byte("4"))
+	// reading own writes doesn't touch the database
+	_, _ = patch.Get([]byte("c"))
+	patch.Iterate([]byte("m"), []byte("n"), false, func(key, value []byte) bool { return false })
+
+	// nested patches read through
+	inner := patch.NewPatch()
+	_, _ = inner.Get([]byte("b"))
+	_ = inner.Delete([]byte("a"))
+	a.NoError(inner.Apply())
+
+	writes := NewAccessSet()
+	for _, k := range []string{"a", "b", "x", "m2"} {
+		writes.AddWrite([]byte(k))
+		a.True(rw.DependsOn(writes), k)
+		writes = NewAccessSet()
+	}
+	for _, k := range []string{"c", "n", "l"} {
+		writes.AddWrite([]byte(k))
+		a.False(rw.DependsOn(writes), k)
+		writes = NewAccessSet()
+	}
+
+	// writes are reported once applied
+	a.Equal(0, rw.WriteCount())
+	a.NoError(patch.Apply())
+	a.Equal(2, rw.WriteCount())
+	merged := NewAccessSet()
+	merged.MergeWrites(rw)
+	merged.AddRead([]byte("c"))
+	a.True(merged.DependsOn(rw))
+
+	v, _ := db.Get([]byte("c"))
+	a.Equal([]byte("4"), v)
+	ok, _ := db.Has([]byte("a"))
+	a.False(ok)
+}
+
+func TestAccessSetUnlimitedRange(t *testing.T) {
+	a := assert.New(t)
+	rw, writes := NewAccessSet(), NewAccessSet()
+	rw.AddRange([]byte("k"), nil)
+	writes.AddWrite([]byte("a"))
+	a.False(rw.DependsOn(writes))
+	writes.AddWrite([]byte("z"))
+	a.True(rw.DependsOn(writes))
+
+	rw = NewAccessSet()
+	rw.AddRange(nil, []byte("b"))
+	a.True(rw.DependsOn(writes))
+}
@@ -35,3 +35,7 @@ func (db *TransactionalDatabase) TransactionHeight() uint {
 func (db *TransactionalDatabase) HashOfTopTransaction() uint32 {
 	return db.HashOfTopSession()
 }
+
+func (db *TransactionalDatabase) ChangedKeysOfTopTransaction() [][]byte {
+	return db.ChangedKeysOfTopSession()
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/prototype"
 )
 
 var ConsensusServerName = "consensus"
@@ -17,6 +18,9 @@ type IConsensus interface {
 
 	ActiveValidators() []string
 
+	// ActiveValidatorByKey returns the active validator whose signing key is the given public key
+	ActiveValidatorByKey(key *prototype.PublicKeyType) (string, bool)
+
 	GetName() string
 
 	// SetBootstrap determines if the current node starts a new block chain
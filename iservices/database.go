@@ -27,6 +27,19 @@ type IDatabaseBatch interface {
 	Reset()
 }
 
+// IDatabaseAccessTracker collects keys accessed through a tracked database patch.
+// methods must be thread safe
+type IDatabaseAccessTracker interface {
+	// a key was read, no matter it exists or not
+	AddRead(key []byte)
+
+	// keys in range [start, limit) were iterated
+	AddRange(start, limit []byte)
+
+	// a key was written or deleted
+	AddWrite(key []byte)
+}
+
 type IDatabaseServiceId interface {
 	ServiceId() uint32
 	BranchId() string
@@ -92,6 +105,9 @@ type IDatabaseService interface {
 
 	HashOfTopTransaction() uint32
 
+	// keys written or deleted in current transaction session
+	ChangedKeysOfTopTransaction() [][]byte
+
 	// current transaction height
 	TransactionHeight() uint
 
@@ -161,4 +177,8 @@ type IDatabaseService interface {
 	RUnlock()
 
 	NewPatch(branchId...string) IDatabasePatch
+
+	// NewTrackedPatch creates a patch reporting keys it reads from and writes to the database to tracker.
+	// keys accessed inside the patch only, e.g. keys read after written in the patch, are not reported.
+	NewTrackedPatch(tracker IDatabaseAccessTracker, branchId...string) IDatabasePatch
 }
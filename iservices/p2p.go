@@ -1,12 +1,21 @@
 package iservices
 
 import (
+	"time"
+
 	comn "github.com/coschain/contentos-go/common"
 	"github.com/coschain/contentos-go/p2p/peer"
 )
 
 var P2PServerName = "p2p"
 
+// PeerReputation is the misbehavior record of a remote IP.
+type PeerReputation struct {
+	Ip          string
+	Score       float64			// penalty score, the higher the worse
+	BannedUntil time.Time		// expiration of the ban, zero if not banned
+}
+
 //IP2P represent the net interface of p2p package which can be called by other service
 type IP2P interface {
 	// Broadcast sigTrx or sigBlk msg
@@ -32,6 +41,15 @@ type IP2P interface {
 
 	GetNodeNeighbours() string
 
+	// List reputations of remote IPs having penalty scores or banned
+	GetPeerReputations() []PeerReputation
+
+	// Ban a remote IP for given duration, and disconnect from it
+	BanPeer(ip string, duration time.Duration) error
+
+	// Lift the ban of a remote IP
+	UnbanPeer(ip string) error
+
 	// for test only
 	SetMockLatency(t int)
 	GetMockLatency() int
@@ -8,4 +8,7 @@ type ConsensusConfig struct {
 	//LocalBpPrivateKey string `toml:",omitempty"`
 	LocalBpName       string
 	LocalBpPrivateKey string
+
+	// OptimisticTrxApply applies transactions of a block speculatively and concurrently, instead of in scheduled groups.
+	OptimisticTrxApply bool
 }
@@ -6,4 +6,9 @@ type GRPCConfig struct {
 	HTTPListen string
 	HTTPCors   []string
 	HTTPLimit  int
+	// WebSocketLimit is the max number of websocket connections, which don't count in HTTPLimit
+	WebSocketLimit int
+
+	// EnableAdminAPI enables rpcs managing the node, e.g. banning peers
+	EnableAdminAPI bool
 }
@@ -16,10 +16,29 @@ type P2PConfig struct {
 	MaxConnInBound            uint
 	MaxConnOutBound           uint
 	MaxConnInBoundForSingleIP uint
+	EnableDiscovery           bool
+	TargetPeerCount           uint
+	// AllowLegacyPeers accepts sync links of peers not upgraded to the secure handshake, which are neither
+	// encrypted nor authenticated. Consensus links of them are never accepted.
+	AllowLegacyPeers          bool
 }
 
 type GenesisConfig struct {
 	SeedList      []string
+	// Features overrides activation heights of builtin features. It's not allowed on main net.
+	Features      map[string]uint64 `toml:",omitempty"`
+	// RewardPolicy sets the initial reward policy. It's not allowed on main net.
+	RewardPolicy  *RewardPolicyConfig `toml:",omitempty"`
+}
+
+// RewardPolicyConfig selects the weighting curve and rates of rewarding pools, in 1/10000
+type RewardPolicyConfig struct {
+	Weighting   string
+	CreatorRate uint32
+	DappRate    uint32
+	AuthorRate  uint32
+	ReplyRate   uint32
+	VoterRate   uint32
 }
 
 type P2PRsvConfig struct {
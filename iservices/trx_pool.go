@@ -30,6 +30,26 @@ type IGlobalPropRW interface {
 	IGlobalPropWriter
 }
 
+// PendingSignerStats is the pending transaction statistics of a signer.
+type PendingSignerStats struct {
+	Signer string
+	Count  int
+	Size   int
+}
+
+// PendingTrxStats is the statistics of transactions waiting to be packed to blocks.
+type PendingTrxStats struct {
+	WaitingCount        int
+	WaitingSize         int
+	FetchedCount        int
+	MaxWaitingCount     int
+	MaxWaitingSize      int
+	MaxWaitingPerSigner int
+	Evicted             uint64
+	Rejected            uint64
+	TopSigners          []PendingSignerStats
+}
+
 type ITrxPool interface {
 	IGlobalPropRW
 
@@ -57,10 +77,20 @@ type ITrxPool interface {
 	//Fetch the latest pushed block number
 	GetHeadBlockNum() (uint64, error)
 	GetFinalizedNum() (uint64, error)
+	// Fetch the state root after applying the latest pushed block
+	GetStateRoot() ([]byte, error)
 
 	CalculateUserMaxStamina(db IDatabaseRW, name string) uint64
 	CheckNetForRPC(name string, db IDatabaseRW, sizeInBytes uint64) (bool, uint64, uint64)
 	EstimateStamina(trx *prototype.SignedTransaction) *prototype.TransactionReceiptWithInfo
+	// GetPendingTrxStats() returns waiting pool statistics including at most @topSigners busiest signers.
+	GetPendingTrxStats(topSigners int) *PendingTrxStats
+	// LookupTrxs() returns pending transactions of given short ids computed by key, nil for the ones not found.
+	LookupTrxs(key prototype.ShortTrxIdKey, shortIds []uint64) []*prototype.SignedTransaction
 
 	HardFork() uint64
+	// FeatureActive() checks if the named feature is active on head block.
+	FeatureActive(id string) bool
+	// Features() returns all builtin features with their effective activation heights.
+	Features() []common.Feature
 }
@@ -14,6 +14,11 @@ const (
 	SERVICE_NODE = 2 //peer only sync with consensus peer
 )
 
+//protocol capability bits announced in version message
+const (
+	CAP_COMPACT_BLOCK = 0 //node serves and handles compact blocks
+)
+
 //link and concurrent const
 const (
 	PER_SEND_LEN        = 1024 * 256 //byte len per conn write
@@ -83,6 +88,16 @@ const (
 	REQUEST_BLOCK_BATCH_TYPE = "sigblk_batch"
 	DETECT_FORMER_IDS_TYPE = "former_ids"
 	CLEAR_OUT_OF_RABGE_STATE = "clear_state"
+
+	COMPACT_BLOCK_TYPE = "cmpct_block"  //block with short trx ids
+	REQ_BLOCK_TRXS_TYPE = "get_blk_trx" //req missing trxs of a compact block
+	BLOCK_TRXS_TYPE = "blk_trx"         //trxs of a compact block
+)
+
+//compact block const
+const (
+	MAX_PENDING_COMPACT_BLOCKS = 64 //the maximum compact blocks waiting for missing trxs
+	PENDING_COMPACT_BLOCK_TIMEOUT = 10 //timeout of compact blocks waiting for missing trxs in sec
 )
 
 const (
@@ -95,6 +110,44 @@ const (
 	MAX_BLOCK_COUNT = 50           // max block count
 )
 
+//peer reputation const
+const (
+	PENALTY_MALFORMED_MSG  = 50                 //penalty of sending a malformed message
+	PENALTY_INVALID_BLOCK  = 50                 //penalty of sending an invalid block
+	PENALTY_INVALID_TRX    = 10                 //penalty of sending an invalid transaction
+	PENALTY_TRX_FLOOD      = 1                  //penalty of sending a transaction beyond the rate limit
+	PENALTY_BAD_HANDSHAKE  = 50                 //penalty of failing the authentication in handshake
+	BAN_SCORE_THRESHOLD    = 100                //peers get banned when their penalty scores reach the threshold
+	SCORE_DECAY_PER_MINUTE = 10                 //penalty score forgiven per minute
+	BAN_DURATION           = 24 * 60 * 60       //default ban duration in sec
+	MAX_TRX_MSG_PER_SECOND = 2000               //the maximum transaction messages per second from a single peer
+	BANNED_PEERS_FILE      = "banned_peers.json" //file storing banned peers in the instance directory
+)
+
+//secure handshake const
+const (
+	HANDSHAKE_TIMEOUT      = 10        //timeout of the handshake in sec
+	MAX_SECURE_FRAME_LEN   = 64 * 1024 //the maximum plaintext length of an encrypted frame
+	NODE_KEY_FILE          = "nodekey" //file storing the node key in the instance directory
+	LEGACY_ADDR_EXPIRATION = 10 * 60   //how long a peer is known not upgraded to the secure handshake in sec
+)
+
+//dht discovery const
+const (
+	DISCOVERY_BUCKET_SIZE         = 16           //the maximum nodes in a bucket of the node table
+	DISCOVERY_MAX_REPLACEMENTS    = 10           //the maximum replacement nodes kept for a full bucket
+	DISCOVERY_ALPHA               = 3            //concurrent queries of a node lookup
+	DISCOVERY_REFRESH_INTERVAL    = 5 * 60       //interval of bucket refresh in sec
+	DISCOVERY_REVALIDATE_INTERVAL = 10           //interval of checking liveness of the table nodes in sec
+	DISCOVERY_REPLY_TIMEOUT       = 1            //timeout of waiting discovery replies in sec
+	DISCOVERY_PACKET_EXPIRATION   = 20           //expiration of discovery packets in sec
+	DISCOVERY_BOND_EXPIRATION     = 24 * 60 * 60 //validity of a node endpoint proved by ping-pong in sec
+	DISCOVERY_MAX_PENDING_BONDS   = 64           //the maximum concurrent pings proving endpoints of nodes pinged us
+	MAX_DISCOVERY_PACKET_LEN      = 1280         //the maximum discovery packet length in byte
+	DEFAULT_TARGET_PEER_COUNT     = 16           //default count of sync peers the node tries to keep
+	DISCOVERY_NODES_FILE          = "nodes.json" //file storing the node table in the instance directory
+)
+
 //ParseIPAddr return ip address
 func ParseIPAddr(s string) (string, error) {
 	i := strings.Index(s, ":")
@@ -0,0 +1,309 @@
+package link
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	handshakeProtocol = "contentos-p2p-noise-v1"
+	ephemeralKeyLen   = 32
+	nodeKeyLen        = 33
+	helloLen          = 4 + ephemeralKeyLen + nodeKeyLen
+	signatureLen      = 65
+	legacyPeekLen     = 4 + common.MSG_CMD_LEN
+	validatorBinding  = "contentos-p2p-validator"
+)
+
+//ErrNoHandshake is returned by Handshake if the remote peer doesn't answer the hello, which is the case of
+//peers not upgraded to the handshake protocol
+var ErrNoHandshake = errors.New("remote peer didn't answer the handshake")
+
+//SecureConn is a connection encrypted and authenticated by the keys derived in Handshake
+type SecureConn struct {
+	net.Conn
+	remoteKey *prototype.PublicKeyType //node key of the remote peer
+	session   []byte                   //hash of the handshake transcript, unique to the connection
+
+	sendAead  cipher.AEAD
+	recvAead  cipher.AEAD
+	sendNonce uint64
+	recvNonce uint64
+	sendLock  sync.Mutex
+	recvLock  sync.Mutex
+	recvBuf   []byte
+}
+
+//Handshake performs a Noise-style handshake on conn. Both peers exchange ephemeral x25519 keys
+//along with their node keys, then sign the handshake transcript with the node keys.
+//The session keys derived from the ephemeral keys encrypt all the following traffic.
+func Handshake(conn net.Conn, nodeKey *prototype.PrivateKeyType, magic uint32) (*SecureConn, error) {
+	pubKey, err := nodeKey.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(common.HANDSHAKE_TIMEOUT * time.Second))
+	defer conn.SetDeadline(time.Time{})
+
+	var ephemeralPriv, ephemeralPub [ephemeralKeyLen]byte
+	if _, err = io.ReadFull(rand.Reader, ephemeralPriv[:]); err != nil {
+		return nil, err
+	}
+	curve25519.ScalarBaseMult(&ephemeralPub, &ephemeralPriv)
+
+	localHello := make([]byte, 0, helloLen)
+	localHello = append(localHello, make([]byte, 4)...)
+	binary.LittleEndian.PutUint32(localHello, magic)
+	localHello = append(localHello, ephemeralPub[:]...)
+	localHello = append(localHello, pubKey.Data...)
+	if len(localHello) != helloLen {
+		return nil, errors.New("invalid node key")
+	}
+	remoteHello, err := exchange(conn, localHello)
+	if err != nil {
+		return nil, ErrNoHandshake
+	}
+	if binary.LittleEndian.Uint32(remoteHello) != magic {
+		return nil, fmt.Errorf("unmatched magic number %d, expected %d", binary.LittleEndian.Uint32(remoteHello), magic)
+	}
+	var remoteEphemeral [ephemeralKeyLen]byte
+	copy(remoteEphemeral[:], remoteHello[4:4+ephemeralKeyLen])
+	if remoteEphemeral == ephemeralPub {
+		return nil, errors.New("handshake with itself")
+	}
+	remoteKey := prototype.PublicKeyFromBytes(append([]byte(nil), remoteHello[4+ephemeralKeyLen:]...))
+
+	// the peer with the smaller ephemeral key takes the initiator role, so that both peers agree on the transcript
+	initiator := bytes.Compare(ephemeralPub[:], remoteEphemeral[:]) < 0
+	h := sha256.New()
+	h.Write([]byte(handshakeProtocol))
+	if initiator {
+		h.Write(localHello)
+		h.Write(remoteHello)
+	} else {
+		h.Write(remoteHello)
+		h.Write(localHello)
+	}
+	session := h.Sum(nil)
+
+	sig, err := secp256k1.Sign(roleDigest(session, initiator), nodeKey.Data)
+	if err != nil {
+		return nil, err
+	}
+	remoteSig, err := exchange(conn, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyNodeSig(remoteKey, roleDigest(session, !initiator), remoteSig) {
+		return nil, errors.New("invalid handshake signature")
+	}
+
+	var shared [32]byte
+	curve25519.ScalarMult(&shared, &ephemeralPriv, &remoteEphemeral)
+	keys := make([]byte, 2*chacha20poly1305.KeySize)
+	if _, err = io.ReadFull(hkdf.New(sha256.New, shared[:], session, []byte(handshakeProtocol)), keys); err != nil {
+		return nil, err
+	}
+	initiatorKey, responderKey := keys[:chacha20poly1305.KeySize], keys[chacha20poly1305.KeySize:]
+	if !initiator {
+		initiatorKey, responderKey = responderKey, initiatorKey
+	}
+	sc := &SecureConn{Conn: conn, remoteKey: remoteKey, session: session}
+	if sc.sendAead, err = chacha20poly1305.New(initiatorKey); err != nil {
+		return nil, err
+	}
+	if sc.recvAead, err = chacha20poly1305.New(responderKey); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+//SignWithNodeKey signs data bound to given session with the node key
+func SignWithNodeKey(nodeKey *prototype.PrivateKeyType, session, data []byte) ([]byte, error) {
+	return secp256k1.Sign(sessionDigest(session, data), nodeKey.Data)
+}
+
+//VerifyNodeSig checks the signature of data bound to given session against the node key
+func VerifyNodeSig(key *prototype.PublicKeyType, session, data, sig []byte) bool {
+	return verifyNodeSig(key, sessionDigest(session, data), sig)
+}
+
+//SignValidatorBinding signs the node key with the block signing key of a validator, proving that
+//the node is run by the validator. The binding is only valid in given session.
+func SignValidatorBinding(validatorKey *prototype.PrivateKeyType, session []byte, nodeKey *prototype.PublicKeyType) ([]byte, error) {
+	return secp256k1.Sign(sessionDigest(session, bindingData(nodeKey)), validatorKey.Data)
+}
+
+//VerifyValidatorBinding checks the binding of the node key signed by given validator key
+func VerifyValidatorBinding(validatorKey *prototype.PublicKeyType, session []byte, nodeKey *prototype.PublicKeyType, sig []byte) bool {
+	return nodeKey != nil && verifyNodeSig(validatorKey, sessionDigest(session, bindingData(nodeKey)), sig)
+}
+
+func bindingData(nodeKey *prototype.PublicKeyType) []byte {
+	return append([]byte(validatorBinding), nodeKey.Data...)
+}
+
+func verifyNodeSig(key *prototype.PublicKeyType, digest, sig []byte) bool {
+	if key == nil || len(sig) != signatureLen {
+		return false
+	}
+	pub, err := secp256k1.RecoverPubkey(digest, sig)
+	if err != nil {
+		return false
+	}
+	x, y := elliptic.Unmarshal(secp256k1.S256(), pub)
+	if x == nil || !bytes.Equal(secp256k1.CompressPubkey(x, y), key.Data) {
+		return false
+	}
+	return secp256k1.VerifySignature(pub, digest, sig[:64])
+}
+
+func roleDigest(session []byte, initiator bool) []byte {
+	role := []byte("responder")
+	if initiator {
+		role = []byte("initiator")
+	}
+	return sessionDigest(session, role)
+}
+
+func sessionDigest(session, data []byte) []byte {
+	h := sha256.New()
+	h.Write(session)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+//exchange sends data and receives the remote data of the same length at the same time
+func exchange(conn net.Conn, data []byte) ([]byte, error) {
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := conn.Write(data)
+		errCh <- err
+	}()
+	remote := make([]byte, len(data))
+	if _, err := io.ReadFull(conn, remote); err != nil {
+		return nil, err
+	}
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+	return remote, nil
+}
+
+//DetectLegacy peeks the first bytes sent by an inbound peer, and tells whether the peer is not upgraded to
+//the handshake protocol, i.e. it sends a plain version message instead of the hello.
+//The returned connection replays the peeked bytes.
+func DetectLegacy(conn net.Conn, magic uint32) (net.Conn, bool, error) {
+	conn.SetReadDeadline(time.Now().Add(common.HANDSHAKE_TIMEOUT * time.Second))
+	defer conn.SetReadDeadline(time.Time{})
+
+	head := make([]byte, legacyPeekLen)
+	if _, err := io.ReadFull(conn, head); err != nil {
+		return nil, false, err
+	}
+	var cmd [common.MSG_CMD_LEN]byte
+	copy(cmd[:], common.VERSION_TYPE)
+	legacy := binary.LittleEndian.Uint32(head) == magic && bytes.Equal(head[4:], cmd[:])
+	return &peekedConn{Conn: conn, head: head}, legacy, nil
+}
+
+//peekedConn is a connection replaying the bytes peeked before reading from the underlying connection
+type peekedConn struct {
+	net.Conn
+	head []byte
+}
+
+func (this *peekedConn) Read(buf []byte) (int, error) {
+	if len(this.head) > 0 {
+		n := copy(buf, this.head)
+		this.head = this.head[n:]
+		return n, nil
+	}
+	return this.Conn.Read(buf)
+}
+
+//RemoteKey returns the node key of the remote peer
+func (this *SecureConn) RemoteKey() *prototype.PublicKeyType {
+	return this.remoteKey
+}
+
+//Session returns the session id of the connection
+func (this *SecureConn) Session() []byte {
+	return this.session
+}
+
+//Write encrypts data into frames and sends them
+func (this *SecureConn) Write(data []byte) (int, error) {
+	this.sendLock.Lock()
+	defer this.sendLock.Unlock()
+
+	n := 0
+	for n < len(data) {
+		size := len(data) - n
+		if size > common.MAX_SECURE_FRAME_LEN {
+			size = common.MAX_SECURE_FRAME_LEN
+		}
+		frame := make([]byte, 4, 4+size+this.sendAead.Overhead())
+		frame = this.sendAead.Seal(frame, frameNonce(this.sendNonce), data[n:n+size], nil)
+		binary.BigEndian.PutUint32(frame, uint32(len(frame)-4))
+		if _, err := this.Conn.Write(frame); err != nil {
+			return n, err
+		}
+		this.sendNonce++
+		n += size
+	}
+	return n, nil
+}
+
+//Read receives and decrypts frames
+func (this *SecureConn) Read(buf []byte) (int, error) {
+	this.recvLock.Lock()
+	defer this.recvLock.Unlock()
+
+	if len(this.recvBuf) == 0 {
+		var header [4]byte
+		if _, err := io.ReadFull(this.Conn, header[:]); err != nil {
+			return 0, err
+		}
+		size := binary.BigEndian.Uint32(header[:])
+		if size > uint32(common.MAX_SECURE_FRAME_LEN+this.recvAead.Overhead()) {
+			return 0, fmt.Errorf("secure frame length %d exceeds the limit", size)
+		}
+		frame := make([]byte, size)
+		if _, err := io.ReadFull(this.Conn, frame); err != nil {
+			return 0, err
+		}
+		plain, err := this.recvAead.Open(frame[:0], frameNonce(this.recvNonce), frame, nil)
+		if err != nil {
+			return 0, errors.New("secure frame authentication failed")
+		}
+		this.recvNonce++
+		this.recvBuf = plain
+	}
+	n := copy(buf, this.recvBuf)
+	this.recvBuf = this.recvBuf[n:]
+	return n, nil
+}
+
+func frameNonce(counter uint64) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce[4:], counter)
+	return nonce
+}
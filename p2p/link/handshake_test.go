@@ -0,0 +1,175 @@
+package link
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+)
+
+type handshakeResult struct {
+	conn *SecureConn
+	err  error
+}
+
+func handshakePair(t *testing.T, magic1, magic2 uint32) (*SecureConn, *SecureConn, error, error) {
+	key1, err := prototype.GenerateNewKey()
+	assert.NoError(t, err)
+	key2, err := prototype.GenerateNewKey()
+	assert.NoError(t, err)
+
+	c1, c2 := net.Pipe()
+	ch := make(chan handshakeResult, 1)
+	go func() {
+		sc, err := Handshake(c2, key2, magic2)
+		if err != nil {
+			c2.Close()
+		}
+		ch <- handshakeResult{sc, err}
+	}()
+	sc1, err1 := Handshake(c1, key1, magic1)
+	if err1 != nil {
+		c1.Close()
+	}
+	res := <-ch
+
+	if err1 == nil && res.err == nil {
+		pub1, _ := key1.PubKey()
+		pub2, _ := key2.PubKey()
+		assert.True(t, sc1.RemoteKey().Equal(pub2))
+		assert.True(t, res.conn.RemoteKey().Equal(pub1))
+	}
+	return sc1, res.conn, err1, res.err
+}
+
+func TestHandshake(t *testing.T) {
+	sc1, sc2, err1, err2 := handshakePair(t, 1234, 1234)
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, sc1.Session(), sc2.Session())
+
+	data := bytes.Repeat([]byte("contentos"), 20000)
+	go func() {
+		_, err := sc1.Write(data)
+		assert.NoError(t, err)
+	}()
+	recv := make([]byte, len(data))
+	_, err := io.ReadFull(sc2, recv)
+	assert.NoError(t, err)
+	assert.Equal(t, data, recv)
+
+	go func() {
+		_, err := sc2.Write([]byte("pong"))
+		assert.NoError(t, err)
+	}()
+	recv = make([]byte, 4)
+	_, err = io.ReadFull(sc1, recv)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("pong"), recv)
+
+	sc1.Close()
+	sc2.Close()
+}
+
+func TestHandshakeMagicMismatch(t *testing.T) {
+	_, _, err1, err2 := handshakePair(t, 1234, 5678)
+	assert.Error(t, err1)
+	assert.Error(t, err2)
+}
+
+func TestNodeSig(t *testing.T) {
+	key, _ := prototype.GenerateNewKey()
+	pub, _ := key.PubKey()
+	other, _ := prototype.GenerateNewKey()
+	otherPub, _ := other.PubKey()
+
+	session, data := []byte("session"), []byte("version")
+	sig, err := SignWithNodeKey(key, session, data)
+	assert.NoError(t, err)
+	assert.True(t, VerifyNodeSig(pub, session, data, sig))
+	assert.False(t, VerifyNodeSig(otherPub, session, data, sig))
+	assert.False(t, VerifyNodeSig(pub, []byte("another session"), data, sig))
+	assert.False(t, VerifyNodeSig(pub, session, []byte("tampered"), sig))
+}
+
+func TestValidatorBinding(t *testing.T) {
+	bpKey, _ := prototype.GenerateNewKey()
+	bpPub, _ := bpKey.PubKey()
+	nodeKey, _ := prototype.GenerateNewKey()
+	nodePub, _ := nodeKey.PubKey()
+	other, _ := prototype.GenerateNewKey()
+	otherPub, _ := other.PubKey()
+
+	session := []byte("session")
+	sig, err := SignValidatorBinding(bpKey, session, nodePub)
+	assert.NoError(t, err)
+	assert.True(t, VerifyValidatorBinding(bpPub, session, nodePub, sig))
+	assert.False(t, VerifyValidatorBinding(otherPub, session, nodePub, sig))
+	assert.False(t, VerifyValidatorBinding(bpPub, session, otherPub, sig))
+	assert.False(t, VerifyValidatorBinding(bpPub, []byte("another session"), nodePub, sig))
+	assert.False(t, VerifyValidatorBinding(bpPub, session, nil, sig))
+
+	// a node signature over the same data doesn't make a binding
+	nodeSig, err := SignWithNodeKey(nodeKey, session, bindingData(nodePub))
+	assert.NoError(t, err)
+	assert.False(t, VerifyValidatorBinding(bpPub, session, nodePub, nodeSig))
+}
+
+func TestDetectLegacy(t *testing.T) {
+	const magic = 1234
+
+	// a legacy peer sends a plain version message header
+	c1, c2 := net.Pipe()
+	legacyHdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(legacyHdr, magic)
+	copy(legacyHdr[4:], common.VERSION_TYPE)
+	go func() {
+		c1.Write(legacyHdr)
+		c1.Close()
+	}()
+	conn, legacy, err := DetectLegacy(c2, magic)
+	assert.NoError(t, err)
+	assert.True(t, legacy)
+	recv, err := ioutil.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, legacyHdr, recv)
+	c2.Close()
+
+	// an upgraded peer completes the handshake on the peeked connection
+	key1, _ := prototype.GenerateNewKey()
+	key2, _ := prototype.GenerateNewKey()
+	c1, c2 = net.Pipe()
+	ch := make(chan error, 1)
+	go func() {
+		_, err := Handshake(c1, key1, magic)
+		ch <- err
+	}()
+	conn, legacy, err = DetectLegacy(c2, magic)
+	assert.NoError(t, err)
+	assert.False(t, legacy)
+	_, err = Handshake(conn, key2, magic)
+	assert.NoError(t, err)
+	assert.NoError(t, <-ch)
+	c1.Close()
+	c2.Close()
+}
+
+func TestHandshakeWithLegacy(t *testing.T) {
+	key, _ := prototype.GenerateNewKey()
+
+	// legacy peers drop the connection on receiving the hello
+	c1, c2 := net.Pipe()
+	go func() {
+		c2.Read(make([]byte, helloLen))
+		c2.Close()
+	}()
+	_, err := Handshake(c1, key, 1234)
+	assert.Equal(t, ErrNoHandshake, err)
+	c1.Close()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/coschain/contentos-go/p2p/common"
 	"github.com/coschain/contentos-go/p2p/message/types"
+	"github.com/coschain/contentos-go/prototype"
 )
 
 //Link used to establish
@@ -29,6 +30,10 @@ type Link struct {
 	sendChan chan types.Message
 	stopSend chan bool
 
+	remoteKey *prototype.PublicKeyType //node key of the remote peer, authenticated in handshake
+	session   []byte                   //session id of the secure connection
+
+
 	sync.RWMutex
 }
 
@@ -91,6 +96,20 @@ func (this *Link) GetConn() net.Conn {
 //set connection
 func (this *Link) SetConn(conn net.Conn) {
 	this.conn = conn
+	if sc, ok := conn.(*SecureConn); ok {
+		this.remoteKey = sc.RemoteKey()
+		this.session = sc.Session()
+	}
+}
+
+//GetRemoteKey return the node key of the remote peer, nil if the connection is not secure
+func (this *Link) GetRemoteKey() *prototype.PublicKeyType {
+	return this.remoteKey
+}
+
+//GetSession return the session id of the connection, nil if the connection is not secure
+func (this *Link) GetSession() []byte {
+	return this.session
 }
 
 //record latest message time
@@ -146,7 +165,8 @@ func (this *Link) Rx(magic uint32) {
 			msg, payloadSize, err := types.ReadMessage(reader, magic)
 			if err != nil {
 				this.log.Infof("read from peer %s error: %v", this.addr, err)
-				this.disconnectNotify()
+				_, malformed := err.(*types.MalformedError)
+				this.notifyDisconnect(malformed)
 				return
 			}
 
@@ -165,14 +185,20 @@ func (this *Link) Rx(magic uint32) {
 
 //disconnectNotify push disconnect msg to channel
 func (this *Link) disconnectNotify() {
+	this.notifyDisconnect(false)
+}
+
+//notifyDisconnect push disconnect msg to channel, telling whether the peer sent a malformed message
+func (this *Link) notifyDisconnect(malformed bool) {
 	//this.CloseConn()
 
 	reqmsg := NewDisconnected()
 
 	discMsg := &types.MsgPayload{
-		Id:      this.id,
-		Addr:    this.addr,
-		Payload: reqmsg,
+		Id:        this.id,
+		Addr:      this.addr,
+		Payload:   reqmsg,
+		Malformed: malformed,
 	}
 	this.recvChan <- discMsg
 }
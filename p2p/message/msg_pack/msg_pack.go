@@ -1,12 +1,16 @@
 package msgpack
 
 import (
+	"math/rand"
 	"time"
 
+	msgCommon "github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/p2p/link"
 	mt "github.com/coschain/contentos-go/p2p/message/types"
 	"github.com/coschain/contentos-go/p2p/net/protocol"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/coschain/gobft/message"
+	"github.com/golang/protobuf/proto"
 )
 
 //Peer address package
@@ -52,6 +56,48 @@ func NewSigBlk(bk *prototype.SignedBlock, needTriggerFetch bool) mt.Message {
 	return &reqmsg
 }
 
+//compact block package, transactions are replaced by their short ids salted by the block id and a random nonce
+func NewCompactBlk(bk *prototype.SignedBlock, needTriggerFetch bool) mt.Message {
+	var reqmsg mt.TransferMsg
+	data := new(mt.CompactBlkMsg)
+	data.SignedHeader = bk.SignedHeader
+	data.NeedTriggerFetch = needTriggerFetch
+	data.Nonce = rand.Uint64()
+	blkId := bk.Id()
+	key := prototype.NewShortTrxIdKey(blkId.Data[:], data.Nonce)
+	for _, trx := range bk.Transactions {
+		id, _ := trx.SigTrx.Id()
+		data.ShortIds = append(data.ShortIds, key.ShortTrxId(id.Hash))
+		data.Receipts = append(data.Receipts, trx.Receipt)
+	}
+
+	reqmsg.Msg = &mt.TransferMsg_Msg17{Msg17:data}
+	return &reqmsg
+}
+
+//request transactions of a compact block package
+func NewRequestBlkTrxs(blockId []byte, indexes []uint32) mt.Message {
+	var reqmsg mt.TransferMsg
+	data := new(mt.RequestBlkTrxs)
+	data.BlockId = blockId
+	data.Indexes = indexes
+
+	reqmsg.Msg = &mt.TransferMsg_Msg18{Msg18:data}
+	return &reqmsg
+}
+
+//transactions of a compact block package
+func NewBlkTrxs(blockId []byte, indexes []uint32, trxs []*prototype.SignedTransaction) mt.Message {
+	var reqmsg mt.TransferMsg
+	data := new(mt.BlkTrxs)
+	data.BlockId = blockId
+	data.Indexes = indexes
+	data.Trxs = trxs
+
+	reqmsg.Msg = &mt.TransferMsg_Msg19{Msg19:data}
+	return &reqmsg
+}
+
 //ping msg package
 func NewPingMsg(height uint64) mt.Message {
 	var reqmsg mt.TransferMsg
@@ -93,7 +139,8 @@ func NewVerAck(isConsensus bool) mt.Message {
 }
 
 //Version package
-func NewVersion(n p2p.P2P, isCons bool, height uint64, runningVersion string) mt.Message {
+//the version is signed with the node key, bound to the session of the secure link
+func NewVersion(n p2p.P2P, isCons bool, height uint64, runningVersion string, session []byte) mt.Message {
 	var reqmsg mt.TransferMsg
 
 	 data := &mt.Version{
@@ -106,12 +153,28 @@ func NewVersion(n p2p.P2P, isCons bool, height uint64, runningVersion string) mt
 		StartHeight        : uint64(height),
 		Timestamp          : time.Now().UnixNano(),
 		RunningCodeVersion : runningVersion,
+		Cap                : []byte{1 << msgCommon.CAP_COMPACT_BLOCK},
 	}
 	if n.GetRelay() {
 		data.Relay = 1
 	} else {
 		data.Relay = 0
 	}
+	if nodeKey := n.GetNodeKey(); nodeKey != nil {
+		if pubKey, err := nodeKey.PubKey(); err == nil {
+			data.NodeKey = pubKey.Data
+			// validators prove that they run the node on consensus links
+			if validatorKey := n.GetValidatorKey(); isCons && validatorKey != nil {
+				if validatorPub, err := validatorKey.PubKey(); err == nil {
+					data.ValidatorKey = validatorPub.Data
+					data.ValidatorSig, _ = link.SignValidatorBinding(validatorKey, session, pubKey)
+				}
+			}
+			if buf, err := proto.Marshal(data); err == nil {
+				data.Signature, _ = link.SignWithNodeKey(nodeKey, session, buf)
+			}
+		}
+	}
 
 	 reqmsg.Msg = &mt.TransferMsg_Msg11{Msg11:data}
 	 return &reqmsg
@@ -50,6 +50,27 @@ func TestMsgPack(t *testing.T) {
 	msgBefore = NewTxn(sigtrx)
 	processAndCheck(t)
 
+	sigBlk.Transactions = append(sigBlk.Transactions, &prototype.TransactionWrapper{
+		SigTrx:  sigtrx,
+		Receipt: &prototype.TransactionReceipt{Status: prototype.StatusSuccess},
+	})
+	msgBefore = NewCompactBlk(sigBlk, true)
+	processAndCheck(t)
+	cmpct := msgAfter.Msg.(*msgTypes.TransferMsg_Msg17).Msg17
+	trxId, _ := sigtrx.Id()
+	cmpctId := sigBlk.Id()
+	key := prototype.NewShortTrxIdKey(cmpctId.Data[:], cmpct.Nonce)
+	assert.Equal(t, []uint64{key.ShortTrxId(trxId.Hash)}, cmpct.ShortIds)
+	assert.Equal(t, prototype.StatusSuccess, cmpct.Receipts[0].Status)
+	assert.True(t, cmpct.NeedTriggerFetch)
+
+	blkId := sigBlk.Id()
+	msgBefore = NewRequestBlkTrxs(blkId.Data[:], []uint32{0})
+	processAndCheck(t)
+
+	msgBefore = NewBlkTrxs(blkId.Data[:], []uint32{0}, []*prototype.SignedTransaction{sigtrx})
+	processAndCheck(t)
+
 	msgBefore = NewVerAck(true)
 	processAndCheck(t)
 }
@@ -22,6 +22,20 @@ type MsgPayload struct {
 	Addr        string  //link address
 	PayloadSize uint32  //payload size
 	Payload     Message //msg payload
+	Malformed   bool    //whether the link was closed for a malformed message
+}
+
+//MalformedError is returned by ReadMessage if the remote peer sent a message violating the protocol
+type MalformedError struct {
+	err error
+}
+
+func (e *MalformedError) Error() string {
+	return e.err.Error()
+}
+
+func malformed(format string, a ...interface{}) error {
+	return &MalformedError{err: fmt.Errorf(format, a...)}
 }
 
 type messageHeader struct {
@@ -88,11 +102,11 @@ func ReadMessage(reader io.Reader, magic uint32) (Message, uint32, error) {
 	}
 
 	if hdr.Magic != magic {
-		return nil, 0, fmt.Errorf("unmatched magic number %d, expected %d", hdr.Magic, magic)
+		return nil, 0, malformed("unmatched magic number %d, expected %d", hdr.Magic, magic)
 	}
 
 	if hdr.Length > common.MAX_PAYLOAD_LEN {
-		return nil, 0, fmt.Errorf("msg payload length:%d exceed max payload size: %d",
+		return nil, 0, malformed("msg payload length:%d exceed max payload size: %d",
 			hdr.Length, common.MAX_PAYLOAD_LEN)
 	}
 
@@ -105,14 +119,14 @@ func ReadMessage(reader io.Reader, magic uint32) (Message, uint32, error) {
 
 	checksum := common.Checksum(buf)
 	if checksum != hdr.Checksum {
-		return nil, 0, fmt.Errorf("message checksum mismatch: %x != %x ", hdr.Checksum, checksum)
+		return nil, 0, malformed("message checksum mismatch: %x != %x ", hdr.Checksum, checksum)
 	}
 
 	cmdType := string(bytes.TrimRight(hdr.CMD[:], string(0)))
 	msg, err := MakeEmptyMessage(cmdType)
 	if err != nil {
 		//return nil, 0, err
-		return nil, 0, fmt.Errorf("make empty message error, %s", err)
+		return nil, 0, malformed("make empty message error, %s", err)
 	}
 
 	// the buf is referenced by msg to avoid reallocation, so can not reused
@@ -120,7 +134,7 @@ func ReadMessage(reader io.Reader, magic uint32) (Message, uint32, error) {
 	err = msg.Deserialization(source)
 	if err != nil {
 		//return nil, 0, err
-		return nil, 0, fmt.Errorf("p2p msg Deserialization error, %s", err)
+		return nil, 0, malformed("p2p msg Deserialization error, %s", err)
 	}
 
 	return msg, hdr.Length, nil
@@ -160,6 +174,12 @@ func MakeEmptyMessage(cmdType string) (Message, error) {
 		return &TransferMsg{}, nil
 	case common.CLEAR_OUT_OF_RABGE_STATE:
 		return &TransferMsg{}, nil
+	case common.COMPACT_BLOCK_TYPE:
+		return &TransferMsg{}, nil
+	case common.REQ_BLOCK_TRXS_TYPE:
+		return &TransferMsg{}, nil
+	case common.BLOCK_TRXS_TYPE:
+		return &TransferMsg{}, nil
 	case common.CONSENSUS_TYPE:
 		return &ConsMsg{Extra:&ConsensusExtraData{},}, nil
 	default:
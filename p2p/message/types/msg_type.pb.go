@@ -24,10 +24,11 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type IdMsgType int32
 
 const (
-	IdMsg_broadcast_sigblk_id  IdMsgType = 0
-	IdMsg_request_sigblk_by_id IdMsgType = 1
-	IdMsg_request_id_ack       IdMsgType = 2
-	IdMsg_detect_former_ids    IdMsgType = 3
+	IdMsg_broadcast_sigblk_id       IdMsgType = 0
+	IdMsg_request_sigblk_by_id      IdMsgType = 1
+	IdMsg_request_id_ack            IdMsgType = 2
+	IdMsg_detect_former_ids         IdMsgType = 3
+	IdMsg_request_compact_blk_by_id IdMsgType = 4
 )
 
 var IdMsgType_name = map[int32]string{
@@ -35,13 +36,15 @@ var IdMsgType_name = map[int32]string{
 	1: "request_sigblk_by_id",
 	2: "request_id_ack",
 	3: "detect_former_ids",
+	4: "request_compact_blk_by_id",
 }
 
 var IdMsgType_value = map[string]int32{
-	"broadcast_sigblk_id":  0,
-	"request_sigblk_by_id": 1,
-	"request_id_ack":       2,
-	"detect_former_ids":    3,
+	"broadcast_sigblk_id":       0,
+	"request_sigblk_by_id":      1,
+	"request_id_ack":            2,
+	"detect_former_ids":         3,
+	"request_compact_blk_by_id": 4,
 }
 
 func (x IdMsgType) String() string {
@@ -185,6 +188,183 @@ func (m *SigBlkMsg) GetNeedTriggerFetch() bool {
 	return false
 }
 
+// compact block carries short ids instead of the transactions,
+// which receivers usually have already in their waiting pools.
+// short ids are salted by the block id and nonce.
+type CompactBlkMsg struct {
+	SignedHeader         *prototype.SignedBlockHeader    `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
+	ShortIds             []uint64                        `protobuf:"varint,2,rep,packed,name=short_ids,json=shortIds,proto3" json:"short_ids,omitempty"`
+	Receipts             []*prototype.TransactionReceipt `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	NeedTriggerFetch     bool                            `protobuf:"varint,4,opt,name=need_trigger_fetch,json=needTriggerFetch,proto3" json:"need_trigger_fetch,omitempty"`
+	Nonce                uint64                          `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *CompactBlkMsg) Reset()         { *m = CompactBlkMsg{} }
+func (m *CompactBlkMsg) String() string { return proto.CompactTextString(m) }
+func (*CompactBlkMsg) ProtoMessage()    {}
+func (*CompactBlkMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{3}
+}
+
+func (m *CompactBlkMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactBlkMsg.Unmarshal(m, b)
+}
+func (m *CompactBlkMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactBlkMsg.Marshal(b, m, deterministic)
+}
+func (m *CompactBlkMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlkMsg.Merge(m, src)
+}
+func (m *CompactBlkMsg) XXX_Size() int {
+	return xxx_messageInfo_CompactBlkMsg.Size(m)
+}
+func (m *CompactBlkMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlkMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlkMsg proto.InternalMessageInfo
+
+func (m *CompactBlkMsg) GetSignedHeader() *prototype.SignedBlockHeader {
+	if m != nil {
+		return m.SignedHeader
+	}
+	return nil
+}
+
+func (m *CompactBlkMsg) GetShortIds() []uint64 {
+	if m != nil {
+		return m.ShortIds
+	}
+	return nil
+}
+
+func (m *CompactBlkMsg) GetReceipts() []*prototype.TransactionReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *CompactBlkMsg) GetNeedTriggerFetch() bool {
+	if m != nil {
+		return m.NeedTriggerFetch
+	}
+	return false
+}
+
+func (m *CompactBlkMsg) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// request transactions of a compact block by their indexes
+type RequestBlkTrxs struct {
+	BlockId              []byte   `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Indexes              []uint32 `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestBlkTrxs) Reset()         { *m = RequestBlkTrxs{} }
+func (m *RequestBlkTrxs) String() string { return proto.CompactTextString(m) }
+func (*RequestBlkTrxs) ProtoMessage()    {}
+func (*RequestBlkTrxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{4}
+}
+
+func (m *RequestBlkTrxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestBlkTrxs.Unmarshal(m, b)
+}
+func (m *RequestBlkTrxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestBlkTrxs.Marshal(b, m, deterministic)
+}
+func (m *RequestBlkTrxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestBlkTrxs.Merge(m, src)
+}
+func (m *RequestBlkTrxs) XXX_Size() int {
+	return xxx_messageInfo_RequestBlkTrxs.Size(m)
+}
+func (m *RequestBlkTrxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestBlkTrxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestBlkTrxs proto.InternalMessageInfo
+
+func (m *RequestBlkTrxs) GetBlockId() []byte {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *RequestBlkTrxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlkTrxs struct {
+	BlockId              []byte                         `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Indexes              []uint32                       `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	Trxs                 []*prototype.SignedTransaction `protobuf:"bytes,3,rep,name=trxs,proto3" json:"trxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *BlkTrxs) Reset()         { *m = BlkTrxs{} }
+func (m *BlkTrxs) String() string { return proto.CompactTextString(m) }
+func (*BlkTrxs) ProtoMessage()    {}
+func (*BlkTrxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{5}
+}
+
+func (m *BlkTrxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlkTrxs.Unmarshal(m, b)
+}
+func (m *BlkTrxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlkTrxs.Marshal(b, m, deterministic)
+}
+func (m *BlkTrxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlkTrxs.Merge(m, src)
+}
+func (m *BlkTrxs) XXX_Size() int {
+	return xxx_messageInfo_BlkTrxs.Size(m)
+}
+func (m *BlkTrxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlkTrxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlkTrxs proto.InternalMessageInfo
+
+func (m *BlkTrxs) GetBlockId() []byte {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *BlkTrxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *BlkTrxs) GetTrxs() []*prototype.SignedTransaction {
+	if m != nil {
+		return m.Trxs
+	}
+	return nil
+}
+
 type ReqIdMsg struct {
 	HeadBlockId          []byte   `protobuf:"bytes,1,opt,name=head_block_id,json=headBlockId,proto3" json:"head_block_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -196,7 +376,7 @@ func (m *ReqIdMsg) Reset()         { *m = ReqIdMsg{} }
 func (m *ReqIdMsg) String() string { return proto.CompactTextString(m) }
 func (*ReqIdMsg) ProtoMessage()    {}
 func (*ReqIdMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{3}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{6}
 }
 
 func (m *ReqIdMsg) XXX_Unmarshal(b []byte) error {
@@ -235,7 +415,7 @@ func (m *Address) Reset()         { *m = Address{} }
 func (m *Address) String() string { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()    {}
 func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{4}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{7}
 }
 
 func (m *Address) XXX_Unmarshal(b []byte) error {
@@ -273,7 +453,7 @@ func (m *AddrReq) Reset()         { *m = AddrReq{} }
 func (m *AddrReq) String() string { return proto.CompactTextString(m) }
 func (*AddrReq) ProtoMessage()    {}
 func (*AddrReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{5}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{8}
 }
 
 func (m *AddrReq) XXX_Unmarshal(b []byte) error {
@@ -304,7 +484,7 @@ func (m *Disconnected) Reset()         { *m = Disconnected{} }
 func (m *Disconnected) String() string { return proto.CompactTextString(m) }
 func (*Disconnected) ProtoMessage()    {}
 func (*Disconnected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{6}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{9}
 }
 
 func (m *Disconnected) XXX_Unmarshal(b []byte) error {
@@ -336,7 +516,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{7}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{10}
 }
 
 func (m *Ping) XXX_Unmarshal(b []byte) error {
@@ -375,7 +555,7 @@ func (m *Pong) Reset()         { *m = Pong{} }
 func (m *Pong) String() string { return proto.CompactTextString(m) }
 func (*Pong) ProtoMessage()    {}
 func (*Pong) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{8}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{11}
 }
 
 func (m *Pong) XXX_Unmarshal(b []byte) error {
@@ -414,7 +594,7 @@ func (m *VerAck) Reset()         { *m = VerAck{} }
 func (m *VerAck) String() string { return proto.CompactTextString(m) }
 func (*VerAck) ProtoMessage()    {}
 func (*VerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{9}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{12}
 }
 
 func (m *VerAck) XXX_Unmarshal(b []byte) error {
@@ -454,6 +634,10 @@ type Version struct {
 	Relay                uint32   `protobuf:"varint,9,opt,name=relay,proto3" json:"relay,omitempty"`
 	IsConsensus          bool     `protobuf:"varint,10,opt,name=IsConsensus,proto3" json:"IsConsensus,omitempty"`
 	RunningCodeVersion   string   `protobuf:"bytes,11,opt,name=RunningCodeVersion,proto3" json:"RunningCodeVersion,omitempty"`
+	NodeKey              []byte   `protobuf:"bytes,12,opt,name=nodeKey,proto3" json:"nodeKey,omitempty"`
+	Signature            []byte   `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	ValidatorKey         []byte   `protobuf:"bytes,14,opt,name=validatorKey,proto3" json:"validatorKey,omitempty"`
+	ValidatorSig         []byte   `protobuf:"bytes,15,opt,name=validatorSig,proto3" json:"validatorSig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -463,7 +647,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{10}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{13}
 }
 
 func (m *Version) XXX_Unmarshal(b []byte) error {
@@ -561,6 +745,34 @@ func (m *Version) GetRunningCodeVersion() string {
 	return ""
 }
 
+func (m *Version) GetNodeKey() []byte {
+	if m != nil {
+		return m.NodeKey
+	}
+	return nil
+}
+
+func (m *Version) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Version) GetValidatorKey() []byte {
+	if m != nil {
+		return m.ValidatorKey
+	}
+	return nil
+}
+
+func (m *Version) GetValidatorSig() []byte {
+	if m != nil {
+		return m.ValidatorSig
+	}
+	return nil
+}
+
 type RequestCheckpointBatch struct {
 	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  uint64   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -573,7 +785,7 @@ func (m *RequestCheckpointBatch) Reset()         { *m = RequestCheckpointBatch{}
 func (m *RequestCheckpointBatch) String() string { return proto.CompactTextString(m) }
 func (*RequestCheckpointBatch) ProtoMessage()    {}
 func (*RequestCheckpointBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{11}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{14}
 }
 
 func (m *RequestCheckpointBatch) XXX_Unmarshal(b []byte) error {
@@ -620,7 +832,7 @@ func (m *RequestOutOfRangeIds) Reset()         { *m = RequestOutOfRangeIds{} }
 func (m *RequestOutOfRangeIds) String() string { return proto.CompactTextString(m) }
 func (*RequestOutOfRangeIds) ProtoMessage()    {}
 func (*RequestOutOfRangeIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{12}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{15}
 }
 
 func (m *RequestOutOfRangeIds) XXX_Unmarshal(b []byte) error {
@@ -668,7 +880,7 @@ func (m *RequestBlockBatch) Reset()         { *m = RequestBlockBatch{} }
 func (m *RequestBlockBatch) String() string { return proto.CompactTextString(m) }
 func (*RequestBlockBatch) ProtoMessage()    {}
 func (*RequestBlockBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{13}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{16}
 }
 
 func (m *RequestBlockBatch) XXX_Unmarshal(b []byte) error {
@@ -714,7 +926,7 @@ func (m *DetectFormerIds) Reset()         { *m = DetectFormerIds{} }
 func (m *DetectFormerIds) String() string { return proto.CompactTextString(m) }
 func (*DetectFormerIds) ProtoMessage()    {}
 func (*DetectFormerIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{14}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{17}
 }
 
 func (m *DetectFormerIds) XXX_Unmarshal(b []byte) error {
@@ -752,7 +964,7 @@ func (m *ClearOutOfRangeState) Reset()         { *m = ClearOutOfRangeState{} }
 func (m *ClearOutOfRangeState) String() string { return proto.CompactTextString(m) }
 func (*ClearOutOfRangeState) ProtoMessage()    {}
 func (*ClearOutOfRangeState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{15}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{18}
 }
 
 func (m *ClearOutOfRangeState) XXX_Unmarshal(b []byte) error {
@@ -791,6 +1003,9 @@ type TransferMsg struct {
 	//	*TransferMsg_Msg14
 	//	*TransferMsg_Msg15
 	//	*TransferMsg_Msg16
+	//	*TransferMsg_Msg17
+	//	*TransferMsg_Msg18
+	//	*TransferMsg_Msg19
 	Msg                  isTransferMsg_Msg `protobuf_oneof:"msg"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -801,7 +1016,7 @@ func (m *TransferMsg) Reset()         { *m = TransferMsg{} }
 func (m *TransferMsg) String() string { return proto.CompactTextString(m) }
 func (*TransferMsg) ProtoMessage()    {}
 func (*TransferMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{16}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{19}
 }
 
 func (m *TransferMsg) XXX_Unmarshal(b []byte) error {
@@ -890,6 +1105,18 @@ type TransferMsg_Msg16 struct {
 	Msg16 *ClearOutOfRangeState `protobuf:"bytes,16,opt,name=msg16,proto3,oneof"`
 }
 
+type TransferMsg_Msg17 struct {
+	Msg17 *CompactBlkMsg `protobuf:"bytes,17,opt,name=msg17,proto3,oneof"`
+}
+
+type TransferMsg_Msg18 struct {
+	Msg18 *RequestBlkTrxs `protobuf:"bytes,18,opt,name=msg18,proto3,oneof"`
+}
+
+type TransferMsg_Msg19 struct {
+	Msg19 *BlkTrxs `protobuf:"bytes,19,opt,name=msg19,proto3,oneof"`
+}
+
 func (*TransferMsg_Msg1) isTransferMsg_Msg() {}
 
 func (*TransferMsg_Msg2) isTransferMsg_Msg() {}
@@ -922,6 +1149,12 @@ func (*TransferMsg_Msg15) isTransferMsg_Msg() {}
 
 func (*TransferMsg_Msg16) isTransferMsg_Msg() {}
 
+func (*TransferMsg_Msg17) isTransferMsg_Msg() {}
+
+func (*TransferMsg_Msg18) isTransferMsg_Msg() {}
+
+func (*TransferMsg_Msg19) isTransferMsg_Msg() {}
+
 func (m *TransferMsg) GetMsg() isTransferMsg_Msg {
 	if m != nil {
 		return m.Msg
@@ -1041,6 +1274,27 @@ func (m *TransferMsg) GetMsg16() *ClearOutOfRangeState {
 	return nil
 }
 
+func (m *TransferMsg) GetMsg17() *CompactBlkMsg {
+	if x, ok := m.GetMsg().(*TransferMsg_Msg17); ok {
+		return x.Msg17
+	}
+	return nil
+}
+
+func (m *TransferMsg) GetMsg18() *RequestBlkTrxs {
+	if x, ok := m.GetMsg().(*TransferMsg_Msg18); ok {
+		return x.Msg18
+	}
+	return nil
+}
+
+func (m *TransferMsg) GetMsg19() *BlkTrxs {
+	if x, ok := m.GetMsg().(*TransferMsg_Msg19); ok {
+		return x.Msg19
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TransferMsg) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1060,6 +1314,9 @@ func (*TransferMsg) XXX_OneofWrappers() []interface{} {
 		(*TransferMsg_Msg14)(nil),
 		(*TransferMsg_Msg15)(nil),
 		(*TransferMsg_Msg16)(nil),
+		(*TransferMsg_Msg17)(nil),
+		(*TransferMsg_Msg18)(nil),
+		(*TransferMsg_Msg19)(nil),
 	}
 }
 
@@ -1079,7 +1336,7 @@ func (m *PeerAddr) Reset()         { *m = PeerAddr{} }
 func (m *PeerAddr) String() string { return proto.CompactTextString(m) }
 func (*PeerAddr) ProtoMessage()    {}
 func (*PeerAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{17}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{20}
 }
 
 func (m *PeerAddr) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1410,7 @@ func (m *ConsensusExtraData) Reset()         { *m = ConsensusExtraData{} }
 func (m *ConsensusExtraData) String() string { return proto.CompactTextString(m) }
 func (*ConsensusExtraData) ProtoMessage()    {}
 func (*ConsensusExtraData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3219c6d1c4a4cc8, []int{18}
+	return fileDescriptor_b3219c6d1c4a4cc8, []int{21}
 }
 
 func (m *ConsensusExtraData) XXX_Unmarshal(b []byte) error {
@@ -1186,6 +1443,9 @@ func init() {
 	proto.RegisterType((*BroadcastSigTrx)(nil), "types.broadcast_sig_trx")
 	proto.RegisterType((*IdMsg)(nil), "types.id_msg")
 	proto.RegisterType((*SigBlkMsg)(nil), "types.sig_blk_msg")
+	proto.RegisterType((*CompactBlkMsg)(nil), "types.compact_blk_msg")
+	proto.RegisterType((*RequestBlkTrxs)(nil), "types.request_blk_trxs")
+	proto.RegisterType((*BlkTrxs)(nil), "types.blk_trxs")
 	proto.RegisterType((*ReqIdMsg)(nil), "types.req_id_msg")
 	proto.RegisterType((*Address)(nil), "types.address")
 	proto.RegisterType((*AddrReq)(nil), "types.addr_req")
@@ -1207,72 +1467,87 @@ func init() {
 func init() { proto.RegisterFile("p2p/message/types/msg_type.proto", fileDescriptor_b3219c6d1c4a4cc8) }
 
 var fileDescriptor_b3219c6d1c4a4cc8 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdb, 0x72, 0xdb, 0x36,
-	0x10, 0x8d, 0xae, 0x96, 0x97, 0xb6, 0xa2, 0x20, 0x49, 0xc3, 0x3a, 0xbd, 0xa8, 0x6c, 0xda, 0xaa,
-	0x6d, 0x2a, 0xd9, 0xf4, 0x2d, 0x79, 0xac, 0x93, 0x69, 0xad, 0x3e, 0x75, 0x18, 0x4f, 0x1f, 0xfa,
-	0xc2, 0xa1, 0x40, 0x98, 0xc2, 0x48, 0x04, 0x64, 0x00, 0xf2, 0xd8, 0x1f, 0xd3, 0x9f, 0xe8, 0x4f,
-	0xf4, 0x27, 0xfa, 0x31, 0x1d, 0x2c, 0x41, 0x89, 0xb2, 0xdd, 0x3c, 0x19, 0xbb, 0x7b, 0x76, 0xb1,
-	0x58, 0x9f, 0x3d, 0x14, 0xf4, 0x17, 0xe1, 0x62, 0x94, 0x33, 0xad, 0x93, 0x8c, 0x8d, 0xcc, 0xed,
-	0x82, 0xe9, 0x51, 0xae, 0xb3, 0xd8, 0x9e, 0x86, 0x0b, 0x25, 0x8d, 0x24, 0x2d, 0xf4, 0xee, 0xbd,
-	0x44, 0xcb, 0x9e, 0x47, 0x46, 0x25, 0x42, 0x27, 0xd4, 0x70, 0x29, 0x0a, 0x4c, 0xf0, 0x1b, 0x3c,
-	0x99, 0x28, 0x99, 0xa4, 0x34, 0xd1, 0x26, 0xd6, 0x3c, 0x8b, 0x8d, 0xba, 0x21, 0xc7, 0xd0, 0xfe,
-	0xc0, 0xb3, 0x0b, 0x75, 0xe3, 0xd7, 0xfa, 0xb5, 0x81, 0x17, 0x7e, 0x3e, 0x5c, 0x95, 0x18, 0x6a,
-	0x9e, 0x09, 0x96, 0xc6, 0x95, 0x4a, 0x91, 0x03, 0x07, 0x7f, 0xd7, 0xa0, 0xcd, 0xd3, 0x38, 0xd7,
-	0x19, 0x79, 0x0d, 0x5b, 0xb9, 0xce, 0x6c, 0x02, 0x96, 0xe8, 0x86, 0x64, 0x88, 0xcd, 0x0c, 0x8b,
-	0x38, 0x1a, 0x51, 0x09, 0x21, 0xcf, 0xa0, 0x75, 0x9d, 0xcc, 0x97, 0xcc, 0xaf, 0xf7, 0x1b, 0x83,
-	0x9d, 0xa8, 0x30, 0x82, 0x14, 0x9a, 0x18, 0x7d, 0x01, 0x4f, 0x37, 0x5a, 0x9c, 0xcc, 0x67, 0x31,
-	0x4f, 0x7b, 0x8f, 0x88, 0x0f, 0xcf, 0x14, 0xbb, 0x5a, 0xb2, 0xb5, 0x7b, 0x72, 0x6b, 0x23, 0x35,
-	0x42, 0xa0, 0x5b, 0x46, 0x78, 0x1a, 0x27, 0x74, 0xd6, 0xab, 0x93, 0xe7, 0xf0, 0x24, 0x65, 0x86,
-	0x51, 0x13, 0x5f, 0x4a, 0x95, 0x33, 0x15, 0xf3, 0x54, 0xf7, 0x1a, 0xc1, 0x1c, 0x3c, 0xfb, 0x6c,
-	0x9b, 0x6d, 0x1b, 0x1f, 0xe1, 0xd3, 0xcf, 0xe6, 0x33, 0xf7, 0xf4, 0x17, 0xf7, 0x9f, 0x3e, 0x99,
-	0x4b, 0x3a, 0x8b, 0x1c, 0x8c, 0xbc, 0x06, 0x22, 0x18, 0x0e, 0x84, 0x67, 0x19, 0x53, 0xf1, 0x25,
-	0x33, 0x74, 0xea, 0xd7, 0xfb, 0xb5, 0x41, 0x27, 0xea, 0xd9, 0xc8, 0x45, 0x11, 0xf8, 0xc5, 0xfa,
-	0x83, 0x7d, 0x00, 0xc5, 0xae, 0x62, 0x37, 0xa5, 0x00, 0x76, 0xa7, 0x2c, 0x71, 0x15, 0x63, 0x9e,
-	0xe2, 0x9d, 0x3b, 0x91, 0x67, 0x9d, 0x67, 0xd6, 0x37, 0x4e, 0x83, 0x11, 0x6c, 0x25, 0x69, 0xaa,
-	0x98, 0xd6, 0xe4, 0x15, 0x34, 0xed, 0xd1, 0xaf, 0xf5, 0x1b, 0x03, 0x2f, 0xec, 0xb9, 0x89, 0x2e,
-	0x18, 0x53, 0xb1, 0xf5, 0x47, 0x18, 0x0d, 0x00, 0x3a, 0xf6, 0x6f, 0xac, 0xd8, 0x55, 0xd0, 0x85,
-	0x9d, 0x94, 0x6b, 0x2a, 0x85, 0x60, 0xd4, 0xb0, 0x34, 0xf8, 0x02, 0x9a, 0x0b, 0x2e, 0x32, 0xf2,
-	0x09, 0xb4, 0xa7, 0x8c, 0x67, 0x53, 0x83, 0x37, 0x36, 0x23, 0x67, 0x61, 0x5c, 0x7e, 0x24, 0xfe,
-	0x23, 0x6c, 0x5d, 0xdb, 0xdb, 0xe8, 0x8c, 0xf4, 0xc1, 0x1b, 0xeb, 0x77, 0x52, 0x68, 0x26, 0xf4,
-	0x52, 0x23, 0xae, 0x13, 0x55, 0x5d, 0xc1, 0x3f, 0x75, 0x44, 0x6b, 0x2e, 0x05, 0xf1, 0x57, 0x47,
-	0x44, 0xee, 0x46, 0xab, 0xc8, 0x1e, 0x74, 0x34, 0x53, 0xd7, 0x9c, 0x32, 0x8d, 0x53, 0x6b, 0x46,
-	0x2b, 0x9b, 0x7c, 0x06, 0xdb, 0x86, 0xe7, 0x4c, 0x9b, 0x24, 0x5f, 0xf8, 0x8d, 0x7e, 0x6d, 0xd0,
-	0x88, 0xd6, 0x0e, 0xcc, 0xbc, 0x15, 0xf4, 0x77, 0xa9, 0x8c, 0xdf, 0xc4, 0xa2, 0x2b, 0xdb, 0xc6,
-	0xa8, 0x14, 0x1a, 0x63, 0xad, 0x22, 0x56, 0xda, 0xa4, 0x07, 0x0d, 0x9a, 0x2c, 0xfc, 0x36, 0xce,
-	0xda, 0x1e, 0x2d, 0xff, 0x84, 0x14, 0x94, 0xf9, 0x5b, 0xd8, 0x40, 0x61, 0xd8, 0x17, 0x6a, 0x93,
-	0x28, 0x73, 0x5e, 0x4c, 0xa2, 0x83, 0xb1, 0xaa, 0xcb, 0xe6, 0x29, 0x36, 0x4f, 0x6e, 0xfd, 0x6d,
-	0xbc, 0xa2, 0x30, 0xee, 0x4e, 0x06, 0xee, 0x4d, 0x86, 0x0c, 0x81, 0x44, 0x4b, 0x21, 0xb8, 0xc8,
-	0xde, 0xc9, 0x94, 0xfd, 0xe1, 0x06, 0xe3, 0xf5, 0x6b, 0x83, 0xed, 0xe8, 0x81, 0x48, 0x70, 0x06,
-	0x7e, 0x49, 0x67, 0x3a, 0x65, 0x74, 0xb6, 0x90, 0x5c, 0x98, 0x78, 0x92, 0x18, 0x3a, 0xb5, 0x3d,
-	0x60, 0x4b, 0xee, 0x3f, 0x55, 0x18, 0xf6, 0x8d, 0x4c, 0xa4, 0x6e, 0xa0, 0xf6, 0x18, 0x44, 0xeb,
-	0x1a, 0x72, 0x69, 0x62, 0x79, 0x19, 0xab, 0x44, 0x64, 0xcc, 0x6e, 0x01, 0xf9, 0x14, 0x3a, 0x98,
-	0xb6, 0xa6, 0xe0, 0x16, 0xda, 0xe3, 0x94, 0xbc, 0x84, 0x6d, 0x93, 0xa8, 0x8c, 0x61, 0xac, 0x8e,
-	0xb1, 0x4e, 0xe1, 0x18, 0xa7, 0xc1, 0xaf, 0xf0, 0xb4, 0xac, 0x59, 0x50, 0xb8, 0x68, 0xe9, 0x23,
-	0xe5, 0x9e, 0x43, 0x9b, 0x89, 0x74, 0x5d, 0xab, 0xc5, 0x44, 0x3a, 0x4e, 0x83, 0x1f, 0x1e, 0xd8,
-	0xcd, 0x0a, 0xb6, 0x56, 0xc5, 0xee, 0x81, 0x4f, 0xe7, 0x2c, 0x51, 0x9b, 0xcf, 0xd0, 0x26, 0x31,
-	0x2c, 0xf8, 0xb7, 0x05, 0x3b, 0xa8, 0x4c, 0x97, 0x4c, 0xe1, 0x86, 0x0d, 0xa1, 0x99, 0xeb, 0xec,
-	0xc0, 0x2d, 0xb3, 0xef, 0x56, 0xe6, 0x9e, 0xe2, 0x9d, 0x3f, 0x8a, 0x10, 0x47, 0xbe, 0x46, 0x7c,
-	0x88, 0xdd, 0x79, 0xe1, 0xee, 0x86, 0x68, 0x39, 0x50, 0x48, 0x06, 0x08, 0x3a, 0x44, 0x46, 0x7a,
-	0x2b, 0x65, 0xab, 0xa8, 0x88, 0x43, 0x1e, 0x92, 0xef, 0x10, 0x79, 0x84, 0xf4, 0xf4, 0xc2, 0x27,
-	0x0e, 0xb9, 0x56, 0x00, 0x07, 0x3c, 0xb2, 0xab, 0x9d, 0xeb, 0xec, 0x18, 0xb9, 0xea, 0x85, 0x5d,
-	0x07, 0x74, 0x8b, 0xef, 0x50, 0xc7, 0xe4, 0x1b, 0x44, 0x9d, 0x20, 0x75, 0xbd, 0xf0, 0x71, 0x05,
-	0x65, 0xb7, 0xdd, 0xc1, 0x4e, 0xc8, 0xf7, 0x08, 0x3b, 0x45, 0x36, 0x7b, 0xe1, 0x53, 0x07, 0xab,
-	0x0a, 0x81, 0x83, 0x9e, 0x92, 0xaf, 0x10, 0xfa, 0x06, 0xc9, 0xed, 0x85, 0x5e, 0x29, 0x29, 0x5c,
-	0x94, 0xad, 0xbd, 0x71, 0x90, 0xb7, 0xc8, 0xf1, 0x0a, 0x44, 0xae, 0x20, 0x6f, 0xc9, 0xb7, 0xd0,
-	0xb2, 0xd3, 0xdb, 0x47, 0xae, 0xaf, 0xdb, 0x77, 0x52, 0x71, 0xfe, 0x28, 0x2a, 0xc2, 0x25, 0xee,
-	0x00, 0xa9, 0xbe, 0x81, 0xb3, 0x34, 0x2f, 0x71, 0x07, 0xe4, 0xb4, 0xc0, 0x85, 0xfe, 0x0e, 0xe2,
-	0xbe, 0x5c, 0xcf, 0xed, 0xc1, 0x1d, 0x28, 0x13, 0xc3, 0x32, 0xf1, 0xd0, 0xdf, 0x7d, 0x30, 0xf1,
-	0x2e, 0xf1, 0xcb, 0xc4, 0x43, 0x12, 0x16, 0x89, 0x47, 0x7e, 0x17, 0x13, 0xf7, 0xee, 0x24, 0x56,
-	0xd8, 0x5d, 0xe6, 0x1c, 0x91, 0xfd, 0x22, 0xe7, 0xd8, 0x7f, 0xbc, 0x41, 0xae, 0x7b, 0x44, 0x2e,
-	0x33, 0x8e, 0xcb, 0xf6, 0x4e, 0xfc, 0xde, 0x46, 0x7b, 0xff, 0x47, 0xe7, 0x32, 0xf1, 0xe4, 0xac,
-	0x05, 0x8d, 0x5c, 0x67, 0xc1, 0x5f, 0x35, 0xd8, 0x5e, 0xc9, 0x3d, 0x21, 0xd0, 0xbc, 0xe0, 0x79,
-	0xf1, 0x81, 0x6d, 0x44, 0x78, 0xb6, 0xba, 0xf7, 0xe1, 0x8e, 0x9a, 0x96, 0xb6, 0x15, 0xf5, 0xf1,
-	0xe2, 0x67, 0xfb, 0x01, 0x69, 0xe0, 0x3e, 0x39, 0xcb, 0xd6, 0xa9, 0x68, 0x28, 0x9e, 0xc9, 0x2b,
-	0xd8, 0x5d, 0xc9, 0x55, 0x45, 0x44, 0x37, 0x9d, 0xa4, 0x0b, 0xf5, 0xf1, 0x7b, 0x64, 0x63, 0x33,
-	0xaa, 0x8f, 0xdf, 0x07, 0xaf, 0xe1, 0x19, 0x2d, 0x01, 0x31, 0xbb, 0x31, 0x2a, 0x89, 0xd3, 0xc4,
-	0x24, 0x56, 0xa3, 0x26, 0x76, 0xdd, 0x9c, 0xf6, 0x17, 0xc6, 0xd9, 0xd1, 0x9f, 0x61, 0xc6, 0xcd,
-	0x74, 0x39, 0x19, 0x52, 0x99, 0x8f, 0xa8, 0xd4, 0x74, 0x9a, 0x70, 0x31, 0xa2, 0x52, 0x18, 0x26,
-	0x8c, 0xd4, 0x3f, 0x65, 0x72, 0x74, 0xef, 0x37, 0xce, 0xa4, 0x8d, 0xdf, 0xe3, 0xc3, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x39, 0xf9, 0x57, 0x57, 0xff, 0x08, 0x00, 0x00,
+	// 1307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcd, 0x72, 0xdb, 0x46,
+	0x12, 0x80, 0x0d, 0xf1, 0x47, 0x54, 0x83, 0x94, 0xa8, 0x91, 0x7f, 0xc6, 0xf2, 0xda, 0xcb, 0xc5,
+	0x7a, 0xd7, 0x4c, 0xe2, 0x90, 0x16, 0xf4, 0x9f, 0x5b, 0x64, 0x57, 0x2c, 0x26, 0x97, 0x14, 0xe4,
+	0xca, 0x21, 0x17, 0x14, 0x08, 0x8c, 0xc0, 0x29, 0x91, 0x03, 0x7a, 0x66, 0xa8, 0x92, 0xce, 0x79,
+	0x8e, 0x3c, 0x4f, 0xee, 0x79, 0x88, 0xbc, 0x40, 0x5e, 0x20, 0x35, 0x8d, 0x01, 0x09, 0x52, 0xb2,
+	0x73, 0xc8, 0x89, 0xd3, 0xdd, 0x5f, 0xcf, 0x4f, 0xb3, 0x7f, 0x00, 0x9d, 0xa9, 0x3f, 0xed, 0x4f,
+	0x98, 0x52, 0x51, 0xca, 0xfa, 0xfa, 0x76, 0xca, 0x54, 0x7f, 0xa2, 0xd2, 0xd0, 0xac, 0x7a, 0x53,
+	0x99, 0xe9, 0x8c, 0xd4, 0x50, 0xbb, 0xfb, 0x0c, 0x25, 0xb3, 0xee, 0x6b, 0x19, 0x09, 0x15, 0xc5,
+	0x9a, 0x67, 0x22, 0x67, 0xbc, 0xef, 0x61, 0x7b, 0x28, 0xb3, 0x28, 0x89, 0x23, 0xa5, 0x43, 0xc5,
+	0xd3, 0x50, 0xcb, 0x1b, 0x72, 0x08, 0xf5, 0x0b, 0x9e, 0x7e, 0x90, 0x37, 0xd4, 0xe9, 0x38, 0x5d,
+	0xd7, 0x7f, 0xde, 0x9b, 0x6f, 0xd1, 0x53, 0x3c, 0x15, 0x2c, 0x09, 0x4b, 0x3b, 0x05, 0x16, 0xf6,
+	0x7e, 0x77, 0xa0, 0xce, 0x93, 0x70, 0xa2, 0x52, 0xf2, 0x1a, 0xd6, 0x27, 0x2a, 0x35, 0x0e, 0xb8,
+	0xc5, 0xa6, 0x4f, 0x7a, 0x46, 0x50, 0xbd, 0xdc, 0x8e, 0x42, 0x50, 0x20, 0xe4, 0x21, 0xd4, 0xae,
+	0xa3, 0xf1, 0x8c, 0xd1, 0xb5, 0x4e, 0xa5, 0xdb, 0x0c, 0x72, 0xc1, 0xfb, 0xc5, 0x81, 0x2a, 0x9a,
+	0x9f, 0xc0, 0xce, 0xd2, 0x1d, 0x87, 0xe3, 0xab, 0x90, 0x27, 0xed, 0x07, 0x84, 0xc2, 0x43, 0xc9,
+	0x3e, 0xce, 0xd8, 0x42, 0x3d, 0xbc, 0x35, 0x16, 0x87, 0x10, 0xd8, 0x2c, 0x2c, 0x3c, 0x09, 0xa3,
+	0xf8, 0xaa, 0xbd, 0x46, 0x1e, 0xc1, 0x76, 0xc2, 0x34, 0x8b, 0x75, 0x78, 0x99, 0xc9, 0x09, 0x93,
+	0x21, 0x4f, 0x54, 0xbb, 0x42, 0x9e, 0xc3, 0xd3, 0x02, 0x8d, 0xb3, 0xc9, 0x34, 0x8a, 0x75, 0xb8,
+	0xd8, 0xa9, 0xea, 0x8d, 0xc1, 0x35, 0x61, 0x31, 0x2a, 0xf3, 0xb0, 0x3e, 0x86, 0xe6, 0x6c, 0x7c,
+	0x65, 0x43, 0xf3, 0xe4, 0x6e, 0x68, 0x86, 0xe3, 0x2c, 0xbe, 0x0a, 0x2c, 0x46, 0x5e, 0x03, 0x11,
+	0x0c, 0x03, 0xc6, 0xd3, 0x94, 0xc9, 0xf0, 0x92, 0xe9, 0x78, 0x44, 0xd7, 0x3a, 0x4e, 0xb7, 0x11,
+	0xb4, 0x8d, 0xe5, 0x43, 0x6e, 0xf8, 0xce, 0xe8, 0xbd, 0x3f, 0x1d, 0xd8, 0x2a, 0xdf, 0xc2, 0x1c,
+	0xf9, 0x16, 0x5a, 0x76, 0xe7, 0x11, 0x8b, 0x12, 0x26, 0xed, 0xc9, 0x2f, 0x3e, 0x71, 0xb2, 0xa5,
+	0x82, 0x66, 0xae, 0x3c, 0x47, 0x89, 0x3c, 0x83, 0x0d, 0x35, 0xca, 0xa4, 0x09, 0x87, 0xc2, 0x30,
+	0x57, 0x83, 0x06, 0x2a, 0x06, 0x89, 0x22, 0xdf, 0x40, 0x43, 0xb2, 0x98, 0xf1, 0xa9, 0x56, 0xb4,
+	0xd2, 0xa9, 0xac, 0x6c, 0x5e, 0xfa, 0xab, 0x43, 0x8b, 0x05, 0x73, 0xfe, 0x13, 0xef, 0xab, 0xde,
+	0xff, 0x3e, 0xf3, 0x4f, 0x8b, 0x4c, 0xc4, 0x8c, 0xd6, 0x3a, 0x4e, 0xb7, 0x1a, 0xe4, 0x82, 0xf7,
+	0x1e, 0xda, 0xc5, 0x5f, 0x60, 0x1e, 0xad, 0xe5, 0x8d, 0x22, 0x4f, 0xa1, 0x91, 0x3f, 0x87, 0x27,
+	0xf8, 0xe0, 0x66, 0xb0, 0x8e, 0xf2, 0x20, 0x21, 0x14, 0xd6, 0xb9, 0x48, 0xd8, 0x0d, 0xcb, 0x5f,
+	0xd2, 0x0a, 0x0a, 0xd1, 0x93, 0xd0, 0xf8, 0x47, 0x1b, 0x90, 0x3d, 0xa8, 0x1a, 0x67, 0x1b, 0x85,
+	0xbf, 0xc9, 0x7b, 0x44, 0xbd, 0x37, 0x00, 0x92, 0x7d, 0x0c, 0x6d, 0xe2, 0x7b, 0xd0, 0x32, 0xf1,
+	0x0f, 0x57, 0x8e, 0x76, 0x8d, 0xf2, 0x2c, 0x3f, 0xde, 0xeb, 0xc3, 0x7a, 0x94, 0x24, 0x92, 0x29,
+	0x45, 0x5e, 0x42, 0xd5, 0x2c, 0xa9, 0x83, 0xe7, 0xb5, 0x6d, 0x91, 0x4c, 0x19, 0x93, 0xa1, 0xd1,
+	0x07, 0x68, 0xf5, 0x00, 0x1a, 0xe6, 0x37, 0x94, 0xec, 0xa3, 0xb7, 0x09, 0xcd, 0x84, 0xab, 0x38,
+	0x13, 0x82, 0xc5, 0x9a, 0x25, 0xde, 0x0b, 0xa8, 0x4e, 0xb9, 0x48, 0xc9, 0x63, 0xa8, 0x8f, 0x18,
+	0x4f, 0x47, 0x1a, 0x4f, 0xac, 0x06, 0x56, 0x42, 0x7b, 0xf6, 0x19, 0xfb, 0x57, 0xb0, 0x7e, 0x6d,
+	0x4e, 0x8b, 0xaf, 0x48, 0x07, 0xdc, 0x81, 0x7a, 0x9b, 0x09, 0xc5, 0x84, 0x9a, 0x29, 0xe4, 0x1a,
+	0x41, 0x59, 0xe5, 0xfd, 0x56, 0x41, 0x5a, 0xf1, 0x4c, 0x10, 0x3a, 0x5f, 0x22, 0xd9, 0x0a, 0xe6,
+	0x96, 0x5d, 0x68, 0x28, 0x26, 0xaf, 0x79, 0x8c, 0xf1, 0x75, 0x30, 0xd5, 0xac, 0x4c, 0xfe, 0x05,
+	0x1b, 0x9a, 0x4f, 0x98, 0xd2, 0xd1, 0x64, 0x4a, 0x2b, 0x1d, 0xa7, 0x5b, 0x09, 0x16, 0x0a, 0xf4,
+	0xbc, 0x15, 0xf1, 0x8f, 0x99, 0xd4, 0x98, 0x42, 0xad, 0x60, 0x2e, 0x1b, 0x5b, 0x9c, 0x09, 0x85,
+	0xb6, 0x5a, 0x6e, 0x2b, 0x64, 0xd2, 0x86, 0x4a, 0x1c, 0x4d, 0x69, 0x1d, 0x63, 0x6d, 0x96, 0x8b,
+	0x44, 0x5b, 0x2f, 0x25, 0x9a, 0x79, 0xa1, 0xd2, 0x91, 0xd4, 0xe7, 0x79, 0x24, 0x1a, 0x68, 0x2b,
+	0xab, 0x8c, 0x9f, 0x64, 0xe3, 0xe8, 0x96, 0x6e, 0xe0, 0x11, 0xb9, 0xb0, 0x1a, 0x19, 0xb8, 0x13,
+	0x19, 0xd2, 0x03, 0x12, 0xcc, 0x84, 0xe0, 0x22, 0x7d, 0x9b, 0x25, 0xec, 0x27, 0x1b, 0x18, 0xb7,
+	0xe3, 0x74, 0x37, 0x82, 0x7b, 0x2c, 0x26, 0x7a, 0x22, 0x4b, 0xd8, 0x0f, 0xec, 0x96, 0x36, 0xf3,
+	0xe4, 0xb4, 0xa2, 0x89, 0x90, 0xc9, 0xb5, 0x48, 0xcf, 0x24, 0xa3, 0x2d, 0xb4, 0x2d, 0x14, 0xc4,
+	0x83, 0xe6, 0x75, 0x34, 0xe6, 0x49, 0xa4, 0x33, 0x69, 0x9c, 0x37, 0x11, 0x58, 0xd2, 0x2d, 0x31,
+	0x17, 0x3c, 0xa5, 0x5b, 0x2b, 0xcc, 0x05, 0x4f, 0xbd, 0x33, 0xa0, 0xf3, 0xae, 0x37, 0x62, 0xf1,
+	0xd5, 0x34, 0xe3, 0x42, 0x87, 0xc3, 0xc8, 0x16, 0x29, 0x86, 0xc4, 0x66, 0x4a, 0x2e, 0x98, 0x18,
+	0x33, 0x91, 0xd8, 0x3f, 0xd4, 0x2c, 0xbd, 0x60, 0xb1, 0x47, 0x36, 0xd3, 0x61, 0x76, 0x19, 0xca,
+	0x48, 0xa4, 0xcc, 0xb4, 0x18, 0x53, 0x7d, 0xe8, 0x56, 0xaa, 0x3e, 0x94, 0x07, 0x89, 0x69, 0x45,
+	0x3a, 0x92, 0x29, 0x43, 0xdb, 0x1a, 0xda, 0x1a, 0xb9, 0x62, 0x90, 0x78, 0xef, 0x61, 0x67, 0xd1,
+	0x0a, 0x4c, 0x09, 0xe5, 0x57, 0xfa, 0xcc, 0x76, 0x8f, 0xa0, 0xce, 0x44, 0xb2, 0xd8, 0xab, 0xc6,
+	0x44, 0x32, 0x48, 0xbc, 0x2f, 0xef, 0xe9, 0xf6, 0x25, 0xd6, 0x29, 0xb3, 0xbb, 0x40, 0xe3, 0x31,
+	0x8b, 0xe4, 0xf2, 0x33, 0x94, 0x8e, 0x34, 0xf3, 0xfe, 0xa8, 0x43, 0x13, 0x8b, 0xfe, 0x92, 0x49,
+	0xac, 0xf0, 0x1e, 0x54, 0x27, 0x2a, 0xdd, 0xb3, 0x5d, 0x98, 0xda, 0x92, 0xbd, 0x33, 0x44, 0xcf,
+	0x1f, 0x04, 0xc8, 0x91, 0xff, 0x22, 0xef, 0xe3, 0xed, 0x5c, 0xbf, 0xb5, 0x34, 0x07, 0x2d, 0xe4,
+	0x93, 0x2e, 0x42, 0xfb, 0x58, 0x11, 0xee, 0x7c, 0x58, 0x96, 0x06, 0x8f, 0x25, 0xf7, 0xc9, 0x2b,
+	0x24, 0x0f, 0xb0, 0x3c, 0x5c, 0x7f, 0xdb, 0x92, 0x8b, 0x0e, 0x64, 0xc1, 0x03, 0xd3, 0x5a, 0x26,
+	0x2a, 0x3d, 0xc4, 0x5a, 0x71, 0xfd, 0x4d, 0x0b, 0xda, 0xc6, 0x63, 0xa9, 0x43, 0xf2, 0x3f, 0xa4,
+	0x8e, 0xb0, 0x74, 0x5c, 0x7f, 0xab, 0x44, 0x99, 0x6e, 0x63, 0xb1, 0x23, 0xf2, 0x05, 0x62, 0xc7,
+	0x58, 0x4d, 0xae, 0xbf, 0x63, 0xb1, 0x72, 0x23, 0xb2, 0xe8, 0x31, 0xf9, 0x0f, 0xa2, 0x27, 0x58,
+	0x5c, 0xae, 0xef, 0x16, 0x2d, 0x8d, 0x8b, 0xe2, 0x6a, 0x27, 0x16, 0x39, 0xa5, 0x1b, 0xcb, 0x48,
+	0x36, 0x47, 0x4e, 0xc9, 0xff, 0xa1, 0x66, 0xa2, 0xf7, 0x86, 0xc2, 0xd2, 0xf5, 0x6d, 0xab, 0x3a,
+	0x7f, 0x10, 0xe4, 0xe6, 0x82, 0xdb, 0xa3, 0xee, 0x2a, 0x67, 0xca, 0xac, 0xe0, 0xf6, 0xc8, 0x71,
+	0xce, 0xf9, 0x58, 0x6d, 0xae, 0xff, 0xef, 0x45, 0xdc, 0xee, 0xad, 0x81, 0xc2, 0xd1, 0x2f, 0x1c,
+	0xf7, 0x69, 0xeb, 0x5e, 0xc7, 0xd5, 0xc4, 0x2f, 0x1c, 0xf7, 0x89, 0x9f, 0x3b, 0x1e, 0x60, 0x89,
+	0xba, 0xfe, 0xee, 0x8a, 0x63, 0x29, 0xbb, 0x0b, 0x9f, 0x03, 0xf2, 0x26, 0xf7, 0x39, 0xa4, 0x5b,
+	0x4b, 0xc9, 0x75, 0x27, 0x91, 0x0b, 0x8f, 0xc3, 0xe2, 0x7a, 0x47, 0xb4, 0xbd, 0x74, 0xbd, 0x4f,
+	0xa5, 0x73, 0xe1, 0x78, 0x44, 0x7a, 0xb9, 0xe3, 0x31, 0xdd, 0x46, 0xc7, 0xc7, 0x85, 0xe3, 0xf2,
+	0xc7, 0x47, 0xc1, 0x1f, 0x93, 0x7e, 0xce, 0x9f, 0x50, 0x62, 0xbf, 0x7b, 0x56, 0x9f, 0x93, 0x8f,
+	0xdd, 0xc2, 0xe1, 0x84, 0xbc, 0xca, 0x1d, 0x4e, 0xe9, 0xce, 0x52, 0x6a, 0xad, 0x82, 0xa7, 0x67,
+	0x35, 0xa8, 0x4c, 0x54, 0xea, 0xfd, 0xea, 0xc0, 0xc6, 0x7c, 0xf0, 0x11, 0x02, 0xd5, 0x0f, 0x7c,
+	0x92, 0x7f, 0x3d, 0x56, 0x02, 0x5c, 0x9b, 0x09, 0x70, 0xb1, 0x32, 0x57, 0x0a, 0xd9, 0x8c, 0xb7,
+	0xc1, 0xf4, 0x5b, 0x33, 0x4a, 0x2b, 0x58, 0xd9, 0x56, 0x32, 0xfb, 0x94, 0xa6, 0x09, 0xae, 0xc9,
+	0x4b, 0x68, 0xcd, 0x1b, 0x77, 0x69, 0x9c, 0x2c, 0x2b, 0xc9, 0x26, 0xac, 0x0d, 0xde, 0x61, 0x5d,
+	0x54, 0x83, 0xb5, 0xc1, 0x3b, 0xef, 0x35, 0x3c, 0x8c, 0x0b, 0x20, 0x64, 0x37, 0x5a, 0x46, 0x61,
+	0x12, 0xe9, 0xc8, 0x74, 0xcb, 0xa1, 0x29, 0x7c, 0x3b, 0x05, 0x73, 0xe1, 0xec, 0xe0, 0x67, 0x3f,
+	0xe5, 0x7a, 0x34, 0x1b, 0x9a, 0x88, 0xf6, 0xe3, 0x4c, 0xc5, 0xa3, 0x88, 0x8b, 0x7e, 0x9c, 0x09,
+	0xcd, 0x84, 0xce, 0xd4, 0xd7, 0x69, 0xd6, 0xbf, 0xf3, 0x01, 0x3f, 0xac, 0xe3, 0xf7, 0xc6, 0xfe,
+	0x5f, 0x03, 0x00, 0xf6, 0x5f, 0x6a, 0x01, 0xdc, 0x0b, 0x00, 0x00,
 }
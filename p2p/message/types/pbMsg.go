@@ -49,6 +49,12 @@ func (this *TransferMsg) CmdType() ( res string) {
 		res = common.DETECT_FORMER_IDS_TYPE
 	case *TransferMsg_Msg16:
 		res = common.CLEAR_OUT_OF_RABGE_STATE
+	case *TransferMsg_Msg17:
+		res = common.COMPACT_BLOCK_TYPE
+	case *TransferMsg_Msg18:
+		res = common.REQ_BLOCK_TRXS_TYPE
+	case *TransferMsg_Msg19:
+		res = common.BLOCK_TRXS_TYPE
 	default:
 		res = "unknow msg"
 	}
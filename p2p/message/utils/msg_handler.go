@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"bytes"
+	"errors"
 	"github.com/coschain/contentos-go/common/constants"
 	"github.com/coschain/gobft/message"
+	"fmt"
 	"math"
 	"net"
 	"strconv"
@@ -14,11 +17,13 @@ import (
 	"github.com/coschain/contentos-go/common"
 	"github.com/coschain/contentos-go/iservices"
 	msgCommon "github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/p2p/link"
 	"github.com/coschain/contentos-go/p2p/message/msg_pack"
 	msgTypes "github.com/coschain/contentos-go/p2p/message/types"
 	"github.com/coschain/contentos-go/p2p/net/protocol"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/coschain/contentos-go/p2p/peer"
+	"github.com/golang/protobuf/proto"
 )
 
 const (
@@ -29,6 +34,7 @@ const (
 
 type MsgHandler struct {
 	blockCache        map[common.BlockID]common.ISignedBlock
+	compactBlocks     map[common.BlockID]*pendingCompactBlock
 
 	blockQueryLimiter      *msgCommon.RateLimiter
 	checkpointQueryLimiter *msgCommon.RateLimiter
@@ -50,6 +56,7 @@ func NewMsgHandler() *MsgHandler {
 	}
 	return &MsgHandler{
 		blockCache:             blockCache,
+		compactBlocks:          make(map[common.BlockID]*pendingCompactBlock),
 		blockQueryLimiter:      msgCommon.NewRateLimiter(MaxBlockQueriesPerSecond),
 		checkpointQueryLimiter: msgCommon.NewRateLimiter(MaxCheckPointQueriesPerSecond),
 		blobSizeLimiter:        msgCommon.NewRateLimiter(uint32(maxBytesPerSecond)),
@@ -174,6 +181,10 @@ func (p *MsgHandler) BlockSyncHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, arg
 	var block = raw.Msg.(*msgTypes.TransferMsg_Msg3).Msg3
 
 	log := p2p.GetLog()
+	if !validSigBlk(block.SigBlk) {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_INVALID_BLOCK, "invalid block")
+		return
+	}
 	log.Info("[p2p] receive a SignedBlock msg, block number :   ", block.SigBlk.Id().BlockNum())
 	blkNum := block.SigBlk.Id().BlockNum()
 
@@ -184,24 +195,47 @@ func (p *MsgHandler) BlockSyncHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, arg
 	}
 	remotePeer.SetLastSeenBlkNum(blkNum)
 
+	if !p.pushBlock(p2p, block.SigBlk) {
+		return
+	}
+
+	go func(){
+		maybeTriggerFetch(p2p, log, remotePeer, block)
+	}()
+}
+
+//pushBlock caches the block and pushes it to consensus asynchronously
+func (p *MsgHandler) pushBlock(p2p p2p.P2P, block *prototype.SignedBlock) bool {
 	p.Lock()
-	p.blockCache[block.SigBlk.Id()] = block.SigBlk
+	p.blockCache[block.Id()] = block
 	p.Unlock()
 
 	s, err := p2p.GetService(iservices.ConsensusServerName)
 	if err != nil {
-		log.Error("[p2p] can't get other service, service name: ", iservices.ConsensusServerName)
-		return
+		p2p.GetLog().Error("[p2p] can't get other service, service name: ", iservices.ConsensusServerName)
+		return false
 	}
 	ctrl := s.(iservices.IConsensus)
 
 	go func() {
 		p.blockHandle(ctrl)
 	}()
+	return true
+}
 
-	go func(){
-		maybeTriggerFetch(p2p, log, remotePeer, block)
-	}()
+//validSigBlk checks the integrity and producer signature of a block received from peers
+func validSigBlk(blk *prototype.SignedBlock) bool {
+	if blk == nil || blk.SignedHeader == nil || blk.SignedHeader.Header == nil ||
+		blk.SignedHeader.Header.TransactionMerkleRoot == nil ||
+		blk.SignedHeader.BlockProducerSignature == nil {
+		return false
+	}
+	for _, trx := range blk.Transactions {
+		if trx == nil || trx.SigTrx == nil {
+			return false
+		}
+	}
+	return blk.Validate()
 }
 
 func (p *MsgHandler) blockHandle(ctrl iservices.IConsensus) {
@@ -268,12 +302,20 @@ func (p *MsgHandler) TransactionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, a
 	log := p2p.GetLog()
 	//log.Info("receive a SignedTransaction msg: ", trn)
 
-	id, _ := trn.SigTrx.Id()
 	remotePeer := p2p.GetPeer(data.Id)
 	if remotePeer == nil {
 		log.Error("[p2p] peer is not exist: ", data.Addr)
 		return
 	}
+	if remotePeer.TrxRateLimiter.Request(1, true) == 0 {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_TRX_FLOOD, "too many transactions")
+		return
+	}
+	if err := trn.SigTrx.Validate(); err != nil {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_INVALID_TRX, fmt.Sprintf("invalid transaction: %v", err))
+		return
+	}
+	id, _ := trn.SigTrx.Id()
 	if remotePeer.HasTrx(id.Hash) {
 		//log.Info("[p2p] we alerady have this transaction, transaction hash: ", id.Hash)
 		return
@@ -291,6 +333,200 @@ func (p *MsgHandler) TransactionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, a
 	}()
 }
 
+//pendingCompactBlock is a compact block waiting for its missing transactions
+type pendingCompactBlock struct {
+	block            *prototype.SignedBlock
+	needTriggerFetch bool
+	created          time.Time
+}
+
+// CompactBlockHandle reconstructs the block from local pending transactions,
+// and requests the missing ones from peer
+func (p *MsgHandler) CompactBlockHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
+	var raw = data.Payload.(*msgTypes.TransferMsg)
+	var cmpct = raw.Msg.(*msgTypes.TransferMsg_Msg17).Msg17
+
+	log := p2p.GetLog()
+	remotePeer := p2p.GetPeer(data.Id)
+	if remotePeer == nil {
+		log.Error("[p2p] peer is not exist: ", data.Addr)
+		return
+	}
+	if cmpct.SignedHeader == nil || cmpct.SignedHeader.Header == nil || len(cmpct.ShortIds) != len(cmpct.Receipts) {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_MALFORMED_MSG, "invalid compact block")
+		return
+	}
+
+	block := &prototype.SignedBlock{
+		SignedHeader: cmpct.SignedHeader,
+		Transactions: make([]*prototype.TransactionWrapper, len(cmpct.ShortIds)),
+	}
+	blkId := block.Id()
+	remotePeer.SetLastSeenBlkNum(blkId.BlockNum())
+
+	s, err := p2p.GetService(iservices.ConsensusServerName)
+	if err != nil {
+		log.Error("[p2p] can't get other service, service name: ", iservices.ConsensusServerName)
+		return
+	}
+	if s.(iservices.IConsensus).HasBlock(blkId) {
+		return
+	}
+	s, err = p2p.GetService(iservices.TxPoolServerName)
+	if err != nil {
+		log.Error("[p2p] can't get other service, service name: ", iservices.TxPoolServerName)
+		return
+	}
+	trxs := s.(iservices.ITrxPool).LookupTrxs(prototype.NewShortTrxIdKey(blkId.Data[:], cmpct.Nonce), cmpct.ShortIds)
+
+	var missing []uint32
+	for i, trx := range trxs {
+		block.Transactions[i] = &prototype.TransactionWrapper{SigTrx: trx, Receipt: cmpct.Receipts[i]}
+		if trx == nil {
+			missing = append(missing, uint32(i))
+		}
+	}
+	if len(missing) == 0 {
+		p.finishCompactBlock(p2p, remotePeer, block, cmpct.NeedTriggerFetch)
+		return
+	}
+
+	log.Debugf("[p2p] compact block %d misses %d of %d trxs", blkId.BlockNum(), len(missing), len(trxs))
+	p.Lock()
+	p.addPendingCompactBlock(blkId, block, cmpct.NeedTriggerFetch)
+	p.Unlock()
+	err = p2p.Send(remotePeer, msgpack.NewRequestBlkTrxs(blkId.Data[:], missing), false)
+	if err != nil {
+		log.Error("[p2p] send message error: ", err)
+	}
+}
+
+//addPendingCompactBlock adds a compact block waiting for missing transactions, and evicts stale ones
+func (p *MsgHandler) addPendingCompactBlock(blkId common.BlockID, block *prototype.SignedBlock, needTriggerFetch bool) {
+	now := time.Now()
+	var oldestId common.BlockID
+	var oldest time.Time
+	for id, pending := range p.compactBlocks {
+		if now.Sub(pending.created) > msgCommon.PENDING_COMPACT_BLOCK_TIMEOUT*time.Second {
+			delete(p.compactBlocks, id)
+		} else if oldest.IsZero() || pending.created.Before(oldest) {
+			oldestId, oldest = id, pending.created
+		}
+	}
+	if len(p.compactBlocks) >= msgCommon.MAX_PENDING_COMPACT_BLOCKS {
+		delete(p.compactBlocks, oldestId)
+	}
+	p.compactBlocks[blkId] = &pendingCompactBlock{block: block, needTriggerFetch: needTriggerFetch, created: now}
+}
+
+//finishCompactBlock pushes the reconstructed block if it's valid, or requests the full block otherwise.
+//either way, it goes on fetching the next block batch if the compact block asks to.
+func (p *MsgHandler) finishCompactBlock(p2p p2p.P2P, remotePeer *peer.Peer, block *prototype.SignedBlock, needTriggerFetch bool) {
+	defer func() {
+		go func() {
+			maybeTriggerFetch(p2p, p2p.GetLog(), remotePeer, &msgTypes.SigBlkMsg{SigBlk: block, NeedTriggerFetch: needTriggerFetch})
+		}()
+	}()
+	if validSigBlk(block) {
+		p.pushBlock(p2p, block)
+		return
+	}
+
+	// short id collisions or bad transactions, fall back to the full block
+	blkId := block.Id()
+	p2p.GetLog().Warnf("[p2p] failed to reconstruct compact block %d, request the full block", blkId.BlockNum())
+	var reqmsg msgTypes.TransferMsg
+	reqdata := new(msgTypes.IdMsg)
+	reqdata.Msgtype = msgTypes.IdMsg_request_sigblk_by_id
+	reqdata.Value = append(reqdata.Value, blkId.Data[:])
+	reqmsg.Msg = &msgTypes.TransferMsg_Msg2{Msg2: reqdata}
+	if err := p2p.Send(remotePeer, &reqmsg, false); err != nil {
+		p2p.GetLog().Error("[p2p] send message error: ", err)
+	}
+}
+
+// RequestBlkTrxsHandle handles the request for transactions of a compact block
+func (p *MsgHandler) RequestBlkTrxsHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
+	var raw = data.Payload.(*msgTypes.TransferMsg)
+	var req = raw.Msg.(*msgTypes.TransferMsg_Msg18).Msg18
+
+	log := p2p.GetLog()
+	remotePeer := p2p.GetPeer(data.Id)
+	if remotePeer == nil {
+		log.Error("[p2p] peer is not exist: ", data.Addr)
+		return
+	}
+	if len(req.BlockId) != prototype.Size {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_MALFORMED_MSG, "invalid block id")
+		return
+	}
+	if p.blockQueryLimiter.Request(1, false) == 0 {
+		return
+	}
+
+	s, err := p2p.GetService(iservices.ConsensusServerName)
+	if err != nil {
+		log.Error("[p2p] can't get other service, service name: ", iservices.ConsensusServerName)
+		return
+	}
+	var blkId common.BlockID
+	copy(blkId.Data[:], req.BlockId)
+	IsigBlk, err := s.(iservices.IConsensus).FetchBlock(blkId)
+	if err != nil {
+		log.Error("[p2p] can't get IsigBlk from consensus, block number: ", blkId.BlockNum(), " error: ", err)
+		return
+	}
+	sigBlk := IsigBlk.(*prototype.SignedBlock)
+
+	trxs := make([]*prototype.SignedTransaction, 0, len(req.Indexes))
+	for _, idx := range req.Indexes {
+		if int(idx) >= len(sigBlk.Transactions) {
+			p2p.PunishPeer(data.Addr, msgCommon.PENALTY_MALFORMED_MSG, "invalid trx index")
+			return
+		}
+		trxs = append(trxs, sigBlk.Transactions[idx].SigTrx)
+	}
+	err = p2p.Send(remotePeer, msgpack.NewBlkTrxs(req.BlockId, req.Indexes, trxs), false)
+	if err != nil {
+		log.Error("[p2p] send message error: ", err)
+	}
+}
+
+// BlkTrxsHandle fills the missing transactions of a compact block
+func (p *MsgHandler) BlkTrxsHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
+	var raw = data.Payload.(*msgTypes.TransferMsg)
+	var blkTrxs = raw.Msg.(*msgTypes.TransferMsg_Msg19).Msg19
+
+	log := p2p.GetLog()
+	remotePeer := p2p.GetPeer(data.Id)
+	if remotePeer == nil {
+		log.Error("[p2p] peer is not exist: ", data.Addr)
+		return
+	}
+	if len(blkTrxs.BlockId) != prototype.Size || len(blkTrxs.Indexes) != len(blkTrxs.Trxs) {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_MALFORMED_MSG, "invalid block trxs")
+		return
+	}
+	var blkId common.BlockID
+	copy(blkId.Data[:], blkTrxs.BlockId)
+
+	p.Lock()
+	pending := p.compactBlocks[blkId]
+	delete(p.compactBlocks, blkId)
+	p.Unlock()
+	if pending == nil {
+		return
+	}
+
+	block := pending.block
+	for i, idx := range blkTrxs.Indexes {
+		if int(idx) < len(block.Transactions) && block.Transactions[idx].SigTrx == nil {
+			block.Transactions[idx].SigTrx = blkTrxs.Trxs[i]
+		}
+	}
+	p.finishCompactBlock(p2p, remotePeer, block, pending.needTriggerFetch)
+}
+
 // VersionHandle handles version handshake protocol from peer
 func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
 	var raw = data.Payload.(*msgTypes.TransferMsg)
@@ -339,6 +575,36 @@ func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args
 		}
 	}
 
+	peerLink := remotePeer.SyncLink
+	if version.IsConsensus {
+		peerLink = remotePeer.ConsLink
+	}
+	// legacy peers not upgraded to the secure handshake have no node keys, and are trusted as before on sync links
+	// if allowed. consensus links are never trusted without node keys.
+	var validatorKey *prototype.PublicKeyType
+	if peerLink.GetRemoteKey() != nil || version.IsConsensus || !ctx.Config().P2P.AllowLegacyPeers {
+		if err := verifyVersion(version, peerLink.GetRemoteKey(), peerLink.GetSession()); err != nil {
+			log.Warnf("[p2p] invalid version from %s: %v", data.Addr, err)
+			p2p.RemoveFromInConnRecord(remotePeer.GetAddr())
+			p2p.RemoveFromOutConnRecord(remotePeer.GetAddr())
+			p2p.RemoveFromConnectingList(remotePeer.GetAddr())
+			p2p.RemovePeerSyncAddress(remotePeer.GetAddr())
+			remotePeer.CloseSync()
+			remotePeer.CloseCons()
+			p2p.PunishPeer(data.Addr, msgCommon.PENALTY_BAD_HANDSHAKE, "invalid version")
+			return
+		}
+		if version.IsConsensus {
+			validatorKey, err = verifyValidator(version, peerLink.GetRemoteKey(), peerLink.GetSession())
+			if err != nil || !p2p.IsValidatorKey(validatorKey) {
+				log.Warnf("[p2p] consensus link from a non-validator node %s: %v", data.Addr, err)
+				remotePeer.CloseCons()
+				return
+			}
+		}
+	}
+	session := peerLink.GetSession()
+
 	//service, err := p2p.GetService(iservices.ConsensusServerName)
 	//if err != nil {
 	//	log.Error("[p2p] can't get other service, service name: ", iservices.ConsensusServerName)
@@ -395,12 +661,14 @@ func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args
 			version.Services, version.SyncPort,
 			version.ConsPort, version.Nonce,
 			version.Relay, version.StartHeight, version.RunningCodeVersion)
+		remotePeer.SetCap(version.Cap)
+		remotePeer.SetValidatorKey(validatorKey)
 
 		var msg msgTypes.Message
 		if s == msgCommon.INIT {
 			remotePeer.SetConsState(msgCommon.HAND_SHAKE)
 			//msg = msgpack.NewVersion(p2p, true, ctrl.GetHeadBlockId().BlockNum())
-			msg = msgpack.NewVersion(p2p, true, uint64(0), ctx.Config().P2P.RunningCodeVersion)
+			msg = msgpack.NewVersion(p2p, true, uint64(0), ctx.Config().P2P.RunningCodeVersion, session)
 		} else if s == msgCommon.HAND {
 			remotePeer.SetConsState(msgCommon.HAND_SHAKED)
 			msg = msgpack.NewVerAck(true)
@@ -481,6 +749,7 @@ func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args
 			version.Services, version.SyncPort,
 			version.ConsPort, version.Nonce,
 			version.Relay, version.StartHeight, version.RunningCodeVersion)
+		remotePeer.SetCap(version.Cap)
 		remotePeer.SyncLink.SetID(version.Nonce)
 		p2p.AddNbrNode(remotePeer)
 
@@ -488,7 +757,7 @@ func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args
 		if s == msgCommon.INIT {
 			remotePeer.SetSyncState(msgCommon.HAND_SHAKE)
 			//msg = msgpack.NewVersion(p2p, false, ctrl.GetHeadBlockId().BlockNum())
-			msg = msgpack.NewVersion(p2p, false, uint64(0), ctx.Config().P2P.RunningCodeVersion)
+			msg = msgpack.NewVersion(p2p, false, uint64(0), ctx.Config().P2P.RunningCodeVersion, session)
 		} else if s == msgCommon.HAND {
 			remotePeer.SetSyncState(msgCommon.HAND_SHAKED)
 			msg = msgpack.NewVerAck(false)
@@ -501,6 +770,38 @@ func (p *MsgHandler) VersionHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args
 	}
 }
 
+//verifyVersion checks that the version is signed by the node key authenticated in handshake
+func verifyVersion(version *msgTypes.Version, key *prototype.PublicKeyType, session []byte) error {
+	if key == nil || len(session) == 0 {
+		return errors.New("link not authenticated")
+	}
+	if !bytes.Equal(version.NodeKey, key.Data) {
+		return errors.New("node key mismatch")
+	}
+	unsigned := *version
+	unsigned.Signature = nil
+	buf, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	if !link.VerifyNodeSig(key, session, buf, version.Signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+//verifyValidator checks the binding of the node key signed by the validator, and returns the validator key
+func verifyValidator(version *msgTypes.Version, nodeKey *prototype.PublicKeyType, session []byte) (*prototype.PublicKeyType, error) {
+	if len(version.ValidatorKey) == 0 {
+		return nil, errors.New("no validator key")
+	}
+	validatorKey := prototype.PublicKeyFromBytes(version.ValidatorKey)
+	if !link.VerifyValidatorBinding(validatorKey, session, nodeKey, version.ValidatorSig) {
+		return nil, errors.New("invalid validator binding")
+	}
+	return validatorKey, nil
+}
+
 // VerAckHandle handles the version ack from peer
 func (p *MsgHandler) VerAckHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
 	var raw = data.Payload.(*msgTypes.TransferMsg)
@@ -613,6 +914,9 @@ func (p *MsgHandler) AddrHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...
 func (p *MsgHandler) DisconnectHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ...interface{}) {
 	log := p2p.GetLog()
 	log.Info("[p2p] receive disconnect message ", data.Addr, " ", data.Id)
+	if data.Malformed {
+		p2p.PunishPeer(data.Addr, msgCommon.PENALTY_MALFORMED_MSG, "malformed message")
+	}
 
 	p2p.RemoveFromInConnRecord(data.Addr)
 	p2p.RemoveFromOutConnRecord(data.Addr)
@@ -671,7 +975,11 @@ func (p *MsgHandler) IdMsgHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ..
 		if !ctrl.HasBlock(blkId) {
 			var reqmsg msgTypes.TransferMsg
 			reqdata := new(msgTypes.IdMsg)
+			// compact blocks are only requested from peers announcing the capability
 			reqdata.Msgtype = msgTypes.IdMsg_request_sigblk_by_id
+			if remotePeer.HasCap(msgCommon.CAP_COMPACT_BLOCK) {
+				reqdata.Msgtype = msgTypes.IdMsg_request_compact_blk_by_id
+			}
 			var tmp []byte
 			reqdata.Value = append(reqdata.Value, tmp)
 			reqdata.Value[0] = msgdata.Value[0]
@@ -730,6 +1038,41 @@ func (p *MsgHandler) IdMsgHandle(data *msgTypes.MsgPayload, p2p p2p.P2P, args ..
 			//log.Infof("send a SignedBlock msg to   v%   data   v%\n", data.Addr, msg)
 		}
 
+	case msgTypes.IdMsg_request_compact_blk_by_id:
+		if !remotePeer.LockBusy() {
+			return
+		} else {
+			defer remotePeer.UnlockBusy()
+		}
+
+		for _, id := range msgdata.Value {
+			if len(id) > prototype.Size {
+				log.Info("[p2p] block id length beyond the limit ", prototype.Size)
+				continue
+			}
+			var blkId common.BlockID
+			copy(blkId.Data[:], id)
+			if blkId.BlockNum() == 0 {
+				continue
+			}
+
+			if p.blockQueryLimiter.Request(1, false) == 0 {
+				break
+			}
+
+			IsigBlk, err := ctrl.FetchBlock(blkId)
+			if err != nil {
+				log.Error("[p2p] can't get IsigBlk from consensus, block number: ", blkId.BlockNum(), " error: ", err)
+				return
+			}
+			msg := msgpack.NewCompactBlk(IsigBlk.(*prototype.SignedBlock), false)
+			err = p2p.Send(remotePeer, msg, false)
+			if err != nil {
+				log.Error("[p2p] send message error: ", err)
+				return
+			}
+		}
+
 	case msgTypes.IdMsg_request_id_ack:
 		//log.Infof("receive a msg from:    v%    data:   %v\n", data.Addr, *msgdata)
 		var reqmsg msgTypes.TransferMsg
@@ -63,6 +63,9 @@ func (this *MessageRouter) init(p2p p2p.P2P) {
 	this.RegisterMsgHandler(msgCommon.CLEAR_OUT_OF_RABGE_STATE, this.handler.ClearOutOfRangeStateHandle)
 
 	this.RegisterSyncMsgHandler(msgCommon.BLOCK_TYPE, this.handler.BlockSyncHandle)
+	this.RegisterSyncMsgHandler(msgCommon.COMPACT_BLOCK_TYPE, this.handler.CompactBlockHandle)
+	this.RegisterSyncMsgHandler(msgCommon.BLOCK_TRXS_TYPE, this.handler.BlkTrxsHandle)
+	this.RegisterMsgHandler(msgCommon.REQ_BLOCK_TRXS_TYPE, this.handler.RequestBlkTrxsHandle)
 
 }
 
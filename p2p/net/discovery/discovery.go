@@ -0,0 +1,572 @@
+package discovery
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+)
+
+const signatureLen = 65
+
+//packet kinds used to match replies
+const (
+	kindPing = iota
+	kindPong
+	kindNeighbors
+)
+
+var errTimeout = errors.New("[p2p] discovery reply timeout")
+
+//incoming is a received packet with its verified sender
+type incoming struct {
+	from   *net.UDPAddr
+	key    *prototype.PublicKeyType
+	id     NodeID
+	hash   []byte
+	packet *Packet
+}
+
+type replyKey struct {
+	addr string
+	kind int
+	hash string //hash of the ping for pongs
+}
+
+//Discovery finds nodes by a kademlia-style dht over udp. Packets are signed by node keys,
+//and nodes only enter the table after answering a ping, so that their endpoints are proved.
+type Discovery struct {
+	key     *prototype.PrivateKeyType
+	self    NodeID
+	magic   uint32
+	udpPort uint16
+	tcpPort uint16
+	seeds   []string //bootstrap nodes in the format of ip:port
+	tab     *Table
+	conn    *net.UDPConn
+
+	pendingLock sync.Mutex
+	pending     map[replyKey]chan *incoming
+
+	bondLock sync.Mutex
+	bonds    map[NodeID]time.Time //nodes answered our pings
+	pingedBy map[NodeID]time.Time //nodes pinged us, who are able to answer our queries
+	bonding  map[NodeID]bool      //nodes pinged us, and being pinged back by us
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+	log  *logrus.Logger
+}
+
+//NewDiscovery returns a discovery service listening on given udp port, which persists the node table in the file of given path
+func NewDiscovery(key *prototype.PrivateKeyType, magic uint32, udpPort, tcpPort uint16, seeds []string, path string, lg *logrus.Logger) (*Discovery, error) {
+	pubKey, err := key.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	self := NodeIDFromKey(pubKey)
+	return &Discovery{
+		key:      key,
+		self:     self,
+		magic:    magic,
+		udpPort:  udpPort,
+		tcpPort:  tcpPort,
+		seeds:    seeds,
+		tab:      NewTable(self, path, lg),
+		pending:  make(map[replyKey]chan *incoming),
+		bonds:    make(map[NodeID]time.Time),
+		pingedBy: make(map[NodeID]time.Time),
+		bonding:  make(map[NodeID]bool),
+		quit:     make(chan struct{}),
+		log:      lg,
+	}, nil
+}
+
+//Start starts listening and the background refresh of the node table
+func (d *Discovery) Start() error {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{Port: int(d.udpPort)})
+	if err != nil {
+		return err
+	}
+	d.conn = conn
+	d.udpPort = uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+	d.log.Infof("[p2p] dht discovery listening on udp port %d, node id %s", d.udpPort, d.self)
+
+	d.wg.Add(2)
+	go d.readLoop()
+	go d.loop()
+	return nil
+}
+
+//Stop stops the service and saves the node table
+func (d *Discovery) Stop() {
+	close(d.quit)
+	d.conn.Close()
+	d.wg.Wait()
+	d.tab.Save()
+}
+
+//Self returns the local node id
+func (d *Discovery) Self() NodeID {
+	return d.self
+}
+
+//Table returns the node table
+func (d *Discovery) Table() *Table {
+	return d.tab
+}
+
+//RandomNodes returns at most count random nodes from the table, which are candidates of sync peers
+func (d *Discovery) RandomNodes(count int) []*Node {
+	return d.tab.RandomNodes(count)
+}
+
+//loop refreshes buckets and checks the liveness of table nodes periodically
+func (d *Discovery) loop() {
+	defer d.wg.Done()
+
+	refresh := time.NewTicker(time.Second * common.DISCOVERY_REFRESH_INTERVAL)
+	revalidate := time.NewTicker(time.Second * common.DISCOVERY_REVALIDATE_INTERVAL)
+	defer refresh.Stop()
+	defer revalidate.Stop()
+
+	d.bondAll(d.tab.Load())
+	d.Refresh()
+	for {
+		select {
+		case <-refresh.C:
+			d.Refresh()
+			d.tab.Save()
+			d.pruneBonds()
+		case <-revalidate.C:
+			// bootstrap again if we've lost all nodes, e.g. the network was down for a while
+			if d.tab.Len() == 0 {
+				d.Refresh()
+			} else {
+				d.revalidate()
+			}
+		case <-d.quit:
+			return
+		}
+	}
+}
+
+//Refresh fills the table by looking up the local node and some random targets
+func (d *Discovery) Refresh() {
+	if d.tab.Len() == 0 {
+		d.bootstrap()
+	}
+	d.Lookup(d.self)
+	for i := 0; i < common.DISCOVERY_ALPHA; i++ {
+		var target NodeID
+		rand.Read(target[:])
+		d.Lookup(target)
+	}
+}
+
+//bootstrap pings the seed nodes
+func (d *Discovery) bootstrap() {
+	var wg sync.WaitGroup
+	for _, seed := range d.seeds {
+		addr, err := net.ResolveUDPAddr("udp", seed)
+		if err != nil {
+			d.log.Warnf("[p2p] resolve dht seed %s error: %v", seed, err)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := d.ping(addr); err != nil {
+				d.log.Debugf("[p2p] ping dht seed %s failed: %v", addr, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (d *Discovery) bondAll(nodes []*Node) {
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			d.ping(n.UDPAddr())
+		}(n)
+	}
+	wg.Wait()
+}
+
+//revalidate pings the least recently seen node of a random bucket, and replaces it if it's dead
+func (d *Discovery) revalidate() {
+	n := d.tab.nodeToRevalidate()
+	if n == nil {
+		return
+	}
+	if _, err := d.ping(n.UDPAddr()); err != nil {
+		d.log.Debugf("[p2p] dht node %s is dead: %v", n, err)
+		d.tab.Replace(n)
+		d.bondLock.Lock()
+		delete(d.bonds, n.ID)
+		d.bondLock.Unlock()
+	}
+}
+
+//Lookup iteratively queries the nodes closest to target, and returns the closest ones found
+func (d *Discovery) Lookup(target NodeID) []*Node {
+	asked := map[NodeID]bool{d.self: true}
+	seen := map[NodeID]bool{d.self: true}
+	result := d.tab.Closest(target, common.DISCOVERY_BUCKET_SIZE)
+	for _, n := range result {
+		seen[n.ID] = true
+	}
+
+	replies := make(chan []*Node, common.DISCOVERY_ALPHA)
+	for {
+		querying := 0
+		for _, n := range result {
+			if querying >= common.DISCOVERY_ALPHA {
+				break
+			}
+			if asked[n.ID] {
+				continue
+			}
+			asked[n.ID] = true
+			querying++
+			go func(n *Node) {
+				nodes, err := d.findNode(n, target)
+				if err != nil {
+					d.log.Debugf("[p2p] find node from %s failed: %v", n, err)
+				}
+				replies <- nodes
+			}(n)
+		}
+		if querying == 0 {
+			break
+		}
+		for ; querying > 0; querying-- {
+			for _, n := range <-replies {
+				if seen[n.ID] {
+					continue
+				}
+				seen[n.ID] = true
+				result = append(result, n)
+			}
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return distCmp(target, result[i].ID, result[j].ID) < 0
+		})
+		if len(result) > common.DISCOVERY_BUCKET_SIZE {
+			result = result[:common.DISCOVERY_BUCKET_SIZE]
+		}
+	}
+
+	// drop the nodes failed to prove their endpoints
+	found := make([]*Node, 0, len(result))
+	for _, n := range result {
+		if d.isBonded(n.ID) {
+			found = append(found, n)
+		}
+	}
+	return found
+}
+
+//ping sends a ping to given address, and returns the node answering it
+func (d *Discovery) ping(addr *net.UDPAddr) (*Node, error) {
+	p := &Packet{Body: &Packet_Ping{Ping: &Ping{TcpPort: uint32(d.tcpPort)}}}
+	data, hash, err := d.encode(p)
+	if err != nil {
+		return nil, err
+	}
+	key := replyKey{addr.String(), kindPong, string(hash)}
+	ch := d.expect(key)
+	if _, err = d.conn.WriteToUDP(data, addr); err != nil {
+		d.unexpect(key, ch)
+		return nil, err
+	}
+	in, err := d.wait(key, ch)
+	if err != nil {
+		return nil, err
+	}
+	n := NewNode(in.key, addr.IP, uint16(addr.Port), uint16(in.packet.GetPong().TcpPort))
+	d.tab.AddSeenNode(n)
+	return n, nil
+}
+
+//findNode asks given node for the nodes closest to target
+func (d *Discovery) findNode(n *Node, target NodeID) ([]*Node, error) {
+	addr := n.UDPAddr()
+	if !d.isPingedBy(n.ID) {
+		// the remote node answers queries only after it has proved our endpoint.
+		// our ping makes it ping us back if it hasn't.
+		pingKey := replyKey{addr: addr.String(), kind: kindPing}
+		pingCh := d.expect(pingKey)
+		if _, err := d.ping(addr); err != nil {
+			d.unexpect(pingKey, pingCh)
+			return nil, err
+		}
+		if !d.isPingedBy(n.ID) {
+			if _, err := d.wait(pingKey, pingCh); err != nil {
+				return nil, err
+			}
+		} else {
+			d.unexpect(pingKey, pingCh)
+		}
+	}
+
+	key := replyKey{addr: addr.String(), kind: kindNeighbors}
+	ch := d.expect(key)
+	if _, err := d.send(addr, &Packet{Body: &Packet_FindNode{FindNode: &FindNode{Target: target[:]}}}); err != nil {
+		d.unexpect(key, ch)
+		return nil, err
+	}
+	in, err := d.wait(key, ch)
+	if err != nil {
+		return nil, err
+	}
+	if in.id != n.ID {
+		return nil, errors.New("[p2p] neighbors from unexpected node")
+	}
+	var nodes []*Node
+	for _, e := range in.packet.GetNeighbors().Nodes {
+		ip := net.IP(e.Ip)
+		if len(e.PubKey) != 33 || (len(ip) != net.IPv4len && len(ip) != net.IPv6len) ||
+			ip.IsUnspecified() || e.UdpPort == 0 || e.UdpPort > 65535 || e.TcpPort > 65535 {
+			continue
+		}
+		nodes = append(nodes, NewNode(prototype.PublicKeyFromBytes(e.PubKey), ip, uint16(e.UdpPort), uint16(e.TcpPort)))
+	}
+	return nodes, nil
+}
+
+func (d *Discovery) isBonded(id NodeID) bool {
+	d.bondLock.Lock()
+	defer d.bondLock.Unlock()
+	t, ok := d.bonds[id]
+	return ok && time.Since(t) < common.DISCOVERY_BOND_EXPIRATION*time.Second
+}
+
+func (d *Discovery) isPingedBy(id NodeID) bool {
+	d.bondLock.Lock()
+	defer d.bondLock.Unlock()
+	t, ok := d.pingedBy[id]
+	return ok && time.Since(t) < common.DISCOVERY_BOND_EXPIRATION*time.Second
+}
+
+//pruneBonds forgets expired bonds
+func (d *Discovery) pruneBonds() {
+	d.bondLock.Lock()
+	defer d.bondLock.Unlock()
+	for _, m := range []map[NodeID]time.Time{d.bonds, d.pingedBy} {
+		for id, t := range m {
+			if time.Since(t) >= common.DISCOVERY_BOND_EXPIRATION*time.Second {
+				delete(m, id)
+			}
+		}
+	}
+}
+
+//bondBack pings back a node which pinged us, unless it's being pinged, or there are too many pending pings.
+func (d *Discovery) bondBack(id NodeID, addr *net.UDPAddr) {
+	d.bondLock.Lock()
+	defer d.bondLock.Unlock()
+	if d.bonding[id] || len(d.bonding) >= common.DISCOVERY_MAX_PENDING_BONDS {
+		return
+	}
+	d.bonding[id] = true
+	go func() {
+		d.ping(addr)
+		d.bondLock.Lock()
+		delete(d.bonding, id)
+		d.bondLock.Unlock()
+	}()
+}
+
+//expect registers a channel receiving the reply matching given key
+func (d *Discovery) expect(key replyKey) chan *incoming {
+	ch := make(chan *incoming, 1)
+	d.pendingLock.Lock()
+	d.pending[key] = ch
+	d.pendingLock.Unlock()
+	return ch
+}
+
+func (d *Discovery) unexpect(key replyKey, ch chan *incoming) {
+	d.pendingLock.Lock()
+	if d.pending[key] == ch {
+		delete(d.pending, key)
+	}
+	d.pendingLock.Unlock()
+}
+
+func (d *Discovery) wait(key replyKey, ch chan *incoming) (*incoming, error) {
+	t := time.NewTimer(time.Second * common.DISCOVERY_REPLY_TIMEOUT)
+	defer t.Stop()
+	select {
+	case in := <-ch:
+		return in, nil
+	case <-t.C:
+		d.unexpect(key, ch)
+		return nil, errTimeout
+	case <-d.quit:
+		return nil, errors.New("[p2p] discovery stopped")
+	}
+}
+
+//deliver passes a packet to the request waiting for it, and returns whether it's expected
+func (d *Discovery) deliver(in *incoming, key replyKey) bool {
+	d.pendingLock.Lock()
+	ch, ok := d.pending[key]
+	delete(d.pending, key)
+	d.pendingLock.Unlock()
+	if ok {
+		ch <- in
+	}
+	return ok
+}
+
+//send signs and sends a packet, and returns the hash of the packet
+func (d *Discovery) send(addr *net.UDPAddr, p *Packet) ([]byte, error) {
+	data, hash, err := d.encode(p)
+	if err != nil {
+		return nil, err
+	}
+	_, err = d.conn.WriteToUDP(data, addr)
+	return hash, err
+}
+
+//encode signs a packet, and returns the signed data and the hash of the packet
+func (d *Discovery) encode(p *Packet) ([]byte, []byte, error) {
+	p.Magic = d.magic
+	p.Expiration = uint64(time.Now().Unix() + common.DISCOVERY_PACKET_EXPIRATION)
+	payload, err := proto.Marshal(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	hash := sha256.Sum256(payload)
+	sig, err := secp256k1.Sign(hash[:], d.key.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := append(sig, payload...)
+	if len(data) > common.MAX_DISCOVERY_PACKET_LEN {
+		return nil, nil, errors.New("[p2p] discovery packet too large")
+	}
+	return data, hash[:], nil
+}
+
+func (d *Discovery) readLoop() {
+	defer d.wg.Done()
+
+	buf := make([]byte, common.MAX_DISCOVERY_PACKET_LEN)
+	for {
+		n, from, err := d.conn.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-d.quit:
+				return
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			d.log.Error("[p2p] dht discovery read error ", err)
+			return
+		}
+		in, err := d.decode(buf[:n], from)
+		if err != nil {
+			d.log.Debugf("[p2p] bad discovery packet from %s: %v", from, err)
+			continue
+		}
+		d.handle(in)
+	}
+}
+
+//decode verifies the signature, magic and expiration of a packet
+func (d *Discovery) decode(data []byte, from *net.UDPAddr) (*incoming, error) {
+	if len(data) <= signatureLen {
+		return nil, errors.New("packet too short")
+	}
+	sig, payload := data[:signatureLen], data[signatureLen:]
+	hash := sha256.Sum256(payload)
+	pub, err := secp256k1.RecoverPubkey(hash[:], sig)
+	if err != nil {
+		return nil, err
+	}
+	x, y := elliptic.Unmarshal(secp256k1.S256(), pub)
+	if x == nil || !secp256k1.VerifySignature(pub, hash[:], sig[:64]) {
+		return nil, errors.New("invalid signature")
+	}
+	p := new(Packet)
+	if err = proto.Unmarshal(payload, p); err != nil {
+		return nil, err
+	}
+	if p.Magic != d.magic {
+		return nil, errors.New("unmatched magic number")
+	}
+	if p.Expiration < uint64(time.Now().Unix()) {
+		return nil, errors.New("packet expired")
+	}
+	key := prototype.PublicKeyFromBytes(secp256k1.CompressPubkey(x, y))
+	return &incoming{
+		from:   from,
+		key:    key,
+		id:     NodeIDFromKey(key),
+		hash:   hash[:],
+		packet: p,
+	}, nil
+}
+
+func (d *Discovery) handle(in *incoming) {
+	if in.id == d.self {
+		return
+	}
+	switch body := in.packet.Body.(type) {
+	case *Packet_Ping:
+		d.send(in.from, &Packet{Body: &Packet_Pong{Pong: &Pong{PingHash: in.hash, TcpPort: uint32(d.tcpPort)}}})
+		d.bondLock.Lock()
+		d.pingedBy[in.id] = time.Now()
+		d.bondLock.Unlock()
+		d.deliver(in, replyKey{addr: in.from.String(), kind: kindPing})
+		if d.isBonded(in.id) {
+			d.tab.AddSeenNode(NewNode(in.key, in.from.IP, uint16(in.from.Port), uint16(body.Ping.TcpPort)))
+		} else {
+			// prove the endpoint of the new node before adding it to the table
+			d.bondBack(in.id, in.from)
+		}
+	case *Packet_Pong:
+		// bond in the read loop rather than the waiting request, so that packets following the pong see the bond
+		if d.deliver(in, replyKey{in.from.String(), kindPong, string(body.Pong.PingHash)}) {
+			d.bondLock.Lock()
+			d.bonds[in.id] = time.Now()
+			d.bondLock.Unlock()
+		}
+	case *Packet_FindNode:
+		// only answer nodes with proved endpoints, so that we can't be used to flood a spoofed address
+		if !d.isBonded(in.id) || len(body.FindNode.Target) != len(NodeID{}) {
+			return
+		}
+		var target NodeID
+		copy(target[:], body.FindNode.Target)
+		closest := d.tab.Closest(target, common.DISCOVERY_BUCKET_SIZE)
+		nodes := make([]*Endpoint, 0, len(closest))
+		for _, n := range closest {
+			if n.ID == in.id {
+				continue
+			}
+			nodes = append(nodes, &Endpoint{PubKey: n.PubKey.Data, Ip: n.IP, UdpPort: uint32(n.UDP), TcpPort: uint32(n.TCP)})
+		}
+		d.send(in.from, &Packet{Body: &Packet_Neighbors{Neighbors: &Neighbors{Nodes: nodes}}})
+	case *Packet_Neighbors:
+		d.deliver(in, replyKey{addr: in.from.String(), kind: kindNeighbors})
+	}
+}
@@ -0,0 +1,184 @@
+package discovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/sirupsen/logrus"
+)
+
+const testMagic = 1234
+
+func newTestDiscovery(t *testing.T, tcpPort uint16, seeds []string, path string) *Discovery {
+	key, err := prototype.GenerateNewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lg := logrus.New()
+	lg.SetOutput(ioutil.Discard)
+	d, err := NewDiscovery(key, testMagic, 0, tcpPort, seeds, path, lg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func hasNode(d *Discovery, id NodeID) bool {
+	for _, n := range d.RandomNodes(common.DISCOVERY_BUCKET_SIZE) {
+		if n.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func waitFor(cond func() bool) bool {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		if cond() {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDiscovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	seed := newTestDiscovery(t, 20338, nil, "")
+	defer seed.Stop()
+	seeds := []string{fmt.Sprintf("127.0.0.1:%d", seed.udpPort)}
+
+	a := newTestDiscovery(t, 20340, seeds, "")
+	defer a.Stop()
+	if !waitFor(func() bool { return hasNode(seed, a.Self()) }) {
+		t.Fatal("TestDiscovery seed didn't learn the first node")
+	}
+
+	path := filepath.Join(dir, common.DISCOVERY_NODES_FILE)
+	b := newTestDiscovery(t, 20342, seeds, path)
+	if !waitFor(func() bool { return hasNode(b, a.Self()) && hasNode(a, b.Self()) }) {
+		t.Fatal("TestDiscovery nodes didn't find each other through the seed")
+	}
+	for _, n := range b.RandomNodes(common.DISCOVERY_BUCKET_SIZE) {
+		if n.ID == a.Self() && n.TCPAddr() != "127.0.0.1:20340" {
+			t.Error("TestDiscovery wrong sync address", n.TCPAddr())
+		}
+	}
+	b.Stop()
+
+	// the node table survives restarts
+	nodes := NewTable(b.Self(), path, b.log).Load()
+	if len(nodes) != 2 {
+		t.Error("TestDiscovery wrong nodes saved", nodes)
+	}
+}
+
+func TestDiscoveryMagicMismatch(t *testing.T) {
+	seed := newTestDiscovery(t, 20338, nil, "")
+	defer seed.Stop()
+
+	key, _ := prototype.GenerateNewKey()
+	d, _ := NewDiscovery(key, testMagic+1, 0, 20340, nil, "", seed.log)
+	if err := d.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer d.Stop()
+	if _, err := d.ping(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: int(seed.udpPort)}); err != errTimeout {
+		t.Error("TestDiscoveryMagicMismatch ping answered by another network")
+	}
+}
+
+func TestDiscoveryBonds(t *testing.T) {
+	d := newTestDiscovery(t, 20338, nil, "")
+	defer d.Stop()
+
+	// expired bonds are pruned
+	expired := time.Now().Add(-common.DISCOVERY_BOND_EXPIRATION * time.Second)
+	d.bonds[NodeID{1}], d.bonds[NodeID{2}] = expired, time.Now()
+	d.pingedBy[NodeID{1}], d.pingedBy[NodeID{2}] = time.Now(), expired
+	d.pruneBonds()
+	if len(d.bonds) != 1 || len(d.pingedBy) != 1 || !d.isBonded(NodeID{2}) || !d.isPingedBy(NodeID{1}) {
+		t.Error("TestDiscoveryBonds wrong bonds after pruning", d.bonds, d.pingedBy)
+	}
+
+	// pings of bonding are bounded
+	addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}
+	d.bondLock.Lock()
+	for i := 0; i < common.DISCOVERY_MAX_PENDING_BONDS; i++ {
+		d.bonding[NodeID{byte(i), 1}] = true
+	}
+	d.bondLock.Unlock()
+	d.bondBack(NodeID{3}, addr)
+	d.bondLock.Lock()
+	if d.bonding[NodeID{3}] {
+		t.Error("TestDiscoveryBonds too many pending bonds")
+	}
+	delete(d.bonding, NodeID{0, 1})
+	d.bondLock.Unlock()
+
+	// and finish on timeout
+	d.bondBack(NodeID{3}, addr)
+	if !waitFor(func() bool {
+		d.bondLock.Lock()
+		defer d.bondLock.Unlock()
+		return !d.bonding[NodeID{3}]
+	}) {
+		t.Error("TestDiscoveryBonds pending bond not finished")
+	}
+}
+
+func TestTable(t *testing.T) {
+	var self NodeID
+	tab := NewTable(self, "", logrus.New())
+
+	// fill the bucket of distance 256, whose nodes have the highest bit set
+	var nodes []*Node
+	for i := 0; i < common.DISCOVERY_BUCKET_SIZE+2; i++ {
+		key, _ := prototype.GenerateNewKey()
+		pub, _ := key.PubKey()
+		n := NewNode(pub, net.IPv4(10, 0, 0, byte(i)), 20338, 20338)
+		if n.ID[0]&0x80 == 0 {
+			i--
+			continue
+		}
+		nodes = append(nodes, n)
+		tab.AddSeenNode(n)
+	}
+	if tab.Len() != common.DISCOVERY_BUCKET_SIZE {
+		t.Fatal("TestTable wrong bucket size", tab.Len())
+	}
+	oldest := tab.nodeToRevalidate()
+	if oldest.ID != nodes[0].ID {
+		t.Error("TestTable wrong node to revalidate")
+	}
+	tab.Replace(oldest)
+	if tab.Len() != common.DISCOVERY_BUCKET_SIZE || indexOf(tab.all(), nodes[len(nodes)-1].ID) < 0 {
+		t.Error("TestTable dead node not replaced by the latest replacement")
+	}
+
+	target := nodes[5].ID
+	closest := tab.Closest(target, 3)
+	if len(closest) != 3 || closest[0].ID != target {
+		t.Fatal("TestTable wrong closest nodes")
+	}
+	if distCmp(target, closest[1].ID, closest[2].ID) > 0 {
+		t.Error("TestTable closest nodes not sorted")
+	}
+	if logDist(self, target) != nBuckets || logDist(target, target) != 0 {
+		t.Error("TestTable wrong log distance")
+	}
+}
@@ -0,0 +1,92 @@
+package discovery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/bits"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/coschain/contentos-go/prototype"
+)
+
+//NodeID identifies a node in the dht, which is the hash of its node key
+type NodeID [sha256.Size]byte
+
+//NodeIDFromKey returns the id of given node key
+func NodeIDFromKey(key *prototype.PublicKeyType) NodeID {
+	return sha256.Sum256(key.Data)
+}
+
+func (id NodeID) String() string {
+	return hex.EncodeToString(id[:8])
+}
+
+//Node is a remote node found by the discovery
+type Node struct {
+	ID      NodeID
+	PubKey  *prototype.PublicKeyType
+	IP      net.IP
+	UDP     uint16 //discovery port
+	TCP     uint16 //sync port
+	addedAt time.Time
+}
+
+//NewNode returns a node of given node key and endpoint
+func NewNode(key *prototype.PublicKeyType, ip net.IP, udp, tcp uint16) *Node {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return &Node{
+		ID:     NodeIDFromKey(key),
+		PubKey: key,
+		IP:     ip,
+		UDP:    udp,
+		TCP:    tcp,
+	}
+}
+
+//UDPAddr returns the discovery address of the node
+func (n *Node) UDPAddr() *net.UDPAddr {
+	return &net.UDPAddr{IP: n.IP, Port: int(n.UDP)}
+}
+
+//TCPAddr returns the sync address of the node, in the same format as the addresses in seed list
+func (n *Node) TCPAddr() string {
+	return n.IP.String() + ":" + strconv.Itoa(int(n.TCP))
+}
+
+func (n *Node) String() string {
+	return n.ID.String() + "@" + n.UDPAddr().String()
+}
+
+//logDist returns the logarithmic distance between a and b, log2(a ^ b)
+func logDist(a, b NodeID) int {
+	lz := 0
+	for i := range a {
+		x := a[i] ^ b[i]
+		if x == 0 {
+			lz += 8
+		} else {
+			lz += bits.LeadingZeros8(x)
+			break
+		}
+	}
+	return len(a)*8 - lz
+}
+
+//distCmp compares the distances a->target and b->target.
+//it returns -1 if a is closer to target, 1 if b is closer to target and 0 if they are equal.
+func distCmp(target, a, b NodeID) int {
+	for i := range target {
+		da := a[i] ^ target[i]
+		db := b[i] ^ target[i]
+		if da > db {
+			return 1
+		} else if da < db {
+			return -1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,240 @@
+package discovery
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/sirupsen/logrus"
+)
+
+const nBuckets = len(NodeID{}) * 8
+
+//bucket contains nodes of the same distance range, the most recently seen first
+type bucket struct {
+	entries      []*Node
+	replacements []*Node //nodes seen when the bucket was full, used to replace dead entries
+}
+
+//Table is the kademlia node table, which only contains nodes having proved their endpoints
+type Table struct {
+	sync.Mutex
+	self    NodeID
+	buckets [nBuckets]*bucket
+	path    string //file to persist nodes, empty for no persistence
+	log     *logrus.Logger
+}
+
+//nodeRecord is the persistent format of a node
+type nodeRecord struct {
+	PubKey string
+	IP     string
+	UDP    uint16
+	TCP    uint16
+}
+
+//NewTable returns an empty table of given local node
+func NewTable(self NodeID, path string, lg *logrus.Logger) *Table {
+	t := &Table{
+		self: self,
+		path: path,
+		log:  lg,
+	}
+	for i := range t.buckets {
+		t.buckets[i] = new(bucket)
+	}
+	return t
+}
+
+func (t *Table) bucket(id NodeID) *bucket {
+	d := logDist(t.self, id)
+	if d == 0 {
+		return nil
+	}
+	return t.buckets[d-1]
+}
+
+//AddSeenNode adds a node which just proved its endpoint, or moves it to the front of its bucket.
+//The node goes to the replacement list if the bucket is full.
+func (t *Table) AddSeenNode(n *Node) {
+	t.Lock()
+	defer t.Unlock()
+
+	b := t.bucket(n.ID)
+	if b == nil {
+		return
+	}
+	if i := indexOf(b.entries, n.ID); i >= 0 {
+		n.addedAt = b.entries[i].addedAt
+		b.entries = append(b.entries[:i], b.entries[i+1:]...)
+	} else if len(b.entries) >= common.DISCOVERY_BUCKET_SIZE {
+		if j := indexOf(b.replacements, n.ID); j >= 0 {
+			b.replacements = append(b.replacements[:j], b.replacements[j+1:]...)
+		}
+		b.replacements = pushNode(b.replacements, n, common.DISCOVERY_MAX_REPLACEMENTS)
+		return
+	} else {
+		n.addedAt = time.Now()
+	}
+	b.entries = pushNode(b.entries, n, common.DISCOVERY_BUCKET_SIZE)
+}
+
+//Replace removes a dead node from the table, and fills its slot with the latest replacement
+func (t *Table) Replace(n *Node) {
+	t.Lock()
+	defer t.Unlock()
+
+	b := t.bucket(n.ID)
+	if b == nil {
+		return
+	}
+	i := indexOf(b.entries, n.ID)
+	if i < 0 {
+		return
+	}
+	b.entries = append(b.entries[:i], b.entries[i+1:]...)
+	if len(b.replacements) > 0 {
+		r := b.replacements[0]
+		b.replacements = b.replacements[1:]
+		r.addedAt = time.Now()
+		b.entries = append(b.entries, r)
+	}
+}
+
+//Closest returns at most count nodes closest to target
+func (t *Table) Closest(target NodeID, count int) []*Node {
+	nodes := t.all()
+	sort.Slice(nodes, func(i, j int) bool {
+		return distCmp(target, nodes[i].ID, nodes[j].ID) < 0
+	})
+	if len(nodes) > count {
+		nodes = nodes[:count]
+	}
+	return nodes
+}
+
+//RandomNodes returns at most count random nodes
+func (t *Table) RandomNodes(count int) []*Node {
+	nodes := t.all()
+	rand.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+	if len(nodes) > count {
+		nodes = nodes[:count]
+	}
+	return nodes
+}
+
+//Len returns the count of nodes in the table
+func (t *Table) Len() int {
+	t.Lock()
+	defer t.Unlock()
+	n := 0
+	for _, b := range t.buckets {
+		n += len(b.entries)
+	}
+	return n
+}
+
+//nodeToRevalidate returns the least recently seen node of a random non-empty bucket
+func (t *Table) nodeToRevalidate() *Node {
+	t.Lock()
+	defer t.Unlock()
+	var candidates []*bucket
+	for _, b := range t.buckets {
+		if len(b.entries) > 0 {
+			candidates = append(candidates, b)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	b := candidates[rand.Intn(len(candidates))]
+	return b.entries[len(b.entries)-1]
+}
+
+func (t *Table) all() []*Node {
+	t.Lock()
+	defer t.Unlock()
+	var nodes []*Node
+	for _, b := range t.buckets {
+		nodes = append(nodes, b.entries...)
+	}
+	return nodes
+}
+
+//Load reads the nodes saved in the file. They are not added to the table until they prove their endpoints again.
+func (t *Table) Load() []*Node {
+	if len(t.path) == 0 {
+		return nil
+	}
+	data, err := ioutil.ReadFile(t.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			t.log.Warnf("[p2p] failed to read dht nodes from %s: %v", t.path, err)
+		}
+		return nil
+	}
+	var records []nodeRecord
+	if err = json.Unmarshal(data, &records); err != nil {
+		t.log.Warnf("[p2p] failed to parse dht nodes from %s: %v", t.path, err)
+		return nil
+	}
+	nodes := make([]*Node, 0, len(records))
+	for _, r := range records {
+		key, err := prototype.PublicKeyFromWIF(r.PubKey)
+		ip := net.ParseIP(r.IP)
+		if err != nil || ip == nil {
+			continue
+		}
+		nodes = append(nodes, NewNode(key, ip, r.UDP, r.TCP))
+	}
+	t.log.Infof("[p2p] loaded %d dht nodes", len(nodes))
+	return nodes
+}
+
+//Save writes the nodes in the table to the file
+func (t *Table) Save() {
+	if len(t.path) == 0 {
+		return
+	}
+	nodes := t.all()
+	records := make([]nodeRecord, 0, len(nodes))
+	for _, n := range nodes {
+		records = append(records, nodeRecord{
+			PubKey: n.PubKey.ToWIF(),
+			IP:     n.IP.String(),
+			UDP:    n.UDP,
+			TCP:    n.TCP,
+		})
+	}
+	data, _ := json.Marshal(records)
+	if err := ioutil.WriteFile(t.path, data, 0644); err != nil {
+		t.log.Warnf("[p2p] failed to save dht nodes to %s: %v", t.path, err)
+	}
+}
+
+func indexOf(nodes []*Node, id NodeID) int {
+	for i, n := range nodes {
+		if n.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+//pushNode adds n to the front of the list, and drops the last ones beyond max
+func pushNode(list []*Node, n *Node, max int) []*Node {
+	list = append([]*Node{n}, list...)
+	if len(list) > max {
+		list = list[:max]
+	}
+	return list
+}
@@ -7,12 +7,42 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/prototype"
 )
 
+//loadNodeKey returns the key in the file of given path, a new key is generated and saved if the file doesn't exist
+func loadNodeKey(path string) (*prototype.PrivateKeyType, error) {
+	if len(path) > 0 {
+		data, err := ioutil.ReadFile(path)
+		if err == nil {
+			return prototype.PrivateKeyFromWIF(strings.TrimSpace(string(data)))
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	key, err := prototype.GenerateNewKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(path) > 0 {
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, err
+		}
+		if err = ioutil.WriteFile(path, []byte(key.ToWIF()), 0600); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
 // createListener creates a net listener on the port
 func createListener(port uint32, isTls bool, CertPath, KeyPath, CAPath string) (net.Listener, error) {
 	var listener net.Listener
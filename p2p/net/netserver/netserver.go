@@ -9,12 +9,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/coschain/contentos-go/iservices"
 	"github.com/coschain/contentos-go/node"
 	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/p2p/link"
 	"github.com/coschain/contentos-go/p2p/message/msg_pack"
 	"github.com/coschain/contentos-go/p2p/message/types"
 	"github.com/coschain/contentos-go/p2p/net/protocol"
 	"github.com/coschain/contentos-go/p2p/peer"
+	"github.com/coschain/contentos-go/prototype"
 	"github.com/sirupsen/logrus"
 )
 
@@ -27,7 +30,9 @@ func NewNetServer(ctx *node.ServiceContext, lg *logrus.Logger) p2p.P2P {
 		ConsChan: make(chan *types.MsgPayload, common.CHAN_CAPABILITY),
 		NetworkMagic: common2.GetChainIdByName(ctx.Config().ChainId),
 		msgCache: common.NewHashCache(common.DefaultHashCacheMaxCount * 50),
+		legacyAddrs: make(map[string]time.Time),
 	}
+	n.reputations = NewPeerReputations(ctx.ResolvePath(common.BANNED_PEERS_FILE), lg)
 
 	//n.PeerAddrMap.PeerSyncAddress = make(map[string]*peer.Peer)
 	//n.PeerAddrMap.PeerConsAddress = make(map[string]*peer.Peer)
@@ -56,6 +61,11 @@ type NetServer struct {
 	NetworkMagic  uint32
 
 	msgCache	 *common.HashCache
+	reputations  *PeerReputations
+	nodeKey      *prototype.PrivateKeyType //key authenticating the node in handshakes
+	validatorKey *prototype.PrivateKeyType //block signing key proving the validator identity on consensus links
+	legacyLock   sync.Mutex
+	legacyAddrs  map[string]time.Time      //addresses of peers not upgraded to the secure handshake, and when they were found
 
 	startUpComplete bool
 }
@@ -115,6 +125,11 @@ func (this *NetServer) init() error {
 
 	this.base.SetRelay(true)
 
+	if err := this.initNodeKey(); err != nil {
+		this.log.Error("[p2p] failed to load node key ", err)
+		return err
+	}
+
 	rand.Seed(time.Now().UnixNano())
 	id := rand.Uint64()
 
@@ -130,6 +145,28 @@ func (this *NetServer) init() error {
 	return nil
 }
 
+//initNodeKey loads the node key, or generates one on first start. Block producers keep their block signing keys
+//out of handshakes, and only use them to sign the bindings of their node keys on consensus links.
+func (this *NetServer) initNodeKey() error {
+	var err error
+	cfg := this.ctx.Config()
+	if this.nodeKey, err = loadNodeKey(this.ctx.ResolvePath(common.NODE_KEY_FILE)); err != nil {
+		return err
+	}
+	pubKey, err := this.nodeKey.PubKey()
+	if err != nil {
+		return err
+	}
+	this.log.Infof("[p2p] node key %s", pubKey.ToWIF())
+
+	if cfg.P2P.EnableConsensus && len(cfg.Consensus.LocalBpPrivateKey) > 0 {
+		if this.validatorKey, err = prototype.PrivateKeyFromWIF(cfg.Consensus.LocalBpPrivateKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (this *NetServer) CheckStartUpFinished() bool {
 	return this.startUpComplete
 }
@@ -289,6 +326,11 @@ func (this *NetServer) Connect(addr string, isConsensus bool) error {
 		this.RemoveFromConnectingList(addr)
 		return nil
 	}
+	if this.IsBannedAddr(addr) {
+		this.RemoveFromConnectingList(addr)
+		this.log.Debugf("[p2p] Address: %s is banned", addr)
+		return nil
+	}
 
 	this.connectLock.Lock()
 	connCount := uint(this.GetOutConnRecordLen())
@@ -333,6 +375,24 @@ func (this *NetServer) Connect(addr string, isConsensus bool) error {
 		}
 	}
 
+	// validator identities are checked in version handshake, where consensus links of non-validators get closed.
+	// consensus links are never made with legacy peers.
+	var session []byte
+	if isConsensus || !this.isLegacyAddr(addr) {
+		secureConn, err := link.Handshake(conn, this.nodeKey, this.NetworkMagic)
+		if err != nil {
+			this.log.Debugf("[p2p] handshake with %s failed:%s", addr, err.Error())
+			if err == link.ErrNoHandshake && !isConsensus && this.ctx.Config().P2P.AllowLegacyPeers {
+				// the peer may not be upgraded yet, fall back to plain links in later connections
+				this.addLegacyAddr(addr)
+			}
+			this.RemoveFromConnectingList(addr)
+			conn.Close()
+			return err
+		}
+		conn, session = secureConn, secureConn.Session()
+	}
+
 	addr = conn.RemoteAddr().String()
 	this.log.Debugf("[p2p] peer %s connect with %s with %s",
 		conn.LocalAddr().String(), conn.RemoteAddr().String(),
@@ -367,7 +427,7 @@ func (this *NetServer) Connect(addr string, isConsensus bool) error {
 	//}
 	//ctrl := service.(iservices.IConsensus)
 	//version := msgpack.NewVersion(this, isConsensus, ctrl.GetHeadBlockId().BlockNum())
-	version := msgpack.NewVersion(this, isConsensus, uint64(0), this.ctx.Config().P2P.RunningCodeVersion )
+	version := msgpack.NewVersion(this, isConsensus, uint64(0), this.ctx.Config().P2P.RunningCodeVersion, session)
 	err = remotePeer.Send(version, isConsensus, this.NetworkMagic)
 	if err != nil {
 		if !isConsensus {
@@ -485,6 +545,11 @@ func (this *NetServer) startSyncAccept(listener net.Listener) {
 			conn.Close()
 			continue
 		}
+		if this.IsBannedAddr(conn.RemoteAddr().String()) {
+			this.log.Debugf("[p2p] remote %s is banned, close it ", conn.RemoteAddr())
+			conn.Close()
+			continue
+		}
 
 		if this.IsAddrInInConnRecord(conn.RemoteAddr().String()) {
 			conn.Close()
@@ -513,18 +578,31 @@ func (this *NetServer) startSyncAccept(listener net.Listener) {
 			continue
 		}
 
-		remotePeer := peer.NewPeer(this.log)
 		addr := conn.RemoteAddr().String()
 		this.AddInConnRecord(addr)
 
-		this.AddPeerSyncAddress(addr, remotePeer)
+		go this.acceptSyncConn(conn, addr)
+	}
+}
 
-		remotePeer.SyncLink.SetAddr(addr)
-		remotePeer.SyncLink.SetConn(conn)
-		remotePeer.AttachSyncChan(this.SyncChan)
-		go remotePeer.SyncLink.Rx(this.NetworkMagic)
-		go remotePeer.SyncLink.Tx(this.NetworkMagic)
+//acceptSyncConn does handshake with the inbound peer, and starts the sync link if succeeded
+func (this *NetServer) acceptSyncConn(conn net.Conn, addr string) {
+	secureConn, err := this.acceptHandshake(conn, addr, true)
+	if err != nil {
+		this.log.Debugf("[p2p] handshake with %s failed:%s", addr, err.Error())
+		this.RemoveFromInConnRecord(addr)
+		conn.Close()
+		return
 	}
+
+	remotePeer := peer.NewPeer(this.log)
+	this.AddPeerSyncAddress(addr, remotePeer)
+
+	remotePeer.SyncLink.SetAddr(addr)
+	remotePeer.SyncLink.SetConn(secureConn)
+	remotePeer.AttachSyncChan(this.SyncChan)
+	go remotePeer.SyncLink.Rx(this.NetworkMagic)
+	go remotePeer.SyncLink.Tx(this.NetworkMagic)
 }
 
 //startConsAccept accepts the consensus connnection from the inbound peer
@@ -542,6 +620,11 @@ func (this *NetServer) startConsAccept(listener net.Listener) {
 			conn.Close()
 			continue
 		}
+		if this.IsBannedAddr(conn.RemoteAddr().String()) {
+			this.log.Debugf("[p2p] remote %s is banned, close it ", conn.RemoteAddr())
+			conn.Close()
+			continue
+		}
 
 		remoteIp, err := common.ParseIPAddr(conn.RemoteAddr().String())
 		if err != nil {
@@ -554,16 +637,73 @@ func (this *NetServer) startConsAccept(listener net.Listener) {
 			continue
 		}
 
-		remotePeer := peer.NewPeer(this.log)
-		addr := conn.RemoteAddr().String()
-		this.AddPeerConsAddress(addr, remotePeer)
+		go this.acceptConsConn(conn, conn.RemoteAddr().String())
+	}
+}
 
-		remotePeer.ConsLink.SetAddr(addr)
-		remotePeer.ConsLink.SetConn(conn)
-		remotePeer.AttachConsChan(this.ConsChan)
-		go remotePeer.ConsLink.Rx(this.NetworkMagic)
-		go remotePeer.SyncLink.Tx(this.NetworkMagic)
+//acceptConsConn does handshake with the inbound peer, and starts the consensus link if succeeded.
+//the link gets closed in version handshake unless the peer proves to be an active validator.
+func (this *NetServer) acceptConsConn(conn net.Conn, addr string) {
+	secureConn, err := this.acceptHandshake(conn, addr, false)
+	if err != nil {
+		this.log.Debugf("[p2p] handshake with %s failed:%s", addr, err.Error())
+		conn.Close()
+		return
 	}
+
+	remotePeer := peer.NewPeer(this.log)
+	this.AddPeerConsAddress(addr, remotePeer)
+
+	remotePeer.ConsLink.SetAddr(addr)
+	remotePeer.ConsLink.SetConn(secureConn)
+	remotePeer.AttachConsChan(this.ConsChan)
+	go remotePeer.ConsLink.Rx(this.NetworkMagic)
+	go remotePeer.SyncLink.Tx(this.NetworkMagic)
+}
+
+//acceptHandshake does handshake with the inbound peer, or returns the plain connection if the peer
+//is not upgraded to the secure handshake and legacy peers are allowed on the link
+func (this *NetServer) acceptHandshake(conn net.Conn, addr string, allowLegacy bool) (net.Conn, error) {
+	conn, legacy, err := link.DetectLegacy(conn, this.NetworkMagic)
+	if err != nil {
+		return nil, err
+	}
+	if legacy {
+		if !allowLegacy || !this.ctx.Config().P2P.AllowLegacyPeers {
+			return nil, errors.New("[p2p] legacy peers not allowed")
+		}
+		this.log.Debugf("[p2p] accept legacy peer %s without secure handshake", addr)
+		return conn, nil
+	}
+	return link.Handshake(conn, this.nodeKey, this.NetworkMagic)
+}
+
+//isLegacyAddr returns whether the peer of given address is known not upgraded to the secure handshake.
+//the knowledge expires after a while, so that upgraded peers get secure links again.
+func (this *NetServer) isLegacyAddr(addr string) bool {
+	if !this.ctx.Config().P2P.AllowLegacyPeers {
+		return false
+	}
+	this.legacyLock.Lock()
+	defer this.legacyLock.Unlock()
+	found, ok := this.legacyAddrs[addr]
+	if ok && time.Since(found) >= common.LEGACY_ADDR_EXPIRATION*time.Second {
+		delete(this.legacyAddrs, addr)
+		return false
+	}
+	return ok
+}
+
+//addLegacyAddr records the address of a peer not upgraded to the secure handshake, and forgets expired ones
+func (this *NetServer) addLegacyAddr(addr string) {
+	this.legacyLock.Lock()
+	defer this.legacyLock.Unlock()
+	for a, found := range this.legacyAddrs {
+		if time.Since(found) >= common.LEGACY_ADDR_EXPIRATION*time.Second {
+			delete(this.legacyAddrs, a)
+		}
+	}
+	this.legacyAddrs[addr] = time.Now()
 }
 
 //record the peer which is going to be dialed and sent version message but not in establish state
@@ -866,6 +1006,98 @@ func (this *NetServer) GetMagic() uint32 {
 	return this.NetworkMagic
 }
 
+func (this *NetServer) GetNodeKey() *prototype.PrivateKeyType {
+	return this.nodeKey
+}
+
+func (this *NetServer) GetValidatorKey() *prototype.PrivateKeyType {
+	return this.validatorKey
+}
+
+//IsValidatorKey returns whether given key is the signing key of an active validator
+func (this *NetServer) IsValidatorKey(key *prototype.PublicKeyType) bool {
+	s, err := this.GetService(iservices.ConsensusServerName)
+	if err != nil {
+		return false
+	}
+	_, ok := s.(iservices.IConsensus).ActiveValidatorByKey(key)
+	return ok
+}
+
 func (this *NetServer) RememberMsg(hash [common.HashSize]byte) bool {
 	return this.msgCache.PutIfNotFound(hash)
 }
+
+//IsBannedAddr returns whether the ip of given address is banned
+func (this *NetServer) IsBannedAddr(addr string) bool {
+	ip, err := common.ParseIPAddr(addr)
+	if err != nil {
+		return false
+	}
+	return this.reputations.IsBanned(ip)
+}
+
+//PunishPeer adds penalty to the ip of given address, and disconnects its peers if it gets banned
+func (this *NetServer) PunishPeer(addr string, penalty int, reason string) {
+	ip, err := common.ParseIPAddr(addr)
+	if err != nil {
+		this.log.Warn("[p2p] parse ip error ", err.Error())
+		return
+	}
+	this.log.Warnf("[p2p] punish peer %s by %d: %s", addr, penalty, reason)
+	if this.reputations.Punish(ip, penalty) {
+		this.log.Warnf("[p2p] peer ip %s banned for %d seconds", ip, common.BAN_DURATION)
+		this.disconnectIP(ip)
+	}
+}
+
+//BanPeer bans given ip for given duration, and disconnects its peers
+func (this *NetServer) BanPeer(ip string, duration time.Duration) error {
+	if net.ParseIP(ip) == nil {
+		return errors.New("[p2p] invalid ip address")
+	}
+	if duration <= 0 {
+		return errors.New("[p2p] invalid ban duration")
+	}
+	this.reputations.Ban(ip, duration)
+	this.log.Infof("[p2p] peer ip %s banned for %v", ip, duration)
+	this.disconnectIP(ip)
+	return nil
+}
+
+//UnbanPeer lifts the ban of given ip
+func (this *NetServer) UnbanPeer(ip string) error {
+	if !this.reputations.Unban(ip) {
+		return errors.New("[p2p] peer ip is not banned")
+	}
+	this.log.Infof("[p2p] peer ip %s unbanned", ip)
+	return nil
+}
+
+//GetPeerReputations returns reputations of misbehaving or banned peer ips
+func (this *NetServer) GetPeerReputations() []iservices.PeerReputation {
+	return this.reputations.List()
+}
+
+//disconnectIP closes connections of all peers with given ip
+func (this *NetServer) disconnectIP(ip string) {
+	var peers []*peer.Peer
+	this.PeerAddrMap.RLock()
+	for addr, p := range this.PeerSyncAddress {
+		if remoteIp, err := common.ParseIPAddr(addr); err == nil && remoteIp == ip {
+			peers = append(peers, p)
+		}
+	}
+	for addr, p := range this.PeerConsAddress {
+		if remoteIp, err := common.ParseIPAddr(addr); err == nil && remoteIp == ip {
+			peers = append(peers, p)
+		}
+	}
+	this.PeerAddrMap.RUnlock()
+
+	//closing the connections makes the links notify disconnection, which cleans up the records
+	for _, p := range peers {
+		p.CloseSync()
+		p.CloseCons()
+	}
+}
@@ -114,3 +114,35 @@ func TestNetServerNbrPeer(t *testing.T) {
 		t.Error("TestNetServerNbrPeer Get/AddPeerConsAddress error")
 	}
 }
+
+func TestLegacyAddrs(t *testing.T) {
+	conf := config.DefaultNodeConfig
+	conf.P2P.AllowLegacyPeers = true
+	ctx := new(node.ServiceContext)
+	ctx.ResetConfig(&conf)
+	server := NewNetServer(ctx, logrus.New()).(*NetServer)
+
+	server.addLegacyAddr("10.0.0.1:20338")
+	server.addLegacyAddr("10.0.0.2:20338")
+	if !server.isLegacyAddr("10.0.0.1:20338") || server.isLegacyAddr("10.0.0.3:20338") {
+		t.Error("TestLegacyAddrs legacy addresses not recorded")
+	}
+
+	// upgraded peers get a chance of secure links once their records expire
+	server.legacyAddrs["10.0.0.1:20338"] = time.Now().Add(-common.LEGACY_ADDR_EXPIRATION * time.Second)
+	if server.isLegacyAddr("10.0.0.1:20338") {
+		t.Error("TestLegacyAddrs expired legacy address not forgotten")
+	}
+	server.legacyAddrs["10.0.0.2:20338"] = time.Now().Add(-common.LEGACY_ADDR_EXPIRATION * time.Second)
+	server.addLegacyAddr("10.0.0.3:20338")
+	if len(server.legacyAddrs) != 1 {
+		t.Error("TestLegacyAddrs expired legacy addresses not pruned", len(server.legacyAddrs))
+	}
+
+	// and nothing is legacy if legacy peers are not allowed
+	conf.P2P.AllowLegacyPeers = false
+	ctx.ResetConfig(&conf)
+	if server.isLegacyAddr("10.0.0.3:20338") {
+		t.Error("TestLegacyAddrs legacy address used while legacy peers not allowed")
+	}
+}
@@ -0,0 +1,194 @@
+package netserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/sirupsen/logrus"
+)
+
+//peerScore is the penalty score of a remote IP
+type peerScore struct {
+	score   float64   //penalty score at the update time
+	updated time.Time //last update time of the score
+}
+
+//current returns the penalty score at given time, which decays as time goes by
+func (s *peerScore) current(now time.Time) float64 {
+	score := s.score - now.Sub(s.updated).Minutes()*common.SCORE_DECAY_PER_MINUTE
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
+
+//PeerReputations keeps penalty scores of remote IPs, and bans the ones misbehaving too much.
+//Bans are persisted in a file so that they survive restarts.
+type PeerReputations struct {
+	sync.Mutex
+	scores map[string]*peerScore //ip -> penalty score
+	bans   map[string]time.Time  //ip -> ban expiration
+	path   string                //file to persist bans, empty for no persistence
+	log    *logrus.Logger
+}
+
+//NewPeerReputations returns a PeerReputations which persists bans in the file of given path
+func NewPeerReputations(path string, lg *logrus.Logger) *PeerReputations {
+	r := &PeerReputations{
+		scores: make(map[string]*peerScore),
+		bans:   make(map[string]time.Time),
+		path:   path,
+		log:    lg,
+	}
+	r.load()
+	return r
+}
+
+//Punish adds penalty to given IP, and returns whether the IP gets banned
+func (r *PeerReputations) Punish(ip string, penalty int) (banned bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	if r.isBanned(ip, now) {
+		return false
+	}
+	s := r.scores[ip]
+	if s == nil {
+		s = new(peerScore)
+		r.scores[ip] = s
+	}
+	s.score, s.updated = s.current(now)+float64(penalty), now
+	if s.score < common.BAN_SCORE_THRESHOLD {
+		return false
+	}
+	r.ban(ip, now.Add(common.BAN_DURATION*time.Second))
+	return true
+}
+
+//Ban bans given IP for given duration
+func (r *PeerReputations) Ban(ip string, duration time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.ban(ip, time.Now().Add(duration))
+}
+
+//Unban lifts the ban of given IP, and returns whether the IP was banned
+func (r *PeerReputations) Unban(ip string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	banned := r.isBanned(ip, time.Now())
+	delete(r.bans, ip)
+	delete(r.scores, ip)
+	r.save()
+	return banned
+}
+
+//IsBanned returns whether given IP is banned
+func (r *PeerReputations) IsBanned(ip string) bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.isBanned(ip, time.Now())
+}
+
+//List returns reputations of IPs having penalty scores or banned, the worst first
+func (r *PeerReputations) List() []iservices.PeerReputation {
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	reps := make(map[string]*iservices.PeerReputation)
+	for ip, s := range r.scores {
+		if score := s.current(now); score > 0 {
+			reps[ip] = &iservices.PeerReputation{Ip: ip, Score: score}
+		} else {
+			delete(r.scores, ip)
+		}
+	}
+	for ip := range r.bans {
+		if r.isBanned(ip, now) {
+			if reps[ip] == nil {
+				reps[ip] = &iservices.PeerReputation{Ip: ip}
+			}
+			reps[ip].BannedUntil = r.bans[ip]
+		}
+	}
+	result := make([]iservices.PeerReputation, 0, len(reps))
+	for _, rep := range reps {
+		result = append(result, *rep)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if bi, bj := !result[i].BannedUntil.IsZero(), !result[j].BannedUntil.IsZero(); bi != bj {
+			return bi
+		}
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Ip < result[j].Ip
+	})
+	return result
+}
+
+func (r *PeerReputations) ban(ip string, expiration time.Time) {
+	r.bans[ip] = expiration
+	delete(r.scores, ip)
+	r.save()
+}
+
+//isBanned checks the ban of given IP, and forgets the ban if expired
+func (r *PeerReputations) isBanned(ip string, now time.Time) bool {
+	expiration, ok := r.bans[ip]
+	if !ok {
+		return false
+	}
+	if now.Before(expiration) {
+		return true
+	}
+	delete(r.bans, ip)
+	r.save()
+	return false
+}
+
+//load reads bans from the file
+func (r *PeerReputations) load() {
+	if len(r.path) == 0 {
+		return
+	}
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			r.log.Warnf("[p2p] failed to read banned peers from %s: %v", r.path, err)
+		}
+		return
+	}
+	bans := make(map[string]time.Time)
+	if err = json.Unmarshal(data, &bans); err != nil {
+		r.log.Warnf("[p2p] failed to parse banned peers from %s: %v", r.path, err)
+		return
+	}
+	now := time.Now()
+	for ip, expiration := range bans {
+		if now.Before(expiration) {
+			r.bans[ip] = expiration
+		}
+	}
+	r.log.Infof("[p2p] loaded %d banned peers", len(r.bans))
+}
+
+//save writes bans to the file
+func (r *PeerReputations) save() {
+	if len(r.path) == 0 {
+		return
+	}
+	data, _ := json.Marshal(r.bans)
+	if err := ioutil.WriteFile(r.path, data, 0644); err != nil {
+		r.log.Warnf("[p2p] failed to save banned peers to %s: %v", r.path, err)
+	}
+}
@@ -0,0 +1,59 @@
+package netserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coschain/contentos-go/p2p/common"
+	"github.com/sirupsen/logrus"
+)
+
+func TestPeerReputations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reputation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, common.BANNED_PEERS_FILE)
+
+	r := NewPeerReputations(path, logrus.New())
+	if r.Punish("10.0.0.1", common.PENALTY_INVALID_TRX) {
+		t.Error("TestPeerReputations banned a peer below threshold")
+	}
+	if reps := r.List(); len(reps) != 1 || reps[0].Ip != "10.0.0.1" || !reps[0].BannedUntil.IsZero() {
+		t.Error("TestPeerReputations wrong reputation list", reps)
+	}
+	banned := false
+	for i := 0; i <= common.BAN_SCORE_THRESHOLD/common.PENALTY_INVALID_BLOCK && !banned; i++ {
+		banned = r.Punish("10.0.0.2", common.PENALTY_INVALID_BLOCK)
+	}
+	if !banned || !r.IsBanned("10.0.0.2") {
+		t.Error("TestPeerReputations peer not banned after reaching threshold")
+	}
+	r.Ban("10.0.0.3", time.Hour)
+	if reps := r.List(); len(reps) != 3 || reps[0].BannedUntil.IsZero() || reps[2].Ip != "10.0.0.1" {
+		t.Error("TestPeerReputations wrong reputation list", reps)
+	}
+
+	// bans survive restarts, but penalty scores don't
+	r = NewPeerReputations(path, logrus.New())
+	if !r.IsBanned("10.0.0.2") || !r.IsBanned("10.0.0.3") || len(r.List()) != 2 {
+		t.Error("TestPeerReputations bans not restored")
+	}
+	if !r.Unban("10.0.0.3") || r.IsBanned("10.0.0.3") || r.Unban("10.0.0.3") {
+		t.Error("TestPeerReputations unban failed")
+	}
+	r.Ban("10.0.0.4", time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	if r.IsBanned("10.0.0.4") {
+		t.Error("TestPeerReputations ban not expired")
+	}
+
+	r = NewPeerReputations(path, logrus.New())
+	if reps := r.List(); len(reps) != 1 || reps[0].Ip != "10.0.0.2" {
+		t.Error("TestPeerReputations wrong bans restored", reps)
+	}
+}
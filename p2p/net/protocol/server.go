@@ -1,10 +1,14 @@
 package p2p
 
 import (
+	"time"
+
+	"github.com/coschain/contentos-go/iservices"
 	"github.com/coschain/contentos-go/node"
 	"github.com/coschain/contentos-go/p2p/common"
 	"github.com/coschain/contentos-go/p2p/message/types"
 	"github.com/coschain/contentos-go/p2p/peer"
+	"github.com/coschain/contentos-go/prototype"
 	"github.com/sirupsen/logrus"
 )
 
@@ -52,8 +56,17 @@ type P2P interface {
 	GetContex() *node.ServiceContext
 	GetLog() *logrus.Logger
 	GetMagic() uint32
+	GetNodeKey() *prototype.PrivateKeyType
+	GetValidatorKey() *prototype.PrivateKeyType
+	IsValidatorKey(key *prototype.PublicKeyType) bool
 
 	RememberMsg(hash [common.HashSize]byte) (isNew bool)
 
+	PunishPeer(addr string, penalty int, reason string)
+	IsBannedAddr(addr string) bool
+	BanPeer(ip string, duration time.Duration) error
+	UnbanPeer(ip string) error
+	GetPeerReputations() []iservices.PeerReputation
+
 	CheckStartUpFinished() bool
 }
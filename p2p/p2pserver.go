@@ -19,6 +19,7 @@ import (
 	"github.com/coschain/contentos-go/p2p/message/msg_pack"
 	msgtypes "github.com/coschain/contentos-go/p2p/message/types"
 	"github.com/coschain/contentos-go/p2p/message/utils"
+	"github.com/coschain/contentos-go/p2p/net/discovery"
 	"github.com/coschain/contentos-go/p2p/net/netserver"
 	"github.com/coschain/contentos-go/p2p/net/protocol"
 	"github.com/coschain/contentos-go/p2p/peer"
@@ -32,6 +33,7 @@ type P2PServer struct {
 	iservices.IP2P
 	Network   p2p.P2P
 	msgRouter *utils.MessageRouter
+	discovery *discovery.Discovery
 	ReconnectAddrs
 	quitSeed       chan bool
 	//quitOnline     chan bool
@@ -86,6 +88,9 @@ func (this *P2PServer) Start(node *node.Node) error {
 	} else {
 		return errors.New("[p2p]msg router invalid")
 	}
+	if err := this.startDiscovery(); err != nil {
+		this.log.Error("[p2p] failed to start dht discovery ", err)
+	}
 	go this.connectSeedService()
 	//go this.keepOnlineService()
 	go this.heartBeatService()
@@ -99,6 +104,32 @@ func (this *P2PServer) Stop() error {
 	//this.quitOnline <- true
 	this.quitHeartBeat <- true
 	this.msgRouter.Stop()
+	if this.discovery != nil {
+		this.discovery.Stop()
+	}
+	return nil
+}
+
+//startDiscovery starts the dht discovery on the udp port of the same number as the sync port.
+//Discovery is disabled in reserved peers only mode, since we never connect to other peers.
+func (this *P2PServer) startDiscovery() error {
+	cfg := this.ctx.Config().P2P
+	if !cfg.EnableDiscovery || cfg.ReservedPeersOnly {
+		return nil
+	}
+	if this.Network.GetNodeKey() == nil {
+		return errors.New("[p2p] node key not loaded")
+	}
+	port := uint16(cfg.NodePort)
+	d, err := discovery.NewDiscovery(this.Network.GetNodeKey(), this.Network.GetMagic(), port, port,
+		cfg.Genesis.SeedList, this.ctx.ResolvePath(common.DISCOVERY_NODES_FILE), this.log)
+	if err != nil {
+		return err
+	}
+	if err = d.Start(); err != nil {
+		return err
+	}
+	this.discovery = d
 	return nil
 }
 
@@ -201,6 +232,25 @@ func (this *P2PServer) connectSeeds() {
 	}
 }
 
+//connectDiscoveredPeers connects random nodes found by the dht discovery until we have enough peers
+func (this *P2PServer) connectDiscoveredPeers() {
+	if this.discovery == nil {
+		return
+	}
+	target := this.ctx.Config().P2P.TargetPeerCount
+	if target == 0 {
+		target = common.DEFAULT_TARGET_PEER_COUNT
+	}
+	count := uint(len(this.Network.GetNeighbors()))
+	if count >= target {
+		return
+	}
+	//Connect skips the connected, banned and own addresses
+	for _, n := range this.discovery.RandomNodes(int(target - count)) {
+		go this.Network.Connect(n.TCPAddr(), false)
+	}
+}
+
 //getNode returns the peer with the id
 //func (this *P2PServer) getNode(id uint64) *peer.Peer {
 //	return this.Network.GetPeer(id)
@@ -294,6 +344,7 @@ func (this *P2PServer) connectSeedService() {
 		select {
 		case <-t.C:
 			this.connectSeeds()
+			this.connectDiscoveredPeers()
 			t.Stop()
 			t.Reset(time.Second * common.CONN_MONITOR)
 		case <-this.quitSeed:
@@ -336,6 +387,7 @@ func (this *P2PServer) heartBeatService() {
 		case <-t.C:
 			this.ping()
 			this.timeout()
+			this.checkValidators()
 		case <-this.quitHeartBeat:
 			t.Stop()
 			return
@@ -390,6 +442,20 @@ func (this *P2PServer) timeout() {
 	}
 }
 
+//checkValidators closes consensus links of peers no longer active validators, since the dynasty may change
+func (this *P2PServer) checkValidators() {
+	for _, p := range this.Network.GetNeighbors() {
+		if s := p.GetConsState(); s == common.INIT || s == common.INACTIVITY {
+			continue
+		}
+		if !this.Network.IsValidatorKey(p.GetValidatorKey()) {
+			this.log.Warnf("[p2p] peer %d - %s is no longer an active validator, close consensus link", p.GetID(), p.ConsLink.GetAddr())
+			p.SetValidatorKey(nil)
+			p.CloseCons()
+		}
+	}
+}
+
 //addToRetryList add retry address to ReconnectAddrs
 func (this *P2PServer) addToRetryList(addr string) {
 	this.ReconnectAddrs.Lock()
@@ -624,6 +690,18 @@ func (this *P2PServer) GetNodeNeighbours() string {
 	return peerList
 }
 
+func (this *P2PServer) GetPeerReputations() []iservices.PeerReputation {
+	return this.Network.GetPeerReputations()
+}
+
+func (this *P2PServer) BanPeer(ip string, duration time.Duration) error {
+	return this.Network.BanPeer(ip, duration)
+}
+
+func (this *P2PServer) UnbanPeer(ip string) error {
+	return this.Network.UnbanPeer(ip)
+}
+
 func (this *P2PServer) SetMockLatency(t int) {
 	this.mockLatency = t
 }
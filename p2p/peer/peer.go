@@ -11,6 +11,7 @@ import (
 	"github.com/coschain/contentos-go/p2p/common"
 	conn "github.com/coschain/contentos-go/p2p/link"
 	"github.com/coschain/contentos-go/p2p/message/types"
+	"github.com/coschain/contentos-go/prototype"
 	"github.com/sirupsen/logrus"
 	"github.com/willf/bloom"
 )
@@ -126,7 +127,10 @@ type Peer struct {
 
 	ConsensusCache     *common.HashCache
 
+	TrxRateLimiter     *common.RateLimiter
+
 	lastSeenBlkNum     uint64
+	validatorKey       *prototype.PublicKeyType //block signing key proved by peer on consensus link
 
 	connLock           sync.RWMutex
 	busy			   int32
@@ -149,6 +153,7 @@ func NewPeer(lg *logrus.Logger) *Peer {
 	p.TrxCache.useFilter2 = false
 
 	p.ConsensusCache = common.NewHashCache(common.DefaultHashCacheMaxCount)
+	p.TrxRateLimiter = common.NewRateLimiter(common.MAX_TRX_MSG_PER_SECOND)
 
 	p.SyncLink = conn.NewLink(p.log)
 	p.ConsLink = conn.NewLink(p.log)
@@ -435,6 +440,30 @@ func (this *Peer) UpdateInfo(t time.Time, version uint32, services uint64,
 	this.SetHeight(uint64(height))
 }
 
+//SetValidatorKey sets the validator key proved by peer on consensus link
+func (this *Peer) SetValidatorKey(key *prototype.PublicKeyType) {
+	this.connLock.Lock()
+	defer this.connLock.Unlock()
+	this.validatorKey = key
+}
+
+//GetValidatorKey returns the validator key proved by peer on consensus link
+func (this *Peer) GetValidatorKey() *prototype.PublicKeyType {
+	this.connLock.RLock()
+	defer this.connLock.RUnlock()
+	return this.validatorKey
+}
+
+//SetCap sets the protocol capabilities announced by peer
+func (this *Peer) SetCap(cap []byte) {
+	copy(this.cap[:], cap)
+}
+
+//HasCap returns whether peer announced the capability of given bit
+func (this *Peer) HasCap(bit uint) bool {
+	return bit < uint(len(this.cap)*8) && this.cap[bit/8]&(1<<(bit%8)) != 0
+}
+
 func (this *Peer) SetLastSeenBlkNum(num uint64) {
 	this.connLock.Lock()
 	if this.lastSeenBlkNum < num {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coschain/contentos-go/p2p/common"
 	"github.com/sirupsen/logrus"
 )
 
@@ -94,3 +95,14 @@ func TestUpdatePeer(t *testing.T) {
 	log := logrus.New()
 	p.DumpInfo(log)
 }
+
+func TestPeerCap(t *testing.T) {
+	p := NewPeer(logrus.New())
+	if p.HasCap(common.CAP_COMPACT_BLOCK) {
+		t.Errorf("Peer HasCap error before SetCap")
+	}
+	p.SetCap([]byte{1 << common.CAP_COMPACT_BLOCK})
+	if !p.HasCap(common.CAP_COMPACT_BLOCK) || p.HasCap(common.CAP_COMPACT_BLOCK+1) || p.HasCap(1024) {
+		t.Errorf("Peer HasCap error after SetCap")
+	}
+}
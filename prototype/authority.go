@@ -0,0 +1,80 @@
+package prototype
+
+import (
+	"fmt"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/pkg/errors"
+)
+
+// NewSingleKeyAuthority returns an authority satisfied by a single signature of given key.
+// Accounts without a multi-signature authority are treated as if they had one like this.
+func NewSingleKeyAuthority(key *PublicKeyType) *Authority {
+	return &Authority{
+		WeightThreshold: 1,
+		KeyAuths:        []*KeyWeight{{Key: key, Weight: 1}},
+	}
+}
+
+func (m *Authority) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if m.WeightThreshold == 0 {
+		return errors.New("weight threshold of authority must be positive")
+	}
+	if len(m.KeyAuths) == 0 {
+		return errors.New("authority has no keys")
+	}
+	if len(m.KeyAuths) > constants.MaxAuthorityKeyCount {
+		return fmt.Errorf("too many keys in authority, %d > %d", len(m.KeyAuths), constants.MaxAuthorityKeyCount)
+	}
+	keys := make(map[string]bool)
+	total := uint64(0)
+	for _, ka := range m.KeyAuths {
+		if ka == nil {
+			return ErrNpe
+		}
+		if err := ka.Key.Validate(); err != nil {
+			return errors.WithMessage(err, "authority key error")
+		}
+		if ka.Weight == 0 {
+			return fmt.Errorf("weight of key %s must be positive", ka.Key.ToWIF())
+		}
+		k := string(ka.Key.Data)
+		if keys[k] {
+			return fmt.Errorf("duplicated key %s in authority", ka.Key.ToWIF())
+		}
+		keys[k] = true
+		total += uint64(ka.Weight)
+	}
+	if total < uint64(m.WeightThreshold) {
+		return fmt.Errorf("authority can never be satisfied, total weight %d < threshold %d", total, m.WeightThreshold)
+	}
+	return nil
+}
+
+// SignedWeight returns the total weight of authority keys appearing in given signing keys.
+func (m *Authority) SignedWeight(signingKeys []*PublicKeyType) uint64 {
+	signed := make(map[string]bool)
+	for _, k := range signingKeys {
+		signed[string(k.GetData())] = true
+	}
+	w := uint64(0)
+	for _, ka := range m.GetKeyAuths() {
+		if signed[string(ka.GetKey().GetData())] {
+			w += uint64(ka.Weight)
+		}
+	}
+	return w
+}
+
+// Check checks if the combined weight of given signing keys reaches the threshold.
+func (m *Authority) Check(signingKeys []*PublicKeyType) error {
+	if m == nil {
+		return ErrNpe
+	}
+	if w := m.SignedWeight(signingKeys); w < uint64(m.WeightThreshold) {
+		return fmt.Errorf("insufficient signature weight, %d < %d", w, m.WeightThreshold)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package prototype
+
+import "github.com/coschain/contentos-go/common/encoding/kope"
+
+func (m *ContractEventId) OpeEncode() ([]byte, error) {
+	return kope.Encode(m.Contract, m.Block, m.Seq)
+}
+
+func (m *ContractEventNameOrder) OpeEncode() ([]byte, error) {
+	return kope.Encode(m.Contract, m.Name, m.Block, m.Seq)
+}
@@ -15,5 +15,6 @@ var (
 	ErrJSONFormatErr = errors.New("JSON Format Error")
 	ErrCoinZero = errors.New("Coin Value Zero Error")
 	ErrSigInvalidS    = errors.New("Invalid S value of signature")
+	ErrSigDuplicated  = errors.New("Duplicated signature")
 
 )
\ No newline at end of file
@@ -741,6 +741,218 @@ func (m *StakeRecordReverse) GetFrom() *AccountName {
 	return nil
 }
 
+type ContractEventId struct {
+	Contract             *ContractId `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Block                uint64      `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Seq                  uint64      `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ContractEventId) Reset()         { *m = ContractEventId{} }
+func (m *ContractEventId) String() string { return proto.CompactTextString(m) }
+func (*ContractEventId) ProtoMessage()    {}
+func (*ContractEventId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b47f83ece5cae8f, []int{15}
+}
+
+func (m *ContractEventId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventId.Unmarshal(m, b)
+}
+func (m *ContractEventId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventId.Marshal(b, m, deterministic)
+}
+func (m *ContractEventId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventId.Merge(m, src)
+}
+func (m *ContractEventId) XXX_Size() int {
+	return xxx_messageInfo_ContractEventId.Size(m)
+}
+func (m *ContractEventId) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventId proto.InternalMessageInfo
+
+func (m *ContractEventId) GetContract() *ContractId {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *ContractEventId) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ContractEventId) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ContractEventNameOrder struct {
+	Contract             *ContractId `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Block                uint64      `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Seq                  uint64      `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ContractEventNameOrder) Reset()         { *m = ContractEventNameOrder{} }
+func (m *ContractEventNameOrder) String() string { return proto.CompactTextString(m) }
+func (*ContractEventNameOrder) ProtoMessage()    {}
+func (*ContractEventNameOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b47f83ece5cae8f, []int{16}
+}
+
+func (m *ContractEventNameOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventNameOrder.Unmarshal(m, b)
+}
+func (m *ContractEventNameOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventNameOrder.Marshal(b, m, deterministic)
+}
+func (m *ContractEventNameOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventNameOrder.Merge(m, src)
+}
+func (m *ContractEventNameOrder) XXX_Size() int {
+	return xxx_messageInfo_ContractEventNameOrder.Size(m)
+}
+func (m *ContractEventNameOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventNameOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventNameOrder proto.InternalMessageInfo
+
+func (m *ContractEventNameOrder) GetContract() *ContractId {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *ContractEventNameOrder) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractEventNameOrder) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *ContractEventNameOrder) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type PostRevisionId struct {
+	PostId               uint64   `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Version              uint32   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostRevisionId) Reset()         { *m = PostRevisionId{} }
+func (m *PostRevisionId) String() string { return proto.CompactTextString(m) }
+func (*PostRevisionId) ProtoMessage()    {}
+func (*PostRevisionId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b47f83ece5cae8f, []int{17}
+}
+
+func (m *PostRevisionId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostRevisionId.Unmarshal(m, b)
+}
+func (m *PostRevisionId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostRevisionId.Marshal(b, m, deterministic)
+}
+func (m *PostRevisionId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevisionId.Merge(m, src)
+}
+func (m *PostRevisionId) XXX_Size() int {
+	return xxx_messageInfo_PostRevisionId.Size(m)
+}
+func (m *PostRevisionId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevisionId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostRevisionId proto.InternalMessageInfo
+
+func (m *PostRevisionId) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *PostRevisionId) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type TrxNonceId struct {
+	Signer               *AccountName `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Nonce                uint64       `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TrxNonceId) Reset()         { *m = TrxNonceId{} }
+func (m *TrxNonceId) String() string { return proto.CompactTextString(m) }
+func (*TrxNonceId) ProtoMessage()    {}
+func (*TrxNonceId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b47f83ece5cae8f, []int{18}
+}
+
+func (m *TrxNonceId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrxNonceId.Unmarshal(m, b)
+}
+func (m *TrxNonceId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrxNonceId.Marshal(b, m, deterministic)
+}
+func (m *TrxNonceId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrxNonceId.Merge(m, src)
+}
+func (m *TrxNonceId) XXX_Size() int {
+	return xxx_messageInfo_TrxNonceId.Size(m)
+}
+func (m *TrxNonceId) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrxNonceId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrxNonceId proto.InternalMessageInfo
+
+func (m *TrxNonceId) GetSigner() *AccountName {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *TrxNonceId) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FollowerRelation)(nil), "prototype.follower_relation")
 	proto.RegisterType((*FollowingRelation)(nil), "prototype.following_relation")
@@ -757,48 +969,60 @@ func init() {
 	proto.RegisterType((*UserTrxCreateOrder)(nil), "prototype.user_trx_create_order")
 	proto.RegisterType((*StakeRecord)(nil), "prototype.stake_record")
 	proto.RegisterType((*StakeRecordReverse)(nil), "prototype.stake_record_reverse")
+	proto.RegisterType((*ContractEventId)(nil), "prototype.contract_event_id")
+	proto.RegisterType((*ContractEventNameOrder)(nil), "prototype.contract_event_name_order")
+	proto.RegisterType((*PostRevisionId)(nil), "prototype.post_revision_id")
+	proto.RegisterType((*TrxNonceId)(nil), "prototype.trx_nonce_id")
 }
 
 func init() { proto.RegisterFile("prototype/multi_id.proto", fileDescriptor_7b47f83ece5cae8f) }
 
 var fileDescriptor_7b47f83ece5cae8f = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xd3, 0x36, 0x4d, 0xa6, 0x05, 0xd4, 0x25, 0xb4, 0x05, 0x2e, 0xc8, 0x17, 0x10, 0x50,
-	0x47, 0xa5, 0xe2, 0x82, 0x10, 0x07, 0x6e, 0xdc, 0x2a, 0x0b, 0x71, 0xe0, 0xb2, 0xda, 0xec, 0x4e,
-	0x93, 0x55, 0x1d, 0x8f, 0xb5, 0x5e, 0x27, 0x54, 0x20, 0x6e, 0xf0, 0x59, 0x48, 0xfc, 0x19, 0xf2,
-	0x7a, 0xe3, 0xc4, 0x95, 0xa8, 0xad, 0x72, 0xe0, 0x62, 0x79, 0x67, 0xdf, 0xbc, 0x79, 0xf3, 0x66,
-	0x16, 0x8e, 0x33, 0x43, 0x96, 0xec, 0x55, 0x86, 0xe3, 0x79, 0x91, 0x58, 0xcd, 0xb5, 0x8a, 0x5c,
-	0x88, 0x0d, 0xeb, 0x9b, 0x47, 0xa3, 0x35, 0xa8, 0xfc, 0x54, 0x80, 0xf0, 0x2b, 0x1c, 0x5c, 0x50,
-	0x92, 0xd0, 0x12, 0x0d, 0x37, 0x98, 0x08, 0xab, 0x29, 0x65, 0xa7, 0xb0, 0x2b, 0xa4, 0xa4, 0x22,
-	0xb5, 0xc7, 0xc1, 0x93, 0xe0, 0xd9, 0xde, 0xab, 0xa3, 0xa8, 0x4e, 0x8e, 0xfc, 0x0d, 0x4f, 0xc5,
-	0x1c, 0xe3, 0x15, 0x8e, 0x9d, 0xc1, 0x60, 0xc5, 0x73, 0xdc, 0xbb, 0x39, 0xa7, 0x06, 0x86, 0xdf,
-	0x81, 0x55, 0xff, 0x3a, 0x9d, 0xfe, 0x53, 0xf5, 0xd7, 0x30, 0xac, 0x89, 0xda, 0xca, 0xaf, 0x91,
-	0xe1, 0xaf, 0x00, 0x0e, 0xeb, 0xee, 0xa5, 0x41, 0x61, 0x51, 0x71, 0x32, 0x0a, 0xcd, 0x6d, 0x44,
-	0xbc, 0x85, 0xfd, 0x15, 0x87, 0xd5, 0x73, 0xf4, 0x3a, 0x1e, 0x6e, 0xe4, 0x95, 0x61, 0x9e, 0x91,
-	0x4e, 0x2d, 0xcf, 0x51, 0xc6, 0x7b, 0x1e, 0xfe, 0x51, 0xcf, 0xb1, 0x61, 0xe0, 0x56, 0x57, 0x03,
-	0x7f, 0x07, 0x70, 0xb4, 0x76, 0xf0, 0x3f, 0x77, 0xd0, 0x18, 0xc2, 0x56, 0xe7, 0x21, 0x5c, 0x00,
-	0xcb, 0x28, 0xb7, 0xd7, 0xd4, 0x9f, 0xc1, 0xae, 0x0f, 0x78, 0xf5, 0x37, 0xa8, 0x58, 0x21, 0xd9,
-	0x63, 0x18, 0x66, 0xc2, 0x60, 0x6a, 0xb9, 0x56, 0x4e, 0xfc, 0x76, 0x3c, 0xa8, 0x02, 0x1f, 0x54,
-	0x18, 0xc3, 0x60, 0x41, 0x16, 0x0d, 0xd7, 0x8a, 0x9d, 0xc0, 0x8e, 0xfb, 0x6f, 0x73, 0xa6, 0x42,
-	0xb1, 0x23, 0xd8, 0x75, 0x12, 0x6b, 0xd6, 0x7e, 0x79, 0x74, 0x9c, 0x30, 0xc9, 0xf8, 0x02, 0xdd,
-	0x1d, 0x3b, 0x84, 0xbe, 0x90, 0x56, 0x2f, 0xd0, 0xd1, 0x0e, 0x62, 0x7f, 0x62, 0x2f, 0x61, 0x58,
-	0xf2, 0x38, 0x9c, 0xf7, 0xf4, 0xde, 0x46, 0xc5, 0x32, 0x1c, 0x3b, 0x6d, 0x9f, 0x30, 0xb7, 0xe1,
-	0x8f, 0x00, 0x46, 0x93, 0x8c, 0x4f, 0x12, 0x92, 0x97, 0x3c, 0x33, 0xa4, 0x0a, 0x59, 0x89, 0x7e,
-	0x07, 0x77, 0x9b, 0xc1, 0x36, 0xf5, 0x77, 0x1c, 0xfc, 0xdc, 0xa3, 0xd7, 0x4d, 0xf7, 0xba, 0x34,
-	0x1d, 0xc6, 0xb0, 0x27, 0x29, 0xb5, 0x46, 0x48, 0xeb, 0x2d, 0xa3, 0x65, 0xda, 0xc1, 0x32, 0x87,
-	0x62, 0x23, 0xd8, 0x91, 0xe5, 0xd9, 0x15, 0x1b, 0xc6, 0xd5, 0x21, 0x9c, 0xc2, 0x7d, 0x83, 0x59,
-	0x72, 0x75, 0x6d, 0xd8, 0x8d, 0xb9, 0x05, 0xcd, 0xb9, 0x6d, 0x6e, 0x42, 0xaf, 0xeb, 0x26, 0x84,
-	0xdf, 0xe0, 0xb0, 0xc8, 0xd1, 0xf0, 0x8d, 0xcd, 0xf2, 0xb5, 0xc6, 0xd0, 0x17, 0x85, 0x9d, 0x51,
-	0x6b, 0x23, 0x1e, 0xc6, 0x4e, 0xa1, 0x5f, 0x11, 0xb4, 0x97, 0xf7, 0xc0, 0x90, 0xc3, 0x81, 0xc1,
-	0xa5, 0x30, 0x8a, 0x4b, 0x91, 0xcf, 0xa8, 0x70, 0x06, 0xde, 0xe2, 0x3d, 0xfe, 0x75, 0xef, 0x7e,
-	0x06, 0xf0, 0xc0, 0xf5, 0x67, 0xcd, 0x97, 0x66, 0x7b, 0xa7, 0xde, 0xad, 0xf6, 0xfe, 0x56, 0x38,
-	0xf6, 0x06, 0xfc, 0x33, 0xee, 0xf8, 0xe8, 0xa1, 0x42, 0x97, 0x6f, 0x3e, 0x54, 0xb0, 0x9f, 0x5b,
-	0x71, 0x89, 0xdc, 0xa0, 0x24, 0xa3, 0xd8, 0x0b, 0xd8, 0xbe, 0x30, 0x34, 0x6f, 0xab, 0xed, 0x40,
-	0xec, 0x29, 0xf4, 0x2c, 0xb5, 0x6d, 0x63, 0xcf, 0x52, 0x98, 0xc0, 0x68, 0xb3, 0x0a, 0x37, 0xb8,
-	0x40, 0x93, 0xa3, 0x27, 0x08, 0x5a, 0x09, 0x6a, 0x59, 0xbd, 0x0e, 0xb2, 0xde, 0x9f, 0x43, 0xa8,
-	0x29, 0x2a, 0x77, 0x1f, 0x53, 0x4b, 0x79, 0x24, 0x52, 0x65, 0x48, 0xab, 0x28, 0x57, 0x97, 0xeb,
-	0xc4, 0xcf, 0xcf, 0xa7, 0xda, 0xce, 0x8a, 0x49, 0x24, 0x69, 0x3e, 0x96, 0x94, 0xcb, 0x99, 0xd0,
-	0xe9, 0xb8, 0x4e, 0x3a, 0x99, 0xd2, 0xb8, 0xc6, 0x4e, 0xfa, 0xee, 0xf7, 0xec, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0xc0, 0x94, 0x72, 0xa8, 0x07, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4d, 0x6f, 0x13, 0x3d,
+	0x10, 0xd6, 0x26, 0x69, 0x3e, 0xa6, 0xed, 0xfb, 0xb6, 0x26, 0xa4, 0x29, 0x5c, 0x90, 0x2f, 0x20,
+	0xa0, 0x89, 0xda, 0x8a, 0x0b, 0x42, 0x1c, 0x90, 0x38, 0x70, 0xab, 0x56, 0xc0, 0x81, 0x8b, 0xb5,
+	0xf1, 0xba, 0x89, 0xd5, 0xc4, 0xb3, 0x78, 0x9d, 0x94, 0x0a, 0xc4, 0x0d, 0x2e, 0xfc, 0x27, 0x24,
+	0xfe, 0x19, 0xb2, 0xd7, 0xbb, 0xd9, 0x54, 0xc0, 0xae, 0xca, 0x81, 0xcb, 0xca, 0x33, 0x7e, 0xfc,
+	0xcc, 0x33, 0x5f, 0x0b, 0xc3, 0x44, 0xa3, 0x41, 0x73, 0x95, 0x88, 0xf1, 0x62, 0x39, 0x37, 0x92,
+	0xc9, 0x78, 0xe4, 0x5c, 0xa4, 0x57, 0xdc, 0xdc, 0xe9, 0xaf, 0x41, 0xf6, 0x93, 0x01, 0xe8, 0x47,
+	0xd8, 0x3f, 0xc7, 0xf9, 0x1c, 0x2f, 0x85, 0x66, 0x5a, 0xcc, 0x23, 0x23, 0x51, 0x91, 0x63, 0xe8,
+	0x44, 0x9c, 0xe3, 0x52, 0x99, 0x61, 0x70, 0x2f, 0x78, 0xb0, 0x7d, 0x72, 0x30, 0x2a, 0x1e, 0x8f,
+	0xfc, 0x0d, 0x53, 0xd1, 0x42, 0x84, 0x39, 0x8e, 0x9c, 0x42, 0x37, 0xe7, 0x19, 0x36, 0xfe, 0xfc,
+	0xa6, 0x00, 0xd2, 0xcf, 0x40, 0xb2, 0xb3, 0x54, 0xd3, 0xbf, 0x8a, 0xfe, 0x04, 0x7a, 0x05, 0x51,
+	0x55, 0xf8, 0x35, 0x92, 0x7e, 0x0f, 0x60, 0x50, 0x64, 0xcf, 0xb5, 0x88, 0x8c, 0x88, 0x19, 0xea,
+	0x58, 0xe8, 0x9b, 0x88, 0x78, 0x06, 0x3b, 0x39, 0x87, 0x91, 0x0b, 0xe1, 0x75, 0x1c, 0x96, 0xde,
+	0x59, 0x37, 0x4b, 0x50, 0x2a, 0xc3, 0x52, 0xc1, 0xc3, 0x6d, 0x0f, 0x7f, 0x2d, 0x17, 0x62, 0xa3,
+	0x80, 0xcd, 0xba, 0x05, 0xfc, 0x11, 0xc0, 0xc1, 0xba, 0x82, 0xff, 0x38, 0x83, 0x8d, 0x26, 0x34,
+	0x6b, 0x37, 0xe1, 0x1c, 0x48, 0x82, 0xa9, 0xb9, 0xa6, 0xfe, 0x14, 0x3a, 0xde, 0x31, 0x0c, 0xaa,
+	0x54, 0xe4, 0x48, 0x72, 0x17, 0x7a, 0x49, 0xa4, 0x85, 0x32, 0x4c, 0xc6, 0x4e, 0x7c, 0x2b, 0xec,
+	0x66, 0x8e, 0x57, 0x31, 0x0d, 0xa1, 0xbb, 0x42, 0x23, 0x34, 0x93, 0x31, 0x39, 0x82, 0x2d, 0x77,
+	0xae, 0xaa, 0x4c, 0x86, 0x22, 0x07, 0xd0, 0x71, 0x12, 0x0b, 0xd6, 0xb6, 0x35, 0x1d, 0x27, 0x4c,
+	0x12, 0xb6, 0x12, 0xee, 0x8e, 0x0c, 0xa0, 0x1d, 0x71, 0x23, 0x57, 0xc2, 0xd1, 0x76, 0x43, 0x6f,
+	0x91, 0xc7, 0xd0, 0xb3, 0x3c, 0x0e, 0xe7, 0x6b, 0xfa, 0x7f, 0x29, 0xa2, 0x75, 0x87, 0x4e, 0xdb,
+	0x5b, 0x91, 0x1a, 0xfa, 0x25, 0x80, 0xfe, 0x24, 0x61, 0x93, 0x39, 0xf2, 0x0b, 0x96, 0x68, 0x8c,
+	0x97, 0x3c, 0x13, 0xfd, 0x1c, 0xfe, 0xdb, 0x74, 0x56, 0xa9, 0xdf, 0x75, 0xf0, 0x33, 0x8f, 0x5e,
+	0x27, 0xdd, 0xa8, 0x93, 0x34, 0x0d, 0x61, 0x9b, 0xa3, 0x32, 0x3a, 0xe2, 0xc6, 0x97, 0x0c, 0x2f,
+	0x55, 0x8d, 0x92, 0x39, 0x14, 0xe9, 0xc3, 0x16, 0xb7, 0xb6, 0x0b, 0xd6, 0x0b, 0x33, 0x83, 0x4e,
+	0xe1, 0x96, 0x16, 0xc9, 0xfc, 0xea, 0x5a, 0xb3, 0x37, 0xfa, 0x16, 0x6c, 0xf6, 0xad, 0x3c, 0x09,
+	0x8d, 0xba, 0x93, 0x40, 0x3f, 0xc1, 0x60, 0x99, 0x0a, 0xcd, 0x4a, 0x93, 0xe5, 0x63, 0x8d, 0xa1,
+	0x1d, 0x2d, 0xcd, 0x0c, 0x2b, 0x13, 0xf1, 0x30, 0x72, 0x0c, 0xed, 0x8c, 0xa0, 0x3a, 0xbc, 0x07,
+	0x52, 0x06, 0xfb, 0x5a, 0x5c, 0x46, 0x3a, 0x66, 0x3c, 0x4a, 0x67, 0xb8, 0x74, 0x05, 0xbc, 0xc1,
+	0x3e, 0xfe, 0x76, 0xee, 0xbe, 0x06, 0x70, 0xdb, 0xe5, 0x67, 0xf4, 0x87, 0xcd, 0xf4, 0x8e, 0x7d,
+	0xb5, 0xaa, 0xf3, 0xcb, 0x71, 0xe4, 0x29, 0xf8, 0x35, 0xae, 0xb9, 0xf4, 0x90, 0xa1, 0xed, 0xce,
+	0xd3, 0x18, 0x76, 0x52, 0x13, 0x5d, 0x08, 0xa6, 0x05, 0x47, 0x1d, 0x93, 0x47, 0xd0, 0x3a, 0xd7,
+	0xb8, 0xa8, 0x8a, 0xed, 0x40, 0xe4, 0x3e, 0x34, 0x0c, 0x56, 0x4d, 0x63, 0xc3, 0x20, 0x9d, 0x43,
+	0xbf, 0x1c, 0x85, 0x69, 0xb1, 0x12, 0x3a, 0x15, 0x9e, 0x20, 0xa8, 0x24, 0x28, 0x64, 0x35, 0x6a,
+	0xc8, 0xa2, 0x08, 0xfb, 0xc5, 0xe0, 0x8b, 0x55, 0x36, 0x95, 0xe4, 0x04, 0xba, 0xb9, 0xd3, 0x07,
+	0x1c, 0x94, 0x58, 0x4a, 0x8b, 0x12, 0x16, 0x38, 0xbb, 0x03, 0x6e, 0x03, 0x7d, 0xf3, 0x32, 0x83,
+	0xec, 0x41, 0x33, 0x15, 0xef, 0xdd, 0x0f, 0xb2, 0x15, 0xda, 0x23, 0xfd, 0x16, 0xc0, 0xe1, 0xb5,
+	0x88, 0x56, 0x8e, 0xef, 0xe8, 0x4d, 0x22, 0x13, 0x68, 0x95, 0x96, 0xcf, 0x9d, 0xd7, 0x6a, 0x9a,
+	0xbf, 0x50, 0xd3, 0x5a, 0xab, 0x79, 0x09, 0x7b, 0x6e, 0xe8, 0xb4, 0x58, 0xc9, 0x54, 0xa2, 0xb2,
+	0xd9, 0x97, 0x06, 0x31, 0x28, 0x0f, 0x22, 0x19, 0x42, 0xc7, 0xb6, 0x42, 0xa2, 0x72, 0xb1, 0x76,
+	0xc3, 0xdc, 0xa4, 0x6f, 0x60, 0xc7, 0x0e, 0xa7, 0x42, 0xc5, 0x85, 0xa5, 0x18, 0x43, 0x3b, 0x95,
+	0xd3, 0x1a, 0x3f, 0x10, 0x0f, 0xb3, 0x7a, 0xdd, 0xe3, 0xbc, 0x7a, 0xce, 0x78, 0x71, 0x06, 0x54,
+	0xa2, 0xcb, 0x5a, 0x28, 0x83, 0xe9, 0x28, 0x52, 0xb1, 0x46, 0x19, 0x8f, 0xd2, 0xf8, 0x62, 0x4d,
+	0xf8, 0xee, 0xe1, 0x54, 0x9a, 0xd9, 0x72, 0x32, 0xe2, 0xb8, 0x18, 0x73, 0x4c, 0xf9, 0x2c, 0x92,
+	0x6a, 0x5c, 0x3c, 0x3a, 0x9a, 0xe2, 0xb8, 0xc0, 0x4e, 0xda, 0xee, 0x78, 0xfa, 0x73, 0x00, 0x92,
+	0xef, 0x73, 0x49, 0x45, 0x09, 0x00, 0x00,
 }
@@ -32,6 +32,12 @@ func (a *AccountCreateOperation) Validate() error {
 		return errors.WithMessage(err, "Owner error")
 	}
 
+	if a.Authority != nil {
+		if err := a.Authority.Validate(); err != nil {
+			return errors.WithMessage(err, "Authority error")
+		}
+	}
+
 	if a.Fee == nil || a.Fee.Value == 0 {
 		return errors.New("Account Create must set Fee")
 	}
@@ -45,4 +51,5 @@ func (a *AccountCreateOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("account_create", (*Operation_Op1)(nil), (*AccountCreateOperation)(nil));
+	registerOperationPermission((*AccountCreateOperation)(nil), PermissionActive)
 }
@@ -11,6 +11,21 @@ func (m *AccountUpdateOperation) Validate() error {
 	if err := m.Owner.Validate(); err != nil{
 		return err
 	}
+	if m.Authority != nil {
+		if err := m.Authority.Validate(); err != nil{
+			return err
+		}
+	}
+	if m.ActiveAuthority != nil {
+		if err := m.ActiveAuthority.Validate(); err != nil{
+			return err
+		}
+	}
+	if m.PostingAuthority != nil {
+		if err := m.PostingAuthority.Validate(); err != nil{
+			return err
+		}
+	}
 
 	return nil
 }
@@ -21,4 +36,5 @@ func (m *AccountUpdateOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("account_update", (*Operation_Op20)(nil), (*AccountUpdateOperation)(nil));
+	registerOperationPermission((*AccountUpdateOperation)(nil), PermissionOwner)
 }
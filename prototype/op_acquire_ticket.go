@@ -33,4 +33,5 @@ func (a *AcquireTicketOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("acquire_ticket", (*Operation_Op21)(nil), (*AcquireTicketOperation)(nil));
+	registerOperationPermission((*AcquireTicketOperation)(nil), PermissionActive)
 }
@@ -24,4 +24,5 @@ func (m *BpEnableOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("bp_enable", (*Operation_Op4)(nil), (*BpEnableOperation)(nil));
+	registerOperationPermission((*BpEnableOperation)(nil), PermissionActive)
 }
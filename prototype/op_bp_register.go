@@ -38,4 +38,5 @@ func (m *BpRegisterOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("bp_register", (*Operation_Op3)(nil), (*BpRegisterOperation)(nil));
+	registerOperationPermission((*BpRegisterOperation)(nil), PermissionActive)
 }
@@ -23,4 +23,5 @@ func (m *BpUpdateOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("bp_update", (*Operation_Op19)(nil), (*BpUpdateOperation)(nil));
+	registerOperationPermission((*BpUpdateOperation)(nil), PermissionActive)
 }
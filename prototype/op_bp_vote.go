@@ -27,4 +27,5 @@ func (m *BpVoteOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("bp_vote", (*Operation_Op5)(nil), (*BpVoteOperation)(nil));
+	registerOperationPermission((*BpVoteOperation)(nil), PermissionActive)
 }
@@ -0,0 +1,32 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *CancelScheduledTransferOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetAccount().GetValue()] = true
+}
+
+func (m *CancelScheduledTransferOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetAccount().Validate(); err != nil {
+		return errors.WithMessage(err, "account error")
+	}
+	if m.GetTransferId() == 0 {
+		return errors.New("invalid transfer id")
+	}
+	return nil
+}
+
+func (m *CancelScheduledTransferOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("cancel_scheduled_transfer", (*Operation_Op26)(nil), (*CancelScheduledTransferOperation)(nil))
+	registerOperationPermission((*CancelScheduledTransferOperation)(nil), PermissionActive)
+}
@@ -30,4 +30,5 @@ func (m *ContractApplyOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("contract_apply", (*Operation_Op14)(nil), (*ContractApplyOperation)(nil));
+	registerOperationPermission((*ContractApplyOperation)(nil), PermissionActive)
 }
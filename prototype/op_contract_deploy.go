@@ -40,4 +40,5 @@ func (m *ContractDeployOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("contract_deploy", (*Operation_Op13)(nil), (*ContractDeployOperation)(nil));
+	registerOperationPermission((*ContractDeployOperation)(nil), PermissionActive)
 }
@@ -28,4 +28,5 @@ func (m *ConvertVestOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("convert_vest", (*Operation_Op16)(nil), (*ConvertVestOperation)(nil));
+	registerOperationPermission((*ConvertVestOperation)(nil), PermissionActive)
 }
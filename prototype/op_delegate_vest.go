@@ -38,4 +38,5 @@ func (m *DelegateVestOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("delegate_vest", (*Operation_Op23)(nil), (*DelegateVestOperation)(nil))
+	registerOperationPermission((*DelegateVestOperation)(nil), PermissionActive)
 }
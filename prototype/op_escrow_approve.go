@@ -0,0 +1,32 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *EscrowApproveOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetAccount().GetValue()] = true
+}
+
+func (m *EscrowApproveOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetAccount().Validate(); err != nil {
+		return errors.WithMessage(err, "account error")
+	}
+	if m.GetEscrowId() == 0 {
+		return errors.New("invalid escrow id")
+	}
+	return nil
+}
+
+func (m *EscrowApproveOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("escrow_approve", (*Operation_Op28)(nil), (*EscrowApproveOperation)(nil))
+	registerOperationPermission((*EscrowApproveOperation)(nil), PermissionActive)
+}
@@ -0,0 +1,38 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *EscrowReleaseOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetAccount().GetValue()] = true
+}
+
+func (m *EscrowReleaseOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetAccount().Validate(); err != nil {
+		return errors.WithMessage(err, "account error")
+	}
+	if m.GetEscrowId() == 0 {
+		return errors.New("invalid escrow id")
+	}
+	if err := m.GetReceiver().Validate(); err != nil {
+		return errors.WithMessage(err, "receiver account error")
+	}
+	if m.GetAmount() == nil || !m.GetAmount().NonZero() {
+		return errors.New("escrow release op must has amount value")
+	}
+	return nil
+}
+
+func (m *EscrowReleaseOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("escrow_release", (*Operation_Op30)(nil), (*EscrowReleaseOperation)(nil))
+	registerOperationPermission((*EscrowReleaseOperation)(nil), PermissionActive)
+}
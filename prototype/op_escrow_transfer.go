@@ -0,0 +1,53 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *EscrowTransferOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetFrom().GetValue()] = true
+}
+
+func (m *EscrowTransferOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetFrom().Validate(); err != nil {
+		return errors.WithMessage(err, "from account error")
+	}
+	if err := m.GetTo().Validate(); err != nil {
+		return errors.WithMessage(err, "to account error")
+	}
+	if err := m.GetAgent().Validate(); err != nil {
+		return errors.WithMessage(err, "agent account error")
+	}
+	if m.GetAgent().GetValue() == m.GetFrom().GetValue() || m.GetAgent().GetValue() == m.GetTo().GetValue() {
+		return errors.New("agent must be a third party")
+	}
+	if m.GetAmount() == nil || !m.GetAmount().NonZero() {
+		return errors.New("escrow transfer op must has amount value")
+	}
+	if m.GetAgentFee() == nil {
+		return errors.New("escrow transfer op must has agent fee")
+	}
+	if err := AtMost4KChars(m.GetMemo()); err != nil {
+		return errors.WithMessage(err, "invalid memo")
+	}
+	if m.GetRatificationDeadline() == nil || m.GetEscrowExpiration() == nil {
+		return errors.New("escrow transfer op must has ratification deadline and expiration")
+	}
+	if m.GetRatificationDeadline().GetUtcSeconds() > m.GetEscrowExpiration().GetUtcSeconds() {
+		return errors.New("ratification deadline must be before escrow expiration")
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("escrow_transfer", (*Operation_Op27)(nil), (*EscrowTransferOperation)(nil))
+	registerOperationPermission((*EscrowTransferOperation)(nil), PermissionActive)
+}
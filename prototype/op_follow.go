@@ -28,4 +28,5 @@ func (m *FollowOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("follow", (*Operation_Op8)(nil), (*FollowOperation)(nil));
+	registerOperationPermission((*FollowOperation)(nil), PermissionPosting)
 }
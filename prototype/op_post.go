@@ -48,4 +48,5 @@ func (m *PostOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("post", (*Operation_Op6)(nil), (*PostOperation)(nil));
+	registerOperationPermission((*PostOperation)(nil), PermissionPosting)
 }
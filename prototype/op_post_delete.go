@@ -0,0 +1,36 @@
+package prototype
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/pkg/errors"
+)
+
+
+func (m *PostDeleteOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.Owner.Value] = true
+}
+
+func (m *PostDeleteOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+
+	if err := m.Owner.Validate(); err != nil {
+		return errors.WithMessage(err, "Owner error")
+	}
+
+	if m.Uuid == constants.PostInvalidId {
+		return errors.New("uuid cant be 0")
+	}
+
+	return nil
+}
+
+func (m *PostDeleteOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("post_delete", (*Operation_Op34)(nil), (*PostDeleteOperation)(nil));
+	registerOperationPermission((*PostDeleteOperation)(nil), PermissionPosting)
+}
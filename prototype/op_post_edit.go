@@ -0,0 +1,55 @@
+package prototype
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/pkg/errors"
+)
+
+
+func (m *PostEditOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.Owner.Value] = true
+}
+
+func (m *PostEditOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+
+	if err := m.Owner.Validate(); err != nil {
+		return errors.WithMessage(err, "Owner error")
+	}
+
+	if m.Uuid == constants.PostInvalidId {
+		return errors.New("uuid cant be 0")
+	}
+
+	if len(m.Content) > 0 && len(m.Patches) > 0 {
+		return errors.New("content and patches cant be both set")
+	}
+	if len(m.Title) == 0 && len(m.Content) == 0 && len(m.Patches) == 0 {
+		return errors.New("nothing to edit")
+	}
+
+	return nil
+}
+
+func (m *PostEditOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+// ApplyPatches applies patches of the operation to body in order.
+func (m *PostEditOperation) ApplyPatches(body string) (string, error) {
+	for i, p := range m.Patches {
+		end := uint64(p.Offset) + uint64(p.DeleteLen)
+		if end > uint64(len(body)) {
+			return "", errors.Errorf("patch #%d out of range", i)
+		}
+		body = body[:p.Offset] + p.Insert + body[end:]
+	}
+	return body, nil
+}
+
+func init() {
+	registerOperation("post_edit", (*Operation_Op33)(nil), (*PostEditOperation)(nil));
+	registerOperationPermission((*PostEditOperation)(nil), PermissionPosting)
+}
@@ -0,0 +1,56 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *ProposalCreateOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetProposer().GetValue()] = true
+}
+
+func (m *ProposalCreateOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetProposer().Validate(); err != nil {
+		return errors.WithMessage(err, "proposer error")
+	}
+	if err := stringLengthValidator(m.GetTitle(), 1, 256); err != nil {
+		return errors.WithMessage(err, "invalid title")
+	}
+	if err := AtMost4KChars(m.GetDescription()); err != nil {
+		return errors.WithMessage(err, "invalid description")
+	}
+	if m.GetProps() == nil && len(m.GetFeature()) == 0 && m.GetRewardPolicy() == nil {
+		return errors.New("proposal must change chain properties, reward policy or activate a feature")
+	}
+	if props := m.GetProps(); props != nil {
+		if props.GetAccountCreationFee() == nil || props.GetPerTicketPrice() == nil {
+			return errors.New("proposed chain properties must has account creation fee and ticket price")
+		}
+	}
+	if policy := m.GetRewardPolicy(); policy != nil {
+		if err := policy.Validate(); err != nil {
+			return errors.WithMessage(err, "invalid reward policy")
+		}
+	}
+	if len(m.GetFeature()) > 0 {
+		if err := ValidFeatureName(m.GetFeature()); err != nil {
+			return errors.WithMessage(err, "invalid feature name")
+		}
+	}
+	if m.GetActivationBlockNum() == 0 {
+		return errors.New("invalid activation block number")
+	}
+	return nil
+}
+
+func (m *ProposalCreateOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("proposal_create", (*Operation_Op31)(nil), (*ProposalCreateOperation)(nil))
+	registerOperationPermission((*ProposalCreateOperation)(nil), PermissionActive)
+}
@@ -0,0 +1,32 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *ProposalVoteOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetVoter().GetValue()] = true
+}
+
+func (m *ProposalVoteOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetVoter().Validate(); err != nil {
+		return errors.WithMessage(err, "voter error")
+	}
+	if m.GetProposalId() == 0 {
+		return errors.New("invalid proposal id")
+	}
+	return nil
+}
+
+func (m *ProposalVoteOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("proposal_vote", (*Operation_Op32)(nil), (*ProposalVoteOperation)(nil))
+	registerOperationPermission((*ProposalVoteOperation)(nil), PermissionActive)
+}
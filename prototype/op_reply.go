@@ -38,4 +38,5 @@ func (m *ReplyOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("reply", (*Operation_Op7)(nil), (*ReplyOperation)(nil));
+	registerOperationPermission((*ReplyOperation)(nil), PermissionPosting)
 }
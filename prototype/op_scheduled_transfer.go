@@ -0,0 +1,41 @@
+package prototype
+
+import (
+	"github.com/pkg/errors"
+)
+
+
+func (m *ScheduledTransferOperation) GetSigner(auths *map[string]bool) {
+	(*auths)[m.GetFrom().GetValue()] = true
+}
+
+func (m *ScheduledTransferOperation) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	if err := m.GetFrom().Validate(); err != nil {
+		return errors.WithMessage(err, "from account error")
+	}
+	if err := m.GetTo().Validate(); err != nil {
+		return errors.WithMessage(err, "to account error")
+	}
+	if m.GetAmount() == nil || !m.GetAmount().NonZero() {
+		return errors.New("scheduled transfer op must has amount value")
+	}
+	if err := AtMost4KChars(m.GetMemo()); err != nil {
+		return errors.WithMessage(err, "invalid memo")
+	}
+	if m.GetDueTime() == nil {
+		return errors.New("scheduled transfer op must has due time")
+	}
+	return nil
+}
+
+func (m *ScheduledTransferOperation) GetAffectedProps(props *map[string]bool) {
+	(*props)["*"] = true
+}
+
+func init() {
+	registerOperation("scheduled_transfer", (*Operation_Op25)(nil), (*ScheduledTransferOperation)(nil))
+	registerOperationPermission((*ScheduledTransferOperation)(nil), PermissionActive)
+}
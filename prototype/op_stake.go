@@ -25,4 +25,5 @@ func (m *StakeOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("stake", (*Operation_Op17)(nil), (*StakeOperation)(nil));
+	registerOperationPermission((*StakeOperation)(nil), PermissionActive)
 }
@@ -32,4 +32,5 @@ func (m *TransferOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("transfer", (*Operation_Op2)(nil), (*TransferOperation)(nil));
+	registerOperationPermission((*TransferOperation)(nil), PermissionActive)
 }
@@ -35,4 +35,5 @@ func (m *TransferToVestOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("transfer_to_vest", (*Operation_Op10)(nil), (*TransferToVestOperation)(nil));
+	registerOperationPermission((*TransferToVestOperation)(nil), PermissionActive)
 }
@@ -25,4 +25,5 @@ func (m *UnDelegateVestOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("un_delegate_vest", (*Operation_Op24)(nil), (*UnDelegateVestOperation)(nil))
+	registerOperationPermission((*UnDelegateVestOperation)(nil), PermissionActive)
 }
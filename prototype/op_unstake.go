@@ -24,4 +24,5 @@ func (m *UnStakeOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("un_stake", (*Operation_Op18)(nil), (*UnStakeOperation)(nil));
+	registerOperationPermission((*UnStakeOperation)(nil), PermissionActive)
 }
@@ -29,4 +29,5 @@ func (m *VoteOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("vote", (*Operation_Op9)(nil), (*VoteOperation)(nil));
+	registerOperationPermission((*VoteOperation)(nil), PermissionPosting)
 }
@@ -32,4 +32,5 @@ func (a *VoteByTicketOperation) GetAffectedProps(props *map[string]bool) {
 
 func init() {
 	registerOperation("vote_by_ticket", (*Operation_Op22)(nil), (*VoteByTicketOperation)(nil));
+	registerOperationPermission((*VoteByTicketOperation)(nil), PermissionActive)
 }
@@ -26,6 +26,7 @@ type AccountCreateOperation struct {
 	NewAccountName       *AccountName   `protobuf:"bytes,3,opt,name=new_account_name,json=newAccountName,proto3" json:"new_account_name,omitempty"`
 	PubKey               *PublicKeyType `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	JsonMetadata         string         `protobuf:"bytes,5,opt,name=json_metadata,json=jsonMetadata,proto3" json:"json_metadata,omitempty"`
+	Authority            *Authority     `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -91,9 +92,19 @@ func (m *AccountCreateOperation) GetJsonMetadata() string {
 	return ""
 }
 
+func (m *AccountCreateOperation) GetAuthority() *Authority {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
 type AccountUpdateOperation struct {
 	Owner                *AccountName   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	PubKey               *PublicKeyType `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Authority            *Authority     `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	ActiveAuthority      *Authority     `protobuf:"bytes,4,opt,name=active_authority,json=activeAuthority,proto3" json:"active_authority,omitempty"`
+	PostingAuthority     *Authority     `protobuf:"bytes,5,opt,name=posting_authority,json=postingAuthority,proto3" json:"posting_authority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -138,6 +149,27 @@ func (m *AccountUpdateOperation) GetPubKey() *PublicKeyType {
 	return nil
 }
 
+func (m *AccountUpdateOperation) GetAuthority() *Authority {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *AccountUpdateOperation) GetActiveAuthority() *Authority {
+	if m != nil {
+		return m.ActiveAuthority
+	}
+	return nil
+}
+
+func (m *AccountUpdateOperation) GetPostingAuthority() *Authority {
+	if m != nil {
+		return m.PostingAuthority
+	}
+	return nil
+}
+
 type TransferOperation struct {
 	From                 *AccountName `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	To                   *AccountName `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
@@ -1374,6 +1406,706 @@ func (m *UnDelegateVestOperation) GetOrderId() uint64 {
 	return 0
 }
 
+type ScheduledTransferOperation struct {
+	From                 *AccountName  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *AccountName  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               *Coin         `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo                 string        `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	DueTime              *TimePointSec `protobuf:"bytes,5,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScheduledTransferOperation) Reset()         { *m = ScheduledTransferOperation{} }
+func (m *ScheduledTransferOperation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTransferOperation) ProtoMessage()    {}
+func (*ScheduledTransferOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{22}
+}
+
+func (m *ScheduledTransferOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledTransferOperation.Unmarshal(m, b)
+}
+func (m *ScheduledTransferOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledTransferOperation.Marshal(b, m, deterministic)
+}
+func (m *ScheduledTransferOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTransferOperation.Merge(m, src)
+}
+func (m *ScheduledTransferOperation) XXX_Size() int {
+	return xxx_messageInfo_ScheduledTransferOperation.Size(m)
+}
+func (m *ScheduledTransferOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTransferOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTransferOperation proto.InternalMessageInfo
+
+func (m *ScheduledTransferOperation) GetFrom() *AccountName {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ScheduledTransferOperation) GetTo() *AccountName {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ScheduledTransferOperation) GetAmount() *Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ScheduledTransferOperation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *ScheduledTransferOperation) GetDueTime() *TimePointSec {
+	if m != nil {
+		return m.DueTime
+	}
+	return nil
+}
+
+type CancelScheduledTransferOperation struct {
+	Account              *AccountName `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TransferId           uint64       `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CancelScheduledTransferOperation) Reset()         { *m = CancelScheduledTransferOperation{} }
+func (m *CancelScheduledTransferOperation) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledTransferOperation) ProtoMessage()    {}
+func (*CancelScheduledTransferOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{23}
+}
+
+func (m *CancelScheduledTransferOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelScheduledTransferOperation.Unmarshal(m, b)
+}
+func (m *CancelScheduledTransferOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelScheduledTransferOperation.Marshal(b, m, deterministic)
+}
+func (m *CancelScheduledTransferOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledTransferOperation.Merge(m, src)
+}
+func (m *CancelScheduledTransferOperation) XXX_Size() int {
+	return xxx_messageInfo_CancelScheduledTransferOperation.Size(m)
+}
+func (m *CancelScheduledTransferOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledTransferOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelScheduledTransferOperation proto.InternalMessageInfo
+
+func (m *CancelScheduledTransferOperation) GetAccount() *AccountName {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *CancelScheduledTransferOperation) GetTransferId() uint64 {
+	if m != nil {
+		return m.TransferId
+	}
+	return 0
+}
+
+type EscrowTransferOperation struct {
+	From                 *AccountName  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *AccountName  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Agent                *AccountName  `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
+	Amount               *Coin         `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AgentFee             *Coin         `protobuf:"bytes,5,opt,name=agent_fee,json=agentFee,proto3" json:"agent_fee,omitempty"`
+	Memo                 string        `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	RatificationDeadline *TimePointSec `protobuf:"bytes,7,opt,name=ratification_deadline,json=ratificationDeadline,proto3" json:"ratification_deadline,omitempty"`
+	EscrowExpiration     *TimePointSec `protobuf:"bytes,8,opt,name=escrow_expiration,json=escrowExpiration,proto3" json:"escrow_expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EscrowTransferOperation) Reset()         { *m = EscrowTransferOperation{} }
+func (m *EscrowTransferOperation) String() string { return proto.CompactTextString(m) }
+func (*EscrowTransferOperation) ProtoMessage()    {}
+func (*EscrowTransferOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{24}
+}
+
+func (m *EscrowTransferOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowTransferOperation.Unmarshal(m, b)
+}
+func (m *EscrowTransferOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowTransferOperation.Marshal(b, m, deterministic)
+}
+func (m *EscrowTransferOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowTransferOperation.Merge(m, src)
+}
+func (m *EscrowTransferOperation) XXX_Size() int {
+	return xxx_messageInfo_EscrowTransferOperation.Size(m)
+}
+func (m *EscrowTransferOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowTransferOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowTransferOperation proto.InternalMessageInfo
+
+func (m *EscrowTransferOperation) GetFrom() *AccountName {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetTo() *AccountName {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetAgent() *AccountName {
+	if m != nil {
+		return m.Agent
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetAmount() *Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetAgentFee() *Coin {
+	if m != nil {
+		return m.AgentFee
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *EscrowTransferOperation) GetRatificationDeadline() *TimePointSec {
+	if m != nil {
+		return m.RatificationDeadline
+	}
+	return nil
+}
+
+func (m *EscrowTransferOperation) GetEscrowExpiration() *TimePointSec {
+	if m != nil {
+		return m.EscrowExpiration
+	}
+	return nil
+}
+
+type EscrowApproveOperation struct {
+	Account              *AccountName `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	EscrowId             uint64       `protobuf:"varint,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Approve              bool         `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EscrowApproveOperation) Reset()         { *m = EscrowApproveOperation{} }
+func (m *EscrowApproveOperation) String() string { return proto.CompactTextString(m) }
+func (*EscrowApproveOperation) ProtoMessage()    {}
+func (*EscrowApproveOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{25}
+}
+
+func (m *EscrowApproveOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowApproveOperation.Unmarshal(m, b)
+}
+func (m *EscrowApproveOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowApproveOperation.Marshal(b, m, deterministic)
+}
+func (m *EscrowApproveOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowApproveOperation.Merge(m, src)
+}
+func (m *EscrowApproveOperation) XXX_Size() int {
+	return xxx_messageInfo_EscrowApproveOperation.Size(m)
+}
+func (m *EscrowApproveOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowApproveOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowApproveOperation proto.InternalMessageInfo
+
+func (m *EscrowApproveOperation) GetAccount() *AccountName {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *EscrowApproveOperation) GetEscrowId() uint64 {
+	if m != nil {
+		return m.EscrowId
+	}
+	return 0
+}
+
+func (m *EscrowApproveOperation) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type EscrowDisputeOperation struct {
+	Account              *AccountName `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	EscrowId             uint64       `protobuf:"varint,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EscrowDisputeOperation) Reset()         { *m = EscrowDisputeOperation{} }
+func (m *EscrowDisputeOperation) String() string { return proto.CompactTextString(m) }
+func (*EscrowDisputeOperation) ProtoMessage()    {}
+func (*EscrowDisputeOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{26}
+}
+
+func (m *EscrowDisputeOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowDisputeOperation.Unmarshal(m, b)
+}
+func (m *EscrowDisputeOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowDisputeOperation.Marshal(b, m, deterministic)
+}
+func (m *EscrowDisputeOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowDisputeOperation.Merge(m, src)
+}
+func (m *EscrowDisputeOperation) XXX_Size() int {
+	return xxx_messageInfo_EscrowDisputeOperation.Size(m)
+}
+func (m *EscrowDisputeOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowDisputeOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowDisputeOperation proto.InternalMessageInfo
+
+func (m *EscrowDisputeOperation) GetAccount() *AccountName {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *EscrowDisputeOperation) GetEscrowId() uint64 {
+	if m != nil {
+		return m.EscrowId
+	}
+	return 0
+}
+
+type EscrowReleaseOperation struct {
+	Account              *AccountName `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	EscrowId             uint64       `protobuf:"varint,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Receiver             *AccountName `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount               *Coin        `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EscrowReleaseOperation) Reset()         { *m = EscrowReleaseOperation{} }
+func (m *EscrowReleaseOperation) String() string { return proto.CompactTextString(m) }
+func (*EscrowReleaseOperation) ProtoMessage()    {}
+func (*EscrowReleaseOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{27}
+}
+
+func (m *EscrowReleaseOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowReleaseOperation.Unmarshal(m, b)
+}
+func (m *EscrowReleaseOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowReleaseOperation.Marshal(b, m, deterministic)
+}
+func (m *EscrowReleaseOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowReleaseOperation.Merge(m, src)
+}
+func (m *EscrowReleaseOperation) XXX_Size() int {
+	return xxx_messageInfo_EscrowReleaseOperation.Size(m)
+}
+func (m *EscrowReleaseOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowReleaseOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowReleaseOperation proto.InternalMessageInfo
+
+func (m *EscrowReleaseOperation) GetAccount() *AccountName {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *EscrowReleaseOperation) GetEscrowId() uint64 {
+	if m != nil {
+		return m.EscrowId
+	}
+	return 0
+}
+
+func (m *EscrowReleaseOperation) GetReceiver() *AccountName {
+	if m != nil {
+		return m.Receiver
+	}
+	return nil
+}
+
+func (m *EscrowReleaseOperation) GetAmount() *Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type ProposalCreateOperation struct {
+	Proposer    *AccountName `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title       string       `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// new chain properties to take effect on activation
+	Props *ChainProperties `protobuf:"bytes,4,opt,name=props,proto3" json:"props,omitempty"`
+	// name of the feature to be activated
+	Feature            string `protobuf:"bytes,5,opt,name=feature,proto3" json:"feature,omitempty"`
+	ActivationBlockNum uint64 `protobuf:"varint,6,opt,name=activation_block_num,json=activationBlockNum,proto3" json:"activation_block_num,omitempty"`
+	// new reward policy to take effect on activation
+	RewardPolicy         *RewardPolicy `protobuf:"bytes,7,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProposalCreateOperation) Reset()         { *m = ProposalCreateOperation{} }
+func (m *ProposalCreateOperation) String() string { return proto.CompactTextString(m) }
+func (*ProposalCreateOperation) ProtoMessage()    {}
+func (*ProposalCreateOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{28}
+}
+
+func (m *ProposalCreateOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalCreateOperation.Unmarshal(m, b)
+}
+func (m *ProposalCreateOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalCreateOperation.Marshal(b, m, deterministic)
+}
+func (m *ProposalCreateOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalCreateOperation.Merge(m, src)
+}
+func (m *ProposalCreateOperation) XXX_Size() int {
+	return xxx_messageInfo_ProposalCreateOperation.Size(m)
+}
+func (m *ProposalCreateOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalCreateOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalCreateOperation proto.InternalMessageInfo
+
+func (m *ProposalCreateOperation) GetProposer() *AccountName {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *ProposalCreateOperation) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalCreateOperation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProposalCreateOperation) GetProps() *ChainProperties {
+	if m != nil {
+		return m.Props
+	}
+	return nil
+}
+
+func (m *ProposalCreateOperation) GetFeature() string {
+	if m != nil {
+		return m.Feature
+	}
+	return ""
+}
+
+func (m *ProposalCreateOperation) GetActivationBlockNum() uint64 {
+	if m != nil {
+		return m.ActivationBlockNum
+	}
+	return 0
+}
+
+func (m *ProposalCreateOperation) GetRewardPolicy() *RewardPolicy {
+	if m != nil {
+		return m.RewardPolicy
+	}
+	return nil
+}
+
+type ProposalVoteOperation struct {
+	Voter                *AccountName `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId           uint64       `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approve              bool         `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ProposalVoteOperation) Reset()         { *m = ProposalVoteOperation{} }
+func (m *ProposalVoteOperation) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoteOperation) ProtoMessage()    {}
+func (*ProposalVoteOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{29}
+}
+
+func (m *ProposalVoteOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalVoteOperation.Unmarshal(m, b)
+}
+func (m *ProposalVoteOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalVoteOperation.Marshal(b, m, deterministic)
+}
+func (m *ProposalVoteOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoteOperation.Merge(m, src)
+}
+func (m *ProposalVoteOperation) XXX_Size() int {
+	return xxx_messageInfo_ProposalVoteOperation.Size(m)
+}
+func (m *ProposalVoteOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoteOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoteOperation proto.InternalMessageInfo
+
+func (m *ProposalVoteOperation) GetVoter() *AccountName {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+func (m *ProposalVoteOperation) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalVoteOperation) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+// body_patch replaces delete_len bytes at offset of a post body with insert.
+type BodyPatch struct {
+	Offset               uint32   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	DeleteLen            uint32   `protobuf:"varint,2,opt,name=delete_len,json=deleteLen,proto3" json:"delete_len,omitempty"`
+	Insert               string   `protobuf:"bytes,3,opt,name=insert,proto3" json:"insert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BodyPatch) Reset()         { *m = BodyPatch{} }
+func (m *BodyPatch) String() string { return proto.CompactTextString(m) }
+func (*BodyPatch) ProtoMessage()    {}
+func (*BodyPatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{30}
+}
+
+func (m *BodyPatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BodyPatch.Unmarshal(m, b)
+}
+func (m *BodyPatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BodyPatch.Marshal(b, m, deterministic)
+}
+func (m *BodyPatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BodyPatch.Merge(m, src)
+}
+func (m *BodyPatch) XXX_Size() int {
+	return xxx_messageInfo_BodyPatch.Size(m)
+}
+func (m *BodyPatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BodyPatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BodyPatch proto.InternalMessageInfo
+
+func (m *BodyPatch) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BodyPatch) GetDeleteLen() uint32 {
+	if m != nil {
+		return m.DeleteLen
+	}
+	return 0
+}
+
+func (m *BodyPatch) GetInsert() string {
+	if m != nil {
+		return m.Insert
+	}
+	return ""
+}
+
+type PostEditOperation struct {
+	Uuid  uint64       `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Owner *AccountName `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new title of a post, keep current one if empty. replies have no titles.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// new body, exclusive with patches
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// patches applied to current body in order, each on the result of previous ones
+	Patches              []*BodyPatch `protobuf:"bytes,5,rep,name=patches,proto3" json:"patches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PostEditOperation) Reset()         { *m = PostEditOperation{} }
+func (m *PostEditOperation) String() string { return proto.CompactTextString(m) }
+func (*PostEditOperation) ProtoMessage()    {}
+func (*PostEditOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{31}
+}
+
+func (m *PostEditOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostEditOperation.Unmarshal(m, b)
+}
+func (m *PostEditOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostEditOperation.Marshal(b, m, deterministic)
+}
+func (m *PostEditOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostEditOperation.Merge(m, src)
+}
+func (m *PostEditOperation) XXX_Size() int {
+	return xxx_messageInfo_PostEditOperation.Size(m)
+}
+func (m *PostEditOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostEditOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostEditOperation proto.InternalMessageInfo
+
+func (m *PostEditOperation) GetUuid() uint64 {
+	if m != nil {
+		return m.Uuid
+	}
+	return 0
+}
+
+func (m *PostEditOperation) GetOwner() *AccountName {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *PostEditOperation) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PostEditOperation) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *PostEditOperation) GetPatches() []*BodyPatch {
+	if m != nil {
+		return m.Patches
+	}
+	return nil
+}
+
+type PostDeleteOperation struct {
+	Uuid                 uint64       `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Owner                *AccountName `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PostDeleteOperation) Reset()         { *m = PostDeleteOperation{} }
+func (m *PostDeleteOperation) String() string { return proto.CompactTextString(m) }
+func (*PostDeleteOperation) ProtoMessage()    {}
+func (*PostDeleteOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c964c0e078f560bc, []int{32}
+}
+
+func (m *PostDeleteOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostDeleteOperation.Unmarshal(m, b)
+}
+func (m *PostDeleteOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostDeleteOperation.Marshal(b, m, deterministic)
+}
+func (m *PostDeleteOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDeleteOperation.Merge(m, src)
+}
+func (m *PostDeleteOperation) XXX_Size() int {
+	return xxx_messageInfo_PostDeleteOperation.Size(m)
+}
+func (m *PostDeleteOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDeleteOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDeleteOperation proto.InternalMessageInfo
+
+func (m *PostDeleteOperation) GetUuid() uint64 {
+	if m != nil {
+		return m.Uuid
+	}
+	return 0
+}
+
+func (m *PostDeleteOperation) GetOwner() *AccountName {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccountCreateOperation)(nil), "prototype.account_create_operation")
 	proto.RegisterType((*AccountUpdateOperation)(nil), "prototype.account_update_operation")
@@ -1397,81 +2129,124 @@ func init() {
 	proto.RegisterType((*VoteByTicketOperation)(nil), "prototype.vote_by_ticket_operation")
 	proto.RegisterType((*DelegateVestOperation)(nil), "prototype.delegate_vest_operation")
 	proto.RegisterType((*UnDelegateVestOperation)(nil), "prototype.un_delegate_vest_operation")
+	proto.RegisterType((*ScheduledTransferOperation)(nil), "prototype.scheduled_transfer_operation")
+	proto.RegisterType((*CancelScheduledTransferOperation)(nil), "prototype.cancel_scheduled_transfer_operation")
+	proto.RegisterType((*EscrowTransferOperation)(nil), "prototype.escrow_transfer_operation")
+	proto.RegisterType((*EscrowApproveOperation)(nil), "prototype.escrow_approve_operation")
+	proto.RegisterType((*EscrowDisputeOperation)(nil), "prototype.escrow_dispute_operation")
+	proto.RegisterType((*EscrowReleaseOperation)(nil), "prototype.escrow_release_operation")
+	proto.RegisterType((*ProposalCreateOperation)(nil), "prototype.proposal_create_operation")
+	proto.RegisterType((*ProposalVoteOperation)(nil), "prototype.proposal_vote_operation")
+	proto.RegisterType((*BodyPatch)(nil), "prototype.body_patch")
+	proto.RegisterType((*PostEditOperation)(nil), "prototype.post_edit_operation")
+	proto.RegisterType((*PostDeleteOperation)(nil), "prototype.post_delete_operation")
 }
 
 func init() { proto.RegisterFile("prototype/operation.proto", fileDescriptor_c964c0e078f560bc) }
 
 var fileDescriptor_c964c0e078f560bc = []byte{
-	// 1134 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0xe4, 0x44,
-	0x13, 0x96, 0xc7, 0xf3, 0x59, 0x93, 0x8f, 0x5d, 0xbf, 0x79, 0xb3, 0xce, 0xac, 0xc4, 0x26, 0xce,
-	0x61, 0x23, 0xd0, 0x4e, 0x48, 0x82, 0x10, 0x27, 0xa4, 0x5d, 0x24, 0x10, 0x42, 0x81, 0x95, 0xd1,
-	0x5e, 0x10, 0x92, 0xd5, 0xb6, 0x6b, 0x26, 0xbd, 0xf1, 0x74, 0x9b, 0x76, 0x3b, 0xd9, 0xb9, 0xf0,
-	0x0f, 0x40, 0x48, 0xdc, 0xb8, 0x72, 0xe0, 0xc8, 0x95, 0x1f, 0xc3, 0x81, 0x03, 0x12, 0x47, 0xfe,
-	0x02, 0xea, 0x76, 0xdb, 0xe3, 0x49, 0x48, 0x66, 0xb2, 0x89, 0xc4, 0x5e, 0xac, 0xae, 0xea, 0xea,
-	0x7e, 0xaa, 0x9e, 0xaa, 0xea, 0x6e, 0xc3, 0x56, 0x2a, 0xb8, 0xe4, 0x72, 0x9a, 0xe2, 0x3e, 0x4f,
-	0x51, 0x10, 0x49, 0x39, 0x1b, 0x6a, 0x9d, 0xd3, 0xab, 0xa6, 0x06, 0x1b, 0x33, 0x2b, 0xf5, 0x29,
-	0x0c, 0xbc, 0xef, 0x1a, 0xe0, 0x92, 0x28, 0xe2, 0x39, 0x93, 0x41, 0x24, 0x90, 0x48, 0x0c, 0xaa,
-	0x3d, 0x9c, 0x1d, 0xb0, 0x47, 0x88, 0xae, 0xb5, 0x6d, 0xed, 0xf5, 0x0f, 0xd7, 0x87, 0xd5, 0x06,
-	0xc3, 0x88, 0x53, 0xe6, 0xab, 0x39, 0xe7, 0x00, 0x3a, 0x7a, 0x19, 0x17, 0x6e, 0x43, 0x9b, 0x3d,
-	0xa8, 0x99, 0x95, 0x1b, 0x33, 0x32, 0x41, 0xbf, 0xb4, 0x73, 0x9e, 0xc2, 0x3d, 0x86, 0xe7, 0x41,
-	0x7d, 0xd2, 0xb5, 0xaf, 0x5f, 0xbb, 0xc6, 0xf0, 0xfc, 0x69, 0xa1, 0xf8, 0x9c, 0x4c, 0xd0, 0x39,
-	0x82, 0x4e, 0x9a, 0x87, 0xc1, 0x29, 0x4e, 0xdd, 0xa6, 0x5e, 0x39, 0xa8, 0xad, 0x4c, 0xf3, 0x30,
-	0xa1, 0x91, 0x9a, 0x0c, 0x94, 0xec, 0xb7, 0xd3, 0x3c, 0xfc, 0x0c, 0xa7, 0xce, 0x2e, 0xac, 0xbe,
-	0xcc, 0x38, 0x0b, 0x26, 0x28, 0x49, 0x4c, 0x24, 0x71, 0x5b, 0xdb, 0xd6, 0x5e, 0xcf, 0x5f, 0x51,
-	0xca, 0x63, 0xa3, 0xf3, 0xbe, 0x9d, 0xd1, 0x91, 0xa7, 0xf1, 0x3c, 0x1d, 0x4f, 0xa0, 0xc5, 0xcf,
-	0x19, 0x0a, 0x43, 0xc8, 0x95, 0xde, 0x16, 0x56, 0x75, 0x27, 0x1b, 0xcb, 0x3a, 0xe9, 0xfd, 0x62,
-	0x81, 0x23, 0x05, 0x61, 0xd9, 0x08, 0x45, 0x0d, 0xfa, 0x1d, 0x68, 0x8e, 0x04, 0x9f, 0x2c, 0x42,
-	0xd6, 0x46, 0xce, 0x63, 0x68, 0x48, 0xbe, 0x28, 0x1d, 0x0d, 0xc9, 0x9d, 0xc7, 0xd0, 0x26, 0x13,
-	0xa5, 0x32, 0xfc, 0x5f, 0x4a, 0xb1, 0x99, 0x76, 0x1c, 0x68, 0x4e, 0x70, 0xc2, 0x35, 0xd9, 0x3d,
-	0x5f, 0x8f, 0xbd, 0x5f, 0x2d, 0x18, 0x54, 0x9e, 0x4a, 0x1e, 0x9c, 0x61, 0x26, 0xdf, 0x6c, 0x8f,
-	0x5f, 0xc0, 0xda, 0x19, 0xbf, 0x98, 0x51, 0xa5, 0x59, 0x9c, 0x51, 0x6d, 0xe5, 0x6c, 0x80, 0x4d,
-	0xe3, 0x57, 0xda, 0xcf, 0xe6, 0xb3, 0xc6, 0xbb, 0x96, 0xaf, 0x44, 0xef, 0x2f, 0x0b, 0xfe, 0x1f,
-	0xa6, 0x81, 0xc0, 0x31, 0xcd, 0xe4, 0x5c, 0xd6, 0x6e, 0x58, 0x30, 0xf7, 0xc0, 0xce, 0x45, 0xa2,
-	0xb7, 0xef, 0xf9, 0x6a, 0xa8, 0xa2, 0x88, 0x31, 0x8b, 0x74, 0xb0, 0x3d, 0x5f, 0x8f, 0x9d, 0x8f,
-	0xe1, 0x7e, 0x98, 0xf0, 0xe8, 0x34, 0xc8, 0xe8, 0x98, 0x51, 0x36, 0x5e, 0xb2, 0x0b, 0xd6, 0xf5,
-	0xa2, 0x2f, 0x8b, 0x35, 0xaa, 0x1d, 0x0e, 0xa0, 0x95, 0x0a, 0x9e, 0x66, 0xba, 0x0d, 0xfa, 0x87,
-	0x0f, 0xeb, 0x4c, 0x9e, 0x10, 0xca, 0x02, 0x35, 0x8b, 0x42, 0x52, 0xcc, 0xfc, 0xc2, 0xd2, 0x3b,
-	0x87, 0xff, 0x85, 0xe9, 0xad, 0xfb, 0xa2, 0x02, 0x6e, 0x2c, 0x0d, 0xfc, 0xb5, 0x06, 0x46, 0x46,
-	0xc2, 0xe4, 0x16, 0xc0, 0x9b, 0xd0, 0x8e, 0x08, 0x8b, 0xb0, 0xa0, 0xb8, 0xeb, 0x1b, 0xc9, 0xfb,
-	0xc9, 0x82, 0xfb, 0x61, 0x1a, 0xdc, 0xae, 0x36, 0x3e, 0x84, 0xb5, 0x22, 0x2d, 0xa9, 0xe0, 0x71,
-	0x1e, 0xe1, 0xc2, 0xf3, 0x70, 0x55, 0x9b, 0x3f, 0x37, 0xd6, 0x35, 0xe7, 0xec, 0x39, 0xe7, 0x7e,
-	0xb4, 0xe0, 0xde, 0x88, 0x27, 0x09, 0x3f, 0xaf, 0xf9, 0x76, 0x00, 0x1d, 0xb3, 0xd7, 0x22, 0xef,
-	0x4a, 0x3b, 0xe7, 0x3d, 0xe8, 0x8d, 0xca, 0x33, 0x77, 0x91, 0x6b, 0xdd, 0x91, 0x39, 0x6c, 0xaf,
-	0xf4, 0xea, 0x77, 0x0b, 0xb6, 0x22, 0xce, 0xa4, 0x20, 0x91, 0x0c, 0x62, 0x4c, 0x13, 0x3e, 0x7d,
-	0xfd, 0xbc, 0x0c, 0xa0, 0x5b, 0xee, 0x65, 0x8a, 0xbf, 0x92, 0x55, 0x4f, 0x90, 0x90, 0x6a, 0xf4,
-	0x15, 0x5f, 0x0d, 0x55, 0x4f, 0x44, 0x3c, 0x46, 0x5d, 0xf2, 0x2b, 0xbe, 0x1e, 0x3b, 0xdb, 0xd0,
-	0xcf, 0xd3, 0xb1, 0x20, 0x31, 0xaa, 0x0a, 0xd1, 0x15, 0xdd, 0xf5, 0xeb, 0xaa, 0xb2, 0xb7, 0xda,
-	0xb3, 0xde, 0x1a, 0x40, 0x57, 0xf5, 0x93, 0xa0, 0x21, 0xba, 0x9d, 0x02, 0xb5, 0x94, 0xbd, 0xbf,
-	0x2d, 0x70, 0xab, 0xf0, 0x48, 0x9a, 0x26, 0xf5, 0xe8, 0xf6, 0x15, 0x27, 0x49, 0xb2, 0x38, 0x3c,
-	0x63, 0x36, 0xa3, 0xa3, 0x71, 0x63, 0x3a, 0xec, 0x0b, 0x74, 0x6c, 0x42, 0x7b, 0x82, 0xf2, 0x84,
-	0xc7, 0xe6, 0x60, 0x33, 0x92, 0xd2, 0xa7, 0x44, 0x90, 0x49, 0x66, 0x2e, 0x35, 0x23, 0xd5, 0xce,
-	0xcb, 0xf6, 0xb5, 0xe7, 0xa5, 0xf7, 0xbd, 0x0d, 0x3b, 0x94, 0x49, 0x14, 0x8c, 0x24, 0xc1, 0x95,
-	0xa1, 0x7f, 0x00, 0x7d, 0x75, 0x5e, 0x07, 0xcb, 0xc5, 0x0f, 0xca, 0xf6, 0xa3, 0x82, 0x83, 0xf7,
-	0x41, 0x4b, 0xc1, 0x52, 0x44, 0xf4, 0x94, 0xe9, 0x17, 0x9a, 0x8c, 0x5d, 0x58, 0x2d, 0x10, 0xe7,
-	0x19, 0x59, 0xd1, 0x5b, 0x97, 0xac, 0x3c, 0x32, 0x6e, 0xcd, 0x51, 0xa3, 0xf1, 0x8e, 0x0b, 0x7a,
-	0x0e, 0xa1, 0x2b, 0xb9, 0xc1, 0x6e, 0x2d, 0x68, 0x18, 0xc9, 0x0b, 0xe4, 0x47, 0xd0, 0x97, 0x7c,
-	0x86, 0x5b, 0x54, 0x0e, 0x48, 0x5e, 0xa1, 0x3e, 0x84, 0x9e, 0xe4, 0x25, 0xa6, 0xa9, 0x20, 0xc9,
-	0x8f, 0x2f, 0x26, 0xa4, 0xab, 0xeb, 0xf4, 0x72, 0x42, 0x7a, 0xd7, 0x27, 0xe4, 0x4f, 0x0b, 0xd6,
-	0x52, 0x3e, 0x77, 0xa5, 0x6e, 0x42, 0x33, 0xcf, 0x69, 0xac, 0x69, 0x2f, 0xee, 0x1f, 0x2d, 0xdf,
-	0xb4, 0xbe, 0x36, 0xa0, 0x25, 0xa9, 0x4c, 0xd0, 0x50, 0x59, 0x08, 0x8e, 0x0b, 0x1d, 0x15, 0x2b,
-	0x32, 0x69, 0xf8, 0x2b, 0x45, 0xd5, 0x70, 0x92, 0x8c, 0x55, 0x65, 0xd9, 0xea, 0x12, 0x52, 0x63,
-	0xe7, 0x13, 0x58, 0x0d, 0x91, 0xe1, 0x88, 0x46, 0x94, 0x08, 0x8a, 0x99, 0xdb, 0xde, 0xb6, 0xf7,
-	0xfa, 0x87, 0x3b, 0x35, 0xe8, 0xd9, 0xfc, 0x34, 0x10, 0x3c, 0x97, 0x58, 0xdc, 0x43, 0xf3, 0xeb,
-	0xbc, 0x3f, 0x2c, 0x58, 0x17, 0x38, 0x5f, 0x65, 0x77, 0x14, 0x67, 0x2d, 0x22, 0x7b, 0x3e, 0xa2,
-	0x5d, 0xe8, 0xa7, 0x44, 0xa0, 0x7a, 0xe3, 0x29, 0x9c, 0x66, 0x85, 0x03, 0x85, 0xfa, 0x85, 0x42,
-	0xbb, 0xb3, 0x10, 0x19, 0x6c, 0x46, 0x9c, 0x9d, 0xa1, 0x90, 0xb7, 0x7c, 0x23, 0x95, 0x95, 0xd3,
-	0xb8, 0x54, 0x39, 0x6a, 0xdf, 0xaa, 0x72, 0x7e, 0xb0, 0x60, 0x3d, 0x93, 0xe4, 0x14, 0xdf, 0x98,
-	0xd7, 0x98, 0xf7, 0xb3, 0x05, 0x4e, 0xce, 0x82, 0x8b, 0x5e, 0x1d, 0x41, 0x37, 0x12, 0x18, 0x53,
-	0xf5, 0xf7, 0xb0, 0xc0, 0xb3, 0xca, 0x50, 0x1d, 0xbf, 0x31, 0x86, 0x4b, 0xfc, 0x70, 0x18, 0xb3,
-	0xe5, 0xbd, 0x8c, 0xd4, 0xdb, 0xff, 0x9b, 0x9c, 0x0a, 0x0c, 0x24, 0x8d, 0x4e, 0x51, 0xde, 0xee,
-	0xc6, 0xdd, 0x80, 0xd6, 0xec, 0xb6, 0x6d, 0xfa, 0x85, 0xe0, 0x4d, 0xc1, 0xd5, 0x0f, 0x8d, 0x70,
-	0x7a, 0x47, 0x20, 0xff, 0xf2, 0x24, 0x9d, 0x41, 0xdb, 0x75, 0xe8, 0xdf, 0x2c, 0x78, 0x10, 0x63,
-	0x82, 0x63, 0xf5, 0x7c, 0xfb, 0xcf, 0x9f, 0xeb, 0xf5, 0x9a, 0x75, 0xde, 0x02, 0xc0, 0x57, 0x29,
-	0x2d, 0x9c, 0x29, 0x1a, 0xd2, 0xaf, 0x69, 0xbc, 0x97, 0x30, 0xc8, 0x59, 0x70, 0x95, 0xf3, 0xaf,
-	0xc1, 0xdb, 0x16, 0x74, 0xb9, 0x88, 0x51, 0x04, 0x34, 0x36, 0xf9, 0xe9, 0x68, 0xf9, 0xd3, 0xf8,
-	0xd9, 0x73, 0xf0, 0x28, 0x1f, 0x9a, 0xb3, 0x82, 0x67, 0x43, 0xc2, 0x62, 0xc1, 0x69, 0x3c, 0xcc,
-	0xe2, 0xd3, 0xd9, 0xb6, 0x5f, 0xbd, 0x3d, 0xa6, 0xf2, 0x24, 0x0f, 0x87, 0x11, 0x9f, 0xec, 0x47,
-	0x3c, 0xd3, 0x2f, 0xd7, 0xfd, 0x6a, 0xd1, 0x93, 0x31, 0xdf, 0xaf, 0x6c, 0xc3, 0xb6, 0x1e, 0x1e,
-	0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x9e, 0x50, 0x47, 0xa9, 0x0f, 0x00, 0x00,
+	// 1636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6f, 0x1c, 0x4f,
+	0x11, 0xd7, 0xec, 0x7b, 0x6b, 0xfd, 0xca, 0xc4, 0x76, 0xc6, 0x0e, 0x10, 0x67, 0x7c, 0x48, 0x04,
+	0xc4, 0x4e, 0xec, 0x08, 0x71, 0x01, 0xe4, 0x00, 0x41, 0x11, 0x24, 0x44, 0x03, 0xb9, 0x00, 0x62,
+	0xd4, 0x33, 0x53, 0xbb, 0xee, 0x78, 0x76, 0x7a, 0xe8, 0xe9, 0xb1, 0xb3, 0x67, 0x38, 0x23, 0x24,
+	0x6e, 0x5c, 0x73, 0xe0, 0xc8, 0x0d, 0x71, 0xe1, 0xc0, 0x05, 0xf1, 0x21, 0x38, 0x70, 0x40, 0xe2,
+	0xc8, 0x57, 0x40, 0xfd, 0x98, 0xc7, 0x3a, 0xf1, 0xee, 0xc6, 0x76, 0x48, 0xfe, 0x97, 0xd5, 0x54,
+	0x75, 0xbd, 0xfb, 0x57, 0xdd, 0xd5, 0x0b, 0x5b, 0x29, 0x67, 0x82, 0x89, 0x49, 0x8a, 0xfb, 0x2c,
+	0x45, 0x4e, 0x04, 0x65, 0xc9, 0x9e, 0xe2, 0xd9, 0xfd, 0x72, 0x69, 0x7b, 0xbd, 0x92, 0x92, 0x3f,
+	0x5a, 0xc0, 0xfd, 0x5b, 0x03, 0x1c, 0x12, 0x86, 0x2c, 0x4f, 0x84, 0x1f, 0x72, 0x24, 0x02, 0xfd,
+	0xd2, 0x86, 0x7d, 0x17, 0x9a, 0x43, 0x44, 0xc7, 0xda, 0xb1, 0xee, 0x0f, 0x0e, 0x56, 0xf7, 0x4a,
+	0x03, 0x7b, 0x21, 0xa3, 0x89, 0x27, 0xd7, 0xec, 0x47, 0xd0, 0x55, 0x6a, 0x8c, 0x3b, 0x0d, 0x25,
+	0x76, 0xab, 0x26, 0x56, 0x18, 0x4e, 0xc8, 0x18, 0xbd, 0x42, 0xce, 0x3e, 0x82, 0xb5, 0x04, 0xcf,
+	0xfc, 0xfa, 0xa2, 0xd3, 0x9c, 0xad, 0xbb, 0x92, 0xe0, 0xd9, 0x91, 0x66, 0xbc, 0x20, 0x63, 0xb4,
+	0x0f, 0xa1, 0x9b, 0xe6, 0x81, 0x7f, 0x82, 0x13, 0xa7, 0xa5, 0x34, 0xb7, 0x6b, 0x9a, 0x69, 0x1e,
+	0xc4, 0x34, 0x94, 0x8b, 0xbe, 0xa4, 0xbd, 0x4e, 0x9a, 0x07, 0x3f, 0xc4, 0x89, 0xbd, 0x0b, 0xcb,
+	0xaf, 0x33, 0x96, 0xf8, 0x63, 0x14, 0x24, 0x22, 0x82, 0x38, 0xed, 0x1d, 0xeb, 0x7e, 0xdf, 0x5b,
+	0x92, 0xcc, 0xe7, 0x86, 0x67, 0x1f, 0x40, 0x9f, 0xe4, 0xe2, 0x98, 0x71, 0x2a, 0x26, 0x4e, 0x47,
+	0xd9, 0x5e, 0xaf, 0x47, 0x55, 0xac, 0x79, 0x95, 0x98, 0xfb, 0xe7, 0x5a, 0x0d, 0xf3, 0x34, 0x9a,
+	0xae, 0xe1, 0x03, 0x68, 0xb3, 0xb3, 0x04, 0xb9, 0x63, 0xcd, 0x4e, 0x51, 0x4b, 0xd5, 0x33, 0x6b,
+	0x2c, 0x9c, 0xd9, 0x54, 0xd0, 0xcd, 0x85, 0x82, 0xb6, 0xbf, 0x03, 0x6b, 0x24, 0x14, 0xf4, 0x14,
+	0xfd, 0x4a, 0xb5, 0x35, 0x43, 0x75, 0x55, 0x4b, 0x1f, 0x95, 0x06, 0x8e, 0xe0, 0x46, 0xca, 0x32,
+	0x41, 0x93, 0x51, 0xcd, 0x42, 0x7b, 0x86, 0x85, 0x35, 0x23, 0x5e, 0x9a, 0x70, 0xff, 0x68, 0x81,
+	0x2d, 0x38, 0x49, 0xb2, 0x21, 0xf2, 0x5a, 0xc9, 0xbe, 0x06, 0xad, 0x21, 0x67, 0xe3, 0x79, 0x15,
+	0x53, 0x42, 0xf6, 0x3d, 0x68, 0x08, 0x36, 0x0f, 0x7b, 0x0d, 0xc1, 0xec, 0x7b, 0xd0, 0x21, 0x63,
+	0xc9, 0x72, 0x9a, 0xef, 0xc7, 0xb3, 0x59, 0xb6, 0x6d, 0x68, 0x8d, 0x71, 0xcc, 0x54, 0x35, 0xfa,
+	0x9e, 0xfa, 0x76, 0xff, 0x64, 0xc1, 0x76, 0x19, 0xa9, 0x60, 0xfe, 0x29, 0x66, 0xe2, 0xf3, 0x8e,
+	0xf8, 0x15, 0xac, 0x9c, 0xb2, 0xf3, 0x48, 0x94, 0x9c, 0xf9, 0x48, 0x54, 0x52, 0xf6, 0x3a, 0x34,
+	0x69, 0xf4, 0x46, 0xc5, 0xd9, 0x7a, 0xd2, 0x78, 0x68, 0x79, 0x92, 0x74, 0xff, 0x63, 0xc1, 0x46,
+	0x90, 0xfa, 0x1c, 0x47, 0x34, 0x13, 0xc8, 0x2f, 0x0f, 0xf4, 0x35, 0x68, 0xe6, 0x3c, 0x56, 0xe6,
+	0xfb, 0x9e, 0xfc, 0x94, 0x59, 0x44, 0x98, 0x85, 0x2a, 0xd9, 0xbe, 0xa7, 0xbe, 0xed, 0xa7, 0x70,
+	0x23, 0x88, 0x59, 0x78, 0xe2, 0x67, 0x74, 0x94, 0x48, 0xa8, 0x2d, 0xd6, 0xf2, 0xab, 0x4a, 0xe9,
+	0x27, 0x5a, 0x47, 0x76, 0xc8, 0x23, 0x68, 0xa7, 0x9c, 0xa5, 0x99, 0x01, 0xe8, 0xed, 0x7a, 0x25,
+	0x8f, 0x09, 0x4d, 0x7c, 0xb9, 0x8a, 0x5c, 0x50, 0xcc, 0x3c, 0x2d, 0xe9, 0x9e, 0xc1, 0xcd, 0x20,
+	0xbd, 0x72, 0x3f, 0x97, 0x8e, 0x1b, 0x0b, 0x3b, 0xfe, 0x85, 0x72, 0x8c, 0x09, 0x09, 0xe2, 0x2b,
+	0x38, 0xde, 0x84, 0x4e, 0x48, 0x92, 0x10, 0x75, 0x89, 0x7b, 0x9e, 0xa1, 0xdc, 0x3f, 0x58, 0x70,
+	0x23, 0x48, 0xfd, 0xab, 0x61, 0xe3, 0xdb, 0xb0, 0xa2, 0xb7, 0x25, 0xe5, 0x2c, 0xca, 0x43, 0x9c,
+	0x7b, 0xf8, 0x2f, 0x2b, 0xf1, 0x97, 0x46, 0xba, 0x16, 0x5c, 0x73, 0x2a, 0xb8, 0xdf, 0x5b, 0xb0,
+	0x36, 0x64, 0x71, 0xcc, 0xce, 0x6a, 0xb1, 0x3d, 0x82, 0xae, 0xb1, 0x35, 0x2f, 0xba, 0x42, 0xce,
+	0x7e, 0x0c, 0xfd, 0x61, 0x71, 0xc1, 0xcc, 0x0b, 0xad, 0x37, 0x34, 0x37, 0xcb, 0x85, 0x51, 0xfd,
+	0xd3, 0x82, 0xad, 0x90, 0x25, 0x82, 0x93, 0x50, 0xf8, 0x11, 0xa6, 0x31, 0x9b, 0x5c, 0x7e, 0x5f,
+	0xb6, 0xa1, 0x57, 0xd8, 0x32, 0xe0, 0x2f, 0x69, 0xd9, 0x13, 0x24, 0xa0, 0xca, 0xfb, 0x92, 0x27,
+	0x3f, 0x65, 0x4f, 0x84, 0x2c, 0x42, 0x05, 0xf9, 0x25, 0x4f, 0x7d, 0xdb, 0x3b, 0x30, 0xc8, 0xd3,
+	0x11, 0x27, 0x11, 0x4a, 0x84, 0x28, 0x44, 0xf7, 0xbc, 0x3a, 0xab, 0xe8, 0xad, 0x4e, 0xd5, 0x5b,
+	0xdb, 0xd0, 0x93, 0xfd, 0xc4, 0x69, 0x80, 0x4e, 0x57, 0x7b, 0x2d, 0x68, 0xf7, 0xbf, 0x16, 0x38,
+	0x65, 0x7a, 0x24, 0x4d, 0xe3, 0x7a, 0x76, 0xfb, 0xb2, 0x26, 0x71, 0x3c, 0x3f, 0x3d, 0x23, 0x56,
+	0x95, 0xa3, 0xf1, 0xc1, 0xe5, 0x68, 0x9e, 0x2b, 0xc7, 0x26, 0x74, 0xc6, 0x28, 0x8e, 0x59, 0x64,
+	0x0e, 0x36, 0x43, 0x49, 0x7e, 0x4a, 0x38, 0x19, 0x67, 0xe6, 0x06, 0x37, 0x54, 0xed, 0xbc, 0xec,
+	0xcc, 0x3c, 0x2f, 0xdd, 0xdf, 0x36, 0xe1, 0x2e, 0x4d, 0x04, 0xf2, 0x84, 0xc4, 0xfe, 0x85, 0xa9,
+	0x7f, 0x13, 0x06, 0xf2, 0xbc, 0xf6, 0x17, 0xcb, 0x1f, 0xa4, 0xec, 0x77, 0x75, 0x0d, 0xbe, 0x01,
+	0x8a, 0xf2, 0x17, 0x2a, 0x44, 0x5f, 0x8a, 0xfe, 0x58, 0x15, 0x63, 0x17, 0x96, 0xb5, 0xc7, 0xe9,
+	0x8a, 0x2c, 0x29, 0xd3, 0x45, 0x55, 0xee, 0x98, 0xb0, 0xa6, 0x4a, 0xa3, 0xfc, 0x3d, 0xd7, 0xe5,
+	0x39, 0x80, 0x9e, 0x60, 0xc6, 0x77, 0x7b, 0x4e, 0xc3, 0x08, 0xa6, 0x3d, 0xdf, 0x81, 0x81, 0x60,
+	0x95, 0x5f, 0x8d, 0x1c, 0x10, 0xac, 0xf4, 0x7a, 0x1b, 0xfa, 0x82, 0x15, 0x3e, 0x0d, 0x82, 0x04,
+	0x7b, 0x7e, 0x7e, 0x43, 0x7a, 0x0a, 0xa7, 0xef, 0x6e, 0x48, 0x7f, 0xf6, 0x86, 0xfc, 0xdb, 0x82,
+	0x15, 0x39, 0x1d, 0xd4, 0xaa, 0xbf, 0x09, 0xad, 0x3c, 0xa7, 0x91, 0x63, 0x95, 0xf7, 0x8f, 0xa2,
+	0x3f, 0x14, 0x5f, 0xeb, 0xd0, 0x16, 0x54, 0xc4, 0x68, 0x4a, 0xa9, 0x09, 0xdb, 0x81, 0xae, 0xcc,
+	0x15, 0x13, 0x61, 0xea, 0x57, 0x90, 0xb2, 0xe1, 0x04, 0x19, 0x49, 0x64, 0x35, 0xe5, 0x25, 0x24,
+	0xbf, 0xed, 0x1f, 0xc0, 0x72, 0x80, 0x09, 0x0e, 0x69, 0x48, 0x09, 0xa7, 0x98, 0x39, 0x9d, 0x9d,
+	0xe6, 0xfd, 0xc1, 0xc1, 0xdd, 0x9a, 0xeb, 0x6a, 0x7d, 0xe2, 0x73, 0x96, 0x0b, 0xd4, 0xf7, 0xd0,
+	0xb4, 0x9e, 0xfb, 0x2f, 0x0b, 0x56, 0x39, 0x4e, 0xa3, 0xec, 0x9a, 0xf2, 0xac, 0x65, 0xd4, 0x9c,
+	0xce, 0x68, 0x17, 0x06, 0x29, 0xe1, 0x28, 0x67, 0x53, 0xe9, 0xa7, 0x55, 0xfa, 0x01, 0xcd, 0x7e,
+	0x25, 0xbd, 0x5d, 0x5b, 0x8a, 0x09, 0x6c, 0x86, 0x2c, 0x39, 0x45, 0x2e, 0xae, 0x38, 0x23, 0x15,
+	0xc8, 0x69, 0xbc, 0x83, 0x1c, 0x69, 0xb7, 0x44, 0xce, 0xef, 0x2c, 0x58, 0xcd, 0x04, 0x39, 0xc1,
+	0xcf, 0x66, 0x1a, 0x73, 0xdf, 0x5a, 0x60, 0xe7, 0x89, 0x7f, 0x3e, 0xaa, 0x43, 0xe8, 0x85, 0x1c,
+	0x23, 0x2a, 0xd8, 0xdc, 0xb3, 0xa4, 0x14, 0x94, 0xc7, 0x6f, 0x84, 0xc1, 0x02, 0xaf, 0x2b, 0x23,
+	0xb6, 0x78, 0x94, 0xa1, 0x7c, 0xb3, 0xfc, 0x2a, 0xa7, 0x1c, 0x7d, 0x41, 0xc3, 0x13, 0x14, 0x57,
+	0xbb, 0x71, 0xd7, 0xa1, 0x5d, 0xdd, 0xb6, 0x2d, 0x4f, 0x13, 0xee, 0x04, 0x1c, 0x35, 0x68, 0x04,
+	0x93, 0x6b, 0x72, 0xf2, 0x9e, 0x91, 0xb4, 0x72, 0xdd, 0xac, 0xbb, 0xfe, 0x8b, 0x05, 0xb7, 0x22,
+	0x8c, 0x71, 0x44, 0x04, 0x7e, 0xfa, 0x71, 0xbd, 0x8e, 0x59, 0xfb, 0x2b, 0x00, 0xf8, 0x26, 0xa5,
+	0x3a, 0x18, 0xdd, 0x90, 0x5e, 0x8d, 0xe3, 0xbe, 0x86, 0xed, 0x3c, 0xf1, 0x2f, 0x0a, 0xfe, 0x12,
+	0x75, 0xdb, 0x82, 0x1e, 0xe3, 0x11, 0x72, 0x9f, 0x46, 0x66, 0x7f, 0xba, 0x8a, 0x7e, 0x16, 0xc9,
+	0x79, 0xfe, 0x4b, 0x59, 0x78, 0x8c, 0x51, 0x1e, 0x63, 0xe4, 0x7f, 0x21, 0x1e, 0x63, 0xf6, 0x63,
+	0xe8, 0x45, 0xb9, 0x84, 0xed, 0x18, 0xcd, 0x05, 0xb7, 0x55, 0x53, 0x97, 0x6c, 0x3f, 0x65, 0x34,
+	0x11, 0x7e, 0x86, 0xa1, 0xd7, 0x8d, 0x72, 0xfc, 0x29, 0x1d, 0xa3, 0x3b, 0x81, 0x5d, 0x3d, 0xcf,
+	0xf9, 0x33, 0xf3, 0xbd, 0x44, 0x79, 0xe5, 0xe5, 0x59, 0x18, 0x2a, 0x2b, 0x0c, 0x05, 0xeb, 0x59,
+	0xe4, 0xbe, 0x6d, 0xc2, 0x96, 0x9c, 0xb6, 0xd8, 0xd9, 0xff, 0xaf, 0xc2, 0x0f, 0xa0, 0x4d, 0x46,
+	0x58, 0x16, 0xf8, 0xe2, 0xfb, 0x43, 0x49, 0xd5, 0x36, 0xa4, 0x35, 0x7b, 0x43, 0xbe, 0x0e, 0x7d,
+	0xa5, 0xe1, 0x0f, 0xb1, 0xa8, 0xfe, 0x3b, 0xb2, 0x3d, 0x25, 0xf1, 0x14, 0xb1, 0xdc, 0xbe, 0x4e,
+	0x6d, 0xfb, 0x5e, 0xc0, 0x86, 0xcc, 0x7c, 0x48, 0x43, 0x95, 0xbf, 0x1f, 0x21, 0x89, 0x62, 0x9a,
+	0xe8, 0xc1, 0x74, 0xe6, 0x5e, 0xae, 0xd7, 0xf5, 0xbe, 0x67, 0xd4, 0xe4, 0x1b, 0xd1, 0x14, 0xb7,
+	0xd6, 0x55, 0xbd, 0x79, 0xb6, 0xd6, 0xb4, 0xce, 0xf7, 0xab, 0xb6, 0xfb, 0x8d, 0x05, 0x8e, 0x31,
+	0x44, 0xd2, 0x94, 0xb3, 0x53, 0xbc, 0x1a, 0x2c, 0x6e, 0x43, 0xdf, 0x98, 0x2b, 0x41, 0xd1, 0xd3,
+	0x8c, 0x67, 0x91, 0xbc, 0xaf, 0x8d, 0x13, 0xf3, 0xd8, 0x28, 0x48, 0xf7, 0x75, 0x19, 0x45, 0x44,
+	0xb3, 0x34, 0x17, 0x1f, 0x31, 0x0a, 0xf7, 0x1f, 0x55, 0xca, 0x1c, 0x63, 0x24, 0xd9, 0xc7, 0x4c,
+	0xf9, 0x10, 0x7a, 0x1c, 0x43, 0xa4, 0xa7, 0xc8, 0xe7, 0x81, 0xb2, 0x14, 0x5c, 0x18, 0x97, 0xee,
+	0xdf, 0x1b, 0xea, 0x7f, 0xd0, 0x94, 0x65, 0x72, 0xa6, 0x3f, 0xff, 0x4f, 0xe6, 0x21, 0xf4, 0xf4,
+	0xe2, 0xfc, 0x41, 0xbe, 0x14, 0xac, 0x66, 0xc7, 0x46, 0x7d, 0x76, 0xdc, 0x81, 0x81, 0x7e, 0x3a,
+	0xa5, 0x0a, 0x68, 0x7a, 0xda, 0xaa, 0xb3, 0xaa, 0x37, 0x7f, 0x6b, 0xd1, 0x37, 0xbf, 0x84, 0xc3,
+	0x10, 0x89, 0xc8, 0x39, 0x9a, 0x37, 0x4d, 0x41, 0xda, 0x0f, 0x61, 0x5d, 0xfd, 0xf3, 0xa6, 0x7b,
+	0x45, 0xbf, 0xba, 0x93, 0x7c, 0xac, 0x3a, 0xaa, 0xe5, 0xd9, 0xd5, 0xda, 0x13, 0xb9, 0xf4, 0x22,
+	0x1f, 0xdb, 0xdf, 0x82, 0x65, 0x8e, 0x67, 0x84, 0x47, 0x7e, 0xca, 0x62, 0x1a, 0x4e, 0x4c, 0x5f,
+	0x39, 0xb5, 0x30, 0xa6, 0xd6, 0xbd, 0x25, 0x4d, 0xbe, 0x54, 0x94, 0xfb, 0x6b, 0x0b, 0x6e, 0x95,
+	0x85, 0xbc, 0xda, 0xdf, 0x04, 0x77, 0x60, 0x50, 0x5a, 0xaa, 0x0e, 0xc6, 0x82, 0x35, 0xb3, 0x0b,
+	0x7e, 0x0e, 0x10, 0xb0, 0x68, 0xe2, 0xa7, 0x44, 0x84, 0xc7, 0xf2, 0x81, 0xc1, 0x86, 0xc3, 0x0c,
+	0x35, 0x12, 0x97, 0x3d, 0x43, 0xd9, 0x5f, 0x06, 0x90, 0xd7, 0xa4, 0x40, 0x3f, 0xc6, 0x44, 0xd9,
+	0x5f, 0xf6, 0xfa, 0x9a, 0xf3, 0x23, 0x94, 0xb3, 0x75, 0x87, 0x26, 0x19, 0xf2, 0x62, 0x26, 0x36,
+	0x94, 0xfb, 0x57, 0x0b, 0x6e, 0xaa, 0xe7, 0x86, 0x9c, 0xb2, 0x3e, 0xfd, 0x9b, 0x63, 0x1f, 0xba,
+	0x2a, 0x4d, 0xd4, 0xcf, 0x8e, 0xc1, 0xc1, 0x46, 0x7d, 0xec, 0x2e, 0xab, 0xe0, 0x15, 0x52, 0xee,
+	0x2f, 0x61, 0x43, 0x85, 0x6f, 0x72, 0xbf, 0xee, 0x04, 0x9e, 0xbc, 0x04, 0x97, 0xb2, 0x3d, 0x13,
+	0x1e, 0xcb, 0xf6, 0x48, 0x12, 0x71, 0x46, 0xa3, 0xbd, 0x2c, 0x3a, 0xa9, 0x34, 0x7f, 0xf6, 0xd5,
+	0x11, 0x15, 0xc7, 0x79, 0xb0, 0x17, 0xb2, 0xf1, 0x7e, 0xc8, 0x32, 0x05, 0xed, 0xfd, 0x52, 0xe9,
+	0xc1, 0x88, 0xed, 0x97, 0xb2, 0x41, 0x47, 0x7d, 0x1e, 0xfe, 0x6f, 0x00, 0x08, 0x38, 0x3c, 0x96,
+	0xab, 0x18, 0x00, 0x00,
 }
@@ -0,0 +1,76 @@
+package prototype
+
+import "fmt"
+
+// Permission is the level of account authority required to sign an operation.
+// Levels are ordered, a higher level can always sign for operations requiring a lower level.
+type Permission uint32
+
+const (
+	PermissionPosting Permission = iota // social activities, e.g. posting, voting and following
+	PermissionActive                    // everything touching tokens or chain governance
+	PermissionOwner                     // changing the authorities of the account itself
+)
+
+const sMetaKeyOperationPermission = "op_meta_permission"
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionPosting:
+		return "posting"
+	case PermissionActive:
+		return "active"
+	case PermissionOwner:
+		return "owner"
+	}
+	return "unknown"
+}
+
+// registerOperationPermission declares the permission level required by given operation type.
+// Operations without a declaration require PermissionActive.
+func registerOperationPermission(opPtr interface{}, permission Permission) {
+	RegisterOperationMeta(opPtr, sMetaKeyOperationPermission, permission)
+}
+
+// GetGenericOperationPermission returns the permission level required by given operation.
+func GetGenericOperationPermission(op *Operation) Permission {
+	if value := GetGenericOperationMeta(op, sMetaKeyOperationPermission); value != nil {
+		return value.(Permission)
+	}
+	return PermissionActive
+}
+
+// RequiredPermission returns the highest permission level required by operations of the transaction.
+func (tx *Transaction) RequiredPermission() Permission {
+	p := PermissionPosting
+	for _, op := range tx.GetOperations() {
+		if opPerm := GetGenericOperationPermission(op); opPerm > p {
+			p = opPerm
+		}
+	}
+	return p
+}
+
+// Authority returns the authority of given permission level.
+func (m *AccountAuthorities) Authority(p Permission) *Authority {
+	switch p {
+	case PermissionPosting:
+		return m.GetPosting()
+	case PermissionActive:
+		return m.GetActive()
+	case PermissionOwner:
+		return m.GetOwner()
+	}
+	return nil
+}
+
+// Check checks if given signing keys are able to sign for given permission level.
+// Authorities of any higher levels are also accepted.
+func (m *AccountAuthorities) Check(signingKeys []*PublicKeyType, p Permission) error {
+	for level := p; level <= PermissionOwner; level++ {
+		if auth := m.Authority(level); auth != nil && auth.Check(signingKeys) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("insufficient signature weight for %s permission", p)
+}
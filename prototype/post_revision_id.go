@@ -0,0 +1,7 @@
+package prototype
+
+import "github.com/coschain/contentos-go/common/encoding/kope"
+
+func (m *PostRevisionId) OpeEncode() ([]byte, error) {
+	return kope.Encode(m.PostId, m.Version)
+}
@@ -0,0 +1,40 @@
+package prototype
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/pkg/errors"
+)
+
+func DefaultRewardPolicy() *RewardPolicy {
+	return &RewardPolicy{
+		Weighting: constants.RewardWeightingLinear,
+		CreatorRate: constants.RewardRateCreator,
+		DappRate: constants.RewardRateDapp,
+		AuthorRate: constants.RewardRateAuthor,
+		ReplyRate: constants.RewardRateReply,
+		VoterRate: constants.RewardRateVoter,
+	}
+}
+
+func (m *RewardPolicy) Validate() error {
+	if m == nil {
+		return ErrNpe
+	}
+	switch m.GetWeighting() {
+	case constants.RewardWeightingLinear, constants.RewardWeightingQuadratic:
+	default:
+		return errors.New("unknown reward weighting " + m.GetWeighting())
+	}
+	// rates are summed up in uint64, so that they can't wrap around
+	if uint64(m.GetCreatorRate()) + uint64(m.GetDappRate()) > constants.PERCENT {
+		return errors.New("creator and dapp rates exceed 100%")
+	}
+	// dapp routes of replies are weighted by reply rate / author rate
+	if m.GetAuthorRate() == 0 {
+		return errors.New("author rate must be positive")
+	}
+	if uint64(m.GetAuthorRate()) + uint64(m.GetReplyRate()) + uint64(m.GetVoterRate()) != constants.PERCENT {
+		return errors.New("author, reply and voter rates must sum up to 100%")
+	}
+	return nil
+}
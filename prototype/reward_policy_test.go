@@ -0,0 +1,31 @@
+package prototype
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestRewardPolicyValidate(t *testing.T) {
+	a := assert.New(t)
+
+	a.NoError(DefaultRewardPolicy().Validate())
+
+	p := DefaultRewardPolicy()
+	p.Weighting = "unknown"
+	a.Error(p.Validate())
+
+	// sums of rates must not wrap around
+	p = DefaultRewardPolicy()
+	p.CreatorRate, p.DappRate = math.MaxUint32, 1
+	a.Error(p.Validate())
+
+	p = DefaultRewardPolicy()
+	p.AuthorRate, p.ReplyRate, p.VoterRate = math.MaxUint32, constants.PERCENT, 1
+	a.Error(p.Validate())
+
+	p = DefaultRewardPolicy()
+	p.AuthorRate, p.ReplyRate, p.VoterRate = 0, constants.PERCENT, 0
+	a.Error(p.Validate())
+}
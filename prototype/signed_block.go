@@ -99,6 +99,63 @@ func (sb *SignedBlock) CalculateMerkleRoot() *common.BlockID {
 	return root
 }
 
+// MerkleProof returns hashes of siblings on the merkle path of the index-th transaction, from the bottom up.
+// A node without sibling, the last one of a level having an odd number of nodes, contributes nothing.
+func (sb *SignedBlock) MerkleProof(index int) ([][]byte, error) {
+	if index < 0 || index >= len(sb.Transactions) {
+		return nil, errors.New("transaction index out of range")
+	}
+	var ids = make([]*Sha256, len(sb.Transactions))
+	for i := 0; i < len(sb.Transactions); i++ {
+		ids[i], _ = sb.Transactions[i].SigTrx.MerkleDigest()
+	}
+
+	var siblings [][]byte
+	for len(ids) > 1 {
+		if index^1 < len(ids) {
+			siblings = append(siblings, ids[index^1].Hash)
+		}
+		next := make([]*Sha256, 0, (len(ids)+1)/2)
+		for i := 0; i+1 < len(ids); i += 2 {
+			h, err := calculatePairHash(ids[i], ids[i+1])
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, h)
+		}
+		if len(ids)&1 == 1 {
+			next = append(next, ids[len(ids)-1])
+		}
+		ids = next
+		index /= 2
+	}
+	return siblings, nil
+}
+
+// VerifyMerkleProof checks if leaf is the index-th one of count leaves under given transaction merkle root.
+func VerifyMerkleProof(root, leaf []byte, index, count uint32, siblings [][]byte) bool {
+	if index >= count || len(leaf) != Size {
+		return false
+	}
+	h, k := &Sha256{Hash: leaf}, 0
+	for n := count; n > 1; n = (n + 1) / 2 {
+		if index^1 < n {
+			if k >= len(siblings) || len(siblings[k]) != Size {
+				return false
+			}
+			s := &Sha256{Hash: siblings[k]}
+			k++
+			if index&1 == 0 {
+				h, _ = calculatePairHash(h, s)
+			} else {
+				h, _ = calculatePairHash(s, h)
+			}
+		}
+		index /= 2
+	}
+	return k == len(siblings) && bytes.Equal(h.Hash, root)
+}
+
 func (sb *SignedBlock) Hash() (hash [Size]byte) {
 	data, _ := proto.Marshal(sb)
 	hash = sha256.Sum256(data)
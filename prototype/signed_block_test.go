@@ -0,0 +1,51 @@
+package prototype
+
+import (
+	"fmt"
+	"testing"
+)
+
+func makeBlockWithTrxs(n int) *SignedBlock {
+	blk := &SignedBlock{}
+	for i := 0; i < n; i++ {
+		op := makeOp()
+		op.Memo = fmt.Sprintf("trx %d", i)
+		trx := &SignedTransaction{
+			Trx: &Transaction{
+				RefBlockNum:    1,
+				RefBlockPrefix: 2,
+				Expiration:     &TimePointSec{UtcSeconds: 3},
+			},
+		}
+		trx.Trx.AddOperation(op)
+		blk.Transactions = append(blk.Transactions, &TransactionWrapper{SigTrx: trx})
+	}
+	return blk
+}
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		blk := makeBlockWithTrxs(n)
+		root := blk.CalculateMerkleRoot().Data[:]
+		for i := 0; i < n; i++ {
+			leaf, _ := blk.Transactions[i].SigTrx.MerkleDigest()
+			siblings, err := blk.MerkleProof(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !VerifyMerkleProof(root, leaf.Hash, uint32(i), uint32(n), siblings) {
+				t.Fatalf("valid proof of trx %d/%d rejected", i, n)
+			}
+			if n > 1 && VerifyMerkleProof(root, leaf.Hash, uint32((i+1)%n), uint32(n), siblings) {
+				t.Fatalf("proof of trx %d/%d accepted at a wrong index", i, n)
+			}
+			other, _ := blk.Transactions[(i+1)%n].SigTrx.MerkleDigest()
+			if n > 1 && VerifyMerkleProof(root, other.Hash, uint32(i), uint32(n), siblings) {
+				t.Fatalf("proof of trx %d/%d accepted for another trx", i, n)
+			}
+		}
+		if _, err := blk.MerkleProof(n); err == nil {
+			t.Fatal("out of range index accepted")
+		}
+	}
+}
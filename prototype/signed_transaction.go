@@ -2,8 +2,10 @@ package prototype
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	cmn "github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/common/constants"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"github.com/gogo/protobuf/proto"
@@ -20,13 +22,38 @@ func (p *SignedTransaction) ExportPubKeys(cid ChainId) (*PublicKeyType, error) {
 	if p.Signature == nil {
 		return nil, errors.New("no signatures")
 	}
-	if err = p.Signature.Validate(); err != nil {
-		return nil, err
+	return recoverPubKey(buf, p.Signature)
+}
+
+// ExportAllPubKeys recovers public keys from the primary signature and all co-signatures.
+// The key of primary signature always comes first.
+func (p *SignedTransaction) ExportAllPubKeys(cid ChainId) ([]*PublicKeyType, error) {
+	buf, err := p.getTrxHash(cid)
+
+	if err != nil {
+		return nil, errors.New("sha256 error")
 	}
 
-	result := &PublicKeyType{}
+	if p.Signature == nil {
+		return nil, errors.New("no signatures")
+	}
+	keys := make([]*PublicKeyType, 0, 1+len(p.Signatures))
+	for _, sig := range append([]*SignatureType{p.Signature}, p.Signatures...) {
+		key, err := recoverPubKey(buf, sig)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func recoverPubKey(hash []byte, sig *SignatureType) (*PublicKeyType, error) {
+	if err := sig.Validate(); err != nil {
+		return nil, err
+	}
 
-	buffer, err := secp256k1.RecoverPubkey(buf, p.Signature.Sig)
+	buffer, err := secp256k1.RecoverPubkey(hash, sig.Sig)
 
 	if err != nil {
 		return nil, errors.New("recover error")
@@ -37,9 +64,7 @@ func (p *SignedTransaction) ExportPubKeys(cid ChainId) (*PublicKeyType, error) {
 		return nil, errors.New("recover error")
 	}
 
-	result = PublicKeyFromBytes(secp256k1.CompressPubkey(ecPubKey.X, ecPubKey.Y))
-
-	return result, nil
+	return PublicKeyFromBytes(secp256k1.CompressPubkey(ecPubKey.X, ecPubKey.Y)), nil
 }
 
 func (p *SignedTransaction) Validate() error {
@@ -55,6 +80,20 @@ func (p *SignedTransaction) Validate() error {
 		return errors.WithMessage(err, fmt.Sprintf("Signature error"))
 	}
 
+	if len(p.Signatures) + 1 > constants.MaxAuthorityKeyCount {
+		return fmt.Errorf("too many signatures, %d > %d", len(p.Signatures) + 1, constants.MaxAuthorityKeyCount)
+	}
+	sigs := map[string]bool{ string(p.Signature.Sig): true }
+	for i, sig := range p.Signatures {
+		if err := sig.Validate(); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("Signatures[%d] error", i))
+		}
+		if sigs[string(sig.Sig)] {
+			return ErrSigDuplicated
+		}
+		sigs[string(sig.Sig)] = true
+	}
+
 	return nil
 }
 
@@ -105,6 +144,12 @@ func (p *SignedTransaction) getTrxHash(cid ChainId) ([]byte, error) {
 	return bs, nil
 }
 
+// Digest returns the hash signed by all signatures of the transaction.
+// Unlike Id, it doesn't depend on the signatures, so any valid signature set of the same Trx shares the digest.
+func (p *SignedTransaction) Digest(cid ChainId) ([]byte, error) {
+	return p.getTrxHash(cid)
+}
+
 func (p *SignedTransaction) Sign(secKey *PrivateKeyType, cid ChainId) []byte {
 
 	buf, err := p.getTrxHash(cid)
@@ -144,6 +189,28 @@ func (p *SignedTransaction) Id() (*Sha256, error) {
 	return id, nil
 }
 
+// ShortTrxIdKey is the per-block key of short transaction ids.
+type ShortTrxIdKey struct {
+	k0, k1 uint64
+}
+
+// NewShortTrxIdKey returns the short id key of a compact block of given block id and nonce.
+func NewShortTrxIdKey(blockId []byte, nonce uint64) ShortTrxIdKey {
+	var n [8]byte
+	binary.LittleEndian.PutUint64(n[:], nonce)
+	h := sha256.New()
+	h.Write(blockId)
+	h.Write(n[:])
+	bs := h.Sum(nil)
+	return ShortTrxIdKey{k0: binary.LittleEndian.Uint64(bs[:8]), k1: binary.LittleEndian.Uint64(bs[8:16])}
+}
+
+// ShortTrxId returns the short form of a transaction id, which identifies transactions in compact blocks.
+// Short ids are salted by the key so that nobody can craft transactions of colliding short ids in advance.
+func (k ShortTrxIdKey) ShortTrxId(trxId []byte) uint64 {
+	return sipHash24(k.k0, k.k1, trxId)
+}
+
 func (p *SignedTransaction) MerkleDigest() (*Sha256, error) {
 	buf, err := proto.Marshal(p)
 	if err != nil {
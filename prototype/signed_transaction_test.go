@@ -154,3 +154,26 @@ func TestVerifySig(t *testing.T) {
 	//}
 	fmt.Println("Export PubKeys: ", expPubKeys.ToWIF())
 }
+
+func TestShortTrxId(t *testing.T) {
+	var msg [15]byte
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	// reference vector of SipHash-2-4, key 00..0f, message 00..0e
+	if h := sipHash24(0x0706050403020100, 0x0f0e0d0c0b0a0908, msg[:]); h != 0xa129ca6149be45e5 {
+		t.Fatalf("unexpected siphash %x", h)
+	}
+
+	trxId := []byte("0123456789abcdef0123456789abcdef")
+	k1, k2 := NewShortTrxIdKey([]byte("block-1"), 1), NewShortTrxIdKey([]byte("block-1"), 2)
+	if k1.ShortTrxId(trxId) != NewShortTrxIdKey([]byte("block-1"), 1).ShortTrxId(trxId) {
+		t.Fatal("short ids of the same key differ")
+	}
+	if k1.ShortTrxId(trxId) == k2.ShortTrxId(trxId) {
+		t.Fatal("short ids of different nonces collide")
+	}
+	if k1.ShortTrxId(trxId) == NewShortTrxIdKey([]byte("block-2"), 1).ShortTrxId(trxId) {
+		t.Fatal("short ids of different blocks collide")
+	}
+}
@@ -51,5 +51,7 @@ func stringLengthValidator(s string, min, max int) error {
 var ValidContractName = ValidVarName
 var ValidContractMethodName = ValidVarName
 var ValidContractTableName = ValidVarName
+var ValidContractEventName = ValidVarName
+var ValidFeatureName = ValidVarName
 var AtMost1KChars = func(s string) error { return stringLengthValidator(s, 0, 1024 * 1) }
 var AtMost4KChars = func(s string) error { return stringLengthValidator(s, 0, 1024 * 4) }
@@ -29,6 +29,10 @@ func (m *Transaction) Validate() error {
 		}
 	}
 
+	if m.Cancel && m.Nonce == 0 {
+		return errors.New("cancellation trx must has Nonce")
+	}
+
 	return nil
 }
 
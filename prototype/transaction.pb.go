@@ -43,6 +43,16 @@ type Operation struct {
 	//	*Operation_Op22
 	//	*Operation_Op23
 	//	*Operation_Op24
+	//	*Operation_Op25
+	//	*Operation_Op26
+	//	*Operation_Op27
+	//	*Operation_Op28
+	//	*Operation_Op29
+	//	*Operation_Op30
+	//	*Operation_Op31
+	//	*Operation_Op32
+	//	*Operation_Op33
+	//	*Operation_Op34
 	Op                   isOperation_Op `protobuf_oneof:"op"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
@@ -162,6 +172,46 @@ type Operation_Op24 struct {
 	Op24 *UnDelegateVestOperation `protobuf:"bytes,24,opt,name=op24,proto3,oneof"`
 }
 
+type Operation_Op25 struct {
+	Op25 *ScheduledTransferOperation `protobuf:"bytes,25,opt,name=op25,proto3,oneof"`
+}
+
+type Operation_Op26 struct {
+	Op26 *CancelScheduledTransferOperation `protobuf:"bytes,26,opt,name=op26,proto3,oneof"`
+}
+
+type Operation_Op27 struct {
+	Op27 *EscrowTransferOperation `protobuf:"bytes,27,opt,name=op27,proto3,oneof"`
+}
+
+type Operation_Op28 struct {
+	Op28 *EscrowApproveOperation `protobuf:"bytes,28,opt,name=op28,proto3,oneof"`
+}
+
+type Operation_Op29 struct {
+	Op29 *EscrowDisputeOperation `protobuf:"bytes,29,opt,name=op29,proto3,oneof"`
+}
+
+type Operation_Op30 struct {
+	Op30 *EscrowReleaseOperation `protobuf:"bytes,30,opt,name=op30,proto3,oneof"`
+}
+
+type Operation_Op31 struct {
+	Op31 *ProposalCreateOperation `protobuf:"bytes,31,opt,name=op31,proto3,oneof"`
+}
+
+type Operation_Op32 struct {
+	Op32 *ProposalVoteOperation `protobuf:"bytes,32,opt,name=op32,proto3,oneof"`
+}
+
+type Operation_Op33 struct {
+	Op33 *PostEditOperation `protobuf:"bytes,33,opt,name=op33,proto3,oneof"`
+}
+
+type Operation_Op34 struct {
+	Op34 *PostDeleteOperation `protobuf:"bytes,34,opt,name=op34,proto3,oneof"`
+}
+
 func (*Operation_Op1) isOperation_Op() {}
 
 func (*Operation_Op2) isOperation_Op() {}
@@ -204,6 +254,26 @@ func (*Operation_Op23) isOperation_Op() {}
 
 func (*Operation_Op24) isOperation_Op() {}
 
+func (*Operation_Op25) isOperation_Op() {}
+
+func (*Operation_Op26) isOperation_Op() {}
+
+func (*Operation_Op27) isOperation_Op() {}
+
+func (*Operation_Op28) isOperation_Op() {}
+
+func (*Operation_Op29) isOperation_Op() {}
+
+func (*Operation_Op30) isOperation_Op() {}
+
+func (*Operation_Op31) isOperation_Op() {}
+
+func (*Operation_Op32) isOperation_Op() {}
+
+func (*Operation_Op33) isOperation_Op() {}
+
+func (*Operation_Op34) isOperation_Op() {}
+
 func (m *Operation) GetOp() isOperation_Op {
 	if m != nil {
 		return m.Op
@@ -358,6 +428,76 @@ func (m *Operation) GetOp24() *UnDelegateVestOperation {
 	return nil
 }
 
+func (m *Operation) GetOp25() *ScheduledTransferOperation {
+	if x, ok := m.GetOp().(*Operation_Op25); ok {
+		return x.Op25
+	}
+	return nil
+}
+
+func (m *Operation) GetOp26() *CancelScheduledTransferOperation {
+	if x, ok := m.GetOp().(*Operation_Op26); ok {
+		return x.Op26
+	}
+	return nil
+}
+
+func (m *Operation) GetOp27() *EscrowTransferOperation {
+	if x, ok := m.GetOp().(*Operation_Op27); ok {
+		return x.Op27
+	}
+	return nil
+}
+
+func (m *Operation) GetOp28() *EscrowApproveOperation {
+	if x, ok := m.GetOp().(*Operation_Op28); ok {
+		return x.Op28
+	}
+	return nil
+}
+
+func (m *Operation) GetOp29() *EscrowDisputeOperation {
+	if x, ok := m.GetOp().(*Operation_Op29); ok {
+		return x.Op29
+	}
+	return nil
+}
+
+func (m *Operation) GetOp30() *EscrowReleaseOperation {
+	if x, ok := m.GetOp().(*Operation_Op30); ok {
+		return x.Op30
+	}
+	return nil
+}
+
+func (m *Operation) GetOp31() *ProposalCreateOperation {
+	if x, ok := m.GetOp().(*Operation_Op31); ok {
+		return x.Op31
+	}
+	return nil
+}
+
+func (m *Operation) GetOp32() *ProposalVoteOperation {
+	if x, ok := m.GetOp().(*Operation_Op32); ok {
+		return x.Op32
+	}
+	return nil
+}
+
+func (m *Operation) GetOp33() *PostEditOperation {
+	if x, ok := m.GetOp().(*Operation_Op33); ok {
+		return x.Op33
+	}
+	return nil
+}
+
+func (m *Operation) GetOp34() *PostDeleteOperation {
+	if x, ok := m.GetOp().(*Operation_Op34); ok {
+		return x.Op34
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Operation) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -382,18 +522,32 @@ func (*Operation) XXX_OneofWrappers() []interface{} {
 		(*Operation_Op22)(nil),
 		(*Operation_Op23)(nil),
 		(*Operation_Op24)(nil),
+		(*Operation_Op25)(nil),
+		(*Operation_Op26)(nil),
+		(*Operation_Op27)(nil),
+		(*Operation_Op28)(nil),
+		(*Operation_Op29)(nil),
+		(*Operation_Op30)(nil),
+		(*Operation_Op31)(nil),
+		(*Operation_Op32)(nil),
+		(*Operation_Op33)(nil),
+		(*Operation_Op34)(nil),
 	}
 }
 
 // transaction
 type Transaction struct {
-	RefBlockNum          uint32        `protobuf:"varint,1,opt,name=ref_block_num,json=refBlockNum,proto3" json:"ref_block_num,omitempty"`
-	RefBlockPrefix       uint32        `protobuf:"varint,2,opt,name=ref_block_prefix,json=refBlockPrefix,proto3" json:"ref_block_prefix,omitempty"`
-	Expiration           *TimePointSec `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	Operations           []*Operation  `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	RefBlockNum    uint32        `protobuf:"varint,1,opt,name=ref_block_num,json=refBlockNum,proto3" json:"ref_block_num,omitempty"`
+	RefBlockPrefix uint32        `protobuf:"varint,2,opt,name=ref_block_prefix,json=refBlockPrefix,proto3" json:"ref_block_prefix,omitempty"`
+	Expiration     *TimePointSec `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Operations     []*Operation  `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	// if non-zero, a pending trx of the same signer and nonce can be replaced by another one of later expiration
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// if set, the trx cancels the pending trx of the same signer and nonce, and never gets into blocks
+	Cancel               bool     `protobuf:"varint,6,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -449,12 +603,27 @@ func (m *Transaction) GetOperations() []*Operation {
 	return nil
 }
 
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
 type SignedTransaction struct {
-	Trx                  *Transaction   `protobuf:"bytes,1,opt,name=trx,proto3" json:"trx,omitempty"`
-	Signature            *SignatureType `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Trx                  *Transaction     `protobuf:"bytes,1,opt,name=trx,proto3" json:"trx,omitempty"`
+	Signature            *SignatureType   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signatures           []*SignatureType `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *SignedTransaction) Reset()         { *m = SignedTransaction{} }
@@ -496,20 +665,100 @@ func (m *SignedTransaction) GetSignature() *SignatureType {
 	return nil
 }
 
+func (m *SignedTransaction) GetSignatures() []*SignatureType {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// an event emitted by a contract, of which data is in json.
+type ContractEvent struct {
+	Owner                *AccountName `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Contract             string       `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Name                 string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 string       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Seq                  uint64       `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
+func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractEvent) ProtoMessage()    {}
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{3}
+}
+
+func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEvent.Unmarshal(m, b)
+}
+func (m *ContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEvent.Marshal(b, m, deterministic)
+}
+func (m *ContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvent.Merge(m, src)
+}
+func (m *ContractEvent) XXX_Size() int {
+	return xxx_messageInfo_ContractEvent.Size(m)
+}
+func (m *ContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvent proto.InternalMessageInfo
+
+func (m *ContractEvent) GetOwner() *AccountName {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *ContractEvent) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ContractEvent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type OperationReceiptWithInfo struct {
-	Status               uint32   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	GasUsage             uint64   `protobuf:"varint,2,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
-	VmConsole            string   `protobuf:"bytes,3,opt,name=vm_console,json=vmConsole,proto3" json:"vm_console,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Status               uint32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	GasUsage             uint64           `protobuf:"varint,2,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	VmConsole            string           `protobuf:"bytes,3,opt,name=vm_console,json=vmConsole,proto3" json:"vm_console,omitempty"`
+	Events               []*ContractEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *OperationReceiptWithInfo) Reset()         { *m = OperationReceiptWithInfo{} }
 func (m *OperationReceiptWithInfo) String() string { return proto.CompactTextString(m) }
 func (*OperationReceiptWithInfo) ProtoMessage()    {}
 func (*OperationReceiptWithInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{3}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{4}
 }
 
 func (m *OperationReceiptWithInfo) XXX_Unmarshal(b []byte) error {
@@ -551,6 +800,13 @@ func (m *OperationReceiptWithInfo) GetVmConsole() string {
 	return ""
 }
 
+func (m *OperationReceiptWithInfo) GetEvents() []*ContractEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type TransactionReceiptWithInfo struct {
 	Status               uint32                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	NetUsage             uint64                      `protobuf:"varint,2,opt,name=net_usage,json=netUsage,proto3" json:"net_usage,omitempty"`
@@ -566,7 +822,7 @@ func (m *TransactionReceiptWithInfo) Reset()         { *m = TransactionReceiptWi
 func (m *TransactionReceiptWithInfo) String() string { return proto.CompactTextString(m) }
 func (*TransactionReceiptWithInfo) ProtoMessage()    {}
 func (*TransactionReceiptWithInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{4}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{5}
 }
 
 func (m *TransactionReceiptWithInfo) XXX_Unmarshal(b []byte) error {
@@ -634,7 +890,7 @@ func (m *TransactionWrapperWithInfo) Reset()         { *m = TransactionWrapperWi
 func (m *TransactionWrapperWithInfo) String() string { return proto.CompactTextString(m) }
 func (*TransactionWrapperWithInfo) ProtoMessage()    {}
 func (*TransactionWrapperWithInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{5}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{6}
 }
 
 func (m *TransactionWrapperWithInfo) XXX_Unmarshal(b []byte) error {
@@ -682,7 +938,7 @@ func (m *TransactionReceipt) Reset()         { *m = TransactionReceipt{} }
 func (m *TransactionReceipt) String() string { return proto.CompactTextString(m) }
 func (*TransactionReceipt) ProtoMessage()    {}
 func (*TransactionReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{6}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{7}
 }
 
 func (m *TransactionReceipt) XXX_Unmarshal(b []byte) error {
@@ -736,7 +992,7 @@ func (m *TransactionWrapper) Reset()         { *m = TransactionWrapper{} }
 func (m *TransactionWrapper) String() string { return proto.CompactTextString(m) }
 func (*TransactionWrapper) ProtoMessage()    {}
 func (*TransactionWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{7}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{8}
 }
 
 func (m *TransactionWrapper) XXX_Unmarshal(b []byte) error {
@@ -778,6 +1034,7 @@ type BlockHeader struct {
 	BlockProducer         *AccountName  `protobuf:"bytes,3,opt,name=block_producer,json=blockProducer,proto3" json:"block_producer,omitempty"`
 	TransactionMerkleRoot *Sha256       `protobuf:"bytes,4,opt,name=transaction_merkle_root,json=transactionMerkleRoot,proto3" json:"transaction_merkle_root,omitempty"`
 	PrevApplyHash         uint64        `protobuf:"varint,5,opt,name=prev_apply_hash,json=prevApplyHash,proto3" json:"prev_apply_hash,omitempty"`
+	PrevStateRoot         *Sha256       `protobuf:"bytes,6,opt,name=prev_state_root,json=prevStateRoot,proto3" json:"prev_state_root,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}      `json:"-"`
 	XXX_unrecognized      []byte        `json:"-"`
 	XXX_sizecache         int32         `json:"-"`
@@ -787,7 +1044,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{8}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{9}
 }
 
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
@@ -843,6 +1100,13 @@ func (m *BlockHeader) GetPrevApplyHash() uint64 {
 	return 0
 }
 
+func (m *BlockHeader) GetPrevStateRoot() *Sha256 {
+	if m != nil {
+		return m.PrevStateRoot
+	}
+	return nil
+}
+
 type SignedBlockHeader struct {
 	Header                 *BlockHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	BlockProducerSignature *SignatureType `protobuf:"bytes,2,opt,name=block_producer_signature,json=blockProducerSignature,proto3" json:"block_producer_signature,omitempty"`
@@ -855,7 +1119,7 @@ func (m *SignedBlockHeader) Reset()         { *m = SignedBlockHeader{} }
 func (m *SignedBlockHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedBlockHeader) ProtoMessage()    {}
 func (*SignedBlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{9}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{10}
 }
 
 func (m *SignedBlockHeader) XXX_Unmarshal(b []byte) error {
@@ -902,7 +1166,7 @@ func (m *SignedBlock) Reset()         { *m = SignedBlock{} }
 func (m *SignedBlock) String() string { return proto.CompactTextString(m) }
 func (*SignedBlock) ProtoMessage()    {}
 func (*SignedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{10}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{11}
 }
 
 func (m *SignedBlock) XXX_Unmarshal(b []byte) error {
@@ -949,7 +1213,7 @@ func (m *EmptySignedBlock) Reset()         { *m = EmptySignedBlock{} }
 func (m *EmptySignedBlock) String() string { return proto.CompactTextString(m) }
 func (*EmptySignedBlock) ProtoMessage()    {}
 func (*EmptySignedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f3aa2bc02ae1e20c, []int{11}
+	return fileDescriptor_f3aa2bc02ae1e20c, []int{12}
 }
 
 func (m *EmptySignedBlock) XXX_Unmarshal(b []byte) error {
@@ -988,6 +1252,7 @@ func init() {
 	proto.RegisterType((*Operation)(nil), "prototype.operation")
 	proto.RegisterType((*Transaction)(nil), "prototype.transaction")
 	proto.RegisterType((*SignedTransaction)(nil), "prototype.signed_transaction")
+	proto.RegisterType((*ContractEvent)(nil), "prototype.contract_event")
 	proto.RegisterType((*OperationReceiptWithInfo)(nil), "prototype.operation_receipt_with_info")
 	proto.RegisterType((*TransactionReceiptWithInfo)(nil), "prototype.transaction_receipt_with_info")
 	proto.RegisterType((*TransactionWrapperWithInfo)(nil), "prototype.transaction_wrapper_with_info")
@@ -1002,75 +1267,93 @@ func init() {
 func init() { proto.RegisterFile("prototype/transaction.proto", fileDescriptor_f3aa2bc02ae1e20c) }
 
 var fileDescriptor_f3aa2bc02ae1e20c = []byte{
-	// 1113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x6e, 0xdb, 0xb6,
-	0x17, 0xc7, 0x7f, 0x4e, 0xdc, 0x34, 0x62, 0xea, 0xfe, 0x5a, 0x36, 0x4d, 0xd8, 0x78, 0x09, 0x32,
-	0x6d, 0x2b, 0x82, 0x01, 0xb1, 0x13, 0xd9, 0xb1, 0x9d, 0x0d, 0x18, 0xb0, 0x04, 0x03, 0xd2, 0x8b,
-	0x0d, 0x85, 0xba, 0xdd, 0xec, 0x86, 0xa0, 0x65, 0xda, 0x16, 0x62, 0x8b, 0x2c, 0x49, 0x39, 0xf6,
-	0x13, 0x0c, 0xd8, 0x0b, 0xec, 0x62, 0x4f, 0xb0, 0x77, 0xd9, 0xfd, 0x5e, 0x67, 0x20, 0xa5, 0xc8,
-	0xd4, 0x9f, 0x14, 0xe8, 0xd0, 0x3b, 0x8b, 0xfc, 0x7e, 0xcc, 0xef, 0x39, 0x3c, 0xe7, 0x48, 0xa0,
-	0xc9, 0x05, 0x53, 0x4c, 0xad, 0x38, 0x6d, 0x2b, 0x41, 0x22, 0x49, 0x02, 0x15, 0xb2, 0xa8, 0x65,
-	0x56, 0xa1, 0x93, 0x6d, 0x1e, 0xec, 0x5a, 0xba, 0x15, 0xa7, 0x89, 0xe0, 0xe0, 0xd5, 0x7a, 0x95,
-	0x71, 0x2a, 0xc8, 0x9a, 0x75, 0x7f, 0x77, 0x80, 0x93, 0xad, 0xc1, 0x3e, 0xd8, 0x64, 0xfc, 0x1c,
-	0xd5, 0x8e, 0x6b, 0x27, 0x3b, 0xde, 0x17, 0xad, 0x0c, 0x6b, 0x91, 0x20, 0x60, 0x71, 0xa4, 0x70,
-	0x20, 0x28, 0x51, 0x14, 0x67, 0xc4, 0xcd, 0xff, 0x7c, 0x4d, 0xc0, 0x73, 0x0d, 0x7a, 0x68, 0xc3,
-	0x80, 0x87, 0x16, 0x68, 0xdc, 0x8e, 0xa9, 0x28, 0x22, 0x1e, 0xec, 0x6a, 0xa4, 0x83, 0x36, 0x0d,
-	0x72, 0x6c, 0x21, 0x43, 0x8e, 0x05, 0x9d, 0x84, 0x52, 0x95, 0xa9, 0x0e, 0xf4, 0x34, 0xd5, 0x45,
-	0x75, 0x43, 0x1d, 0xe5, 0x29, 0x1a, 0x91, 0xe1, 0xac, 0x64, 0xae, 0x0b, 0xcf, 0x34, 0x73, 0x81,
-	0x1e, 0x19, 0xe6, 0xb3, 0x3c, 0xb3, 0x60, 0xe5, 0x70, 0x2e, 0xe0, 0xa9, 0x26, 0x7a, 0x68, 0xcb,
-	0x10, 0xaf, 0x2c, 0x82, 0x33, 0xa9, 0x8a, 0xf2, 0x1e, 0x6c, 0x69, 0x79, 0x1f, 0x3d, 0x36, 0xf2,
-	0x03, 0x4b, 0x2e, 0x28, 0x9f, 0xad, 0x8a, 0xfa, 0x3e, 0x6c, 0x6b, 0xfd, 0x00, 0x6d, 0x1b, 0x7d,
-	0xd3, 0xd2, 0x8f, 0xd9, 0x6c, 0xc6, 0xee, 0x8a, 0xc0, 0x20, 0xf1, 0x73, 0x89, 0x9c, 0x92, 0x9f,
-	0x2a, 0xfb, 0x97, 0xf0, 0x5b, 0x50, 0x67, 0xfc, 0xfc, 0x0c, 0x01, 0xa3, 0xff, 0xaa, 0xea, 0x3a,
-	0x14, 0xc3, 0x0b, 0x5a, 0x88, 0xc5, 0x40, 0xf0, 0x1b, 0x03, 0x77, 0x50, 0xc3, 0xc0, 0x5f, 0x5a,
-	0x70, 0xc0, 0x22, 0x25, 0x48, 0xa0, 0xf0, 0x88, 0xf2, 0x19, 0x5b, 0x95, 0xd8, 0x0e, 0xbc, 0x34,
-	0x6c, 0x17, 0x3d, 0x2d, 0x15, 0x50, 0xc6, 0x12, 0x5e, 0x4c, 0x89, 0x41, 0x60, 0xdf, 0xa0, 0x3d,
-	0xf4, 0xcc, 0xa0, 0x9f, 0xe7, 0xd1, 0x05, 0x15, 0xaa, 0xda, 0x6f, 0x0f, 0x9e, 0x19, 0xb0, 0x8f,
-	0x9e, 0x97, 0xb2, 0x2f, 0x15, 0xb9, 0xa5, 0x25, 0xa2, 0x0f, 0x3b, 0x86, 0x18, 0x20, 0x58, 0xaa,
-	0xd6, 0x38, 0xc2, 0xd5, 0xd0, 0x00, 0x76, 0x0d, 0x74, 0x89, 0x5e, 0x54, 0x55, 0x5e, 0xcc, 0x47,
-	0xc5, 0xb6, 0x30, 0xea, 0x24, 0x21, 0xde, 0x19, 0xda, 0x7d, 0xb0, 0xa3, 0xaa, 0x51, 0xef, 0x2c,
-	0x45, 0xcf, 0xd1, 0xcb, 0x0a, 0xf4, 0x7d, 0x1c, 0x0a, 0x8a, 0x55, 0x18, 0xdc, 0xd2, 0x52, 0x4a,
-	0xbc, 0xf3, 0x14, 0xf5, 0xd0, 0x5e, 0x09, 0x35, 0xf5, 0x32, 0x5c, 0x3d, 0x84, 0x7a, 0x70, 0x60,
-	0xd0, 0x0e, 0xda, 0x37, 0xa8, 0x6b, 0xa1, 0x23, 0x3a, 0xa3, 0x13, 0x6d, 0xb5, 0xea, 0x1e, 0xbc,
-	0x4e, 0x52, 0x74, 0x5e, 0x17, 0xa1, 0x52, 0xd1, 0xc5, 0x11, 0xfe, 0x30, 0xdc, 0xbd, 0xaa, 0x83,
-	0x0d, 0xc6, 0xdd, 0xbf, 0x6b, 0x60, 0xc7, 0x1a, 0x6f, 0xd0, 0x05, 0x0d, 0x41, 0xc7, 0x78, 0x38,
-	0x63, 0xc1, 0x2d, 0x8e, 0xe2, 0xb9, 0x19, 0x4c, 0x0d, 0x7f, 0x47, 0xd0, 0xf1, 0x95, 0x5e, 0xfb,
-	0x29, 0x9e, 0xc3, 0x13, 0xf0, 0x6c, 0xad, 0xe1, 0x82, 0x8e, 0xc3, 0xa5, 0x19, 0x43, 0x0d, 0xff,
-	0xe9, 0xbd, 0xec, 0xad, 0x59, 0x85, 0x97, 0x00, 0xd0, 0x25, 0x0f, 0x93, 0x93, 0xd3, 0xb9, 0x63,
-	0xf7, 0x92, 0x0a, 0xe7, 0x14, 0x73, 0x16, 0x46, 0x0a, 0x4b, 0x1a, 0xf8, 0x96, 0x18, 0x76, 0x01,
-	0xc8, 0x3c, 0x4b, 0x54, 0x3f, 0xde, 0x3c, 0xd9, 0xf1, 0x76, 0x2d, 0x34, 0xdb, 0xf4, 0x2d, 0x9d,
-	0x7b, 0x07, 0xa0, 0x0c, 0x27, 0x11, 0x1d, 0x61, 0x3b, 0xa8, 0x13, 0xb0, 0xa9, 0xc4, 0x32, 0x9d,
-	0xb1, 0x7b, 0xc5, 0xde, 0x4c, 0x44, 0xbe, 0x96, 0xc0, 0x3e, 0x70, 0x34, 0x4f, 0x54, 0x2c, 0x68,
-	0x3a, 0x5a, 0x6d, 0xbf, 0xd9, 0x1e, 0xd6, 0x8f, 0xfe, 0x5a, 0xeb, 0xbe, 0x07, 0xcd, 0xcc, 0x06,
-	0x16, 0x34, 0xa0, 0x21, 0x57, 0xf8, 0x2e, 0x54, 0x53, 0x1c, 0x46, 0x63, 0x06, 0xf7, 0xc0, 0x96,
-	0x54, 0x44, 0xc5, 0x32, 0xcd, 0x67, 0xfa, 0x04, 0x9b, 0xc0, 0x99, 0x10, 0x89, 0x63, 0x49, 0x26,
-	0xc9, 0x79, 0x75, 0x7f, 0x7b, 0x42, 0xe4, 0x2f, 0xfa, 0x19, 0x1e, 0x02, 0xb0, 0x98, 0xe3, 0x80,
-	0x45, 0x92, 0xcd, 0xa8, 0xc9, 0x9e, 0xe3, 0x3b, 0x8b, 0xf9, 0x75, 0xb2, 0xe0, 0xfe, 0x53, 0x03,
-	0x87, 0x56, 0x00, 0x1f, 0x77, 0x6a, 0x44, 0x55, 0xfe, 0xd4, 0x88, 0xaa, 0xe4, 0xd4, 0x26, 0x70,
-	0x02, 0x1e, 0xa7, 0x9b, 0x9b, 0xc9, 0x66, 0xc0, 0xe3, 0xcc, 0x12, 0x15, 0x82, 0x09, 0xf3, 0xff,
-	0xe6, 0x95, 0xe0, 0xf8, 0x8e, 0x59, 0x79, 0xa3, 0x0f, 0xfc, 0x41, 0x5f, 0x1a, 0x16, 0x54, 0xc6,
-	0x33, 0x25, 0xd1, 0x23, 0x73, 0x69, 0xaf, 0xab, 0x2e, 0xad, 0x6c, 0xd6, 0x77, 0x18, 0xf7, 0x13,
-	0xd0, 0xfd, 0xb3, 0x10, 0xd9, 0x9d, 0x20, 0x9c, 0x53, 0x61, 0x45, 0xd6, 0x03, 0x8f, 0x65, 0x38,
-	0xc1, 0xeb, 0x5b, 0x3d, 0x2c, 0xdc, 0x52, 0xbe, 0x02, 0xfc, 0x2d, 0x19, 0x4e, 0x7e, 0x16, 0x4b,
-	0x78, 0x05, 0x1e, 0xa7, 0x27, 0xa7, 0xb7, 0x7b, 0x52, 0x5d, 0x0d, 0x15, 0xfe, 0xee, 0x41, 0x77,
-	0x02, 0x5e, 0x54, 0x28, 0x3f, 0x7d, 0xb2, 0xdd, 0xdf, 0x6a, 0xf9, 0x93, 0xd2, 0x34, 0xfc, 0xe7,
-	0xe0, 0x07, 0xc5, 0xe0, 0x8f, 0x3e, 0x1c, 0xfc, 0x3a, 0xe4, 0xbf, 0x36, 0xc0, 0x93, 0xa4, 0xdd,
-	0xa7, 0x94, 0x8c, 0xa8, 0x80, 0xa7, 0x60, 0x9b, 0x0b, 0xba, 0x08, 0x59, 0x1a, 0xee, 0x8e, 0xf7,
-	0xdc, 0xf6, 0x30, 0x25, 0xde, 0x45, 0xcf, 0xcf, 0x24, 0xba, 0xad, 0x74, 0xab, 0x4b, 0x45, 0xe6,
-	0xbc, 0xa2, 0xad, 0x0a, 0x63, 0x60, 0xad, 0x85, 0xdf, 0x81, 0xa7, 0xf7, 0x63, 0x86, 0x8d, 0xe2,
-	0x80, 0x8a, 0x74, 0x88, 0xec, 0x57, 0x8c, 0xf5, 0x88, 0xcc, 0xa9, 0xdf, 0x18, 0x26, 0xe3, 0x27,
-	0x51, 0xc3, 0x37, 0x60, 0xdf, 0x0e, 0x6c, 0x4e, 0xc5, 0xed, 0x8c, 0x62, 0xc1, 0x98, 0x4a, 0xbf,
-	0x67, 0x2a, 0x6c, 0xbf, 0xb4, 0x88, 0x1f, 0x0d, 0xe0, 0x33, 0xa6, 0xe0, 0x6b, 0xf0, 0x7f, 0x1d,
-	0x4f, 0xfa, 0x36, 0x9d, 0x12, 0x39, 0x35, 0x9f, 0x37, 0x75, 0xbf, 0xa1, 0x97, 0xbf, 0xd7, 0xab,
-	0x37, 0x44, 0x4e, 0x75, 0xf1, 0xbe, 0x48, 0x2f, 0x21, 0x97, 0xb2, 0x36, 0xd8, 0x4a, 0x7e, 0xa5,
-	0x09, 0xb3, 0x43, 0xb0, 0x85, 0x7e, 0x2a, 0x83, 0xef, 0x00, 0xca, 0xc7, 0x8e, 0x3f, 0x62, 0x34,
-	0xed, 0xe5, 0xf2, 0xf0, 0x2e, 0x9b, 0x53, 0x7f, 0xd4, 0xc0, 0x13, 0xdb, 0x1d, 0xbc, 0x06, 0x8d,
-	0xf4, 0x39, 0xe7, 0xee, 0xa8, 0x5c, 0x52, 0x39, 0x93, 0xe9, 0x9f, 0xdc, 0x24, 0x56, 0xaf, 0xc0,
-	0x13, 0x2b, 0x69, 0x12, 0x6d, 0x98, 0xce, 0x7f, 0xa8, 0xbc, 0xd2, 0x3a, 0xf6, 0x73, 0x8c, 0xbb,
-	0x00, 0x90, 0xce, 0xb9, 0x5a, 0xe1, 0x4f, 0x6f, 0xaf, 0x09, 0x1c, 0x25, 0x96, 0xd8, 0x94, 0x49,
-	0xfa, 0xa6, 0xda, 0x56, 0x62, 0x79, 0xad, 0x9f, 0xaf, 0xde, 0x02, 0x37, 0x64, 0xe6, 0x63, 0x89,
-	0x46, 0x8a, 0xc9, 0x16, 0x89, 0x46, 0x82, 0x85, 0xa3, 0x96, 0x1c, 0xdd, 0xae, 0x0f, 0xf9, 0xf5,
-	0xeb, 0x49, 0xa8, 0xa6, 0xf1, 0xb0, 0x15, 0xb0, 0x79, 0x3b, 0x60, 0x32, 0x98, 0x92, 0x30, 0x6a,
-	0x67, 0xd0, 0xe9, 0x84, 0xb5, 0x33, 0xed, 0x70, 0xcb, 0xfc, 0xec, 0xfc, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0xd8, 0xac, 0x23, 0x42, 0x0c, 0x00, 0x00,
+	// 1396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xc7, 0x7f, 0x8e, 0x1d, 0x37, 0x66, 0xea, 0xfc, 0x5a, 0x26, 0x4d, 0x99, 0xb8, 0xc9, 0x52,
+	0x6d, 0xeb, 0x82, 0x01, 0x71, 0x62, 0xc9, 0xf1, 0x9f, 0x0d, 0x1b, 0xb0, 0x74, 0x03, 0xda, 0xc3,
+	0x86, 0x42, 0xd9, 0x2e, 0xbb, 0x08, 0xb4, 0xcc, 0xd8, 0x42, 0x64, 0x91, 0x25, 0x29, 0x27, 0x79,
+	0x05, 0x7b, 0x05, 0xc3, 0x0e, 0xbb, 0xee, 0x15, 0xec, 0xcd, 0xec, 0xba, 0xc3, 0x5e, 0xc5, 0x4e,
+	0x03, 0x29, 0x5a, 0x96, 0x2d, 0x39, 0x58, 0x87, 0xde, 0x44, 0xea, 0xfb, 0x11, 0x9f, 0xe7, 0xe1,
+	0x97, 0x0f, 0x05, 0x1a, 0x8c, 0x53, 0x49, 0xe5, 0x1d, 0x23, 0xa7, 0x92, 0xe3, 0x48, 0x60, 0x5f,
+	0x06, 0x34, 0x6a, 0xea, 0x59, 0x58, 0x4b, 0x5f, 0xee, 0xef, 0x64, 0x74, 0x77, 0x8c, 0x24, 0x82,
+	0xfd, 0xbd, 0xf9, 0x2c, 0x65, 0x84, 0xe3, 0x39, 0x6b, 0xfd, 0x59, 0x07, 0xb5, 0x74, 0x0e, 0x76,
+	0x41, 0x99, 0xb2, 0x16, 0x2a, 0x1d, 0x95, 0x8e, 0x37, 0xed, 0x0f, 0x9b, 0x29, 0xd6, 0xc4, 0xbe,
+	0x4f, 0xe3, 0x48, 0x7a, 0x3e, 0x27, 0x58, 0x12, 0x2f, 0x25, 0x5e, 0xfd, 0xcf, 0x55, 0x04, 0x6c,
+	0x29, 0xd0, 0x46, 0x6b, 0x1a, 0x3c, 0xc8, 0x80, 0x3a, 0xda, 0x2b, 0xc2, 0x97, 0x11, 0x1b, 0xb6,
+	0x15, 0xe2, 0xa0, 0xb2, 0x46, 0x8e, 0x32, 0xc8, 0x80, 0x79, 0x9c, 0x8c, 0x02, 0x21, 0xf3, 0x94,
+	0x03, 0x6d, 0x45, 0xb5, 0x51, 0x45, 0x53, 0x87, 0x8b, 0x14, 0x89, 0xf0, 0x20, 0xcc, 0x05, 0xd7,
+	0x86, 0x67, 0x8a, 0x39, 0x47, 0xeb, 0x9a, 0x79, 0xb6, 0xc8, 0x4c, 0x69, 0x3e, 0x9d, 0x73, 0x78,
+	0xa2, 0x88, 0x0e, 0xaa, 0x6a, 0x62, 0x2f, 0x43, 0x30, 0x2a, 0xe4, 0xb2, 0xbc, 0x03, 0x9b, 0x4a,
+	0xde, 0x45, 0x0f, 0xb4, 0x7c, 0x3f, 0x23, 0xe7, 0x84, 0x85, 0x77, 0xcb, 0xfa, 0x2e, 0x3c, 0x55,
+	0xfa, 0x1e, 0xda, 0xd0, 0xfa, 0x46, 0x46, 0x7f, 0x45, 0xc3, 0x90, 0xde, 0x2c, 0x03, 0xbd, 0x24,
+	0x9e, 0x3e, 0xaa, 0xe5, 0xe2, 0x29, 0x0a, 0xbf, 0x0f, 0x3f, 0x07, 0x15, 0xca, 0x5a, 0x67, 0x08,
+	0x68, 0xfd, 0xc7, 0x45, 0xdb, 0x21, 0xa9, 0x37, 0x25, 0x4b, 0xb9, 0x68, 0x08, 0x7e, 0xa6, 0x61,
+	0x07, 0xd5, 0x35, 0xfc, 0x51, 0x06, 0xf6, 0x69, 0x24, 0x39, 0xf6, 0xa5, 0x37, 0x24, 0x2c, 0xa4,
+	0x77, 0x39, 0xd6, 0x81, 0x7d, 0xcd, 0xb6, 0xd1, 0x56, 0xce, 0x40, 0x29, 0x8b, 0xd9, 0x72, 0x49,
+	0x34, 0x02, 0xbb, 0x1a, 0xed, 0xa0, 0x47, 0x1a, 0x7d, 0xbe, 0x88, 0x4e, 0x09, 0x97, 0xc5, 0xf1,
+	0x76, 0xe0, 0x99, 0x06, 0xbb, 0xe8, 0x71, 0xae, 0xfa, 0x42, 0xe2, 0x6b, 0x92, 0x23, 0xba, 0xd0,
+	0xd1, 0x44, 0x0f, 0xc1, 0x9c, 0x5b, 0xe3, 0xc8, 0x2b, 0x86, 0x7a, 0xb0, 0xad, 0xa1, 0x3e, 0xda,
+	0x2e, 0x72, 0x5e, 0xcc, 0x86, 0xcb, 0xc7, 0x42, 0xab, 0x93, 0x82, 0xd8, 0x67, 0x68, 0x67, 0xe5,
+	0x89, 0x2a, 0x46, 0xed, 0x33, 0x83, 0xb6, 0xd0, 0x93, 0x02, 0xf4, 0x6d, 0x1c, 0x70, 0xe2, 0xc9,
+	0xc0, 0xbf, 0x26, 0xb9, 0x92, 0xd8, 0x2d, 0x83, 0xda, 0x68, 0x37, 0x87, 0x6a, 0xbf, 0x0c, 0xee,
+	0x56, 0xa1, 0x36, 0xec, 0x69, 0xd4, 0x41, 0x4f, 0x35, 0x6a, 0x65, 0xd0, 0x21, 0x09, 0xc9, 0x48,
+	0x85, 0x5a, 0xb4, 0x0f, 0xb6, 0x93, 0x98, 0xce, 0x6e, 0x23, 0x94, 0x33, 0x5d, 0x1c, 0x79, 0xf7,
+	0xc3, 0x6d, 0xf8, 0x85, 0x86, 0xcf, 0xd1, 0x9e, 0x86, 0x3f, 0xc9, 0x6e, 0xa2, 0x3f, 0x26, 0xc3,
+	0x38, 0x24, 0x43, 0xaf, 0xb0, 0x95, 0x68, 0x0c, 0x7e, 0xad, 0xf1, 0x0e, 0xda, 0xd7, 0x78, 0x33,
+	0x6b, 0x1e, 0x1c, 0xf9, 0x24, 0xf4, 0xfe, 0xc5, 0x57, 0x3a, 0x89, 0xf3, 0xed, 0x2e, 0x6a, 0xe4,
+	0x9c, 0x4f, 0x84, 0xcf, 0xe9, 0xcd, 0x6a, 0xb6, 0x6b, 0x4a, 0xde, 0x43, 0xcf, 0x72, 0x25, 0x37,
+	0x2c, 0x66, 0x8c, 0xd3, 0x69, 0x7e, 0xa3, 0x7b, 0x06, 0xed, 0xa3, 0x83, 0x55, 0xe8, 0x30, 0x10,
+	0x2c, 0x2e, 0xf0, 0x88, 0xb1, 0x97, 0x73, 0x86, 0x0e, 0x57, 0xa1, 0x9c, 0x84, 0x04, 0x8b, 0x1c,
+	0xea, 0x98, 0x63, 0xee, 0xb4, 0xd0, 0x07, 0xb9, 0x64, 0x19, 0xa7, 0x8c, 0x0a, 0x1c, 0x16, 0x35,
+	0x7b, 0xcd, 0x24, 0x26, 0x71, 0x6c, 0x74, 0x94, 0x33, 0x49, 0xca, 0xe6, 0x1a, 0x93, 0x26, 0x92,
+	0x53, 0xe4, 0x38, 0xe8, 0x79, 0xee, 0x14, 0xe9, 0xce, 0x4a, 0x86, 0x41, 0xce, 0x1d, 0x8e, 0x03,
+	0x3b, 0x9a, 0x6a, 0x23, 0x2b, 0x77, 0x57, 0x68, 0x4a, 0x99, 0xab, 0x60, 0xb5, 0xf6, 0x45, 0x05,
+	0xac, 0x51, 0x66, 0xfd, 0x5d, 0x02, 0x9b, 0x99, 0x4b, 0x13, 0x5a, 0xa0, 0xce, 0xc9, 0x95, 0x37,
+	0x08, 0xa9, 0x7f, 0xed, 0x45, 0xf1, 0x44, 0x5f, 0x77, 0x75, 0x77, 0x93, 0x93, 0xab, 0x0b, 0x35,
+	0xf7, 0x5d, 0x3c, 0x81, 0xc7, 0xe0, 0xd1, 0x5c, 0xc3, 0x38, 0xb9, 0x0a, 0x6e, 0xf5, 0xe5, 0x56,
+	0x77, 0xb7, 0x66, 0xb2, 0x37, 0x7a, 0x16, 0xf6, 0x01, 0x20, 0xb7, 0x2c, 0x48, 0x56, 0x46, 0xe5,
+	0x5c, 0x87, 0x96, 0xc1, 0x84, 0x78, 0x8c, 0x06, 0x91, 0xf4, 0x04, 0xf1, 0xdd, 0x8c, 0x18, 0xb6,
+	0x01, 0x48, 0x63, 0x16, 0xa8, 0x72, 0x54, 0x3e, 0xde, 0xb4, 0x77, 0x32, 0x68, 0xfa, 0xd2, 0xcd,
+	0xe8, 0xe0, 0x0e, 0x58, 0x8f, 0x68, 0xe4, 0x13, 0x7d, 0x9f, 0x55, 0xdc, 0x64, 0x00, 0x77, 0x41,
+	0x35, 0xb1, 0xba, 0xbe, 0xb4, 0x36, 0x5c, 0x33, 0xb2, 0x7e, 0x2f, 0x01, 0x28, 0x82, 0x51, 0x34,
+	0x73, 0xbe, 0xa9, 0xc1, 0x31, 0x28, 0x4b, 0x7e, 0x6b, 0x2e, 0xfa, 0xdd, 0xe5, 0x0b, 0x22, 0x11,
+	0xb9, 0x4a, 0x02, 0xbb, 0xa0, 0xa6, 0x78, 0x2c, 0x63, 0x4e, 0xd0, 0x5a, 0x2e, 0xbd, 0xf4, 0x9d,
+	0xa7, 0x86, 0xee, 0x5c, 0xab, 0x0a, 0x93, 0x0e, 0x04, 0x2a, 0x1f, 0x95, 0xef, 0x27, 0x33, 0x62,
+	0xeb, 0xe7, 0x12, 0xd8, 0x4a, 0x2f, 0x0c, 0x32, 0x25, 0x91, 0x84, 0x27, 0x60, 0x9d, 0xde, 0x44,
+	0x84, 0x9b, 0x90, 0x9f, 0x16, 0x74, 0xd2, 0x08, 0x4f, 0x88, 0x9b, 0xa8, 0xe0, 0x3e, 0xd8, 0x98,
+	0x7d, 0x40, 0x07, 0x5d, 0x73, 0xd3, 0x31, 0x84, 0xa0, 0xa2, 0xa4, 0x7a, 0xaf, 0x6a, 0xae, 0x7e,
+	0x56, 0x73, 0x43, 0x2c, 0xb1, 0xfe, 0xaf, 0xa8, 0xb9, 0xfa, 0x19, 0x3e, 0x02, 0x65, 0x41, 0xde,
+	0x9a, 0x32, 0xab, 0x47, 0xeb, 0xb7, 0x12, 0x68, 0xa4, 0x3b, 0xe1, 0x71, 0xe2, 0x93, 0x80, 0x49,
+	0xef, 0x26, 0x90, 0x63, 0x2f, 0x88, 0xae, 0xa8, 0xda, 0x04, 0x21, 0xb1, 0x8c, 0x85, 0xb1, 0x94,
+	0x19, 0xc1, 0x06, 0xa8, 0x8d, 0xb0, 0xf0, 0x62, 0x81, 0x47, 0x49, 0x0d, 0x2b, 0xee, 0xc6, 0x08,
+	0x8b, 0x1f, 0xd4, 0x18, 0x1e, 0x00, 0x30, 0x9d, 0x78, 0x3e, 0x8d, 0x04, 0x0d, 0x67, 0x41, 0xd5,
+	0xa6, 0x93, 0x97, 0xc9, 0x04, 0x6c, 0x81, 0xaa, 0xae, 0xc0, 0xcc, 0x20, 0x7b, 0x45, 0x97, 0xaa,
+	0x56, 0xb8, 0x46, 0x68, 0xfd, 0x51, 0x02, 0x07, 0x99, 0x7d, 0x7c, 0xb7, 0x40, 0x23, 0x22, 0x17,
+	0x03, 0x8d, 0x88, 0x4c, 0x02, 0x6d, 0x80, 0x9a, 0xcf, 0x62, 0xf3, 0xb2, 0x9c, 0xbc, 0xf4, 0x59,
+	0x9c, 0x66, 0x41, 0x38, 0xa7, 0x5c, 0x7f, 0xdf, 0x94, 0xb1, 0xa6, 0x67, 0x5e, 0xab, 0x05, 0xbf,
+	0x51, 0x56, 0xf7, 0x38, 0x11, 0x71, 0x28, 0x05, 0x5a, 0xd7, 0x99, 0xbc, 0x28, 0xb2, 0x7a, 0x3e,
+	0x58, 0xb7, 0x46, 0x99, 0x9b, 0x80, 0xd6, 0xaf, 0x4b, 0x99, 0xdd, 0x70, 0xcc, 0x18, 0xe1, 0x99,
+	0xcc, 0x3a, 0xe0, 0x81, 0x08, 0x46, 0xde, 0xdc, 0xdc, 0x07, 0x4b, 0x96, 0x5b, 0x3c, 0x08, 0x6e,
+	0x55, 0x04, 0xa3, 0xef, 0xf9, 0x2d, 0xbc, 0x00, 0x0f, 0xcc, 0xca, 0xc6, 0xe4, 0xc7, 0xc5, 0x87,
+	0xa2, 0x20, 0xbe, 0x19, 0x68, 0x8d, 0xc0, 0x76, 0x81, 0xf2, 0xfd, 0x17, 0xdb, 0xfa, 0xa9, 0x04,
+	0xb6, 0x0b, 0xca, 0xf0, 0x9f, 0x93, 0xef, 0x2d, 0x27, 0x7f, 0x78, 0x7f, 0xf2, 0xf3, 0x94, 0xff,
+	0x5a, 0x03, 0x0f, 0x93, 0x26, 0x39, 0x26, 0x78, 0x48, 0x38, 0x3c, 0x01, 0x1b, 0x8c, 0x93, 0x69,
+	0x40, 0x4d, 0xba, 0x9b, 0xf6, 0xe3, 0x6c, 0x0c, 0x63, 0x6c, 0x9f, 0x77, 0xdc, 0x54, 0xa2, 0xba,
+	0x8b, 0x6a, 0x90, 0x42, 0xe2, 0x09, 0x2b, 0xe8, 0x2e, 0x4b, 0xcd, 0x73, 0xae, 0x85, 0x5f, 0x82,
+	0xad, 0x59, 0x73, 0xa6, 0xc3, 0xd8, 0x27, 0x1c, 0x95, 0xef, 0x6f, 0x0c, 0xf5, 0x41, 0xd2, 0xb4,
+	0x13, 0x35, 0x7c, 0x0d, 0x9e, 0x66, 0x13, 0x9b, 0x10, 0x7e, 0x1d, 0x12, 0x8f, 0x53, 0x2a, 0x51,
+	0x65, 0x55, 0xd8, 0x4f, 0x32, 0xc4, 0xb7, 0x1a, 0x70, 0x29, 0x95, 0xf0, 0x05, 0xf8, 0xbf, 0xca,
+	0xc7, 0xfc, 0xd9, 0x8e, 0xb1, 0x18, 0x9b, 0x9e, 0x51, 0x57, 0xd3, 0x5f, 0xa9, 0xd9, 0x57, 0x58,
+	0x8c, 0x61, 0xdf, 0xe8, 0xd4, 0xf6, 0x9b, 0xa5, 0xaa, 0xab, 0x96, 0xd2, 0xe8, 0xa5, 0x12, 0xaa,
+	0x25, 0x94, 0xef, 0xb7, 0xcd, 0xfe, 0x2d, 0x54, 0xfb, 0x14, 0x54, 0x93, 0xa7, 0x82, 0xb6, 0x98,
+	0x15, 0xba, 0x46, 0x06, 0x2f, 0x01, 0x5a, 0x2c, 0x9b, 0xf7, 0x0e, 0xcd, 0x7d, 0x77, 0xa1, 0x84,
+	0x97, 0xb3, 0x97, 0xd6, 0x2f, 0x25, 0xf0, 0x30, 0x1b, 0x1d, 0x7c, 0x09, 0xea, 0x66, 0xbc, 0x10,
+	0xdd, 0x61, 0xde, 0x8d, 0x0b, 0x41, 0x9a, 0x8f, 0xbc, 0x4a, 0x42, 0xbd, 0x00, 0x0f, 0x33, 0xf5,
+	0x16, 0x68, 0xed, 0xa8, 0xbc, 0xf4, 0x8d, 0x82, 0x23, 0xe0, 0x2e, 0x30, 0xd6, 0x14, 0x40, 0x32,
+	0x61, 0xf2, 0xce, 0x7b, 0xff, 0xe1, 0x35, 0x40, 0x4d, 0xf2, 0x5b, 0x4f, 0x3b, 0xcc, 0xfc, 0x1a,
+	0x6c, 0x48, 0x7e, 0xfb, 0x52, 0x8d, 0x2f, 0xde, 0x00, 0x2b, 0xa0, 0xba, 0x3d, 0x93, 0x48, 0x52,
+	0xd1, 0xc4, 0xd1, 0x90, 0xd3, 0x60, 0xd8, 0x14, 0xc3, 0xeb, 0xf9, 0x22, 0x3f, 0x7e, 0x3a, 0x0a,
+	0xe4, 0x38, 0x1e, 0x34, 0x7d, 0x3a, 0x39, 0xf5, 0xa9, 0xf0, 0xc7, 0x38, 0x88, 0x4e, 0x53, 0xe8,
+	0x64, 0x44, 0x4f, 0x53, 0xed, 0xa0, 0xaa, 0x1f, 0x9d, 0x7f, 0x06, 0x00, 0x74, 0xa7, 0x0d, 0xda,
+	0x09, 0x10, 0x00, 0x00,
 }
@@ -0,0 +1,7 @@
+package prototype
+
+import "github.com/coschain/contentos-go/common/encoding/kope"
+
+func (m *TrxNonceId) OpeEncode() ([]byte, error) {
+	return kope.Encode(m.Signer, m.Nonce)
+}
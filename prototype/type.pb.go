@@ -332,6 +332,155 @@ func (m *SignatureType) GetSig() []byte {
 	return nil
 }
 
+type KeyWeight struct {
+	Key                  *PublicKeyType `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Weight               uint32         `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *KeyWeight) Reset()         { *m = KeyWeight{} }
+func (m *KeyWeight) String() string { return proto.CompactTextString(m) }
+func (*KeyWeight) ProtoMessage()    {}
+func (*KeyWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1b10af7c504b1c5, []int{8}
+}
+
+func (m *KeyWeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyWeight.Unmarshal(m, b)
+}
+func (m *KeyWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyWeight.Marshal(b, m, deterministic)
+}
+func (m *KeyWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyWeight.Merge(m, src)
+}
+func (m *KeyWeight) XXX_Size() int {
+	return xxx_messageInfo_KeyWeight.Size(m)
+}
+func (m *KeyWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyWeight proto.InternalMessageInfo
+
+func (m *KeyWeight) GetKey() *PublicKeyType {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyWeight) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type Authority struct {
+	WeightThreshold      uint32       `protobuf:"varint,1,opt,name=weight_threshold,json=weightThreshold,proto3" json:"weight_threshold,omitempty"`
+	KeyAuths             []*KeyWeight `protobuf:"bytes,2,rep,name=key_auths,json=keyAuths,proto3" json:"key_auths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Authority) Reset()         { *m = Authority{} }
+func (m *Authority) String() string { return proto.CompactTextString(m) }
+func (*Authority) ProtoMessage()    {}
+func (*Authority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1b10af7c504b1c5, []int{9}
+}
+
+func (m *Authority) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Authority.Unmarshal(m, b)
+}
+func (m *Authority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Authority.Marshal(b, m, deterministic)
+}
+func (m *Authority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authority.Merge(m, src)
+}
+func (m *Authority) XXX_Size() int {
+	return xxx_messageInfo_Authority.Size(m)
+}
+func (m *Authority) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authority proto.InternalMessageInfo
+
+func (m *Authority) GetWeightThreshold() uint32 {
+	if m != nil {
+		return m.WeightThreshold
+	}
+	return 0
+}
+
+func (m *Authority) GetKeyAuths() []*KeyWeight {
+	if m != nil {
+		return m.KeyAuths
+	}
+	return nil
+}
+
+type AccountAuthorities struct {
+	Owner                *Authority `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Active               *Authority `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`
+	Posting              *Authority `protobuf:"bytes,3,opt,name=posting,proto3" json:"posting,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AccountAuthorities) Reset()         { *m = AccountAuthorities{} }
+func (m *AccountAuthorities) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthorities) ProtoMessage()    {}
+func (*AccountAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1b10af7c504b1c5, []int{10}
+}
+
+func (m *AccountAuthorities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountAuthorities.Unmarshal(m, b)
+}
+func (m *AccountAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountAuthorities.Marshal(b, m, deterministic)
+}
+func (m *AccountAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthorities.Merge(m, src)
+}
+func (m *AccountAuthorities) XXX_Size() int {
+	return xxx_messageInfo_AccountAuthorities.Size(m)
+}
+func (m *AccountAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthorities proto.InternalMessageInfo
+
+func (m *AccountAuthorities) GetOwner() *Authority {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *AccountAuthorities) GetActive() *Authority {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *AccountAuthorities) GetPosting() *Authority {
+	if m != nil {
+		return m.Posting
+	}
+	return nil
+}
+
 type Sha256 struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -343,7 +492,7 @@ func (m *Sha256) Reset()         { *m = Sha256{} }
 func (m *Sha256) String() string { return proto.CompactTextString(m) }
 func (*Sha256) ProtoMessage()    {}
 func (*Sha256) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1b10af7c504b1c5, []int{8}
+	return fileDescriptor_f1b10af7c504b1c5, []int{11}
 }
 
 func (m *Sha256) XXX_Unmarshal(b []byte) error {
@@ -385,7 +534,7 @@ func (m *GiftTicketKeyType) Reset()         { *m = GiftTicketKeyType{} }
 func (m *GiftTicketKeyType) String() string { return proto.CompactTextString(m) }
 func (*GiftTicketKeyType) ProtoMessage()    {}
 func (*GiftTicketKeyType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1b10af7c504b1c5, []int{9}
+	return fileDescriptor_f1b10af7c504b1c5, []int{12}
 }
 
 func (m *GiftTicketKeyType) XXX_Unmarshal(b []byte) error {
@@ -451,7 +600,7 @@ func (m *ChainProperties) Reset()         { *m = ChainProperties{} }
 func (m *ChainProperties) String() string { return proto.CompactTextString(m) }
 func (*ChainProperties) ProtoMessage()    {}
 func (*ChainProperties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1b10af7c504b1c5, []int{10}
+	return fileDescriptor_f1b10af7c504b1c5, []int{13}
 }
 
 func (m *ChainProperties) XXX_Unmarshal(b []byte) error {
@@ -521,6 +670,88 @@ func (m *ChainProperties) GetPerTicketWeight() uint64 {
 	return 0
 }
 
+// reward_policy selects the weighting curve of rewards and rates of rewarding pools, in 1/10000
+type RewardPolicy struct {
+	Weighting string `protobuf:"bytes,1,opt,name=weighting,proto3" json:"weighting,omitempty"`
+	// creator and dapp rates of block rewards, the rest goes to block producers
+	CreatorRate uint32 `protobuf:"varint,2,opt,name=creator_rate,json=creatorRate,proto3" json:"creator_rate,omitempty"`
+	DappRate    uint32 `protobuf:"varint,3,opt,name=dapp_rate,json=dappRate,proto3" json:"dapp_rate,omitempty"`
+	// author, reply and voter rates of creator rewards
+	AuthorRate           uint32   `protobuf:"varint,4,opt,name=author_rate,json=authorRate,proto3" json:"author_rate,omitempty"`
+	ReplyRate            uint32   `protobuf:"varint,5,opt,name=reply_rate,json=replyRate,proto3" json:"reply_rate,omitempty"`
+	VoterRate            uint32   `protobuf:"varint,6,opt,name=voter_rate,json=voterRate,proto3" json:"voter_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RewardPolicy) Reset()         { *m = RewardPolicy{} }
+func (m *RewardPolicy) String() string { return proto.CompactTextString(m) }
+func (*RewardPolicy) ProtoMessage()    {}
+func (*RewardPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1b10af7c504b1c5, []int{14}
+}
+
+func (m *RewardPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RewardPolicy.Unmarshal(m, b)
+}
+func (m *RewardPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RewardPolicy.Marshal(b, m, deterministic)
+}
+func (m *RewardPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPolicy.Merge(m, src)
+}
+func (m *RewardPolicy) XXX_Size() int {
+	return xxx_messageInfo_RewardPolicy.Size(m)
+}
+func (m *RewardPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPolicy proto.InternalMessageInfo
+
+func (m *RewardPolicy) GetWeighting() string {
+	if m != nil {
+		return m.Weighting
+	}
+	return ""
+}
+
+func (m *RewardPolicy) GetCreatorRate() uint32 {
+	if m != nil {
+		return m.CreatorRate
+	}
+	return 0
+}
+
+func (m *RewardPolicy) GetDappRate() uint32 {
+	if m != nil {
+		return m.DappRate
+	}
+	return 0
+}
+
+func (m *RewardPolicy) GetAuthorRate() uint32 {
+	if m != nil {
+		return m.AuthorRate
+	}
+	return 0
+}
+
+func (m *RewardPolicy) GetReplyRate() uint32 {
+	if m != nil {
+		return m.ReplyRate
+	}
+	return 0
+}
+
+func (m *RewardPolicy) GetVoterRate() uint32 {
+	if m != nil {
+		return m.VoterRate
+	}
+	return 0
+}
+
 type DynamicProperties struct {
 	HeadBlockId                *Sha256       `protobuf:"bytes,1,opt,name=head_block_id,json=headBlockId,proto3" json:"head_block_id,omitempty"`
 	HeadBlockNumber            uint64        `protobuf:"varint,2,opt,name=head_block_number,json=headBlockNumber,proto3" json:"head_block_number,omitempty"`
@@ -567,16 +798,21 @@ type DynamicProperties struct {
 	ChargedTicketsNum          uint64        `protobuf:"varint,43,opt,name=charged_tickets_num,json=chargedTicketsNum,proto3" json:"charged_tickets_num,omitempty"`
 	CopyrightAdmin             *AccountName  `protobuf:"bytes,44,opt,name=copyright_admin,json=copyrightAdmin,proto3" json:"copyright_admin,omitempty"`
 	TicketsBpBonus             *Vest         `protobuf:"bytes,45,opt,name=tickets_bp_bonus,json=ticketsBpBonus,proto3" json:"tickets_bp_bonus,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}      `json:"-"`
-	XXX_unrecognized           []byte        `json:"-"`
-	XXX_sizecache              int32         `json:"-"`
+	// chain properties are set by governance proposals instead of medians of bp proposed values
+	PropsGoverned bool          `protobuf:"varint,46,opt,name=props_governed,json=propsGoverned,proto3" json:"props_governed,omitempty"`
+	RewardPolicy  *RewardPolicy `protobuf:"bytes,47,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy,omitempty"`
+	// features activated by governance proposals, in order of activation
+	ActivatedFeatures    []*ActivatedFeature `protobuf:"bytes,48,rep,name=activated_features,json=activatedFeatures,proto3" json:"activated_features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DynamicProperties) Reset()         { *m = DynamicProperties{} }
 func (m *DynamicProperties) String() string { return proto.CompactTextString(m) }
 func (*DynamicProperties) ProtoMessage()    {}
 func (*DynamicProperties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1b10af7c504b1c5, []int{11}
+	return fileDescriptor_f1b10af7c504b1c5, []int{15}
 }
 
 func (m *DynamicProperties) XXX_Unmarshal(b []byte) error {
@@ -912,6 +1148,74 @@ func (m *DynamicProperties) GetTicketsBpBonus() *Vest {
 	return nil
 }
 
+func (m *DynamicProperties) GetPropsGoverned() bool {
+	if m != nil {
+		return m.PropsGoverned
+	}
+	return false
+}
+
+func (m *DynamicProperties) GetRewardPolicy() *RewardPolicy {
+	if m != nil {
+		return m.RewardPolicy
+	}
+	return nil
+}
+
+func (m *DynamicProperties) GetActivatedFeatures() []*ActivatedFeature {
+	if m != nil {
+		return m.ActivatedFeatures
+	}
+	return nil
+}
+
+type ActivatedFeature struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActivatedFeature) Reset()         { *m = ActivatedFeature{} }
+func (m *ActivatedFeature) String() string { return proto.CompactTextString(m) }
+func (*ActivatedFeature) ProtoMessage()    {}
+func (*ActivatedFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1b10af7c504b1c5, []int{16}
+}
+
+func (m *ActivatedFeature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivatedFeature.Unmarshal(m, b)
+}
+func (m *ActivatedFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivatedFeature.Marshal(b, m, deterministic)
+}
+func (m *ActivatedFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivatedFeature.Merge(m, src)
+}
+func (m *ActivatedFeature) XXX_Size() int {
+	return xxx_messageInfo_ActivatedFeature.Size(m)
+}
+func (m *ActivatedFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivatedFeature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivatedFeature proto.InternalMessageInfo
+
+func (m *ActivatedFeature) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ActivatedFeature) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type BeneficiaryRouteType struct {
 	Name                 *AccountName `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Weight               uint32       `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
@@ -924,7 +1228,7 @@ func (m *BeneficiaryRouteType) Reset()         { *m = BeneficiaryRouteType{} }
 func (m *BeneficiaryRouteType) String() string { return proto.CompactTextString(m) }
 func (*BeneficiaryRouteType) ProtoMessage()    {}
 func (*BeneficiaryRouteType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1b10af7c504b1c5, []int{12}
+	return fileDescriptor_f1b10af7c504b1c5, []int{17}
 }
 
 func (m *BeneficiaryRouteType) XXX_Unmarshal(b []byte) error {
@@ -968,98 +1272,124 @@ func init() {
 	proto.RegisterType((*PrivateKeyType)(nil), "prototype.private_key_type")
 	proto.RegisterType((*TimePointSec)(nil), "prototype.time_point_sec")
 	proto.RegisterType((*SignatureType)(nil), "prototype.signature_type")
+	proto.RegisterType((*KeyWeight)(nil), "prototype.key_weight")
+	proto.RegisterType((*Authority)(nil), "prototype.authority")
+	proto.RegisterType((*AccountAuthorities)(nil), "prototype.account_authorities")
 	proto.RegisterType((*Sha256)(nil), "prototype.sha256")
 	proto.RegisterType((*GiftTicketKeyType)(nil), "prototype.gift_ticket_key_type")
 	proto.RegisterType((*ChainProperties)(nil), "prototype.chain_properties")
+	proto.RegisterType((*RewardPolicy)(nil), "prototype.reward_policy")
 	proto.RegisterType((*DynamicProperties)(nil), "prototype.dynamic_properties")
+	proto.RegisterType((*ActivatedFeature)(nil), "prototype.activated_feature")
 	proto.RegisterType((*BeneficiaryRouteType)(nil), "prototype.beneficiary_route_type")
 }
 
 func init() { proto.RegisterFile("prototype/type.proto", fileDescriptor_f1b10af7c504b1c5) }
 
 var fileDescriptor_f1b10af7c504b1c5 = []byte{
-	// 1328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x6f, 0x6f, 0x13, 0x37,
-	0x18, 0xc0, 0xd5, 0x12, 0x4a, 0xeb, 0x34, 0xff, 0x4c, 0x28, 0x57, 0x06, 0xa3, 0x64, 0xc0, 0xa0,
-	0x40, 0xba, 0x75, 0x03, 0x09, 0x4d, 0x93, 0xd6, 0x14, 0x90, 0x78, 0x01, 0xaa, 0x8e, 0x02, 0xda,
-	0xa4, 0xc9, 0x72, 0x7c, 0x6e, 0x62, 0x35, 0x67, 0x1b, 0xdb, 0xd7, 0x36, 0xef, 0xf6, 0x55, 0xf7,
-	0x4d, 0x26, 0x3f, 0xbe, 0x4b, 0x2f, 0xcb, 0x91, 0xbd, 0xd8, 0x9b, 0xd6, 0x79, 0xfc, 0x7b, 0xfe,
-	0x9f, 0xfd, 0x18, 0x75, 0xb5, 0x51, 0x4e, 0xb9, 0xa9, 0xe6, 0x7b, 0xfe, 0x4f, 0x1f, 0x7e, 0xe2,
-	0x8d, 0x99, 0xb4, 0x77, 0x1f, 0x6d, 0x52, 0xc6, 0x54, 0x26, 0x1d, 0x91, 0x34, 0xe5, 0xb8, 0x8b,
-	0xae, 0x9e, 0xd1, 0x49, 0xc6, 0xa3, 0x95, 0x9d, 0x95, 0x47, 0x1b, 0x71, 0xf8, 0xd1, 0xdb, 0x41,
-	0xeb, 0x6c, 0x4c, 0x85, 0x24, 0x22, 0x99, 0x27, 0x1a, 0x97, 0x44, 0x8d, 0x29, 0x21, 0x71, 0x54,
-	0xde, 0xad, 0x0d, 0x56, 0x7f, 0x58, 0x29, 0x11, 0x67, 0xdc, 0xba, 0x25, 0xc4, 0x03, 0xd4, 0xd2,
-	0xd9, 0x70, 0x22, 0x18, 0x39, 0xe5, 0x53, 0xe2, 0xc3, 0xc3, 0x18, 0xd5, 0x12, 0xea, 0x28, 0xb0,
-	0x9b, 0x31, 0xac, 0x7b, 0x0f, 0x51, 0x5b, 0x1b, 0x71, 0x46, 0x1d, 0x5f, 0xce, 0xfd, 0x88, 0x9a,
-	0x4e, 0xa4, 0x9c, 0x68, 0x25, 0xa4, 0x23, 0x96, 0x33, 0x7c, 0x17, 0xd5, 0x33, 0xc7, 0xfc, 0x52,
-	0xc9, 0xc4, 0xe6, 0x09, 0xa0, 0xcc, 0xb1, 0x0f, 0x41, 0xd2, 0xeb, 0xa1, 0xa6, 0x15, 0x23, 0x49,
-	0x5d, 0x66, 0x78, 0x30, 0xdc, 0x46, 0x57, 0xac, 0x18, 0xe5, 0x76, 0xfd, 0xb2, 0x77, 0x1b, 0xad,
-	0xd9, 0x31, 0xdd, 0x7f, 0xfe, 0xc2, 0x3b, 0x1d, 0x53, 0x3b, 0x2e, 0x9c, 0xfa, 0x75, 0xef, 0x0b,
-	0xea, 0x8e, 0xc4, 0x89, 0x23, 0x4e, 0xb0, 0x53, 0xee, 0xe6, 0x02, 0xf4, 0xff, 0x73, 0x9f, 0xb5,
-	0x42, 0x76, 0x62, 0x54, 0x1a, 0xad, 0x42, 0xa9, 0x61, 0x8d, 0x9b, 0x68, 0xd5, 0xa9, 0xe8, 0x0a,
-	0x48, 0x56, 0x9d, 0xc2, 0xf7, 0xd0, 0x26, 0x33, 0xdc, 0xe7, 0x3a, 0x9c, 0x28, 0x76, 0x1a, 0xd5,
-	0x7c, 0xd1, 0xe2, 0x7a, 0x90, 0x0d, 0xbc, 0xa8, 0xf7, 0xf7, 0x2a, 0x6a, 0x87, 0xee, 0x68, 0xa3,
-	0x34, 0x37, 0x4e, 0x70, 0x8b, 0x0f, 0x50, 0xb7, 0xe8, 0x2b, 0xb0, 0x42, 0x49, 0x72, 0xc2, 0x83,
-	0xff, 0xfa, 0x7e, 0xab, 0x3f, 0xfb, 0x02, 0xfa, 0xbe, 0x6d, 0x31, 0xce, 0xe1, 0xc3, 0x9c, 0x7d,
-	0xc3, 0xb9, 0x77, 0x6d, 0x1d, 0x4d, 0x85, 0xa4, 0xe4, 0xc4, 0x70, 0x0e, 0x61, 0xd6, 0xe2, 0x7a,
-	0x2e, 0x7b, 0x63, 0x02, 0xe2, 0xb4, 0x25, 0xfc, 0x42, 0x73, 0xe6, 0x78, 0x02, 0x71, 0xd7, 0xe2,
-	0xba, 0xd3, 0xf6, 0x75, 0x2e, 0xc2, 0x2f, 0x50, 0xe4, 0x94, 0x26, 0x92, 0x50, 0xf6, 0x25, 0x13,
-	0x86, 0x83, 0x2d, 0xe2, 0xd4, 0x29, 0x97, 0x90, 0x4c, 0x23, 0xee, 0x3a, 0xa5, 0xdf, 0x1f, 0x84,
-	0x5d, 0x6f, 0xf5, 0xd8, 0xef, 0xe1, 0x07, 0xa8, 0xc9, 0xb5, 0x62, 0x63, 0x92, 0x64, 0x06, 0x42,
-	0x8a, 0xae, 0x82, 0xf1, 0x06, 0x48, 0x5f, 0xe5, 0x42, 0xfc, 0x12, 0xb5, 0x35, 0x37, 0x45, 0xb9,
-	0xb5, 0x11, 0x8c, 0x47, 0x6b, 0xd5, 0x39, 0x36, 0x35, 0x37, 0xc7, 0xc0, 0x1d, 0x79, 0x0c, 0xef,
-	0xa2, 0x4e, 0x49, 0xf5, 0x9c, 0x8b, 0xd1, 0xd8, 0x45, 0xd7, 0xc0, 0x49, 0x6b, 0x86, 0x7e, 0x06,
-	0x71, 0xef, 0x2f, 0x8c, 0x70, 0x32, 0x95, 0x34, 0x15, 0xac, 0x5c, 0xe5, 0xe7, 0xa8, 0x31, 0xe6,
-	0x34, 0x09, 0xbd, 0x21, 0x22, 0xc9, 0xcb, 0xdb, 0x29, 0xb9, 0x0e, 0xdf, 0x4a, 0x5c, 0xf7, 0x1c,
-	0xf4, 0xeb, 0x6d, 0xe2, 0x3d, 0x97, 0xd4, 0x64, 0x96, 0x0e, 0xb9, 0xc9, 0xcb, 0xdb, 0x9a, 0x71,
-	0xef, 0x41, 0x8c, 0x9f, 0xa2, 0x0d, 0xa7, 0x1c, 0x9d, 0x10, 0xa6, 0x2c, 0xd4, 0xb7, 0x22, 0xb3,
-	0x75, 0x20, 0x0e, 0x95, 0xc5, 0xcf, 0x50, 0xcd, 0x7f, 0xf3, 0x50, 0xd9, 0xfa, 0xfe, 0x76, 0x09,
-	0x9c, 0x3f, 0x0a, 0x31, 0x60, 0xf8, 0x1d, 0xda, 0x62, 0x99, 0x31, 0x5c, 0xba, 0x3c, 0x16, 0x6d,
-	0x54, 0x92, 0x31, 0x6e, 0xa0, 0xd8, 0xf5, 0xfd, 0x9b, 0x25, 0x03, 0xe5, 0x6b, 0x22, 0xee, 0xe6,
-	0x6a, 0x10, 0xe9, 0x51, 0xae, 0xe4, 0x0f, 0x8b, 0xd3, 0x16, 0xea, 0xdf, 0x88, 0xfd, 0x12, 0xf7,
-	0x11, 0x0a, 0xd1, 0xfb, 0xa3, 0x0f, 0xc5, 0x9d, 0x0f, 0xdf, 0x8b, 0xe3, 0x90, 0xe0, 0x27, 0x7f,
-	0x39, 0xf4, 0x50, 0x23, 0xf0, 0xce, 0x5c, 0x10, 0x26, 0x5d, 0xb4, 0x9e, 0x7f, 0x51, 0x5e, 0x78,
-	0x6c, 0x2e, 0x0e, 0xa5, 0xc3, 0xf7, 0x51, 0x33, 0x30, 0x5a, 0x59, 0x07, 0xd0, 0x06, 0x40, 0x9b,
-	0x20, 0x3d, 0x52, 0xd6, 0xcd, 0x51, 0x99, 0xe5, 0x06, 0x28, 0x54, 0xa2, 0x3e, 0x5a, 0x6e, 0x3c,
-	0x75, 0x13, 0x5d, 0x4b, 0xe9, 0x05, 0xf1, 0x51, 0xd7, 0x21, 0xea, 0xb5, 0x94, 0x5e, 0x1c, 0x6b,
-	0x5f, 0xc8, 0x4e, 0xbe, 0x71, 0xd9, 0xa5, 0x68, 0x73, 0x76, 0x63, 0x35, 0x03, 0x56, 0x34, 0x0a,
-	0x6f, 0xa3, 0x75, 0xe1, 0xc6, 0x64, 0xca, 0xa9, 0x89, 0x1a, 0x60, 0xe8, 0x9a, 0x70, 0xe3, 0xdf,
-	0x39, 0x35, 0xf8, 0x67, 0xd4, 0xa0, 0x52, 0x66, 0x74, 0x42, 0x86, 0x59, 0x32, 0xe2, 0x2e, 0x6a,
-	0x56, 0x57, 0x61, 0x33, 0x50, 0x03, 0x80, 0x4a, 0x5a, 0xa9, 0x90, 0xfe, 0x68, 0xb5, 0x96, 0x6a,
-	0xbd, 0x03, 0x08, 0xff, 0x82, 0x3a, 0x5a, 0xa9, 0xbc, 0x32, 0x86, 0x9f, 0x53, 0x93, 0xd8, 0xa8,
-	0x5d, 0xad, 0xd9, 0xf2, 0xa4, 0xaf, 0x56, 0x1c, 0x38, 0xfc, 0x2b, 0xc2, 0xa0, 0x6c, 0xb8, 0x9e,
-	0x4c, 0x67, 0xda, 0x9d, 0x6a, 0xed, 0xb6, 0x47, 0x63, 0x4f, 0x16, 0xea, 0x85, 0xef, 0x33, 0xe5,
-	0xf8, 0x4c, 0x1b, 0x2f, 0xf1, 0xfd, 0x49, 0x39, 0xfe, 0x6f, 0xe5, 0x84, 0x6a, 0x3d, 0x53, 0xbe,
-	0xbe, 0x44, 0xf9, 0x15, 0xd5, 0xba, 0x50, 0xde, 0x45, 0x9d, 0x70, 0x7a, 0x79, 0x42, 0xce, 0xb4,
-	0x85, 0xec, 0xa3, 0x2e, 0x5c, 0xa1, 0xad, 0x62, 0xe3, 0x93, 0xb6, 0x3e, 0x57, 0xfc, 0x14, 0xe1,
-	0x39, 0x16, 0x92, 0x8d, 0x6e, 0x00, 0xdc, 0x2e, 0xc1, 0x90, 0xda, 0x82, 0x65, 0x9f, 0x5b, 0xb4,
-	0xb5, 0x60, 0xd9, 0x67, 0xb2, 0xc0, 0xfa, 0x54, 0xa2, 0x9b, 0x0b, 0xac, 0x0f, 0xdc, 0xdf, 0xce,
-	0x6c, 0x42, 0x45, 0xca, 0x93, 0xf9, 0x56, 0x45, 0xd5, 0x19, 0xe3, 0x1c, 0x2e, 0x77, 0xeb, 0x10,
-	0xdd, 0x28, 0x4c, 0xcc, 0x37, 0x6c, 0xbb, 0xda, 0xc6, 0xf5, 0x9c, 0x9e, 0xeb, 0x59, 0x29, 0x8e,
-	0xb9, 0xb6, 0xdd, 0x5a, 0x1e, 0x47, 0xb9, 0x73, 0x25, 0x13, 0x73, 0xcd, 0xfb, 0x66, 0xb9, 0x89,
-	0x72, 0xff, 0xfa, 0x08, 0x59, 0x47, 0x4f, 0x79, 0xb8, 0x24, 0x6e, 0x7f, 0xe5, 0x92, 0x00, 0x04,
-	0x2e, 0x89, 0x03, 0x74, 0x67, 0xfe, 0xb6, 0x22, 0x43, 0xa5, 0x1c, 0x61, 0x2a, 0xd5, 0x13, 0xee,
-	0xcf, 0xca, 0x9d, 0x9d, 0x95, 0x47, 0xeb, 0xf1, 0xad, 0x61, 0xf9, 0x72, 0x1a, 0x28, 0xe5, 0x0e,
-	0x0b, 0x62, 0x61, 0xb6, 0x7d, 0xfb, 0xdf, 0xb3, 0xed, 0xee, 0xe2, 0x6c, 0xdb, 0x43, 0x5d, 0x7a,
-	0x36, 0x82, 0x4b, 0x22, 0xd3, 0xc9, 0xe5, 0x90, 0xde, 0x01, 0xb4, 0x43, 0xcf, 0x46, 0xc7, 0xda,
-	0x7e, 0x84, 0x1d, 0xb8, 0x29, 0xf0, 0x63, 0xd4, 0x29, 0x14, 0x84, 0x24, 0xe7, 0x42, 0x26, 0xea,
-	0x3c, 0xba, 0x07, 0x74, 0x33, 0xd0, 0x6f, 0xe5, 0x67, 0x90, 0xe2, 0x87, 0xa8, 0xa5, 0x24, 0x27,
-	0x09, 0x9d, 0x92, 0x3c, 0xaa, 0xa8, 0x17, 0x06, 0xa0, 0x92, 0xfc, 0x15, 0x9d, 0x7e, 0x08, 0x42,
-	0x7f, 0x6a, 0xe7, 0x06, 0x3d, 0x87, 0x31, 0xff, 0x5d, 0xf5, 0xa0, 0x68, 0x97, 0xc7, 0x3c, 0xf7,
-	0x43, 0x7e, 0x80, 0xda, 0x86, 0xeb, 0xcc, 0x85, 0x17, 0x02, 0x4d, 0x52, 0x21, 0xa3, 0xfb, 0xcb,
-	0xef, 0xfe, 0xd6, 0xa5, 0xc2, 0x81, 0xe7, 0xf1, 0x4b, 0xb4, 0x5d, 0x4c, 0x91, 0x30, 0xb2, 0xad,
-	0xa3, 0x26, 0x9f, 0x28, 0xd1, 0x03, 0x08, 0xba, 0x18, 0x33, 0xaf, 0xfd, 0xfe, 0x07, 0xbf, 0x1d,
-	0x0a, 0xb2, 0x38, 0xe5, 0x1f, 0x56, 0x4d, 0xf9, 0x65, 0x8f, 0x88, 0xef, 0x97, 0x3c, 0x22, 0xaa,
-	0x5e, 0x07, 0x8f, 0xfe, 0xc7, 0xeb, 0xe0, 0x71, 0xe5, 0xeb, 0x00, 0xbf, 0xf0, 0x2f, 0x4d, 0xff,
-	0xdb, 0xb7, 0x95, 0xa9, 0x94, 0x47, 0xbb, 0xd5, 0x1f, 0x71, 0x23, 0xc7, 0xde, 0x02, 0x85, 0xfb,
-	0xe8, 0x3a, 0x1b, 0x53, 0x33, 0xe2, 0x09, 0x29, 0xf4, 0xfd, 0x98, 0x79, 0x12, 0x3e, 0x9f, 0x7c,
-	0x2b, 0x78, 0xb2, 0x7e, 0xca, 0xfc, 0x86, 0x5a, 0x4c, 0xe9, 0xa9, 0xf1, 0x4e, 0xf3, 0x5e, 0x3d,
-	0x5d, 0xde, 0xab, 0xe6, 0x8c, 0x2f, 0x5a, 0xd5, 0x2e, 0x3c, 0x0d, 0x35, 0x19, 0x2a, 0x99, 0xd9,
-	0xe8, 0x59, 0x75, 0xac, 0x45, 0x4a, 0x03, 0x3d, 0xf0, 0x58, 0xef, 0x4f, 0xb4, 0x35, 0xe4, 0x92,
-	0x9f, 0x08, 0x26, 0xa8, 0x99, 0x12, 0xa3, 0x32, 0x97, 0xbf, 0x91, 0x9f, 0xa0, 0x9a, 0x77, 0x96,
-	0x3f, 0x7e, 0xbe, 0x1a, 0x0b, 0x40, 0x78, 0x0b, 0xad, 0xe5, 0xc5, 0x5c, 0x0d, 0x03, 0x37, 0xfc,
-	0x1a, 0x1c, 0xa1, 0x9e, 0x50, 0x7d, 0xa6, 0xa4, 0xe3, 0xd2, 0x29, 0xdb, 0xa7, 0x32, 0x31, 0x4a,
-	0x24, 0x7d, 0x9b, 0x9c, 0x5e, 0x1a, 0xfc, 0x63, 0x77, 0x24, 0xdc, 0x38, 0x1b, 0xf6, 0x99, 0x4a,
-	0xf7, 0x98, 0xb2, 0xf0, 0xec, 0xdd, 0x9b, 0x29, 0x3d, 0x1b, 0xa9, 0xbd, 0x19, 0x3b, 0x5c, 0x83,
-	0xe5, 0x4f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x56, 0x16, 0x39, 0x58, 0x04, 0x0d, 0x00, 0x00,
+	// 1649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xef, 0x6e, 0x1b, 0xb9,
+	0x11, 0x87, 0x6d, 0xc5, 0xb6, 0x46, 0xd6, 0x3f, 0x5a, 0x97, 0x6c, 0x72, 0x49, 0xe3, 0xdb, 0x26,
+	0x69, 0xe2, 0x73, 0xe4, 0xab, 0xdb, 0x0b, 0x70, 0x38, 0x1c, 0x50, 0xdb, 0xb9, 0x14, 0x41, 0x71,
+	0x07, 0x63, 0xe3, 0xcb, 0xa1, 0x05, 0x0a, 0x82, 0xda, 0xa5, 0x25, 0xd6, 0xd2, 0x92, 0x47, 0x72,
+	0x65, 0xeb, 0x69, 0xfa, 0x10, 0x7d, 0x8d, 0xbe, 0x44, 0xdf, 0xa4, 0xe0, 0x90, 0x2b, 0xaf, 0x22,
+	0x9d, 0xfa, 0xa1, 0x5f, 0xa4, 0xe5, 0xcc, 0x6f, 0x86, 0xf3, 0x8f, 0xc3, 0x21, 0xf4, 0x94, 0x96,
+	0x56, 0xda, 0x99, 0xe2, 0xc7, 0xee, 0xa7, 0x8f, 0x4b, 0x52, 0x9f, 0x53, 0xe3, 0x67, 0xb0, 0xc7,
+	0xd2, 0x54, 0x16, 0xb9, 0xa5, 0x39, 0x9b, 0x70, 0xd2, 0x83, 0x7b, 0x53, 0x36, 0x2e, 0x78, 0xb4,
+	0x71, 0xb0, 0xf1, 0xb2, 0x9e, 0xf8, 0x45, 0x7c, 0x00, 0xbb, 0xe9, 0x88, 0x89, 0x9c, 0x8a, 0x6c,
+	0x11, 0xd1, 0xbc, 0x43, 0xd4, 0x52, 0x29, 0x72, 0x12, 0x55, 0xb9, 0xb5, 0xb3, 0xcd, 0xaf, 0x36,
+	0x2a, 0x88, 0x29, 0x37, 0x76, 0x0d, 0xe2, 0x39, 0xb4, 0x55, 0x31, 0x18, 0x8b, 0x94, 0x5e, 0xf3,
+	0x19, 0x75, 0xe6, 0x11, 0x02, 0xb5, 0x8c, 0x59, 0x86, 0xd8, 0xbd, 0x04, 0xbf, 0xe3, 0x17, 0xd0,
+	0x51, 0x5a, 0x4c, 0x99, 0xe5, 0xeb, 0x71, 0xbf, 0x87, 0x96, 0x15, 0x13, 0x4e, 0x95, 0x14, 0xb9,
+	0xa5, 0x86, 0xa7, 0xe4, 0x29, 0x34, 0x0a, 0x9b, 0xba, 0x4f, 0x99, 0x67, 0x26, 0x38, 0x00, 0x85,
+	0x4d, 0x3f, 0x78, 0x4a, 0x1c, 0x43, 0xcb, 0x88, 0x61, 0xce, 0x6c, 0xa1, 0xb9, 0x57, 0xdc, 0x81,
+	0x2d, 0x23, 0x86, 0x41, 0xaf, 0xfb, 0x8c, 0x13, 0x00, 0xb7, 0xed, 0x0d, 0x17, 0xc3, 0x91, 0x25,
+	0x47, 0xb0, 0x75, 0xcd, 0x67, 0xc8, 0x6f, 0x9c, 0x3c, 0xea, 0xcf, 0x03, 0xdb, 0xff, 0xc4, 0x93,
+	0xc4, 0xc1, 0xc8, 0x7d, 0xd8, 0xf6, 0x72, 0xd1, 0x26, 0xee, 0x1d, 0x56, 0xf1, 0x3f, 0xa0, 0xce,
+	0x0a, 0x3b, 0x92, 0x5a, 0xd8, 0x19, 0x79, 0x05, 0x1d, 0x4f, 0xa6, 0x76, 0xa4, 0xb9, 0x19, 0xc9,
+	0x71, 0x16, 0x4c, 0x6d, 0x7b, 0xfa, 0x65, 0x49, 0x26, 0x27, 0x50, 0x77, 0x1b, 0x38, 0x59, 0x13,
+	0x6d, 0x1e, 0x6c, 0xbd, 0x6c, 0x9c, 0x7c, 0x56, 0xb1, 0xe1, 0xce, 0xce, 0x64, 0xf7, 0x9a, 0xcf,
+	0x4e, 0x1d, 0x2c, 0xfe, 0xe7, 0x06, 0xec, 0x97, 0x29, 0x2f, 0x37, 0x15, 0xdc, 0x90, 0x43, 0xb8,
+	0x27, 0x6f, 0x72, 0xae, 0x83, 0x2f, 0xbd, 0x8a, 0x9e, 0xb9, 0x6d, 0x89, 0x87, 0x90, 0x23, 0xd8,
+	0x66, 0xa9, 0x15, 0x53, 0x1e, 0x6d, 0xae, 0x01, 0x07, 0x0c, 0xe9, 0xc3, 0x8e, 0x92, 0xc6, 0x8a,
+	0x7c, 0x18, 0x6d, 0xad, 0x81, 0x97, 0xa0, 0xf8, 0x31, 0x6c, 0x9b, 0x11, 0x3b, 0xf9, 0xfa, 0x8d,
+	0x4b, 0xeb, 0x88, 0x99, 0x51, 0x99, 0x56, 0xf7, 0x1d, 0xff, 0x02, 0xbd, 0xa1, 0xb8, 0xb2, 0xd4,
+	0x8a, 0xf4, 0x9a, 0xdb, 0x85, 0x12, 0x70, 0xff, 0x21, 0x54, 0xb5, 0x92, 0x76, 0xa5, 0xe5, 0x04,
+	0xad, 0xac, 0x27, 0xf8, 0x4d, 0x5a, 0xb0, 0x69, 0x25, 0x1a, 0x52, 0x4f, 0x36, 0xad, 0x24, 0x5f,
+	0xc0, 0x5e, 0xaa, 0xb9, 0xab, 0xa6, 0xc1, 0x58, 0xa6, 0xd7, 0x51, 0xcd, 0x95, 0x65, 0xd2, 0xf0,
+	0xb4, 0x33, 0x47, 0x8a, 0xff, 0xb3, 0x09, 0x1d, 0x5f, 0xff, 0x4a, 0x4b, 0xc5, 0x35, 0xc6, 0xeb,
+	0x14, 0x7a, 0x65, 0x18, 0x11, 0x2b, 0x64, 0x4e, 0xaf, 0x38, 0x0f, 0xe1, 0x6b, 0x57, 0x5c, 0x74,
+	0x07, 0x23, 0x21, 0x01, 0x7c, 0x1e, 0xb0, 0xef, 0x38, 0x77, 0x5b, 0x1b, 0xcb, 0x26, 0x22, 0x67,
+	0xf4, 0x4a, 0x73, 0x1f, 0xcc, 0x5a, 0xd2, 0x08, 0xb4, 0x77, 0xda, 0x43, 0xac, 0x32, 0x94, 0xdf,
+	0x2a, 0x9e, 0x5a, 0x9e, 0xa1, 0xdd, 0xb5, 0xa4, 0x61, 0x95, 0xf9, 0x3e, 0x90, 0xc8, 0x1b, 0x88,
+	0xac, 0x54, 0x34, 0xa7, 0x2c, 0xfd, 0xa5, 0x10, 0x9a, 0xa3, 0x2e, 0x6a, 0xe5, 0x35, 0xcf, 0xd1,
+	0x99, 0x66, 0xd2, 0xb3, 0x52, 0xfd, 0x78, 0xea, 0xb9, 0x4e, 0xeb, 0xa5, 0xe3, 0x91, 0xe7, 0xd0,
+	0xe2, 0x4a, 0xa6, 0x23, 0x9a, 0x15, 0x1a, 0x4d, 0x8a, 0xee, 0xa1, 0xf2, 0x26, 0x52, 0xdf, 0x06,
+	0x22, 0xf9, 0x06, 0x3a, 0x8a, 0xeb, 0x32, 0xdc, 0x4a, 0x8b, 0x94, 0x47, 0xdb, 0xab, 0x7d, 0x6c,
+	0x29, 0xae, 0x2f, 0x11, 0x77, 0xe1, 0x60, 0xe4, 0x10, 0xba, 0x15, 0xd1, 0x50, 0xf9, 0x3b, 0xb8,
+	0x49, 0x7b, 0x0e, 0xfd, 0xd9, 0x1f, 0x81, 0x7f, 0x6f, 0x40, 0x53, 0xf3, 0x1b, 0xa6, 0x33, 0xaa,
+	0xe4, 0x58, 0xa4, 0x33, 0xf2, 0x18, 0xea, 0x5e, 0xc4, 0x15, 0x8e, 0x6f, 0x47, 0x77, 0x84, 0x79,
+	0xda, 0xa4, 0xa6, 0x9a, 0x59, 0x1e, 0x0e, 0x54, 0x23, 0xd0, 0x12, 0x66, 0x39, 0xf9, 0x1c, 0xea,
+	0x19, 0x53, 0xca, 0xf3, 0xb7, 0x90, 0xbf, 0xeb, 0x08, 0xc8, 0x7c, 0x0a, 0x0d, 0x5f, 0x7a, 0x9e,
+	0xed, 0x03, 0x05, 0x9e, 0x84, 0x80, 0x27, 0x00, 0x9a, 0xab, 0xf1, 0xcc, 0xf3, 0xef, 0x21, 0xbf,
+	0x8e, 0x94, 0x92, 0x3d, 0x95, 0x96, 0x07, 0xf1, 0x6d, 0xcf, 0x46, 0x8a, 0x63, 0xc7, 0xff, 0xda,
+	0x07, 0x92, 0xcd, 0x72, 0x36, 0x11, 0x69, 0xb5, 0x68, 0xbe, 0x86, 0xe6, 0x88, 0xb3, 0xcc, 0x97,
+	0x1a, 0x15, 0x59, 0xa8, 0x96, 0x6e, 0x25, 0x92, 0xbe, 0xf4, 0x93, 0x86, 0xc3, 0x61, 0xf9, 0xbd,
+	0xcf, 0x5c, 0x20, 0x2b, 0x62, 0x79, 0x31, 0x19, 0x70, 0x1d, 0xaa, 0xa5, 0x3d, 0xc7, 0xfd, 0x88,
+	0x64, 0x72, 0x04, 0x75, 0x2b, 0x2d, 0x1b, 0xd3, 0x54, 0x9a, 0x68, 0x6b, 0x75, 0xa2, 0x76, 0x11,
+	0x71, 0x2e, 0x0d, 0x79, 0x0d, 0x35, 0xd7, 0x24, 0xd1, 0xff, 0xc6, 0xc9, 0xc3, 0x0a, 0x70, 0xb1,
+	0x77, 0x26, 0x08, 0x23, 0x3f, 0xc0, 0xfd, 0xb4, 0xd0, 0x9a, 0xe7, 0x36, 0xd8, 0xa2, 0xb4, 0xcc,
+	0x8a, 0x94, 0x6b, 0x0c, 0x50, 0xe3, 0xe4, 0x41, 0xf5, 0x64, 0x57, 0xee, 0x95, 0xa4, 0x17, 0xc4,
+	0xd0, 0xd2, 0x8b, 0x20, 0xe4, 0xba, 0xab, 0x55, 0x26, 0x44, 0xcf, 0x7d, 0x92, 0x3e, 0x80, 0xb7,
+	0xde, 0xdd, 0x15, 0xd1, 0xce, 0x92, 0xf9, 0x8e, 0x9c, 0x78, 0x07, 0x3f, 0xba, 0xdb, 0x24, 0x86,
+	0xa6, 0xc7, 0x5b, 0x7d, 0x4b, 0xd3, 0xdc, 0x46, 0xbb, 0xe1, 0x80, 0x38, 0xe2, 0xa5, 0xbe, 0x3d,
+	0xcf, 0x2d, 0x79, 0x06, 0x2d, 0x8f, 0x71, 0x0d, 0x06, 0x41, 0x75, 0x04, 0xed, 0x21, 0xf5, 0x42,
+	0x1a, 0xbb, 0x80, 0x2a, 0x0c, 0xd7, 0x88, 0x82, 0x0a, 0xea, 0x27, 0xc3, 0xb5, 0x43, 0x3d, 0x80,
+	0x9d, 0x09, 0xbb, 0xa5, 0xce, 0xea, 0x86, 0x6f, 0xe1, 0x13, 0x76, 0x7b, 0xa9, 0x5c, 0x20, 0xbb,
+	0x81, 0x71, 0x97, 0xa5, 0x68, 0x6f, 0x7e, 0xc5, 0xb5, 0x3c, 0xac, 0x4c, 0x14, 0x79, 0x08, 0xbb,
+	0xc2, 0x8e, 0xe8, 0x8c, 0x33, 0x1d, 0x35, 0x51, 0xd1, 0x8e, 0xb0, 0xa3, 0xbf, 0x72, 0xa6, 0xc9,
+	0x1f, 0xa1, 0xc9, 0xf2, 0xbc, 0x60, 0x63, 0x3a, 0x28, 0xb2, 0x21, 0xb7, 0x51, 0x6b, 0x75, 0x14,
+	0xf6, 0x3c, 0xea, 0x0c, 0x41, 0x15, 0xa9, 0x89, 0xc8, 0x5d, 0xa7, 0x68, 0xaf, 0x95, 0xfa, 0x01,
+	0x41, 0xe4, 0x5b, 0xe8, 0x2a, 0x29, 0x43, 0x64, 0xfc, 0xf1, 0x33, 0x51, 0x67, 0xb5, 0x64, 0xdb,
+	0x21, 0x5d, 0xb4, 0x12, 0x8f, 0x23, 0xdf, 0x01, 0x41, 0xe1, 0x70, 0x4c, 0x82, 0x74, 0x77, 0xb5,
+	0x74, 0xc7, 0x41, 0x13, 0x3c, 0x3e, 0x41, 0xbc, 0xdc, 0xdb, 0x1d, 0x9a, 0xb9, 0x34, 0x59, 0xb3,
+	0xf7, 0x47, 0x69, 0xf9, 0xa7, 0xc2, 0xfe, 0x80, 0x07, 0xe1, 0xfd, 0x35, 0xc2, 0x6f, 0xdd, 0xc1,
+	0x0f, 0xc2, 0x87, 0xd0, 0xf5, 0x8d, 0x84, 0x67, 0x74, 0xaa, 0x0c, 0x7a, 0x1f, 0xf5, 0xb0, 0xc3,
+	0xb4, 0x4b, 0xc6, 0x47, 0x65, 0x9c, 0xaf, 0xe4, 0x08, 0xc8, 0x02, 0x16, 0x9d, 0x8d, 0x3e, 0x43,
+	0x70, 0xa7, 0x02, 0x46, 0xd7, 0x96, 0x34, 0x3b, 0xdf, 0xa2, 0xfb, 0x4b, 0x9a, 0x9d, 0x27, 0x4b,
+	0x58, 0xe7, 0x4a, 0xf4, 0x60, 0x09, 0xeb, 0x0c, 0x77, 0x97, 0x4d, 0x3a, 0x66, 0x62, 0xc2, 0xb3,
+	0xc5, 0x54, 0x45, 0xab, 0x3d, 0x26, 0x01, 0x5c, 0xcd, 0xd6, 0x39, 0x7c, 0x56, 0xaa, 0x58, 0x4c,
+	0xd8, 0xc3, 0xd5, 0x3a, 0xf6, 0x03, 0x7a, 0x21, 0x67, 0x15, 0x3b, 0x16, 0xd2, 0xf6, 0x68, 0xbd,
+	0x1d, 0xd5, 0xcc, 0x55, 0x54, 0x2c, 0x24, 0xef, 0xf3, 0xf5, 0x2a, 0xaa, 0xf9, 0xeb, 0x03, 0x18,
+	0xcb, 0xae, 0xb9, 0x6f, 0x12, 0x8f, 0x7f, 0xa5, 0x49, 0x20, 0x04, 0x9b, 0xc4, 0x29, 0x3c, 0x59,
+	0xec, 0x56, 0x74, 0x20, 0xa5, 0xa5, 0xa9, 0x9c, 0xa8, 0x31, 0x77, 0x67, 0xe5, 0xc9, 0xc1, 0xc6,
+	0xcb, 0xdd, 0xe4, 0xd1, 0xa0, 0xda, 0x9c, 0xce, 0xa4, 0xb4, 0xe7, 0x25, 0x62, 0xe9, 0xaa, 0xfe,
+	0xcd, 0xff, 0xbe, 0xaa, 0x9f, 0x2e, 0x5f, 0xd5, 0xc7, 0xd0, 0x63, 0xd3, 0x21, 0x36, 0x89, 0x42,
+	0x65, 0x77, 0x33, 0xc7, 0x01, 0x42, 0xbb, 0x6c, 0x3a, 0xbc, 0x54, 0xe6, 0x27, 0xe4, 0x60, 0xa7,
+	0x20, 0xaf, 0xa0, 0x5b, 0x0a, 0x88, 0x9c, 0xde, 0x88, 0x3c, 0x93, 0x37, 0xd1, 0x17, 0x88, 0x6e,
+	0x79, 0xf4, 0xfb, 0xfc, 0x67, 0xa4, 0x92, 0x17, 0xd0, 0x96, 0x39, 0xa7, 0x19, 0x9b, 0xd1, 0x60,
+	0x55, 0x14, 0xfb, 0xfb, 0x5c, 0xe6, 0xfc, 0x2d, 0x9b, 0x7d, 0xf0, 0x44, 0x77, 0x6a, 0x17, 0xe6,
+	0x16, 0x8e, 0x53, 0xcb, 0x6f, 0x57, 0x5f, 0x14, 0x9d, 0xea, 0xd4, 0xc2, 0xdd, 0xcc, 0x72, 0x06,
+	0x1d, 0xcd, 0x55, 0x61, 0xfd, 0xc0, 0xc3, 0xb2, 0x89, 0xc8, 0xa3, 0x67, 0xeb, 0x7b, 0x7f, 0xfb,
+	0x4e, 0xe0, 0xd4, 0xe1, 0xc9, 0x37, 0xf0, 0xb0, 0xbc, 0x45, 0xfc, 0x04, 0x62, 0x2c, 0xd3, 0xe1,
+	0x46, 0x89, 0x9e, 0xa3, 0xd1, 0xe5, 0x35, 0xf3, 0xbd, 0xe3, 0x7f, 0x70, 0x6c, 0x1f, 0x90, 0xe5,
+	0xa1, 0xe5, 0xc5, 0xaa, 0xa1, 0x65, 0xdd, 0x4c, 0xf4, 0xbb, 0x35, 0x33, 0xd1, 0xaa, 0x61, 0xe7,
+	0xe5, 0xff, 0x31, 0xec, 0xbc, 0x5a, 0x39, 0xec, 0x90, 0x37, 0xee, 0x69, 0xe2, 0xd6, 0x2e, 0xad,
+	0xa9, 0x9c, 0xf0, 0xe8, 0x70, 0x75, 0x11, 0x37, 0x03, 0xec, 0x3d, 0xa2, 0x48, 0x1f, 0xf6, 0xd3,
+	0x11, 0xd3, 0x43, 0x9e, 0xd1, 0x52, 0xde, 0x5d, 0x33, 0x5f, 0xfa, 0xf2, 0x09, 0x2c, 0xbf, 0x93,
+	0x71, 0xb7, 0xcc, 0x9f, 0xa0, 0x9d, 0x4a, 0x35, 0xd3, 0x6e, 0xd3, 0x90, 0xab, 0xa3, 0xf5, 0xb9,
+	0x6a, 0xcd, 0xf1, 0x65, 0xaa, 0x3a, 0xe5, 0x4e, 0x03, 0x45, 0x07, 0x32, 0x2f, 0x4c, 0xf4, 0x7a,
+	0xb5, 0xad, 0xa5, 0x4b, 0x67, 0xea, 0xcc, 0xc1, 0x5c, 0xaa, 0x94, 0x96, 0xca, 0xd0, 0xa1, 0x9c,
+	0x72, 0x9d, 0xf3, 0x2c, 0xea, 0xe3, 0x31, 0x6b, 0x22, 0xf5, 0xcf, 0x81, 0x48, 0xbe, 0xfb, 0x64,
+	0xee, 0x8b, 0x8e, 0x51, 0x7d, 0x54, 0x51, 0xbf, 0xc0, 0x4f, 0xf6, 0xfc, 0xf2, 0x02, 0x57, 0xe4,
+	0x2f, 0xae, 0x9c, 0x2d, 0x3e, 0x07, 0x33, 0x7a, 0xc5, 0xf1, 0xe9, 0x66, 0xa2, 0xaf, 0xf0, 0x2d,
+	0xf4, 0x78, 0xc1, 0xcb, 0x4f, 0x40, 0x49, 0x77, 0x4e, 0x7a, 0x17, 0xc4, 0xe2, 0x6f, 0xa1, 0xbb,
+	0x84, 0x73, 0x0f, 0x86, 0x30, 0xa8, 0xd5, 0x93, 0x4d, 0x91, 0xb9, 0x47, 0xdc, 0xe8, 0xee, 0x11,
+	0x57, 0x4b, 0xc2, 0x2a, 0xfe, 0x3b, 0xdc, 0x1f, 0xf0, 0x9c, 0x5f, 0x89, 0x54, 0x30, 0x3d, 0xa3,
+	0x5a, 0x16, 0x36, 0x3c, 0x22, 0xbf, 0x84, 0x9a, 0x0b, 0x6e, 0xb4, 0xb1, 0x3e, 0xf6, 0x08, 0xfa,
+	0xb5, 0x37, 0xe2, 0xd9, 0x05, 0xc4, 0x42, 0xf6, 0x53, 0x99, 0x5b, 0x9e, 0x5b, 0x69, 0xfa, 0x2c,
+	0xcf, 0xb4, 0x14, 0x59, 0xdf, 0x64, 0xd7, 0x77, 0x0a, 0xff, 0x76, 0x38, 0x14, 0x76, 0x54, 0x0c,
+	0xfa, 0xa9, 0x9c, 0x1c, 0xa7, 0xd2, 0xe0, 0xab, 0xe5, 0x78, 0x2e, 0xf4, 0x7a, 0x28, 0x8f, 0xe7,
+	0xd8, 0xc1, 0x36, 0x7e, 0xfe, 0xe1, 0xbf, 0x03, 0x00, 0x11, 0x1f, 0xe9, 0x65, 0x25, 0x10, 0x00,
+	0x00,
 }
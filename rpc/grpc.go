@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/asaskevich/EventBus"
@@ -8,9 +9,11 @@ import (
 	"github.com/coschain/contentos-go/common"
 	"github.com/coschain/contentos-go/common/constants"
 	"github.com/coschain/contentos-go/common/eventloop"
+	"github.com/coschain/contentos-go/db/smt"
 	"github.com/coschain/contentos-go/iservices"
 	"github.com/coschain/contentos-go/node"
 	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/lightclient"
 	"github.com/coschain/contentos-go/rpc/pb"
 	"github.com/coschain/contentos-go/vm/contract/abi"
 	contractTable "github.com/coschain/contentos-go/vm/contract/table"
@@ -38,6 +41,9 @@ type APIService struct {
 	log       *logrus.Logger
 	eBus      EventBus.Bus
 	ctx       *node.ServiceContext
+	blockLogs *blockLogHistory
+	trxResults *trxResultHub
+	subscriptions subscriptionQuota
 }
 
 func NewAPIService(con iservices.IConsensus, loop *eventloop.EventLoop, db iservices.IDatabaseService, log *logrus.Logger) *APIService {
@@ -414,6 +420,32 @@ func (as *APIService) EstimateStamina(ctx context.Context, req *grpcpb.EsimateRe
 	return estimateResponse,nil
 }
 
+func (as *APIService) GetPendingTrxStats(ctx context.Context, req *grpcpb.GetPendingTrxStatsRequest) (*grpcpb.GetPendingTrxStatsResponse, error) {
+	limit := checkLimit(req.Limit)
+	if limit <= 0 {
+		limit = uint32(defaultPageSizeLimit)
+	}
+	stats := as.pool.GetPendingTrxStats(int(limit))
+	res := &grpcpb.GetPendingTrxStatsResponse{
+		WaitingCount:        uint32(stats.WaitingCount),
+		WaitingSize:         uint64(stats.WaitingSize),
+		FetchedCount:        uint32(stats.FetchedCount),
+		MaxWaitingCount:     uint32(stats.MaxWaitingCount),
+		MaxWaitingSize:      uint64(stats.MaxWaitingSize),
+		MaxWaitingPerSigner: uint32(stats.MaxWaitingPerSigner),
+		Evicted:             stats.Evicted,
+		Rejected:            stats.Rejected,
+	}
+	for _, s := range stats.TopSigners {
+		res.TopSigners = append(res.TopSigners, &grpcpb.PendingSignerStats{
+			Signer: prototype.NewAccountName(s.Signer),
+			Count:  uint32(s.Count),
+			Size:   uint64(s.Size),
+		})
+	}
+	return res, nil
+}
+
 func (as *APIService) BroadcastTrx(ctx context.Context, req *grpcpb.BroadcastTrxRequest) (*grpcpb.BroadcastTrxResponse, error) {
 
 	//var result chan *prototype.TransactionReceiptWithInfo
@@ -920,6 +952,9 @@ func (as *APIService) getAccountResponseByName(name *prototype.AccountName, isNe
 		acctInfo.WithdrawEachTime = accWrap.GetEachPowerdownRate()
 		acctInfo.BpVoteCount = accWrap.GetBpVoteCount()
 		acctInfo.PublicKey = accWrap.GetPubKey()
+		acctInfo.Authority = accWrap.GetAuthority()
+		acctInfo.ActiveAuthority = accWrap.GetActiveAuthority()
+		acctInfo.PostingAuthority = accWrap.GetPostingAuthority()
 		currentBlockNum := gp.GetHeadBlockNumber()
 		currentTime := gp.GetTime()
 		nextWithdrawBlock := accWrap.GetNextPowerdownBlockNum()
@@ -1131,6 +1166,9 @@ func (as *APIService) fetchPostInfoResponseById(postId uint64,isNeedLock bool) *
 			Copyright:      pWrap.GetCopyright(),
 			CopyrightMemo:  pWrap.GetCopyrightMemo(),
 			CashoutBlockNum: pWrap.GetCashoutBlockNum(),
+			Version:        pWrap.GetVersion(),
+			LastEdited:     pWrap.GetLastEdited(),
+			Deleted:        pWrap.GetDeleted(),
 		}
 	}
 	return res
@@ -1362,6 +1400,84 @@ func (as *APIService) GetContractListByTime(ctx context.Context, req *grpcpb.Get
     return  res,err
 }
 
+func (as *APIService) GetContractEvents(ctx context.Context, req *grpcpb.GetContractEventsRequest) (*grpcpb.GetContractEventsResponse, error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	if req.Owner == nil || len(req.Contract) == 0 {
+		return nil, errors.New("contract owner and name are required")
+	}
+	cid := &prototype.ContractId{Owner: req.Owner, Cname: req.Contract}
+	if !table.NewSoContractWrap(as.db, cid).CheckExist() {
+		return nil, errors.New("contract doesn't exist")
+	}
+	limit := checkLimit(req.Limit)
+	if limit <= 0 {
+		limit = uint32(defaultPageSizeLimit)
+	}
+	endBlock := req.EndBlock
+	if endBlock == 0 {
+		endBlock = table.NewSoGlobalWrap(as.db, &constants.GlobalId).GetProps().GetHeadBlockNumber()
+	}
+	// resume from the event next to the last one of previous page
+	startBlock, startSeq := req.StartBlock, uint64(0)
+	if last := req.LastEvent; last != nil && last.Event != nil && last.BlockNum >= startBlock {
+		startBlock, startSeq = last.BlockNum, last.Event.Seq+1
+	}
+	res := &grpcpb.GetContractEventsResponse{}
+	if startBlock > endBlock {
+		return res, nil
+	}
+	collect := func(id *prototype.ContractEventId) bool {
+		eventWrap := table.NewSoContractEventWrap(as.db, id)
+		if eventWrap.CheckExist() {
+			res.Events = append(res.Events, &grpcpb.ContractEventInfo{
+				BlockNum: id.Block,
+				TrxId:    eventWrap.GetTrxId(),
+				Event: &prototype.ContractEvent{
+					Owner:    cid.Owner,
+					Contract: cid.Cname,
+					Name:     eventWrap.GetName(),
+					Data:     eventWrap.GetData(),
+					Seq:      id.Seq,
+				},
+			})
+		}
+		return uint32(len(res.Events)) < limit
+	}
+
+	var err error
+	if len(req.Names) == 1 {
+		name := req.Names[0]
+		err = table.NewContractEventNameOrderWrap(as.db).ForEachByOrder(
+			&prototype.ContractEventNameOrder{Contract: cid, Name: name, Block: startBlock, Seq: startSeq},
+			&prototype.ContractEventNameOrder{Contract: cid, Name: name, Block: endBlock + 1},
+			nil, nil,
+			func(mVal *prototype.ContractEventId, sVal *prototype.ContractEventNameOrder, idx uint32) bool {
+				return collect(mVal)
+			})
+	} else {
+		names := make(map[string]bool)
+		for _, name := range req.Names {
+			names[name] = true
+		}
+		err = table.NewContractEventIdWrap(as.db).ForEachByOrder(
+			&prototype.ContractEventId{Contract: cid, Block: startBlock, Seq: startSeq},
+			&prototype.ContractEventId{Contract: cid, Block: endBlock + 1},
+			nil, nil,
+			func(mVal *prototype.ContractEventId, sVal *prototype.ContractEventId, idx uint32) bool {
+				if len(names) > 0 && !names[table.NewSoContractEventWrap(as.db, mVal).GetName()] {
+					return true
+				}
+				return collect(mVal)
+			})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (as *APIService) GetBlockProducerListByVoteCount(ctx context.Context, req *grpcpb.GetBlockProducerListByVoteCountRequest) (*grpcpb.GetBlockProducerListResponse,error){
 	as.db.RLock()
 	defer as.db.RUnlock()
@@ -1472,6 +1588,16 @@ func (as *APIService) GetNodeRunningVersion(ctx context.Context, req *grpcpb.Non
 	ret := &grpcpb.GetNodeRunningVersionResponse{}
 	ret.NodeVersion = as.ctx.Config().P2P.RunningCodeVersion
 
+	as.db.RLock()
+	defer as.db.RUnlock()
+	for _, f := range as.pool.Features() {
+		ret.Features = append(ret.Features, &grpcpb.ChainFeature{
+			Id: f.Id,
+			ActivationBlockNum: f.Height,
+			Description: f.Description,
+			Active: as.pool.FeatureActive(f.Id),
+		})
+	}
 	return ret, nil
 }
 
@@ -1658,3 +1784,332 @@ func (as *APIService) GetVestDelegationOrderList(ctx context.Context, req *grpcp
 	}
 	return
 }
+
+func (as *APIService) GetEscrowList(ctx context.Context, req *grpcpb.GetEscrowListRequest) (resp *grpcpb.GetEscrowListResponse, err error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	resp = new(grpcpb.GetEscrowListResponse)
+	lastEscrow := req.LastEscrowId
+	if lastEscrow == 0 {
+		lastEscrow = math.MaxUint64
+	}
+	var iterFunc func(*prototype.AccountName, *prototype.AccountName, *uint64, *prototype.AccountName, func(*uint64, *prototype.AccountName, uint32)bool)error
+	switch req.Party {
+	case grpcpb.EscrowParty_ESCROW_FROM:
+		iterFunc = table.NewEscrowFromAccountWrap(as.db).ForEachByRevOrder
+	case grpcpb.EscrowParty_ESCROW_TO:
+		iterFunc = table.NewEscrowToAccountWrap(as.db).ForEachByRevOrder
+	case grpcpb.EscrowParty_ESCROW_AGENT:
+		iterFunc = table.NewEscrowAgentWrap(as.db).ForEachByRevOrder
+	default:
+		return nil, errors.New("unknown escrow party")
+	}
+
+	var escrows []uint64
+	err = iterFunc(nil, req.Account, &lastEscrow, req.Account, func(escrowId *uint64, name *prototype.AccountName, idx uint32) bool {
+		if *escrowId != lastEscrow {
+			escrows = append(escrows, *escrowId)
+		}
+		return len(escrows) < int(req.Limit)
+	})
+	if err != nil {
+		return
+	}
+
+	for _, escrowId := range escrows {
+		if rec := table.NewSoEscrowWrap(as.db, &escrowId); rec.CheckExist() {
+			resp.Escrows = append(resp.Escrows, &grpcpb.Escrow{
+				Id: escrowId,
+				FromAccount: rec.GetFromAccount(),
+				ToAccount: rec.GetToAccount(),
+				Agent: rec.GetAgent(),
+				Amount: rec.GetAmount(),
+				AgentFee: rec.GetAgentFee(),
+				Memo: rec.GetMemo(),
+				CreatedTime: rec.GetCreatedTime(),
+				RatificationDeadline: rec.GetRatificationDeadline(),
+				EscrowExpiration: rec.GetEscrowExpiration(),
+				ToApproved: rec.GetToApproved(),
+				AgentApproved: rec.GetAgentApproved(),
+				Disputed: rec.GetDisputed(),
+			})
+		}
+	}
+	return
+}
+
+func (as *APIService) GetProposalList(ctx context.Context, req *grpcpb.GetProposalListRequest) (resp *grpcpb.GetProposalListResponse, err error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	resp = new(grpcpb.GetProposalListResponse)
+	limit := checkLimit(req.GetLimit())
+	if limit == 0 {
+		limit = uint32(defaultPageSizeLimit)
+	}
+	lastProposal := req.LastProposalId
+	if lastProposal == 0 {
+		lastProposal = math.MaxUint64
+	}
+	status := uint32(req.Status)
+
+	// newer proposals first
+	var proposals []uint64
+	err = table.NewProposalStatusWrap(as.db).ForEachByRevOrder(nil, &status, &lastProposal, &status, func(proposalId *uint64, sVal *uint32, idx uint32) bool {
+		if *proposalId != lastProposal {
+			proposals = append(proposals, *proposalId)
+		}
+		return uint32(len(proposals)) < limit
+	})
+	if err != nil {
+		return
+	}
+	for _, proposalId := range proposals {
+		if proposal := as.getProposal(proposalId); proposal != nil {
+			resp.Proposals = append(resp.Proposals, proposal)
+		}
+	}
+	return
+}
+
+func (as *APIService) GetProposal(ctx context.Context, req *grpcpb.GetProposalRequest) (*grpcpb.GetProposalResponse, error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	proposal := as.getProposal(req.ProposalId)
+	if proposal == nil {
+		return nil, errors.New("proposal not found")
+	}
+	return &grpcpb.GetProposalResponse{Proposal: proposal}, nil
+}
+
+func (as *APIService) getProposal(proposalId uint64) *grpcpb.Proposal {
+	rec := table.NewSoProposalWrap(as.db, &proposalId)
+	if !rec.CheckExist() {
+		return nil
+	}
+	return &grpcpb.Proposal{
+		Id: proposalId,
+		Proposer: rec.GetProposer(),
+		Title: rec.GetTitle(),
+		Description: rec.GetDescription(),
+		Props: rec.GetProps(),
+		Feature: rec.GetFeature(),
+		ActivationBlockNum: rec.GetActivationBlockNum(),
+		Status: grpcpb.ProposalStatus(rec.GetStatus()),
+		Approvals: rec.GetApprovals(),
+		CreatedTime: rec.GetCreatedTime(),
+		RewardPolicy: rec.GetRewardPolicy(),
+	}
+}
+
+func (as *APIService) GetPostRevisions(ctx context.Context, req *grpcpb.GetPostRevisionsRequest) (*grpcpb.GetPostRevisionsResponse, error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	postWrap := table.NewSoPostWrap(as.db, &req.PostId)
+	if !postWrap.CheckExist() {
+		return nil, errors.New("post not found")
+	}
+	limit := checkLimit(req.Limit)
+	if limit <= 0 {
+		limit = uint32(defaultPageSizeLimit)
+	}
+	current := postWrap.GetVersion()
+	res := &grpcpb.GetPostRevisionsResponse{CurrentVersion: current, Deleted: postWrap.GetDeleted()}
+	if req.StartVersion > current {
+		return res, nil
+	}
+	err := table.NewPostRevisionIdWrap(as.db).ForEachByOrder(
+		&prototype.PostRevisionId{PostId: req.PostId, Version: req.StartVersion},
+		&prototype.PostRevisionId{PostId: req.PostId, Version: current},
+		nil, nil,
+		func(mVal *prototype.PostRevisionId, sVal *prototype.PostRevisionId, idx uint32) bool {
+			revisionWrap := table.NewSoPostRevisionWrap(as.db, mVal)
+			if revisionWrap.CheckExist() {
+				res.Revisions = append(res.Revisions, &grpcpb.PostRevision{
+					Version: mVal.Version,
+					Title:   revisionWrap.GetTitle(),
+					Body:    revisionWrap.GetBody(),
+					Created: revisionWrap.GetCreated(),
+				})
+			}
+			return uint32(len(res.Revisions)) < limit
+		})
+	if err != nil {
+		return nil, err
+	}
+	// the current version lives in the post itself
+	if uint32(len(res.Revisions)) < limit {
+		created := postWrap.GetLastEdited()
+		if current == 0 {
+			created = postWrap.GetCreated()
+		}
+		res.Revisions = append(res.Revisions, &grpcpb.PostRevision{
+			Version: current,
+			Title:   postWrap.GetTitle(),
+			Body:    postWrap.GetBody(),
+			Created: created,
+		})
+	}
+	return res, nil
+}
+
+func (as *APIService) GetPeerReputations(ctx context.Context, req *grpcpb.NonParamsRequest) (*grpcpb.GetPeerReputationsResponse, error) {
+	if !as.ctx.Config().GRPC.EnableAdminAPI {
+		return nil, errors.New("admin api disabled")
+	}
+	ret := &grpcpb.GetPeerReputationsResponse{}
+	for _, rep := range as.p2p.GetPeerReputations() {
+		peer := &grpcpb.PeerReputation{Ip: rep.Ip, Score: rep.Score}
+		if !rep.BannedUntil.IsZero() {
+			peer.BannedUntil = prototype.NewTimePointSec(uint32(rep.BannedUntil.Unix()))
+		}
+		ret.Peers = append(ret.Peers, peer)
+	}
+	return ret, nil
+}
+
+func (as *APIService) BanPeer(ctx context.Context, req *grpcpb.BanPeerRequest) (*grpcpb.BanPeerResponse, error) {
+	if !as.ctx.Config().GRPC.EnableAdminAPI {
+		return nil, errors.New("admin api disabled")
+	}
+	if err := as.p2p.BanPeer(req.Ip, time.Duration(req.Duration) * time.Second); err != nil {
+		return nil, err
+	}
+	return &grpcpb.BanPeerResponse{}, nil
+}
+
+func (as *APIService) UnbanPeer(ctx context.Context, req *grpcpb.UnbanPeerRequest) (*grpcpb.UnbanPeerResponse, error) {
+	if !as.ctx.Config().GRPC.EnableAdminAPI {
+		return nil, errors.New("admin api disabled")
+	}
+	if err := as.p2p.UnbanPeer(req.Ip); err != nil {
+		return nil, err
+	}
+	return &grpcpb.UnbanPeerResponse{}, nil
+}
+
+func (as *APIService) GetAccountProof(ctx context.Context, req *grpcpb.GetAccountProofRequest) (*grpcpb.StateProofResponse, error) {
+	if req.AccountName == nil {
+		return nil, errors.New("account name is empty")
+	}
+	return as.getStateProof(lightclient.AccountKey(req.AccountName))
+}
+
+func (as *APIService) GetPostProof(ctx context.Context, req *grpcpb.GetPostProofRequest) (*grpcpb.StateProofResponse, error) {
+	return as.getStateProof(lightclient.PostKey(req.PostId))
+}
+
+// getStateProof proves the value of key in the state after applying the head block.
+func (as *APIService) getStateProof(key []byte) (*grpcpb.StateProofResponse, error) {
+	as.db.RLock()
+	defer as.db.RUnlock()
+
+	tree := smt.New(as.db)
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	proof, err := tree.Prove(key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := as.db.Get(key)
+	exist := err == nil
+	if !exist {
+		value = nil
+	}
+	return &grpcpb.StateProofResponse{
+		Proof: &grpcpb.StateProof{
+			BlockNum: table.NewSoGlobalWrap(as.db, &constants.GlobalId).GetProps().GetHeadBlockNumber(),
+			StateRoot: root,
+			Key: key,
+			Value: value,
+			Exist: exist,
+			Siblings: proof.Siblings,
+			LeafKeyHash: proof.LeafKeyHash,
+			LeafValueHash: proof.LeafValueHash,
+		},
+	}, nil
+}
+
+func (as *APIService) GetTrxInclusionProof(ctx context.Context, req *grpcpb.GetTrxInclusionProofRequest) (*grpcpb.GetTrxInclusionProofResponse, error) {
+	if req.TrxId == nil {
+		return nil, errors.New("trx id is empty")
+	}
+	blockNum := req.BlockNum
+	if blockNum == 0 {
+		as.db.RLock()
+		if wrap := table.NewSoExtTrxWrap(as.db, req.TrxId); wrap.CheckExist() {
+			blockNum = wrap.GetBlockHeight()
+		}
+		as.db.RUnlock()
+		if blockNum == 0 {
+			return nil, errors.New("the trx not found")
+		}
+	}
+	list, err := as.consensus.FetchBlocks(blockNum, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	var block *prototype.SignedBlock
+	for _, blk := range list {
+		if b := blk.(*prototype.SignedBlock); b.Id().BlockNum() == blockNum {
+			block = b
+		}
+	}
+	if block == nil {
+		return nil, errors.New("the block not exist")
+	}
+	for i, w := range block.Transactions {
+		id, err := w.SigTrx.Id()
+		if err != nil || !bytes.Equal(id.Hash, req.TrxId.Hash) {
+			continue
+		}
+		siblings, err := block.MerkleProof(i)
+		if err != nil {
+			return nil, err
+		}
+		return &grpcpb.GetTrxInclusionProofResponse{
+			Proof: &grpcpb.TrxInclusionProof{
+				BlockNum: blockNum,
+				Index: uint32(i),
+				Count: uint32(len(block.Transactions)),
+				Siblings: siblings,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("the trx not found in block %d", blockNum)
+}
+
+func (as *APIService) GetBlockHeaderWithCommit(ctx context.Context, req *grpcpb.GetBlockHeaderWithCommitRequest) (*grpcpb.GetBlockHeaderWithCommitResponse, error) {
+	// the first commit at or after the requested block, which finalizes the requested one
+	c, ok := as.consensus.GetBFTCommitInfo(req.BlockNum).(*message.Commit)
+	if !ok || c == nil {
+		return nil, fmt.Errorf("block %d is not committed yet", req.BlockNum)
+	}
+	committed := common.BlockID{Data: c.ProposedData}
+	to := committed.BlockNum()
+	if to < req.BlockNum {
+		return nil, fmt.Errorf("block %d is not committed yet", req.BlockNum)
+	}
+	list, err := as.consensus.FetchBlocks(req.BlockNum, to)
+	if err != nil {
+		return nil, err
+	}
+	res := &grpcpb.GetBlockHeaderWithCommitResponse{ Commit: c.Bytes() }
+	var lastId common.BlockID
+	for _, blk := range list {
+		b := blk.(*prototype.SignedBlock)
+		if id := b.Id(); id.BlockNum() >= req.BlockNum && id.BlockNum() <= to {
+			res.Headers = append(res.Headers, b.SignedHeader)
+			lastId = id
+		}
+	}
+	if uint64(len(res.Headers)) != to-req.BlockNum+1 || lastId != committed {
+		return nil, errors.New("failed to fetch blocks")
+	}
+	return res, nil
+}
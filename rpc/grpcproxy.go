@@ -10,6 +10,8 @@ import (
 const (
 	// DefaultHTTPLimit default max http conns
 	DefaultProxyHTTPLimit = 2000
+	// DefaultProxyWebSocketLimit default max websocket conns
+	DefaultProxyWebSocketLimit = 100
 )
 
 func makeHttpOriginFunc() func(origin string) bool {
@@ -17,7 +19,7 @@ func makeHttpOriginFunc() func(origin string) bool {
 		return true
 	}}
 
-func RunWebProxy(grpcServer *grpc.Server, config *service_configs.GRPCConfig) error {
+func RunWebProxy(grpcServer *grpc.Server, wsServer http.Handler, config *service_configs.GRPCConfig) error {
 
 	options := []grpcweb.Option{
 		grpcweb.WithCorsForRegisteredEndpointsOnly(false),
@@ -42,6 +44,12 @@ func RunWebProxy(grpcServer *grpc.Server, config *service_configs.GRPCConfig) er
 		}
 	}) )
 
+	wsLimit := DefaultProxyWebSocketLimit
+	if config.WebSocketLimit != 0 {
+		wsLimit = config.WebSocketLimit
+	}
+	mux.Handle(WebSocketPath, limitWebSocketConns(wsServer, wsLimit))
+
 	go func() {
 		http.ListenAndServe(config.HTTPListen, mux)
 	}()
@@ -49,3 +57,17 @@ func RunWebProxy(grpcServer *grpc.Server, config *service_configs.GRPCConfig) er
 	return nil
 }
 
+// limitWebSocketConns limits concurrent websocket connections, which are long-lived and have their own slots,
+// so that idle ones don't starve plain http requests. Connections beyond the limit are refused at once.
+func limitWebSocketConns(wsServer http.Handler, limit int) http.Handler {
+	wsCh := make(chan bool, limit)
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		select {
+		case wsCh <- true:
+			defer func() { <-wsCh }()
+			wsServer.ServeHTTP(resp, req)
+		default:
+			http.Error(resp, "too many websocket connections", http.StatusServiceUnavailable)
+		}
+	})
+}
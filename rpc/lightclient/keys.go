@@ -0,0 +1,30 @@
+package lightclient
+
+import (
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/prototype"
+)
+
+// recordKey returns the state key of a table record, the same one as generated table wrappers use.
+func recordKey(prefix uint32, mainKey interface{}) []byte {
+	preBuf, err := kope.Encode(prefix)
+	if err != nil {
+		return nil
+	}
+	mBuf, err := kope.Encode(mainKey)
+	if err != nil {
+		return nil
+	}
+	return kope.PackList([][]byte{preBuf, mBuf})
+}
+
+// AccountKey returns the state key of an account record.
+func AccountKey(name *prototype.AccountName) []byte {
+	return recordKey(table.AccountNameRow, name)
+}
+
+// PostKey returns the state key of a post record.
+func PostKey(postId uint64) []byte {
+	return recordKey(table.PostPostIdRow, &postId)
+}
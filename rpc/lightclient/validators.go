@@ -0,0 +1,92 @@
+package lightclient
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/gobft/message"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+// ValidatorSet is a set of validators trusted by the light client.
+type ValidatorSet struct {
+	keys map[message.PubKey]*prototype.PublicKeyType
+}
+
+// NewValidatorSet returns a validator set of given public keys.
+func NewValidatorSet(keys []*prototype.PublicKeyType) *ValidatorSet {
+	vs := &ValidatorSet{keys: make(map[message.PubKey]*prototype.PublicKeyType)}
+	for _, k := range keys {
+		// SABFT identifies validators by their public keys in WIF.
+		vs.keys[message.PubKey(k.ToWIF())] = k
+	}
+	return vs
+}
+
+// NewValidatorSetFromWIF returns a validator set of given public keys in WIF.
+func NewValidatorSetFromWIF(wifs []string) (*ValidatorSet, error) {
+	keys := make([]*prototype.PublicKeyType, 0, len(wifs))
+	for _, w := range wifs {
+		k, err := prototype.PublicKeyFromWIF(w)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %v", w, err)
+		}
+		keys = append(keys, k)
+	}
+	return NewValidatorSet(keys), nil
+}
+
+// Size returns the number of validators.
+func (vs *ValidatorSet) Size() int {
+	return len(vs.keys)
+}
+
+// verifySig checks if signature of digest was signed by validator of given public key.
+func (vs *ValidatorSet) verifySig(signer message.PubKey, digest, signature []byte) bool {
+	key := vs.keys[signer]
+	if key == nil {
+		return false
+	}
+	sig := &prototype.SignatureType{Sig: signature}
+	if err := sig.Validate(); err != nil {
+		return false
+	}
+	buffer, err := secp256k1.RecoverPubkey(digest, signature)
+	if err != nil {
+		return false
+	}
+	ecPubKey, err := crypto.UnmarshalPubkey(buffer)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(key.Data, secp256k1.CompressPubkey(ecPubKey.X, ecPubKey.Y))
+}
+
+// VerifyCommit checks a SABFT commit the same way as SABFT does: more than 2/3 of validators must have
+// precommitted, and the commit itself must be signed by a validator.
+func (vs *ValidatorSet) VerifyCommit(commit *message.Commit) error {
+	if commit == nil {
+		return errors.New("nil commit")
+	}
+	if err := commit.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(commit.Precommits) <= vs.Size()*2/3 {
+		return fmt.Errorf("insufficient precommits: %d of %d validators", len(commit.Precommits), vs.Size())
+	}
+	for _, v := range commit.Precommits {
+		if v == nil {
+			return errors.New("nil precommit")
+		}
+		if !vs.verifySig(v.Address, v.Digest(), v.Signature) {
+			return fmt.Errorf("invalid precommit from %s", v.Address)
+		}
+	}
+	if !vs.verifySig(commit.Address, commit.Digest(), commit.Signature) {
+		return fmt.Errorf("invalid commit signature from %s", commit.Address)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Package lightclient verifies responses of proof RPCs against a trusted validator set, so that clients
+// don't have to trust the RPC node they talk to.
+//
+// Block headers are trusted through SABFT commits. A commit finalizes a block and all of its ancestors, so
+// GetBlockHeaderWithCommit returns headers from the requested block up to a committed one, linked by their
+// previous block ids. Transactions are proven against the transaction merkle root of a trusted header.
+// States are proven against the sparse merkle state root, which is committed in the header of the next
+// block, i.e. a state proof at block N is verified with the trusted header of block N+1.
+package lightclient
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/db/smt"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/coschain/gobft/message"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	ErrNoProof      = errors.New("lightclient: missing proof")
+	ErrWrongHeader  = errors.New("lightclient: proof doesn't match the header")
+	ErrBrokenChain  = errors.New("lightclient: broken header chain")
+	ErrInvalidProof = errors.New("lightclient: invalid proof")
+)
+
+func headerId(header *prototype.SignedBlockHeader) (common.BlockID, error) {
+	if header == nil || header.Header == nil || header.Header.Previous == nil || len(header.Header.Previous.Hash) != 32 {
+		return common.BlockID{}, errors.New("lightclient: malformed header")
+	}
+	return (&prototype.SignedBlock{SignedHeader: header}).Id(), nil
+}
+
+// headerNum returns the block number of header, 0 if the header is malformed.
+func headerNum(header *prototype.SignedBlockHeader) uint64 {
+	id, err := headerId(header)
+	if err != nil {
+		return 0
+	}
+	return id.BlockNum()
+}
+
+// VerifyHeaders checks a response of GetBlockHeaderWithCommit, and returns the trusted header of blockNum.
+func (vs *ValidatorSet) VerifyHeaders(blockNum uint64, resp *grpcpb.GetBlockHeaderWithCommitResponse) (*prototype.SignedBlockHeader, error) {
+	if resp == nil || len(resp.Headers) == 0 {
+		return nil, ErrNoProof
+	}
+	msg, err := message.DecodeConsensusMsg(resp.Commit)
+	if err != nil {
+		return nil, err
+	}
+	commit, ok := msg.(*message.Commit)
+	if !ok {
+		return nil, errors.New("lightclient: not a commit")
+	}
+	if err = vs.VerifyCommit(commit); err != nil {
+		return nil, err
+	}
+
+	// walk down from the committed block, following previous block ids
+	expected := common.BlockID{Data: commit.ProposedData}
+	for i := len(resp.Headers) - 1; i >= 0; i-- {
+		id, err := headerId(resp.Headers[i])
+		if err != nil {
+			return nil, err
+		}
+		if id != expected {
+			return nil, ErrBrokenChain
+		}
+		copy(expected.Data[:], resp.Headers[i].Header.Previous.Hash)
+	}
+	header := resp.Headers[0]
+	if num := headerNum(header); num != blockNum {
+		return nil, fmt.Errorf("lightclient: expected header of block %d, got %d", blockNum, num)
+	}
+	return header, nil
+}
+
+// VerifyStateProof checks a state proof against the trusted header of the block next to the proof's.
+// It returns the proven value, nil if the key doesn't exist.
+func VerifyStateProof(header *prototype.SignedBlockHeader, key []byte, p *grpcpb.StateProof) ([]byte, error) {
+	if p == nil {
+		return nil, ErrNoProof
+	}
+	if headerNum(header) != p.BlockNum+1 {
+		return nil, ErrWrongHeader
+	}
+	if header.Header.PrevStateRoot == nil || !bytes.Equal(header.Header.PrevStateRoot.Hash, p.StateRoot) {
+		return nil, ErrWrongHeader
+	}
+	if !bytes.Equal(key, p.Key) {
+		return nil, ErrInvalidProof
+	}
+	var value []byte
+	if p.Exist {
+		value = append([]byte{}, p.Value...)
+	}
+	proof := &smt.Proof{
+		Siblings:      p.Siblings,
+		LeafKeyHash:   p.LeafKeyHash,
+		LeafValueHash: p.LeafValueHash,
+	}
+	if err := proof.Verify(p.StateRoot, key, value); err != nil {
+		return nil, ErrInvalidProof
+	}
+	return value, nil
+}
+
+// VerifyAccountProof checks a response of GetAccountProof, and returns the proven account record, nil if
+// the account doesn't exist.
+func VerifyAccountProof(header *prototype.SignedBlockHeader, name *prototype.AccountName, resp *grpcpb.StateProofResponse) (*table.SoAccount, error) {
+	if resp == nil {
+		return nil, ErrNoProof
+	}
+	value, err := VerifyStateProof(header, AccountKey(name), resp.Proof)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	account := &table.SoAccount{}
+	if err = proto.Unmarshal(value, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// VerifyPostProof checks a response of GetPostProof, and returns the proven post record, nil if the post
+// doesn't exist.
+func VerifyPostProof(header *prototype.SignedBlockHeader, postId uint64, resp *grpcpb.StateProofResponse) (*table.SoPost, error) {
+	if resp == nil {
+		return nil, ErrNoProof
+	}
+	value, err := VerifyStateProof(header, PostKey(postId), resp.Proof)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	post := &table.SoPost{}
+	if err = proto.Unmarshal(value, post); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// VerifyTrxInclusionProof checks a response of GetTrxInclusionProof against the trusted header of the block
+// including the transaction.
+func VerifyTrxInclusionProof(header *prototype.SignedBlockHeader, trxId *prototype.Sha256, resp *grpcpb.GetTrxInclusionProofResponse) error {
+	if resp == nil || resp.Proof == nil || trxId == nil {
+		return ErrNoProof
+	}
+	p := resp.Proof
+	if num := headerNum(header); num == 0 || num != p.BlockNum || header.Header.TransactionMerkleRoot == nil {
+		return ErrWrongHeader
+	}
+	if !prototype.VerifyMerkleProof(header.Header.TransactionMerkleRoot.Hash, trxId.Hash, p.Index, p.Count, p.Siblings) {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package lightclient
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/db/smt"
+	"github.com/coschain/contentos-go/db/storage"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/coschain/gobft/message"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeKeys(t *testing.T, n int) (privs []*prototype.PrivateKeyType, pubs []*prototype.PublicKeyType) {
+	for i := 0; i < n; i++ {
+		priv, err := prototype.GenerateNewKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, _ := priv.PubKey()
+		privs, pubs = append(privs, priv), append(pubs, pub)
+	}
+	return
+}
+
+func sign(t *testing.T, priv *prototype.PrivateKeyType, digest []byte) []byte {
+	sig, err := secp256k1.Sign(digest, priv.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// makeCommit returns a commit of block id, precommitted by given validators and signed by the first one.
+func makeCommit(t *testing.T, id common.BlockID, prev common.BlockID, privs []*prototype.PrivateKeyType) *message.Commit {
+	c := &message.Commit{
+		ProposedData: id.Data,
+		Prev:         prev.Data,
+		CommitTime:   time.Unix(1000, 0).UTC(),
+	}
+	for _, priv := range privs {
+		pub, _ := priv.PubKey()
+		v := &message.Vote{
+			Type:      message.PrecommitType,
+			Height:    int64(id.BlockNum()),
+			Timestamp: time.Unix(1000, 0).UTC(),
+			Proposed:  id.Data,
+			Prev:      prev.Data,
+			Address:   message.PubKey(pub.ToWIF()),
+		}
+		v.Signature = sign(t, priv, v.Digest())
+		c.Precommits = append(c.Precommits, v)
+	}
+	pub, _ := privs[0].PubKey()
+	c.Address = message.PubKey(pub.ToWIF())
+	c.Signature = sign(t, privs[0], c.Digest())
+	return c
+}
+
+func makeHeaders(n int, stateRoots map[int][]byte, trxRoots map[int][]byte) (headers []*prototype.SignedBlockHeader, ids []common.BlockID) {
+	prev := common.BlockID{}
+	for i := 1; i <= n; i++ {
+		h := &prototype.SignedBlockHeader{
+			Header: &prototype.BlockHeader{
+				Previous:              &prototype.Sha256{Hash: append([]byte{}, prev.Data[:]...)},
+				Timestamp:             &prototype.TimePointSec{UtcSeconds: uint32(i)},
+				BlockProducer:         &prototype.AccountName{Value: "initminer"},
+				TransactionMerkleRoot: &prototype.Sha256{Hash: make([]byte, 32)},
+			},
+			BlockProducerSignature: &prototype.SignatureType{Sig: make([]byte, 65)},
+		}
+		if r, ok := stateRoots[i]; ok {
+			h.Header.PrevStateRoot = &prototype.Sha256{Hash: r}
+		}
+		if r, ok := trxRoots[i]; ok {
+			h.Header.TransactionMerkleRoot = &prototype.Sha256{Hash: r}
+		}
+		prev = (&prototype.SignedBlock{SignedHeader: h}).Id()
+		headers, ids = append(headers, h), append(ids, prev)
+	}
+	return
+}
+
+func TestVerifyCommit(t *testing.T) {
+	a := assert.New(t)
+	privs, pubs := makeKeys(t, 4)
+	vs := NewValidatorSet(pubs)
+	_, ids := makeHeaders(2, nil, nil)
+
+	a.NoError(vs.VerifyCommit(makeCommit(t, ids[1], ids[0], privs)))
+	a.NoError(vs.VerifyCommit(makeCommit(t, ids[1], ids[0], privs[:3])))
+	// 2 of 4 validators are not enough
+	a.Error(vs.VerifyCommit(makeCommit(t, ids[1], ids[0], privs[:2])))
+
+	// precommits from strangers are refused
+	strangers, _ := makeKeys(t, 1)
+	a.Error(vs.VerifyCommit(makeCommit(t, ids[1], ids[0], append(privs[:3:3], strangers...))))
+
+	// tampered commits are refused
+	c := makeCommit(t, ids[1], ids[0], privs)
+	c.Precommits[2].Signature = c.Precommits[1].Signature
+	a.Error(vs.VerifyCommit(c))
+	c = makeCommit(t, ids[1], ids[0], privs)
+	c.CommitTime = c.CommitTime.Add(time.Second)
+	a.Error(vs.VerifyCommit(c))
+
+	var wifs []string
+	for _, k := range pubs {
+		wifs = append(wifs, k.ToWIF())
+	}
+	vs2, err := NewValidatorSetFromWIF(wifs)
+	a.NoError(err)
+	a.Equal(4, vs2.Size())
+	a.NoError(vs2.VerifyCommit(makeCommit(t, ids[1], ids[0], privs)))
+}
+
+func TestVerifyHeaders(t *testing.T) {
+	a := assert.New(t)
+	privs, pubs := makeKeys(t, 4)
+	vs := NewValidatorSet(pubs)
+	headers, ids := makeHeaders(5, nil, nil)
+	commit := makeCommit(t, ids[4], ids[3], privs).Bytes()
+
+	for num := 1; num <= 5; num++ {
+		h, err := vs.VerifyHeaders(uint64(num), &grpcpb.GetBlockHeaderWithCommitResponse{
+			Headers: headers[num-1:],
+			Commit:  commit,
+		})
+		a.NoError(err)
+		a.Equal(headers[num-1], h)
+	}
+
+	// wrong block number
+	_, err := vs.VerifyHeaders(1, &grpcpb.GetBlockHeaderWithCommitResponse{Headers: headers[1:], Commit: commit})
+	a.Error(err)
+	// missing headers
+	_, err = vs.VerifyHeaders(1, &grpcpb.GetBlockHeaderWithCommitResponse{Headers: headers[:4], Commit: commit})
+	a.Error(err)
+	_, err = vs.VerifyHeaders(1, &grpcpb.GetBlockHeaderWithCommitResponse{Headers: append(headers[:2:2], headers[3:]...), Commit: commit})
+	a.Error(err)
+	// a forged header
+	forged := proto.Clone(headers[2]).(*prototype.SignedBlockHeader)
+	forged.Header.Timestamp.UtcSeconds++
+	_, err = vs.VerifyHeaders(3, &grpcpb.GetBlockHeaderWithCommitResponse{Headers: append([]*prototype.SignedBlockHeader{forged}, headers[3:]...), Commit: commit})
+	a.Error(err)
+	// commit of untrusted validators
+	others, _ := makeKeys(t, 4)
+	_, err = vs.VerifyHeaders(1, &grpcpb.GetBlockHeaderWithCommitResponse{Headers: headers, Commit: makeCommit(t, ids[4], ids[3], others).Bytes()})
+	a.Error(err)
+}
+
+func TestVerifyStateProof(t *testing.T) {
+	a := assert.New(t)
+	db := storage.NewMemoryDatabase()
+	tree := smt.New(db)
+	accounts := make(map[string][]byte)
+	for i := 0; i < 50; i++ {
+		name := &prototype.AccountName{Value: fmt.Sprintf("account%d", i)}
+		value, _ := proto.Marshal(&table.SoAccount{Name: name, Balance: prototype.NewCoin(uint64(i))})
+		accounts[name.Value] = value
+		a.NoError(tree.Update(AccountKey(name), value))
+	}
+	a.NoError(tree.Update(PostKey(1), []byte("post")))
+	root, _ := tree.Root()
+	headers, _ := makeHeaders(3, map[int][]byte{2: root}, nil)
+
+	prove := func(key, value []byte) *grpcpb.StateProofResponse {
+		proof, err := tree.Prove(key)
+		a.NoError(err)
+		return &grpcpb.StateProofResponse{Proof: &grpcpb.StateProof{
+			BlockNum:      1,
+			StateRoot:     root,
+			Key:           key,
+			Value:         value,
+			Exist:         value != nil,
+			Siblings:      proof.Siblings,
+			LeafKeyHash:   proof.LeafKeyHash,
+			LeafValueHash: proof.LeafValueHash,
+		}}
+	}
+
+	name := &prototype.AccountName{Value: "account7"}
+	resp := prove(AccountKey(name), accounts[name.Value])
+	account, err := VerifyAccountProof(headers[1], name, resp)
+	a.NoError(err)
+	a.Equal(uint64(7), account.Balance.Value)
+
+	// the state root is in the header of next block
+	_, err = VerifyAccountProof(headers[0], name, resp)
+	a.Error(err)
+	_, err = VerifyAccountProof(headers[2], name, resp)
+	a.Error(err)
+	// proof of another account
+	_, err = VerifyAccountProof(headers[1], &prototype.AccountName{Value: "account8"}, resp)
+	a.Error(err)
+	// faked value
+	resp.Proof.Value = accounts["account8"]
+	_, err = VerifyAccountProof(headers[1], name, resp)
+	a.Error(err)
+	// hidden account
+	resp = prove(AccountKey(name), nil)
+	_, err = VerifyAccountProof(headers[1], name, resp)
+	a.Error(err)
+
+	// absence
+	name = &prototype.AccountName{Value: "nobody"}
+	account, err = VerifyAccountProof(headers[1], name, prove(AccountKey(name), nil))
+	a.NoError(err)
+	a.Nil(account)
+	_, err = VerifyAccountProof(headers[1], name, prove(AccountKey(name), accounts["account8"]))
+	a.Error(err)
+
+	_, err = VerifyStateProof(headers[1], PostKey(1), prove(PostKey(1), []byte("post")).Proof)
+	a.NoError(err)
+	post, err := VerifyPostProof(headers[1], 2, prove(PostKey(2), nil))
+	a.NoError(err)
+	a.Nil(post)
+}
+
+func TestVerifyTrxInclusionProof(t *testing.T) {
+	a := assert.New(t)
+	block := &prototype.SignedBlock{}
+	for i := 0; i < 5; i++ {
+		trx := &prototype.SignedTransaction{Trx: &prototype.Transaction{RefBlockNum: uint32(i), Expiration: &prototype.TimePointSec{}}}
+		block.Transactions = append(block.Transactions, &prototype.TransactionWrapper{SigTrx: trx})
+	}
+	headers, _ := makeHeaders(2, nil, map[int][]byte{2: block.CalculateMerkleRoot().Data[:]})
+
+	for i, w := range block.Transactions {
+		id, _ := w.SigTrx.Id()
+		siblings, err := block.MerkleProof(i)
+		a.NoError(err)
+		resp := &grpcpb.GetTrxInclusionProofResponse{Proof: &grpcpb.TrxInclusionProof{
+			BlockNum: 2,
+			Index:    uint32(i),
+			Count:    uint32(len(block.Transactions)),
+			Siblings: siblings,
+		}}
+		a.NoError(VerifyTrxInclusionProof(headers[1], id, resp))
+		a.Error(VerifyTrxInclusionProof(headers[0], id, resp))
+		a.Error(VerifyTrxInclusionProof(headers[1], &prototype.Sha256{Hash: make([]byte, 32)}, resp))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	pb "github.com/coschain/contentos-go/rpc/pb"
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
 )
 
@@ -855,640 +856,2199 @@ func (mr *MockApiServiceClientMockRecorder) GetVestDelegationOrderList(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVestDelegationOrderList", reflect.TypeOf((*MockApiServiceClient)(nil).GetVestDelegationOrderList), varargs...)
 }
 
-// MockApiServiceServer is a mock of ApiServiceServer interface
-type MockApiServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockApiServiceServerMockRecorder
-}
-
-// MockApiServiceServerMockRecorder is the mock recorder for MockApiServiceServer
-type MockApiServiceServerMockRecorder struct {
-	mock *MockApiServiceServer
-}
-
-// NewMockApiServiceServer creates a new mock instance
-func NewMockApiServiceServer(ctrl *gomock.Controller) *MockApiServiceServer {
-	mock := &MockApiServiceServer{ctrl: ctrl}
-	mock.recorder = &MockApiServiceServerMockRecorder{mock}
-	return mock
+// GetAccountProof mocks base method
+func (m *MockApiServiceClient) GetAccountProof(ctx context.Context, in *pb.GetAccountProofRequest, opts ...grpc.CallOption) (*pb.StateProofResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountProof", varargs...)
+	ret0, _ := ret[0].(*pb.StateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
-	return m.recorder
+// GetAccountProof indicates an expected call of GetAccountProof
+func (mr *MockApiServiceClientMockRecorder) GetAccountProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountProof", reflect.TypeOf((*MockApiServiceClient)(nil).GetAccountProof), varargs...)
 }
 
-// QueryTableContent mocks base method
-func (m *MockApiServiceServer) QueryTableContent(arg0 context.Context, arg1 *pb.GetTableContentRequest) (*pb.TableContentResponse, error) {
+// GetPostProof mocks base method
+func (m *MockApiServiceClient) GetPostProof(ctx context.Context, in *pb.GetPostProofRequest, opts ...grpc.CallOption) (*pb.StateProofResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryTableContent", arg0, arg1)
-	ret0, _ := ret[0].(*pb.TableContentResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPostProof", varargs...)
+	ret0, _ := ret[0].(*pb.StateProofResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// QueryTableContent indicates an expected call of QueryTableContent
-func (mr *MockApiServiceServerMockRecorder) QueryTableContent(arg0, arg1 interface{}) *gomock.Call {
+// GetPostProof indicates an expected call of GetPostProof
+func (mr *MockApiServiceClientMockRecorder) GetPostProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTableContent", reflect.TypeOf((*MockApiServiceServer)(nil).QueryTableContent), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostProof", reflect.TypeOf((*MockApiServiceClient)(nil).GetPostProof), varargs...)
 }
 
-// GetAccountByName mocks base method
-func (m *MockApiServiceServer) GetAccountByName(arg0 context.Context, arg1 *pb.GetAccountByNameRequest) (*pb.AccountResponse, error) {
+// GetTrxInclusionProof mocks base method
+func (m *MockApiServiceClient) GetTrxInclusionProof(ctx context.Context, in *pb.GetTrxInclusionProofRequest, opts ...grpc.CallOption) (*pb.GetTrxInclusionProofResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.AccountResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrxInclusionProof", varargs...)
+	ret0, _ := ret[0].(*pb.GetTrxInclusionProofResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountByName indicates an expected call of GetAccountByName
-func (mr *MockApiServiceServerMockRecorder) GetAccountByName(arg0, arg1 interface{}) *gomock.Call {
+// GetTrxInclusionProof indicates an expected call of GetTrxInclusionProof
+func (mr *MockApiServiceClientMockRecorder) GetTrxInclusionProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountByName), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxInclusionProof", reflect.TypeOf((*MockApiServiceClient)(nil).GetTrxInclusionProof), varargs...)
 }
 
-// GetFollowerListByName mocks base method
-func (m *MockApiServiceServer) GetFollowerListByName(arg0 context.Context, arg1 *pb.GetFollowerListByNameRequest) (*pb.GetFollowerListByNameResponse, error) {
+// GetBlockHeaderWithCommit mocks base method
+func (m *MockApiServiceClient) GetBlockHeaderWithCommit(ctx context.Context, in *pb.GetBlockHeaderWithCommitRequest, opts ...grpc.CallOption) (*pb.GetBlockHeaderWithCommitResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFollowerListByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetFollowerListByNameResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockHeaderWithCommit", varargs...)
+	ret0, _ := ret[0].(*pb.GetBlockHeaderWithCommitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFollowerListByName indicates an expected call of GetFollowerListByName
-func (mr *MockApiServiceServerMockRecorder) GetFollowerListByName(arg0, arg1 interface{}) *gomock.Call {
+// GetBlockHeaderWithCommit indicates an expected call of GetBlockHeaderWithCommit
+func (mr *MockApiServiceClientMockRecorder) GetBlockHeaderWithCommit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowerListByName), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeaderWithCommit", reflect.TypeOf((*MockApiServiceClient)(nil).GetBlockHeaderWithCommit), varargs...)
 }
 
-// GetFollowingListByName mocks base method
-func (m *MockApiServiceServer) GetFollowingListByName(arg0 context.Context, arg1 *pb.GetFollowingListByNameRequest) (*pb.GetFollowingListByNameResponse, error) {
+// SubscribeBlocks mocks base method
+func (m *MockApiServiceClient) SubscribeBlocks(ctx context.Context, in *pb.SubscribeBlocksRequest, opts ...grpc.CallOption) (pb.ApiService_SubscribeBlocksClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFollowingListByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetFollowingListByNameResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeBlocks", varargs...)
+	ret0, _ := ret[0].(pb.ApiService_SubscribeBlocksClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFollowingListByName indicates an expected call of GetFollowingListByName
-func (mr *MockApiServiceServerMockRecorder) GetFollowingListByName(arg0, arg1 interface{}) *gomock.Call {
+// SubscribeBlocks indicates an expected call of SubscribeBlocks
+func (mr *MockApiServiceClientMockRecorder) SubscribeBlocks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowingListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowingListByName), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBlocks", reflect.TypeOf((*MockApiServiceClient)(nil).SubscribeBlocks), varargs...)
 }
 
-// GetFollowCountByName mocks base method
-func (m *MockApiServiceServer) GetFollowCountByName(arg0 context.Context, arg1 *pb.GetFollowCountByNameRequest) (*pb.GetFollowCountByNameResponse, error) {
+// SubscribeIrreversibleBlocks mocks base method
+func (m *MockApiServiceClient) SubscribeIrreversibleBlocks(ctx context.Context, in *pb.SubscribeBlocksRequest, opts ...grpc.CallOption) (pb.ApiService_SubscribeIrreversibleBlocksClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFollowCountByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetFollowCountByNameResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeIrreversibleBlocks", varargs...)
+	ret0, _ := ret[0].(pb.ApiService_SubscribeIrreversibleBlocksClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFollowCountByName indicates an expected call of GetFollowCountByName
-func (mr *MockApiServiceServerMockRecorder) GetFollowCountByName(arg0, arg1 interface{}) *gomock.Call {
+// SubscribeIrreversibleBlocks indicates an expected call of SubscribeIrreversibleBlocks
+func (mr *MockApiServiceClientMockRecorder) SubscribeIrreversibleBlocks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCountByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowCountByName), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIrreversibleBlocks", reflect.TypeOf((*MockApiServiceClient)(nil).SubscribeIrreversibleBlocks), varargs...)
 }
 
-// GetBlockProducerList mocks base method
-func (m *MockApiServiceServer) GetBlockProducerList(arg0 context.Context, arg1 *pb.GetBlockProducerListRequest) (*pb.GetBlockProducerListResponse, error) {
+// SubscribeTrxResult mocks base method
+func (m *MockApiServiceClient) SubscribeTrxResult(ctx context.Context, in *pb.SubscribeTrxResultRequest, opts ...grpc.CallOption) (pb.ApiService_SubscribeTrxResultClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockProducerList", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockProducerListResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeTrxResult", varargs...)
+	ret0, _ := ret[0].(pb.ApiService_SubscribeTrxResultClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlockProducerList indicates an expected call of GetBlockProducerList
-func (mr *MockApiServiceServerMockRecorder) GetBlockProducerList(arg0, arg1 interface{}) *gomock.Call {
+// SubscribeTrxResult indicates an expected call of SubscribeTrxResult
+func (mr *MockApiServiceClientMockRecorder) SubscribeTrxResult(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerList), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeTrxResult", reflect.TypeOf((*MockApiServiceClient)(nil).SubscribeTrxResult), varargs...)
 }
 
-// GetPostListByCreated mocks base method
-func (m *MockApiServiceServer) GetPostListByCreated(arg0 context.Context, arg1 *pb.GetPostListByCreatedRequest) (*pb.GetPostListByCreatedResponse, error) {
+// SubscribeBlockLog mocks base method
+func (m *MockApiServiceClient) SubscribeBlockLog(ctx context.Context, in *pb.SubscribeBlocksRequest, opts ...grpc.CallOption) (pb.ApiService_SubscribeBlockLogClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostListByCreated", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetPostListByCreatedResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeBlockLog", varargs...)
+	ret0, _ := ret[0].(pb.ApiService_SubscribeBlockLogClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPostListByCreated indicates an expected call of GetPostListByCreated
-func (mr *MockApiServiceServerMockRecorder) GetPostListByCreated(arg0, arg1 interface{}) *gomock.Call {
+// SubscribeBlockLog indicates an expected call of SubscribeBlockLog
+func (mr *MockApiServiceClientMockRecorder) SubscribeBlockLog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByCreated", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByCreated), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBlockLog", reflect.TypeOf((*MockApiServiceClient)(nil).SubscribeBlockLog), varargs...)
 }
 
-// GetReplyListByPostId mocks base method
-func (m *MockApiServiceServer) GetReplyListByPostId(arg0 context.Context, arg1 *pb.GetReplyListByPostIdRequest) (*pb.GetReplyListByPostIdResponse, error) {
+// GetContractEvents mocks base method
+func (m *MockApiServiceClient) GetContractEvents(ctx context.Context, in *pb.GetContractEventsRequest, opts ...grpc.CallOption) (*pb.GetContractEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplyListByPostId", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetReplyListByPostIdResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetContractEvents", varargs...)
+	ret0, _ := ret[0].(*pb.GetContractEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReplyListByPostId indicates an expected call of GetReplyListByPostId
-func (mr *MockApiServiceServerMockRecorder) GetReplyListByPostId(arg0, arg1 interface{}) *gomock.Call {
+// GetContractEvents indicates an expected call of GetContractEvents
+func (mr *MockApiServiceClientMockRecorder) GetContractEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplyListByPostId", reflect.TypeOf((*MockApiServiceServer)(nil).GetReplyListByPostId), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockApiServiceClient)(nil).GetContractEvents), varargs...)
 }
 
-// GetBlockTransactionsByNum mocks base method
-func (m *MockApiServiceServer) GetBlockTransactionsByNum(arg0 context.Context, arg1 *pb.GetBlockTransactionsByNumRequest) (*pb.GetBlockTransactionsByNumResponse, error) {
+// GetPendingTrxStats mocks base method
+func (m *MockApiServiceClient) GetPendingTrxStats(ctx context.Context, in *pb.GetPendingTrxStatsRequest, opts ...grpc.CallOption) (*pb.GetPendingTrxStatsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockTransactionsByNum", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockTransactionsByNumResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPendingTrxStats", varargs...)
+	ret0, _ := ret[0].(*pb.GetPendingTrxStatsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlockTransactionsByNum indicates an expected call of GetBlockTransactionsByNum
-func (mr *MockApiServiceServerMockRecorder) GetBlockTransactionsByNum(arg0, arg1 interface{}) *gomock.Call {
+// GetPendingTrxStats indicates an expected call of GetPendingTrxStats
+func (mr *MockApiServiceClientMockRecorder) GetPendingTrxStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTransactionsByNum", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockTransactionsByNum), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTrxStats", reflect.TypeOf((*MockApiServiceClient)(nil).GetPendingTrxStats), varargs...)
 }
 
-// GetChainState mocks base method
-func (m *MockApiServiceServer) GetChainState(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetChainStateResponse, error) {
+// GetEscrowList mocks base method
+func (m *MockApiServiceClient) GetEscrowList(ctx context.Context, in *pb.GetEscrowListRequest, opts ...grpc.CallOption) (*pb.GetEscrowListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChainState", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetChainStateResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEscrowList", varargs...)
+	ret0, _ := ret[0].(*pb.GetEscrowListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetChainState indicates an expected call of GetChainState
-func (mr *MockApiServiceServerMockRecorder) GetChainState(arg0, arg1 interface{}) *gomock.Call {
+// GetEscrowList indicates an expected call of GetEscrowList
+func (mr *MockApiServiceClientMockRecorder) GetEscrowList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainState", reflect.TypeOf((*MockApiServiceServer)(nil).GetChainState), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowList", reflect.TypeOf((*MockApiServiceClient)(nil).GetEscrowList), varargs...)
 }
 
-// BroadcastTrx mocks base method
-func (m *MockApiServiceServer) BroadcastTrx(arg0 context.Context, arg1 *pb.BroadcastTrxRequest) (*pb.BroadcastTrxResponse, error) {
+// GetProposalList mocks base method
+func (m *MockApiServiceClient) GetProposalList(ctx context.Context, in *pb.GetProposalListRequest, opts ...grpc.CallOption) (*pb.GetProposalListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BroadcastTrx", arg0, arg1)
-	ret0, _ := ret[0].(*pb.BroadcastTrxResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProposalList", varargs...)
+	ret0, _ := ret[0].(*pb.GetProposalListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BroadcastTrx indicates an expected call of BroadcastTrx
-func (mr *MockApiServiceServerMockRecorder) BroadcastTrx(arg0, arg1 interface{}) *gomock.Call {
+// GetProposalList indicates an expected call of GetProposalList
+func (mr *MockApiServiceClientMockRecorder) GetProposalList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastTrx", reflect.TypeOf((*MockApiServiceServer)(nil).BroadcastTrx), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalList", reflect.TypeOf((*MockApiServiceClient)(nil).GetProposalList), varargs...)
 }
 
-// GetBlockList mocks base method
-func (m *MockApiServiceServer) GetBlockList(arg0 context.Context, arg1 *pb.GetBlockListRequest) (*pb.GetBlockListResponse, error) {
+// GetProposal mocks base method
+func (m *MockApiServiceClient) GetProposal(ctx context.Context, in *pb.GetProposalRequest, opts ...grpc.CallOption) (*pb.GetProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockList", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockListResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProposal", varargs...)
+	ret0, _ := ret[0].(*pb.GetProposalResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlockList indicates an expected call of GetBlockList
-func (mr *MockApiServiceServerMockRecorder) GetBlockList(arg0, arg1 interface{}) *gomock.Call {
+// GetProposal indicates an expected call of GetProposal
+func (mr *MockApiServiceClientMockRecorder) GetProposal(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockList), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockApiServiceClient)(nil).GetProposal), varargs...)
 }
 
-// GetSignedBlock mocks base method
-func (m *MockApiServiceServer) GetSignedBlock(arg0 context.Context, arg1 *pb.GetSignedBlockRequest) (*pb.GetSignedBlockResponse, error) {
+// GetPostRevisions mocks base method
+func (m *MockApiServiceClient) GetPostRevisions(ctx context.Context, in *pb.GetPostRevisionsRequest, opts ...grpc.CallOption) (*pb.GetPostRevisionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSignedBlock", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetSignedBlockResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPostRevisions", varargs...)
+	ret0, _ := ret[0].(*pb.GetPostRevisionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSignedBlock indicates an expected call of GetSignedBlock
-func (mr *MockApiServiceServerMockRecorder) GetSignedBlock(arg0, arg1 interface{}) *gomock.Call {
+// GetPostRevisions indicates an expected call of GetPostRevisions
+func (mr *MockApiServiceClientMockRecorder) GetPostRevisions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignedBlock", reflect.TypeOf((*MockApiServiceServer)(nil).GetSignedBlock), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisions", reflect.TypeOf((*MockApiServiceClient)(nil).GetPostRevisions), varargs...)
 }
 
-// GetAccountListByBalance mocks base method
-func (m *MockApiServiceServer) GetAccountListByBalance(arg0 context.Context, arg1 *pb.GetAccountListByBalanceRequest) (*pb.GetAccountListResponse, error) {
+// UnbanPeer mocks base method
+func (m *MockApiServiceClient) UnbanPeer(ctx context.Context, in *pb.UnbanPeerRequest, opts ...grpc.CallOption) (*pb.UnbanPeerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountListByBalance", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetAccountListResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnbanPeer", varargs...)
+	ret0, _ := ret[0].(*pb.UnbanPeerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountListByBalance indicates an expected call of GetAccountListByBalance
-func (mr *MockApiServiceServerMockRecorder) GetAccountListByBalance(arg0, arg1 interface{}) *gomock.Call {
+// UnbanPeer indicates an expected call of UnbanPeer
+func (mr *MockApiServiceClientMockRecorder) UnbanPeer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByBalance", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByBalance), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanPeer", reflect.TypeOf((*MockApiServiceClient)(nil).UnbanPeer), varargs...)
 }
 
-// GetDailyTotalTrxInfo mocks base method
-func (m *MockApiServiceServer) GetDailyTotalTrxInfo(arg0 context.Context, arg1 *pb.GetDailyTotalTrxRequest) (*pb.GetDailyTotalTrxResponse, error) {
+// BanPeer mocks base method
+func (m *MockApiServiceClient) BanPeer(ctx context.Context, in *pb.BanPeerRequest, opts ...grpc.CallOption) (*pb.BanPeerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDailyTotalTrxInfo", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetDailyTotalTrxResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BanPeer", varargs...)
+	ret0, _ := ret[0].(*pb.BanPeerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDailyTotalTrxInfo indicates an expected call of GetDailyTotalTrxInfo
-func (mr *MockApiServiceServerMockRecorder) GetDailyTotalTrxInfo(arg0, arg1 interface{}) *gomock.Call {
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockApiServiceClientMockRecorder) BanPeer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyTotalTrxInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetDailyTotalTrxInfo), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockApiServiceClient)(nil).BanPeer), varargs...)
 }
 
-// GetTrxInfoById mocks base method
-func (m *MockApiServiceServer) GetTrxInfoById(arg0 context.Context, arg1 *pb.GetTrxInfoByIdRequest) (*pb.GetTrxInfoByIdResponse, error) {
+// GetPeerReputations mocks base method
+func (m *MockApiServiceClient) GetPeerReputations(ctx context.Context, in *pb.NonParamsRequest, opts ...grpc.CallOption) (*pb.GetPeerReputationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrxInfoById", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetTrxInfoByIdResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPeerReputations", varargs...)
+	ret0, _ := ret[0].(*pb.GetPeerReputationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrxInfoById indicates an expected call of GetTrxInfoById
-func (mr *MockApiServiceServerMockRecorder) GetTrxInfoById(arg0, arg1 interface{}) *gomock.Call {
+// GetPeerReputations indicates an expected call of GetPeerReputations
+func (mr *MockApiServiceClientMockRecorder) GetPeerReputations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxInfoById", reflect.TypeOf((*MockApiServiceServer)(nil).GetTrxInfoById), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerReputations", reflect.TypeOf((*MockApiServiceClient)(nil).GetPeerReputations), varargs...)
 }
 
-// GetTrxListByTime mocks base method
-func (m *MockApiServiceServer) GetTrxListByTime(arg0 context.Context, arg1 *pb.GetTrxListByTimeRequest) (*pb.GetTrxListByTimeResponse, error) {
+// MockApiService_SubscribeBlocksClient is a mock of ApiService_SubscribeBlocksClient interface
+type MockApiService_SubscribeBlocksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeBlocksClientMockRecorder
+}
+
+// MockApiService_SubscribeBlocksClientMockRecorder is the mock recorder for MockApiService_SubscribeBlocksClient
+type MockApiService_SubscribeBlocksClientMockRecorder struct {
+	mock *MockApiService_SubscribeBlocksClient
+}
+
+// NewMockApiService_SubscribeBlocksClient creates a new mock instance
+func NewMockApiService_SubscribeBlocksClient(ctrl *gomock.Controller) *MockApiService_SubscribeBlocksClient {
+	mock := &MockApiService_SubscribeBlocksClient{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeBlocksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeBlocksClient) EXPECT() *MockApiService_SubscribeBlocksClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockApiService_SubscribeBlocksClient) Recv() (*pb.BlockEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrxListByTime", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetTrxListByTimeResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BlockEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrxListByTime indicates an expected call of GetTrxListByTime
-func (mr *MockApiServiceServerMockRecorder) GetTrxListByTime(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetTrxListByTime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).Recv))
 }
 
-// GetPostListByCreateTime mocks base method
-func (m *MockApiServiceServer) GetPostListByCreateTime(arg0 context.Context, arg1 *pb.GetPostListByCreateTimeRequest) (*pb.GetPostListByCreateTimeResponse, error) {
+// Header mocks base method
+func (m *MockApiService_SubscribeBlocksClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostListByCreateTime", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetPostListByCreateTimeResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPostListByCreateTime indicates an expected call of GetPostListByCreateTime
-func (mr *MockApiServiceServerMockRecorder) GetPostListByCreateTime(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByCreateTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByCreateTime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).Header))
 }
 
-// GetPostListByName mocks base method
-func (m *MockApiServiceServer) GetPostListByName(arg0 context.Context, arg1 *pb.GetPostListByNameRequest) (*pb.GetPostListByCreateTimeResponse, error) {
+// Trailer mocks base method
+func (m *MockApiService_SubscribeBlocksClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostListByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetPostListByCreateTimeResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// GetPostListByName indicates an expected call of GetPostListByName
-func (mr *MockApiServiceServerMockRecorder) GetPostListByName(arg0, arg1 interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByName), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).Trailer))
 }
 
-// TrxStatByHour mocks base method
-func (m *MockApiServiceServer) TrxStatByHour(arg0 context.Context, arg1 *pb.TrxStatByHourRequest) (*pb.TrxStatByHourResponse, error) {
+// CloseSend mocks base method
+func (m *MockApiService_SubscribeBlocksClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TrxStatByHour", arg0, arg1)
-	ret0, _ := ret[0].(*pb.TrxStatByHourResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// TrxStatByHour indicates an expected call of TrxStatByHour
-func (mr *MockApiServiceServerMockRecorder) TrxStatByHour(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrxStatByHour", reflect.TypeOf((*MockApiServiceServer)(nil).TrxStatByHour), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).CloseSend))
 }
 
-// GetUserTrxListByTime mocks base method
-func (m *MockApiServiceServer) GetUserTrxListByTime(arg0 context.Context, arg1 *pb.GetUserTrxListByTimeRequest) (*pb.GetUserTrxListByTimeResponse, error) {
+// Context mocks base method
+func (m *MockApiService_SubscribeBlocksClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTrxListByTime", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetUserTrxListByTimeResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// GetUserTrxListByTime indicates an expected call of GetUserTrxListByTime
-func (mr *MockApiServiceServerMockRecorder) GetUserTrxListByTime(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTrxListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetUserTrxListByTime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).Context))
 }
 
-// GetPostInfoById mocks base method
-func (m *MockApiServiceServer) GetPostInfoById(arg0 context.Context, arg1 *pb.GetPostInfoByIdRequest) (*pb.GetPostInfoByIdResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostInfoById", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetPostInfoByIdResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlocksClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPostInfoById indicates an expected call of GetPostInfoById
-func (mr *MockApiServiceServerMockRecorder) GetPostInfoById(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostInfoById", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostInfoById), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).SendMsg), m)
 }
 
-// GetContractInfo mocks base method
-func (m *MockApiServiceServer) GetContractInfo(arg0 context.Context, arg1 *pb.GetContractInfoRequest) (*pb.GetContractInfoResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContractInfo", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetContractInfoResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlocksClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetContractInfo indicates an expected call of GetContractInfo
-func (mr *MockApiServiceServerMockRecorder) GetContractInfo(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeBlocksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractInfo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeBlocksClient)(nil).RecvMsg), m)
 }
 
-// GetBlkIsIrreversibleByTxId mocks base method
-func (m *MockApiServiceServer) GetBlkIsIrreversibleByTxId(arg0 context.Context, arg1 *pb.GetBlkIsIrreversibleByTxIdRequest) (*pb.GetBlkIsIrreversibleByTxIdResponse, error) {
+// MockApiService_SubscribeIrreversibleBlocksClient is a mock of ApiService_SubscribeIrreversibleBlocksClient interface
+type MockApiService_SubscribeIrreversibleBlocksClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder
+}
+
+// MockApiService_SubscribeIrreversibleBlocksClientMockRecorder is the mock recorder for MockApiService_SubscribeIrreversibleBlocksClient
+type MockApiService_SubscribeIrreversibleBlocksClientMockRecorder struct {
+	mock *MockApiService_SubscribeIrreversibleBlocksClient
+}
+
+// NewMockApiService_SubscribeIrreversibleBlocksClient creates a new mock instance
+func NewMockApiService_SubscribeIrreversibleBlocksClient(ctrl *gomock.Controller) *MockApiService_SubscribeIrreversibleBlocksClient {
+	mock := &MockApiService_SubscribeIrreversibleBlocksClient{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeIrreversibleBlocksClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) EXPECT() *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) Recv() (*pb.BlockEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlkIsIrreversibleByTxId", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlkIsIrreversibleByTxIdResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BlockEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlkIsIrreversibleByTxId indicates an expected call of GetBlkIsIrreversibleByTxId
-func (mr *MockApiServiceServerMockRecorder) GetBlkIsIrreversibleByTxId(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlkIsIrreversibleByTxId", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlkIsIrreversibleByTxId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).Recv))
 }
 
-// GetAccountListByCreTime mocks base method
-func (m *MockApiServiceServer) GetAccountListByCreTime(arg0 context.Context, arg1 *pb.GetAccountListByCreTimeRequest) (*pb.GetAccountListResponse, error) {
+// Header mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountListByCreTime", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetAccountListResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountListByCreTime indicates an expected call of GetAccountListByCreTime
-func (mr *MockApiServiceServerMockRecorder) GetAccountListByCreTime(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByCreTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByCreTime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).Header))
 }
 
-// GetDailyStats mocks base method
-func (m *MockApiServiceServer) GetDailyStats(arg0 context.Context, arg1 *pb.GetDailyStatsRequest) (*pb.GetDailyStatsResponse, error) {
+// Trailer mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDailyStats", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetDailyStatsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// GetDailyStats indicates an expected call of GetDailyStats
-func (mr *MockApiServiceServerMockRecorder) GetDailyStats(arg0, arg1 interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetDailyStats), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).Trailer))
 }
 
-// GetContractListByTime mocks base method
-func (m *MockApiServiceServer) GetContractListByTime(arg0 context.Context, arg1 *pb.GetContractListByTimeRequest) (*pb.GetContractListResponse, error) {
+// CloseSend mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContractListByTime", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetContractListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetContractListByTime indicates an expected call of GetContractListByTime
-func (mr *MockApiServiceServerMockRecorder) GetContractListByTime(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractListByTime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).CloseSend))
 }
 
-// GetBlockProducerListByVoteCount mocks base method
-func (m *MockApiServiceServer) GetBlockProducerListByVoteCount(arg0 context.Context, arg1 *pb.GetBlockProducerListByVoteCountRequest) (*pb.GetBlockProducerListResponse, error) {
+// Context mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockProducerListByVoteCount", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockProducerListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// GetBlockProducerListByVoteCount indicates an expected call of GetBlockProducerListByVoteCount
-func (mr *MockApiServiceServerMockRecorder) GetBlockProducerListByVoteCount(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerListByVoteCount", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerListByVoteCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).Context))
 }
 
-// GetPostListByVest mocks base method
-func (m *MockApiServiceServer) GetPostListByVest(arg0 context.Context, arg1 *pb.GetPostListByVestRequest) (*pb.GetPostListByVestResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPostListByVest", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetPostListByVestResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeIrreversibleBlocksClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPostListByVest indicates an expected call of GetPostListByVest
-func (mr *MockApiServiceServerMockRecorder) GetPostListByVest(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByVest", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByVest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).SendMsg), m)
 }
 
-// EstimateStamina mocks base method
-func (m *MockApiServiceServer) EstimateStamina(arg0 context.Context, arg1 *pb.EsimateRequest) (*pb.EsimateResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EstimateStamina", arg0, arg1)
-	ret0, _ := ret[0].(*pb.EsimateResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeIrreversibleBlocksClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EstimateStamina indicates an expected call of EstimateStamina
-func (mr *MockApiServiceServerMockRecorder) EstimateStamina(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeIrreversibleBlocksClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateStamina", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateStamina), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksClient)(nil).RecvMsg), m)
 }
 
-// GetNodeNeighbours mocks base method
-func (m *MockApiServiceServer) GetNodeNeighbours(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetNodeNeighboursResponse, error) {
+// MockApiService_SubscribeTrxResultClient is a mock of ApiService_SubscribeTrxResultClient interface
+type MockApiService_SubscribeTrxResultClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeTrxResultClientMockRecorder
+}
+
+// MockApiService_SubscribeTrxResultClientMockRecorder is the mock recorder for MockApiService_SubscribeTrxResultClient
+type MockApiService_SubscribeTrxResultClientMockRecorder struct {
+	mock *MockApiService_SubscribeTrxResultClient
+}
+
+// NewMockApiService_SubscribeTrxResultClient creates a new mock instance
+func NewMockApiService_SubscribeTrxResultClient(ctrl *gomock.Controller) *MockApiService_SubscribeTrxResultClient {
+	mock := &MockApiService_SubscribeTrxResultClient{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeTrxResultClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeTrxResultClient) EXPECT() *MockApiService_SubscribeTrxResultClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockApiService_SubscribeTrxResultClient) Recv() (*pb.TrxResultEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNodeNeighbours", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetNodeNeighboursResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.TrxResultEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNodeNeighbours indicates an expected call of GetNodeNeighbours
-func (mr *MockApiServiceServerMockRecorder) GetNodeNeighbours(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeNeighbours", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeNeighbours), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).Recv))
 }
 
-// GetMyStakers mocks base method
-func (m *MockApiServiceServer) GetMyStakers(arg0 context.Context, arg1 *pb.GetMyStakerListByNameRequest) (*pb.GetMyStakerListByNameResponse, error) {
+// Header mocks base method
+func (m *MockApiService_SubscribeTrxResultClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMyStakers", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetMyStakerListByNameResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMyStakers indicates an expected call of GetMyStakers
-func (mr *MockApiServiceServerMockRecorder) GetMyStakers(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyStakers", reflect.TypeOf((*MockApiServiceServer)(nil).GetMyStakers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).Header))
 }
 
-// GetMyStakes mocks base method
-func (m *MockApiServiceServer) GetMyStakes(arg0 context.Context, arg1 *pb.GetMyStakeListByNameRequest) (*pb.GetMyStakeListByNameResponse, error) {
+// Trailer mocks base method
+func (m *MockApiService_SubscribeTrxResultClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMyStakes", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetMyStakeListByNameResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// GetMyStakes indicates an expected call of GetMyStakes
-func (mr *MockApiServiceServerMockRecorder) GetMyStakes(arg0, arg1 interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyStakes", reflect.TypeOf((*MockApiServiceServer)(nil).GetMyStakes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).Trailer))
 }
 
-// GetNodeRunningVersion mocks base method
-func (m *MockApiServiceServer) GetNodeRunningVersion(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetNodeRunningVersionResponse, error) {
+// CloseSend mocks base method
+func (m *MockApiService_SubscribeTrxResultClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNodeRunningVersion", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetNodeRunningVersionResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetNodeRunningVersion indicates an expected call of GetNodeRunningVersion
-func (mr *MockApiServiceServerMockRecorder) GetNodeRunningVersion(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeRunningVersion", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeRunningVersion), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).CloseSend))
 }
 
-// GetAccountListByVest mocks base method
-func (m *MockApiServiceServer) GetAccountListByVest(arg0 context.Context, arg1 *pb.GetAccountListByVestRequest) (*pb.GetAccountListResponse, error) {
+// Context mocks base method
+func (m *MockApiService_SubscribeTrxResultClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountListByVest", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetAccountListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// GetAccountListByVest indicates an expected call of GetAccountListByVest
-func (mr *MockApiServiceServerMockRecorder) GetAccountListByVest(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByVest", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByVest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).Context))
 }
 
-// GetBlockProducerByName mocks base method
-func (m *MockApiServiceServer) GetBlockProducerByName(arg0 context.Context, arg1 *pb.GetBlockProducerByNameRequest) (*pb.BlockProducerResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockProducerByName", arg0, arg1)
-	ret0, _ := ret[0].(*pb.BlockProducerResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeTrxResultClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetBlockProducerByName indicates an expected call of GetBlockProducerByName
-func (mr *MockApiServiceServerMockRecorder) GetBlockProducerByName(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerByName), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).SendMsg), m)
 }
 
-// GetAccountByPubKey mocks base method
-func (m *MockApiServiceServer) GetAccountByPubKey(arg0 context.Context, arg1 *pb.GetAccountByPubKeyRequest) (*pb.AccountResponse, error) {
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeTrxResultClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeTrxResultClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeTrxResultClient)(nil).RecvMsg), m)
+}
+
+// MockApiService_SubscribeBlockLogClient is a mock of ApiService_SubscribeBlockLogClient interface
+type MockApiService_SubscribeBlockLogClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeBlockLogClientMockRecorder
+}
+
+// MockApiService_SubscribeBlockLogClientMockRecorder is the mock recorder for MockApiService_SubscribeBlockLogClient
+type MockApiService_SubscribeBlockLogClientMockRecorder struct {
+	mock *MockApiService_SubscribeBlockLogClient
+}
+
+// NewMockApiService_SubscribeBlockLogClient creates a new mock instance
+func NewMockApiService_SubscribeBlockLogClient(ctrl *gomock.Controller) *MockApiService_SubscribeBlockLogClient {
+	mock := &MockApiService_SubscribeBlockLogClient{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeBlockLogClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeBlockLogClient) EXPECT() *MockApiService_SubscribeBlockLogClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockApiService_SubscribeBlockLogClient) Recv() (*pb.BlockLogEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountByPubKey", arg0, arg1)
-	ret0, _ := ret[0].(*pb.AccountResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BlockLogEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountByPubKey indicates an expected call of GetAccountByPubKey
-func (mr *MockApiServiceServerMockRecorder) GetAccountByPubKey(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByPubKey", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountByPubKey), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).Recv))
 }
 
-// GetBlockBFTInfoByNum mocks base method
-func (m *MockApiServiceServer) GetBlockBFTInfoByNum(arg0 context.Context, arg1 *pb.GetBlockBFTInfoByNumRequest) (*pb.GetBlockBFTInfoByNumResponse, error) {
+// Header mocks base method
+func (m *MockApiService_SubscribeBlockLogClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockBFTInfoByNum", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockBFTInfoByNumResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBlockBFTInfoByNum indicates an expected call of GetBlockBFTInfoByNum
-func (mr *MockApiServiceServerMockRecorder) GetBlockBFTInfoByNum(arg0, arg1 interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBFTInfoByNum", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockBFTInfoByNum), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).Header))
 }
 
-// GetAppTableRecord mocks base method
-func (m *MockApiServiceServer) GetAppTableRecord(arg0 context.Context, arg1 *pb.GetAppTableRecordRequest) (*pb.GetAppTableRecordResponse, error) {
+// Trailer mocks base method
+func (m *MockApiService_SubscribeBlockLogClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppTableRecord", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetAppTableRecordResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// GetAppTableRecord indicates an expected call of GetAppTableRecord
-func (mr *MockApiServiceServerMockRecorder) GetAppTableRecord(arg0, arg1 interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppTableRecord", reflect.TypeOf((*MockApiServiceServer)(nil).GetAppTableRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).Trailer))
 }
 
-// GetBlockProducerVoterList mocks base method
-func (m *MockApiServiceServer) GetBlockProducerVoterList(arg0 context.Context, arg1 *pb.GetBlockProducerVoterListRequest) (*pb.GetBlockProducerVoterListResponse, error) {
+// CloseSend mocks base method
+func (m *MockApiService_SubscribeBlockLogClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBlockProducerVoterList", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetBlockProducerVoterListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetBlockProducerVoterList indicates an expected call of GetBlockProducerVoterList
-func (mr *MockApiServiceServerMockRecorder) GetBlockProducerVoterList(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerVoterList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerVoterList), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).CloseSend))
 }
 
-// GetVestDelegationOrderList mocks base method
-func (m *MockApiServiceServer) GetVestDelegationOrderList(arg0 context.Context, arg1 *pb.GetVestDelegationOrderListRequest) (*pb.GetVestDelegationOrderListResponse, error) {
+// Context mocks base method
+func (m *MockApiService_SubscribeBlockLogClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVestDelegationOrderList", arg0, arg1)
-	ret0, _ := ret[0].(*pb.GetVestDelegationOrderListResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// GetVestDelegationOrderList indicates an expected call of GetVestDelegationOrderList
-func (mr *MockApiServiceServerMockRecorder) GetVestDelegationOrderList(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVestDelegationOrderList", reflect.TypeOf((*MockApiServiceServer)(nil).GetVestDelegationOrderList), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlockLogClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlockLogClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeBlockLogClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeBlockLogClient)(nil).RecvMsg), m)
+}
+
+// MockApiServiceServer is a mock of ApiServiceServer interface
+type MockApiServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiServiceServerMockRecorder
+}
+
+// MockApiServiceServerMockRecorder is the mock recorder for MockApiServiceServer
+type MockApiServiceServerMockRecorder struct {
+	mock *MockApiServiceServer
+}
+
+// NewMockApiServiceServer creates a new mock instance
+func NewMockApiServiceServer(ctrl *gomock.Controller) *MockApiServiceServer {
+	mock := &MockApiServiceServer{ctrl: ctrl}
+	mock.recorder = &MockApiServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiServiceServer) EXPECT() *MockApiServiceServerMockRecorder {
+	return m.recorder
+}
+
+// QueryTableContent mocks base method
+func (m *MockApiServiceServer) QueryTableContent(arg0 context.Context, arg1 *pb.GetTableContentRequest) (*pb.TableContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTableContent", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TableContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTableContent indicates an expected call of QueryTableContent
+func (mr *MockApiServiceServerMockRecorder) QueryTableContent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTableContent", reflect.TypeOf((*MockApiServiceServer)(nil).QueryTableContent), arg0, arg1)
+}
+
+// GetAccountByName mocks base method
+func (m *MockApiServiceServer) GetAccountByName(arg0 context.Context, arg1 *pb.GetAccountByNameRequest) (*pb.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByName indicates an expected call of GetAccountByName
+func (mr *MockApiServiceServerMockRecorder) GetAccountByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountByName), arg0, arg1)
+}
+
+// GetFollowerListByName mocks base method
+func (m *MockApiServiceServer) GetFollowerListByName(arg0 context.Context, arg1 *pb.GetFollowerListByNameRequest) (*pb.GetFollowerListByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowerListByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetFollowerListByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowerListByName indicates an expected call of GetFollowerListByName
+func (mr *MockApiServiceServerMockRecorder) GetFollowerListByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowerListByName), arg0, arg1)
+}
+
+// GetFollowingListByName mocks base method
+func (m *MockApiServiceServer) GetFollowingListByName(arg0 context.Context, arg1 *pb.GetFollowingListByNameRequest) (*pb.GetFollowingListByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowingListByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetFollowingListByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowingListByName indicates an expected call of GetFollowingListByName
+func (mr *MockApiServiceServerMockRecorder) GetFollowingListByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowingListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowingListByName), arg0, arg1)
+}
+
+// GetFollowCountByName mocks base method
+func (m *MockApiServiceServer) GetFollowCountByName(arg0 context.Context, arg1 *pb.GetFollowCountByNameRequest) (*pb.GetFollowCountByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCountByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetFollowCountByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCountByName indicates an expected call of GetFollowCountByName
+func (mr *MockApiServiceServerMockRecorder) GetFollowCountByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCountByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetFollowCountByName), arg0, arg1)
+}
+
+// GetBlockProducerList mocks base method
+func (m *MockApiServiceServer) GetBlockProducerList(arg0 context.Context, arg1 *pb.GetBlockProducerListRequest) (*pb.GetBlockProducerListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockProducerList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockProducerListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducerList indicates an expected call of GetBlockProducerList
+func (mr *MockApiServiceServerMockRecorder) GetBlockProducerList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerList), arg0, arg1)
+}
+
+// GetPostListByCreated mocks base method
+func (m *MockApiServiceServer) GetPostListByCreated(arg0 context.Context, arg1 *pb.GetPostListByCreatedRequest) (*pb.GetPostListByCreatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostListByCreated", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostListByCreatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostListByCreated indicates an expected call of GetPostListByCreated
+func (mr *MockApiServiceServerMockRecorder) GetPostListByCreated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByCreated", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByCreated), arg0, arg1)
+}
+
+// GetReplyListByPostId mocks base method
+func (m *MockApiServiceServer) GetReplyListByPostId(arg0 context.Context, arg1 *pb.GetReplyListByPostIdRequest) (*pb.GetReplyListByPostIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplyListByPostId", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetReplyListByPostIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplyListByPostId indicates an expected call of GetReplyListByPostId
+func (mr *MockApiServiceServerMockRecorder) GetReplyListByPostId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplyListByPostId", reflect.TypeOf((*MockApiServiceServer)(nil).GetReplyListByPostId), arg0, arg1)
+}
+
+// GetBlockTransactionsByNum mocks base method
+func (m *MockApiServiceServer) GetBlockTransactionsByNum(arg0 context.Context, arg1 *pb.GetBlockTransactionsByNumRequest) (*pb.GetBlockTransactionsByNumResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockTransactionsByNum", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockTransactionsByNumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockTransactionsByNum indicates an expected call of GetBlockTransactionsByNum
+func (mr *MockApiServiceServerMockRecorder) GetBlockTransactionsByNum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTransactionsByNum", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockTransactionsByNum), arg0, arg1)
+}
+
+// GetChainState mocks base method
+func (m *MockApiServiceServer) GetChainState(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetChainStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainState", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetChainStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainState indicates an expected call of GetChainState
+func (mr *MockApiServiceServerMockRecorder) GetChainState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainState", reflect.TypeOf((*MockApiServiceServer)(nil).GetChainState), arg0, arg1)
+}
+
+// BroadcastTrx mocks base method
+func (m *MockApiServiceServer) BroadcastTrx(arg0 context.Context, arg1 *pb.BroadcastTrxRequest) (*pb.BroadcastTrxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastTrx", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BroadcastTrxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BroadcastTrx indicates an expected call of BroadcastTrx
+func (mr *MockApiServiceServerMockRecorder) BroadcastTrx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastTrx", reflect.TypeOf((*MockApiServiceServer)(nil).BroadcastTrx), arg0, arg1)
+}
+
+// GetBlockList mocks base method
+func (m *MockApiServiceServer) GetBlockList(arg0 context.Context, arg1 *pb.GetBlockListRequest) (*pb.GetBlockListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockList indicates an expected call of GetBlockList
+func (mr *MockApiServiceServerMockRecorder) GetBlockList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockList), arg0, arg1)
+}
+
+// GetSignedBlock mocks base method
+func (m *MockApiServiceServer) GetSignedBlock(arg0 context.Context, arg1 *pb.GetSignedBlockRequest) (*pb.GetSignedBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignedBlock", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetSignedBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignedBlock indicates an expected call of GetSignedBlock
+func (mr *MockApiServiceServerMockRecorder) GetSignedBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignedBlock", reflect.TypeOf((*MockApiServiceServer)(nil).GetSignedBlock), arg0, arg1)
+}
+
+// GetAccountListByBalance mocks base method
+func (m *MockApiServiceServer) GetAccountListByBalance(arg0 context.Context, arg1 *pb.GetAccountListByBalanceRequest) (*pb.GetAccountListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountListByBalance", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountListByBalance indicates an expected call of GetAccountListByBalance
+func (mr *MockApiServiceServerMockRecorder) GetAccountListByBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByBalance", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByBalance), arg0, arg1)
+}
+
+// GetDailyTotalTrxInfo mocks base method
+func (m *MockApiServiceServer) GetDailyTotalTrxInfo(arg0 context.Context, arg1 *pb.GetDailyTotalTrxRequest) (*pb.GetDailyTotalTrxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyTotalTrxInfo", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetDailyTotalTrxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyTotalTrxInfo indicates an expected call of GetDailyTotalTrxInfo
+func (mr *MockApiServiceServerMockRecorder) GetDailyTotalTrxInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyTotalTrxInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetDailyTotalTrxInfo), arg0, arg1)
+}
+
+// GetTrxInfoById mocks base method
+func (m *MockApiServiceServer) GetTrxInfoById(arg0 context.Context, arg1 *pb.GetTrxInfoByIdRequest) (*pb.GetTrxInfoByIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrxInfoById", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTrxInfoByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrxInfoById indicates an expected call of GetTrxInfoById
+func (mr *MockApiServiceServerMockRecorder) GetTrxInfoById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxInfoById", reflect.TypeOf((*MockApiServiceServer)(nil).GetTrxInfoById), arg0, arg1)
+}
+
+// GetTrxListByTime mocks base method
+func (m *MockApiServiceServer) GetTrxListByTime(arg0 context.Context, arg1 *pb.GetTrxListByTimeRequest) (*pb.GetTrxListByTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrxListByTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTrxListByTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrxListByTime indicates an expected call of GetTrxListByTime
+func (mr *MockApiServiceServerMockRecorder) GetTrxListByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetTrxListByTime), arg0, arg1)
+}
+
+// GetPostListByCreateTime mocks base method
+func (m *MockApiServiceServer) GetPostListByCreateTime(arg0 context.Context, arg1 *pb.GetPostListByCreateTimeRequest) (*pb.GetPostListByCreateTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostListByCreateTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostListByCreateTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostListByCreateTime indicates an expected call of GetPostListByCreateTime
+func (mr *MockApiServiceServerMockRecorder) GetPostListByCreateTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByCreateTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByCreateTime), arg0, arg1)
+}
+
+// GetPostListByName mocks base method
+func (m *MockApiServiceServer) GetPostListByName(arg0 context.Context, arg1 *pb.GetPostListByNameRequest) (*pb.GetPostListByCreateTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostListByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostListByCreateTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostListByName indicates an expected call of GetPostListByName
+func (mr *MockApiServiceServerMockRecorder) GetPostListByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByName), arg0, arg1)
+}
+
+// TrxStatByHour mocks base method
+func (m *MockApiServiceServer) TrxStatByHour(arg0 context.Context, arg1 *pb.TrxStatByHourRequest) (*pb.TrxStatByHourResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrxStatByHour", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TrxStatByHourResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrxStatByHour indicates an expected call of TrxStatByHour
+func (mr *MockApiServiceServerMockRecorder) TrxStatByHour(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrxStatByHour", reflect.TypeOf((*MockApiServiceServer)(nil).TrxStatByHour), arg0, arg1)
+}
+
+// GetUserTrxListByTime mocks base method
+func (m *MockApiServiceServer) GetUserTrxListByTime(arg0 context.Context, arg1 *pb.GetUserTrxListByTimeRequest) (*pb.GetUserTrxListByTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTrxListByTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetUserTrxListByTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTrxListByTime indicates an expected call of GetUserTrxListByTime
+func (mr *MockApiServiceServerMockRecorder) GetUserTrxListByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTrxListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetUserTrxListByTime), arg0, arg1)
+}
+
+// GetPostInfoById mocks base method
+func (m *MockApiServiceServer) GetPostInfoById(arg0 context.Context, arg1 *pb.GetPostInfoByIdRequest) (*pb.GetPostInfoByIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostInfoById", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostInfoByIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostInfoById indicates an expected call of GetPostInfoById
+func (mr *MockApiServiceServerMockRecorder) GetPostInfoById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostInfoById", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostInfoById), arg0, arg1)
+}
+
+// GetContractInfo mocks base method
+func (m *MockApiServiceServer) GetContractInfo(arg0 context.Context, arg1 *pb.GetContractInfoRequest) (*pb.GetContractInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractInfo", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetContractInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractInfo indicates an expected call of GetContractInfo
+func (mr *MockApiServiceServerMockRecorder) GetContractInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractInfo), arg0, arg1)
+}
+
+// GetBlkIsIrreversibleByTxId mocks base method
+func (m *MockApiServiceServer) GetBlkIsIrreversibleByTxId(arg0 context.Context, arg1 *pb.GetBlkIsIrreversibleByTxIdRequest) (*pb.GetBlkIsIrreversibleByTxIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlkIsIrreversibleByTxId", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlkIsIrreversibleByTxIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlkIsIrreversibleByTxId indicates an expected call of GetBlkIsIrreversibleByTxId
+func (mr *MockApiServiceServerMockRecorder) GetBlkIsIrreversibleByTxId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlkIsIrreversibleByTxId", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlkIsIrreversibleByTxId), arg0, arg1)
+}
+
+// GetAccountListByCreTime mocks base method
+func (m *MockApiServiceServer) GetAccountListByCreTime(arg0 context.Context, arg1 *pb.GetAccountListByCreTimeRequest) (*pb.GetAccountListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountListByCreTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountListByCreTime indicates an expected call of GetAccountListByCreTime
+func (mr *MockApiServiceServerMockRecorder) GetAccountListByCreTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByCreTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByCreTime), arg0, arg1)
+}
+
+// GetDailyStats mocks base method
+func (m *MockApiServiceServer) GetDailyStats(arg0 context.Context, arg1 *pb.GetDailyStatsRequest) (*pb.GetDailyStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetDailyStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyStats indicates an expected call of GetDailyStats
+func (mr *MockApiServiceServerMockRecorder) GetDailyStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetDailyStats), arg0, arg1)
+}
+
+// GetContractListByTime mocks base method
+func (m *MockApiServiceServer) GetContractListByTime(arg0 context.Context, arg1 *pb.GetContractListByTimeRequest) (*pb.GetContractListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractListByTime", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetContractListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractListByTime indicates an expected call of GetContractListByTime
+func (mr *MockApiServiceServerMockRecorder) GetContractListByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractListByTime", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractListByTime), arg0, arg1)
+}
+
+// GetBlockProducerListByVoteCount mocks base method
+func (m *MockApiServiceServer) GetBlockProducerListByVoteCount(arg0 context.Context, arg1 *pb.GetBlockProducerListByVoteCountRequest) (*pb.GetBlockProducerListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockProducerListByVoteCount", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockProducerListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducerListByVoteCount indicates an expected call of GetBlockProducerListByVoteCount
+func (mr *MockApiServiceServerMockRecorder) GetBlockProducerListByVoteCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerListByVoteCount", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerListByVoteCount), arg0, arg1)
+}
+
+// GetPostListByVest mocks base method
+func (m *MockApiServiceServer) GetPostListByVest(arg0 context.Context, arg1 *pb.GetPostListByVestRequest) (*pb.GetPostListByVestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostListByVest", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostListByVestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostListByVest indicates an expected call of GetPostListByVest
+func (mr *MockApiServiceServerMockRecorder) GetPostListByVest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostListByVest", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostListByVest), arg0, arg1)
+}
+
+// EstimateStamina mocks base method
+func (m *MockApiServiceServer) EstimateStamina(arg0 context.Context, arg1 *pb.EsimateRequest) (*pb.EsimateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateStamina", arg0, arg1)
+	ret0, _ := ret[0].(*pb.EsimateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateStamina indicates an expected call of EstimateStamina
+func (mr *MockApiServiceServerMockRecorder) EstimateStamina(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateStamina", reflect.TypeOf((*MockApiServiceServer)(nil).EstimateStamina), arg0, arg1)
+}
+
+// GetNodeNeighbours mocks base method
+func (m *MockApiServiceServer) GetNodeNeighbours(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetNodeNeighboursResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeNeighbours", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetNodeNeighboursResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeNeighbours indicates an expected call of GetNodeNeighbours
+func (mr *MockApiServiceServerMockRecorder) GetNodeNeighbours(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeNeighbours", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeNeighbours), arg0, arg1)
+}
+
+// GetMyStakers mocks base method
+func (m *MockApiServiceServer) GetMyStakers(arg0 context.Context, arg1 *pb.GetMyStakerListByNameRequest) (*pb.GetMyStakerListByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyStakers", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetMyStakerListByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyStakers indicates an expected call of GetMyStakers
+func (mr *MockApiServiceServerMockRecorder) GetMyStakers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyStakers", reflect.TypeOf((*MockApiServiceServer)(nil).GetMyStakers), arg0, arg1)
+}
+
+// GetMyStakes mocks base method
+func (m *MockApiServiceServer) GetMyStakes(arg0 context.Context, arg1 *pb.GetMyStakeListByNameRequest) (*pb.GetMyStakeListByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyStakes", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetMyStakeListByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyStakes indicates an expected call of GetMyStakes
+func (mr *MockApiServiceServerMockRecorder) GetMyStakes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyStakes", reflect.TypeOf((*MockApiServiceServer)(nil).GetMyStakes), arg0, arg1)
+}
+
+// GetNodeRunningVersion mocks base method
+func (m *MockApiServiceServer) GetNodeRunningVersion(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetNodeRunningVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeRunningVersion", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetNodeRunningVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeRunningVersion indicates an expected call of GetNodeRunningVersion
+func (mr *MockApiServiceServerMockRecorder) GetNodeRunningVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeRunningVersion", reflect.TypeOf((*MockApiServiceServer)(nil).GetNodeRunningVersion), arg0, arg1)
+}
+
+// GetAccountListByVest mocks base method
+func (m *MockApiServiceServer) GetAccountListByVest(arg0 context.Context, arg1 *pb.GetAccountListByVestRequest) (*pb.GetAccountListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountListByVest", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountListByVest indicates an expected call of GetAccountListByVest
+func (mr *MockApiServiceServerMockRecorder) GetAccountListByVest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountListByVest", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountListByVest), arg0, arg1)
+}
+
+// GetBlockProducerByName mocks base method
+func (m *MockApiServiceServer) GetBlockProducerByName(arg0 context.Context, arg1 *pb.GetBlockProducerByNameRequest) (*pb.BlockProducerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockProducerByName", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BlockProducerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducerByName indicates an expected call of GetBlockProducerByName
+func (mr *MockApiServiceServerMockRecorder) GetBlockProducerByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerByName", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerByName), arg0, arg1)
+}
+
+// GetAccountByPubKey mocks base method
+func (m *MockApiServiceServer) GetAccountByPubKey(arg0 context.Context, arg1 *pb.GetAccountByPubKeyRequest) (*pb.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByPubKey", arg0, arg1)
+	ret0, _ := ret[0].(*pb.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByPubKey indicates an expected call of GetAccountByPubKey
+func (mr *MockApiServiceServerMockRecorder) GetAccountByPubKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByPubKey", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountByPubKey), arg0, arg1)
+}
+
+// GetBlockBFTInfoByNum mocks base method
+func (m *MockApiServiceServer) GetBlockBFTInfoByNum(arg0 context.Context, arg1 *pb.GetBlockBFTInfoByNumRequest) (*pb.GetBlockBFTInfoByNumResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockBFTInfoByNum", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockBFTInfoByNumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockBFTInfoByNum indicates an expected call of GetBlockBFTInfoByNum
+func (mr *MockApiServiceServerMockRecorder) GetBlockBFTInfoByNum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBFTInfoByNum", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockBFTInfoByNum), arg0, arg1)
+}
+
+// GetAppTableRecord mocks base method
+func (m *MockApiServiceServer) GetAppTableRecord(arg0 context.Context, arg1 *pb.GetAppTableRecordRequest) (*pb.GetAppTableRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppTableRecord", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAppTableRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppTableRecord indicates an expected call of GetAppTableRecord
+func (mr *MockApiServiceServerMockRecorder) GetAppTableRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppTableRecord", reflect.TypeOf((*MockApiServiceServer)(nil).GetAppTableRecord), arg0, arg1)
+}
+
+// GetBlockProducerVoterList mocks base method
+func (m *MockApiServiceServer) GetBlockProducerVoterList(arg0 context.Context, arg1 *pb.GetBlockProducerVoterListRequest) (*pb.GetBlockProducerVoterListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockProducerVoterList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockProducerVoterListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockProducerVoterList indicates an expected call of GetBlockProducerVoterList
+func (mr *MockApiServiceServerMockRecorder) GetBlockProducerVoterList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockProducerVoterList", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockProducerVoterList), arg0, arg1)
+}
+
+// GetVestDelegationOrderList mocks base method
+func (m *MockApiServiceServer) GetVestDelegationOrderList(arg0 context.Context, arg1 *pb.GetVestDelegationOrderListRequest) (*pb.GetVestDelegationOrderListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVestDelegationOrderList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetVestDelegationOrderListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVestDelegationOrderList indicates an expected call of GetVestDelegationOrderList
+func (mr *MockApiServiceServerMockRecorder) GetVestDelegationOrderList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVestDelegationOrderList", reflect.TypeOf((*MockApiServiceServer)(nil).GetVestDelegationOrderList), arg0, arg1)
+}
+
+// GetAccountProof mocks base method
+func (m *MockApiServiceServer) GetAccountProof(arg0 context.Context, arg1 *pb.GetAccountProofRequest) (*pb.StateProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.StateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountProof indicates an expected call of GetAccountProof
+func (mr *MockApiServiceServerMockRecorder) GetAccountProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountProof), arg0, arg1)
+}
+
+// GetPostProof mocks base method
+func (m *MockApiServiceServer) GetPostProof(arg0 context.Context, arg1 *pb.GetPostProofRequest) (*pb.StateProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.StateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostProof indicates an expected call of GetPostProof
+func (mr *MockApiServiceServerMockRecorder) GetPostProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostProof), arg0, arg1)
+}
+
+// GetTrxInclusionProof mocks base method
+func (m *MockApiServiceServer) GetTrxInclusionProof(arg0 context.Context, arg1 *pb.GetTrxInclusionProofRequest) (*pb.GetTrxInclusionProofResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrxInclusionProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTrxInclusionProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrxInclusionProof indicates an expected call of GetTrxInclusionProof
+func (mr *MockApiServiceServerMockRecorder) GetTrxInclusionProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrxInclusionProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTrxInclusionProof), arg0, arg1)
+}
+
+// GetBlockHeaderWithCommit mocks base method
+func (m *MockApiServiceServer) GetBlockHeaderWithCommit(arg0 context.Context, arg1 *pb.GetBlockHeaderWithCommitRequest) (*pb.GetBlockHeaderWithCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHeaderWithCommit", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetBlockHeaderWithCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeaderWithCommit indicates an expected call of GetBlockHeaderWithCommit
+func (mr *MockApiServiceServerMockRecorder) GetBlockHeaderWithCommit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeaderWithCommit", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockHeaderWithCommit), arg0, arg1)
+}
+
+// SubscribeBlocks mocks base method
+func (m *MockApiServiceServer) SubscribeBlocks(arg0 *pb.SubscribeBlocksRequest, arg1 pb.ApiService_SubscribeBlocksServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeBlocks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeBlocks indicates an expected call of SubscribeBlocks
+func (mr *MockApiServiceServerMockRecorder) SubscribeBlocks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBlocks", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribeBlocks), arg0, arg1)
+}
+
+// SubscribeIrreversibleBlocks mocks base method
+func (m *MockApiServiceServer) SubscribeIrreversibleBlocks(arg0 *pb.SubscribeBlocksRequest, arg1 pb.ApiService_SubscribeIrreversibleBlocksServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeIrreversibleBlocks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeIrreversibleBlocks indicates an expected call of SubscribeIrreversibleBlocks
+func (mr *MockApiServiceServerMockRecorder) SubscribeIrreversibleBlocks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIrreversibleBlocks", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribeIrreversibleBlocks), arg0, arg1)
+}
+
+// SubscribeTrxResult mocks base method
+func (m *MockApiServiceServer) SubscribeTrxResult(arg0 *pb.SubscribeTrxResultRequest, arg1 pb.ApiService_SubscribeTrxResultServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeTrxResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeTrxResult indicates an expected call of SubscribeTrxResult
+func (mr *MockApiServiceServerMockRecorder) SubscribeTrxResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeTrxResult", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribeTrxResult), arg0, arg1)
+}
+
+// SubscribeBlockLog mocks base method
+func (m *MockApiServiceServer) SubscribeBlockLog(arg0 *pb.SubscribeBlocksRequest, arg1 pb.ApiService_SubscribeBlockLogServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeBlockLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeBlockLog indicates an expected call of SubscribeBlockLog
+func (mr *MockApiServiceServerMockRecorder) SubscribeBlockLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeBlockLog", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribeBlockLog), arg0, arg1)
+}
+
+// GetContractEvents mocks base method
+func (m *MockApiServiceServer) GetContractEvents(arg0 context.Context, arg1 *pb.GetContractEventsRequest) (*pb.GetContractEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractEvents", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetContractEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractEvents indicates an expected call of GetContractEvents
+func (mr *MockApiServiceServerMockRecorder) GetContractEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractEvents), arg0, arg1)
+}
+
+// GetPendingTrxStats mocks base method
+func (m *MockApiServiceServer) GetPendingTrxStats(arg0 context.Context, arg1 *pb.GetPendingTrxStatsRequest) (*pb.GetPendingTrxStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingTrxStats", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPendingTrxStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTrxStats indicates an expected call of GetPendingTrxStats
+func (mr *MockApiServiceServerMockRecorder) GetPendingTrxStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTrxStats", reflect.TypeOf((*MockApiServiceServer)(nil).GetPendingTrxStats), arg0, arg1)
+}
+
+// GetEscrowList mocks base method
+func (m *MockApiServiceServer) GetEscrowList(arg0 context.Context, arg1 *pb.GetEscrowListRequest) (*pb.GetEscrowListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrowList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetEscrowListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrowList indicates an expected call of GetEscrowList
+func (mr *MockApiServiceServerMockRecorder) GetEscrowList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowList", reflect.TypeOf((*MockApiServiceServer)(nil).GetEscrowList), arg0, arg1)
+}
+
+// GetProposalList mocks base method
+func (m *MockApiServiceServer) GetProposalList(arg0 context.Context, arg1 *pb.GetProposalListRequest) (*pb.GetProposalListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalList", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetProposalListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalList indicates an expected call of GetProposalList
+func (mr *MockApiServiceServerMockRecorder) GetProposalList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalList", reflect.TypeOf((*MockApiServiceServer)(nil).GetProposalList), arg0, arg1)
+}
+
+// GetProposal mocks base method
+func (m *MockApiServiceServer) GetProposal(arg0 context.Context, arg1 *pb.GetProposalRequest) (*pb.GetProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal
+func (mr *MockApiServiceServerMockRecorder) GetProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockApiServiceServer)(nil).GetProposal), arg0, arg1)
+}
+
+// GetPostRevisions mocks base method
+func (m *MockApiServiceServer) GetPostRevisions(arg0 context.Context, arg1 *pb.GetPostRevisionsRequest) (*pb.GetPostRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPostRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostRevisions indicates an expected call of GetPostRevisions
+func (mr *MockApiServiceServerMockRecorder) GetPostRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisions", reflect.TypeOf((*MockApiServiceServer)(nil).GetPostRevisions), arg0, arg1)
+}
+
+// UnbanPeer mocks base method
+func (m *MockApiServiceServer) UnbanPeer(arg0 context.Context, arg1 *pb.UnbanPeerRequest) (*pb.UnbanPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanPeer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UnbanPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanPeer indicates an expected call of UnbanPeer
+func (mr *MockApiServiceServerMockRecorder) UnbanPeer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanPeer", reflect.TypeOf((*MockApiServiceServer)(nil).UnbanPeer), arg0, arg1)
+}
+
+// BanPeer mocks base method
+func (m *MockApiServiceServer) BanPeer(arg0 context.Context, arg1 *pb.BanPeerRequest) (*pb.BanPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanPeer", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BanPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockApiServiceServerMockRecorder) BanPeer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockApiServiceServer)(nil).BanPeer), arg0, arg1)
+}
+
+// GetPeerReputations mocks base method
+func (m *MockApiServiceServer) GetPeerReputations(arg0 context.Context, arg1 *pb.NonParamsRequest) (*pb.GetPeerReputationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeerReputations", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetPeerReputationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeerReputations indicates an expected call of GetPeerReputations
+func (mr *MockApiServiceServerMockRecorder) GetPeerReputations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerReputations", reflect.TypeOf((*MockApiServiceServer)(nil).GetPeerReputations), arg0, arg1)
+}
+
+// MockApiService_SubscribeBlocksServer is a mock of ApiService_SubscribeBlocksServer interface
+type MockApiService_SubscribeBlocksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeBlocksServerMockRecorder
+}
+
+// MockApiService_SubscribeBlocksServerMockRecorder is the mock recorder for MockApiService_SubscribeBlocksServer
+type MockApiService_SubscribeBlocksServerMockRecorder struct {
+	mock *MockApiService_SubscribeBlocksServer
+}
+
+// NewMockApiService_SubscribeBlocksServer creates a new mock instance
+func NewMockApiService_SubscribeBlocksServer(ctrl *gomock.Controller) *MockApiService_SubscribeBlocksServer {
+	mock := &MockApiService_SubscribeBlocksServer{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeBlocksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeBlocksServer) EXPECT() *MockApiService_SubscribeBlocksServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockApiService_SubscribeBlocksServer) Send(arg0 *pb.BlockEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockApiService_SubscribeBlocksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockApiService_SubscribeBlocksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockApiService_SubscribeBlocksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockApiService_SubscribeBlocksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlocksServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlocksServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeBlocksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeBlocksServer)(nil).RecvMsg), m)
+}
+
+// MockApiService_SubscribeIrreversibleBlocksServer is a mock of ApiService_SubscribeIrreversibleBlocksServer interface
+type MockApiService_SubscribeIrreversibleBlocksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder
+}
+
+// MockApiService_SubscribeIrreversibleBlocksServerMockRecorder is the mock recorder for MockApiService_SubscribeIrreversibleBlocksServer
+type MockApiService_SubscribeIrreversibleBlocksServerMockRecorder struct {
+	mock *MockApiService_SubscribeIrreversibleBlocksServer
+}
+
+// NewMockApiService_SubscribeIrreversibleBlocksServer creates a new mock instance
+func NewMockApiService_SubscribeIrreversibleBlocksServer(ctrl *gomock.Controller) *MockApiService_SubscribeIrreversibleBlocksServer {
+	mock := &MockApiService_SubscribeIrreversibleBlocksServer{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeIrreversibleBlocksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) EXPECT() *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) Send(arg0 *pb.BlockEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockApiService_SubscribeIrreversibleBlocksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeIrreversibleBlocksServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeIrreversibleBlocksServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeIrreversibleBlocksServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeIrreversibleBlocksServer)(nil).RecvMsg), m)
+}
+
+// MockApiService_SubscribeTrxResultServer is a mock of ApiService_SubscribeTrxResultServer interface
+type MockApiService_SubscribeTrxResultServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeTrxResultServerMockRecorder
+}
+
+// MockApiService_SubscribeTrxResultServerMockRecorder is the mock recorder for MockApiService_SubscribeTrxResultServer
+type MockApiService_SubscribeTrxResultServerMockRecorder struct {
+	mock *MockApiService_SubscribeTrxResultServer
+}
+
+// NewMockApiService_SubscribeTrxResultServer creates a new mock instance
+func NewMockApiService_SubscribeTrxResultServer(ctrl *gomock.Controller) *MockApiService_SubscribeTrxResultServer {
+	mock := &MockApiService_SubscribeTrxResultServer{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeTrxResultServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeTrxResultServer) EXPECT() *MockApiService_SubscribeTrxResultServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockApiService_SubscribeTrxResultServer) Send(arg0 *pb.TrxResultEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockApiService_SubscribeTrxResultServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockApiService_SubscribeTrxResultServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockApiService_SubscribeTrxResultServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockApiService_SubscribeTrxResultServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeTrxResultServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeTrxResultServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeTrxResultServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeTrxResultServer)(nil).RecvMsg), m)
+}
+
+// MockApiService_SubscribeBlockLogServer is a mock of ApiService_SubscribeBlockLogServer interface
+type MockApiService_SubscribeBlockLogServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiService_SubscribeBlockLogServerMockRecorder
+}
+
+// MockApiService_SubscribeBlockLogServerMockRecorder is the mock recorder for MockApiService_SubscribeBlockLogServer
+type MockApiService_SubscribeBlockLogServerMockRecorder struct {
+	mock *MockApiService_SubscribeBlockLogServer
+}
+
+// NewMockApiService_SubscribeBlockLogServer creates a new mock instance
+func NewMockApiService_SubscribeBlockLogServer(ctrl *gomock.Controller) *MockApiService_SubscribeBlockLogServer {
+	mock := &MockApiService_SubscribeBlockLogServer{ctrl: ctrl}
+	mock.recorder = &MockApiService_SubscribeBlockLogServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApiService_SubscribeBlockLogServer) EXPECT() *MockApiService_SubscribeBlockLogServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockApiService_SubscribeBlockLogServer) Send(arg0 *pb.BlockLogEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockApiService_SubscribeBlockLogServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockApiService_SubscribeBlockLogServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockApiService_SubscribeBlockLogServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockApiService_SubscribeBlockLogServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlockLogServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockApiService_SubscribeBlockLogServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockApiService_SubscribeBlockLogServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockApiService_SubscribeBlockLogServer)(nil).RecvMsg), m)
 }
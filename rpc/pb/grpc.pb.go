@@ -25,6 +25,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// role of an account in escrows
+type EscrowParty int32
+
+const (
+	EscrowParty_ESCROW_FROM  EscrowParty = 0
+	EscrowParty_ESCROW_TO    EscrowParty = 1
+	EscrowParty_ESCROW_AGENT EscrowParty = 2
+)
+
+var EscrowParty_name = map[int32]string{
+	0: "ESCROW_FROM",
+	1: "ESCROW_TO",
+	2: "ESCROW_AGENT",
+}
+
+var EscrowParty_value = map[string]int32{
+	"ESCROW_FROM":  0,
+	"ESCROW_TO":    1,
+	"ESCROW_AGENT": 2,
+}
+
+func (x EscrowParty) String() string {
+	return proto.EnumName(EscrowParty_name, int32(x))
+}
+
+func (EscrowParty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{0}
+}
+
+type ProposalStatus int32
+
+const (
+	ProposalStatus_PROPOSAL_PENDING   ProposalStatus = 0
+	ProposalStatus_PROPOSAL_APPROVED  ProposalStatus = 1
+	ProposalStatus_PROPOSAL_ACTIVATED ProposalStatus = 2
+	ProposalStatus_PROPOSAL_REJECTED  ProposalStatus = 3
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_PENDING",
+	1: "PROPOSAL_APPROVED",
+	2: "PROPOSAL_ACTIVATED",
+	3: "PROPOSAL_REJECTED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_PENDING":   0,
+	"PROPOSAL_APPROVED":  1,
+	"PROPOSAL_ACTIVATED": 2,
+	"PROPOSAL_REJECTED":  3,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{1}
+}
+
 type GetTableContentRequest struct {
 	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	Contract             string   `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
@@ -561,6 +621,9 @@ type AccountInfo struct {
 	VestDelivering       *prototype.Vest          `protobuf:"bytes,32,opt,name=vest_delivering,json=vestDelivering,proto3" json:"vest_delivering,omitempty"`
 	VestOwned            *prototype.Vest          `protobuf:"bytes,33,opt,name=vest_owned,json=vestOwned,proto3" json:"vest_owned,omitempty"`
 	VestSelf             *prototype.Vest          `protobuf:"bytes,34,opt,name=vest_self,json=vestSelf,proto3" json:"vest_self,omitempty"`
+	Authority            *prototype.Authority     `protobuf:"bytes,35,opt,name=authority,proto3" json:"authority,omitempty"`
+	ActiveAuthority      *prototype.Authority     `protobuf:"bytes,36,opt,name=active_authority,json=activeAuthority,proto3" json:"active_authority,omitempty"`
+	PostingAuthority     *prototype.Authority     `protobuf:"bytes,37,opt,name=posting_authority,json=postingAuthority,proto3" json:"posting_authority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -829,6 +892,27 @@ func (m *AccountInfo) GetVestSelf() *prototype.Vest {
 	return nil
 }
 
+func (m *AccountInfo) GetAuthority() *prototype.Authority {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *AccountInfo) GetActiveAuthority() *prototype.Authority {
+	if m != nil {
+		return m.ActiveAuthority
+	}
+	return nil
+}
+
+func (m *AccountInfo) GetPostingAuthority() *prototype.Authority {
+	if m != nil {
+		return m.PostingAuthority
+	}
+	return nil
+}
+
 type AccountResponse struct {
 	Info                 *AccountInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	State                *ChainState  `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
@@ -1515,6 +1599,9 @@ type PostResponse struct {
 	Copyright            uint32                            `protobuf:"varint,28,opt,name=copyright,proto3" json:"copyright,omitempty"`
 	CopyrightMemo        string                            `protobuf:"bytes,29,opt,name=copyright_memo,json=copyrightMemo,proto3" json:"copyright_memo,omitempty"`
 	CashoutBlockNum      uint64                            `protobuf:"varint,30,opt,name=cashout_block_num,json=cashoutBlockNum,proto3" json:"cashout_block_num,omitempty"`
+	Version              uint32                            `protobuf:"varint,31,opt,name=version,proto3" json:"version,omitempty"`
+	LastEdited           *prototype.TimePointSec           `protobuf:"bytes,32,opt,name=last_edited,json=lastEdited,proto3" json:"last_edited,omitempty"`
+	Deleted              bool                              `protobuf:"varint,33,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
 	XXX_unrecognized     []byte                            `json:"-"`
 	XXX_sizecache        int32                             `json:"-"`
@@ -1720,6 +1807,27 @@ func (m *PostResponse) GetCashoutBlockNum() uint64 {
 	return 0
 }
 
+func (m *PostResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PostResponse) GetLastEdited() *prototype.TimePointSec {
+	if m != nil {
+		return m.LastEdited
+	}
+	return nil
+}
+
+func (m *PostResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type GetPostListByCreatedRequest struct {
 	Start                *prototype.PostCreatedOrder `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  *prototype.PostCreatedOrder `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -2080,18 +2188,82 @@ func (m *GetNodeNeighboursResponse) GetPeerlist() string {
 	return ""
 }
 
-type GetNodeRunningVersionResponse struct {
-	NodeVersion          string   `protobuf:"bytes,1,opt,name=nodeVersion,proto3" json:"nodeVersion,omitempty"`
+type ChainFeature struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActivationBlockNum   uint64   `protobuf:"varint,2,opt,name=activation_block_num,json=activationBlockNum,proto3" json:"activation_block_num,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *ChainFeature) Reset()         { *m = ChainFeature{} }
+func (m *ChainFeature) String() string { return proto.CompactTextString(m) }
+func (*ChainFeature) ProtoMessage()    {}
+func (*ChainFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{33}
+}
+
+func (m *ChainFeature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainFeature.Unmarshal(m, b)
+}
+func (m *ChainFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainFeature.Marshal(b, m, deterministic)
+}
+func (m *ChainFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainFeature.Merge(m, src)
+}
+func (m *ChainFeature) XXX_Size() int {
+	return xxx_messageInfo_ChainFeature.Size(m)
+}
+func (m *ChainFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainFeature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainFeature proto.InternalMessageInfo
+
+func (m *ChainFeature) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChainFeature) GetActivationBlockNum() uint64 {
+	if m != nil {
+		return m.ActivationBlockNum
+	}
+	return 0
+}
+
+func (m *ChainFeature) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ChainFeature) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type GetNodeRunningVersionResponse struct {
+	NodeVersion          string          `protobuf:"bytes,1,opt,name=nodeVersion,proto3" json:"nodeVersion,omitempty"`
+	Features             []*ChainFeature `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
 func (m *GetNodeRunningVersionResponse) Reset()         { *m = GetNodeRunningVersionResponse{} }
 func (m *GetNodeRunningVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNodeRunningVersionResponse) ProtoMessage()    {}
 func (*GetNodeRunningVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{33}
+	return fileDescriptor_bedfbfc9b54e5600, []int{34}
 }
 
 func (m *GetNodeRunningVersionResponse) XXX_Unmarshal(b []byte) error {
@@ -2119,6 +2291,13 @@ func (m *GetNodeRunningVersionResponse) GetNodeVersion() string {
 	return ""
 }
 
+func (m *GetNodeRunningVersionResponse) GetFeatures() []*ChainFeature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 type BroadcastTrxRequest struct {
 	Transaction          *prototype.SignedTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	OnlyDeliver          bool                         `protobuf:"varint,2,opt,name=only_deliver,json=onlyDeliver,proto3" json:"only_deliver,omitempty"`
@@ -2132,7 +2311,7 @@ func (m *BroadcastTrxRequest) Reset()         { *m = BroadcastTrxRequest{} }
 func (m *BroadcastTrxRequest) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTrxRequest) ProtoMessage()    {}
 func (*BroadcastTrxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{34}
+	return fileDescriptor_bedfbfc9b54e5600, []int{35}
 }
 
 func (m *BroadcastTrxRequest) XXX_Unmarshal(b []byte) error {
@@ -2188,7 +2367,7 @@ func (m *BroadcastTrxResponse) Reset()         { *m = BroadcastTrxResponse{} }
 func (m *BroadcastTrxResponse) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTrxResponse) ProtoMessage()    {}
 func (*BroadcastTrxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{35}
+	return fileDescriptor_bedfbfc9b54e5600, []int{36}
 }
 
 func (m *BroadcastTrxResponse) XXX_Unmarshal(b []byte) error {
@@ -2247,7 +2426,7 @@ func (m *NonParamsRequest) Reset()         { *m = NonParamsRequest{} }
 func (m *NonParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*NonParamsRequest) ProtoMessage()    {}
 func (*NonParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{36}
+	return fileDescriptor_bedfbfc9b54e5600, []int{37}
 }
 
 func (m *NonParamsRequest) XXX_Unmarshal(b []byte) error {
@@ -2281,7 +2460,7 @@ func (m *CallResponse) Reset()         { *m = CallResponse{} }
 func (m *CallResponse) String() string { return proto.CompactTextString(m) }
 func (*CallResponse) ProtoMessage()    {}
 func (*CallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{37}
+	return fileDescriptor_bedfbfc9b54e5600, []int{38}
 }
 
 func (m *CallResponse) XXX_Unmarshal(b []byte) error {
@@ -2336,7 +2515,7 @@ func (m *ChainState) Reset()         { *m = ChainState{} }
 func (m *ChainState) String() string { return proto.CompactTextString(m) }
 func (*ChainState) ProtoMessage()    {}
 func (*ChainState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{38}
+	return fileDescriptor_bedfbfc9b54e5600, []int{39}
 }
 
 func (m *ChainState) XXX_Unmarshal(b []byte) error {
@@ -2391,7 +2570,7 @@ func (m *GetBlockListRequest) Reset()         { *m = GetBlockListRequest{} }
 func (m *GetBlockListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockListRequest) ProtoMessage()    {}
 func (*GetBlockListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{39}
+	return fileDescriptor_bedfbfc9b54e5600, []int{40}
 }
 
 func (m *GetBlockListRequest) XXX_Unmarshal(b []byte) error {
@@ -2450,7 +2629,7 @@ func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
 func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockInfo) ProtoMessage()    {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{40}
+	return fileDescriptor_bedfbfc9b54e5600, []int{41}
 }
 
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
@@ -2531,7 +2710,7 @@ func (m *GetBlockListResponse) Reset()         { *m = GetBlockListResponse{} }
 func (m *GetBlockListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockListResponse) ProtoMessage()    {}
 func (*GetBlockListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{41}
+	return fileDescriptor_bedfbfc9b54e5600, []int{42}
 }
 
 func (m *GetBlockListResponse) XXX_Unmarshal(b []byte) error {
@@ -2570,7 +2749,7 @@ func (m *GetSignedBlockRequest) Reset()         { *m = GetSignedBlockRequest{} }
 func (m *GetSignedBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSignedBlockRequest) ProtoMessage()    {}
 func (*GetSignedBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{42}
+	return fileDescriptor_bedfbfc9b54e5600, []int{43}
 }
 
 func (m *GetSignedBlockRequest) XXX_Unmarshal(b []byte) error {
@@ -2609,7 +2788,7 @@ func (m *GetSignedBlockResponse) Reset()         { *m = GetSignedBlockResponse{}
 func (m *GetSignedBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSignedBlockResponse) ProtoMessage()    {}
 func (*GetSignedBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{43}
+	return fileDescriptor_bedfbfc9b54e5600, []int{44}
 }
 
 func (m *GetSignedBlockResponse) XXX_Unmarshal(b []byte) error {
@@ -2651,7 +2830,7 @@ func (m *GetAccountListByBalanceRequest) Reset()         { *m = GetAccountListBy
 func (m *GetAccountListByBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountListByBalanceRequest) ProtoMessage()    {}
 func (*GetAccountListByBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{44}
+	return fileDescriptor_bedfbfc9b54e5600, []int{45}
 }
 
 func (m *GetAccountListByBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -2711,7 +2890,7 @@ func (m *GetAccountListResponse) Reset()         { *m = GetAccountListResponse{}
 func (m *GetAccountListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountListResponse) ProtoMessage()    {}
 func (*GetAccountListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{45}
+	return fileDescriptor_bedfbfc9b54e5600, []int{46}
 }
 
 func (m *GetAccountListResponse) XXX_Unmarshal(b []byte) error {
@@ -2751,7 +2930,7 @@ func (m *DailyTotalTrx) Reset()         { *m = DailyTotalTrx{} }
 func (m *DailyTotalTrx) String() string { return proto.CompactTextString(m) }
 func (*DailyTotalTrx) ProtoMessage()    {}
 func (*DailyTotalTrx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{46}
+	return fileDescriptor_bedfbfc9b54e5600, []int{47}
 }
 
 func (m *DailyTotalTrx) XXX_Unmarshal(b []byte) error {
@@ -2800,7 +2979,7 @@ func (m *GetDailyTotalTrxRequest) Reset()         { *m = GetDailyTotalTrxRequest
 func (m *GetDailyTotalTrxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDailyTotalTrxRequest) ProtoMessage()    {}
 func (*GetDailyTotalTrxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{47}
+	return fileDescriptor_bedfbfc9b54e5600, []int{48}
 }
 
 func (m *GetDailyTotalTrxRequest) XXX_Unmarshal(b []byte) error {
@@ -2860,7 +3039,7 @@ func (m *GetDailyTotalTrxResponse) Reset()         { *m = GetDailyTotalTrxRespon
 func (m *GetDailyTotalTrxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDailyTotalTrxResponse) ProtoMessage()    {}
 func (*GetDailyTotalTrxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{48}
+	return fileDescriptor_bedfbfc9b54e5600, []int{49}
 }
 
 func (m *GetDailyTotalTrxResponse) XXX_Unmarshal(b []byte) error {
@@ -2900,7 +3079,7 @@ func (m *StatByHour) Reset()         { *m = StatByHour{} }
 func (m *StatByHour) String() string { return proto.CompactTextString(m) }
 func (*StatByHour) ProtoMessage()    {}
 func (*StatByHour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{49}
+	return fileDescriptor_bedfbfc9b54e5600, []int{50}
 }
 
 func (m *StatByHour) XXX_Unmarshal(b []byte) error {
@@ -2946,7 +3125,7 @@ func (m *TrxStatByHourRequest) Reset()         { *m = TrxStatByHourRequest{} }
 func (m *TrxStatByHourRequest) String() string { return proto.CompactTextString(m) }
 func (*TrxStatByHourRequest) ProtoMessage()    {}
 func (*TrxStatByHourRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{50}
+	return fileDescriptor_bedfbfc9b54e5600, []int{51}
 }
 
 func (m *TrxStatByHourRequest) XXX_Unmarshal(b []byte) error {
@@ -2985,7 +3164,7 @@ func (m *TrxStatByHourResponse) Reset()         { *m = TrxStatByHourResponse{} }
 func (m *TrxStatByHourResponse) String() string { return proto.CompactTextString(m) }
 func (*TrxStatByHourResponse) ProtoMessage()    {}
 func (*TrxStatByHourResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{51}
+	return fileDescriptor_bedfbfc9b54e5600, []int{52}
 }
 
 func (m *TrxStatByHourResponse) XXX_Unmarshal(b []byte) error {
@@ -3029,7 +3208,7 @@ func (m *TrxInfo) Reset()         { *m = TrxInfo{} }
 func (m *TrxInfo) String() string { return proto.CompactTextString(m) }
 func (*TrxInfo) ProtoMessage()    {}
 func (*TrxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{52}
+	return fileDescriptor_bedfbfc9b54e5600, []int{53}
 }
 
 func (m *TrxInfo) XXX_Unmarshal(b []byte) error {
@@ -3103,7 +3282,7 @@ func (m *GetTrxInfoByIdRequest) Reset()         { *m = GetTrxInfoByIdRequest{} }
 func (m *GetTrxInfoByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTrxInfoByIdRequest) ProtoMessage()    {}
 func (*GetTrxInfoByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{53}
+	return fileDescriptor_bedfbfc9b54e5600, []int{54}
 }
 
 func (m *GetTrxInfoByIdRequest) XXX_Unmarshal(b []byte) error {
@@ -3142,7 +3321,7 @@ func (m *GetTrxInfoByIdResponse) Reset()         { *m = GetTrxInfoByIdResponse{}
 func (m *GetTrxInfoByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTrxInfoByIdResponse) ProtoMessage()    {}
 func (*GetTrxInfoByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{54}
+	return fileDescriptor_bedfbfc9b54e5600, []int{55}
 }
 
 func (m *GetTrxInfoByIdResponse) XXX_Unmarshal(b []byte) error {
@@ -3184,7 +3363,7 @@ func (m *GetTrxListByTimeRequest) Reset()         { *m = GetTrxListByTimeRequest
 func (m *GetTrxListByTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTrxListByTimeRequest) ProtoMessage()    {}
 func (*GetTrxListByTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{55}
+	return fileDescriptor_bedfbfc9b54e5600, []int{56}
 }
 
 func (m *GetTrxListByTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -3244,7 +3423,7 @@ func (m *GetTrxListByTimeResponse) Reset()         { *m = GetTrxListByTimeRespon
 func (m *GetTrxListByTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTrxListByTimeResponse) ProtoMessage()    {}
 func (*GetTrxListByTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{56}
+	return fileDescriptor_bedfbfc9b54e5600, []int{57}
 }
 
 func (m *GetTrxListByTimeResponse) XXX_Unmarshal(b []byte) error {
@@ -3286,7 +3465,7 @@ func (m *GetPostListByCreateTimeRequest) Reset()         { *m = GetPostListByCre
 func (m *GetPostListByCreateTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPostListByCreateTimeRequest) ProtoMessage()    {}
 func (*GetPostListByCreateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{57}
+	return fileDescriptor_bedfbfc9b54e5600, []int{58}
 }
 
 func (m *GetPostListByCreateTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -3346,7 +3525,7 @@ func (m *GetPostListByCreateTimeResponse) Reset()         { *m = GetPostListByCr
 func (m *GetPostListByCreateTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPostListByCreateTimeResponse) ProtoMessage()    {}
 func (*GetPostListByCreateTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{58}
+	return fileDescriptor_bedfbfc9b54e5600, []int{59}
 }
 
 func (m *GetPostListByCreateTimeResponse) XXX_Unmarshal(b []byte) error {
@@ -3388,7 +3567,7 @@ func (m *GetPostListByNameRequest) Reset()         { *m = GetPostListByNameReque
 func (m *GetPostListByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPostListByNameRequest) ProtoMessage()    {}
 func (*GetPostListByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{59}
+	return fileDescriptor_bedfbfc9b54e5600, []int{60}
 }
 
 func (m *GetPostListByNameRequest) XXX_Unmarshal(b []byte) error {
@@ -3452,7 +3631,7 @@ func (m *GetUserTrxListByTimeRequest) Reset()         { *m = GetUserTrxListByTim
 func (m *GetUserTrxListByTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserTrxListByTimeRequest) ProtoMessage()    {}
 func (*GetUserTrxListByTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{60}
+	return fileDescriptor_bedfbfc9b54e5600, []int{61}
 }
 
 func (m *GetUserTrxListByTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -3519,7 +3698,7 @@ func (m *GetUserTrxListByTimeResponse) Reset()         { *m = GetUserTrxListByTi
 func (m *GetUserTrxListByTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserTrxListByTimeResponse) ProtoMessage()    {}
 func (*GetUserTrxListByTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{61}
+	return fileDescriptor_bedfbfc9b54e5600, []int{62}
 }
 
 func (m *GetUserTrxListByTimeResponse) XXX_Unmarshal(b []byte) error {
@@ -3559,7 +3738,7 @@ func (m *VoterOfPost) Reset()         { *m = VoterOfPost{} }
 func (m *VoterOfPost) String() string { return proto.CompactTextString(m) }
 func (*VoterOfPost) ProtoMessage()    {}
 func (*VoterOfPost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{62}
+	return fileDescriptor_bedfbfc9b54e5600, []int{63}
 }
 
 func (m *VoterOfPost) XXX_Unmarshal(b []byte) error {
@@ -3607,7 +3786,7 @@ func (m *GetPostInfoByIdRequest) Reset()         { *m = GetPostInfoByIdRequest{}
 func (m *GetPostInfoByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPostInfoByIdRequest) ProtoMessage()    {}
 func (*GetPostInfoByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{63}
+	return fileDescriptor_bedfbfc9b54e5600, []int{64}
 }
 
 func (m *GetPostInfoByIdRequest) XXX_Unmarshal(b []byte) error {
@@ -3662,7 +3841,7 @@ func (m *GetPostInfoByIdResponse) Reset()         { *m = GetPostInfoByIdResponse
 func (m *GetPostInfoByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPostInfoByIdResponse) ProtoMessage()    {}
 func (*GetPostInfoByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{64}
+	return fileDescriptor_bedfbfc9b54e5600, []int{65}
 }
 
 func (m *GetPostInfoByIdResponse) XXX_Unmarshal(b []byte) error {
@@ -3718,7 +3897,7 @@ func (m *GetContractInfoRequest) Reset()         { *m = GetContractInfoRequest{}
 func (m *GetContractInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetContractInfoRequest) ProtoMessage()    {}
 func (*GetContractInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{65}
+	return fileDescriptor_bedfbfc9b54e5600, []int{66}
 }
 
 func (m *GetContractInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3782,7 +3961,7 @@ func (m *GetContractInfoResponse) Reset()         { *m = GetContractInfoResponse
 func (m *GetContractInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetContractInfoResponse) ProtoMessage()    {}
 func (*GetContractInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{66}
+	return fileDescriptor_bedfbfc9b54e5600, []int{67}
 }
 
 func (m *GetContractInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3849,7 +4028,7 @@ func (m *GetBlkIsIrreversibleByTxIdRequest) Reset()         { *m = GetBlkIsIrrev
 func (m *GetBlkIsIrreversibleByTxIdRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlkIsIrreversibleByTxIdRequest) ProtoMessage()    {}
 func (*GetBlkIsIrreversibleByTxIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{67}
+	return fileDescriptor_bedfbfc9b54e5600, []int{68}
 }
 
 func (m *GetBlkIsIrreversibleByTxIdRequest) XXX_Unmarshal(b []byte) error {
@@ -3888,7 +4067,7 @@ func (m *GetBlkIsIrreversibleByTxIdResponse) Reset()         { *m = GetBlkIsIrre
 func (m *GetBlkIsIrreversibleByTxIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlkIsIrreversibleByTxIdResponse) ProtoMessage()    {}
 func (*GetBlkIsIrreversibleByTxIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{68}
+	return fileDescriptor_bedfbfc9b54e5600, []int{69}
 }
 
 func (m *GetBlkIsIrreversibleByTxIdResponse) XXX_Unmarshal(b []byte) error {
@@ -3930,7 +4109,7 @@ func (m *GetAccountListByCreTimeRequest) Reset()         { *m = GetAccountListBy
 func (m *GetAccountListByCreTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountListByCreTimeRequest) ProtoMessage()    {}
 func (*GetAccountListByCreTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{69}
+	return fileDescriptor_bedfbfc9b54e5600, []int{70}
 }
 
 func (m *GetAccountListByCreTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -3996,7 +4175,7 @@ func (m *DailyStat) Reset()         { *m = DailyStat{} }
 func (m *DailyStat) String() string { return proto.CompactTextString(m) }
 func (*DailyStat) ProtoMessage()    {}
 func (*DailyStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{70}
+	return fileDescriptor_bedfbfc9b54e5600, []int{71}
 }
 
 func (m *DailyStat) XXX_Unmarshal(b []byte) error {
@@ -4078,7 +4257,7 @@ func (m *GetDailyStatsRequest) Reset()         { *m = GetDailyStatsRequest{} }
 func (m *GetDailyStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDailyStatsRequest) ProtoMessage()    {}
 func (*GetDailyStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{71}
+	return fileDescriptor_bedfbfc9b54e5600, []int{72}
 }
 
 func (m *GetDailyStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -4124,7 +4303,7 @@ func (m *GetDailyStatsResponse) Reset()         { *m = GetDailyStatsResponse{} }
 func (m *GetDailyStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDailyStatsResponse) ProtoMessage()    {}
 func (*GetDailyStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{72}
+	return fileDescriptor_bedfbfc9b54e5600, []int{73}
 }
 
 func (m *GetDailyStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -4168,7 +4347,7 @@ func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{73}
+	return fileDescriptor_bedfbfc9b54e5600, []int{74}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -4245,7 +4424,7 @@ func (m *GetContractListByTimeRequest) Reset()         { *m = GetContractListByT
 func (m *GetContractListByTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetContractListByTimeRequest) ProtoMessage()    {}
 func (*GetContractListByTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{74}
+	return fileDescriptor_bedfbfc9b54e5600, []int{75}
 }
 
 func (m *GetContractListByTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -4305,7 +4484,7 @@ func (m *GetContractListResponse) Reset()         { *m = GetContractListResponse
 func (m *GetContractListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetContractListResponse) ProtoMessage()    {}
 func (*GetContractListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{75}
+	return fileDescriptor_bedfbfc9b54e5600, []int{76}
 }
 
 func (m *GetContractListResponse) XXX_Unmarshal(b []byte) error {
@@ -4349,7 +4528,7 @@ func (m *GetBlockProducerListByVoteCountRequest) Reset() {
 func (m *GetBlockProducerListByVoteCountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockProducerListByVoteCountRequest) ProtoMessage()    {}
 func (*GetBlockProducerListByVoteCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{76}
+	return fileDescriptor_bedfbfc9b54e5600, []int{77}
 }
 
 func (m *GetBlockProducerListByVoteCountRequest) XXX_Unmarshal(b []byte) error {
@@ -4412,7 +4591,7 @@ func (m *GetPostListByVestRequest) Reset()         { *m = GetPostListByVestReque
 func (m *GetPostListByVestRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPostListByVestRequest) ProtoMessage()    {}
 func (*GetPostListByVestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{77}
+	return fileDescriptor_bedfbfc9b54e5600, []int{78}
 }
 
 func (m *GetPostListByVestRequest) XXX_Unmarshal(b []byte) error {
@@ -4472,7 +4651,7 @@ func (m *GetPostListByVestResponse) Reset()         { *m = GetPostListByVestResp
 func (m *GetPostListByVestResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPostListByVestResponse) ProtoMessage()    {}
 func (*GetPostListByVestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{78}
+	return fileDescriptor_bedfbfc9b54e5600, []int{79}
 }
 
 func (m *GetPostListByVestResponse) XXX_Unmarshal(b []byte) error {
@@ -4511,7 +4690,7 @@ func (m *EsimateRequest) Reset()         { *m = EsimateRequest{} }
 func (m *EsimateRequest) String() string { return proto.CompactTextString(m) }
 func (*EsimateRequest) ProtoMessage()    {}
 func (*EsimateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{79}
+	return fileDescriptor_bedfbfc9b54e5600, []int{80}
 }
 
 func (m *EsimateRequest) XXX_Unmarshal(b []byte) error {
@@ -4550,7 +4729,7 @@ func (m *EsimateResponse) Reset()         { *m = EsimateResponse{} }
 func (m *EsimateResponse) String() string { return proto.CompactTextString(m) }
 func (*EsimateResponse) ProtoMessage()    {}
 func (*EsimateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{80}
+	return fileDescriptor_bedfbfc9b54e5600, []int{81}
 }
 
 func (m *EsimateResponse) XXX_Unmarshal(b []byte) error {
@@ -4591,7 +4770,7 @@ func (m *StakeInfo) Reset()         { *m = StakeInfo{} }
 func (m *StakeInfo) String() string { return proto.CompactTextString(m) }
 func (*StakeInfo) ProtoMessage()    {}
 func (*StakeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{81}
+	return fileDescriptor_bedfbfc9b54e5600, []int{82}
 }
 
 func (m *StakeInfo) XXX_Unmarshal(b []byte) error {
@@ -4646,7 +4825,7 @@ func (m *GetMyStakerListByNameRequest) Reset()         { *m = GetMyStakerListByN
 func (m *GetMyStakerListByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMyStakerListByNameRequest) ProtoMessage()    {}
 func (*GetMyStakerListByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{82}
+	return fileDescriptor_bedfbfc9b54e5600, []int{83}
 }
 
 func (m *GetMyStakerListByNameRequest) XXX_Unmarshal(b []byte) error {
@@ -4699,7 +4878,7 @@ func (m *GetMyStakerListByNameResponse) Reset()         { *m = GetMyStakerListBy
 func (m *GetMyStakerListByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMyStakerListByNameResponse) ProtoMessage()    {}
 func (*GetMyStakerListByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{83}
+	return fileDescriptor_bedfbfc9b54e5600, []int{84}
 }
 
 func (m *GetMyStakerListByNameResponse) XXX_Unmarshal(b []byte) error {
@@ -4740,7 +4919,7 @@ func (m *GetMyStakeListByNameRequest) Reset()         { *m = GetMyStakeListByNam
 func (m *GetMyStakeListByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMyStakeListByNameRequest) ProtoMessage()    {}
 func (*GetMyStakeListByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{84}
+	return fileDescriptor_bedfbfc9b54e5600, []int{85}
 }
 
 func (m *GetMyStakeListByNameRequest) XXX_Unmarshal(b []byte) error {
@@ -4793,7 +4972,7 @@ func (m *GetMyStakeListByNameResponse) Reset()         { *m = GetMyStakeListByNa
 func (m *GetMyStakeListByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMyStakeListByNameResponse) ProtoMessage()    {}
 func (*GetMyStakeListByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{85}
+	return fileDescriptor_bedfbfc9b54e5600, []int{86}
 }
 
 func (m *GetMyStakeListByNameResponse) XXX_Unmarshal(b []byte) error {
@@ -4835,7 +5014,7 @@ func (m *GetAccountListByVestRequest) Reset()         { *m = GetAccountListByVes
 func (m *GetAccountListByVestRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountListByVestRequest) ProtoMessage()    {}
 func (*GetAccountListByVestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{86}
+	return fileDescriptor_bedfbfc9b54e5600, []int{87}
 }
 
 func (m *GetAccountListByVestRequest) XXX_Unmarshal(b []byte) error {
@@ -4895,7 +5074,7 @@ func (m *GetBlockBFTInfoByNumRequest) Reset()         { *m = GetBlockBFTInfoByNu
 func (m *GetBlockBFTInfoByNumRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockBFTInfoByNumRequest) ProtoMessage()    {}
 func (*GetBlockBFTInfoByNumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{87}
+	return fileDescriptor_bedfbfc9b54e5600, []int{88}
 }
 
 func (m *GetBlockBFTInfoByNumRequest) XXX_Unmarshal(b []byte) error {
@@ -4935,7 +5114,7 @@ func (m *BFTVoteInfo) Reset()         { *m = BFTVoteInfo{} }
 func (m *BFTVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*BFTVoteInfo) ProtoMessage()    {}
 func (*BFTVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{88}
+	return fileDescriptor_bedfbfc9b54e5600, []int{89}
 }
 
 func (m *BFTVoteInfo) XXX_Unmarshal(b []byte) error {
@@ -4983,7 +5162,7 @@ func (m *GetBlockBFTInfoByNumResponse) Reset()         { *m = GetBlockBFTInfoByN
 func (m *GetBlockBFTInfoByNumResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockBFTInfoByNumResponse) ProtoMessage()    {}
 func (*GetBlockBFTInfoByNumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{89}
+	return fileDescriptor_bedfbfc9b54e5600, []int{90}
 }
 
 func (m *GetBlockBFTInfoByNumResponse) XXX_Unmarshal(b []byte) error {
@@ -5037,7 +5216,7 @@ func (m *GetAppTableRecordRequest) Reset()         { *m = GetAppTableRecordReque
 func (m *GetAppTableRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAppTableRecordRequest) ProtoMessage()    {}
 func (*GetAppTableRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{90}
+	return fileDescriptor_bedfbfc9b54e5600, []int{91}
 }
 
 func (m *GetAppTableRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -5085,7 +5264,7 @@ func (m *GetAppTableRecordResponse) Reset()         { *m = GetAppTableRecordResp
 func (m *GetAppTableRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAppTableRecordResponse) ProtoMessage()    {}
 func (*GetAppTableRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{91}
+	return fileDescriptor_bedfbfc9b54e5600, []int{92}
 }
 
 func (m *GetAppTableRecordResponse) XXX_Unmarshal(b []byte) error {
@@ -5140,7 +5319,7 @@ func (m *GetBlockProducerVoterListRequest) Reset()         { *m = GetBlockProduc
 func (m *GetBlockProducerVoterListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockProducerVoterListRequest) ProtoMessage()    {}
 func (*GetBlockProducerVoterListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{92}
+	return fileDescriptor_bedfbfc9b54e5600, []int{93}
 }
 
 func (m *GetBlockProducerVoterListRequest) XXX_Unmarshal(b []byte) error {
@@ -5194,7 +5373,7 @@ func (m *BlockProducerVoterResponse) Reset()         { *m = BlockProducerVoterRe
 func (m *BlockProducerVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockProducerVoterResponse) ProtoMessage()    {}
 func (*BlockProducerVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{93}
+	return fileDescriptor_bedfbfc9b54e5600, []int{94}
 }
 
 func (m *BlockProducerVoterResponse) XXX_Unmarshal(b []byte) error {
@@ -5240,7 +5419,7 @@ func (m *GetBlockProducerVoterListResponse) Reset()         { *m = GetBlockProdu
 func (m *GetBlockProducerVoterListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockProducerVoterListResponse) ProtoMessage()    {}
 func (*GetBlockProducerVoterListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{94}
+	return fileDescriptor_bedfbfc9b54e5600, []int{95}
 }
 
 func (m *GetBlockProducerVoterListResponse) XXX_Unmarshal(b []byte) error {
@@ -5286,7 +5465,7 @@ func (m *VestDelegationOrder) Reset()         { *m = VestDelegationOrder{} }
 func (m *VestDelegationOrder) String() string { return proto.CompactTextString(m) }
 func (*VestDelegationOrder) ProtoMessage()    {}
 func (*VestDelegationOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{95}
+	return fileDescriptor_bedfbfc9b54e5600, []int{96}
 }
 
 func (m *VestDelegationOrder) XXX_Unmarshal(b []byte) error {
@@ -5377,7 +5556,7 @@ func (m *GetVestDelegationOrderListRequest) Reset()         { *m = GetVestDelega
 func (m *GetVestDelegationOrderListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVestDelegationOrderListRequest) ProtoMessage()    {}
 func (*GetVestDelegationOrderListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{96}
+	return fileDescriptor_bedfbfc9b54e5600, []int{97}
 }
 
 func (m *GetVestDelegationOrderListRequest) XXX_Unmarshal(b []byte) error {
@@ -5437,7 +5616,7 @@ func (m *GetVestDelegationOrderListResponse) Reset()         { *m = GetVestDeleg
 func (m *GetVestDelegationOrderListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetVestDelegationOrderListResponse) ProtoMessage()    {}
 func (*GetVestDelegationOrderListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bedfbfc9b54e5600, []int{97}
+	return fileDescriptor_bedfbfc9b54e5600, []int{98}
 }
 
 func (m *GetVestDelegationOrderListResponse) XXX_Unmarshal(b []byte) error {
@@ -5465,40 +5644,2082 @@ func (m *GetVestDelegationOrderListResponse) GetOrders() []*VestDelegationOrder
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetTableContentRequest)(nil), "grpcpb.GetTableContentRequest")
-	proto.RegisterType((*TableContentResponse)(nil), "grpcpb.TableContentResponse")
-	proto.RegisterType((*GetAccountByPubKeyRequest)(nil), "grpcpb.GetAccountByPubKeyRequest")
-	proto.RegisterType((*GetAccountByNameRequest)(nil), "grpcpb.GetAccountByNameRequest")
-	proto.RegisterType((*GetBlockProducerByNameRequest)(nil), "grpcpb.GetBlockProducerByNameRequest")
-	proto.RegisterType((*GetAccountCashoutRequest)(nil), "grpcpb.GetAccountCashoutRequest")
-	proto.RegisterType((*AccountCashoutResponse)(nil), "grpcpb.AccountCashoutResponse")
-	proto.RegisterType((*GetBlockCashoutRequest)(nil), "grpcpb.GetBlockCashoutRequest")
-	proto.RegisterType((*BlockCashoutResponse)(nil), "grpcpb.BlockCashoutResponse")
-	proto.RegisterType((*GetAccountRewardByNameRequest)(nil), "grpcpb.GetAccountRewardByNameRequest")
-	proto.RegisterType((*AccountRewardResponse)(nil), "grpcpb.AccountRewardResponse")
-	proto.RegisterType((*AccountInfo)(nil), "grpcpb.AccountInfo")
-	proto.RegisterType((*AccountResponse)(nil), "grpcpb.AccountResponse")
-	proto.RegisterType((*GetFollowerListByNameRequest)(nil), "grpcpb.GetFollowerListByNameRequest")
-	proto.RegisterType((*FollowerListInfo)(nil), "grpcpb.FollowerListInfo")
-	proto.RegisterType((*GetFollowerListByNameResponse)(nil), "grpcpb.GetFollowerListByNameResponse")
-	proto.RegisterType((*GetFollowingListByNameRequest)(nil), "grpcpb.GetFollowingListByNameRequest")
-	proto.RegisterType((*FollowingListInfo)(nil), "grpcpb.FollowingListInfo")
-	proto.RegisterType((*GetFollowingListByNameResponse)(nil), "grpcpb.GetFollowingListByNameResponse")
-	proto.RegisterType((*GetFollowCountByNameRequest)(nil), "grpcpb.GetFollowCountByNameRequest")
-	proto.RegisterType((*GetFollowCountByNameResponse)(nil), "grpcpb.GetFollowCountByNameResponse")
-	proto.RegisterType((*BlockProducerResponse)(nil), "grpcpb.BlockProducerResponse")
-	proto.RegisterType((*GetBlockProducerListRequest)(nil), "grpcpb.GetBlockProducerListRequest")
-	proto.RegisterType((*GetBlockProducerListResponse)(nil), "grpcpb.GetBlockProducerListResponse")
-	proto.RegisterType((*PostResponse)(nil), "grpcpb.PostResponse")
-	proto.RegisterType((*GetPostListByCreatedRequest)(nil), "grpcpb.GetPostListByCreatedRequest")
-	proto.RegisterType((*GetPostListByCreatedResponse)(nil), "grpcpb.GetPostListByCreatedResponse")
-	proto.RegisterType((*GetReplyListByPostIdRequest)(nil), "grpcpb.GetReplyListByPostIdRequest")
-	proto.RegisterType((*GetReplyListByPostIdResponse)(nil), "grpcpb.GetReplyListByPostIdResponse")
+type GetAccountProofRequest struct {
+	AccountName          *prototype.AccountName `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetAccountProofRequest) Reset()         { *m = GetAccountProofRequest{} }
+func (m *GetAccountProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountProofRequest) ProtoMessage()    {}
+func (*GetAccountProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{99}
+}
+
+func (m *GetAccountProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountProofRequest.Unmarshal(m, b)
+}
+func (m *GetAccountProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountProofRequest.Merge(m, src)
+}
+func (m *GetAccountProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountProofRequest.Size(m)
+}
+func (m *GetAccountProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountProofRequest proto.InternalMessageInfo
+
+func (m *GetAccountProofRequest) GetAccountName() *prototype.AccountName {
+	if m != nil {
+		return m.AccountName
+	}
+	return nil
+}
+
+type GetPostProofRequest struct {
+	PostId               uint64   `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPostProofRequest) Reset()         { *m = GetPostProofRequest{} }
+func (m *GetPostProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPostProofRequest) ProtoMessage()    {}
+func (*GetPostProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{100}
+}
+
+func (m *GetPostProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPostProofRequest.Unmarshal(m, b)
+}
+func (m *GetPostProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPostProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPostProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPostProofRequest.Merge(m, src)
+}
+func (m *GetPostProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPostProofRequest.Size(m)
+}
+func (m *GetPostProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPostProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPostProofRequest proto.InternalMessageInfo
+
+func (m *GetPostProofRequest) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+// StateProof proves the value of a state key, or its absence, in the state after applying block_num.
+// The state root is committed in the header of block block_num + 1.
+type StateProof struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Exist                bool     `protobuf:"varint,5,opt,name=exist,proto3" json:"exist,omitempty"`
+	Siblings             [][]byte `protobuf:"bytes,6,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	LeafKeyHash          []byte   `protobuf:"bytes,7,opt,name=leaf_key_hash,json=leafKeyHash,proto3" json:"leaf_key_hash,omitempty"`
+	LeafValueHash        []byte   `protobuf:"bytes,8,opt,name=leaf_value_hash,json=leafValueHash,proto3" json:"leaf_value_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{101}
+}
+
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *StateProof) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetExist() bool {
+	if m != nil {
+		return m.Exist
+	}
+	return false
+}
+
+func (m *StateProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeafKeyHash() []byte {
+	if m != nil {
+		return m.LeafKeyHash
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeafValueHash() []byte {
+	if m != nil {
+		return m.LeafValueHash
+	}
+	return nil
+}
+
+type StateProofResponse struct {
+	Proof                *StateProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StateProofResponse) Reset()         { *m = StateProofResponse{} }
+func (m *StateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*StateProofResponse) ProtoMessage()    {}
+func (*StateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{102}
+}
+
+func (m *StateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProofResponse.Unmarshal(m, b)
+}
+func (m *StateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *StateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProofResponse.Merge(m, src)
+}
+func (m *StateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_StateProofResponse.Size(m)
+}
+func (m *StateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProofResponse proto.InternalMessageInfo
+
+func (m *StateProofResponse) GetProof() *StateProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetTrxInclusionProofRequest struct {
+	TrxId                *prototype.Sha256 `protobuf:"bytes,1,opt,name=trx_id,json=trxId,proto3" json:"trx_id,omitempty"`
+	BlockNum             uint64            `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTrxInclusionProofRequest) Reset()         { *m = GetTrxInclusionProofRequest{} }
+func (m *GetTrxInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrxInclusionProofRequest) ProtoMessage()    {}
+func (*GetTrxInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{103}
+}
+
+func (m *GetTrxInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrxInclusionProofRequest.Unmarshal(m, b)
+}
+func (m *GetTrxInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrxInclusionProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTrxInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrxInclusionProofRequest.Merge(m, src)
+}
+func (m *GetTrxInclusionProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTrxInclusionProofRequest.Size(m)
+}
+func (m *GetTrxInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrxInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrxInclusionProofRequest proto.InternalMessageInfo
+
+func (m *GetTrxInclusionProofRequest) GetTrxId() *prototype.Sha256 {
+	if m != nil {
+		return m.TrxId
+	}
+	return nil
+}
+
+func (m *GetTrxInclusionProofRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+// TrxInclusionProof proves that a transaction is the index-th one of total count in a block,
+// against the transaction merkle root of the block header.
+type TrxInclusionProof struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Siblings             [][]byte `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrxInclusionProof) Reset()         { *m = TrxInclusionProof{} }
+func (m *TrxInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*TrxInclusionProof) ProtoMessage()    {}
+func (*TrxInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{104}
+}
+
+func (m *TrxInclusionProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrxInclusionProof.Unmarshal(m, b)
+}
+func (m *TrxInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrxInclusionProof.Marshal(b, m, deterministic)
+}
+func (m *TrxInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrxInclusionProof.Merge(m, src)
+}
+func (m *TrxInclusionProof) XXX_Size() int {
+	return xxx_messageInfo_TrxInclusionProof.Size(m)
+}
+func (m *TrxInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrxInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrxInclusionProof proto.InternalMessageInfo
+
+func (m *TrxInclusionProof) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *TrxInclusionProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TrxInclusionProof) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TrxInclusionProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+type GetTrxInclusionProofResponse struct {
+	Proof                *TrxInclusionProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTrxInclusionProofResponse) Reset()         { *m = GetTrxInclusionProofResponse{} }
+func (m *GetTrxInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTrxInclusionProofResponse) ProtoMessage()    {}
+func (*GetTrxInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{105}
+}
+
+func (m *GetTrxInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrxInclusionProofResponse.Unmarshal(m, b)
+}
+func (m *GetTrxInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrxInclusionProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTrxInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrxInclusionProofResponse.Merge(m, src)
+}
+func (m *GetTrxInclusionProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTrxInclusionProofResponse.Size(m)
+}
+func (m *GetTrxInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrxInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrxInclusionProofResponse proto.InternalMessageInfo
+
+func (m *GetTrxInclusionProofResponse) GetProof() *TrxInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetBlockHeaderWithCommitRequest struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeaderWithCommitRequest) Reset()         { *m = GetBlockHeaderWithCommitRequest{} }
+func (m *GetBlockHeaderWithCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeaderWithCommitRequest) ProtoMessage()    {}
+func (*GetBlockHeaderWithCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{106}
+}
+
+func (m *GetBlockHeaderWithCommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeaderWithCommitRequest.Unmarshal(m, b)
+}
+func (m *GetBlockHeaderWithCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeaderWithCommitRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockHeaderWithCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeaderWithCommitRequest.Merge(m, src)
+}
+func (m *GetBlockHeaderWithCommitRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeaderWithCommitRequest.Size(m)
+}
+func (m *GetBlockHeaderWithCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeaderWithCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeaderWithCommitRequest proto.InternalMessageInfo
+
+func (m *GetBlockHeaderWithCommitRequest) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+type GetBlockHeaderWithCommitResponse struct {
+	// headers from the requested block up to the first committed block since it
+	Headers []*prototype.SignedBlockHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	// the encoded SABFT commit of the last header
+	Commit               []byte   `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeaderWithCommitResponse) Reset()         { *m = GetBlockHeaderWithCommitResponse{} }
+func (m *GetBlockHeaderWithCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeaderWithCommitResponse) ProtoMessage()    {}
+func (*GetBlockHeaderWithCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{107}
+}
+
+func (m *GetBlockHeaderWithCommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeaderWithCommitResponse.Unmarshal(m, b)
+}
+func (m *GetBlockHeaderWithCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeaderWithCommitResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockHeaderWithCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeaderWithCommitResponse.Merge(m, src)
+}
+func (m *GetBlockHeaderWithCommitResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeaderWithCommitResponse.Size(m)
+}
+func (m *GetBlockHeaderWithCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeaderWithCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeaderWithCommitResponse proto.InternalMessageInfo
+
+func (m *GetBlockHeaderWithCommitResponse) GetHeaders() []*prototype.SignedBlockHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *GetBlockHeaderWithCommitResponse) GetCommit() []byte {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+type SubscribeBlocksRequest struct {
+	// the block number to resume from, 0 means from now on
+	StartBlock           uint64   `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()    {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{108}
+}
+
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeBlocksRequest.Unmarshal(m, b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(m, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeBlocksRequest.Size(m)
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+func (m *SubscribeBlocksRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+type BlockEvent struct {
+	Block *prototype.SignedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// if non-zero, sent blocks since reverted_block were reverted, and blocks of the new fork will follow
+	RevertedBlock        uint64   `protobuf:"varint,2,opt,name=reverted_block,json=revertedBlock,proto3" json:"reverted_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockEvent) Reset()         { *m = BlockEvent{} }
+func (m *BlockEvent) String() string { return proto.CompactTextString(m) }
+func (*BlockEvent) ProtoMessage()    {}
+func (*BlockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{109}
+}
+
+func (m *BlockEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockEvent.Unmarshal(m, b)
+}
+func (m *BlockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockEvent.Marshal(b, m, deterministic)
+}
+func (m *BlockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockEvent.Merge(m, src)
+}
+func (m *BlockEvent) XXX_Size() int {
+	return xxx_messageInfo_BlockEvent.Size(m)
+}
+func (m *BlockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockEvent proto.InternalMessageInfo
+
+func (m *BlockEvent) GetBlock() *prototype.SignedBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockEvent) GetRevertedBlock() uint64 {
+	if m != nil {
+		return m.RevertedBlock
+	}
+	return 0
+}
+
+type SubscribeTrxResultRequest struct {
+	TrxId                *prototype.Sha256 `protobuf:"bytes,1,opt,name=trx_id,json=trxId,proto3" json:"trx_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SubscribeTrxResultRequest) Reset()         { *m = SubscribeTrxResultRequest{} }
+func (m *SubscribeTrxResultRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTrxResultRequest) ProtoMessage()    {}
+func (*SubscribeTrxResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{110}
+}
+
+func (m *SubscribeTrxResultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTrxResultRequest.Unmarshal(m, b)
+}
+func (m *SubscribeTrxResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTrxResultRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeTrxResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTrxResultRequest.Merge(m, src)
+}
+func (m *SubscribeTrxResultRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTrxResultRequest.Size(m)
+}
+func (m *SubscribeTrxResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTrxResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTrxResultRequest proto.InternalMessageInfo
+
+func (m *SubscribeTrxResultRequest) GetTrxId() *prototype.Sha256 {
+	if m != nil {
+		return m.TrxId
+	}
+	return nil
+}
+
+type TrxResultEvent struct {
+	BlockNum uint64                                `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	Receipt  *prototype.TransactionReceiptWithInfo `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// the block including the trx was reverted, and the trx may be applied again later
+	Reverted bool `protobuf:"varint,3,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	// the block including the trx became irreversible, which ends the stream
+	Irreversible         bool     `protobuf:"varint,4,opt,name=irreversible,proto3" json:"irreversible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrxResultEvent) Reset()         { *m = TrxResultEvent{} }
+func (m *TrxResultEvent) String() string { return proto.CompactTextString(m) }
+func (*TrxResultEvent) ProtoMessage()    {}
+func (*TrxResultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{111}
+}
+
+func (m *TrxResultEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrxResultEvent.Unmarshal(m, b)
+}
+func (m *TrxResultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrxResultEvent.Marshal(b, m, deterministic)
+}
+func (m *TrxResultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrxResultEvent.Merge(m, src)
+}
+func (m *TrxResultEvent) XXX_Size() int {
+	return xxx_messageInfo_TrxResultEvent.Size(m)
+}
+func (m *TrxResultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrxResultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrxResultEvent proto.InternalMessageInfo
+
+func (m *TrxResultEvent) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *TrxResultEvent) GetReceipt() *prototype.TransactionReceiptWithInfo {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *TrxResultEvent) GetReverted() bool {
+	if m != nil {
+		return m.Reverted
+	}
+	return false
+}
+
+func (m *TrxResultEvent) GetIrreversible() bool {
+	if m != nil {
+		return m.Irreversible
+	}
+	return false
+}
+
+type BlockLogEvent struct {
+	BlockNum uint64 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// the block log in json
+	BlockLog      string `protobuf:"bytes,2,opt,name=block_log,json=blockLog,proto3" json:"block_log,omitempty"`
+	BlockProducer string `protobuf:"bytes,3,opt,name=block_producer,json=blockProducer,proto3" json:"block_producer,omitempty"`
+	// if non-zero, sent block logs since reverted_block were reverted, and logs of the new fork will follow
+	RevertedBlock        uint64   `protobuf:"varint,4,opt,name=reverted_block,json=revertedBlock,proto3" json:"reverted_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockLogEvent) Reset()         { *m = BlockLogEvent{} }
+func (m *BlockLogEvent) String() string { return proto.CompactTextString(m) }
+func (*BlockLogEvent) ProtoMessage()    {}
+func (*BlockLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{112}
+}
+
+func (m *BlockLogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockLogEvent.Unmarshal(m, b)
+}
+func (m *BlockLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockLogEvent.Marshal(b, m, deterministic)
+}
+func (m *BlockLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLogEvent.Merge(m, src)
+}
+func (m *BlockLogEvent) XXX_Size() int {
+	return xxx_messageInfo_BlockLogEvent.Size(m)
+}
+func (m *BlockLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockLogEvent proto.InternalMessageInfo
+
+func (m *BlockLogEvent) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *BlockLogEvent) GetBlockLog() string {
+	if m != nil {
+		return m.BlockLog
+	}
+	return ""
+}
+
+func (m *BlockLogEvent) GetBlockProducer() string {
+	if m != nil {
+		return m.BlockProducer
+	}
+	return ""
+}
+
+func (m *BlockLogEvent) GetRevertedBlock() uint64 {
+	if m != nil {
+		return m.RevertedBlock
+	}
+	return 0
+}
+
+type GetContractEventsRequest struct {
+	Owner    *prototype.AccountName `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Contract string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event names to match, empty means all events
+	Names      []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	StartBlock uint64   `protobuf:"varint,4,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// the last block to search, 0 means the head block
+	EndBlock             uint64             `protobuf:"varint,5,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	LastEvent            *ContractEventInfo `protobuf:"bytes,6,opt,name=last_event,json=lastEvent,proto3" json:"last_event,omitempty"`
+	Limit                uint32             `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetContractEventsRequest) Reset()         { *m = GetContractEventsRequest{} }
+func (m *GetContractEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractEventsRequest) ProtoMessage()    {}
+func (*GetContractEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{113}
+}
+
+func (m *GetContractEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractEventsRequest.Unmarshal(m, b)
+}
+func (m *GetContractEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractEventsRequest.Merge(m, src)
+}
+func (m *GetContractEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractEventsRequest.Size(m)
+}
+func (m *GetContractEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractEventsRequest proto.InternalMessageInfo
+
+func (m *GetContractEventsRequest) GetOwner() *prototype.AccountName {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *GetContractEventsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *GetContractEventsRequest) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *GetContractEventsRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *GetContractEventsRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *GetContractEventsRequest) GetLastEvent() *ContractEventInfo {
+	if m != nil {
+		return m.LastEvent
+	}
+	return nil
+}
+
+func (m *GetContractEventsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ContractEventInfo struct {
+	BlockNum             uint64                   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	TrxId                *prototype.Sha256        `protobuf:"bytes,2,opt,name=trx_id,json=trxId,proto3" json:"trx_id,omitempty"`
+	Event                *prototype.ContractEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ContractEventInfo) Reset()         { *m = ContractEventInfo{} }
+func (m *ContractEventInfo) String() string { return proto.CompactTextString(m) }
+func (*ContractEventInfo) ProtoMessage()    {}
+func (*ContractEventInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{114}
+}
+
+func (m *ContractEventInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractEventInfo.Unmarshal(m, b)
+}
+func (m *ContractEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractEventInfo.Marshal(b, m, deterministic)
+}
+func (m *ContractEventInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEventInfo.Merge(m, src)
+}
+func (m *ContractEventInfo) XXX_Size() int {
+	return xxx_messageInfo_ContractEventInfo.Size(m)
+}
+func (m *ContractEventInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEventInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEventInfo proto.InternalMessageInfo
+
+func (m *ContractEventInfo) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *ContractEventInfo) GetTrxId() *prototype.Sha256 {
+	if m != nil {
+		return m.TrxId
+	}
+	return nil
+}
+
+func (m *ContractEventInfo) GetEvent() *prototype.ContractEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type GetContractEventsResponse struct {
+	Events               []*ContractEventInfo `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetContractEventsResponse) Reset()         { *m = GetContractEventsResponse{} }
+func (m *GetContractEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractEventsResponse) ProtoMessage()    {}
+func (*GetContractEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{115}
+}
+
+func (m *GetContractEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractEventsResponse.Unmarshal(m, b)
+}
+func (m *GetContractEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetContractEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractEventsResponse.Merge(m, src)
+}
+func (m *GetContractEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractEventsResponse.Size(m)
+}
+func (m *GetContractEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractEventsResponse proto.InternalMessageInfo
+
+func (m *GetContractEventsResponse) GetEvents() []*ContractEventInfo {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetPendingTrxStatsRequest struct {
+	// number of busiest signers to return
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPendingTrxStatsRequest) Reset()         { *m = GetPendingTrxStatsRequest{} }
+func (m *GetPendingTrxStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTrxStatsRequest) ProtoMessage()    {}
+func (*GetPendingTrxStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{116}
+}
+
+func (m *GetPendingTrxStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTrxStatsRequest.Unmarshal(m, b)
+}
+func (m *GetPendingTrxStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTrxStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTrxStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTrxStatsRequest.Merge(m, src)
+}
+func (m *GetPendingTrxStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTrxStatsRequest.Size(m)
+}
+func (m *GetPendingTrxStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTrxStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTrxStatsRequest proto.InternalMessageInfo
+
+func (m *GetPendingTrxStatsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PendingSignerStats struct {
+	Signer               *prototype.AccountName `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Count                uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Size                 uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PendingSignerStats) Reset()         { *m = PendingSignerStats{} }
+func (m *PendingSignerStats) String() string { return proto.CompactTextString(m) }
+func (*PendingSignerStats) ProtoMessage()    {}
+func (*PendingSignerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{117}
+}
+
+func (m *PendingSignerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingSignerStats.Unmarshal(m, b)
+}
+func (m *PendingSignerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingSignerStats.Marshal(b, m, deterministic)
+}
+func (m *PendingSignerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSignerStats.Merge(m, src)
+}
+func (m *PendingSignerStats) XXX_Size() int {
+	return xxx_messageInfo_PendingSignerStats.Size(m)
+}
+func (m *PendingSignerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSignerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSignerStats proto.InternalMessageInfo
+
+func (m *PendingSignerStats) GetSigner() *prototype.AccountName {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *PendingSignerStats) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PendingSignerStats) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GetPendingTrxStatsResponse struct {
+	WaitingCount        uint32 `protobuf:"varint,1,opt,name=waiting_count,json=waitingCount,proto3" json:"waiting_count,omitempty"`
+	WaitingSize         uint64 `protobuf:"varint,2,opt,name=waiting_size,json=waitingSize,proto3" json:"waiting_size,omitempty"`
+	FetchedCount        uint32 `protobuf:"varint,3,opt,name=fetched_count,json=fetchedCount,proto3" json:"fetched_count,omitempty"`
+	MaxWaitingCount     uint32 `protobuf:"varint,4,opt,name=max_waiting_count,json=maxWaitingCount,proto3" json:"max_waiting_count,omitempty"`
+	MaxWaitingSize      uint64 `protobuf:"varint,5,opt,name=max_waiting_size,json=maxWaitingSize,proto3" json:"max_waiting_size,omitempty"`
+	MaxWaitingPerSigner uint32 `protobuf:"varint,6,opt,name=max_waiting_per_signer,json=maxWaitingPerSigner,proto3" json:"max_waiting_per_signer,omitempty"`
+	// number of waiting trxs evicted by higher priority ones
+	Evicted uint64 `protobuf:"varint,7,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	// number of trxs refused by the waiting pool
+	Rejected             uint64                `protobuf:"varint,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	TopSigners           []*PendingSignerStats `protobuf:"bytes,9,rep,name=top_signers,json=topSigners,proto3" json:"top_signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPendingTrxStatsResponse) Reset()         { *m = GetPendingTrxStatsResponse{} }
+func (m *GetPendingTrxStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTrxStatsResponse) ProtoMessage()    {}
+func (*GetPendingTrxStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{118}
+}
+
+func (m *GetPendingTrxStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTrxStatsResponse.Unmarshal(m, b)
+}
+func (m *GetPendingTrxStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTrxStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTrxStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTrxStatsResponse.Merge(m, src)
+}
+func (m *GetPendingTrxStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTrxStatsResponse.Size(m)
+}
+func (m *GetPendingTrxStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTrxStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPendingTrxStatsResponse proto.InternalMessageInfo
+
+func (m *GetPendingTrxStatsResponse) GetWaitingCount() uint32 {
+	if m != nil {
+		return m.WaitingCount
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetWaitingSize() uint64 {
+	if m != nil {
+		return m.WaitingSize
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetFetchedCount() uint32 {
+	if m != nil {
+		return m.FetchedCount
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetMaxWaitingCount() uint32 {
+	if m != nil {
+		return m.MaxWaitingCount
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetMaxWaitingSize() uint64 {
+	if m != nil {
+		return m.MaxWaitingSize
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetMaxWaitingPerSigner() uint32 {
+	if m != nil {
+		return m.MaxWaitingPerSigner
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetEvicted() uint64 {
+	if m != nil {
+		return m.Evicted
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetRejected() uint64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *GetPendingTrxStatsResponse) GetTopSigners() []*PendingSignerStats {
+	if m != nil {
+		return m.TopSigners
+	}
+	return nil
+}
+
+type Escrow struct {
+	Id                   uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccount          *prototype.AccountName  `protobuf:"bytes,2,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount            *prototype.AccountName  `protobuf:"bytes,3,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	Agent                *prototype.AccountName  `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
+	Amount               *prototype.Coin         `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AgentFee             *prototype.Coin         `protobuf:"bytes,6,opt,name=agent_fee,json=agentFee,proto3" json:"agent_fee,omitempty"`
+	Memo                 string                  `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	CreatedTime          *prototype.TimePointSec `protobuf:"bytes,8,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	RatificationDeadline *prototype.TimePointSec `protobuf:"bytes,9,opt,name=ratification_deadline,json=ratificationDeadline,proto3" json:"ratification_deadline,omitempty"`
+	EscrowExpiration     *prototype.TimePointSec `protobuf:"bytes,10,opt,name=escrow_expiration,json=escrowExpiration,proto3" json:"escrow_expiration,omitempty"`
+	ToApproved           bool                    `protobuf:"varint,11,opt,name=to_approved,json=toApproved,proto3" json:"to_approved,omitempty"`
+	AgentApproved        bool                    `protobuf:"varint,12,opt,name=agent_approved,json=agentApproved,proto3" json:"agent_approved,omitempty"`
+	Disputed             bool                    `protobuf:"varint,13,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{119}
+}
+
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Escrow.Unmarshal(m, b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return xxx_messageInfo_Escrow.Size(m)
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Escrow) GetFromAccount() *prototype.AccountName {
+	if m != nil {
+		return m.FromAccount
+	}
+	return nil
+}
+
+func (m *Escrow) GetToAccount() *prototype.AccountName {
+	if m != nil {
+		return m.ToAccount
+	}
+	return nil
+}
+
+func (m *Escrow) GetAgent() *prototype.AccountName {
+	if m != nil {
+		return m.Agent
+	}
+	return nil
+}
+
+func (m *Escrow) GetAmount() *prototype.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Escrow) GetAgentFee() *prototype.Coin {
+	if m != nil {
+		return m.AgentFee
+	}
+	return nil
+}
+
+func (m *Escrow) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Escrow) GetCreatedTime() *prototype.TimePointSec {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Escrow) GetRatificationDeadline() *prototype.TimePointSec {
+	if m != nil {
+		return m.RatificationDeadline
+	}
+	return nil
+}
+
+func (m *Escrow) GetEscrowExpiration() *prototype.TimePointSec {
+	if m != nil {
+		return m.EscrowExpiration
+	}
+	return nil
+}
+
+func (m *Escrow) GetToApproved() bool {
+	if m != nil {
+		return m.ToApproved
+	}
+	return false
+}
+
+func (m *Escrow) GetAgentApproved() bool {
+	if m != nil {
+		return m.AgentApproved
+	}
+	return false
+}
+
+func (m *Escrow) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
+type GetEscrowListRequest struct {
+	Account              *prototype.AccountName `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Party                EscrowParty            `protobuf:"varint,2,opt,name=party,proto3,enum=grpcpb.EscrowParty" json:"party,omitempty"`
+	Limit                uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastEscrowId         uint64                 `protobuf:"varint,4,opt,name=last_escrow_id,json=lastEscrowId,proto3" json:"last_escrow_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetEscrowListRequest) Reset()         { *m = GetEscrowListRequest{} }
+func (m *GetEscrowListRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEscrowListRequest) ProtoMessage()    {}
+func (*GetEscrowListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{120}
+}
+
+func (m *GetEscrowListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEscrowListRequest.Unmarshal(m, b)
+}
+func (m *GetEscrowListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEscrowListRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEscrowListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscrowListRequest.Merge(m, src)
+}
+func (m *GetEscrowListRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEscrowListRequest.Size(m)
+}
+func (m *GetEscrowListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscrowListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscrowListRequest proto.InternalMessageInfo
+
+func (m *GetEscrowListRequest) GetAccount() *prototype.AccountName {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetEscrowListRequest) GetParty() EscrowParty {
+	if m != nil {
+		return m.Party
+	}
+	return EscrowParty_ESCROW_FROM
+}
+
+func (m *GetEscrowListRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetEscrowListRequest) GetLastEscrowId() uint64 {
+	if m != nil {
+		return m.LastEscrowId
+	}
+	return 0
+}
+
+type GetEscrowListResponse struct {
+	Escrows              []*Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetEscrowListResponse) Reset()         { *m = GetEscrowListResponse{} }
+func (m *GetEscrowListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEscrowListResponse) ProtoMessage()    {}
+func (*GetEscrowListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{121}
+}
+
+func (m *GetEscrowListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEscrowListResponse.Unmarshal(m, b)
+}
+func (m *GetEscrowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEscrowListResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEscrowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscrowListResponse.Merge(m, src)
+}
+func (m *GetEscrowListResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEscrowListResponse.Size(m)
+}
+func (m *GetEscrowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscrowListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscrowListResponse proto.InternalMessageInfo
+
+func (m *GetEscrowListResponse) GetEscrows() []*Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+type PeerReputation struct {
+	Ip                   string                  `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Score                float64                 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	BannedUntil          *prototype.TimePointSec `protobuf:"bytes,3,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PeerReputation) Reset()         { *m = PeerReputation{} }
+func (m *PeerReputation) String() string { return proto.CompactTextString(m) }
+func (*PeerReputation) ProtoMessage()    {}
+func (*PeerReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{122}
+}
+
+func (m *PeerReputation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerReputation.Unmarshal(m, b)
+}
+func (m *PeerReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerReputation.Marshal(b, m, deterministic)
+}
+func (m *PeerReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerReputation.Merge(m, src)
+}
+func (m *PeerReputation) XXX_Size() int {
+	return xxx_messageInfo_PeerReputation.Size(m)
+}
+func (m *PeerReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerReputation proto.InternalMessageInfo
+
+func (m *PeerReputation) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *PeerReputation) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerReputation) GetBannedUntil() *prototype.TimePointSec {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return nil
+}
+
+type GetPeerReputationsResponse struct {
+	Peers                []*PeerReputation `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPeerReputationsResponse) Reset()         { *m = GetPeerReputationsResponse{} }
+func (m *GetPeerReputationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPeerReputationsResponse) ProtoMessage()    {}
+func (*GetPeerReputationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{123}
+}
+
+func (m *GetPeerReputationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPeerReputationsResponse.Unmarshal(m, b)
+}
+func (m *GetPeerReputationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPeerReputationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPeerReputationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPeerReputationsResponse.Merge(m, src)
+}
+func (m *GetPeerReputationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPeerReputationsResponse.Size(m)
+}
+func (m *GetPeerReputationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPeerReputationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPeerReputationsResponse proto.InternalMessageInfo
+
+func (m *GetPeerReputationsResponse) GetPeers() []*PeerReputation {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type BanPeerRequest struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Duration             uint32   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanPeerRequest) Reset()         { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()    {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{124}
+}
+
+func (m *BanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPeerRequest.Unmarshal(m, b)
+}
+func (m *BanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *BanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerRequest.Merge(m, src)
+}
+func (m *BanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_BanPeerRequest.Size(m)
+}
+func (m *BanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanPeerRequest proto.InternalMessageInfo
+
+func (m *BanPeerRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *BanPeerRequest) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type BanPeerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanPeerResponse) Reset()         { *m = BanPeerResponse{} }
+func (m *BanPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*BanPeerResponse) ProtoMessage()    {}
+func (*BanPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{125}
+}
+
+func (m *BanPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPeerResponse.Unmarshal(m, b)
+}
+func (m *BanPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPeerResponse.Marshal(b, m, deterministic)
+}
+func (m *BanPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerResponse.Merge(m, src)
+}
+func (m *BanPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_BanPeerResponse.Size(m)
+}
+func (m *BanPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanPeerResponse proto.InternalMessageInfo
+
+type UnbanPeerRequest struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanPeerRequest) Reset()         { *m = UnbanPeerRequest{} }
+func (m *UnbanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerRequest) ProtoMessage()    {}
+func (*UnbanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{126}
+}
+
+func (m *UnbanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanPeerRequest.Unmarshal(m, b)
+}
+func (m *UnbanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *UnbanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerRequest.Merge(m, src)
+}
+func (m *UnbanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanPeerRequest.Size(m)
+}
+func (m *UnbanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanPeerRequest proto.InternalMessageInfo
+
+func (m *UnbanPeerRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type UnbanPeerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanPeerResponse) Reset()         { *m = UnbanPeerResponse{} }
+func (m *UnbanPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerResponse) ProtoMessage()    {}
+func (*UnbanPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{127}
+}
+
+func (m *UnbanPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanPeerResponse.Unmarshal(m, b)
+}
+func (m *UnbanPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanPeerResponse.Marshal(b, m, deterministic)
+}
+func (m *UnbanPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerResponse.Merge(m, src)
+}
+func (m *UnbanPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_UnbanPeerResponse.Size(m)
+}
+func (m *UnbanPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanPeerResponse proto.InternalMessageInfo
+
+type Proposal struct {
+	Id                   uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer             *prototype.AccountName     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title                string                     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Props                *prototype.ChainProperties `protobuf:"bytes,5,opt,name=props,proto3" json:"props,omitempty"`
+	Feature              string                     `protobuf:"bytes,6,opt,name=feature,proto3" json:"feature,omitempty"`
+	ActivationBlockNum   uint64                     `protobuf:"varint,7,opt,name=activation_block_num,json=activationBlockNum,proto3" json:"activation_block_num,omitempty"`
+	Status               ProposalStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=grpcpb.ProposalStatus" json:"status,omitempty"`
+	Approvals            []string                   `protobuf:"bytes,9,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	CreatedTime          *prototype.TimePointSec    `protobuf:"bytes,10,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	RewardPolicy         *prototype.RewardPolicy    `protobuf:"bytes,11,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{128}
+}
+
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proposal.Unmarshal(m, b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return xxx_messageInfo_Proposal.Size(m)
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetProposer() *prototype.AccountName {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Proposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Proposal) GetProps() *prototype.ChainProperties {
+	if m != nil {
+		return m.Props
+	}
+	return nil
+}
+
+func (m *Proposal) GetFeature() string {
+	if m != nil {
+		return m.Feature
+	}
+	return ""
+}
+
+func (m *Proposal) GetActivationBlockNum() uint64 {
+	if m != nil {
+		return m.ActivationBlockNum
+	}
+	return 0
+}
+
+func (m *Proposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_PENDING
+}
+
+func (m *Proposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *Proposal) GetCreatedTime() *prototype.TimePointSec {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetRewardPolicy() *prototype.RewardPolicy {
+	if m != nil {
+		return m.RewardPolicy
+	}
+	return nil
+}
+
+type GetProposalListRequest struct {
+	Status               ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=grpcpb.ProposalStatus" json:"status,omitempty"`
+	Limit                uint32         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastProposalId       uint64         `protobuf:"varint,3,opt,name=last_proposal_id,json=lastProposalId,proto3" json:"last_proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetProposalListRequest) Reset()         { *m = GetProposalListRequest{} }
+func (m *GetProposalListRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProposalListRequest) ProtoMessage()    {}
+func (*GetProposalListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{129}
+}
+
+func (m *GetProposalListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalListRequest.Unmarshal(m, b)
+}
+func (m *GetProposalListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalListRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProposalListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalListRequest.Merge(m, src)
+}
+func (m *GetProposalListRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProposalListRequest.Size(m)
+}
+func (m *GetProposalListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalListRequest proto.InternalMessageInfo
+
+func (m *GetProposalListRequest) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_PENDING
+}
+
+func (m *GetProposalListRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetProposalListRequest) GetLastProposalId() uint64 {
+	if m != nil {
+		return m.LastProposalId
+	}
+	return 0
+}
+
+type GetProposalListResponse struct {
+	Proposals            []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetProposalListResponse) Reset()         { *m = GetProposalListResponse{} }
+func (m *GetProposalListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProposalListResponse) ProtoMessage()    {}
+func (*GetProposalListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{130}
+}
+
+func (m *GetProposalListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalListResponse.Unmarshal(m, b)
+}
+func (m *GetProposalListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalListResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProposalListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalListResponse.Merge(m, src)
+}
+func (m *GetProposalListResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProposalListResponse.Size(m)
+}
+func (m *GetProposalListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalListResponse proto.InternalMessageInfo
+
+func (m *GetProposalListResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type GetProposalRequest struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProposalRequest) Reset()         { *m = GetProposalRequest{} }
+func (m *GetProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProposalRequest) ProtoMessage()    {}
+func (*GetProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{131}
+}
+
+func (m *GetProposalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalRequest.Unmarshal(m, b)
+}
+func (m *GetProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalRequest.Merge(m, src)
+}
+func (m *GetProposalRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProposalRequest.Size(m)
+}
+func (m *GetProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalRequest proto.InternalMessageInfo
+
+func (m *GetProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type GetProposalResponse struct {
+	Proposal             *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetProposalResponse) Reset()         { *m = GetProposalResponse{} }
+func (m *GetProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProposalResponse) ProtoMessage()    {}
+func (*GetProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{132}
+}
+
+func (m *GetProposalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalResponse.Unmarshal(m, b)
+}
+func (m *GetProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalResponse.Merge(m, src)
+}
+func (m *GetProposalResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProposalResponse.Size(m)
+}
+func (m *GetProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalResponse proto.InternalMessageInfo
+
+func (m *GetProposalResponse) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+type PostRevision struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body    string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// when the version was written
+	Created              *prototype.TimePointSec `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PostRevision) Reset()         { *m = PostRevision{} }
+func (m *PostRevision) String() string { return proto.CompactTextString(m) }
+func (*PostRevision) ProtoMessage()    {}
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{133}
+}
+
+func (m *PostRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostRevision.Unmarshal(m, b)
+}
+func (m *PostRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostRevision.Marshal(b, m, deterministic)
+}
+func (m *PostRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevision.Merge(m, src)
+}
+func (m *PostRevision) XXX_Size() int {
+	return xxx_messageInfo_PostRevision.Size(m)
+}
+func (m *PostRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostRevision proto.InternalMessageInfo
+
+func (m *PostRevision) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PostRevision) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PostRevision) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *PostRevision) GetCreated() *prototype.TimePointSec {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type GetPostRevisionsRequest struct {
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// the first version to return
+	StartVersion         uint32   `protobuf:"varint,2,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPostRevisionsRequest) Reset()         { *m = GetPostRevisionsRequest{} }
+func (m *GetPostRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPostRevisionsRequest) ProtoMessage()    {}
+func (*GetPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{134}
+}
+
+func (m *GetPostRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPostRevisionsRequest.Unmarshal(m, b)
+}
+func (m *GetPostRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPostRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPostRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPostRevisionsRequest.Merge(m, src)
+}
+func (m *GetPostRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPostRevisionsRequest.Size(m)
+}
+func (m *GetPostRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPostRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPostRevisionsRequest proto.InternalMessageInfo
+
+func (m *GetPostRevisionsRequest) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *GetPostRevisionsRequest) GetStartVersion() uint32 {
+	if m != nil {
+		return m.StartVersion
+	}
+	return 0
+}
+
+func (m *GetPostRevisionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetPostRevisionsResponse struct {
+	// revisions in ascending order of versions, the current one is the last if reached
+	Revisions            []*PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	CurrentVersion       uint32          `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	Deleted              bool            `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPostRevisionsResponse) Reset()         { *m = GetPostRevisionsResponse{} }
+func (m *GetPostRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPostRevisionsResponse) ProtoMessage()    {}
+func (*GetPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedfbfc9b54e5600, []int{135}
+}
+
+func (m *GetPostRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPostRevisionsResponse.Unmarshal(m, b)
+}
+func (m *GetPostRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPostRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPostRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPostRevisionsResponse.Merge(m, src)
+}
+func (m *GetPostRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPostRevisionsResponse.Size(m)
+}
+func (m *GetPostRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPostRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPostRevisionsResponse proto.InternalMessageInfo
+
+func (m *GetPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *GetPostRevisionsResponse) GetCurrentVersion() uint32 {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return 0
+}
+
+func (m *GetPostRevisionsResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("grpcpb.EscrowParty", EscrowParty_name, EscrowParty_value)
+	proto.RegisterEnum("grpcpb.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*GetTableContentRequest)(nil), "grpcpb.GetTableContentRequest")
+	proto.RegisterType((*TableContentResponse)(nil), "grpcpb.TableContentResponse")
+	proto.RegisterType((*GetAccountByPubKeyRequest)(nil), "grpcpb.GetAccountByPubKeyRequest")
+	proto.RegisterType((*GetAccountByNameRequest)(nil), "grpcpb.GetAccountByNameRequest")
+	proto.RegisterType((*GetBlockProducerByNameRequest)(nil), "grpcpb.GetBlockProducerByNameRequest")
+	proto.RegisterType((*GetAccountCashoutRequest)(nil), "grpcpb.GetAccountCashoutRequest")
+	proto.RegisterType((*AccountCashoutResponse)(nil), "grpcpb.AccountCashoutResponse")
+	proto.RegisterType((*GetBlockCashoutRequest)(nil), "grpcpb.GetBlockCashoutRequest")
+	proto.RegisterType((*BlockCashoutResponse)(nil), "grpcpb.BlockCashoutResponse")
+	proto.RegisterType((*GetAccountRewardByNameRequest)(nil), "grpcpb.GetAccountRewardByNameRequest")
+	proto.RegisterType((*AccountRewardResponse)(nil), "grpcpb.AccountRewardResponse")
+	proto.RegisterType((*AccountInfo)(nil), "grpcpb.AccountInfo")
+	proto.RegisterType((*AccountResponse)(nil), "grpcpb.AccountResponse")
+	proto.RegisterType((*GetFollowerListByNameRequest)(nil), "grpcpb.GetFollowerListByNameRequest")
+	proto.RegisterType((*FollowerListInfo)(nil), "grpcpb.FollowerListInfo")
+	proto.RegisterType((*GetFollowerListByNameResponse)(nil), "grpcpb.GetFollowerListByNameResponse")
+	proto.RegisterType((*GetFollowingListByNameRequest)(nil), "grpcpb.GetFollowingListByNameRequest")
+	proto.RegisterType((*FollowingListInfo)(nil), "grpcpb.FollowingListInfo")
+	proto.RegisterType((*GetFollowingListByNameResponse)(nil), "grpcpb.GetFollowingListByNameResponse")
+	proto.RegisterType((*GetFollowCountByNameRequest)(nil), "grpcpb.GetFollowCountByNameRequest")
+	proto.RegisterType((*GetFollowCountByNameResponse)(nil), "grpcpb.GetFollowCountByNameResponse")
+	proto.RegisterType((*BlockProducerResponse)(nil), "grpcpb.BlockProducerResponse")
+	proto.RegisterType((*GetBlockProducerListRequest)(nil), "grpcpb.GetBlockProducerListRequest")
+	proto.RegisterType((*GetBlockProducerListResponse)(nil), "grpcpb.GetBlockProducerListResponse")
+	proto.RegisterType((*PostResponse)(nil), "grpcpb.PostResponse")
+	proto.RegisterType((*GetPostListByCreatedRequest)(nil), "grpcpb.GetPostListByCreatedRequest")
+	proto.RegisterType((*GetPostListByCreatedResponse)(nil), "grpcpb.GetPostListByCreatedResponse")
+	proto.RegisterType((*GetReplyListByPostIdRequest)(nil), "grpcpb.GetReplyListByPostIdRequest")
+	proto.RegisterType((*GetReplyListByPostIdResponse)(nil), "grpcpb.GetReplyListByPostIdResponse")
 	proto.RegisterType((*GetBlockTransactionsByNumRequest)(nil), "grpcpb.GetBlockTransactionsByNumRequest")
 	proto.RegisterType((*GetBlockTransactionsByNumResponse)(nil), "grpcpb.GetBlockTransactionsByNumResponse")
 	proto.RegisterType((*GetChainStateResponse)(nil), "grpcpb.GetChainStateResponse")
 	proto.RegisterType((*GetNodeNeighboursResponse)(nil), "grpcpb.GetNodeNeighboursResponse")
+	proto.RegisterType((*ChainFeature)(nil), "grpcpb.ChainFeature")
 	proto.RegisterType((*GetNodeRunningVersionResponse)(nil), "grpcpb.GetNodeRunningVersionResponse")
 	proto.RegisterType((*BroadcastTrxRequest)(nil), "grpcpb.BroadcastTrxRequest")
 	proto.RegisterType((*BroadcastTrxResponse)(nil), "grpcpb.BroadcastTrxResponse")
@@ -5564,344 +7785,500 @@ func init() {
 	proto.RegisterType((*VestDelegationOrder)(nil), "grpcpb.VestDelegationOrder")
 	proto.RegisterType((*GetVestDelegationOrderListRequest)(nil), "grpcpb.GetVestDelegationOrderListRequest")
 	proto.RegisterType((*GetVestDelegationOrderListResponse)(nil), "grpcpb.GetVestDelegationOrderListResponse")
+	proto.RegisterType((*GetAccountProofRequest)(nil), "grpcpb.GetAccountProofRequest")
+	proto.RegisterType((*GetPostProofRequest)(nil), "grpcpb.GetPostProofRequest")
+	proto.RegisterType((*StateProof)(nil), "grpcpb.StateProof")
+	proto.RegisterType((*StateProofResponse)(nil), "grpcpb.StateProofResponse")
+	proto.RegisterType((*GetTrxInclusionProofRequest)(nil), "grpcpb.GetTrxInclusionProofRequest")
+	proto.RegisterType((*TrxInclusionProof)(nil), "grpcpb.TrxInclusionProof")
+	proto.RegisterType((*GetTrxInclusionProofResponse)(nil), "grpcpb.GetTrxInclusionProofResponse")
+	proto.RegisterType((*GetBlockHeaderWithCommitRequest)(nil), "grpcpb.GetBlockHeaderWithCommitRequest")
+	proto.RegisterType((*GetBlockHeaderWithCommitResponse)(nil), "grpcpb.GetBlockHeaderWithCommitResponse")
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "grpcpb.SubscribeBlocksRequest")
+	proto.RegisterType((*BlockEvent)(nil), "grpcpb.BlockEvent")
+	proto.RegisterType((*SubscribeTrxResultRequest)(nil), "grpcpb.SubscribeTrxResultRequest")
+	proto.RegisterType((*TrxResultEvent)(nil), "grpcpb.TrxResultEvent")
+	proto.RegisterType((*BlockLogEvent)(nil), "grpcpb.BlockLogEvent")
+	proto.RegisterType((*GetContractEventsRequest)(nil), "grpcpb.GetContractEventsRequest")
+	proto.RegisterType((*ContractEventInfo)(nil), "grpcpb.ContractEventInfo")
+	proto.RegisterType((*GetContractEventsResponse)(nil), "grpcpb.GetContractEventsResponse")
+	proto.RegisterType((*GetPendingTrxStatsRequest)(nil), "grpcpb.GetPendingTrxStatsRequest")
+	proto.RegisterType((*PendingSignerStats)(nil), "grpcpb.PendingSignerStats")
+	proto.RegisterType((*GetPendingTrxStatsResponse)(nil), "grpcpb.GetPendingTrxStatsResponse")
+	proto.RegisterType((*Escrow)(nil), "grpcpb.Escrow")
+	proto.RegisterType((*GetEscrowListRequest)(nil), "grpcpb.GetEscrowListRequest")
+	proto.RegisterType((*GetEscrowListResponse)(nil), "grpcpb.GetEscrowListResponse")
+	proto.RegisterType((*PeerReputation)(nil), "grpcpb.PeerReputation")
+	proto.RegisterType((*GetPeerReputationsResponse)(nil), "grpcpb.GetPeerReputationsResponse")
+	proto.RegisterType((*BanPeerRequest)(nil), "grpcpb.BanPeerRequest")
+	proto.RegisterType((*BanPeerResponse)(nil), "grpcpb.BanPeerResponse")
+	proto.RegisterType((*UnbanPeerRequest)(nil), "grpcpb.UnbanPeerRequest")
+	proto.RegisterType((*UnbanPeerResponse)(nil), "grpcpb.UnbanPeerResponse")
+	proto.RegisterType((*Proposal)(nil), "grpcpb.Proposal")
+	proto.RegisterType((*GetProposalListRequest)(nil), "grpcpb.GetProposalListRequest")
+	proto.RegisterType((*GetProposalListResponse)(nil), "grpcpb.GetProposalListResponse")
+	proto.RegisterType((*GetProposalRequest)(nil), "grpcpb.GetProposalRequest")
+	proto.RegisterType((*GetProposalResponse)(nil), "grpcpb.GetProposalResponse")
+	proto.RegisterType((*PostRevision)(nil), "grpcpb.PostRevision")
+	proto.RegisterType((*GetPostRevisionsRequest)(nil), "grpcpb.GetPostRevisionsRequest")
+	proto.RegisterType((*GetPostRevisionsResponse)(nil), "grpcpb.GetPostRevisionsResponse")
 }
 
 func init() { proto.RegisterFile("grpc.proto", fileDescriptor_bedfbfc9b54e5600) }
 
 var fileDescriptor_bedfbfc9b54e5600 = []byte{
-	// 5305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x1c, 0x72, 0xf8, 0x31, 0x6f, 0x38, 0xfc, 0x28, 0x52, 0xd2, 0x68, 0x28, 0x51, 0x52, 0xdb,
-	0xb2, 0x24, 0x7f, 0xd0, 0x36, 0x6d, 0xcb, 0xdf, 0xf6, 0x92, 0xd4, 0xc7, 0x72, 0x6d, 0xd1, 0x72,
-	0x8b, 0x96, 0xd7, 0x59, 0x6c, 0x7a, 0x7b, 0xa6, 0x8b, 0x64, 0x5b, 0x33, 0xdd, 0xbd, 0xd5, 0x3d,
-	0x12, 0xc7, 0xc8, 0x9e, 0x72, 0x0a, 0x82, 0x3d, 0x04, 0x48, 0x0e, 0x49, 0x80, 0x20, 0xa7, 0x00,
-	0x7b, 0x08, 0x90, 0x1c, 0x16, 0x08, 0xb0, 0x01, 0x82, 0x1c, 0x82, 0x5c, 0x92, 0x43, 0x0e, 0xc1,
-	0xfe, 0x81, 0x1c, 0x82, 0x9c, 0xf3, 0x03, 0x16, 0xf5, 0xaa, 0xaa, 0xbb, 0xaa, 0xa7, 0x7b, 0x66,
-	0x64, 0x6b, 0x7d, 0x21, 0xa6, 0xaa, 0x5e, 0x55, 0xbd, 0x7a, 0xf5, 0xea, 0x7d, 0x37, 0x01, 0x8e,
-	0x59, 0xd4, 0xd9, 0x8a, 0x58, 0x98, 0x84, 0x64, 0x8e, 0xff, 0x8e, 0xda, 0xad, 0x75, 0x6c, 0x26,
-	0x83, 0x88, 0xbe, 0xca, 0xff, 0x88, 0xd1, 0x56, 0x33, 0xeb, 0xed, 0xf5, 0xbb, 0x89, 0xef, 0xf8,
-	0x9e, 0x1c, 0xd9, 0xd0, 0xe0, 0x99, 0x1b, 0xc4, 0x6e, 0x27, 0xf1, 0xc3, 0x40, 0x0c, 0x5a, 0xff,
-	0x5c, 0x81, 0xb3, 0x77, 0x69, 0x72, 0xe8, 0xb6, 0xbb, 0x74, 0x2f, 0x0c, 0x12, 0x1a, 0x24, 0x36,
-	0xfd, 0x79, 0x9f, 0xc6, 0x09, 0x59, 0x87, 0xd9, 0xf0, 0x49, 0x40, 0x59, 0xb3, 0x72, 0xb9, 0x72,
-	0xbd, 0x66, 0x8b, 0x06, 0x69, 0xc1, 0x42, 0x27, 0x0c, 0x12, 0xe6, 0x76, 0x92, 0xe6, 0x34, 0x0e,
-	0xa4, 0x6d, 0x3e, 0x23, 0xe1, 0x0b, 0x35, 0x67, 0xc4, 0x0c, 0x6c, 0xf0, 0xde, 0x23, 0x9f, 0x76,
-	0xbd, 0x66, 0x55, 0xf4, 0x62, 0x83, 0xf7, 0xb6, 0xe9, 0xb1, 0x1f, 0x34, 0x67, 0x45, 0x2f, 0x36,
-	0x78, 0x6f, 0x27, 0xec, 0x07, 0x49, 0x73, 0xee, 0x72, 0xe5, 0x7a, 0xc3, 0x16, 0x0d, 0xd2, 0x84,
-	0x79, 0x46, 0x1f, 0x53, 0x16, 0xd3, 0xe6, 0xfc, 0xe5, 0xca, 0xf5, 0x05, 0x5b, 0x35, 0xad, 0xf7,
-	0x61, 0xdd, 0x44, 0x3d, 0x8e, 0xc2, 0x20, 0xa6, 0xe4, 0x39, 0x68, 0xe0, 0xe6, 0x4e, 0x47, 0x0c,
-	0xc8, 0x33, 0x2c, 0x26, 0x1a, 0xb0, 0xf5, 0x1e, 0x9c, 0xbf, 0x4b, 0x93, 0x9d, 0x0e, 0x6e, 0xb2,
-	0x3b, 0xb8, 0xdf, 0x6f, 0x7f, 0x42, 0x07, 0xea, 0xf4, 0x17, 0x01, 0xa2, 0x7e, 0xbb, 0xeb, 0x77,
-	0x9c, 0x47, 0x74, 0x20, 0xa7, 0xd7, 0x44, 0xcf, 0x27, 0x74, 0x60, 0x7d, 0x01, 0xe7, 0xf4, 0xb9,
-	0x07, 0x6e, 0x8f, 0xaa, 0x99, 0xef, 0xc1, 0xa2, 0x2b, 0xfa, 0x9d, 0xc0, 0xed, 0x51, 0x9c, 0x5b,
-	0xdf, 0x3e, 0xb7, 0x95, 0x5e, 0xc3, 0x96, 0x3e, 0x6c, 0xd7, 0x65, 0x8b, 0x2f, 0x61, 0x7d, 0x0e,
-	0x17, 0xef, 0xd2, 0x64, 0xb7, 0x1b, 0x76, 0x1e, 0xdd, 0x67, 0xa1, 0xd7, 0xef, 0x50, 0x66, 0x2e,
-	0xfe, 0x1a, 0xcc, 0xb7, 0xa3, 0x89, 0xd6, 0x9d, 0x6b, 0x47, 0xb8, 0x64, 0x08, 0xcd, 0x0c, 0xd3,
-	0x3d, 0x37, 0x3e, 0x09, 0xfb, 0xc9, 0x33, 0x40, 0x95, 0x9c, 0x83, 0xf9, 0x28, 0x8c, 0x13, 0xc7,
-	0xf7, 0x90, 0x0f, 0xaa, 0xf6, 0x1c, 0x6f, 0xee, 0x7b, 0xd6, 0x2f, 0xe0, 0x6c, 0x7e, 0x37, 0x79,
-	0x2b, 0xdf, 0x65, 0xbb, 0x6b, 0x30, 0xc7, 0xe8, 0x13, 0x97, 0x89, 0xdd, 0xea, 0xdb, 0xcb, 0xda,
-	0xac, 0xc7, 0x34, 0x4e, 0x6c, 0x39, 0x6c, 0xbd, 0x8f, 0x0c, 0x8d, 0x24, 0xcc, 0x9d, 0xf6, 0x0a,
-	0x2c, 0xb6, 0x79, 0xb7, 0x73, 0x42, 0xfd, 0xe3, 0x13, 0xc1, 0x13, 0x55, 0xbb, 0x8e, 0x7d, 0x3f,
-	0xc4, 0x2e, 0xeb, 0x2b, 0x58, 0x37, 0x67, 0x4a, 0xcc, 0x77, 0x60, 0xb1, 0x23, 0xba, 0x9c, 0xae,
-	0x1f, 0xf3, 0xa9, 0x33, 0xd7, 0xeb, 0xdb, 0x9b, 0x5b, 0xe2, 0x49, 0x6e, 0x15, 0x9f, 0xd7, 0xae,
-	0xcb, 0x39, 0x9f, 0xfa, 0x71, 0x62, 0xfd, 0x04, 0xaf, 0x56, 0x42, 0xda, 0x88, 0xeb, 0xb3, 0xe3,
-	0x9b, 0x3f, 0x82, 0x33, 0xc6, 0xca, 0xdf, 0x2f, 0xc9, 0xff, 0x7f, 0x11, 0xea, 0x72, 0xfb, 0xfd,
-	0xe0, 0x28, 0xfc, 0x4e, 0x9b, 0x3e, 0x07, 0xd5, 0x4e, 0xe8, 0x07, 0x05, 0x5b, 0xf2, 0x6e, 0x1b,
-	0x07, 0x39, 0x10, 0x47, 0x00, 0xe5, 0x4c, 0x01, 0x5e, 0x38, 0x48, 0xde, 0x35, 0x5e, 0x70, 0x15,
-	0x41, 0x5b, 0x1a, 0x68, 0x36, 0xe8, 0xf0, 0xb6, 0xf6, 0xba, 0xc9, 0x07, 0xb0, 0xd8, 0x61, 0xd4,
-	0x4d, 0xa8, 0xe7, 0x24, 0x7e, 0x8f, 0xa2, 0x8c, 0xaa, 0x6f, 0x9f, 0xd7, 0x26, 0xf3, 0x6e, 0x27,
-	0x0a, 0xfd, 0x20, 0x71, 0x62, 0xda, 0xb1, 0xeb, 0x12, 0xfc, 0xd0, 0xef, 0x51, 0x72, 0x0b, 0x96,
-	0x04, 0x9f, 0x45, 0xf2, 0x09, 0xa3, 0x34, 0xab, 0x6f, 0x5f, 0x54, 0xec, 0x62, 0xbc, 0xef, 0x94,
-	0x5b, 0x1a, 0x6d, 0xbd, 0x1b, 0x05, 0x10, 0x7f, 0x5f, 0x42, 0x1e, 0xce, 0xa3, 0x3c, 0xac, 0xf1,
-	0x9e, 0x3d, 0x94, 0x89, 0x57, 0x61, 0xe9, 0x28, 0xec, 0x76, 0xc3, 0x27, 0x94, 0x49, 0x90, 0x05,
-	0x04, 0x69, 0xa8, 0x5e, 0x01, 0x76, 0x0d, 0x96, 0x45, 0x87, 0x1f, 0x1c, 0x4b, 0xb8, 0x1a, 0xc2,
-	0x2d, 0xa5, 0xdd, 0x02, 0x70, 0x03, 0x6a, 0x09, 0x3b, 0x95, 0x20, 0x80, 0x20, 0x0b, 0x09, 0x3b,
-	0x15, 0x83, 0x17, 0x01, 0x1e, 0x87, 0x09, 0x3f, 0xf0, 0x13, 0xca, 0x9a, 0x75, 0x81, 0x0b, 0xef,
-	0xb9, 0xcf, 0x3b, 0xc8, 0x16, 0xac, 0xc5, 0x89, 0xdb, 0xf3, 0x03, 0xd7, 0x39, 0x62, 0x94, 0x3a,
-	0x8c, 0xf6, 0x5c, 0x3f, 0x68, 0x2e, 0xe2, 0xfb, 0x5a, 0x95, 0x43, 0x77, 0x18, 0xa5, 0x36, 0x0e,
-	0x90, 0xd7, 0x60, 0x5d, 0xc1, 0xc7, 0x89, 0xfb, 0x28, 0x9d, 0xd0, 0xc0, 0x09, 0x44, 0x8e, 0x3d,
-	0xe0, 0x43, 0x72, 0xc6, 0x25, 0xa8, 0xab, 0x19, 0x3d, 0xf7, 0xb4, 0xb9, 0x84, 0x80, 0x20, 0xbb,
-	0xee, 0xb9, 0xa7, 0xe4, 0x3d, 0x58, 0x15, 0x4b, 0xf1, 0xab, 0x77, 0x8e, 0x42, 0xe6, 0xf4, 0x68,
-	0x73, 0xb9, 0x98, 0x3d, 0x96, 0x10, 0xf2, 0x21, 0x8d, 0x93, 0x3b, 0x21, 0xbb, 0xc7, 0xdf, 0xc8,
-	0xca, 0x13, 0x3f, 0x39, 0xf1, 0x98, 0xfb, 0x44, 0x62, 0x12, 0x37, 0x57, 0x8a, 0xa7, 0x2e, 0x2b,
-	0x40, 0x81, 0x57, 0x4c, 0xde, 0x84, 0xc6, 0x89, 0x1b, 0x3b, 0xaa, 0x3b, 0x68, 0xae, 0x16, 0x4f,
-	0x5c, 0x3c, 0x71, 0xe3, 0x2f, 0x15, 0x10, 0xf9, 0x10, 0x48, 0xba, 0x23, 0x75, 0x3b, 0x27, 0x82,
-	0xcb, 0x48, 0xf1, 0xd4, 0x14, 0xb9, 0xdb, 0x6e, 0xe7, 0x04, 0x19, 0xec, 0x2e, 0x90, 0x80, 0x9e,
-	0x26, 0xe9, 0xae, 0x62, 0xfa, 0xda, 0x38, 0x26, 0x5d, 0xe1, 0x93, 0x14, 0x12, 0xb8, 0xd0, 0x3e,
-	0x5e, 0x1c, 0xcb, 0xaf, 0xb4, 0x3e, 0x6e, 0xa5, 0x55, 0x9c, 0x65, 0x2c, 0x65, 0x41, 0xa3, 0x1d,
-	0x39, 0xc8, 0x25, 0x82, 0x87, 0xce, 0x20, 0x97, 0xd4, 0xdb, 0xd1, 0xc3, 0x30, 0xa1, 0x82, 0x8d,
-	0x36, 0x01, 0x18, 0x8d, 0xfa, 0x89, 0xcb, 0x0d, 0x90, 0xe6, 0x59, 0x04, 0xd0, 0x7a, 0x38, 0xb3,
-	0x66, 0x2d, 0xa7, 0x47, 0x7b, 0x61, 0xf3, 0x1c, 0x2a, 0xde, 0xa5, 0xac, 0xfb, 0x1e, 0xed, 0x85,
-	0x9c, 0xf9, 0x3b, 0x27, 0x2e, 0x3b, 0xc6, 0xf7, 0xd9, 0x79, 0x44, 0x93, 0x66, 0x53, 0x30, 0xbf,
-	0xec, 0x3d, 0xc4, 0x4e, 0xce, 0x35, 0xc8, 0x8f, 0x12, 0xe6, 0xbc, 0xd8, 0x90, 0x77, 0x49, 0x80,
-	0xb3, 0x30, 0xc7, 0x5b, 0xdf, 0xd0, 0x66, 0x0b, 0xc7, 0x64, 0x4b, 0x4d, 0xfc, 0x86, 0x0a, 0x24,
-	0x36, 0x10, 0x09, 0x10, 0x5d, 0x88, 0xc0, 0x07, 0x40, 0x74, 0x76, 0x63, 0x61, 0x8f, 0xf3, 0xdb,
-	0x85, 0x12, 0xa6, 0xc9, 0xf8, 0x8d, 0x85, 0xbd, 0x7b, 0x9c, 0xec, 0xeb, 0x9c, 0x50, 0x9e, 0x93,
-	0x13, 0x13, 0x17, 0x47, 0xcb, 0x49, 0x82, 0x93, 0x0c, 0xe1, 0xc1, 0xf9, 0x0f, 0x51, 0x68, 0x87,
-	0x8c, 0x85, 0x4f, 0xa8, 0xd7, 0xdc, 0x2c, 0xe1, 0x3f, 0xfe, 0x77, 0x57, 0x02, 0x91, 0x97, 0xa1,
-	0x86, 0xb3, 0xba, 0xdc, 0x34, 0xba, 0x54, 0x3c, 0x63, 0x81, 0xff, 0xfd, 0x94, 0x06, 0x09, 0x79,
-	0x07, 0x96, 0x11, 0xda, 0xa3, 0x5d, 0xff, 0x31, 0x65, 0x7e, 0x70, 0xdc, 0xbc, 0x5c, 0xf2, 0xb2,
-	0xf8, 0xdf, 0x5b, 0x29, 0x18, 0xd9, 0x02, 0xc0, 0x99, 0xdc, 0x74, 0xf4, 0x9a, 0x57, 0x8a, 0x27,
-	0x21, 0x2a, 0x9f, 0x71, 0x88, 0x14, 0xaf, 0x98, 0x76, 0x8f, 0x9a, 0xd6, 0x08, 0xbc, 0x1e, 0xd0,
-	0xee, 0x91, 0xe5, 0xc1, 0x72, 0xaa, 0xf4, 0xa4, 0xba, 0xbb, 0x06, 0x55, 0x3f, 0x38, 0x0a, 0xa5,
-	0xc6, 0x59, 0xcb, 0xe9, 0x67, 0xae, 0x9c, 0x6c, 0x04, 0x20, 0xd7, 0x61, 0x36, 0x4e, 0xdc, 0x84,
-	0x4a, 0x3d, 0x43, 0x14, 0xe4, 0xde, 0x89, 0xeb, 0x07, 0x0f, 0xf8, 0x88, 0x2d, 0x00, 0xac, 0xff,
-	0xa9, 0xc0, 0x85, 0xbb, 0x34, 0xb9, 0x23, 0xc5, 0x2a, 0xd7, 0xe5, 0xa6, 0xde, 0x7e, 0x1b, 0x97,
-	0x62, 0x89, 0xdc, 0xf4, 0x8a, 0x86, 0x70, 0x26, 0xa1, 0xa5, 0x36, 0x09, 0x99, 0x47, 0x99, 0x2d,
-	0xe0, 0xc9, 0x1b, 0x30, 0x43, 0x03, 0xa5, 0x5c, 0x27, 0x98, 0xc6, 0xa1, 0xb9, 0x85, 0xdc, 0xf5,
-	0x7b, 0xbe, 0xd0, 0x7d, 0x0d, 0x5b, 0x34, 0xc8, 0x0f, 0x00, 0xba, 0x2e, 0x27, 0x34, 0x07, 0x94,
-	0xba, 0x6e, 0x82, 0x15, 0x6b, 0x7c, 0xd2, 0x67, 0xfc, 0xa7, 0xf5, 0xa7, 0x15, 0x58, 0xd1, 0xcf,
-	0x88, 0x8a, 0xfc, 0x75, 0x98, 0x97, 0x1c, 0x98, 0xea, 0x70, 0x93, 0xa2, 0xa9, 0xf2, 0x52, 0x70,
-	0xe4, 0x96, 0x52, 0x9d, 0x12, 0x97, 0x89, 0x4f, 0x27, 0x55, 0xa8, 0xc0, 0xe6, 0x0f, 0xd1, 0x58,
-	0x2a, 0xa2, 0xb9, 0xbc, 0xe8, 0x0f, 0x21, 0x55, 0x74, 0xba, 0x45, 0xd6, 0x54, 0xf8, 0xe5, 0x8f,
-	0x62, 0x2f, 0x1e, 0x69, 0x3d, 0xd6, 0xff, 0x56, 0xb4, 0x0d, 0xfc, 0xe0, 0x78, 0xf8, 0x56, 0xdf,
-	0x31, 0x6f, 0xd5, 0x1a, 0x3a, 0x00, 0x2a, 0xd4, 0xa2, 0x6b, 0x7d, 0x53, 0xbf, 0xd6, 0x49, 0xe6,
-	0x8d, 0xb8, 0xd7, 0x9d, 0x82, 0x7b, 0x9d, 0x64, 0x49, 0xed, 0x62, 0x7f, 0x59, 0x81, 0x55, 0xe3,
-	0x9c, 0xdf, 0xf6, 0x66, 0x6f, 0x17, 0xde, 0xec, 0x24, 0xd8, 0x18, 0x57, 0xdb, 0x86, 0xcd, 0x32,
-	0xca, 0xcb, 0xbb, 0xfd, 0x01, 0x64, 0xc6, 0x89, 0x7e, 0xb9, 0xe7, 0xcd, 0xcb, 0xd5, 0x8e, 0xa3,
-	0xac, 0x1e, 0xd9, 0x65, 0x7d, 0x05, 0x1b, 0xe9, 0x1e, 0x7b, 0xcf, 0xd6, 0x43, 0xb3, 0x35, 0x69,
-	0x60, 0x2c, 0x2d, 0x91, 0x3f, 0x07, 0xf3, 0x47, 0x9c, 0xb7, 0x25, 0x61, 0xb9, 0x4e, 0xa1, 0x6c,
-	0x2f, 0x48, 0xc8, 0x79, 0x58, 0x38, 0x42, 0xd2, 0x04, 0xc2, 0x71, 0x6e, 0xd8, 0xf3, 0xbc, 0xbd,
-	0x17, 0x24, 0xd6, 0xaf, 0x67, 0xe1, 0x4c, 0xa1, 0x4d, 0x48, 0x5e, 0xd1, 0x7d, 0xf0, 0x11, 0x28,
-	0x4a, 0xe7, 0x3c, 0x6f, 0xb7, 0x4e, 0x3f, 0x95, 0xdd, 0xba, 0x02, 0x33, 0x7d, 0xd6, 0x95, 0xce,
-	0x3b, 0xff, 0x49, 0xb6, 0xd0, 0xdb, 0x44, 0x53, 0x5b, 0xf0, 0xde, 0x19, 0x6d, 0x29, 0x39, 0xe2,
-	0xf8, 0x1e, 0xf7, 0x35, 0xb9, 0x7a, 0x23, 0xef, 0x43, 0x3d, 0xf6, 0x8f, 0x03, 0x7e, 0x4c, 0x6e,
-	0x73, 0xcf, 0x8e, 0xb5, 0xb9, 0x41, 0x82, 0x73, 0xa3, 0x7b, 0x1b, 0xce, 0x44, 0x2c, 0x8c, 0xc2,
-	0x98, 0x7a, 0x8e, 0x6e, 0x4e, 0xa2, 0xf5, 0x5c, 0xb5, 0xd7, 0xd4, 0xe0, 0x83, 0xcc, 0x9e, 0xe4,
-	0x2e, 0x5d, 0x12, 0xc5, 0x0e, 0x3d, 0x8d, 0x68, 0x27, 0xa1, 0x1e, 0x9a, 0xc9, 0x55, 0xbb, 0x9e,
-	0x44, 0xf1, 0x6d, 0xd9, 0xc5, 0x6d, 0x2d, 0x45, 0x2a, 0xc9, 0xbe, 0x47, 0x94, 0xa2, 0xb1, 0x5c,
-	0xe0, 0x5e, 0xac, 0x48, 0xd0, 0x3d, 0x84, 0xbc, 0x43, 0x29, 0xb9, 0x09, 0xcd, 0x24, 0x8c, 0x9c,
-	0xc0, 0x71, 0x3b, 0x3f, 0xef, 0xfb, 0x8c, 0x0a, 0x0b, 0x37, 0x09, 0x1f, 0xd1, 0x40, 0x5a, 0xd2,
-	0xeb, 0x49, 0x18, 0x1d, 0xec, 0x88, 0x51, 0x8e, 0xd4, 0x21, 0x1f, 0xe3, 0xa7, 0x11, 0x66, 0x87,
-	0x73, 0xd4, 0xed, 0xc7, 0x27, 0x8e, 0x1f, 0x24, 0x94, 0x3d, 0x76, 0xbb, 0x68, 0x5b, 0x57, 0xed,
-	0x35, 0x31, 0x78, 0x87, 0x8f, 0xed, 0xcb, 0x21, 0xf2, 0x2e, 0xac, 0x44, 0x94, 0x49, 0x73, 0xc5,
-	0x89, 0x98, 0xdf, 0xa1, 0x68, 0x6c, 0x17, 0x20, 0xba, 0x14, 0x51, 0x26, 0x8c, 0x98, 0xfb, 0x1c,
-	0x8c, 0xbc, 0x08, 0xab, 0xda, 0xd4, 0x27, 0xc2, 0xc1, 0x15, 0x06, 0xf8, 0x72, 0x0a, 0xfa, 0x25,
-	0x76, 0x73, 0xeb, 0x86, 0x5b, 0x12, 0xca, 0x6f, 0x10, 0x56, 0x37, 0x1a, 0xf8, 0xd2, 0x69, 0x78,
-	0x01, 0x96, 0x8f, 0x69, 0x20, 0xad, 0x13, 0x01, 0x24, 0x2c, 0xee, 0xc6, 0x31, 0x0d, 0x84, 0x7f,
-	0xcc, 0x3b, 0xad, 0x36, 0x3e, 0x33, 0x83, 0x73, 0xf9, 0xf3, 0x53, 0xcf, 0xec, 0x15, 0x53, 0x84,
-	0x96, 0x33, 0xaf, 0x90, 0x9b, 0xa9, 0x04, 0x9c, 0xd6, 0x24, 0xa0, 0xd5, 0xc3, 0xf7, 0x56, 0xb0,
-	0x87, 0x7c, 0x21, 0xf7, 0x60, 0xcd, 0xb4, 0xa2, 0x74, 0x89, 0x31, 0xc6, 0xe3, 0x5a, 0x6d, 0xe7,
-	0x97, 0xb5, 0x7e, 0x3b, 0x0f, 0x8b, 0xf7, 0x43, 0x6d, 0xfd, 0x8d, 0x2c, 0xcc, 0x81, 0xf1, 0x82,
-	0xdd, 0xe9, 0xd7, 0x2a, 0x2a, 0xd4, 0x81, 0xc1, 0x30, 0x37, 0xa1, 0xc7, 0x21, 0x1b, 0xa4, 0xc1,
-	0x30, 0xd9, 0x26, 0x1f, 0x40, 0x23, 0x72, 0x19, 0x0d, 0x12, 0xc7, 0xed, 0x27, 0x27, 0x21, 0x93,
-	0xce, 0x6a, 0x29, 0x15, 0x16, 0x05, 0xf4, 0x0e, 0x02, 0x93, 0x57, 0x61, 0x4e, 0x4e, 0x9b, 0x1d,
-	0x13, 0xe6, 0x11, 0x60, 0x18, 0x7b, 0xf3, 0x93, 0xae, 0x88, 0x90, 0xd5, 0x6c, 0xd1, 0x20, 0x04,
-	0xaa, 0xed, 0xd0, 0x1b, 0x20, 0xbb, 0xd7, 0x6c, 0xfc, 0x4d, 0xde, 0x80, 0x79, 0xf9, 0xea, 0x25,
-	0x73, 0x8d, 0x90, 0x0f, 0x0a, 0x92, 0xbc, 0x07, 0x75, 0x54, 0x44, 0x91, 0x3b, 0x08, 0xfb, 0x82,
-	0x67, 0x46, 0x4e, 0x44, 0xb5, 0x75, 0x1f, 0x81, 0x39, 0x6a, 0x1e, 0x8d, 0x92, 0x13, 0x64, 0xa2,
-	0x86, 0x2d, 0x1a, 0x48, 0xbb, 0x13, 0xbf, 0xeb, 0x31, 0x1a, 0xa0, 0xa3, 0xd6, 0xb0, 0xd3, 0x36,
-	0x27, 0x3a, 0x0b, 0x43, 0x24, 0xfa, 0x4a, 0x46, 0x74, 0xde, 0xb5, 0xef, 0x91, 0x4b, 0x50, 0x93,
-	0x84, 0xf5, 0x3d, 0x74, 0xb7, 0xc4, 0xf0, 0x82, 0xe8, 0xdc, 0xf7, 0xf8, 0xa1, 0x13, 0xf7, 0x38,
-	0x6e, 0x92, 0xcb, 0x33, 0xfc, 0xd0, 0xfc, 0x37, 0xb9, 0x0b, 0x8d, 0x36, 0x0d, 0xe8, 0x91, 0xdf,
-	0xf1, 0x5d, 0xe6, 0xd3, 0xb8, 0xb9, 0x86, 0x0c, 0xa2, 0xdb, 0x25, 0xd9, 0xf8, 0xc0, 0x61, 0x61,
-	0x3f, 0xa1, 0x42, 0x44, 0x99, 0xf3, 0xb8, 0x18, 0x17, 0x4e, 0x4e, 0x90, 0xa0, 0x9f, 0x54, 0xb5,
-	0xe7, 0x79, 0x9b, 0x4b, 0xf8, 0x4b, 0x50, 0x17, 0x0f, 0x8f, 0x7a, 0xce, 0xe3, 0x08, 0x1d, 0xa0,
-	0x9a, 0x0d, 0xaa, 0xeb, 0x61, 0x44, 0x6e, 0xc0, 0xbc, 0x88, 0x98, 0xc4, 0xe8, 0xfc, 0x14, 0x18,
-	0xb7, 0x6a, 0x9c, 0x6c, 0xc3, 0xa2, 0xe7, 0x46, 0x91, 0xa3, 0xe0, 0xcf, 0x15, 0xc3, 0xd7, 0x39,
-	0x90, 0x2d, 0xe7, 0xdc, 0x80, 0x15, 0x15, 0xa4, 0x4a, 0xa5, 0x4d, 0x53, 0x88, 0x00, 0xd9, 0x9f,
-	0x4a, 0x9a, 0x9b, 0xb0, 0x74, 0xdc, 0x0d, 0xdb, 0x6e, 0x37, 0xdd, 0xe0, 0x7c, 0xf1, 0x06, 0x0d,
-	0x01, 0xa6, 0xb6, 0x78, 0x19, 0x88, 0x9c, 0xa7, 0x9f, 0xb4, 0x85, 0x27, 0x5d, 0x11, 0x23, 0x5f,
-	0x66, 0xe7, 0x3d, 0x0b, 0x73, 0xd2, 0xf5, 0xda, 0x10, 0xaa, 0x50, 0xb4, 0xc8, 0x05, 0xa8, 0x75,
-	0xc2, 0x68, 0xc0, 0x50, 0x48, 0x5d, 0x10, 0xd1, 0x84, 0xb4, 0x03, 0x9d, 0x3b, 0xd5, 0x10, 0xfe,
-	0xd7, 0x45, 0x5c, 0xbf, 0x91, 0xf6, 0xa2, 0x0b, 0xb6, 0x05, 0xab, 0xea, 0xb4, 0x42, 0x00, 0x04,
-	0xfd, 0x1e, 0x7a, 0x3f, 0x82, 0x1d, 0xd4, 0x91, 0xf1, 0xe5, 0x1f, 0xf4, 0x7b, 0xd6, 0x5f, 0x56,
-	0x50, 0x5a, 0xf1, 0xc7, 0x2d, 0x6c, 0x0e, 0x21, 0xe3, 0x3d, 0x25, 0xad, 0xde, 0x30, 0xa5, 0xd5,
-	0x45, 0x5d, 0x6b, 0x61, 0x1c, 0xa6, 0xc8, 0xd6, 0x7b, 0x55, 0xb7, 0xf5, 0xc6, 0x4c, 0x29, 0x37,
-	0xf3, 0xac, 0xcf, 0x51, 0xc8, 0x15, 0xa0, 0x26, 0x85, 0xd0, 0xeb, 0x80, 0x91, 0x1f, 0x5d, 0xb4,
-	0xad, 0x2b, 0xd1, 0xa6, 0x4b, 0x2b, 0x7b, 0x21, 0x92, 0x6b, 0x58, 0x7f, 0x2d, 0x8e, 0x6b, 0xd3,
-	0xa8, 0x3b, 0x10, 0x8b, 0xde, 0x47, 0x99, 0xa5, 0x8e, 0xfb, 0xa6, 0x79, 0xdc, 0x4d, 0x0d, 0x77,
-	0xc6, 0xe7, 0x14, 0x9f, 0xf7, 0x35, 0xfd, 0xbc, 0xe3, 0xe6, 0x8c, 0x38, 0xf0, 0x03, 0x3c, 0x70,
-	0x01, 0x72, 0xf2, 0xc0, 0x6f, 0x60, 0xa4, 0xa0, 0x3b, 0x18, 0x7f, 0xe2, 0x1a, 0x53, 0x8b, 0x58,
-	0x8f, 0xe0, 0xb2, 0x52, 0x15, 0x87, 0x59, 0xa2, 0x23, 0xde, 0x1d, 0x1c, 0xf4, 0x7b, 0xea, 0xd8,
-	0x1b, 0x50, 0xcb, 0xb8, 0x45, 0x18, 0x68, 0x0b, 0x6d, 0xc9, 0x22, 0x1c, 0x57, 0x41, 0x13, 0xa9,
-	0x81, 0x72, 0x7a, 0xc9, 0x38, 0xc1, 0x11, 0x5c, 0x19, 0xb1, 0x59, 0x16, 0x36, 0xd6, 0x52, 0x2e,
-	0x71, 0xaa, 0x95, 0x32, 0xba, 0x71, 0xfb, 0x87, 0x5b, 0x6b, 0x19, 0x94, 0x6d, 0x4c, 0xb1, 0x76,
-	0xe0, 0xcc, 0x5d, 0x9a, 0x68, 0x6e, 0xa9, 0x5a, 0x3b, 0xf5, 0x60, 0x2b, 0xe3, 0x3c, 0xd8, 0xb7,
-	0x31, 0xcf, 0x71, 0x10, 0x7a, 0xf4, 0x80, 0xbf, 0xcd, 0x76, 0xd8, 0x67, 0x71, 0xba, 0x4c, 0x0b,
-	0x16, 0x22, 0x4a, 0x99, 0xa4, 0x33, 0xaa, 0x30, 0xd5, 0xb6, 0x76, 0xd0, 0x49, 0xe2, 0x13, 0xed,
-	0x7e, 0xc0, 0xcd, 0xb4, 0x87, 0x94, 0xc5, 0x1c, 0x45, 0x35, 0xf9, 0x32, 0xd4, 0x83, 0xd0, 0xa3,
-	0xb2, 0x5b, 0xce, 0xd7, 0xbb, 0xac, 0xbf, 0xa8, 0xc0, 0xda, 0x2e, 0x0b, 0x5d, 0xaf, 0xe3, 0xc6,
-	0xc9, 0x21, 0x3b, 0x55, 0xf7, 0xf0, 0x31, 0xd4, 0xb5, 0x63, 0x16, 0xbc, 0xb9, 0x02, 0xc2, 0xe8,
-	0x33, 0xb8, 0xe5, 0x17, 0x06, 0xdd, 0x81, 0x0a, 0x4a, 0xe0, 0x95, 0x2d, 0xd8, 0x75, 0xde, 0x27,
-	0x03, 0x10, 0xfc, 0x68, 0x47, 0x7e, 0xe0, 0x76, 0xfd, 0x64, 0x80, 0x77, 0xb7, 0x60, 0xa7, 0x6d,
-	0xeb, 0x6f, 0x2b, 0xb0, 0x6e, 0xe2, 0x25, 0x8f, 0xb4, 0x0b, 0xf3, 0x7e, 0xf0, 0x38, 0xe4, 0xa6,
-	0x97, 0x40, 0xea, 0xba, 0xae, 0xe4, 0x32, 0x04, 0x1c, 0x46, 0x3b, 0xd4, 0x8f, 0x44, 0xe8, 0xcc,
-	0xf1, 0xb9, 0x13, 0xa2, 0x26, 0x72, 0xb9, 0xc7, 0x29, 0xdf, 0x8f, 0x25, 0x23, 0xc9, 0x16, 0x37,
-	0xb0, 0x7b, 0xf1, 0xb1, 0x32, 0xb0, 0x7b, 0xf1, 0xb1, 0x81, 0x62, 0x35, 0x87, 0x22, 0x81, 0x95,
-	0x83, 0x30, 0xb8, 0xef, 0x32, 0xb7, 0x17, 0x4b, 0xb2, 0x59, 0x5f, 0xc3, 0xe2, 0x9e, 0xdb, 0xed,
-	0xa6, 0xd8, 0x9e, 0x85, 0x39, 0x46, 0xe3, 0x7e, 0x57, 0xdd, 0x9d, 0x6c, 0x71, 0x55, 0x44, 0x4f,
-	0x69, 0x87, 0x2b, 0x31, 0xca, 0x98, 0xb4, 0x4d, 0x40, 0x76, 0xdd, 0x66, 0x8c, 0x93, 0x8f, 0xc6,
-	0x89, 0xdf, 0xe3, 0xf6, 0xf0, 0xb1, 0x1b, 0x4b, 0x9c, 0xea, 0xaa, 0xef, 0xae, 0x1b, 0x5b, 0xff,
-	0x56, 0x01, 0xc8, 0x98, 0x89, 0xec, 0xc1, 0x26, 0x5a, 0x00, 0x3e, 0x13, 0xc9, 0x37, 0xbf, 0xdd,
-	0xa5, 0x99, 0xe4, 0x6d, 0x4b, 0x1f, 0xa5, 0x6a, 0x6f, 0x70, 0xa8, 0x7d, 0x0d, 0x48, 0x49, 0xe0,
-	0x36, 0x65, 0xe4, 0x63, 0xb8, 0x50, 0xb6, 0x48, 0xea, 0xb0, 0x54, 0xed, 0xf3, 0x85, 0x4b, 0xa0,
-	0x8f, 0xf2, 0x3a, 0x54, 0xbd, 0xe3, 0x28, 0x94, 0xc6, 0x94, 0xce, 0x30, 0xde, 0x20, 0x70, 0x7b,
-	0x7e, 0x87, 0xdb, 0x81, 0x11, 0x65, 0x89, 0x4f, 0x63, 0x1b, 0x41, 0xad, 0x07, 0xb0, 0xa6, 0x5e,
-	0xaa, 0x6e, 0x9d, 0xae, 0xeb, 0x02, 0xb0, 0xaa, 0x1e, 0xfb, 0x4a, 0x26, 0xe0, 0xaa, 0xa3, 0x04,
-	0xd8, 0xbf, 0x4c, 0x43, 0x0d, 0x97, 0x44, 0x6f, 0xfa, 0x6d, 0xa8, 0x71, 0xf4, 0xb9, 0xd7, 0x12,
-	0x49, 0xb6, 0x19, 0x61, 0x1b, 0x65, 0xb0, 0xe4, 0x6a, 0x2e, 0x25, 0x35, 0x9d, 0xea, 0x2f, 0x3d,
-	0x2d, 0x65, 0x06, 0xe7, 0x67, 0x72, 0xc1, 0xf9, 0x8f, 0x86, 0xd2, 0x0d, 0xd5, 0xd1, 0x26, 0x63,
-	0x2e, 0xd1, 0xf0, 0x32, 0x08, 0x09, 0xc8, 0xcd, 0x29, 0x61, 0x6c, 0xae, 0xea, 0xef, 0xf0, 0xc4,
-	0xdd, 0x7e, 0xeb, 0xa6, 0x3d, 0x8f, 0x20, 0xfb, 0x1e, 0xb9, 0x0e, 0x73, 0x11, 0xa3, 0x1c, 0x76,
-	0xae, 0x0c, 0x76, 0x36, 0x62, 0x74, 0xdf, 0x23, 0x17, 0x01, 0xc4, 0xba, 0xb1, 0xff, 0x0d, 0x55,
-	0x09, 0x0c, 0xec, 0x79, 0xe0, 0x7f, 0x43, 0xad, 0x1d, 0x58, 0x37, 0xaf, 0x45, 0xb2, 0xf4, 0x0d,
-	0x98, 0x43, 0x20, 0x25, 0x2d, 0x57, 0x0d, 0x1b, 0x1e, 0xbd, 0x7d, 0x09, 0x60, 0xbd, 0x82, 0xb2,
-	0xf1, 0x01, 0x4a, 0x0a, 0x1c, 0x1d, 0x79, 0xb7, 0xd6, 0x5d, 0xcc, 0x0c, 0x1a, 0xe0, 0x99, 0x9b,
-	0x8d, 0x4b, 0x16, 0x78, 0x2a, 0x52, 0x0e, 0xe1, 0xb0, 0x2d, 0xa0, 0xac, 0x7f, 0xaa, 0x60, 0x0c,
-	0x43, 0x46, 0x4a, 0x84, 0x02, 0xdb, 0x75, 0xbb, 0x6e, 0xd0, 0x49, 0x43, 0x0c, 0x57, 0x4d, 0xf5,
-	0x3a, 0xe4, 0xbf, 0x49, 0x76, 0xbb, 0xa2, 0xeb, 0xd3, 0x21, 0x20, 0xe4, 0xbf, 0x9b, 0xb0, 0x88,
-	0x4f, 0x46, 0x85, 0x6b, 0x66, 0xca, 0x43, 0x9b, 0x68, 0xa2, 0xcb, 0x8e, 0x8c, 0x6f, 0xab, 0x3a,
-	0xdf, 0xde, 0x46, 0x1a, 0x68, 0x98, 0xa7, 0x34, 0x78, 0x09, 0xaa, 0x9a, 0xb2, 0x2d, 0x0d, 0x07,
-	0x21, 0x90, 0x75, 0x08, 0x8d, 0x5b, 0xae, 0xdf, 0x1d, 0x1c, 0x86, 0x89, 0xdb, 0x3d, 0x64, 0xa7,
-	0xe4, 0x15, 0xa8, 0x7a, 0x99, 0x32, 0x1a, 0xc1, 0xfc, 0x08, 0x96, 0xe5, 0xf9, 0xc5, 0x43, 0x13,
-	0x0d, 0xeb, 0x5f, 0x2b, 0x98, 0x55, 0x37, 0x56, 0x56, 0x04, 0x7d, 0xd5, 0x24, 0xe8, 0x88, 0x1d,
-	0x24, 0x69, 0x5f, 0xd2, 0x49, 0x3b, 0x02, 0x1c, 0x89, 0xbc, 0x0d, 0x35, 0x21, 0x97, 0x82, 0x23,
-	0x25, 0x5b, 0xce, 0x28, 0x0a, 0x98, 0xe8, 0x2c, 0xa0, 0x6c, 0xe2, 0x8f, 0xbe, 0x8c, 0xc0, 0xcd,
-	0xe1, 0x23, 0xa4, 0xac, 0xad, 0x93, 0xb8, 0x64, 0x03, 0x41, 0xe0, 0x9b, 0x00, 0x5c, 0xec, 0xee,
-	0x0e, 0x7e, 0x18, 0xf6, 0x19, 0xf7, 0x68, 0x4e, 0xc2, 0x3e, 0x93, 0x06, 0x0b, 0xfe, 0x36, 0x49,
-	0xa8, 0x4a, 0x25, 0xac, 0x97, 0x61, 0xfd, 0x90, 0x9d, 0x66, 0x53, 0xb5, 0x17, 0xc1, 0x67, 0xc5,
-	0x72, 0x09, 0xd1, 0xb0, 0x3e, 0x86, 0x33, 0x39, 0x68, 0x89, 0xe9, 0x0b, 0x50, 0xe5, 0x3a, 0x4b,
-	0x62, 0x9a, 0xda, 0x16, 0x1a, 0x24, 0x8e, 0x5b, 0xbf, 0x9a, 0x86, 0xf9, 0x43, 0x76, 0xba, 0x2f,
-	0x42, 0xea, 0x73, 0x5c, 0x48, 0x49, 0x47, 0xb9, 0x58, 0x32, 0x24, 0xec, 0x74, 0xdf, 0x1b, 0x4a,
-	0xc4, 0x4f, 0x0f, 0x25, 0xe2, 0xc9, 0xbb, 0xc0, 0x05, 0x9c, 0xf3, 0x84, 0xb9, 0x91, 0xbc, 0x8f,
-	0xcd, 0x12, 0x3d, 0xcc, 0x41, 0x22, 0xca, 0xec, 0xf9, 0x84, 0x9d, 0x7e, 0xc9, 0xdc, 0x88, 0xbc,
-	0xa3, 0xe4, 0x0e, 0x6a, 0x94, 0xea, 0x58, 0x69, 0xdc, 0x4e, 0x95, 0xcb, 0xd3, 0x49, 0xc2, 0x2d,
-	0x58, 0x6b, 0x77, 0x1f, 0x39, 0x7e, 0x6c, 0x68, 0x33, 0x14, 0x8b, 0x0b, 0xf6, 0x6a, 0xbb, 0xfb,
-	0x68, 0x3f, 0xd6, 0x75, 0x98, 0xb4, 0xe4, 0x24, 0xb5, 0x76, 0x07, 0x99, 0x29, 0x3e, 0x31, 0xe1,
-	0xac, 0x0f, 0x45, 0xb1, 0x8e, 0xbe, 0x44, 0x5a, 0xf0, 0xa2, 0x27, 0x3e, 0x96, 0xd5, 0x85, 0x49,
-	0x50, 0x91, 0xf4, 0xb0, 0x7e, 0x23, 0xde, 0xd7, 0x21, 0x3b, 0x15, 0x32, 0x8b, 0x1f, 0xfa, 0xfb,
-	0x79, 0x5f, 0xc5, 0xd1, 0xed, 0x97, 0xf5, 0x57, 0x57, 0x2d, 0xc6, 0x3c, 0x7d, 0x6f, 0xd6, 0xc7,
-	0xf8, 0xb2, 0x72, 0xc8, 0x67, 0xc7, 0xd7, 0x5e, 0xd6, 0xf0, 0xf1, 0xf1, 0x4d, 0xfd, 0xbb, 0x10,
-	0xdb, 0x79, 0x37, 0xeb, 0xfb, 0xa3, 0xc2, 0xeb, 0xf2, 0xbc, 0xdc, 0x49, 0x93, 0x5c, 0x5d, 0xe2,
-	0xc6, 0x61, 0xf4, 0x24, 0x8c, 0xcb, 0xa4, 0xf8, 0x8f, 0xe1, 0x52, 0xe9, 0x41, 0x24, 0x45, 0xde,
-	0x82, 0x3a, 0xdf, 0x86, 0x7a, 0xe3, 0x5d, 0x28, 0x10, 0x80, 0xe8, 0x43, 0xfd, 0x57, 0x05, 0xa9,
-	0x9c, 0x2d, 0x3d, 0x61, 0xa6, 0xab, 0x1f, 0x53, 0xe6, 0x68, 0x4e, 0xef, 0x84, 0x99, 0xae, 0x92,
-	0x69, 0xcf, 0x96, 0x5a, 0xff, 0x27, 0x5c, 0xe1, 0x2f, 0x62, 0xca, 0x0a, 0x59, 0xff, 0x25, 0xa8,
-	0x4e, 0x92, 0x06, 0x40, 0xa0, 0x8c, 0x43, 0xa6, 0x9f, 0x8e, 0x43, 0x66, 0x26, 0xe2, 0x90, 0x17,
-	0x01, 0x0f, 0xe3, 0x24, 0xec, 0xb4, 0xec, 0x41, 0xcc, 0x77, 0x85, 0xc7, 0x92, 0x1d, 0x76, 0x56,
-	0x3f, 0xec, 0x8f, 0xd0, 0xb3, 0x2e, 0x38, 0xab, 0xe4, 0x8b, 0x17, 0x85, 0x60, 0x1d, 0xf5, 0x5a,
-	0xb8, 0x24, 0x45, 0x66, 0xf8, 0x1a, 0xea, 0x0f, 0xc3, 0x84, 0xb2, 0xcf, 0x8e, 0x90, 0xba, 0xdf,
-	0xa5, 0xac, 0x27, 0x17, 0x1b, 0x9b, 0xce, 0xc7, 0xc6, 0xac, 0x3f, 0x11, 0x85, 0x88, 0x18, 0x07,
-	0xc8, 0xc9, 0xc7, 0x91, 0x21, 0xd8, 0xeb, 0xb0, 0x22, 0x82, 0xd9, 0xfc, 0x44, 0x8e, 0x1e, 0x40,
-	0x5e, 0xc2, 0x7e, 0x7e, 0x90, 0x4f, 0x51, 0xda, 0x5c, 0x87, 0x95, 0x2c, 0xa6, 0xe0, 0xe8, 0xe2,
-	0x68, 0x29, 0x8d, 0x21, 0x20, 0xa4, 0xf5, 0x6b, 0x21, 0x27, 0x4d, 0x5c, 0x72, 0xa1, 0x18, 0x4d,
-	0xda, 0x8e, 0x08, 0xc5, 0xa0, 0x62, 0xdc, 0x16, 0xd5, 0x33, 0x32, 0x32, 0x3d, 0x8d, 0x44, 0x4f,
-	0xed, 0x37, 0x8d, 0xc0, 0xa2, 0xa4, 0x06, 0x31, 0xce, 0x05, 0x40, 0x66, 0x26, 0x0b, 0x80, 0xfc,
-	0x9d, 0xa0, 0xe1, 0x9e, 0xac, 0xc7, 0xc4, 0xcb, 0xcc, 0x62, 0xf1, 0x4f, 0x93, 0x48, 0x7a, 0x0e,
-	0x1a, 0xaa, 0xaa, 0x53, 0xdc, 0xb5, 0xb8, 0xb0, 0x45, 0xd5, 0x89, 0x77, 0xca, 0x9d, 0x57, 0x9a,
-	0x74, 0x4e, 0x76, 0xda, 0x7e, 0xea, 0x5f, 0xcb, 0x36, 0xb9, 0x00, 0x35, 0xfc, 0xbd, 0x17, 0x7a,
-	0x54, 0x7a, 0xb6, 0x59, 0x87, 0xf5, 0xc7, 0x82, 0xc0, 0x26, 0xa2, 0x92, 0xc0, 0xeb, 0x30, 0x4b,
-	0x4f, 0x55, 0x34, 0x62, 0xc1, 0x16, 0x0d, 0xee, 0x97, 0xb9, 0x6d, 0x5f, 0xa2, 0xc1, 0x7f, 0x72,
-	0x9b, 0xa8, 0xc3, 0x17, 0xe7, 0x3b, 0x2f, 0xda, 0xf8, 0x5b, 0x65, 0xb0, 0xaa, 0x59, 0x06, 0xab,
-	0x05, 0x0b, 0x1e, 0x8d, 0x3b, 0xcc, 0x6f, 0x53, 0x59, 0x69, 0x9a, 0xb6, 0xad, 0x7b, 0x32, 0x84,
-	0x93, 0x53, 0xd4, 0xbb, 0x83, 0xc3, 0xd3, 0x6f, 0xa3, 0x9c, 0x3f, 0x00, 0x6b, 0xd4, 0x72, 0x85,
-	0x1e, 0xfb, 0x82, 0xf2, 0xd8, 0xad, 0xff, 0x28, 0xf0, 0x29, 0xf6, 0xd8, 0xf7, 0xa8, 0x9c, 0x9e,
-	0xad, 0x9f, 0xf1, 0x8f, 0x15, 0xa8, 0xa1, 0x5d, 0xcb, 0x4d, 0x46, 0x7e, 0x57, 0xa9, 0x77, 0x50,
-	0x95, 0x2e, 0x00, 0xf6, 0x45, 0x4a, 0x14, 0xe0, 0x6f, 0x7e, 0x7f, 0x9e, 0xdb, 0x97, 0xaf, 0x92,
-	0xff, 0xc4, 0x9e, 0xa0, 0x2f, 0xd7, 0xe6, 0x3f, 0x31, 0xba, 0xcf, 0x4e, 0x63, 0x29, 0xf5, 0xf0,
-	0x37, 0x69, 0xc1, 0x9c, 0xdb, 0x4b, 0xeb, 0x86, 0xa5, 0x80, 0x10, 0x3d, 0xfc, 0xd9, 0x27, 0xdc,
-	0xb6, 0x76, 0x50, 0xd7, 0xe8, 0xd5, 0x74, 0x4b, 0xd8, 0xcf, 0x45, 0xa5, 0x48, 0x67, 0x7d, 0x84,
-	0x1e, 0x69, 0x8a, 0xb5, 0x0a, 0xba, 0x08, 0x4c, 0x07, 0xca, 0x74, 0xc6, 0xdf, 0x45, 0xd8, 0x5b,
-	0x1f, 0xa1, 0x81, 0xa7, 0xcf, 0x97, 0x77, 0x7e, 0xd5, 0xb0, 0xa6, 0x57, 0x0d, 0xbb, 0x9f, 0x43,
-	0x4a, 0x63, 0xfa, 0xcf, 0xa7, 0x61, 0x51, 0x7f, 0x12, 0x4f, 0xfb, 0x68, 0x95, 0x1e, 0x9b, 0x9e,
-	0x44, 0x8f, 0xbd, 0x07, 0x32, 0xf7, 0x2b, 0xcc, 0xe4, 0xb1, 0xea, 0x09, 0x3a, 0xa9, 0x8d, 0x41,
-	0x6e, 0xc0, 0x7c, 0x5b, 0xb8, 0xbb, 0xa9, 0x92, 0xca, 0x79, 0xae, 0x6a, 0x9c, 0xcb, 0x7d, 0x37,
-	0xc2, 0xd0, 0x30, 0x12, 0x5e, 0x5c, 0x1a, 0x60, 0x97, 0x88, 0x5e, 0x5c, 0x86, 0x7a, 0x3f, 0x3a,
-	0x66, 0xae, 0x47, 0xdd, 0xcc, 0x7a, 0xd6, 0xbb, 0xac, 0xff, 0x14, 0x05, 0x38, 0x8a, 0x32, 0xdf,
-	0xb7, 0xe9, 0xfa, 0x2e, 0x34, 0xf0, 0x5d, 0xa4, 0x55, 0xef, 0x39, 0x53, 0xc4, 0x10, 0x62, 0xf8,
-	0x84, 0xf6, 0xb4, 0x7a, 0xf8, 0x82, 0xa7, 0x71, 0x68, 0xc8, 0x3e, 0xc3, 0x07, 0x7f, 0x57, 0x13,
-	0xbb, 0x45, 0x66, 0x9b, 0xb9, 0x57, 0x47, 0x5b, 0xc2, 0xfa, 0x6d, 0x05, 0x5e, 0x28, 0x4a, 0x94,
-	0xee, 0x0e, 0xd2, 0xfa, 0xbb, 0x09, 0x62, 0x13, 0x98, 0xf3, 0x19, 0x17, 0x9b, 0x40, 0x20, 0xa4,
-	0xcd, 0x3d, 0x58, 0x43, 0xda, 0xe4, 0xe2, 0x4f, 0x33, 0x93, 0x94, 0xbb, 0xae, 0xf2, 0x99, 0x66,
-	0x31, 0x5b, 0x31, 0xbd, 0xfe, 0x3e, 0x6f, 0x92, 0x3e, 0xa4, 0xf1, 0xef, 0xe1, 0x2c, 0xcf, 0xcc,
-	0xdc, 0x3c, 0xc0, 0x70, 0x7b, 0x1e, 0xdd, 0x6f, 0x9f, 0xc9, 0xf9, 0x1c, 0x96, 0x6e, 0xc7, 0x18,
-	0x95, 0x7d, 0x56, 0xc1, 0x73, 0xeb, 0x0b, 0x58, 0x4e, 0x97, 0x7c, 0x76, 0x71, 0x6f, 0xeb, 0xaf,
-	0x2a, 0x50, 0xc3, 0xaa, 0xdd, 0xb2, 0x12, 0xa3, 0x12, 0x89, 0x94, 0x96, 0x18, 0x6d, 0xc3, 0xa2,
-	0x28, 0xab, 0x94, 0xd2, 0xbc, 0xe4, 0xbe, 0xea, 0x08, 0xb4, 0xd3, 0x53, 0xc5, 0x0a, 0x62, 0x4e,
-	0x96, 0xd7, 0x99, 0x11, 0xc5, 0x0a, 0xd8, 0x9d, 0xe6, 0xff, 0xfe, 0x46, 0x88, 0x91, 0x7b, 0x03,
-	0xc4, 0xb0, 0xa0, 0x8e, 0xef, 0x2d, 0x93, 0x95, 0x2e, 0xe9, 0xf4, 0x94, 0x55, 0xca, 0x9d, 0x90,
-	0x79, 0x8e, 0xfc, 0xf6, 0x44, 0xb1, 0xd6, 0xeb, 0x3a, 0x6b, 0x8d, 0x9d, 0x34, 0x22, 0xa4, 0xfc,
-	0x63, 0xcc, 0xb6, 0x14, 0xe1, 0x27, 0xaf, 0xe8, 0x6d, 0x58, 0xea, 0x0d, 0x44, 0xc5, 0xb4, 0x51,
-	0xe5, 0xb0, 0xaa, 0x85, 0x67, 0x04, 0xed, 0xed, 0xc5, 0x9e, 0xb6, 0x8c, 0xf5, 0x4b, 0xe1, 0x00,
-	0xc9, 0xa5, 0x87, 0x4f, 0x3e, 0xa2, 0x50, 0x43, 0x3f, 0x84, 0x3a, 0xf1, 0x0d, 0xfd, 0xc4, 0xa5,
-	0xc0, 0x23, 0x4e, 0xfa, 0x85, 0x7e, 0x13, 0x05, 0x07, 0x7d, 0x0b, 0x1a, 0xea, 0xa0, 0x63, 0xce,
-	0x59, 0xef, 0x65, 0x8b, 0x70, 0xb3, 0x7d, 0x23, 0x6f, 0x42, 0xfd, 0x7e, 0x64, 0xc5, 0xb3, 0xb5,
-	0x95, 0x3e, 0xca, 0xca, 0x68, 0x76, 0xef, 0x1c, 0x0a, 0x8f, 0x43, 0x4b, 0x59, 0x5e, 0xca, 0xa7,
-	0x2c, 0x65, 0xbd, 0x83, 0x4a, 0x5b, 0x5a, 0xb7, 0xa0, 0xbe, 0x7b, 0xe7, 0x90, 0x8b, 0x79, 0x7c,
-	0x77, 0xe7, 0x60, 0x3e, 0xea, 0xb7, 0xb5, 0xcf, 0x96, 0xe6, 0x22, 0xfc, 0xb2, 0x89, 0xdb, 0xe4,
-	0x5c, 0x30, 0xb8, 0x49, 0x9f, 0x09, 0x23, 0x61, 0xd1, 0xce, 0x3a, 0xac, 0x3f, 0xab, 0x64, 0x95,
-	0x36, 0x26, 0x1a, 0xa9, 0xe7, 0xb8, 0xda, 0x09, 0x7b, 0x3d, 0x3f, 0xe1, 0xae, 0x8c, 0xb9, 0xc3,
-	0x72, 0x3a, 0x70, 0x7f, 0x82, 0xad, 0xc8, 0x35, 0xa8, 0x72, 0x4f, 0x47, 0xba, 0x35, 0x29, 0xd9,
-	0xb4, 0x43, 0xd8, 0x08, 0x60, 0x7d, 0x22, 0xbe, 0x5d, 0x8a, 0x22, 0xfc, 0xc8, 0xcb, 0x16, 0x8c,
-	0x95, 0x7d, 0xa0, 0x25, 0x3e, 0xf1, 0x4a, 0x7d, 0xd1, 0x9a, 0x5d, 0xc3, 0x1e, 0x74, 0x4e, 0x56,
-	0x60, 0x86, 0xe3, 0x27, 0x1d, 0x86, 0x47, 0x74, 0x60, 0x7d, 0x2d, 0x3e, 0xf7, 0xca, 0x2d, 0x26,
-	0x0f, 0xd7, 0x84, 0xf9, 0xb8, 0xdf, 0xe9, 0xd0, 0x38, 0x96, 0x76, 0xb9, 0x6a, 0x92, 0x0d, 0xa8,
-	0x51, 0xc6, 0x42, 0xe6, 0xf4, 0xe2, 0x63, 0x55, 0xe4, 0x83, 0x1d, 0xf7, 0xe2, 0x63, 0x61, 0xcd,
-	0xf3, 0x85, 0x64, 0x02, 0x4d, 0xb6, 0xac, 0x7f, 0xa8, 0x64, 0xb9, 0x68, 0xa5, 0xde, 0x1e, 0x2a,
-	0xe7, 0x4e, 0x9d, 0x60, 0x38, 0x71, 0x53, 0x79, 0xaa, 0xc4, 0x4d, 0x61, 0xc1, 0x14, 0xb9, 0x29,
-	0x4b, 0x46, 0xd1, 0x97, 0x1c, 0x57, 0x74, 0x84, 0x2a, 0x0f, 0x11, 0xb3, 0x7e, 0x01, 0xad, 0x61,
-	0x74, 0x9f, 0xc9, 0x77, 0x44, 0xea, 0x6b, 0x9d, 0xe9, 0x11, 0x5f, 0xeb, 0x58, 0x3f, 0xcd, 0xf2,
-	0xe9, 0x05, 0x04, 0x93, 0x58, 0xbc, 0x03, 0xb3, 0xe2, 0x58, 0x42, 0x20, 0x58, 0x85, 0x16, 0x86,
-	0x81, 0xb8, 0x2d, 0x26, 0x58, 0xff, 0x3d, 0x0d, 0x6b, 0x0f, 0x45, 0x71, 0x3a, 0x3d, 0xc6, 0x0f,
-	0x09, 0xb0, 0x1a, 0x95, 0x2c, 0xc1, 0xb4, 0x0a, 0x2b, 0xd8, 0xd3, 0xbe, 0xc7, 0xcf, 0x89, 0xd5,
-	0xfc, 0xea, 0x65, 0x8f, 0xb1, 0xa5, 0xeb, 0x1c, 0x58, 0xbd, 0xee, 0x9b, 0x00, 0x49, 0x98, 0x93,
-	0x09, 0xe5, 0x94, 0x4f, 0x42, 0x35, 0xef, 0x5a, 0xea, 0xbd, 0x54, 0x4b, 0xbe, 0xb3, 0x92, 0xae,
-	0x0c, 0xf7, 0xca, 0x65, 0x85, 0x85, 0x48, 0x57, 0xcd, 0x22, 0xde, 0xaa, 0xe6, 0x13, 0xa9, 0x40,
-	0xae, 0xc2, 0x52, 0x8f, 0x3f, 0x33, 0x3f, 0x19, 0x48, 0x28, 0x51, 0x3f, 0xd9, 0x50, 0xbd, 0x29,
-	0x98, 0x4c, 0x9d, 0x2b, 0x30, 0x51, 0x3b, 0xd9, 0x50, 0xbd, 0x02, 0x6c, 0x13, 0x40, 0x2b, 0xfb,
-	0x5f, 0xc0, 0xa7, 0xa1, 0xf5, 0x58, 0xbf, 0xaa, 0xe0, 0xbd, 0x15, 0x90, 0x56, 0xe7, 0xf4, 0x6f,
-	0x61, 0x0a, 0x9c, 0x83, 0x79, 0x3f, 0xc6, 0x2f, 0x2b, 0x64, 0x6a, 0x7f, 0xce, 0x8f, 0xef, 0xb0,
-	0xb0, 0x57, 0x12, 0x4a, 0xb6, 0xa4, 0x95, 0x8e, 0xf1, 0x43, 0xee, 0xad, 0x57, 0x45, 0x4e, 0x21,
-	0xad, 0x83, 0xde, 0xf7, 0xac, 0xaf, 0xd0, 0x41, 0x2f, 0x45, 0x35, 0x2d, 0x3d, 0x99, 0xc3, 0x45,
-	0x54, 0xfe, 0x71, 0x23, 0x8d, 0xd4, 0x0c, 0x4f, 0xb4, 0x25, 0xe8, 0xf6, 0x6f, 0x36, 0x01, 0x76,
-	0x22, 0xff, 0x01, 0x65, 0x8f, 0xfd, 0x0e, 0x25, 0x0f, 0x60, 0xf5, 0xf3, 0x3e, 0x65, 0x03, 0xfd,
-	0xdb, 0x54, 0x92, 0x7e, 0x2d, 0x58, 0xfc, 0xbd, 0x6d, 0xeb, 0x42, 0x1a, 0x87, 0x2b, 0xf8, 0xa2,
-	0xd5, 0x9a, 0x22, 0x07, 0xb0, 0x92, 0xff, 0xe4, 0x94, 0x5c, 0xd2, 0xd6, 0x2c, 0xfa, 0x18, 0xb5,
-	0x55, 0x96, 0xc7, 0xb3, 0xa6, 0xc8, 0x11, 0xba, 0xab, 0xc3, 0x35, 0xf6, 0xe4, 0x79, 0x6d, 0xd1,
-	0xd2, 0xcf, 0x1e, 0x5a, 0x57, 0xc7, 0x40, 0xa5, 0xfb, 0xf8, 0x18, 0x94, 0x2a, 0x28, 0xf8, 0x26,
-	0xc3, 0x4b, 0x14, 0x95, 0xe2, 0xb7, 0x5e, 0x18, 0x07, 0x96, 0x6e, 0xd5, 0x41, 0x0f, 0x7e, 0xa8,
-	0x38, 0x9b, 0x3c, 0x37, 0xb4, 0xc2, 0x70, 0x55, 0x78, 0xeb, 0xf9, 0xd1, 0x40, 0xb9, 0x4d, 0x86,
-	0x1c, 0x2d, 0x63, 0x93, 0xb2, 0x9a, 0x58, 0x63, 0x93, 0xd2, 0xa2, 0xd6, 0x74, 0x93, 0xa1, 0x8a,
-	0x30, 0x63, 0x93, 0xb2, 0x52, 0x36, 0x63, 0x93, 0xd2, 0xa2, 0xb2, 0x74, 0x93, 0xa1, 0x2a, 0x2c,
-	0x63, 0x93, 0xb2, 0x02, 0x32, 0x63, 0x93, 0xd2, 0x42, 0x2e, 0x6b, 0x8a, 0x30, 0x54, 0xbb, 0xc5,
-	0x85, 0x52, 0xe4, 0x7a, 0x9e, 0x1c, 0x65, 0x85, 0x5b, 0xad, 0x1b, 0x13, 0x40, 0xa6, 0x7b, 0xfe,
-	0x08, 0x1a, 0x46, 0xd1, 0x14, 0x49, 0xbf, 0x0b, 0xc9, 0x57, 0xd4, 0xb4, 0x2e, 0x6a, 0xeb, 0x0e,
-	0x57, 0x59, 0x59, 0x53, 0xe4, 0x13, 0x58, 0xd4, 0x0b, 0x85, 0x48, 0x2a, 0x0f, 0x0a, 0xca, 0x9a,
-	0xb2, 0x37, 0x5c, 0x54, 0x5b, 0x24, 0x16, 0xd3, 0x8b, 0x1e, 0xb2, 0xc5, 0x0a, 0x2a, 0x54, 0xb2,
-	0xc5, 0x8a, 0xea, 0x24, 0xac, 0x29, 0xf2, 0x39, 0x2c, 0x99, 0xf5, 0x0c, 0x44, 0x3f, 0xcc, 0x70,
-	0x59, 0x44, 0x6b, 0xb3, 0x6c, 0x58, 0xe3, 0x88, 0x73, 0x25, 0x85, 0x0d, 0xe4, 0x85, 0x61, 0x51,
-	0x53, 0x54, 0xf9, 0x60, 0x6c, 0x52, 0x50, 0x67, 0x60, 0x4d, 0x91, 0x9f, 0x64, 0x71, 0x36, 0x95,
-	0xf5, 0x46, 0xc3, 0x55, 0x17, 0x66, 0x45, 0x35, 0x00, 0xad, 0xcb, 0xe5, 0x00, 0x39, 0xa2, 0x68,
-	0x29, 0x52, 0x83, 0x28, 0xc3, 0xd9, 0x57, 0x03, 0xdf, 0x82, 0xcc, 0xaa, 0x35, 0x45, 0xbe, 0x44,
-	0xc1, 0x6b, 0xa4, 0x53, 0x0c, 0x5c, 0x8b, 0x92, 0x4a, 0x06, 0xae, 0x85, 0x99, 0x18, 0x6b, 0x8a,
-	0x74, 0xd3, 0x34, 0x43, 0x3e, 0x8d, 0x67, 0x50, 0x7b, 0x44, 0xc2, 0xb2, 0x75, 0x6d, 0x2c, 0x5c,
-	0xba, 0xdb, 0xcf, 0x60, 0x75, 0x28, 0xb3, 0x47, 0x2e, 0x17, 0xce, 0xd7, 0xc5, 0xe2, 0x53, 0xec,
-	0x70, 0x00, 0x0d, 0xa3, 0x9c, 0x80, 0x5c, 0xd0, 0x52, 0x4b, 0x43, 0x35, 0x09, 0xd9, 0xd3, 0x2b,
-	0xac, 0x41, 0x48, 0xe5, 0xd3, 0x50, 0x2e, 0xcb, 0x90, 0x4f, 0x65, 0x59, 0x3d, 0x43, 0x3e, 0x95,
-	0xa6, 0xc3, 0xac, 0x29, 0x72, 0x08, 0xcb, 0xb9, 0x5c, 0x8f, 0xa1, 0xa9, 0x0b, 0x12, 0x52, 0xad,
-	0x4b, 0xa5, 0xe3, 0xb9, 0x55, 0x8d, 0x68, 0xae, 0xbe, 0x6a, 0x41, 0x8a, 0xc6, 0x58, 0xb5, 0x28,
-	0x33, 0x62, 0x4d, 0x91, 0x3e, 0xb4, 0xca, 0x53, 0x0c, 0xc4, 0x14, 0x91, 0xa3, 0xb2, 0x1a, 0xad,
-	0x17, 0x27, 0x01, 0x1d, 0x25, 0x15, 0x64, 0x6a, 0xa2, 0x5c, 0x2a, 0x98, 0xb9, 0x8b, 0x09, 0xa4,
-	0xc2, 0x01, 0xca, 0xec, 0x2c, 0x7a, 0x4e, 0x2e, 0xe4, 0x5f, 0xbb, 0x1e, 0x94, 0x37, 0xe4, 0xf6,
-	0x70, 0xc8, 0x1d, 0xd9, 0xfd, 0x4c, 0x61, 0xd4, 0xd8, 0x30, 0x6f, 0x4a, 0x83, 0xca, 0x85, 0xb7,
-	0x91, 0xc3, 0x78, 0x80, 0x59, 0xf8, 0x51, 0x11, 0x57, 0xb2, 0x35, 0x4a, 0xdd, 0x0f, 0x87, 0x66,
-	0x27, 0x36, 0x0f, 0xfe, 0x20, 0xf7, 0x96, 0xf1, 0xeb, 0xab, 0xe2, 0xb7, 0xac, 0x45, 0x40, 0x5a,
-	0x57, 0x46, 0x40, 0xa4, 0x6b, 0xdf, 0x82, 0xe5, 0xdb, 0xb2, 0x0c, 0x54, 0x7e, 0x6a, 0x45, 0xce,
-	0xaa, 0x79, 0x66, 0x20, 0x32, 0xb3, 0x2e, 0x73, 0xd1, 0x44, 0x6b, 0x8a, 0xd8, 0x88, 0xa1, 0x59,
-	0x74, 0x3c, 0x42, 0x0d, 0xeb, 0x98, 0x15, 0x57, 0x2a, 0x5b, 0x53, 0xe4, 0xa7, 0xa8, 0x3d, 0x55,
-	0x84, 0x2c, 0x36, 0x6e, 0xb2, 0x34, 0xae, 0x67, 0x18, 0xaa, 0xe5, 0xd1, 0x35, 0x24, 0x6a, 0x3d,
-	0x03, 0x89, 0x0d, 0x29, 0x53, 0x16, 0x3a, 0x6b, 0x3d, 0x3f, 0x1a, 0x48, 0x5b, 0xfb, 0x4c, 0x61,
-	0x29, 0xf5, 0x08, 0x92, 0x5c, 0xcd, 0x91, 0xa4, 0xb8, 0x06, 0x1b, 0xc9, 0xb2, 0x5e, 0x14, 0xf6,
-	0x32, 0x0e, 0x50, 0x16, 0x14, 0x9b, 0xe0, 0x61, 0xfe, 0x2c, 0xfb, 0x87, 0x2a, 0xe6, 0xff, 0xa4,
-	0x31, 0xec, 0xf7, 0xf2, 0xff, 0x59, 0xd3, 0x1a, 0x9d, 0x08, 0x40, 0x5e, 0x21, 0xc3, 0xff, 0x88,
-	0x87, 0x5c, 0x29, 0xf2, 0x6d, 0x8c, 0x7f, 0xd2, 0x33, 0xca, 0xbb, 0xd1, 0xac, 0x74, 0x3d, 0x9a,
-	0x35, 0x6c, 0xa5, 0x17, 0x84, 0xdc, 0x86, 0x9f, 0x61, 0x51, 0x40, 0x2c, 0x7d, 0x86, 0x66, 0x48,
-	0xc9, 0x78, 0x86, 0x85, 0xa1, 0x2b, 0x83, 0xd9, 0x8b, 0xe3, 0x51, 0xa6, 0xdd, 0x3c, 0x14, 0x10,
-	0x19, 0xb6, 0x9b, 0xcb, 0x82, 0x4c, 0xc3, 0x76, 0x73, 0x69, 0x74, 0x25, 0xd5, 0x2f, 0x25, 0x1e,
-	0xb2, 0xa1, 0x5f, 0x46, 0x3b, 0xfc, 0x86, 0x7e, 0x19, 0xe3, 0x70, 0x5b, 0x53, 0xbb, 0x9b, 0x70,
-	0xc1, 0x0f, 0xb7, 0xe4, 0xbf, 0x6a, 0x0a, 0xe3, 0x2d, 0x37, 0xf0, 0x58, 0xe8, 0x7b, 0x5b, 0xb1,
-	0xf7, 0x68, 0x8b, 0x45, 0x9d, 0xf6, 0x1c, 0x06, 0x0b, 0xde, 0xf8, 0x5d, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x4b, 0x63, 0x14, 0x98, 0x0e, 0x4b, 0x00, 0x00,
+	// 7202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0xcd, 0x6f, 0x1c, 0x47,
+	0x76, 0xb8, 0x86, 0x1c, 0x92, 0x33, 0x6f, 0x66, 0xc8, 0x61, 0x89, 0xa2, 0x46, 0x2d, 0x4a, 0xa2,
+	0x5a, 0x92, 0x2d, 0x69, 0x6d, 0xda, 0x96, 0xbf, 0xbd, 0xfe, 0x58, 0x92, 0xa2, 0x64, 0xda, 0x16,
+	0x45, 0xb7, 0x68, 0x79, 0xf7, 0xb7, 0xd8, 0xdf, 0x6c, 0xcf, 0x4c, 0x91, 0x6c, 0x6b, 0xa6, 0xbb,
+	0xb7, 0xbb, 0x47, 0xe2, 0x18, 0xbb, 0xa7, 0xdf, 0xe1, 0x97, 0x0f, 0x2c, 0x82, 0x05, 0x12, 0x04,
+	0x49, 0x80, 0x24, 0xa7, 0x00, 0x0b, 0x24, 0x40, 0x72, 0x30, 0x10, 0x20, 0x01, 0x82, 0x1c, 0x82,
+	0xbd, 0x24, 0x40, 0x72, 0x58, 0xe4, 0x9a, 0x43, 0x0e, 0x41, 0x8e, 0xf9, 0x0b, 0x82, 0x7a, 0xf5,
+	0xd1, 0xd5, 0x5f, 0x33, 0x23, 0x5b, 0x31, 0x72, 0x21, 0xa6, 0x5e, 0xbd, 0xaa, 0x7a, 0x55, 0xf5,
+	0xea, 0xd5, 0xfb, 0xaa, 0x26, 0xc0, 0x51, 0xe0, 0x77, 0x37, 0xfc, 0xc0, 0x8b, 0x3c, 0x32, 0xcf,
+	0x7e, 0xfb, 0x1d, 0x63, 0x05, 0x8b, 0xd1, 0xc8, 0xa7, 0x2f, 0xb1, 0x3f, 0xbc, 0xd6, 0x68, 0xc5,
+	0xd0, 0xc1, 0xb0, 0x1f, 0x39, 0x6d, 0xa7, 0x27, 0x6a, 0xce, 0x6b, 0xf8, 0x81, 0xed, 0x86, 0x76,
+	0x37, 0x72, 0x3c, 0x97, 0x57, 0x9a, 0x7f, 0x5b, 0x82, 0xd5, 0xbb, 0x34, 0x3a, 0xb0, 0x3b, 0x7d,
+	0xba, 0xed, 0xb9, 0x11, 0x75, 0x23, 0x8b, 0xfe, 0x64, 0x48, 0xc3, 0x88, 0xac, 0xc0, 0x9c, 0xf7,
+	0xc4, 0xa5, 0x41, 0xab, 0xb4, 0x5e, 0xba, 0x5e, 0xb5, 0x78, 0x81, 0x18, 0x50, 0xe9, 0x7a, 0x6e,
+	0x14, 0xd8, 0xdd, 0xa8, 0x35, 0x83, 0x15, 0xaa, 0xcc, 0x5a, 0x44, 0xac, 0xa3, 0xd6, 0x2c, 0x6f,
+	0x81, 0x05, 0x06, 0x3d, 0x74, 0x68, 0xbf, 0xd7, 0x2a, 0x73, 0x28, 0x16, 0x18, 0xb4, 0x43, 0x8f,
+	0x1c, 0xb7, 0x35, 0xc7, 0xa1, 0x58, 0x60, 0xd0, 0xae, 0x37, 0x74, 0xa3, 0xd6, 0xfc, 0x7a, 0xe9,
+	0x7a, 0xc3, 0xe2, 0x05, 0xd2, 0x82, 0x85, 0x80, 0x3e, 0xa6, 0x41, 0x48, 0x5b, 0x0b, 0xeb, 0xa5,
+	0xeb, 0x15, 0x4b, 0x16, 0xcd, 0xef, 0xc2, 0x4a, 0x92, 0xf4, 0xd0, 0xf7, 0xdc, 0x90, 0x92, 0x2b,
+	0xd0, 0xc0, 0xc1, 0xdb, 0x5d, 0x5e, 0x21, 0xe6, 0x50, 0x8f, 0x34, 0x64, 0xf3, 0x1d, 0x38, 0x77,
+	0x97, 0x46, 0x9b, 0x5d, 0x1c, 0x64, 0x6b, 0xb4, 0x3f, 0xec, 0x7c, 0x4c, 0x47, 0x72, 0xf6, 0x17,
+	0x00, 0xfc, 0x61, 0xa7, 0xef, 0x74, 0xdb, 0x8f, 0xe8, 0x48, 0x34, 0xaf, 0x72, 0xc8, 0xc7, 0x74,
+	0x64, 0x7e, 0x06, 0x67, 0xf5, 0xb6, 0x7b, 0xf6, 0x80, 0xca, 0x96, 0xef, 0x40, 0xdd, 0xe6, 0xf0,
+	0xb6, 0x6b, 0x0f, 0x28, 0xb6, 0xad, 0xdd, 0x3a, 0xbb, 0xa1, 0xb6, 0x61, 0x43, 0xaf, 0xb6, 0x6a,
+	0xa2, 0xc4, 0xba, 0x30, 0x3f, 0x85, 0x0b, 0x77, 0x69, 0xb4, 0xd5, 0xf7, 0xba, 0x8f, 0xf6, 0x03,
+	0xaf, 0x37, 0xec, 0xd2, 0x20, 0xd9, 0xf9, 0xcb, 0xb0, 0xd0, 0xf1, 0xa7, 0xea, 0x77, 0xbe, 0xe3,
+	0x63, 0x97, 0x1e, 0xb4, 0x62, 0x4a, 0xb7, 0xed, 0xf0, 0xd8, 0x1b, 0x46, 0xcf, 0x80, 0x54, 0x72,
+	0x16, 0x16, 0x7c, 0x2f, 0x8c, 0xda, 0x4e, 0x0f, 0xf9, 0xa0, 0x6c, 0xcd, 0xb3, 0xe2, 0x6e, 0xcf,
+	0xfc, 0x19, 0xac, 0xa6, 0x47, 0x13, 0xbb, 0xf2, 0x4d, 0x86, 0x7b, 0x1e, 0xe6, 0x03, 0xfa, 0xc4,
+	0x0e, 0xf8, 0x68, 0xb5, 0x5b, 0x4b, 0x5a, 0xab, 0xc7, 0x34, 0x8c, 0x2c, 0x51, 0x6d, 0x7e, 0x17,
+	0x56, 0xe5, 0x12, 0xa6, 0x66, 0x7b, 0x19, 0xea, 0x1d, 0x06, 0x6e, 0x1f, 0x53, 0xe7, 0xe8, 0x98,
+	0xf3, 0x44, 0xd9, 0xaa, 0x21, 0xec, 0x43, 0x04, 0x99, 0x3f, 0x80, 0x95, 0x64, 0x4b, 0x41, 0xf9,
+	0x26, 0xd4, 0xbb, 0x1c, 0xd4, 0xee, 0x3b, 0x21, 0x6b, 0x3a, 0x7b, 0xbd, 0x76, 0xeb, 0xe2, 0x06,
+	0x3f, 0x92, 0x1b, 0xf9, 0xf3, 0xb5, 0x6a, 0xa2, 0xcd, 0x27, 0x4e, 0x18, 0x99, 0x3f, 0xc4, 0xad,
+	0x15, 0x98, 0x16, 0xd2, 0xfa, 0xec, 0xf8, 0xe6, 0xa7, 0x70, 0x26, 0xd1, 0xf3, 0xb7, 0xbb, 0xe4,
+	0x7f, 0xbe, 0x08, 0x35, 0x31, 0xfc, 0xae, 0x7b, 0xe8, 0x7d, 0xa3, 0x41, 0xaf, 0x40, 0xb9, 0xeb,
+	0x39, 0x6e, 0xce, 0x90, 0x0c, 0x6c, 0x61, 0x25, 0x43, 0x62, 0x04, 0xb4, 0x66, 0x33, 0x48, 0x48,
+	0x17, 0x56, 0x92, 0xb7, 0x13, 0x27, 0xb8, 0x8c, 0xa8, 0x86, 0x86, 0x1a, 0x57, 0xb6, 0x59, 0x59,
+	0x3b, 0xdd, 0xe4, 0x5d, 0xa8, 0x77, 0x03, 0x6a, 0x47, 0xb4, 0xd7, 0x8e, 0x9c, 0x01, 0x45, 0x19,
+	0x55, 0xbb, 0x75, 0x4e, 0x6b, 0xcc, 0xc0, 0x6d, 0xdf, 0x73, 0xdc, 0xa8, 0x1d, 0xd2, 0xae, 0x55,
+	0x13, 0xe8, 0x07, 0xce, 0x80, 0x92, 0xdb, 0xb0, 0xc8, 0xf9, 0xcc, 0x17, 0x47, 0x18, 0xa5, 0x59,
+	0xed, 0xd6, 0x05, 0xc9, 0x2e, 0x89, 0xf3, 0xad, 0xb8, 0xa5, 0xd1, 0xd1, 0xc1, 0x28, 0x80, 0xd8,
+	0xf9, 0xe2, 0xf2, 0x70, 0x01, 0xe5, 0x61, 0x95, 0x41, 0xb6, 0x19, 0x80, 0x5c, 0x83, 0xc5, 0x43,
+	0xaf, 0xdf, 0xf7, 0x9e, 0xd0, 0x40, 0xa0, 0x54, 0x10, 0xa5, 0x21, 0xa1, 0x1c, 0xed, 0x79, 0x58,
+	0xe2, 0x00, 0xc7, 0x3d, 0x12, 0x78, 0x55, 0xc4, 0x5b, 0x54, 0x60, 0x8e, 0x78, 0x1e, 0xaa, 0x51,
+	0x70, 0x22, 0x50, 0x00, 0x51, 0x2a, 0x51, 0x70, 0xc2, 0x2b, 0x2f, 0x00, 0x3c, 0xf6, 0x22, 0x36,
+	0xe1, 0x27, 0x34, 0x68, 0xd5, 0x38, 0x2d, 0x0c, 0xb2, 0xcf, 0x00, 0x64, 0x03, 0x4e, 0x87, 0x91,
+	0x3d, 0x70, 0x5c, 0xbb, 0x7d, 0x18, 0x50, 0xda, 0x0e, 0xe8, 0xc0, 0x76, 0xdc, 0x56, 0x1d, 0xcf,
+	0xd7, 0xb2, 0xa8, 0xba, 0x13, 0x50, 0x6a, 0x61, 0x05, 0x79, 0x19, 0x56, 0x24, 0x7e, 0x18, 0xd9,
+	0x8f, 0x54, 0x83, 0x06, 0x36, 0x20, 0xa2, 0xee, 0x01, 0xab, 0x12, 0x2d, 0x2e, 0x41, 0x4d, 0xb6,
+	0x18, 0xd8, 0x27, 0xad, 0x45, 0x44, 0x04, 0x01, 0xba, 0x67, 0x9f, 0x90, 0x77, 0x60, 0x99, 0x77,
+	0xc5, 0xb6, 0xbe, 0x7d, 0xe8, 0x05, 0xed, 0x01, 0x6d, 0x2d, 0xe5, 0xb3, 0xc7, 0x22, 0x62, 0x3e,
+	0xa4, 0x61, 0x74, 0xc7, 0x0b, 0xee, 0xb1, 0x33, 0xd2, 0x7c, 0xe2, 0x44, 0xc7, 0xbd, 0xc0, 0x7e,
+	0x22, 0x28, 0x09, 0x5b, 0xcd, 0xfc, 0xa6, 0x4b, 0x12, 0x91, 0xd3, 0x15, 0x92, 0xd7, 0xa0, 0x71,
+	0x6c, 0x87, 0x6d, 0x09, 0x76, 0x5b, 0xcb, 0xf9, 0x0d, 0xeb, 0xc7, 0x76, 0xf8, 0xb9, 0x44, 0x22,
+	0xef, 0x01, 0x51, 0x23, 0x52, 0xbb, 0x7b, 0xcc, 0xb9, 0x8c, 0xe4, 0x37, 0x55, 0xc4, 0xed, 0xd8,
+	0xdd, 0x63, 0x64, 0xb0, 0xbb, 0x40, 0x5c, 0x7a, 0x12, 0xa9, 0x51, 0x79, 0xf3, 0xd3, 0x93, 0x98,
+	0xb4, 0xc9, 0x1a, 0x49, 0x22, 0xb0, 0xa3, 0x5d, 0xdc, 0xb8, 0x20, 0xdd, 0xd3, 0xca, 0xa4, 0x9e,
+	0x96, 0xb1, 0x55, 0xa2, 0x2b, 0x13, 0x1a, 0x1d, 0xbf, 0x8d, 0x5c, 0xc2, 0x79, 0xe8, 0x0c, 0x72,
+	0x49, 0xad, 0xe3, 0x3f, 0xf4, 0x22, 0xca, 0xd9, 0xe8, 0x22, 0x40, 0x40, 0xfd, 0x61, 0x64, 0x33,
+	0x05, 0xa4, 0xb5, 0x8a, 0x08, 0x1a, 0x84, 0x31, 0x6b, 0x5c, 0x6a, 0x0f, 0xe8, 0xc0, 0x6b, 0x9d,
+	0xc5, 0x8b, 0x77, 0x31, 0x06, 0xdf, 0xa3, 0x03, 0x8f, 0x31, 0x7f, 0xf7, 0xd8, 0x0e, 0x8e, 0xf0,
+	0x7c, 0x76, 0x1f, 0xd1, 0xa8, 0xd5, 0xe2, 0xcc, 0x2f, 0xa0, 0x07, 0x08, 0x64, 0x5c, 0x83, 0xfc,
+	0x28, 0x70, 0xce, 0xf1, 0x01, 0x19, 0x48, 0x20, 0xac, 0xc2, 0x3c, 0x2b, 0x7d, 0x49, 0x5b, 0x06,
+	0xd6, 0x89, 0x92, 0x6c, 0xf8, 0x25, 0xe5, 0x44, 0x9c, 0x47, 0x22, 0x80, 0x83, 0x90, 0x80, 0x77,
+	0x81, 0xe8, 0xec, 0x16, 0x78, 0x03, 0xc6, 0x6f, 0x6b, 0x05, 0x4c, 0x13, 0xf3, 0x5b, 0xe0, 0x0d,
+	0xee, 0xb1, 0x65, 0x5f, 0x61, 0x0b, 0xd5, 0x6b, 0xa7, 0xc4, 0xc4, 0x85, 0xf1, 0x72, 0x92, 0x60,
+	0xa3, 0x84, 0xf0, 0x60, 0xfc, 0x87, 0x24, 0x74, 0xbc, 0x20, 0xf0, 0x9e, 0xd0, 0x5e, 0xeb, 0x62,
+	0x01, 0xff, 0xb1, 0xbf, 0x5b, 0x02, 0x89, 0xbc, 0x00, 0x55, 0x6c, 0xd5, 0x67, 0xaa, 0xd1, 0xa5,
+	0xfc, 0x16, 0x15, 0xf6, 0xf7, 0x13, 0xea, 0x46, 0xe4, 0x2d, 0x58, 0x42, 0xec, 0x1e, 0xed, 0x3b,
+	0x8f, 0x69, 0xe0, 0xb8, 0x47, 0xad, 0xf5, 0x82, 0x93, 0xc5, 0xfe, 0xde, 0x56, 0x68, 0x64, 0x03,
+	0x00, 0x5b, 0x32, 0xd5, 0xb1, 0xd7, 0xba, 0x9c, 0xdf, 0x08, 0x49, 0xb9, 0xff, 0xc4, 0xd5, 0xe8,
+	0x0a, 0x69, 0xff, 0xb0, 0x65, 0x8e, 0xa1, 0xeb, 0x01, 0xed, 0x1f, 0x92, 0x5b, 0x50, 0xb5, 0x87,
+	0xd1, 0xb1, 0x17, 0x38, 0xd1, 0xa8, 0x75, 0x05, 0xb1, 0x57, 0xf4, 0xb5, 0x93, 0x75, 0x56, 0x8c,
+	0x46, 0x3e, 0x80, 0x26, 0xd3, 0x7f, 0x1f, 0xd3, 0x76, 0xdc, 0xf4, 0xea, 0x98, 0xa6, 0x4b, 0x1c,
+	0x7b, 0x53, 0x75, 0xb0, 0x09, 0xcb, 0x4c, 0x08, 0x33, 0x71, 0x1a, 0xf7, 0x70, 0x6d, 0x4c, 0x0f,
+	0x4d, 0x81, 0xae, 0xba, 0x30, 0x7b, 0xb0, 0xa4, 0x2e, 0x6b, 0x71, 0x4d, 0x3f, 0x0f, 0x65, 0xc7,
+	0x3d, 0xf4, 0xc4, 0x4d, 0x79, 0x3a, 0xa5, 0x57, 0xb0, 0x4b, 0xd5, 0x42, 0x04, 0x72, 0x1d, 0xe6,
+	0xc2, 0xc8, 0x8e, 0xa8, 0xb8, 0x1f, 0x89, 0xc4, 0xdc, 0x3e, 0xb6, 0x1d, 0xf7, 0x01, 0xab, 0xb1,
+	0x38, 0x82, 0xf9, 0xef, 0x25, 0x58, 0xbb, 0x4b, 0xa3, 0x3b, 0xe2, 0x3a, 0x60, 0x3a, 0x48, 0x52,
+	0xdf, 0x78, 0x13, 0xbb, 0x0a, 0x22, 0x31, 0xe8, 0x65, 0x8d, 0xfa, 0xf8, 0x66, 0x11, 0xb7, 0xa0,
+	0x17, 0xf4, 0x68, 0x60, 0x71, 0x7c, 0xf2, 0x2a, 0xcc, 0x52, 0x57, 0x2a, 0x05, 0x53, 0x34, 0x63,
+	0xd8, 0x4c, 0xb3, 0xef, 0x3b, 0x03, 0x87, 0xdf, 0xd9, 0x0d, 0x8b, 0x17, 0xc8, 0xf7, 0x00, 0xfa,
+	0x76, 0x18, 0x71, 0xc4, 0x56, 0x79, 0xda, 0x1e, 0xab, 0xac, 0xd1, 0x7d, 0xf6, 0xd3, 0xfc, 0xed,
+	0x12, 0x34, 0xf5, 0x39, 0xa2, 0x02, 0xf2, 0x0a, 0x2c, 0x88, 0x93, 0xa3, 0x74, 0x8f, 0xe4, 0x8a,
+	0xaa, 0x4b, 0x57, 0xe2, 0x91, 0xdb, 0xf2, 0xca, 0x17, 0xb4, 0x4c, 0x3d, 0x3b, 0x71, 0xf5, 0x73,
+	0x6a, 0xfe, 0x2f, 0x2a, 0x79, 0x79, 0x6b, 0x2e, 0x36, 0xfa, 0x3d, 0x50, 0x17, 0xb4, 0xae, 0x49,
+	0xb6, 0x24, 0x7d, 0xe9, 0xa9, 0x58, 0xf5, 0x43, 0x0d, 0x62, 0xfe, 0x47, 0x49, 0x1b, 0xc0, 0x71,
+	0x8f, 0xb2, 0xbb, 0xfa, 0x56, 0x72, 0x57, 0xcd, 0xcc, 0x04, 0x50, 0x11, 0xc8, 0xdb, 0xd6, 0xd7,
+	0xf4, 0x6d, 0x9d, 0xa6, 0xdd, 0x98, 0x7d, 0xdd, 0xcc, 0xd9, 0xd7, 0x69, 0xba, 0xd4, 0x36, 0xf6,
+	0xe7, 0x25, 0x58, 0x4e, 0xcc, 0xf3, 0xeb, 0xee, 0xec, 0x4e, 0xee, 0xce, 0x4e, 0x43, 0x4d, 0x62,
+	0x6b, 0x3b, 0x70, 0xb1, 0x68, 0xe5, 0xc5, 0xde, 0x7e, 0x0f, 0x62, 0xa5, 0x4a, 0xdf, 0xdc, 0x73,
+	0xc9, 0xcd, 0xd5, 0xa6, 0x23, 0xb5, 0x35, 0x01, 0x32, 0x7f, 0x00, 0xe7, 0xd5, 0x18, 0xdb, 0xcf,
+	0xd6, 0xb2, 0xb4, 0x60, 0x2d, 0xbf, 0x6b, 0x41, 0xfc, 0x59, 0x58, 0x38, 0x64, 0xbc, 0x2d, 0x16,
+	0x96, 0xdd, 0x85, 0x34, 0xd8, 0x76, 0x23, 0x72, 0x0e, 0x2a, 0x87, 0xb8, 0x34, 0x2e, 0x37, 0xf8,
+	0x1b, 0xd6, 0x02, 0x2b, 0x6f, 0xbb, 0x91, 0xf9, 0xd5, 0x1c, 0x9c, 0xc9, 0xd5, 0x65, 0xc9, 0x8b,
+	0xba, 0xef, 0x60, 0x0c, 0x89, 0x1c, 0x2b, 0xa3, 0x6f, 0xcf, 0x3c, 0x95, 0xbe, 0xdd, 0x84, 0xd9,
+	0x61, 0xd0, 0x17, 0x4e, 0x07, 0xf6, 0x93, 0x6c, 0xa0, 0x95, 0x8c, 0x26, 0x02, 0xe7, 0xbd, 0x33,
+	0x5a, 0x57, 0xa2, 0xa6, 0xed, 0xf4, 0x98, 0x8d, 0xcc, 0xae, 0x65, 0xf2, 0x5d, 0xa8, 0x85, 0xce,
+	0x91, 0xcb, 0xa6, 0xc9, 0x6c, 0x85, 0xb9, 0x89, 0xb6, 0x02, 0x08, 0x74, 0x66, 0x2c, 0xdc, 0x82,
+	0x33, 0x7e, 0xe0, 0xf9, 0x5e, 0x48, 0x7b, 0x6d, 0x5d, 0x0d, 0x46, 0xad, 0xbf, 0x6c, 0x9d, 0x96,
+	0x95, 0x0f, 0x62, 0x3d, 0x98, 0x99, 0xa2, 0x91, 0x1f, 0xb6, 0xe9, 0x89, 0x4f, 0xbb, 0x11, 0xed,
+	0xa1, 0x7a, 0x5f, 0xb6, 0x6a, 0x91, 0x1f, 0xee, 0x08, 0x10, 0xd3, 0x11, 0xe5, 0x52, 0x09, 0xf6,
+	0x3d, 0xa4, 0xb4, 0x55, 0xc9, 0x5c, 0x8a, 0x68, 0x16, 0x35, 0x05, 0xea, 0x36, 0x62, 0xde, 0xa1,
+	0x94, 0xbc, 0x01, 0xad, 0xc8, 0xf3, 0xdb, 0x6e, 0xdb, 0xee, 0xfe, 0x64, 0xe8, 0x04, 0x94, 0x6b,
+	0xe6, 0x91, 0xf7, 0x88, 0xba, 0xc2, 0x02, 0x58, 0x89, 0x3c, 0x7f, 0x6f, 0x93, 0xd7, 0x32, 0xa2,
+	0x0e, 0x58, 0x1d, 0x9b, 0x0d, 0x57, 0x97, 0xda, 0x87, 0xfd, 0x61, 0x78, 0xdc, 0x76, 0xdc, 0x88,
+	0x06, 0x8f, 0xed, 0x3e, 0xda, 0x04, 0x65, 0xeb, 0x34, 0xaf, 0xbc, 0xc3, 0xea, 0x76, 0x45, 0x15,
+	0x79, 0x1b, 0x9a, 0x3e, 0x0d, 0x84, 0x9a, 0xd5, 0xf6, 0x03, 0xa7, 0x4b, 0x5b, 0xb5, 0x7c, 0x42,
+	0x17, 0x7d, 0x1a, 0x70, 0xe5, 0x6b, 0x9f, 0xa1, 0x91, 0x9b, 0xb0, 0xac, 0x35, 0x7d, 0xc2, 0x0d,
+	0x73, 0x6e, 0x38, 0x2c, 0x29, 0xd4, 0xcf, 0x11, 0xcc, 0xb4, 0x32, 0xa6, 0x01, 0x49, 0x7b, 0x87,
+	0x5b, 0x0b, 0x68, 0x98, 0x08, 0x63, 0xe7, 0x39, 0x58, 0x3a, 0xa2, 0xae, 0xd0, 0xaa, 0x38, 0x12,
+	0xb7, 0x14, 0x1a, 0x47, 0xd4, 0xe5, 0x76, 0x3d, 0x03, 0x9a, 0x1d, 0x3c, 0x66, 0x09, 0xce, 0x65,
+	0xc7, 0x4f, 0x1e, 0xb3, 0x17, 0x93, 0x22, 0xb4, 0x98, 0x79, 0xb9, 0xdc, 0x54, 0x12, 0x70, 0x46,
+	0x93, 0x80, 0xe6, 0x00, 0xd6, 0xf2, 0xc7, 0x10, 0x27, 0xe4, 0x1e, 0x9c, 0x4e, 0x6a, 0x7f, 0xba,
+	0xc4, 0x98, 0x60, 0x29, 0x2e, 0x77, 0xd2, 0xdd, 0x9a, 0xbf, 0xaa, 0x40, 0x7d, 0xdf, 0xd3, 0xfa,
+	0x3f, 0x1f, 0xbb, 0x67, 0xd0, 0xcf, 0xb1, 0x35, 0xf3, 0x72, 0x49, 0xba, 0x68, 0xd0, 0x89, 0x67,
+	0x47, 0xf4, 0xc8, 0x0b, 0x46, 0xca, 0x89, 0x27, 0xca, 0xe4, 0x5d, 0x68, 0xf8, 0x76, 0x40, 0xdd,
+	0x48, 0xe8, 0x37, 0xad, 0xd9, 0xf1, 0xab, 0x50, 0xe7, 0xd8, 0x5c, 0xbd, 0x21, 0x2f, 0xc1, 0xbc,
+	0x68, 0x36, 0x37, 0xc1, 0x3d, 0xc5, 0xd1, 0xd8, 0xea, 0x45, 0x4e, 0xd4, 0xe7, 0x9e, 0xbd, 0xaa,
+	0xc5, 0x0b, 0x84, 0x40, 0xb9, 0xe3, 0xf5, 0x46, 0xc8, 0xee, 0x55, 0x0b, 0x7f, 0x93, 0x57, 0x61,
+	0x41, 0x9c, 0xfa, 0x56, 0x6d, 0x92, 0x7c, 0x90, 0x98, 0xe4, 0x1d, 0xa8, 0xe1, 0x45, 0xe4, 0xdb,
+	0x23, 0x6f, 0xc8, 0x79, 0x66, 0x6c, 0x43, 0xbc, 0xb6, 0xf6, 0x11, 0x99, 0x91, 0xd6, 0xa3, 0x7e,
+	0x74, 0x8c, 0x4c, 0xd4, 0xb0, 0x78, 0x01, 0xd7, 0xee, 0xd8, 0xe9, 0xf7, 0x02, 0xea, 0xa2, 0x81,
+	0xd9, 0xb0, 0x54, 0x99, 0x2d, 0x7a, 0xe0, 0x79, 0xb8, 0xe8, 0xcd, 0x78, 0xd1, 0x19, 0x68, 0xb7,
+	0x47, 0x2e, 0x41, 0x55, 0x2c, 0xac, 0xd3, 0x6b, 0x2d, 0xab, 0xea, 0x0a, 0x07, 0xee, 0xf6, 0xd8,
+	0xa4, 0x23, 0xfb, 0x28, 0x6c, 0x91, 0xf5, 0x59, 0x36, 0x69, 0xf6, 0x9b, 0xdc, 0x85, 0x46, 0x87,
+	0xba, 0xf4, 0xd0, 0xe9, 0x3a, 0x76, 0xe0, 0xd0, 0xb0, 0x75, 0x7a, 0x7d, 0x36, 0xa5, 0x97, 0xc4,
+	0xf5, 0xa3, 0x76, 0xe0, 0x0d, 0x23, 0xca, 0x45, 0x54, 0xb2, 0x1d, 0x13, 0xe3, 0xdc, 0x38, 0x73,
+	0x23, 0xb4, 0xef, 0xca, 0xd6, 0x02, 0x2b, 0x33, 0x09, 0x7f, 0x09, 0x6a, 0xfc, 0xe0, 0xd1, 0x5e,
+	0xfb, 0xb1, 0x8f, 0x86, 0x5b, 0xd5, 0x02, 0x09, 0x7a, 0xe8, 0x93, 0x1b, 0xb0, 0xc0, 0x3d, 0x3d,
+	0x61, 0x6b, 0x35, 0x73, 0xac, 0x51, 0x29, 0x97, 0xf5, 0xe4, 0x16, 0xd4, 0x7b, 0xb6, 0xef, 0xb7,
+	0x25, 0xfe, 0xd9, 0x7c, 0xfc, 0x1a, 0x43, 0xb2, 0x44, 0x9b, 0x1b, 0xd0, 0x94, 0xce, 0x35, 0x25,
+	0x6d, 0x5a, 0x5c, 0x04, 0x08, 0xb8, 0x92, 0x34, 0x6f, 0xc0, 0xe2, 0x51, 0xdf, 0xeb, 0xd8, 0x7d,
+	0x35, 0xc0, 0xb9, 0xfc, 0x01, 0x1a, 0x1c, 0x4d, 0x0e, 0xf1, 0x02, 0x10, 0xd1, 0x4e, 0x9f, 0xa9,
+	0x81, 0x33, 0x6d, 0xf2, 0x9a, 0xcf, 0xe3, 0xf9, 0xae, 0xc2, 0xbc, 0x30, 0x19, 0xcf, 0xf3, 0xab,
+	0x90, 0x97, 0xc8, 0x1a, 0x54, 0xbb, 0x9e, 0x3f, 0x0a, 0x50, 0x48, 0xad, 0x61, 0x55, 0x0c, 0x40,
+	0xa3, 0x54, 0x16, 0xb8, 0xdd, 0x78, 0x01, 0xfb, 0x6f, 0x28, 0x28, 0x9a, 0x8e, 0x1b, 0xb0, 0x2c,
+	0x67, 0xcb, 0x05, 0x80, 0x3b, 0x1c, 0xb4, 0x2e, 0x2a, 0x76, 0x90, 0x53, 0xc6, 0x93, 0xbf, 0x37,
+	0x1c, 0x30, 0xe7, 0x37, 0xf3, 0x75, 0x33, 0x8b, 0xf9, 0x12, 0xbf, 0x7e, 0x45, 0x51, 0xf1, 0x36,
+	0xed, 0x39, 0xec, 0x50, 0xac, 0x4f, 0xc5, 0xdb, 0x3b, 0x88, 0xcc, 0x7a, 0xed, 0xd1, 0x3e, 0x8d,
+	0x84, 0x59, 0x56, 0xb1, 0x64, 0xd1, 0xfc, 0x83, 0x12, 0x4a, 0x47, 0x26, 0x4c, 0xb8, 0x8e, 0xc3,
+	0xef, 0x94, 0x9e, 0x94, 0x8e, 0xaf, 0x26, 0xa5, 0xe3, 0x05, 0xfd, 0x96, 0x44, 0x7f, 0x55, 0x9e,
+	0x6e, 0xf9, 0x92, 0xae, 0x5b, 0x4e, 0x68, 0x52, 0xac, 0x56, 0x9a, 0x9f, 0xc2, 0x5a, 0x3e, 0x69,
+	0x42, 0xe8, 0xbd, 0x02, 0xe8, 0x21, 0xd3, 0x45, 0xe9, 0x8a, 0x14, 0xa5, 0xba, 0x74, 0xb4, 0x2a,
+	0xbe, 0xe8, 0xc3, 0xfc, 0x23, 0x3e, 0x5d, 0x8b, 0xfa, 0xfd, 0x11, 0xef, 0x74, 0x1f, 0x65, 0xa4,
+	0x9c, 0xee, 0x6b, 0xc9, 0xe9, 0x5e, 0xd4, 0x68, 0x0f, 0x58, 0x9b, 0xfc, 0xf9, 0xbe, 0xac, 0xcf,
+	0x77, 0x52, 0x9b, 0x31, 0x13, 0x7e, 0x00, 0x6b, 0xf9, 0xc4, 0x89, 0x09, 0xbf, 0x8a, 0x1e, 0x95,
+	0xfe, 0x68, 0xf2, 0x8c, 0xab, 0x81, 0xec, 0xc4, 0x7c, 0x04, 0xeb, 0xf2, 0x6a, 0x3a, 0x88, 0x03,
+	0x42, 0xe1, 0xd6, 0x68, 0x6f, 0x38, 0x90, 0xd3, 0x3e, 0x0f, 0xd5, 0x98, 0x3b, 0xb9, 0x42, 0x58,
+	0xe9, 0x48, 0x96, 0x5c, 0x91, 0x6b, 0x22, 0x6e, 0xbc, 0xd4, 0x3d, 0x98, 0x98, 0xc1, 0x21, 0x5c,
+	0x1e, 0x33, 0x58, 0xec, 0x5e, 0xd7, 0x42, 0x53, 0xa1, 0xba, 0x05, 0xe3, 0x75, 0x63, 0xfa, 0x16,
+	0xd3, 0x0e, 0x63, 0x2c, 0x2b, 0xd1, 0xc4, 0xdc, 0x84, 0x33, 0x77, 0x69, 0xa4, 0x99, 0xc1, 0xb2,
+	0x6f, 0x65, 0x31, 0x97, 0x26, 0x59, 0xcc, 0x6f, 0x62, 0x3c, 0x68, 0xcf, 0xeb, 0xd1, 0x3d, 0x26,
+	0x0b, 0x3a, 0xde, 0x30, 0x08, 0x55, 0x37, 0x06, 0x54, 0x7c, 0x4a, 0x03, 0xb1, 0xce, 0x78, 0x65,
+	0xca, 0xb2, 0xf9, 0x5b, 0x25, 0xa8, 0x63, 0x77, 0x77, 0xa8, 0x1d, 0x0d, 0x03, 0x4a, 0x16, 0x61,
+	0x46, 0xdc, 0xbb, 0x55, 0x6b, 0xc6, 0xe9, 0x31, 0x87, 0x27, 0xfa, 0x11, 0xb8, 0x63, 0x2b, 0x5e,
+	0x58, 0x1e, 0x38, 0x21, 0x71, 0x9d, 0x3a, 0xf5, 0xeb, 0x50, 0xeb, 0xd1, 0xb0, 0x1b, 0x38, 0x3e,
+	0x03, 0x0b, 0xdd, 0x56, 0x07, 0x31, 0x21, 0x85, 0xed, 0x28, 0xaa, 0xb8, 0x15, 0x4b, 0x94, 0xcc,
+	0x10, 0x2e, 0x88, 0x59, 0x58, 0x43, 0x97, 0xe9, 0xa8, 0x0f, 0xb9, 0xb8, 0x50, 0x33, 0x59, 0x87,
+	0x9a, 0xeb, 0xf5, 0xa8, 0x00, 0x0b, 0x2a, 0x75, 0x10, 0x79, 0x19, 0x2a, 0x87, 0x7c, 0x26, 0x61,
+	0x6b, 0x26, 0xc9, 0x53, 0xfa, 0x34, 0x2d, 0x85, 0x65, 0xfe, 0x5e, 0x09, 0x4e, 0x6f, 0x05, 0x9e,
+	0xdd, 0xeb, 0xda, 0x61, 0x74, 0x10, 0x9c, 0x48, 0x36, 0xfa, 0x00, 0x6a, 0xda, 0x2e, 0xe5, 0x88,
+	0x8c, 0x9c, 0x7d, 0xd5, 0x5b, 0x30, 0x45, 0xd9, 0x73, 0xfb, 0x23, 0xe9, 0x7b, 0xc2, 0x15, 0xab,
+	0x58, 0x35, 0x06, 0x13, 0x7e, 0x26, 0xb6, 0x33, 0x87, 0x8e, 0x6b, 0xf7, 0x99, 0x23, 0x66, 0x16,
+	0xab, 0x55, 0xd9, 0xfc, 0xd3, 0x12, 0xac, 0x24, 0xe9, 0x12, 0x8b, 0xb0, 0x05, 0x0b, 0x8e, 0xfb,
+	0xd8, 0x63, 0x9a, 0x2a, 0x27, 0xea, 0xba, 0x2e, 0x37, 0x63, 0x02, 0xda, 0x01, 0xed, 0x52, 0xc7,
+	0xe7, 0x1e, 0xd2, 0x36, 0x73, 0xc1, 0x58, 0xb2, 0x21, 0xdb, 0x01, 0xc6, 0x38, 0xc3, 0x50, 0x9c,
+	0x03, 0x51, 0x62, 0xf6, 0xc8, 0x20, 0x3c, 0x92, 0xf6, 0xc8, 0x20, 0x3c, 0x4a, 0x90, 0x58, 0x4e,
+	0x91, 0x48, 0xa0, 0xb9, 0xe7, 0xb9, 0xfb, 0x76, 0x60, 0x0f, 0x42, 0xb1, 0x6c, 0xe6, 0x17, 0x50,
+	0xdf, 0xb6, 0xfb, 0x7d, 0x45, 0xed, 0x2a, 0x8b, 0xc4, 0x84, 0xc3, 0xbe, 0x64, 0x3d, 0x51, 0x62,
+	0x37, 0x37, 0x3d, 0xa1, 0x5d, 0x76, 0xe7, 0xd3, 0x20, 0x10, 0xaa, 0x1c, 0x08, 0xd0, 0x4e, 0x10,
+	0xb0, 0xe5, 0xa3, 0x61, 0xe4, 0x0c, 0x98, 0xf9, 0x70, 0x64, 0x87, 0x92, 0x8f, 0x24, 0xec, 0xae,
+	0x1d, 0x9a, 0xff, 0x50, 0x02, 0x88, 0xcf, 0x02, 0xd9, 0x86, 0x8b, 0x78, 0xa9, 0x38, 0x01, 0x8f,
+	0xb1, 0x3a, 0x2c, 0x8a, 0xaa, 0x38, 0xb6, 0x23, 0x4c, 0xba, 0xb2, 0x75, 0x9e, 0x61, 0xed, 0x6a,
+	0x48, 0x92, 0x75, 0x3b, 0x34, 0x20, 0x1f, 0xc0, 0x5a, 0x51, 0x27, 0xca, 0xbe, 0x2b, 0x5b, 0xe7,
+	0x72, 0xbb, 0x40, 0x93, 0xee, 0x15, 0x28, 0xf7, 0x8e, 0x7c, 0xaf, 0x35, 0x9b, 0x61, 0x98, 0xde,
+	0xc8, 0xb5, 0x07, 0x4e, 0x97, 0xa9, 0xcd, 0x3e, 0x0d, 0x22, 0x87, 0x86, 0x16, 0xa2, 0x9a, 0x0f,
+	0xe0, 0xb4, 0x14, 0x34, 0xba, 0x32, 0xbf, 0xa2, 0xcb, 0xef, 0xb2, 0x94, 0x55, 0xcd, 0x58, 0x3e,
+	0x97, 0xc7, 0xc9, 0xdf, 0xbf, 0x9b, 0x81, 0x2a, 0x76, 0x89, 0xce, 0x87, 0x37, 0xa1, 0xca, 0xc8,
+	0x67, 0x46, 0x9e, 0xdf, 0x2a, 0x4d, 0xba, 0x6e, 0x63, 0x5c, 0x72, 0x2d, 0x15, 0x79, 0x9c, 0x51,
+	0xd7, 0xbd, 0x1e, 0x7d, 0x4c, 0xc6, 0x60, 0x66, 0x53, 0x31, 0x98, 0xf7, 0x33, 0x51, 0xa5, 0xf2,
+	0x78, 0x0d, 0x3b, 0x15, 0x4f, 0x7a, 0x01, 0xb8, 0x00, 0x67, 0xda, 0x27, 0xd7, 0xcd, 0x97, 0xb5,
+	0x96, 0xe1, 0xb1, 0x7d, 0xeb, 0xf5, 0x37, 0xac, 0x05, 0x44, 0xd9, 0xed, 0x91, 0xeb, 0x30, 0xef,
+	0x07, 0x94, 0xe1, 0xce, 0x17, 0xe1, 0xce, 0xf9, 0x01, 0xdd, 0xed, 0xb1, 0xd8, 0x10, 0xef, 0x37,
+	0x74, 0xbe, 0xa4, 0x32, 0x4e, 0x85, 0x90, 0x07, 0xce, 0x97, 0xd4, 0xdc, 0x84, 0x95, 0xe4, 0xb6,
+	0x08, 0x96, 0xbe, 0x01, 0xf3, 0x88, 0x24, 0x85, 0xfd, 0x72, 0xc2, 0xe4, 0x41, 0xe7, 0x88, 0x40,
+	0x30, 0x5f, 0x44, 0xd1, 0xfe, 0x00, 0x25, 0x05, 0xd6, 0x8e, 0xdd, 0x5b, 0xf3, 0x2e, 0xac, 0xa6,
+	0xd1, 0x63, 0xaf, 0x04, 0x76, 0x99, 0x63, 0xd8, 0x09, 0x39, 0x84, 0xd5, 0x16, 0xc7, 0x32, 0xff,
+	0xba, 0x84, 0x2e, 0x1f, 0xe1, 0x58, 0xe2, 0xf7, 0xef, 0x96, 0xdd, 0xb7, 0xdd, 0xae, 0xf2, 0xc8,
+	0x5c, 0x4b, 0x6a, 0x07, 0x19, 0x73, 0x57, 0xb0, 0xdb, 0x65, 0x5d, 0x1d, 0xc8, 0x20, 0x21, 0xff,
+	0xbd, 0x01, 0x75, 0x3c, 0x32, 0x76, 0x37, 0xde, 0xfe, 0x02, 0x4f, 0x30, 0x6a, 0x7d, 0x02, 0x10,
+	0xf3, 0x6d, 0x59, 0xe7, 0xdb, 0x1d, 0x5c, 0x03, 0x8d, 0x72, 0xb5, 0x06, 0xdf, 0x81, 0xb2, 0xa6,
+	0x2b, 0x14, 0x7a, 0xcf, 0x10, 0xc9, 0x3c, 0x80, 0xc6, 0x6d, 0xdb, 0xe9, 0x8f, 0x0e, 0xbc, 0xc8,
+	0xee, 0x1f, 0x04, 0x27, 0xe4, 0x45, 0x28, 0xf7, 0xe2, 0xbb, 0x74, 0x0c, 0xf3, 0x23, 0x5a, 0x9c,
+	0xce, 0xc1, 0x0f, 0x1a, 0x2f, 0x98, 0x7f, 0x5f, 0xc2, 0xe4, 0x89, 0x44, 0xcf, 0x72, 0x41, 0x5f,
+	0x4a, 0x2e, 0xe8, 0x98, 0x11, 0xc4, 0xd2, 0x7e, 0x47, 0x5f, 0xda, 0x31, 0xe8, 0xb8, 0xc8, 0xb7,
+	0xa0, 0xca, 0xe5, 0x12, 0xf3, 0xb5, 0xcf, 0x0a, 0xcf, 0x90, 0x58, 0x81, 0x24, 0x39, 0x15, 0x94,
+	0x4d, 0xec, 0xd0, 0x17, 0x2d, 0x70, 0x2b, 0x3b, 0x05, 0xc5, 0xda, 0xfa, 0x12, 0x17, 0x0c, 0xc0,
+	0x17, 0xf8, 0x0d, 0x00, 0x26, 0x76, 0xb7, 0x46, 0x1f, 0x7a, 0xc3, 0x80, 0x19, 0x80, 0xc7, 0xde,
+	0x30, 0x10, 0xfa, 0x16, 0xfe, 0x4e, 0x2e, 0xa1, 0xcc, 0x88, 0x31, 0x5f, 0x80, 0x95, 0x83, 0xe0,
+	0x24, 0x6e, 0xaa, 0x9d, 0x08, 0xd6, 0x2a, 0x14, 0x5d, 0xf0, 0x82, 0xf9, 0x01, 0x9c, 0x49, 0x61,
+	0x0b, 0x4a, 0x9f, 0x83, 0x32, 0xbb, 0xb3, 0x04, 0xa5, 0x4a, 0x35, 0xd2, 0x30, 0xb1, 0xde, 0xfc,
+	0xe5, 0x0c, 0x2c, 0x1c, 0x04, 0x27, 0xbb, 0x3c, 0x02, 0x31, 0xcf, 0x84, 0x94, 0xd0, 0x6f, 0xf2,
+	0x25, 0x43, 0x14, 0x9c, 0xec, 0xf6, 0x32, 0xf9, 0x16, 0x33, 0x99, 0x7c, 0x0b, 0xf2, 0x36, 0x30,
+	0x01, 0xd7, 0x7e, 0x12, 0xd8, 0x7e, 0x6b, 0x36, 0xa3, 0x2c, 0xeb, 0xf7, 0x30, 0x43, 0xf1, 0x69,
+	0x60, 0x2d, 0x44, 0xc1, 0xc9, 0xe7, 0x81, 0xed, 0x93, 0xb7, 0xa4, 0xdc, 0xc1, 0x1b, 0xa5, 0x3c,
+	0x51, 0x1a, 0x77, 0xd4, 0xe5, 0xf2, 0x74, 0x92, 0x70, 0x83, 0x39, 0x6a, 0x1e, 0xb5, 0x9d, 0x30,
+	0x71, 0x9b, 0xa1, 0x58, 0xac, 0x30, 0x4f, 0xcc, 0xa3, 0xdd, 0x50, 0xbf, 0xc3, 0x84, 0x22, 0x2a,
+	0x56, 0x6b, 0x6b, 0x14, 0x5b, 0x12, 0x53, 0x2f, 0x9c, 0xf9, 0x1e, 0xac, 0xa6, 0xbb, 0x50, 0x79,
+	0x4d, 0x7a, 0x9c, 0x68, 0x49, 0x6e, 0x98, 0x40, 0xe5, 0x31, 0x22, 0xf3, 0x6f, 0xf8, 0xf9, 0x3a,
+	0x08, 0x4e, 0xb8, 0xcc, 0x62, 0x93, 0xfe, 0x76, 0xce, 0x57, 0x7e, 0x30, 0xe0, 0x05, 0xfd, 0xd4,
+	0x95, 0xf3, 0x29, 0x57, 0xe7, 0xcd, 0xfc, 0x00, 0x5a, 0x59, 0xe2, 0xe3, 0xe9, 0x6b, 0x27, 0x2b,
+	0x3b, 0x7d, 0x3c, 0x53, 0xbf, 0xe2, 0x62, 0x3b, 0x6d, 0x25, 0x7e, 0x7b, 0xab, 0xf0, 0x8a, 0x98,
+	0xaf, 0xef, 0xa9, 0x14, 0x95, 0x02, 0x2b, 0x94, 0xa1, 0x31, 0x48, 0x81, 0x90, 0xf9, 0x3e, 0x5c,
+	0x2a, 0x9c, 0x88, 0x58, 0x91, 0xd7, 0xa1, 0xc6, 0x86, 0xa1, 0xbd, 0xc9, 0x16, 0x20, 0x70, 0x44,
+	0x34, 0x01, 0xff, 0xa5, 0x84, 0xab, 0x1c, 0x77, 0x3d, 0x65, 0x60, 0x70, 0x18, 0xd2, 0xa0, 0xad,
+	0xd9, 0xec, 0x53, 0x06, 0x06, 0x0b, 0x9a, 0x3d, 0xdb, 0xd5, 0xfa, 0x4f, 0x6e, 0xc9, 0x7f, 0x16,
+	0xd2, 0x20, 0x97, 0xf5, 0xbf, 0x03, 0xe5, 0x69, 0xa2, 0x26, 0x88, 0x14, 0x73, 0xc8, 0xcc, 0xd3,
+	0x71, 0xc8, 0xec, 0x54, 0x1c, 0x72, 0x13, 0x70, 0x32, 0xed, 0x28, 0x38, 0x29, 0x3a, 0x10, 0x0b,
+	0x7d, 0x6e, 0xb1, 0xc4, 0x93, 0x9d, 0xd3, 0x27, 0xfb, 0x11, 0xac, 0xe5, 0xcf, 0x55, 0xf0, 0xc5,
+	0x4d, 0x2e, 0x58, 0xc7, 0x9d, 0x96, 0x85, 0x88, 0xb7, 0x34, 0xbf, 0x80, 0x1a, 0xcb, 0xd1, 0x08,
+	0xee, 0x1f, 0xee, 0x7b, 0xdf, 0x2c, 0xca, 0x94, 0x76, 0x25, 0xce, 0xa4, 0x5d, 0x89, 0xe6, 0x6f,
+	0xf2, 0x7c, 0x53, 0x74, 0x63, 0xa4, 0xe4, 0xe3, 0x58, 0x8f, 0xf5, 0x75, 0x68, 0x72, 0xdf, 0x3f,
+	0x9b, 0x51, 0x5b, 0xf7, 0xb7, 0x2f, 0x22, 0x9c, 0x4d, 0xe4, 0x13, 0x06, 0x65, 0x98, 0xb1, 0x4b,
+	0xa4, 0xad, 0x8b, 0xa3, 0x45, 0xe5, 0x02, 0x41, 0x4c, 0xf3, 0x2b, 0x2e, 0x27, 0x93, 0xb4, 0xa4,
+	0x3c, 0x49, 0x9a, 0xb4, 0x1d, 0xe3, 0x49, 0xc2, 0x8b, 0xf1, 0x16, 0x40, 0x4c, 0xa2, 0xb0, 0x9b,
+	0x95, 0xfe, 0xa6, 0x2d, 0xb0, 0x55, 0x55, 0x14, 0xa7, 0xfc, 0x37, 0xb3, 0xd3, 0xf9, 0x6f, 0xfe,
+	0x8c, 0xaf, 0xe1, 0xb6, 0x48, 0xbb, 0xc5, 0xcd, 0x8c, 0x43, 0x17, 0x4f, 0x13, 0x77, 0xbb, 0x02,
+	0x0d, 0x99, 0xbc, 0xcb, 0xf7, 0x9a, 0x6f, 0x58, 0x5d, 0x02, 0x71, 0x4f, 0x99, 0xf1, 0x4a, 0xa3,
+	0xee, 0xf1, 0x66, 0xc7, 0x51, 0xf6, 0xb5, 0x28, 0x33, 0x8f, 0x28, 0xfe, 0xde, 0xf6, 0x7a, 0xd2,
+	0x0f, 0x11, 0x03, 0xcc, 0xff, 0xc7, 0x17, 0x38, 0x49, 0xa8, 0x58, 0xe0, 0x15, 0x98, 0xa3, 0x27,
+	0xd2, 0x99, 0x52, 0xb1, 0x78, 0x81, 0xd9, 0x65, 0x76, 0xc7, 0x11, 0x64, 0xb0, 0x9f, 0x4c, 0x27,
+	0xea, 0xb2, 0xce, 0xd9, 0xc8, 0x75, 0x0b, 0x7f, 0xcb, 0x80, 0x5f, 0x39, 0x0e, 0xf8, 0x19, 0x50,
+	0xe1, 0xbe, 0x91, 0x0e, 0x15, 0x09, 0xc5, 0xaa, 0x6c, 0xde, 0x13, 0x1e, 0xa8, 0xd4, 0x45, 0xbd,
+	0x35, 0x3a, 0x38, 0xf9, 0x3a, 0x97, 0xf3, 0xbb, 0x60, 0x8e, 0xeb, 0x2e, 0xd7, 0x62, 0xaf, 0x48,
+	0x8b, 0xdd, 0xfc, 0xc7, 0x1c, 0x9b, 0x62, 0x3b, 0xf8, 0x16, 0x2f, 0xa7, 0x67, 0x6b, 0x67, 0xfc,
+	0x55, 0x09, 0xaa, 0xa8, 0xd7, 0x32, 0x95, 0x91, 0xed, 0x95, 0xb2, 0x0e, 0xca, 0xc2, 0x04, 0x40,
+	0x98, 0x2f, 0x45, 0x01, 0xfe, 0x66, 0xfb, 0xd7, 0xb3, 0x87, 0xe2, 0x54, 0xb2, 0x9f, 0x08, 0x71,
+	0x87, 0xa2, 0x6f, 0xf6, 0x93, 0xb5, 0x8b, 0x82, 0x93, 0x50, 0x48, 0x3d, 0xfc, 0x4d, 0x0c, 0x98,
+	0xb7, 0x07, 0x2a, 0x3d, 0x5c, 0x08, 0x08, 0x0e, 0x61, 0xc7, 0x3e, 0x62, 0xba, 0x75, 0x1b, 0xef,
+	0x1a, 0x3d, 0x69, 0x72, 0x11, 0xe1, 0x4c, 0x54, 0xf2, 0xe8, 0xdf, 0xfb, 0x68, 0x91, 0x2a, 0xaa,
+	0xa5, 0xd3, 0x85, 0x53, 0x3a, 0x92, 0xaa, 0x33, 0xfe, 0xce, 0xa3, 0xde, 0x7c, 0x1f, 0xce, 0xa4,
+	0xda, 0x8b, 0x3d, 0xbf, 0x96, 0xd0, 0xa6, 0x97, 0x13, 0x7a, 0x3f, 0xc3, 0x14, 0xca, 0xf4, 0xef,
+	0xce, 0x40, 0x5d, 0x3f, 0x12, 0x4f, 0x7b, 0x68, 0xe5, 0x3d, 0x36, 0x33, 0xcd, 0x3d, 0xf6, 0x0e,
+	0x88, 0x50, 0x39, 0x57, 0x93, 0x27, 0x5e, 0x4f, 0xd0, 0x55, 0x3a, 0x06, 0x0b, 0xfb, 0x74, 0xb8,
+	0xb9, 0xab, 0x2e, 0xa9, 0x94, 0xe5, 0x2a, 0xeb, 0x99, 0xdc, 0xb7, 0x7d, 0xf4, 0x6c, 0xe3, 0xc2,
+	0xf3, 0x4d, 0x03, 0x04, 0x71, 0xef, 0xc5, 0x3a, 0xd4, 0x86, 0xfe, 0x51, 0x60, 0xf7, 0xa8, 0x1d,
+	0x6b, 0xcf, 0x3a, 0xc8, 0xfc, 0x27, 0x9e, 0xaf, 0x24, 0x57, 0xe6, 0xdb, 0x56, 0x5d, 0xdf, 0x86,
+	0x06, 0x9e, 0x0b, 0xf5, 0xb8, 0x21, 0xa5, 0x8a, 0x24, 0x84, 0x18, 0x1e, 0xa1, 0x6d, 0xed, 0xd9,
+	0x43, 0xce, 0xd1, 0x38, 0x80, 0xb3, 0xa9, 0xe9, 0x28, 0x46, 0x79, 0x5b, 0x13, 0xbb, 0x79, 0x6a,
+	0x5b, 0x72, 0xac, 0xae, 0xd6, 0x85, 0xf9, 0xaf, 0x25, 0x78, 0x2e, 0x2f, 0xae, 0xbc, 0x35, 0x52,
+	0x69, 0x96, 0x53, 0xf8, 0x26, 0x30, 0x44, 0x36, 0xc9, 0x37, 0x81, 0x48, 0xb8, 0x36, 0xf7, 0xe0,
+	0x34, 0xae, 0x4d, 0xca, 0xff, 0x34, 0x3b, 0x4d, 0x56, 0xf3, 0x32, 0x6b, 0x99, 0xa8, 0x2a, 0x58,
+	0xaf, 0xbf, 0x48, 0xab, 0xa4, 0x2c, 0x33, 0xe3, 0xd9, 0xcf, 0xe5, 0x99, 0xa9, 0x9b, 0x7b, 0x18,
+	0x2d, 0x48, 0x93, 0xfb, 0xf5, 0x03, 0x51, 0x9f, 0xc2, 0xe2, 0x4e, 0x88, 0x5e, 0xd9, 0x67, 0xe5,
+	0x3c, 0x37, 0x3f, 0x83, 0x25, 0xd5, 0xe5, 0xb3, 0xf3, 0x7b, 0x9b, 0x7f, 0x58, 0x82, 0x2a, 0x26,
+	0x67, 0x17, 0x65, 0x64, 0x15, 0x48, 0x24, 0x89, 0xc7, 0x82, 0xc4, 0x3c, 0x7b, 0x56, 0x48, 0xf3,
+	0x82, 0xfd, 0xaa, 0x21, 0xd2, 0xe6, 0x40, 0xe6, 0x76, 0xf0, 0x36, 0x71, 0xf4, 0x64, 0x96, 0xe7,
+	0x76, 0x20, 0x58, 0x7a, 0x9f, 0xcd, 0x3f, 0xe6, 0x62, 0xe4, 0xde, 0x08, 0x29, 0xcc, 0x49, 0x7b,
+	0x7c, 0x3d, 0xc9, 0x4a, 0x97, 0xf4, 0xf5, 0x14, 0xc9, 0xe8, 0x5d, 0x2f, 0xe8, 0xb5, 0xc5, 0x13,
+	0x23, 0xc9, 0x5a, 0xaf, 0xe8, 0xac, 0x35, 0xb1, 0xd1, 0x18, 0x97, 0xf2, 0xf7, 0xe1, 0x42, 0x01,
+	0x7d, 0x62, 0x8b, 0xde, 0x84, 0xc5, 0xc1, 0x88, 0x27, 0xc6, 0x27, 0x92, 0x42, 0x96, 0x35, 0xf7,
+	0x0c, 0x5f, 0x7b, 0xab, 0x3e, 0xd0, 0xba, 0x31, 0x7f, 0xce, 0x0d, 0x20, 0xd1, 0x75, 0x76, 0xe6,
+	0x63, 0xf2, 0x5a, 0xf4, 0x49, 0xc8, 0x19, 0xdf, 0xd0, 0x67, 0x5c, 0x88, 0x3c, 0x66, 0xa6, 0x9f,
+	0xc1, 0x5a, 0x3e, 0x39, 0xca, 0x76, 0x6d, 0xc8, 0x89, 0x4e, 0x98, 0x67, 0x6d, 0x10, 0x77, 0x62,
+	0x7e, 0xc5, 0xa7, 0x99, 0x50, 0xa1, 0xfe, 0x67, 0x64, 0xc5, 0xb3, 0xd5, 0x95, 0xde, 0x8f, 0xb3,
+	0x8e, 0xb6, 0xee, 0x1c, 0x70, 0x8b, 0x43, 0x8b, 0xb8, 0x5e, 0x4a, 0x47, 0x5c, 0x45, 0x7a, 0x88,
+	0x8c, 0xba, 0x9a, 0xb7, 0xa1, 0xb6, 0x75, 0xe7, 0x80, 0x89, 0x79, 0x3c, 0x77, 0xec, 0xfd, 0xd5,
+	0xb0, 0xa3, 0xbd, 0x4e, 0x9b, 0xf7, 0xf1, 0x01, 0x1b, 0xd3, 0xc9, 0x99, 0x60, 0xc0, 0xc8, 0x1c,
+	0x4e, 0xaf, 0x6e, 0xc5, 0x00, 0xf3, 0x17, 0x25, 0x58, 0xcb, 0x27, 0x43, 0x59, 0x8e, 0xcb, 0x5d,
+	0x6f, 0x30, 0x70, 0x22, 0x66, 0xca, 0x24, 0x47, 0x58, 0x52, 0x15, 0xfb, 0x53, 0x0c, 0xc5, 0x92,
+	0x9a, 0x99, 0xa5, 0x23, 0xcc, 0x1a, 0xb5, 0x6c, 0xda, 0x24, 0x2c, 0x44, 0x30, 0x3f, 0xe6, 0x4f,
+	0xd4, 0x7c, 0x1f, 0xdf, 0xf2, 0x59, 0x9c, 0xb1, 0xe2, 0x77, 0x78, 0xfc, 0x25, 0x9f, 0xb2, 0x45,
+	0xab, 0x56, 0x15, 0x21, 0x68, 0x9c, 0x34, 0x61, 0x96, 0xd1, 0x27, 0x0c, 0x86, 0x47, 0x74, 0x64,
+	0x7e, 0x01, 0xe7, 0x72, 0x3a, 0x13, 0x93, 0x6b, 0xc1, 0x42, 0x38, 0xec, 0x76, 0x69, 0x18, 0x0a,
+	0xbd, 0x5c, 0x16, 0x59, 0xec, 0x85, 0x06, 0x01, 0x7b, 0x37, 0x12, 0x1e, 0xc9, 0x9c, 0x28, 0x04,
+	0xdc, 0x0b, 0x8f, 0xb8, 0x36, 0xcf, 0x3a, 0x12, 0x01, 0x34, 0x51, 0x32, 0xff, 0xb2, 0x14, 0x87,
+	0xd2, 0xe5, 0xf5, 0xf6, 0x50, 0x1a, 0x77, 0x72, 0x06, 0xd9, 0xc0, 0x4d, 0xe9, 0xa9, 0x02, 0x37,
+	0xb9, 0xf9, 0x65, 0xe4, 0x0d, 0x91, 0x61, 0x8b, 0xb6, 0xe4, 0xa4, 0x1c, 0x2d, 0xbc, 0xf2, 0x90,
+	0x30, 0xf3, 0x67, 0x60, 0x64, 0xc9, 0x7d, 0x26, 0xcf, 0xc5, 0xe4, 0xa3, 0xac, 0x99, 0x31, 0x8f,
+	0xb2, 0xcc, 0x1f, 0xc5, 0xe9, 0x00, 0x39, 0x0b, 0x26, 0xa8, 0x78, 0x0b, 0xe6, 0xf8, 0xb4, 0xb8,
+	0x40, 0x30, 0x73, 0x35, 0x8c, 0x04, 0xe1, 0x16, 0x6f, 0x60, 0xfe, 0x7a, 0x06, 0x4e, 0x3f, 0xe4,
+	0x6f, 0x10, 0xe8, 0x11, 0x46, 0xd4, 0x31, 0x79, 0x57, 0x0b, 0xc8, 0x97, 0x31, 0x20, 0xff, 0x0e,
+	0xd4, 0xf1, 0xd1, 0x86, 0x3c, 0xd9, 0x13, 0x74, 0xe9, 0x1a, 0x43, 0x96, 0xa7, 0xfb, 0x0d, 0x80,
+	0xc8, 0x4b, 0xc9, 0x84, 0xe2, 0x95, 0x8f, 0x3c, 0xd9, 0xee, 0x79, 0x65, 0xbd, 0x94, 0x0b, 0x9e,
+	0xd3, 0xf1, 0x6a, 0xb4, 0xca, 0x45, 0x82, 0x08, 0x0f, 0x57, 0xcd, 0x21, 0xdd, 0x32, 0x45, 0x16,
+	0x57, 0x81, 0x65, 0x1b, 0x0d, 0xd8, 0x31, 0x73, 0xa2, 0x91, 0xc0, 0xe2, 0xe9, 0xa6, 0x0d, 0x09,
+	0x55, 0x68, 0x22, 0x74, 0x2e, 0xd1, 0x78, 0xaa, 0x69, 0x43, 0x42, 0x39, 0xda, 0x45, 0x00, 0xed,
+	0x75, 0x47, 0x05, 0x8f, 0x86, 0x06, 0x31, 0x7f, 0x59, 0xc2, 0x7d, 0xcb, 0x59, 0x5a, 0x9d, 0xd3,
+	0xbf, 0x86, 0x2a, 0x70, 0x16, 0x16, 0x9c, 0x10, 0x1f, 0xd0, 0x88, 0xd0, 0xfe, 0xbc, 0x13, 0xb2,
+	0x57, 0x32, 0x05, 0xae, 0x64, 0x53, 0x68, 0xe9, 0xe8, 0x3f, 0x64, 0xd6, 0x7a, 0x99, 0xc7, 0x14,
+	0x54, 0xda, 0xf8, 0x6e, 0xcf, 0xfc, 0x01, 0x98, 0xe3, 0x48, 0x55, 0x99, 0x33, 0xf3, 0xd8, 0x89,
+	0x8c, 0x3f, 0x9e, 0x57, 0x9e, 0x9a, 0x6c, 0x43, 0x4b, 0xa0, 0x9a, 0x07, 0x7a, 0x58, 0x6d, 0x3f,
+	0xf0, 0xbc, 0xc3, 0x67, 0x91, 0x9a, 0x7d, 0x0b, 0x23, 0xd7, 0x4c, 0x2d, 0x4c, 0x74, 0x39, 0xce,
+	0x1f, 0x66, 0xfe, 0x57, 0x89, 0x47, 0x8e, 0x28, 0x36, 0x99, 0x78, 0x79, 0x30, 0x31, 0x8a, 0x49,
+	0x2e, 0xed, 0xc0, 0xf3, 0x22, 0x25, 0xaa, 0x19, 0xc4, 0xf2, 0xbc, 0x48, 0x8a, 0x51, 0xee, 0x64,
+	0x61, 0x3f, 0xd9, 0xfa, 0x3f, 0xb6, 0xfb, 0x43, 0x6e, 0xfa, 0xd5, 0x2d, 0x5e, 0x88, 0xbd, 0x36,
+	0x73, 0xba, 0xd7, 0xc6, 0x80, 0x0a, 0xf3, 0x81, 0x38, 0xee, 0x51, 0xd8, 0x9a, 0x5f, 0x9f, 0xbd,
+	0x5e, 0xb7, 0x54, 0x19, 0x77, 0x8c, 0xda, 0x87, 0x98, 0x3a, 0x7d, 0x6c, 0x87, 0xc7, 0xc8, 0x7f,
+	0x75, 0xab, 0xc6, 0x80, 0x1f, 0xd3, 0xd1, 0x87, 0x76, 0x78, 0xcc, 0x74, 0x3b, 0xc4, 0xc1, 0x31,
+	0x38, 0x56, 0x05, 0xb1, 0xb0, 0xe9, 0x43, 0x06, 0x65, 0x78, 0xe6, 0xfb, 0x40, 0xe2, 0x39, 0xeb,
+	0x09, 0x3e, 0x3e, 0x03, 0xa4, 0x13, 0x7c, 0x34, 0x54, 0x8e, 0x60, 0x1e, 0xe3, 0x0d, 0x8c, 0xfe,
+	0xcf, 0x6e, 0x7f, 0x18, 0x3a, 0x9e, 0x9b, 0x58, 0xf0, 0xe9, 0x23, 0x5b, 0x89, 0xe5, 0x9e, 0xc9,
+	0xb9, 0xab, 0x7f, 0x0a, 0xcb, 0x99, 0x61, 0x26, 0x6f, 0xd2, 0x0a, 0xcc, 0x39, 0x6e, 0x8f, 0x9e,
+	0x48, 0x49, 0x8f, 0x85, 0x38, 0x02, 0x38, 0xab, 0xbf, 0x89, 0xd7, 0xd7, 0xbc, 0x9c, 0x5c, 0x73,
+	0xf3, 0x3e, 0x5e, 0xf1, 0x39, 0xf3, 0x14, 0x2b, 0xf6, 0x52, 0x72, 0xc5, 0xce, 0x25, 0x3c, 0xc3,
+	0x89, 0x16, 0x62, 0xe1, 0xb6, 0x30, 0x10, 0xb1, 0xc5, 0x03, 0x77, 0x76, 0x8f, 0x06, 0xec, 0xe9,
+	0xdf, 0x36, 0x2a, 0x03, 0x53, 0xab, 0x2f, 0x11, 0xac, 0x17, 0xf7, 0xa1, 0x04, 0xff, 0xc2, 0x31,
+	0xb5, 0xb5, 0x53, 0x79, 0xb1, 0x20, 0x44, 0xdf, 0xe6, 0x68, 0x96, 0x44, 0x67, 0x37, 0x34, 0x57,
+	0x4e, 0x04, 0x6f, 0x8b, 0x12, 0x0b, 0xa5, 0x3d, 0x18, 0x76, 0xb8, 0x27, 0x10, 0xc7, 0x56, 0xee,
+	0x9e, 0x2b, 0xf8, 0xa4, 0x34, 0x10, 0x56, 0xad, 0x46, 0x32, 0x20, 0x18, 0x91, 0xcd, 0x43, 0x00,
+	0xfc, 0xb1, 0xf3, 0x98, 0xba, 0xd1, 0x53, 0xe6, 0x0f, 0x90, 0x1b, 0xb0, 0x88, 0xf6, 0x40, 0x2c,
+	0xc8, 0x63, 0x56, 0x69, 0xc8, 0x1a, 0x3e, 0xce, 0x0e, 0x9c, 0x53, 0x64, 0xf2, 0x50, 0xf2, 0xb0,
+	0x1f, 0x3d, 0xbd, 0x6f, 0xf2, 0xab, 0x12, 0x2c, 0xaa, 0xe6, 0x9c, 0xe6, 0x89, 0x4c, 0xb7, 0x05,
+	0x0b, 0xc2, 0xd6, 0x6b, 0xcd, 0x3c, 0xad, 0x45, 0x28, 0x40, 0x8c, 0x19, 0xe5, 0x7c, 0xa4, 0x8b,
+	0x58, 0x96, 0x89, 0x09, 0xf5, 0x44, 0xe0, 0x94, 0x7b, 0x89, 0x13, 0x30, 0xf3, 0x4f, 0x4a, 0xd0,
+	0xe0, 0x29, 0x22, 0xde, 0xd1, 0x94, 0x64, 0xab, 0x04, 0xc5, 0xbe, 0xa7, 0xf4, 0xb5, 0x8e, 0xe8,
+	0x82, 0xdd, 0x7a, 0x39, 0xbe, 0x8a, 0x6a, 0x5a, 0xb3, 0xca, 0x6e, 0x50, 0xb9, 0x68, 0x83, 0x7e,
+	0x31, 0x83, 0x3a, 0xaa, 0xf4, 0xcc, 0x20, 0x91, 0xe1, 0xd7, 0xf4, 0xba, 0x4f, 0xf8, 0x84, 0x06,
+	0x43, 0x0d, 0x51, 0x69, 0xae, 0x5a, 0xbc, 0x90, 0xe6, 0xd5, 0x72, 0x1e, 0xaf, 0xb2, 0x25, 0xa3,
+	0x6e, 0x42, 0x65, 0xe0, 0x4b, 0x46, 0x5d, 0xa1, 0x32, 0xbc, 0x25, 0x54, 0x46, 0xca, 0x88, 0x6f,
+	0xcd, 0x27, 0xcf, 0x7e, 0x62, 0x66, 0xa8, 0x9b, 0xa3, 0xd2, 0xc8, 0x77, 0x43, 0x5d, 0xc6, 0x0b,
+	0xba, 0x41, 0xf3, 0x3b, 0x25, 0x58, 0xce, 0x34, 0x9b, 0xbc, 0x73, 0x31, 0x3b, 0xcf, 0x4c, 0x10,
+	0xb3, 0x2f, 0xc1, 0x1c, 0xa7, 0x35, 0xeb, 0xb6, 0x54, 0xfe, 0x33, 0x44, 0xb0, 0x38, 0x9e, 0xf0,
+	0xc9, 0xa4, 0x37, 0x49, 0xf9, 0x64, 0xe6, 0x11, 0x2b, 0x4c, 0x3f, 0xcb, 0xca, 0x4e, 0x5d, 0x20,
+	0x9a, 0xaf, 0x70, 0x1f, 0x0f, 0x75, 0x7b, 0x8e, 0x7b, 0x24, 0x52, 0x28, 0x42, 0x2d, 0xd7, 0x82,
+	0x2f, 0x4a, 0x49, 0x5f, 0x94, 0x10, 0x88, 0xc0, 0xc7, 0x0c, 0xa4, 0x00, 0x9b, 0xb0, 0x67, 0x11,
+	0x28, 0x21, 0x26, 0xb2, 0x88, 0x40, 0xcb, 0x4f, 0xfb, 0x20, 0xab, 0x50, 0xc6, 0x2c, 0xab, 0x59,
+	0xb5, 0xac, 0x58, 0x36, 0x7f, 0x7f, 0x16, 0x8c, 0x3c, 0x42, 0xe3, 0xaf, 0xa1, 0x3c, 0xb1, 0x9d,
+	0x28, 0xfe, 0x04, 0x00, 0xa7, 0xb8, 0x2e, 0x80, 0xf2, 0x83, 0x02, 0xb2, 0xcc, 0x33, 0xb9, 0xb4,
+	0x1c, 0x35, 0x01, 0x67, 0xf9, 0x5c, 0xac, 0x2f, 0x0c, 0xf0, 0xd0, 0x5e, 0x22, 0x4f, 0xad, 0x2e,
+	0x80, 0xbc, 0xaf, 0x9b, 0xb0, 0x3c, 0xb0, 0x4f, 0xda, 0xc9, 0x41, 0xb9, 0x31, 0xbc, 0x34, 0xb0,
+	0x4f, 0x3e, 0xd7, 0xc7, 0x7d, 0x01, 0x9a, 0x3a, 0x2e, 0x8e, 0x1d, 0x73, 0xef, 0x62, 0x8c, 0x8e,
+	0xc3, 0xbf, 0x0a, 0xab, 0x3a, 0xb6, 0x4f, 0x83, 0xb6, 0x58, 0x58, 0xfe, 0xc5, 0x98, 0xd3, 0x31,
+	0xfe, 0x3e, 0x0d, 0xf8, 0x16, 0x90, 0x35, 0x58, 0xa0, 0x8f, 0x9d, 0xf8, 0xa1, 0x15, 0xf6, 0x2c,
+	0x41, 0xe4, 0x22, 0x13, 0x5e, 0x5f, 0xf0, 0x77, 0x58, 0x95, 0x98, 0x5f, 0x25, 0x8c, 0x3d, 0x0e,
+	0x8b, 0x3c, 0x5f, 0x0c, 0x13, 0xb6, 0xaa, 0xc8, 0x3c, 0x86, 0xf2, 0xe6, 0x65, 0x36, 0xdb, 0x82,
+	0xc8, 0xf3, 0x79, 0x39, 0x34, 0xff, 0xad, 0x0c, 0xf3, 0x3b, 0x61, 0x37, 0xf0, 0x9e, 0xfc, 0xaf,
+	0xb0, 0x41, 0x5e, 0x84, 0x39, 0xfb, 0x88, 0x2a, 0x13, 0xa4, 0x58, 0x52, 0x21, 0x96, 0x66, 0xb2,
+	0xcc, 0xe5, 0x07, 0x00, 0x44, 0x35, 0x4b, 0xf1, 0xc0, 0x16, 0xf8, 0x46, 0x6d, 0x3e, 0x1f, 0xb7,
+	0x82, 0x18, 0xec, 0x6d, 0x1a, 0x81, 0x32, 0xbe, 0x8f, 0xe0, 0x4f, 0x7e, 0xf0, 0x77, 0xe6, 0x09,
+	0x60, 0xe5, 0xa9, 0x9e, 0x00, 0xee, 0xc1, 0x99, 0xc0, 0x8e, 0x9c, 0x43, 0xa7, 0xcb, 0x53, 0xac,
+	0x7b, 0xd4, 0xee, 0xf5, 0x1d, 0x97, 0xb6, 0xaa, 0x93, 0xba, 0x59, 0xd1, 0xdb, 0xdd, 0x16, 0xcd,
+	0xc8, 0x1d, 0x58, 0xa6, 0xb8, 0x6b, 0xec, 0x89, 0x9e, 0x13, 0x60, 0x65, 0x0b, 0x26, 0xf5, 0xd5,
+	0xe4, 0x6d, 0x76, 0x54, 0x13, 0x16, 0x17, 0x61, 0xfb, 0xe4, 0xfb, 0x81, 0xf7, 0x58, 0xbc, 0x5b,
+	0xaa, 0x30, 0xfe, 0xd8, 0x14, 0x10, 0x76, 0x53, 0xf1, 0x85, 0x53, 0x38, 0x75, 0xc4, 0x69, 0x20,
+	0x54, 0xa1, 0xb1, 0xf8, 0xa6, 0x13, 0xfa, 0x43, 0xc6, 0xa3, 0x0d, 0x7e, 0xc1, 0xca, 0x32, 0x73,
+	0x42, 0xb0, 0x80, 0x16, 0xe7, 0xb2, 0x6f, 0x68, 0x8e, 0xdd, 0x80, 0x39, 0xdf, 0x0e, 0x22, 0xee,
+	0x50, 0x59, 0x8c, 0x7d, 0x36, 0xbc, 0xf3, 0x7d, 0x56, 0x65, 0x71, 0x8c, 0x02, 0x03, 0xed, 0x2a,
+	0x2c, 0xf2, 0x3b, 0x86, 0xaf, 0x9e, 0xb2, 0xd0, 0xd0, 0x91, 0xc6, 0x7b, 0xd9, 0xed, 0x89, 0x1c,
+	0x29, 0x9d, 0x62, 0xa5, 0xcb, 0x2f, 0xf0, 0x96, 0x52, 0x48, 0x2f, 0x26, 0x29, 0xb0, 0x64, 0xb5,
+	0x19, 0xc1, 0xe2, 0x3e, 0xa5, 0x81, 0x15, 0x7f, 0x3d, 0x82, 0x9d, 0x2f, 0x5f, 0x25, 0xdd, 0xfb,
+	0x8c, 0xc0, 0xb0, 0xeb, 0x09, 0xc7, 0x54, 0xc9, 0xe2, 0x05, 0xc6, 0x67, 0x1d, 0xdb, 0x65, 0xba,
+	0xda, 0xd0, 0x8d, 0x9c, 0xfe, 0xe4, 0x88, 0x58, 0x8d, 0xa3, 0x7f, 0xc6, 0xb0, 0xcd, 0x8f, 0x84,
+	0x9c, 0xd5, 0x07, 0x8e, 0xe5, 0xec, 0x0b, 0x30, 0xe7, 0xd3, 0x58, 0x79, 0x5d, 0x8d, 0x65, 0x84,
+	0x8e, 0x6f, 0x71, 0x24, 0xf3, 0x5d, 0x58, 0xdc, 0xb2, 0x5d, 0x5e, 0xc7, 0x37, 0x2c, 0x3d, 0x03,
+	0xb6, 0xeb, 0x43, 0xc1, 0x7c, 0xfc, 0x1e, 0x50, 0x65, 0x73, 0x19, 0x96, 0x54, 0x6b, 0x3e, 0xbc,
+	0x69, 0x42, 0xf3, 0x33, 0xb7, 0x33, 0xb6, 0x4b, 0xf3, 0x34, 0x2c, 0x6b, 0x38, 0xa2, 0xe1, 0xaf,
+	0x67, 0xa1, 0xb2, 0x8f, 0xaf, 0x54, 0xed, 0x7e, 0x46, 0x4c, 0xbd, 0x0a, 0x15, 0xf1, 0x82, 0x35,
+	0x98, 0x24, 0xa2, 0x14, 0x62, 0xfc, 0xaa, 0x6f, 0x56, 0x7f, 0xd5, 0x97, 0x7a, 0xd4, 0x50, 0xce,
+	0x3e, 0x6a, 0x78, 0x05, 0x2d, 0x13, 0x3f, 0x14, 0xf2, 0xe6, 0xbc, 0x2e, 0x43, 0x58, 0x8e, 0xba,
+	0x9e, 0xf6, 0xcd, 0x31, 0x99, 0x4b, 0x4f, 0x3c, 0x43, 0x40, 0xc1, 0x53, 0xb5, 0x64, 0x91, 0xbc,
+	0x56, 0xf0, 0xea, 0x22, 0xbe, 0x03, 0xf2, 0x5e, 0x5e, 0x6c, 0xa8, 0xac, 0xfe, 0x0a, 0x9e, 0x81,
+	0x78, 0x17, 0xc5, 0x0a, 0x3d, 0xc0, 0x5a, 0x95, 0xed, 0xbf, 0x06, 0x55, 0x7e, 0x76, 0xed, 0x3e,
+	0xbf, 0x1c, 0xaa, 0x56, 0x0c, 0xc8, 0x88, 0x35, 0x78, 0x2a, 0xb1, 0xf6, 0x1e, 0x34, 0xf8, 0x3b,
+	0xb7, 0xb6, 0xef, 0xf5, 0x9d, 0xee, 0x48, 0x3c, 0x7c, 0x6c, 0x69, 0xcd, 0x13, 0xf5, 0x56, 0x9d,
+	0x17, 0xf7, 0xb1, 0x64, 0xfe, 0x86, 0x48, 0xb6, 0x11, 0x84, 0xeb, 0xb2, 0x21, 0x9e, 0x65, 0x69,
+	0xaa, 0x59, 0xe6, 0x3b, 0x21, 0xaf, 0x43, 0x93, 0x07, 0xd3, 0x44, 0x23, 0x76, 0xde, 0x79, 0x54,
+	0x06, 0xa5, 0x80, 0xec, 0x6b, 0xb7, 0x67, 0xee, 0xf2, 0x54, 0x9b, 0x04, 0x25, 0xe2, 0xd4, 0x6c,
+	0x40, 0x55, 0xb6, 0x97, 0x27, 0xa7, 0x99, 0xa6, 0xc6, 0x8a, 0x51, 0xcc, 0xd7, 0x81, 0x68, 0x5d,
+	0xc5, 0xf6, 0x67, 0x4d, 0xa7, 0x82, 0xf3, 0x2f, 0xf8, 0x31, 0x05, 0xdb, 0xdc, 0xcb, 0xa2, 0x9a,
+	0xa9, 0x33, 0x5b, 0x91, 0x48, 0x42, 0x4a, 0x66, 0x07, 0x57, 0x18, 0xe6, 0xff, 0x2f, 0xc9, 0x67,
+	0xb6, 0x8f, 0x1d, 0x7c, 0x2a, 0xa3, 0xbd, 0xce, 0x2b, 0x25, 0x5f, 0xe7, 0xa9, 0x23, 0x30, 0x93,
+	0xf7, 0xb0, 0x75, 0x36, 0xff, 0x61, 0x6b, 0x79, 0xda, 0x87, 0xad, 0xe6, 0x4f, 0x54, 0xee, 0x92,
+	0xa4, 0x25, 0x9c, 0x2a, 0x91, 0xea, 0x0a, 0x34, 0xb8, 0x29, 0x21, 0xc9, 0xe6, 0x1b, 0x5a, 0x47,
+	0xe0, 0xc3, 0x98, 0xf6, 0x9c, 0x78, 0xce, 0x2f, 0xe2, 0x08, 0xad, 0x36, 0xa6, 0x58, 0xc7, 0x5b,
+	0x50, 0x0d, 0x24, 0x30, 0x3f, 0xe2, 0xc9, 0x2b, 0xad, 0x18, 0x8d, 0x7d, 0xef, 0xa7, 0x3b, 0x0c,
+	0xf0, 0x49, 0x6c, 0x92, 0x9a, 0x45, 0x01, 0x96, 0xf4, 0x68, 0xaf, 0x15, 0x67, 0x13, 0xaf, 0x15,
+	0x6f, 0x7e, 0x00, 0x35, 0xed, 0x6e, 0x22, 0x4b, 0x50, 0xdb, 0x79, 0xb0, 0x6d, 0xdd, 0xff, 0xbc,
+	0x7d, 0xc7, 0xba, 0x7f, 0xaf, 0x79, 0x8a, 0x34, 0xa0, 0x2a, 0x00, 0x07, 0xf7, 0x9b, 0x25, 0xd2,
+	0x84, 0xba, 0x28, 0x6e, 0xde, 0xdd, 0xd9, 0x3b, 0x68, 0xce, 0xdc, 0xec, 0xc3, 0x62, 0x92, 0xe5,
+	0xc9, 0x0a, 0x34, 0xf7, 0xad, 0xfb, 0xfb, 0xf7, 0x1f, 0x6c, 0x7e, 0xd2, 0xde, 0xdf, 0xd9, 0xbb,
+	0xbd, 0xbb, 0x77, 0xb7, 0x79, 0x8a, 0x9c, 0x81, 0x65, 0x05, 0xdd, 0xdc, 0xdf, 0xb7, 0xee, 0x3f,
+	0xdc, 0xb9, 0xdd, 0x2c, 0x91, 0x55, 0x20, 0x31, 0x78, 0xfb, 0x60, 0xf7, 0xe1, 0xe6, 0xc1, 0xce,
+	0xed, 0xe6, 0x4c, 0x02, 0xdd, 0xda, 0xf9, 0x68, 0x67, 0x9b, 0x81, 0x67, 0x6f, 0xfd, 0xf3, 0x0d,
+	0x80, 0x4d, 0xdf, 0x79, 0x40, 0x83, 0xc7, 0xec, 0x05, 0xd1, 0x03, 0x58, 0xfe, 0x74, 0x48, 0x83,
+	0x91, 0xfe, 0x0d, 0x43, 0xa2, 0xbe, 0x2a, 0x97, 0xff, 0x5d, 0x46, 0x63, 0x4d, 0xb9, 0x6b, 0x72,
+	0xbe, 0x7c, 0x68, 0x9e, 0x22, 0x7b, 0xd0, 0x4c, 0x7f, 0x9a, 0x90, 0x5c, 0xd2, 0xfa, 0xcc, 0xfb,
+	0x68, 0xa1, 0x51, 0xf4, 0x10, 0xc0, 0x3c, 0x45, 0x0e, 0xe1, 0x8c, 0xfa, 0x72, 0x84, 0x1e, 0xb0,
+	0x24, 0x57, 0xb5, 0x4e, 0x0b, 0x3f, 0x33, 0x63, 0x5c, 0x9b, 0x80, 0xa5, 0xc6, 0x71, 0x60, 0x55,
+	0xa1, 0x24, 0x3e, 0xb0, 0x41, 0xb2, 0x5d, 0xe4, 0x7d, 0xfa, 0xc4, 0x78, 0x6e, 0x12, 0x9a, 0x1a,
+	0xaa, 0x0b, 0x2b, 0x0a, 0x47, 0xfb, 0x18, 0x06, 0xb9, 0x92, 0xe9, 0x21, 0xfb, 0x15, 0x0e, 0xe3,
+	0xea, 0x78, 0xa4, 0xd4, 0x20, 0x99, 0x4c, 0x8d, 0xc4, 0x20, 0x45, 0xdf, 0x20, 0x30, 0xae, 0x8e,
+	0x47, 0x4a, 0x0d, 0x92, 0x79, 0x11, 0x9b, 0x18, 0xa4, 0xe8, 0x29, 0xaf, 0x71, 0x75, 0x3c, 0x52,
+	0x6a, 0x90, 0xcc, 0x2b, 0xd4, 0xc4, 0x20, 0x45, 0x0f, 0x68, 0x8d, 0xab, 0xe3, 0x91, 0xd4, 0x20,
+	0x01, 0x9c, 0x93, 0x73, 0xcd, 0x3c, 0x14, 0x25, 0xd7, 0xd3, 0xcb, 0x51, 0xf4, 0x70, 0xd5, 0xb8,
+	0x31, 0x05, 0xa6, 0x1a, 0xf3, 0x23, 0x68, 0x24, 0x1e, 0x8d, 0x12, 0xf5, 0x1d, 0x9e, 0xf4, 0x93,
+	0x3c, 0xe3, 0x82, 0xd6, 0x6f, 0xf6, 0x95, 0xa9, 0x79, 0x8a, 0x7c, 0x0c, 0x75, 0xfd, 0xa5, 0x21,
+	0x51, 0x01, 0x85, 0x9c, 0x77, 0x91, 0xc6, 0x5a, 0x7e, 0xa5, 0xde, 0x99, 0xfe, 0x6a, 0x2a, 0xee,
+	0x2c, 0xe7, 0x89, 0x9b, 0xb1, 0x96, 0x5f, 0xa9, 0x3a, 0xfb, 0x14, 0x16, 0x93, 0x0f, 0xa2, 0x88,
+	0x3e, 0x99, 0xec, 0xbb, 0x2a, 0xe3, 0x62, 0x51, 0xb5, 0xc6, 0x11, 0x67, 0x0b, 0x5e, 0x46, 0x91,
+	0xe7, 0xb2, 0xa2, 0x26, 0xef, 0xe9, 0x94, 0x71, 0x31, 0x1f, 0x4f, 0x1b, 0xe4, 0x87, 0x71, 0xa2,
+	0x9e, 0x7c, 0x36, 0xc3, 0x3d, 0x4c, 0x5a, 0xcb, 0xbc, 0x47, 0x44, 0xc6, 0x7a, 0x31, 0x42, 0x6a,
+	0x51, 0xb4, 0x37, 0x16, 0x89, 0x45, 0xc9, 0x3e, 0xdf, 0x30, 0x2e, 0x16, 0x55, 0xab, 0x2e, 0x3f,
+	0x47, 0xc1, 0x9b, 0xc8, 0xc7, 0x4e, 0xd0, 0x9a, 0x97, 0x95, 0x6e, 0xac, 0x17, 0x23, 0xa8, 0x8e,
+	0xfb, 0xea, 0xae, 0x4f, 0xbf, 0x03, 0x48, 0xac, 0xf6, 0x98, 0x17, 0x0f, 0xc6, 0xf3, 0x13, 0xf1,
+	0xd4, 0x68, 0x3f, 0x86, 0xe5, 0xcc, 0xd3, 0x00, 0xb2, 0x9e, 0xdb, 0x7e, 0xcf, 0xfe, 0x5a, 0x23,
+	0xec, 0x41, 0x23, 0xf1, 0x1e, 0x89, 0xac, 0x69, 0x11, 0x88, 0xcc, 0xa3, 0x26, 0xe3, 0x42, 0x41,
+	0x6d, 0x4a, 0x3e, 0x65, 0x92, 0xe1, 0x13, 0xf2, 0xa9, 0xe8, 0x59, 0x80, 0x71, 0x75, 0x3c, 0x92,
+	0x1a, 0xe4, 0x00, 0x96, 0x52, 0xc9, 0xe2, 0x89, 0x9b, 0x3a, 0x27, 0xa3, 0xdd, 0xb8, 0x54, 0x58,
+	0x9f, 0xea, 0x35, 0x91, 0x0e, 0xaa, 0xf7, 0x9a, 0x93, 0xe3, 0x6d, 0x5c, 0x2a, 0xac, 0x57, 0xbd,
+	0x0e, 0xc1, 0x28, 0xce, 0x51, 0x26, 0x49, 0x11, 0x39, 0x2e, 0x2d, 0xda, 0xb8, 0x39, 0x0d, 0xea,
+	0x38, 0xa9, 0xb0, 0x1d, 0x64, 0xf9, 0x74, 0x4c, 0xf2, 0xf3, 0x14, 0x52, 0x61, 0x0f, 0x1a, 0xf2,
+	0x58, 0x73, 0xdf, 0xea, 0x5a, 0xfa, 0xb4, 0xeb, 0x5e, 0x5a, 0xe3, 0x42, 0x41, 0xad, 0xc6, 0xee,
+	0x67, 0x72, 0xd3, 0x4e, 0x13, 0xea, 0x4d, 0x61, 0x56, 0xaa, 0x71, 0xa9, 0x00, 0x4b, 0x1b, 0x61,
+	0x04, 0x97, 0xf2, 0x6e, 0x71, 0x2d, 0x65, 0x93, 0x6c, 0x8c, 0xbb, 0xee, 0xb3, 0xb9, 0x9d, 0x53,
+	0xab, 0x07, 0xff, 0x27, 0x75, 0x96, 0xf1, 0x6b, 0x57, 0xf9, 0x67, 0x59, 0x4b, 0xa1, 0x32, 0x2e,
+	0x8f, 0xc1, 0x50, 0x7d, 0xdf, 0x66, 0xd9, 0x85, 0xfc, 0x1d, 0xb9, 0xf8, 0xb4, 0x15, 0x59, 0x8d,
+	0xbd, 0x35, 0x7a, 0x26, 0xa3, 0x71, 0x36, 0x03, 0x57, 0xbd, 0x58, 0xb0, 0x2c, 0x3e, 0x57, 0x10,
+	0x7f, 0x74, 0x61, 0xcc, 0x35, 0xac, 0x53, 0x96, 0xff, 0xa5, 0x06, 0xf3, 0x14, 0xf9, 0x11, 0xd4,
+	0xe3, 0xc4, 0xb3, 0x20, 0x4c, 0xec, 0x64, 0x61, 0x62, 0xa0, 0x71, 0x6d, 0x02, 0x96, 0xb6, 0xa8,
+	0xb5, 0x18, 0x25, 0x4c, 0x48, 0x99, 0xa2, 0xdc, 0x3b, 0xe3, 0xea, 0x78, 0x24, 0xad, 0xef, 0x33,
+	0xb9, 0x5f, 0x6f, 0x18, 0xb3, 0x24, 0xd7, 0x52, 0x4b, 0x92, 0xff, 0xd9, 0x07, 0x5c, 0x96, 0x95,
+	0xbc, 0xbc, 0xb9, 0xc4, 0x04, 0x8a, 0xb2, 0xea, 0xa6, 0x38, 0x98, 0x3f, 0x86, 0xd5, 0x34, 0x37,
+	0xe6, 0xe8, 0xef, 0xc5, 0xdf, 0x36, 0x37, 0xc6, 0x67, 0x12, 0x23, 0xaf, 0x90, 0xec, 0x07, 0xdb,
+	0xc9, 0xe5, 0x3c, 0xdb, 0x26, 0xf1, 0x31, 0xf7, 0x71, 0xd6, 0x8d, 0xa6, 0xa5, 0xeb, 0xe9, 0x70,
+	0x59, 0x2d, 0x3d, 0x27, 0x67, 0xcf, 0xb8, 0x3a, 0x1e, 0x29, 0x75, 0x0c, 0x93, 0x39, 0x69, 0x89,
+	0x63, 0x98, 0x9b, 0xfb, 0x66, 0x5c, 0x1e, 0x83, 0x91, 0xa7, 0x37, 0x67, 0x32, 0xaa, 0xb2, 0x7a,
+	0x73, 0x51, 0x96, 0x9a, 0x71, 0x63, 0x0a, 0xcc, 0xd4, 0xfd, 0x52, 0x90, 0x62, 0x93, 0xb8, 0x5f,
+	0xc6, 0x67, 0x0c, 0x19, 0x37, 0xa7, 0x41, 0x55, 0xc3, 0xde, 0xc7, 0xcb, 0x52, 0x4f, 0xbf, 0x21,
+	0x39, 0x6c, 0xa9, 0xe7, 0x74, 0x18, 0x46, 0x4e, 0x36, 0x48, 0xdc, 0xe1, 0x2e, 0xd4, 0x85, 0x84,
+	0xe3, 0xbd, 0x9d, 0x4f, 0xc9, 0xbd, 0xa7, 0xe8, 0x8a, 0xf3, 0x51, 0x36, 0xe9, 0xe3, 0x4a, 0x5a,
+	0x6d, 0xcc, 0xc9, 0x3c, 0x31, 0xae, 0x8e, 0x47, 0x52, 0x83, 0xf0, 0x6f, 0xf9, 0xe7, 0xe6, 0x50,
+	0x90, 0xe7, 0xd3, 0x1b, 0x58, 0x90, 0xa9, 0x61, 0x5c, 0x9f, 0x8c, 0xa8, 0x2d, 0xd0, 0x52, 0x2a,
+	0x7d, 0x22, 0x5e, 0xf1, 0xfc, 0xbc, 0x0a, 0x83, 0x24, 0x4e, 0x31, 0xc6, 0x54, 0xcd, 0x53, 0x2f,
+	0x97, 0xc8, 0x67, 0x70, 0x5e, 0xb5, 0xc8, 0x7c, 0xf4, 0xe3, 0xeb, 0x77, 0xfb, 0x00, 0x48, 0x36,
+	0x73, 0x22, 0x96, 0x09, 0x85, 0x59, 0x15, 0xc6, 0xaa, 0xa6, 0x55, 0x6a, 0x09, 0x13, 0xd8, 0xe9,
+	0x1e, 0x2c, 0x27, 0xc9, 0x60, 0x49, 0x05, 0x93, 0x28, 0x3c, 0x93, 0xa0, 0x50, 0x66, 0x32, 0x60,
+	0x7f, 0xfc, 0xfc, 0x27, 0xe3, 0xd2, 0x89, 0xf3, 0x9f, 0x9b, 0x57, 0x60, 0x5c, 0x1e, 0x83, 0xa1,
+	0x49, 0x75, 0x92, 0x0d, 0xfd, 0x26, 0x84, 0x62, 0x7e, 0xfc, 0xda, 0x30, 0xc7, 0xa1, 0xa4, 0xd4,
+	0xad, 0x38, 0x54, 0x93, 0x50, 0xb7, 0x32, 0x31, 0x27, 0xe3, 0x42, 0x41, 0x6d, 0x5a, 0x8d, 0xd6,
+	0x1c, 0xc1, 0x49, 0x35, 0x3a, 0xeb, 0xab, 0x36, 0x2e, 0x15, 0xd6, 0xab, 0x5e, 0x3f, 0x84, 0x9a,
+	0x56, 0x49, 0x8c, 0x9c, 0x16, 0xb2, 0xb7, 0xf3, 0xb9, 0x75, 0x29, 0x23, 0x2e, 0xe1, 0xe3, 0x24,
+	0x69, 0x3d, 0x3e, 0xed, 0x71, 0x35, 0xd6, 0x8b, 0x11, 0xb4, 0x89, 0x93, 0x6c, 0xe8, 0x68, 0xcc,
+	0xb5, 0x9e, 0xdc, 0x9e, 0xdc, 0x80, 0x93, 0x79, 0x8a, 0xbc, 0x0b, 0x0b, 0x22, 0x0c, 0x14, 0x2b,
+	0x5f, 0xc9, 0xa8, 0x92, 0x71, 0x36, 0x03, 0x57, 0xad, 0xb7, 0xa0, 0xaa, 0xa2, 0x41, 0x31, 0x29,
+	0xe9, 0x20, 0x92, 0x71, 0x2e, 0xa7, 0x46, 0xf6, 0xb1, 0x75, 0x11, 0xd6, 0x1c, 0x6f, 0x43, 0xfc,
+	0x9f, 0x15, 0x2f, 0xdc, 0xb0, 0xdd, 0x5e, 0xe0, 0x39, 0xbd, 0x8d, 0xb0, 0xf7, 0x68, 0x23, 0xf0,
+	0xbb, 0x9d, 0x79, 0xf4, 0x65, 0xbf, 0xfa, 0xdf, 0x03, 0x00, 0x93, 0x10, 0x13, 0x36, 0xcb, 0x66,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5957,6 +8334,23 @@ type ApiServiceClient interface {
 	GetAppTableRecord(ctx context.Context, in *GetAppTableRecordRequest, opts ...grpc.CallOption) (*GetAppTableRecordResponse, error)
 	GetBlockProducerVoterList(ctx context.Context, in *GetBlockProducerVoterListRequest, opts ...grpc.CallOption) (*GetBlockProducerVoterListResponse, error)
 	GetVestDelegationOrderList(ctx context.Context, in *GetVestDelegationOrderListRequest, opts ...grpc.CallOption) (*GetVestDelegationOrderListResponse, error)
+	GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error)
+	GetPostProof(ctx context.Context, in *GetPostProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error)
+	GetTrxInclusionProof(ctx context.Context, in *GetTrxInclusionProofRequest, opts ...grpc.CallOption) (*GetTrxInclusionProofResponse, error)
+	GetBlockHeaderWithCommit(ctx context.Context, in *GetBlockHeaderWithCommitRequest, opts ...grpc.CallOption) (*GetBlockHeaderWithCommitResponse, error)
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error)
+	SubscribeIrreversibleBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeIrreversibleBlocksClient, error)
+	SubscribeTrxResult(ctx context.Context, in *SubscribeTrxResultRequest, opts ...grpc.CallOption) (ApiService_SubscribeTrxResultClient, error)
+	SubscribeBlockLog(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlockLogClient, error)
+	GetContractEvents(ctx context.Context, in *GetContractEventsRequest, opts ...grpc.CallOption) (*GetContractEventsResponse, error)
+	GetPendingTrxStats(ctx context.Context, in *GetPendingTrxStatsRequest, opts ...grpc.CallOption) (*GetPendingTrxStatsResponse, error)
+	GetEscrowList(ctx context.Context, in *GetEscrowListRequest, opts ...grpc.CallOption) (*GetEscrowListResponse, error)
+	GetProposalList(ctx context.Context, in *GetProposalListRequest, opts ...grpc.CallOption) (*GetProposalListResponse, error)
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error)
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error)
+	GetPeerReputations(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetPeerReputationsResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error)
 }
 
 type apiServiceClient struct {
@@ -6336,6 +8730,251 @@ func (c *apiServiceClient) GetVestDelegationOrderList(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error) {
+	out := new(StateProofResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPostProof(ctx context.Context, in *GetPostProofRequest, opts ...grpc.CallOption) (*StateProofResponse, error) {
+	out := new(StateProofResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetPostProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTrxInclusionProof(ctx context.Context, in *GetTrxInclusionProofRequest, opts ...grpc.CallOption) (*GetTrxInclusionProofResponse, error) {
+	out := new(GetTrxInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetTrxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockHeaderWithCommit(ctx context.Context, in *GetBlockHeaderWithCommitRequest, opts ...grpc.CallOption) (*GetBlockHeaderWithCommitResponse, error) {
+	out := new(GetBlockHeaderWithCommitResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetBlockHeaderWithCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/grpcpb.ApiService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeBlocksClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeBlocksClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeIrreversibleBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeIrreversibleBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/grpcpb.ApiService/SubscribeIrreversibleBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeIrreversibleBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeIrreversibleBlocksClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeIrreversibleBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeIrreversibleBlocksClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeTrxResult(ctx context.Context, in *SubscribeTrxResultRequest, opts ...grpc.CallOption) (ApiService_SubscribeTrxResultClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[2], "/grpcpb.ApiService/SubscribeTrxResult", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeTrxResultClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeTrxResultClient interface {
+	Recv() (*TrxResultEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeTrxResultClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeTrxResultClient) Recv() (*TrxResultEvent, error) {
+	m := new(TrxResultEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) SubscribeBlockLog(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (ApiService_SubscribeBlockLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[3], "/grpcpb.ApiService/SubscribeBlockLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeBlockLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeBlockLogClient interface {
+	Recv() (*BlockLogEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeBlockLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeBlockLogClient) Recv() (*BlockLogEvent, error) {
+	m := new(BlockLogEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetContractEvents(ctx context.Context, in *GetContractEventsRequest, opts ...grpc.CallOption) (*GetContractEventsResponse, error) {
+	out := new(GetContractEventsResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetContractEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPendingTrxStats(ctx context.Context, in *GetPendingTrxStatsRequest, opts ...grpc.CallOption) (*GetPendingTrxStatsResponse, error) {
+	out := new(GetPendingTrxStatsResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetPendingTrxStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEscrowList(ctx context.Context, in *GetEscrowListRequest, opts ...grpc.CallOption) (*GetEscrowListResponse, error) {
+	out := new(GetEscrowListResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetEscrowList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProposalList(ctx context.Context, in *GetProposalListRequest, opts ...grpc.CallOption) (*GetProposalListResponse, error) {
+	out := new(GetProposalListResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetProposalList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*GetProposalResponse, error) {
+	out := new(GetProposalResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error) {
+	out := new(GetPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetPostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPeerReputations(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetPeerReputationsResponse, error) {
+	out := new(GetPeerReputationsResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/GetPeerReputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error) {
+	out := new(BanPeerResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error) {
+	out := new(UnbanPeerResponse)
+	err := c.cc.Invoke(ctx, "/grpcpb.ApiService/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServiceServer is the server API for ApiService service.
 type ApiServiceServer interface {
 	QueryTableContent(context.Context, *GetTableContentRequest) (*TableContentResponse, error)
@@ -6379,6 +9018,23 @@ type ApiServiceServer interface {
 	GetAppTableRecord(context.Context, *GetAppTableRecordRequest) (*GetAppTableRecordResponse, error)
 	GetBlockProducerVoterList(context.Context, *GetBlockProducerVoterListRequest) (*GetBlockProducerVoterListResponse, error)
 	GetVestDelegationOrderList(context.Context, *GetVestDelegationOrderListRequest) (*GetVestDelegationOrderListResponse, error)
+	GetAccountProof(context.Context, *GetAccountProofRequest) (*StateProofResponse, error)
+	GetPostProof(context.Context, *GetPostProofRequest) (*StateProofResponse, error)
+	GetTrxInclusionProof(context.Context, *GetTrxInclusionProofRequest) (*GetTrxInclusionProofResponse, error)
+	GetBlockHeaderWithCommit(context.Context, *GetBlockHeaderWithCommitRequest) (*GetBlockHeaderWithCommitResponse, error)
+	SubscribeBlocks(*SubscribeBlocksRequest, ApiService_SubscribeBlocksServer) error
+	SubscribeIrreversibleBlocks(*SubscribeBlocksRequest, ApiService_SubscribeIrreversibleBlocksServer) error
+	SubscribeTrxResult(*SubscribeTrxResultRequest, ApiService_SubscribeTrxResultServer) error
+	SubscribeBlockLog(*SubscribeBlocksRequest, ApiService_SubscribeBlockLogServer) error
+	GetContractEvents(context.Context, *GetContractEventsRequest) (*GetContractEventsResponse, error)
+	GetPendingTrxStats(context.Context, *GetPendingTrxStatsRequest) (*GetPendingTrxStatsResponse, error)
+	GetEscrowList(context.Context, *GetEscrowListRequest) (*GetEscrowListResponse, error)
+	GetProposalList(context.Context, *GetProposalListRequest) (*GetProposalListResponse, error)
+	GetProposal(context.Context, *GetProposalRequest) (*GetProposalResponse, error)
+	GetPostRevisions(context.Context, *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error)
+	GetPeerReputations(context.Context, *NonParamsRequest) (*GetPeerReputationsResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*BanPeerResponse, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error)
 }
 
 // UnimplementedApiServiceServer can be embedded to have forward compatible implementations.
@@ -6487,26 +9143,77 @@ func (*UnimplementedApiServiceServer) GetMyStakes(ctx context.Context, req *GetM
 func (*UnimplementedApiServiceServer) GetNodeRunningVersion(ctx context.Context, req *NonParamsRequest) (*GetNodeRunningVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeRunningVersion not implemented")
 }
-func (*UnimplementedApiServiceServer) GetAccountListByVest(ctx context.Context, req *GetAccountListByVestRequest) (*GetAccountListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountListByVest not implemented")
+func (*UnimplementedApiServiceServer) GetAccountListByVest(ctx context.Context, req *GetAccountListByVestRequest) (*GetAccountListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountListByVest not implemented")
+}
+func (*UnimplementedApiServiceServer) GetBlockProducerByName(ctx context.Context, req *GetBlockProducerByNameRequest) (*BlockProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProducerByName not implemented")
+}
+func (*UnimplementedApiServiceServer) GetAccountByPubKey(ctx context.Context, req *GetAccountByPubKeyRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByPubKey not implemented")
+}
+func (*UnimplementedApiServiceServer) GetBlockBFTInfoByNum(ctx context.Context, req *GetBlockBFTInfoByNumRequest) (*GetBlockBFTInfoByNumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockBFTInfoByNum not implemented")
+}
+func (*UnimplementedApiServiceServer) GetAppTableRecord(ctx context.Context, req *GetAppTableRecordRequest) (*GetAppTableRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppTableRecord not implemented")
+}
+func (*UnimplementedApiServiceServer) GetBlockProducerVoterList(ctx context.Context, req *GetBlockProducerVoterListRequest) (*GetBlockProducerVoterListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProducerVoterList not implemented")
+}
+func (*UnimplementedApiServiceServer) GetVestDelegationOrderList(ctx context.Context, req *GetVestDelegationOrderListRequest) (*GetVestDelegationOrderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVestDelegationOrderList not implemented")
+}
+func (*UnimplementedApiServiceServer) GetAccountProof(ctx context.Context, req *GetAccountProofRequest) (*StateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProof not implemented")
+}
+func (*UnimplementedApiServiceServer) GetPostProof(ctx context.Context, req *GetPostProofRequest) (*StateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostProof not implemented")
+}
+func (*UnimplementedApiServiceServer) GetTrxInclusionProof(ctx context.Context, req *GetTrxInclusionProofRequest) (*GetTrxInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrxInclusionProof not implemented")
+}
+func (*UnimplementedApiServiceServer) GetBlockHeaderWithCommit(ctx context.Context, req *GetBlockHeaderWithCommitRequest) (*GetBlockHeaderWithCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderWithCommit not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeBlocks(req *SubscribeBlocksRequest, srv ApiService_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeIrreversibleBlocks(req *SubscribeBlocksRequest, srv ApiService_SubscribeIrreversibleBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeIrreversibleBlocks not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeTrxResult(req *SubscribeTrxResultRequest, srv ApiService_SubscribeTrxResultServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTrxResult not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeBlockLog(req *SubscribeBlocksRequest, srv ApiService_SubscribeBlockLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlockLog not implemented")
+}
+func (*UnimplementedApiServiceServer) GetContractEvents(ctx context.Context, req *GetContractEventsRequest) (*GetContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractEvents not implemented")
+}
+func (*UnimplementedApiServiceServer) GetPendingTrxStats(ctx context.Context, req *GetPendingTrxStatsRequest) (*GetPendingTrxStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTrxStats not implemented")
 }
-func (*UnimplementedApiServiceServer) GetBlockProducerByName(ctx context.Context, req *GetBlockProducerByNameRequest) (*BlockProducerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProducerByName not implemented")
+func (*UnimplementedApiServiceServer) GetEscrowList(ctx context.Context, req *GetEscrowListRequest) (*GetEscrowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscrowList not implemented")
 }
-func (*UnimplementedApiServiceServer) GetAccountByPubKey(ctx context.Context, req *GetAccountByPubKeyRequest) (*AccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByPubKey not implemented")
+func (*UnimplementedApiServiceServer) GetProposalList(ctx context.Context, req *GetProposalListRequest) (*GetProposalListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposalList not implemented")
 }
-func (*UnimplementedApiServiceServer) GetBlockBFTInfoByNum(ctx context.Context, req *GetBlockBFTInfoByNumRequest) (*GetBlockBFTInfoByNumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockBFTInfoByNum not implemented")
+func (*UnimplementedApiServiceServer) GetProposal(ctx context.Context, req *GetProposalRequest) (*GetProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
 }
-func (*UnimplementedApiServiceServer) GetAppTableRecord(ctx context.Context, req *GetAppTableRecordRequest) (*GetAppTableRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppTableRecord not implemented")
+func (*UnimplementedApiServiceServer) GetPostRevisions(ctx context.Context, req *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
 }
-func (*UnimplementedApiServiceServer) GetBlockProducerVoterList(ctx context.Context, req *GetBlockProducerVoterListRequest) (*GetBlockProducerVoterListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockProducerVoterList not implemented")
+func (*UnimplementedApiServiceServer) GetPeerReputations(ctx context.Context, req *NonParamsRequest) (*GetPeerReputationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerReputations not implemented")
 }
-func (*UnimplementedApiServiceServer) GetVestDelegationOrderList(ctx context.Context, req *GetVestDelegationOrderListRequest) (*GetVestDelegationOrderListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVestDelegationOrderList not implemented")
+func (*UnimplementedApiServiceServer) BanPeer(ctx context.Context, req *BanPeerRequest) (*BanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (*UnimplementedApiServiceServer) UnbanPeer(ctx context.Context, req *UnbanPeerRequest) (*UnbanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -7251,6 +9958,324 @@ func _ApiService_GetVestDelegationOrderList_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountProof(ctx, req.(*GetAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPostProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPostProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetPostProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPostProof(ctx, req.(*GetPostProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTrxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrxInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTrxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetTrxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTrxInclusionProof(ctx, req.(*GetTrxInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeaderWithCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeaderWithCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeaderWithCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetBlockHeaderWithCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeaderWithCommit(ctx, req.(*GetBlockHeaderWithCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeBlocks(m, &apiServiceSubscribeBlocksServer{stream})
+}
+
+type ApiService_SubscribeBlocksServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeBlocksServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeIrreversibleBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeIrreversibleBlocks(m, &apiServiceSubscribeIrreversibleBlocksServer{stream})
+}
+
+type ApiService_SubscribeIrreversibleBlocksServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeIrreversibleBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeIrreversibleBlocksServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeTrxResult_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTrxResultRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeTrxResult(m, &apiServiceSubscribeTrxResultServer{stream})
+}
+
+type ApiService_SubscribeTrxResultServer interface {
+	Send(*TrxResultEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeTrxResultServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeTrxResultServer) Send(m *TrxResultEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeBlockLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeBlockLog(m, &apiServiceSubscribeBlockLogServer{stream})
+}
+
+type ApiService_SubscribeBlockLogServer interface {
+	Send(*BlockLogEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeBlockLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeBlockLogServer) Send(m *BlockLogEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetContractEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractEvents(ctx, req.(*GetContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPendingTrxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTrxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPendingTrxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetPendingTrxStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPendingTrxStats(ctx, req.(*GetPendingTrxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEscrowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscrowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEscrowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetEscrowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEscrowList(ctx, req.(*GetEscrowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProposalList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposalList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetProposalList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposalList(ctx, req.(*GetProposalListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetPostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPostRevisions(ctx, req.(*GetPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetPeerReputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetPeerReputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/GetPeerReputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetPeerReputations(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcpb.ApiService/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "grpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -7419,7 +10444,80 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVestDelegationOrderList",
 			Handler:    _ApiService_GetVestDelegationOrderList_Handler,
 		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _ApiService_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetPostProof",
+			Handler:    _ApiService_GetPostProof_Handler,
+		},
+		{
+			MethodName: "GetTrxInclusionProof",
+			Handler:    _ApiService_GetTrxInclusionProof_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderWithCommit",
+			Handler:    _ApiService_GetBlockHeaderWithCommit_Handler,
+		},
+		{
+			MethodName: "GetContractEvents",
+			Handler:    _ApiService_GetContractEvents_Handler,
+		},
+		{
+			MethodName: "GetPendingTrxStats",
+			Handler:    _ApiService_GetPendingTrxStats_Handler,
+		},
+		{
+			MethodName: "GetEscrowList",
+			Handler:    _ApiService_GetEscrowList_Handler,
+		},
+		{
+			MethodName: "GetProposalList",
+			Handler:    _ApiService_GetProposalList_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _ApiService_GetProposal_Handler,
+		},
+		{
+			MethodName: "GetPostRevisions",
+			Handler:    _ApiService_GetPostRevisions_Handler,
+		},
+		{
+			MethodName: "GetPeerReputations",
+			Handler:    _ApiService_GetPeerReputations_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _ApiService_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _ApiService_UnbanPeer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _ApiService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeIrreversibleBlocks",
+			Handler:       _ApiService_SubscribeIrreversibleBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTrxResult",
+			Handler:       _ApiService_SubscribeTrxResult_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBlockLog",
+			Handler:       _ApiService_SubscribeBlockLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc.proto",
 }
@@ -0,0 +1,8 @@
+package grpcpb
+
+import "google.golang.org/grpc"
+
+// ApiServiceDesc returns the service descriptor of ApiService, for serving it over transports other than grpc.
+func ApiServiceDesc() *grpc.ServiceDesc {
+	return &_ApiService_serviceDesc
+}
@@ -22,6 +22,7 @@ type GRPCServer struct {
 	rpcServer *grpc.Server
 	ctx       *node.ServiceContext
 	api       *APIService
+	ws        *WebSocketServer
 	config    *service_configs.GRPCConfig
 	log       *logrus.Logger
 }
@@ -40,7 +41,12 @@ func NewGRPCServer(ctx *node.ServiceContext, config service_configs.GRPCConfig,
 		grpc.MaxRecvMsgSize(GRPCMaxRecvMsgSize))
 	api := &APIService{ctx: ctx}
 	grpcpb.RegisterApiServiceServer(rpc, api)
-	srv := &GRPCServer{rpcServer: rpc, ctx: ctx, api: api, config: &config}
+	wsLimit := DefaultProxyHTTPLimit
+	if config.HTTPLimit != 0 {
+		wsLimit = config.HTTPLimit
+	}
+	ws := NewWebSocketServer(api, gi, wsLimit, wsLimit, lg)
+	srv := &GRPCServer{rpcServer: rpc, ctx: ctx, api: api, ws: ws, config: &config}
 
 	srv.log = lg
 	srv.api.log = srv.log
@@ -83,6 +89,12 @@ func (gs *GRPCServer) Start(node *node.Node) error {
 
 	gs.api.mainLoop = node.MainLoop
 	gs.api.eBus = node.EvBus
+	if gs.api.eBus != nil {
+		gs.api.blockLogs = newBlockLogHistory(blockLogHistorySize)
+		gs.api.blockLogs.start(gs.api.eBus)
+		gs.api.trxResults = newTrxResultHub()
+		gs.api.trxResults.start(gs.api.eBus)
+	}
 
 	err = gs.startGRPC()
 	if err != nil {
@@ -123,12 +135,18 @@ func (gs *GRPCServer) startGRPC() error {
 
 func (gs *GRPCServer) Stop() error {
 	gs.rpcServer.Stop()
+	if gs.api.blockLogs != nil {
+		gs.api.blockLogs.stop(gs.api.eBus)
+	}
+	if gs.api.trxResults != nil {
+		gs.api.trxResults.stop(gs.api.eBus)
+	}
 	return nil
 }
 
 func (gs *GRPCServer) startWebProxy() error {
 	go func() {
-		if err := RunWebProxy(gs.rpcServer, gs.config); err != nil {
+		if err := RunWebProxy(gs.rpcServer, gs.ws, gs.config); err != nil {
 			gs.log.Error("rpc WebProxy start failure")
 		} else {
 			gs.log.Info("rpc WebProxy start success")
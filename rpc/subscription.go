@@ -0,0 +1,556 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/asaskevich/EventBus"
+	"github.com/coschain/contentos-go/app/blocklog"
+	"github.com/coschain/contentos-go/app/table"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subscriberBufferSize      = 256
+	replayBatchSize           = 100
+	blockLogHistorySize       = 256
+	maxSubscriptions          = 4096
+	maxSubscriptionsPerClient = 64
+)
+
+var (
+	ErrSubscriberTooSlow    = status.Error(codes.ResourceExhausted, "subscriber is too slow, please resume from the last received block")
+	ErrBlockLogExpired      = status.Error(codes.OutOfRange, "block logs of the start block are no longer available")
+	ErrTooManySubscriptions = status.Error(codes.ResourceExhausted, "too many subscriptions")
+	ErrEventBusUnavailable  = status.Error(codes.Unavailable, "event bus is not available")
+)
+
+// blockReverted is the event of reverting blocks since a block number.
+type blockReverted uint64
+
+// blockCommitted is the event of committing blocks up to a block number.
+type blockCommitted uint64
+
+// subscriber relays events from the event bus to a stream.
+// Event handlers run in goroutines of publishers, e.g. the one applying blocks, so they must never block. Instead
+// of blocking, a subscriber whose buffer is full is failed, and its client is supposed to resume from the last
+// block it received.
+type subscriber struct {
+	bus      EventBus.Bus
+	events   chan interface{}
+	overflow chan struct{}
+	once     sync.Once
+	topics   []string
+	handlers []interface{}
+}
+
+func newSubscriber(bus EventBus.Bus, size int) *subscriber {
+	return &subscriber{
+		bus:      bus,
+		events:   make(chan interface{}, size),
+		overflow: make(chan struct{}),
+	}
+}
+
+func (s *subscriber) subscribe(topic string, handler interface{}) error {
+	if s.bus == nil {
+		return ErrEventBusUnavailable
+	}
+	if err := s.bus.Subscribe(topic, handler); err != nil {
+		return err
+	}
+	s.topics = append(s.topics, topic)
+	s.handlers = append(s.handlers, handler)
+	return nil
+}
+
+// push queues an event, or fails the subscriber if its buffer is full.
+func (s *subscriber) push(event interface{}) {
+	select {
+	case s.events <- event:
+	default:
+		s.once.Do(func() { close(s.overflow) })
+	}
+}
+
+// notify queues an event if the buffer isn't full. It's for events that can be coalesced.
+func (s *subscriber) notify(event interface{}) {
+	select {
+	case s.events <- event:
+	default:
+	}
+}
+
+// next waits for the next event.
+func (s *subscriber) next(ctx context.Context) (interface{}, error) {
+	// once failed, queued events are dropped
+	select {
+	case <-s.overflow:
+		return nil, ErrSubscriberTooSlow
+	default:
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.overflow:
+		return nil, ErrSubscriberTooSlow
+	case e := <-s.events:
+		return e, nil
+	}
+}
+
+func (s *subscriber) close() {
+	for i := range s.topics {
+		_ = s.bus.Unsubscribe(s.topics[i], s.handlers[i])
+	}
+}
+
+// subscriptionQuota limits the number of concurrent subscriptions, in total and of each client.
+// Clients are told by their addresses without ports. The zero value is ready to use.
+type subscriptionQuota struct {
+	sync.Mutex
+	total   int
+	clients map[string]int
+}
+
+// clientOf returns the client of a stream context.
+func clientOf(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// acquire takes a subscription of the client of ctx, and returns the function releasing it.
+func (q *subscriptionQuota) acquire(ctx context.Context) (func(), error) {
+	client := clientOf(ctx)
+	q.Lock()
+	defer q.Unlock()
+	if q.total >= maxSubscriptions || q.clients[client] >= maxSubscriptionsPerClient {
+		return nil, ErrTooManySubscriptions
+	}
+	if q.clients == nil {
+		q.clients = make(map[string]int)
+	}
+	q.total++
+	q.clients[client]++
+	return func() {
+		q.Lock()
+		defer q.Unlock()
+		q.total--
+		if q.clients[client]--; q.clients[client] <= 0 {
+			delete(q.clients, client)
+		}
+	}, nil
+}
+
+// trxResultHub relays results of applied trxs to their subscribers.
+// Each trx id is computed once no matter how many subscribers there are.
+type trxResultHub struct {
+	sync.RWMutex
+	subs map[string]map[*subscriber]struct{}
+}
+
+func newTrxResultHub() *trxResultHub {
+	return &trxResultHub{subs: make(map[string]map[*subscriber]struct{})}
+}
+
+func (h *trxResultHub) onTrxApplied(trx *prototype.SignedTransaction, receipt *prototype.TransactionReceiptWithInfo, blockNum uint64) {
+	h.RLock()
+	defer h.RUnlock()
+	if len(h.subs) == 0 {
+		return
+	}
+	id, err := trx.Id()
+	if err != nil {
+		return
+	}
+	for sub := range h.subs[string(id.Hash)] {
+		sub.push(&grpcpb.TrxResultEvent{BlockNum: blockNum, Receipt: receipt})
+	}
+}
+
+func (h *trxResultHub) add(trxId []byte, sub *subscriber) {
+	h.Lock()
+	defer h.Unlock()
+	subs := h.subs[string(trxId)]
+	if subs == nil {
+		subs = make(map[*subscriber]struct{})
+		h.subs[string(trxId)] = subs
+	}
+	subs[sub] = struct{}{}
+}
+
+func (h *trxResultHub) remove(trxId []byte, sub *subscriber) {
+	h.Lock()
+	defer h.Unlock()
+	if subs := h.subs[string(trxId)]; subs != nil {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(h.subs, string(trxId))
+		}
+	}
+}
+
+func (h *trxResultHub) start(bus EventBus.Bus) {
+	_ = bus.Subscribe(constants.NoticeTrxApplied, h.onTrxApplied)
+}
+
+func (h *trxResultHub) stop(bus EventBus.Bus) {
+	_ = bus.Unsubscribe(constants.NoticeTrxApplied, h.onTrxApplied)
+}
+
+// blockCursor tracks the progress of a stream ordered by block numbers, so that replayed and live events
+// don't overlap.
+type blockCursor struct {
+	min  uint64 // blocks below min are not wanted
+	next uint64 // the next block number to send
+	sent uint64 // the last block number sent, 0 if none
+}
+
+// onBlock returns true if the block should be sent.
+func (c *blockCursor) onBlock(num uint64) bool {
+	if num < c.next || num < c.min {
+		return false
+	}
+	c.next, c.sent = num+1, num
+	return true
+}
+
+// onRevert returns true if the client should be told of the reversion.
+func (c *blockCursor) onRevert(num uint64) bool {
+	if num < c.next {
+		c.next = num
+	}
+	if num == 0 || num > c.sent {
+		return false
+	}
+	c.sent = num - 1
+	return true
+}
+
+// replayBlocks sends blocks of given range from the main branch.
+func (as *APIService) replayBlocks(from, to uint64, send func(*prototype.SignedBlock) error) error {
+	for from <= to {
+		end := from + replayBatchSize - 1
+		if end > to {
+			end = to
+		}
+		list, err := as.consensus.FetchBlocks(from, end)
+		if err != nil {
+			return err
+		}
+		for _, blk := range list {
+			b := blk.(*prototype.SignedBlock)
+			if num := b.Id().BlockNum(); num >= from && num <= end {
+				if err = send(b); err != nil {
+					return err
+				}
+			}
+		}
+		from = end + 1
+	}
+	return nil
+}
+
+func (as *APIService) SubscribeBlocks(req *grpcpb.SubscribeBlocksRequest, stream grpcpb.ApiService_SubscribeBlocksServer) error {
+	release, err := as.subscriptions.acquire(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	sub := newSubscriber(as.eBus, subscriberBufferSize)
+	defer sub.close()
+	if err := sub.subscribe(constants.NoticeBlockApplied, func(b *prototype.SignedBlock) { sub.push(b) }); err != nil {
+		return err
+	}
+	_ = sub.subscribe(constants.NoticeBlockApplyFailed, func(b *prototype.SignedBlock) { sub.push(blockReverted(b.Id().BlockNum())) })
+	_ = sub.subscribe(constants.NoticeBlockRevert, func(num uint64) { sub.push(blockReverted(num)) })
+
+	cursor := &blockCursor{min: req.StartBlock}
+	send := func(b *prototype.SignedBlock) error {
+		if !cursor.onBlock(b.Id().BlockNum()) {
+			return nil
+		}
+		return stream.Send(&grpcpb.BlockEvent{Block: b})
+	}
+
+	// blocks applied from now on are queued in the subscriber, replay the older ones.
+	head := as.consensus.GetHeadBlockId().BlockNum()
+	cursor.next = head + 1
+	if req.StartBlock > 0 && req.StartBlock <= head {
+		cursor.next = req.StartBlock
+		if err := as.replayBlocks(req.StartBlock, head, send); err != nil {
+			return err
+		}
+	}
+	for {
+		e, err := sub.next(stream.Context())
+		if err != nil {
+			return err
+		}
+		switch ev := e.(type) {
+		case *prototype.SignedBlock:
+			err = send(ev)
+		case blockReverted:
+			if cursor.onRevert(uint64(ev)) {
+				err = stream.Send(&grpcpb.BlockEvent{RevertedBlock: uint64(ev)})
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (as *APIService) SubscribeIrreversibleBlocks(req *grpcpb.SubscribeBlocksRequest, stream grpcpb.ApiService_SubscribeIrreversibleBlocksServer) error {
+	release, err := as.subscriptions.acquire(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	// irreversible blocks never change, so commit events are coalesced and blocks are always fetched from
+	// consensus, at the pace of the client.
+	var committed uint64
+	sub := newSubscriber(as.eBus, 1)
+	defer sub.close()
+	if err := sub.subscribe(constants.NoticeBlockCommit, func(num uint64) {
+		for {
+			old := atomic.LoadUint64(&committed)
+			if num <= old || atomic.CompareAndSwapUint64(&committed, old, num) {
+				break
+			}
+		}
+		sub.notify(blockCommitted(num))
+	}); err != nil {
+		return err
+	}
+
+	next := req.StartBlock
+	if next == 0 {
+		next = as.consensus.GetLIB().BlockNum() + 1
+	}
+	for {
+		lib := as.consensus.GetLIB().BlockNum()
+		if c := atomic.LoadUint64(&committed); c > lib {
+			lib = c
+		}
+		if next <= lib {
+			err := as.replayBlocks(next, lib, func(b *prototype.SignedBlock) error {
+				return stream.Send(&grpcpb.BlockEvent{Block: b})
+			})
+			if err != nil {
+				return err
+			}
+			next = lib + 1
+		}
+		if _, err := sub.next(stream.Context()); err != nil {
+			return err
+		}
+	}
+}
+
+func (as *APIService) SubscribeTrxResult(req *grpcpb.SubscribeTrxResultRequest, stream grpcpb.ApiService_SubscribeTrxResultServer) error {
+	if req.TrxId == nil {
+		return status.Error(codes.InvalidArgument, "trx id is empty")
+	}
+	if as.trxResults == nil {
+		return ErrEventBusUnavailable
+	}
+	release, err := as.subscriptions.acquire(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	trxId := req.TrxId.Hash
+	sub := newSubscriber(as.eBus, subscriberBufferSize)
+	defer sub.close()
+	as.trxResults.add(trxId, sub)
+	defer as.trxResults.remove(trxId, sub)
+	if err = sub.subscribe(constants.NoticeBlockApplyFailed, func(b *prototype.SignedBlock) { sub.push(blockReverted(b.Id().BlockNum())) }); err != nil {
+		return err
+	}
+	_ = sub.subscribe(constants.NoticeBlockRevert, func(num uint64) { sub.push(blockReverted(num)) })
+	_ = sub.subscribe(constants.NoticeBlockCommit, func(num uint64) { sub.push(blockCommitted(num)) })
+
+	// the trx may have been applied already
+	var last *grpcpb.TrxResultEvent
+	if as.db != nil {
+		as.db.RLock()
+		if wrap := table.NewSoExtTrxWrap(as.db, req.TrxId); wrap.CheckExist() {
+			last = &grpcpb.TrxResultEvent{BlockNum: wrap.GetBlockHeight()}
+			if r := wrap.GetTrxWrap().GetReceipt(); r != nil {
+				last.Receipt = &prototype.TransactionReceiptWithInfo{Status: r.Status, NetUsage: r.NetUsage, CpuUsage: r.CpuUsage}
+			}
+		}
+		as.db.RUnlock()
+	}
+	if last != nil {
+		if err := stream.Send(last); err != nil {
+			return err
+		}
+		if as.consensus != nil && as.consensus.GetLIB().BlockNum() >= last.BlockNum {
+			return stream.Send(&grpcpb.TrxResultEvent{BlockNum: last.BlockNum, Receipt: last.Receipt, Irreversible: true})
+		}
+	}
+
+	for {
+		e, err := sub.next(stream.Context())
+		if err != nil {
+			return err
+		}
+		switch ev := e.(type) {
+		case *grpcpb.TrxResultEvent:
+			last = ev
+			if err = stream.Send(ev); err != nil {
+				return err
+			}
+			if ev.Receipt == nil || ev.Receipt.Status == prototype.StatusError {
+				// the trx failed, and will never be included in any block
+				return nil
+			}
+		case blockReverted:
+			if last != nil && !last.Reverted && uint64(ev) <= last.BlockNum {
+				last = &grpcpb.TrxResultEvent{BlockNum: last.BlockNum, Reverted: true}
+				if err = stream.Send(last); err != nil {
+					return err
+				}
+			}
+		case blockCommitted:
+			if last != nil && !last.Reverted && uint64(ev) >= last.BlockNum {
+				return stream.Send(&grpcpb.TrxResultEvent{BlockNum: last.BlockNum, Receipt: last.Receipt, Irreversible: true})
+			}
+		}
+	}
+}
+
+// blockLogHistory keeps block logs of recent blocks, so that block log subscribers can resume.
+type blockLogHistory struct {
+	sync.RWMutex
+	size int
+	logs []*grpcpb.BlockLogEvent
+}
+
+func newBlockLogHistory(size int) *blockLogHistory {
+	return &blockLogHistory{size: size}
+}
+
+func (h *blockLogHistory) onBlockLog(log *blocklog.BlockLog, blockProducer string) {
+	h.Lock()
+	defer h.Unlock()
+	h.truncate(log.BlockNum)
+	h.logs = append(h.logs, &grpcpb.BlockLogEvent{BlockNum: log.BlockNum, BlockLog: log.ToJsonString(), BlockProducer: blockProducer})
+	if len(h.logs) > h.size {
+		h.logs = append(h.logs[:0:0], h.logs[len(h.logs)-h.size:]...)
+	}
+}
+
+func (h *blockLogHistory) onBlockReverted(num uint64) {
+	h.Lock()
+	defer h.Unlock()
+	h.truncate(num)
+}
+
+// truncate drops block logs since given block number.
+func (h *blockLogHistory) truncate(num uint64) {
+	i := len(h.logs)
+	for i > 0 && h.logs[i-1].BlockNum >= num {
+		i--
+	}
+	h.logs = h.logs[:i]
+}
+
+// since returns block logs since given block number, or false if some of them are no longer available.
+func (h *blockLogHistory) since(num uint64) ([]*grpcpb.BlockLogEvent, bool) {
+	h.RLock()
+	defer h.RUnlock()
+	if len(h.logs) == 0 || num > h.logs[len(h.logs)-1].BlockNum {
+		return nil, true
+	}
+	if num < h.logs[0].BlockNum {
+		return nil, false
+	}
+	i := 0
+	for h.logs[i].BlockNum < num {
+		i++
+	}
+	return append([]*grpcpb.BlockLogEvent(nil), h.logs[i:]...), true
+}
+
+func (h *blockLogHistory) start(bus EventBus.Bus) {
+	_ = bus.Subscribe(constants.NoticeBlockLog, h.onBlockLog)
+	_ = bus.Subscribe(constants.NoticeBlockRevert, h.onBlockReverted)
+}
+
+func (h *blockLogHistory) stop(bus EventBus.Bus) {
+	_ = bus.Unsubscribe(constants.NoticeBlockLog, h.onBlockLog)
+	_ = bus.Unsubscribe(constants.NoticeBlockRevert, h.onBlockReverted)
+}
+
+func (as *APIService) SubscribeBlockLog(req *grpcpb.SubscribeBlocksRequest, stream grpcpb.ApiService_SubscribeBlockLogServer) error {
+	release, err := as.subscriptions.acquire(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	sub := newSubscriber(as.eBus, subscriberBufferSize)
+	defer sub.close()
+	if err := sub.subscribe(constants.NoticeBlockLog, func(log *blocklog.BlockLog, blockProducer string) {
+		sub.push(&grpcpb.BlockLogEvent{BlockNum: log.BlockNum, BlockLog: log.ToJsonString(), BlockProducer: blockProducer})
+	}); err != nil {
+		return err
+	}
+	_ = sub.subscribe(constants.NoticeBlockRevert, func(num uint64) { sub.push(blockReverted(num)) })
+
+	cursor := &blockCursor{min: req.StartBlock}
+	send := func(ev *grpcpb.BlockLogEvent) error {
+		if !cursor.onBlock(ev.BlockNum) {
+			return nil
+		}
+		return stream.Send(ev)
+	}
+	if req.StartBlock > 0 {
+		cursor.next = req.StartBlock
+		if as.blockLogs == nil {
+			return ErrBlockLogExpired
+		}
+		logs, ok := as.blockLogs.since(req.StartBlock)
+		if !ok {
+			return ErrBlockLogExpired
+		}
+		for _, ev := range logs {
+			if err := send(ev); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		e, err := sub.next(stream.Context())
+		if err != nil {
+			return err
+		}
+		switch ev := e.(type) {
+		case *grpcpb.BlockLogEvent:
+			err = send(ev)
+		case blockReverted:
+			if cursor.onRevert(uint64(ev)) {
+				err = stream.Send(&grpcpb.BlockLogEvent{RevertedBlock: uint64(ev)})
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asaskevich/EventBus"
+	"github.com/coschain/contentos-go/app/blocklog"
+	"github.com/coschain/contentos-go/common"
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeChain is a consensus serving blocks of a single branch.
+type fakeChain struct {
+	iservices.IConsensus
+	sync.Mutex
+	blocks []*prototype.SignedBlock
+	lib    uint64
+}
+
+func (c *fakeChain) grow(n int, salt uint32) []*prototype.SignedBlock {
+	c.Lock()
+	defer c.Unlock()
+	prev := common.BlockID{}
+	if len(c.blocks) > 0 {
+		prev = c.blocks[len(c.blocks)-1].Id()
+	}
+	for i := 0; i < n; i++ {
+		b := &prototype.SignedBlock{SignedHeader: &prototype.SignedBlockHeader{
+			Header: &prototype.BlockHeader{
+				Previous:              &prototype.Sha256{Hash: append([]byte{}, prev.Data[:]...)},
+				Timestamp:             &prototype.TimePointSec{UtcSeconds: uint32(len(c.blocks)) + salt},
+				BlockProducer:         &prototype.AccountName{Value: "initminer"},
+				TransactionMerkleRoot: &prototype.Sha256{Hash: make([]byte, 32)},
+			},
+			BlockProducerSignature: &prototype.SignatureType{Sig: make([]byte, 65)},
+		}}
+		c.blocks = append(c.blocks, b)
+		prev = b.Id()
+	}
+	return c.blocks[len(c.blocks)-n:]
+}
+
+func (c *fakeChain) popTo(num uint64) {
+	c.Lock()
+	defer c.Unlock()
+	c.blocks = c.blocks[:num]
+}
+
+func (c *fakeChain) commit(num uint64) {
+	c.Lock()
+	defer c.Unlock()
+	c.lib = num
+}
+
+func (c *fakeChain) FetchBlocks(from, to uint64) ([]common.ISignedBlock, error) {
+	c.Lock()
+	defer c.Unlock()
+	var r []common.ISignedBlock
+	for num := from; num <= to && num <= uint64(len(c.blocks)); num++ {
+		r = append(r, c.blocks[num-1])
+	}
+	return r, nil
+}
+
+func (c *fakeChain) GetHeadBlockId() common.BlockID {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.blocks) == 0 {
+		return common.BlockID{}
+	}
+	return c.blocks[len(c.blocks)-1].Id()
+}
+
+func (c *fakeChain) GetLIB() common.BlockID {
+	c.Lock()
+	defer c.Unlock()
+	if c.lib == 0 {
+		return common.BlockID{}
+	}
+	return c.blocks[c.lib-1].Id()
+}
+
+// fakeStream is a server stream collecting sent messages.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs chan interface{}
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) send(m interface{}) error {
+	select {
+	case s.msgs <- m:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *fakeStream) recv(t *testing.T) interface{} {
+	select {
+	case m := <-s.msgs:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout receiving from stream")
+		return nil
+	}
+}
+
+type blockStream struct{ *fakeStream }
+
+func (s blockStream) Send(e *grpcpb.BlockEvent) error { return s.send(e) }
+
+type trxResultStream struct{ *fakeStream }
+
+func (s trxResultStream) Send(e *grpcpb.TrxResultEvent) error { return s.send(e) }
+
+type blockLogStream struct{ *fakeStream }
+
+func (s blockLogStream) Send(e *grpcpb.BlockLogEvent) error { return s.send(e) }
+
+type subscriptionTest struct {
+	t      *testing.T
+	bus    EventBus.Bus
+	chain  *fakeChain
+	api    *APIService
+	stream *fakeStream
+	cancel context.CancelFunc
+	done   chan error
+}
+
+func newSubscriptionTest(t *testing.T, streamBuffer int) *subscriptionTest {
+	ctx, cancel := context.WithCancel(context.Background())
+	st := &subscriptionTest{
+		t:      t,
+		bus:    EventBus.New(),
+		chain:  &fakeChain{},
+		stream: &fakeStream{ctx: ctx, msgs: make(chan interface{}, streamBuffer)},
+		cancel: cancel,
+		done:   make(chan error, 1),
+	}
+	st.api = &APIService{consensus: st.chain, eBus: st.bus, trxResults: newTrxResultHub()}
+	st.api.trxResults.start(st.bus)
+	return st
+}
+
+// run starts a subscription, and waits until it has subscribed to given topic.
+func (st *subscriptionTest) run(f func() error, topic string) {
+	go func() { st.done <- f() }()
+	for i := 0; !st.bus.HasCallback(topic); i++ {
+		if i > 500 {
+			st.t.Fatal("timeout subscribing")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (st *subscriptionTest) wait() error {
+	select {
+	case err := <-st.done:
+		return err
+	case <-time.After(5 * time.Second):
+		st.t.Fatal("timeout waiting for the subscription")
+		return nil
+	}
+}
+
+func (st *subscriptionTest) stop() {
+	st.cancel()
+	_ = st.wait()
+}
+
+func (st *subscriptionTest) recvBlock() *grpcpb.BlockEvent {
+	return st.stream.recv(st.t).(*grpcpb.BlockEvent)
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	a := assert.New(t)
+	st := newSubscriptionTest(t, 100)
+	blocks := st.chain.grow(10, 0)
+
+	st.run(func() error {
+		return st.api.SubscribeBlocks(&grpcpb.SubscribeBlocksRequest{StartBlock: 8}, blockStream{st.stream})
+	}, constants.NoticeBlockRevert)
+	defer st.stop()
+
+	// replayed
+	for num := 8; num <= 10; num++ {
+		a.Equal(blocks[num-1].Id(), st.recvBlock().Block.Id())
+	}
+
+	// a block already replayed is skipped
+	st.bus.Publish(constants.NoticeBlockApplied, blocks[9])
+	blocks = st.chain.grow(1, 0)
+	st.bus.Publish(constants.NoticeBlockApplied, blocks[0])
+	a.Equal(uint64(11), st.recvBlock().Block.Id().BlockNum())
+
+	// switching to another branch
+	st.chain.popTo(9)
+	st.bus.Publish(constants.NoticeBlockRevert, uint64(10))
+	a.Equal(uint64(10), st.recvBlock().RevertedBlock)
+	blocks = st.chain.grow(2, 100)
+	for _, b := range blocks {
+		st.bus.Publish(constants.NoticeBlockApplied, b)
+	}
+	a.Equal(blocks[0].Id(), st.recvBlock().Block.Id())
+	a.Equal(blocks[1].Id(), st.recvBlock().Block.Id())
+
+	// a failed block is reverted too
+	st.bus.Publish(constants.NoticeBlockApplyFailed, blocks[1])
+	a.Equal(uint64(11), st.recvBlock().RevertedBlock)
+}
+
+func TestSubscribeBlocksTooSlow(t *testing.T) {
+	st := newSubscriptionTest(t, 0)
+	st.chain.grow(1, 0)
+
+	st.run(func() error {
+		return st.api.SubscribeBlocks(&grpcpb.SubscribeBlocksRequest{}, blockStream{st.stream})
+	}, constants.NoticeBlockRevert)
+
+	// nobody receives from the stream
+	blocks := st.chain.grow(subscriberBufferSize+10, 0)
+	for _, b := range blocks {
+		st.bus.Publish(constants.NoticeBlockApplied, b)
+	}
+	<-st.stream.msgs
+	err := st.wait()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.False(t, st.bus.HasCallback(constants.NoticeBlockApplied))
+}
+
+func TestSubscriptionQuota(t *testing.T) {
+	a := assert.New(t)
+	var q subscriptionQuota
+	client := func(ip string, port int) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: port}})
+	}
+
+	// a client can't have too many subscriptions, whatever ports it uses
+	var releases []func()
+	for i := 0; i < maxSubscriptionsPerClient; i++ {
+		release, err := q.acquire(client("10.0.0.1", 1000+i))
+		a.NoError(err)
+		releases = append(releases, release)
+	}
+	_, err := q.acquire(client("10.0.0.1", 999))
+	a.Equal(ErrTooManySubscriptions, err)
+
+	// while other clients can
+	release, err := q.acquire(client("10.0.0.2", 1000))
+	a.NoError(err)
+	release()
+
+	// released subscriptions can be taken again
+	releases[0]()
+	releases[0], err = q.acquire(client("10.0.0.1", 999))
+	a.NoError(err)
+
+	// and the total is limited as well
+	for i := len(releases); i < maxSubscriptions; i++ {
+		release, err := q.acquire(client(fmt.Sprintf("10.1.%d.%d", i/256, i%256), 1000))
+		a.NoError(err)
+		releases = append(releases, release)
+	}
+	_, err = q.acquire(client("10.0.0.2", 1000))
+	a.Equal(ErrTooManySubscriptions, err)
+	for _, release := range releases {
+		release()
+	}
+	a.Zero(q.total)
+	a.Empty(q.clients)
+}
+
+func TestSubscribeIrreversibleBlocks(t *testing.T) {
+	a := assert.New(t)
+	st := newSubscriptionTest(t, 100)
+	blocks := st.chain.grow(10, 0)
+	st.chain.commit(3)
+
+	st.run(func() error {
+		return st.api.SubscribeIrreversibleBlocks(&grpcpb.SubscribeBlocksRequest{StartBlock: 2}, blockStream{st.stream})
+	}, constants.NoticeBlockCommit)
+	defer st.stop()
+
+	a.Equal(blocks[1].Id(), st.recvBlock().Block.Id())
+	a.Equal(blocks[2].Id(), st.recvBlock().Block.Id())
+
+	st.chain.commit(6)
+	st.bus.Publish(constants.NoticeBlockCommit, uint64(6))
+	for num := 4; num <= 6; num++ {
+		a.Equal(blocks[num-1].Id(), st.recvBlock().Block.Id())
+	}
+}
+
+func TestSubscribeTrxResult(t *testing.T) {
+	a := assert.New(t)
+	st := newSubscriptionTest(t, 100)
+	trx := &prototype.SignedTransaction{Trx: &prototype.Transaction{RefBlockNum: 1, Expiration: &prototype.TimePointSec{}}}
+	other := &prototype.SignedTransaction{Trx: &prototype.Transaction{RefBlockNum: 2, Expiration: &prototype.TimePointSec{}}}
+	id, _ := trx.Id()
+	receipt := &prototype.TransactionReceiptWithInfo{Status: prototype.StatusSuccess}
+
+	st.run(func() error {
+		return st.api.SubscribeTrxResult(&grpcpb.SubscribeTrxResultRequest{TrxId: id}, trxResultStream{st.stream})
+	}, constants.NoticeBlockCommit)
+
+	st.bus.Publish(constants.NoticeTrxApplied, other, receipt, uint64(4))
+	st.bus.Publish(constants.NoticeTrxApplied, trx, receipt, uint64(5))
+	e := st.stream.recv(t).(*grpcpb.TrxResultEvent)
+	a.Equal(uint64(5), e.BlockNum)
+	a.Equal(prototype.StatusSuccess, e.Receipt.Status)
+
+	st.bus.Publish(constants.NoticeBlockRevert, uint64(5))
+	e = st.stream.recv(t).(*grpcpb.TrxResultEvent)
+	a.True(e.Reverted)
+
+	// committing a reverted trx means nothing
+	st.bus.Publish(constants.NoticeBlockCommit, uint64(5))
+	st.bus.Publish(constants.NoticeTrxApplied, trx, receipt, uint64(6))
+	e = st.stream.recv(t).(*grpcpb.TrxResultEvent)
+	a.Equal(uint64(6), e.BlockNum)
+	a.False(e.Reverted)
+
+	st.bus.Publish(constants.NoticeBlockCommit, uint64(6))
+	e = st.stream.recv(t).(*grpcpb.TrxResultEvent)
+	a.True(e.Irreversible)
+	a.Equal(uint64(6), e.BlockNum)
+	a.NoError(st.wait())
+	a.Empty(st.api.trxResults.subs)
+}
+
+func TestBlockLogHistory(t *testing.T) {
+	a := assert.New(t)
+	h := newBlockLogHistory(5)
+	for num := uint64(1); num <= 8; num++ {
+		h.onBlockLog(&blocklog.BlockLog{BlockNum: num}, "initminer")
+	}
+	_, ok := h.since(3)
+	a.False(ok)
+	logs, ok := h.since(6)
+	a.True(ok)
+	a.Len(logs, 3)
+	logs, ok = h.since(9)
+	a.True(ok)
+	a.Len(logs, 0)
+
+	h.onBlockReverted(7)
+	logs, _ = h.since(4)
+	a.Len(logs, 3)
+	h.onBlockLog(&blocklog.BlockLog{BlockNum: 5, BlockId: "new"}, "initminer")
+	logs, _ = h.since(5)
+	a.Len(logs, 1)
+	a.Contains(logs[0].BlockLog, "new")
+}
+
+func TestSubscribeBlockLog(t *testing.T) {
+	a := assert.New(t)
+	st := newSubscriptionTest(t, 100)
+	st.api.blockLogs = newBlockLogHistory(3)
+	st.api.blockLogs.start(st.bus)
+	defer st.api.blockLogs.stop(st.bus)
+	for num := uint64(1); num <= 5; num++ {
+		st.bus.Publish(constants.NoticeBlockLog, &blocklog.BlockLog{BlockNum: num}, "initminer")
+	}
+
+	// logs of block 1 and 2 are gone
+	err := st.api.SubscribeBlockLog(&grpcpb.SubscribeBlocksRequest{StartBlock: 1}, blockLogStream{st.stream})
+	a.Equal(codes.OutOfRange, status.Code(err))
+
+	st.run(func() error {
+		return st.api.SubscribeBlockLog(&grpcpb.SubscribeBlocksRequest{StartBlock: 4}, blockLogStream{st.stream})
+	}, constants.NoticeBlockLog)
+	defer st.stop()
+	// wait for the stream, not the history, subscribing
+	for len(st.stream.msgs) < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	a.Equal(uint64(4), st.stream.recv(t).(*grpcpb.BlockLogEvent).BlockNum)
+	a.Equal(uint64(5), st.stream.recv(t).(*grpcpb.BlockLogEvent).BlockNum)
+
+	st.bus.Publish(constants.NoticeBlockRevert, uint64(5))
+	a.Equal(uint64(5), st.stream.recv(t).(*grpcpb.BlockLogEvent).RevertedBlock)
+	st.bus.Publish(constants.NoticeBlockLog, &blocklog.BlockLog{BlockNum: 5}, "initminer")
+	e := st.stream.recv(t).(*grpcpb.BlockLogEvent)
+	a.Equal(uint64(5), e.BlockNum)
+	a.Equal("initminer", e.BlockProducer)
+}
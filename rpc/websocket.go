@@ -0,0 +1,426 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	p2pCommon "github.com/coschain/contentos-go/p2p/common"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The websocket endpoint speaks JSON-RPC 2.0 over ApiService.
+//
+// A unary method is called by a request like
+//
+//	{"jsonrpc":"2.0", "id":1, "method":"GetAccountByName", "params":{"account_name":"initminer"}}
+//
+// and replied by {"jsonrpc":"2.0", "id":1, "result":{...}}.
+//
+// Calling a streaming method, e.g. SubscribeBlocks, replies a subscription id as the result, and events of the stream
+// are pushed as notifications,
+//
+//	{"jsonrpc":"2.0", "method":"subscription", "params":{"subscription":1, "result":{...}}}
+//
+// until the stream ends with {"subscription":1, "done":true, "error":{...}}, where error is omitted if the stream
+// ended normally. A subscription is cancelled by calling "Unsubscribe" with params {"subscription":1}.
+
+const (
+	WebSocketPath = "/ws"
+
+	wsJsonRpcVersion          = "2.0"
+	wsMethodUnsubscribe       = "Unsubscribe"
+	wsMethodSubscription      = "subscription"
+	wsWriteTimeout            = 10 * time.Second
+	wsPingInterval            = 30 * time.Second
+	wsErrParse                = -32700
+	wsErrInvalidRequest       = -32600
+	wsErrMethodNotFound       = -32601
+	wsErrInvalidParams        = -32602
+	wsErrServer               = -32000
+	wsErrTooManyRequests      = -32005
+	wsErrSubscriptionNotFound = -32006
+)
+
+type wsRequest struct {
+	Version string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type wsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+type wsResponse struct {
+	Version string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *wsError        `json:"error,omitempty"`
+}
+
+type wsNotification struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+type wsSubscriptionEvent struct {
+	Subscription uint64      `json:"subscription"`
+	Result       interface{} `json:"result,omitempty"`
+	Done         bool        `json:"done,omitempty"`
+	Error        *wsError    `json:"error,omitempty"`
+}
+
+type wsUnsubscribeParams struct {
+	Subscription uint64 `json:"subscription"`
+}
+
+// wsStatusError converts an error returned by ApiService to a JSON-RPC error.
+func wsStatusError(err error) *wsError {
+	s := status.Convert(err)
+	return &wsError{Code: wsErrServer, Message: s.Message(), Data: s.Code().String()}
+}
+
+// WebSocketServer serves ApiService in JSON-RPC over websocket connections.
+type WebSocketServer struct {
+	srv      grpcpb.ApiServiceServer
+	gi       *GRPCIntercepter
+	log      *logrus.Logger
+	limit    int
+	rate     int
+	methods  map[string]grpc.MethodDesc
+	streams  map[string]grpc.StreamDesc
+	upgrader websocket.Upgrader
+}
+
+// NewWebSocketServer returns a websocket server of srv. Each connection is allowed to have at most limit pending
+// calls and subscriptions, and to make at most rate requests per second. Connections keeping on requesting over the
+// rate for another second are closed.
+func NewWebSocketServer(srv grpcpb.ApiServiceServer, gi *GRPCIntercepter, limit, rate int, log *logrus.Logger) *WebSocketServer {
+	desc := grpcpb.ApiServiceDesc()
+	ws := &WebSocketServer{
+		srv:     srv,
+		gi:      gi,
+		log:     log,
+		limit:   limit,
+		rate:    rate,
+		methods: make(map[string]grpc.MethodDesc),
+		streams: make(map[string]grpc.StreamDesc),
+	}
+	for _, m := range desc.Methods {
+		ws.methods[m.MethodName] = m
+	}
+	for _, s := range desc.Streams {
+		if s.ServerStreams && !s.ClientStreams {
+			ws.streams[s.StreamName] = s
+		}
+	}
+	originFunc := makeHttpOriginFunc()
+	ws.upgrader = websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return originFunc(r.Header.Get("Origin"))
+		},
+	}
+	return ws
+}
+
+func (ws *WebSocketServer) fullMethod(name string) string {
+	return "/" + grpcpb.ApiServiceDesc().ServiceName + "/" + name
+}
+
+func (ws *WebSocketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		ws.log.Debugf("websocket upgrade failed: %v", err)
+		return
+	}
+	// subscriptions are limited per client, which is told by the peer address.
+	c := newWSConn(ws, conn, peer.NewContext(r.Context(), &peer.Peer{Addr: conn.RemoteAddr()}))
+	c.serve()
+}
+
+// wsConn is a websocket connection.
+type wsConn struct {
+	ws      *WebSocketServer
+	conn    *websocket.Conn
+	ctx     context.Context
+	cancel  context.CancelFunc
+	slots   chan struct{}
+	limiter *p2pCommon.RateLimiter
+	writeMu sync.Mutex
+	subMu   sync.Mutex
+	subId   uint64
+	subs    map[uint64]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newWSConn(ws *WebSocketServer, conn *websocket.Conn, parent context.Context) *wsConn {
+	ctx, cancel := context.WithCancel(parent)
+	return &wsConn{
+		ws:      ws,
+		conn:    conn,
+		ctx:     ctx,
+		cancel:  cancel,
+		slots:   make(chan struct{}, ws.limit),
+		limiter: p2pCommon.NewRateLimiter(uint32(ws.rate)),
+		subs:    make(map[uint64]context.CancelFunc),
+	}
+}
+
+func (c *wsConn) serve() {
+	defer func() {
+		c.cancel()
+		c.wg.Wait()
+		_ = c.conn.Close()
+	}()
+	c.conn.SetReadLimit(GRPCMaxRecvMsgSize)
+	go c.keepAlive()
+	rejected := 0
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		req := new(wsRequest)
+		if err = json.Unmarshal(data, req); err != nil {
+			c.reply(nil, nil, &wsError{Code: wsErrParse, Message: err.Error()})
+			continue
+		}
+		if req.Version != wsJsonRpcVersion || req.Method == "" {
+			c.reply(req.Id, nil, &wsError{Code: wsErrInvalidRequest, Message: "invalid request"})
+			continue
+		}
+		if c.limiter.Request(1, true) == 0 {
+			if rejected++; rejected > c.ws.rate {
+				return
+			}
+			c.reply(req.Id, nil, &wsError{Code: wsErrTooManyRequests, Message: "too many requests per second"})
+			continue
+		}
+		rejected = 0
+		c.handle(req)
+	}
+}
+
+func (c *wsConn) keepAlive() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.writeMu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+			c.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) write(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (c *wsConn) reply(id json.RawMessage, result interface{}, rpcErr *wsError) {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	if err := c.write(&wsResponse{Version: wsJsonRpcVersion, Id: id, Result: result, Error: rpcErr}); err != nil {
+		c.ws.log.Debugf("websocket write failed: %v", err)
+	}
+}
+
+// acquire takes a slot of pending calls, or returns false if the connection has too many of them.
+func (c *wsConn) acquire() bool {
+	select {
+	case c.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *wsConn) release() {
+	<-c.slots
+}
+
+func (c *wsConn) handle(req *wsRequest) {
+	if req.Method == wsMethodUnsubscribe {
+		c.unsubscribe(req)
+		return
+	}
+	method, isUnary := c.ws.methods[req.Method]
+	stream, isStream := c.ws.streams[req.Method]
+	if !isUnary && !isStream {
+		c.reply(req.Id, nil, &wsError{Code: wsErrMethodNotFound, Message: "method not found: " + req.Method})
+		return
+	}
+	if !c.acquire() {
+		c.reply(req.Id, nil, &wsError{Code: wsErrTooManyRequests, Message: "too many requests"})
+		return
+	}
+	c.wg.Add(1)
+	if isUnary {
+		go c.call(req, method)
+	} else {
+		c.subscribe(req, stream)
+	}
+}
+
+// decodeParams returns a decoder of request params, and the error of decoding if any.
+func decodeParams(params json.RawMessage, decodeErr *error) func(interface{}) error {
+	return func(m interface{}) error {
+		if len(params) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(params, m); err != nil {
+			*decodeErr = err
+			return err
+		}
+		return nil
+	}
+}
+
+func (c *wsConn) call(req *wsRequest, method grpc.MethodDesc) {
+	defer c.wg.Done()
+	defer c.release()
+
+	var decodeErr error
+	interceptor := func(ctx context.Context, r interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		info.FullMethod = c.ws.fullMethod(method.MethodName)
+		return c.ws.gi.unaryRecoveryLoggingInterceptor(ctx, r, info, handler)
+	}
+	resp, err := method.Handler(c.ws.srv, c.ctx, decodeParams(req.Params, &decodeErr), interceptor)
+	if decodeErr != nil {
+		c.reply(req.Id, nil, &wsError{Code: wsErrInvalidParams, Message: decodeErr.Error()})
+	} else if err != nil {
+		c.reply(req.Id, nil, wsStatusError(err))
+	} else {
+		c.reply(req.Id, resp, nil)
+	}
+}
+
+func (c *wsConn) subscribe(req *wsRequest, desc grpc.StreamDesc) {
+	var decodeErr error
+	decode := decodeParams(req.Params, &decodeErr)
+
+	c.subMu.Lock()
+	c.subId++
+	id := c.subId
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.subs[id] = cancel
+	c.subMu.Unlock()
+
+	ss := &wsServerStream{ctx: ctx, conn: c, id: id, decode: decode, reqId: req.Id}
+	info := &grpc.StreamServerInfo{FullMethod: c.ws.fullMethod(desc.StreamName), IsServerStream: true}
+	go func() {
+		defer c.wg.Done()
+		defer c.release()
+		err := c.ws.gi.streamRecoveryLoggingInterceptor(c.ws.srv, ss, info, desc.Handler)
+
+		c.subMu.Lock()
+		_, active := c.subs[id]
+		delete(c.subs, id)
+		c.subMu.Unlock()
+		cancel()
+
+		if !ss.received {
+			// the request was never accepted
+			if decodeErr != nil {
+				c.reply(req.Id, nil, &wsError{Code: wsErrInvalidParams, Message: decodeErr.Error()})
+			} else {
+				c.reply(req.Id, nil, wsStatusError(err))
+			}
+			return
+		}
+		if !active || c.ctx.Err() != nil {
+			// unsubscribed, or the connection was closed
+			return
+		}
+		event := &wsSubscriptionEvent{Subscription: id, Done: true}
+		if err != nil {
+			event.Error = wsStatusError(err)
+		}
+		_ = c.write(&wsNotification{Version: wsJsonRpcVersion, Method: wsMethodSubscription, Params: event})
+	}()
+}
+
+func (c *wsConn) unsubscribe(req *wsRequest) {
+	params := new(wsUnsubscribeParams)
+	if err := json.Unmarshal(req.Params, params); err != nil {
+		c.reply(req.Id, nil, &wsError{Code: wsErrInvalidParams, Message: err.Error()})
+		return
+	}
+	c.subMu.Lock()
+	cancel, ok := c.subs[params.Subscription]
+	delete(c.subs, params.Subscription)
+	c.subMu.Unlock()
+	if !ok {
+		c.reply(req.Id, nil, &wsError{Code: wsErrSubscriptionNotFound, Message: "subscription not found"})
+		return
+	}
+	cancel()
+	c.reply(req.Id, true, nil)
+}
+
+// wsServerStream is a grpc server stream of a subscription, sending messages as notifications.
+type wsServerStream struct {
+	ctx      context.Context
+	conn     *wsConn
+	id       uint64
+	decode   func(interface{}) error
+	reqId    json.RawMessage
+	received bool
+}
+
+func (s *wsServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *wsServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *wsServerStream) SetTrailer(metadata.MD)       {}
+
+func (s *wsServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *wsServerStream) SendMsg(m interface{}) error {
+	return s.conn.write(&wsNotification{
+		Version: wsJsonRpcVersion,
+		Method:  wsMethodSubscription,
+		Params:  &wsSubscriptionEvent{Subscription: s.id, Result: m},
+	})
+}
+
+func (s *wsServerStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return errors.New("websocket stream has only one request")
+	}
+	if err := s.decode(m); err != nil {
+		return err
+	}
+	// the request is accepted, reply the subscription id before any notification.
+	s.received = true
+	s.conn.reply(s.reqId, s.id, nil)
+	return nil
+}
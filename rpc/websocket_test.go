@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// wsTestApi serves GetAccountByName without a database.
+type wsTestApi struct {
+	*APIService
+}
+
+func (api *wsTestApi) GetAccountByName(ctx context.Context, req *grpcpb.GetAccountByNameRequest) (*grpcpb.AccountResponse, error) {
+	if req.AccountName.Value == "panic" {
+		panic("boom")
+	}
+	return &grpcpb.AccountResponse{Info: &grpcpb.AccountInfo{AccountName: req.AccountName, Coin: prototype.NewCoin(100)}}, nil
+}
+
+type wsTestMessage struct {
+	Id     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *wsError        `json:"error"`
+	Params *struct {
+		Subscription uint64          `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+		Done         bool            `json:"done"`
+		Error        *wsError        `json:"error"`
+	} `json:"params"`
+}
+
+type wsTestClient struct {
+	t    *testing.T
+	conn *websocket.Conn
+}
+
+func (c *wsTestClient) send(msg string) {
+	if err := c.conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *wsTestClient) recv() *wsTestMessage {
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := c.conn.ReadMessage()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	m := new(wsTestMessage)
+	if err = json.Unmarshal(data, m); err != nil {
+		c.t.Fatal(err)
+	}
+	return m
+}
+
+func newWSTest(t *testing.T, limit, rate int) (*subscriptionTest, *wsTestClient, func()) {
+	st := newSubscriptionTest(t, 0)
+	log := logrus.New()
+	log.SetOutput(ioutil.Discard)
+	server := httptest.NewServer(NewWebSocketServer(&wsTestApi{st.api}, NewGRPCIntercepter(log), limit, rate, log))
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st, &wsTestClient{t: t, conn: conn}, func() {
+		_ = conn.Close()
+		server.Close()
+	}
+}
+
+func TestWebSocketCall(t *testing.T) {
+	a := assert.New(t)
+	_, c, done := newWSTest(t, 10, 1000)
+	defer done()
+
+	c.send(`{"jsonrpc":"2.0","id":1,"method":"GetAccountByName","params":{"account_name":"alice"}}`)
+	m := c.recv()
+	a.Equal("1", string(m.Id))
+	a.Nil(m.Error)
+	// results are encoded the same way as wallet-cli prints them
+	a.Contains(string(m.Result), `"account_name":"alice"`)
+	a.Contains(string(m.Result), `"coin":"`+prototype.NewCoin(100).ToString()+`"`)
+
+	c.send(`{"jsonrpc":"2.0","id":"x","method":"NoSuchMethod"}`)
+	m = c.recv()
+	a.Equal(`"x"`, string(m.Id))
+	a.Equal(wsErrMethodNotFound, m.Error.Code)
+
+	c.send(`{"jsonrpc":"2.0","id":2,"method":"GetAccountByName","params":{"account_name":1}}`)
+	a.Equal(wsErrInvalidParams, c.recv().Error.Code)
+
+	c.send(`{"id":3,"method":"GetAccountByName"}`)
+	a.Equal(wsErrInvalidRequest, c.recv().Error.Code)
+
+	c.send(`not json`)
+	a.Equal(wsErrParse, c.recv().Error.Code)
+
+	// panics are recovered by the interceptor
+	c.send(`{"jsonrpc":"2.0","id":4,"method":"GetAccountByName","params":{"account_name":"panic"}}`)
+	m = c.recv()
+	a.Equal(wsErrServer, m.Error.Code)
+	a.Equal(ErrPanicResp.Error(), m.Error.Message)
+}
+
+func TestWebSocketSubscription(t *testing.T) {
+	a := assert.New(t)
+	st, c, done := newWSTest(t, 10, 1000)
+	defer done()
+	st.chain.grow(1, 0)
+
+	c.send(`{"jsonrpc":"2.0","id":1,"method":"SubscribeBlocks","params":{}}`)
+	m := c.recv()
+	a.Nil(m.Error)
+	var subId uint64
+	a.NoError(json.Unmarshal(m.Result, &subId))
+	for !st.bus.HasCallback(constants.NoticeBlockRevert) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	blocks := st.chain.grow(2, 0)
+	for _, b := range blocks {
+		st.bus.Publish(constants.NoticeBlockApplied, b)
+	}
+	for _, b := range blocks {
+		m = c.recv()
+		a.Equal(wsMethodSubscription, m.Method)
+		a.Equal(subId, m.Params.Subscription)
+		e := new(grpcpb.BlockEvent)
+		a.NoError(json.Unmarshal(m.Params.Result, e))
+		a.Equal(b.Id(), e.Block.Id())
+	}
+
+	c.send(fmt.Sprintf(`{"jsonrpc":"2.0","id":2,"method":"Unsubscribe","params":{"subscription":%d}}`, subId))
+	m = c.recv()
+	a.Nil(m.Error)
+	a.Equal("true", string(m.Result))
+	for st.bus.HasCallback(constants.NoticeBlockRevert) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.send(fmt.Sprintf(`{"jsonrpc":"2.0","id":3,"method":"Unsubscribe","params":{"subscription":%d}}`, subId))
+	a.Equal(wsErrSubscriptionNotFound, c.recv().Error.Code)
+
+	// a stream failing after accepted ends with an error
+	c.send(`{"jsonrpc":"2.0","id":4,"method":"SubscribeTrxResult","params":{}}`)
+	m = c.recv()
+	a.Nil(m.Error)
+	m = c.recv()
+	a.True(m.Params.Done)
+	a.Equal("InvalidArgument", m.Params.Error.Data)
+}
+
+func TestWebSocketLimit(t *testing.T) {
+	a := assert.New(t)
+	st, c, done := newWSTest(t, 1, 1000)
+	defer done()
+
+	c.send(`{"jsonrpc":"2.0","id":1,"method":"SubscribeBlocks","params":{}}`)
+	a.Nil(c.recv().Error)
+	c.send(`{"jsonrpc":"2.0","id":2,"method":"GetAccountByName","params":{"account_name":"alice"}}`)
+	a.Equal(wsErrTooManyRequests, c.recv().Error.Code)
+
+	// the slot is released once unsubscribed
+	c.send(`{"jsonrpc":"2.0","id":3,"method":"Unsubscribe","params":{"subscription":1}}`)
+	a.Nil(c.recv().Error)
+	for st.bus.HasCallback(constants.NoticeBlockRevert) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	c.send(`{"jsonrpc":"2.0","id":4,"method":"GetAccountByName","params":{"account_name":"alice"}}`)
+	a.Nil(c.recv().Error)
+}
+
+func TestWebSocketRate(t *testing.T) {
+	a := assert.New(t)
+	_, c, done := newWSTest(t, 10, 2)
+	defer done()
+
+	call := `{"jsonrpc":"2.0","id":1,"method":"GetAccountByName","params":{"account_name":"alice"}}`
+	for i := 0; i < 2; i++ {
+		c.send(call)
+		a.Nil(c.recv().Error)
+	}
+	c.send(call)
+	a.Equal(wsErrTooManyRequests, c.recv().Error.Code)
+
+	// requests are accepted again in the next second
+	time.Sleep(time.Second)
+	c.send(call)
+	a.Nil(c.recv().Error)
+
+	// and the connection is closed if the client keeps on going over the rate
+	for i := 0; i < 10; i++ {
+		_ = c.conn.WriteMessage(websocket.TextMessage, []byte(call))
+	}
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var err error
+	for err == nil {
+		_, _, err = c.conn.ReadMessage()
+	}
+	netErr, isNetErr := err.(net.Error)
+	a.False(isNetErr && netErr.Timeout(), "connection not closed")
+}
+
+func TestWebSocketConnLimit(t *testing.T) {
+	a := assert.New(t)
+
+	// the handler holds its connection until released, like an idle websocket.
+	entered, release := make(chan bool), make(chan bool)
+	server := httptest.NewServer(limitWebSocketConns(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		entered <- true
+		<-release
+	}), 1))
+	defer server.Close()
+
+	done := make(chan int)
+	go func() {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			done <- 0
+			return
+		}
+		_ = resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+	<-entered
+
+	// no more slots
+	resp, err := http.Get(server.URL)
+	a.NoError(err)
+	_ = resp.Body.Close()
+	a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+
+	// the slot is released once the connection is closed
+	release <- true
+	a.Equal(http.StatusOK, <-done)
+	go func() {
+		<-entered
+		release <- true
+	}()
+	resp, err = http.Get(server.URL)
+	a.NoError(err)
+	_ = resp.Body.Close()
+	a.Equal(http.StatusOK, resp.StatusCode)
+}
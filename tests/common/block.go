@@ -0,0 +1,116 @@
+package common
+
+import (
+	"context"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc"
+	"github.com/coschain/contentos-go/rpc/lightclient"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type BlockTester struct{}
+
+func (tester *BlockTester) Test(t *testing.T, d *Dandelion) {
+	t.Run("state_root", d.Test(tester.stateRoot))
+	t.Run("state_proof", d.Test(tester.stateProof))
+}
+
+func (tester *BlockTester) stateRoot(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	root0, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+	a.Len(root0, 32)
+
+	// state changes, so does state root
+	a.NoError(d.Account(constants.COSInitMiner).SendTrxAndProduceBlock(Transfer(constants.COSInitMiner, "actor0", 1, "")))
+	root1, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+	a.NotEqual(root0, root1)
+
+	// blocks with wrong state roots are refused
+	headNum, _ := d.TrxPool().GetHeadBlockNum()
+	_, err = d.PushBlockWithStateRoot(root0)
+	a.Error(err)
+	num, _ := d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum, num)
+
+	// blocks with correct state roots are accepted
+	_, err = d.PushBlockWithStateRoot(root1)
+	a.NoError(err)
+	num, _ = d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum+1, num)
+
+	// blocks without state roots are refused
+	_, err = d.PushBlock()
+	a.Error(err)
+	num, _ = d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum+1, num)
+
+	// and the refused block leaves nothing in progress
+	root2, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+	_, err = d.PushBlockWithStateRoot(root2)
+	a.NoError(err)
+	num, _ = d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum+2, num)
+}
+
+func (tester *BlockTester) TestInactive(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	// blocks produced before the state root feature have no state roots
+	headNum, _ := d.TrxPool().GetHeadBlockNum()
+	_, err := d.PushBlock()
+	a.NoError(err)
+	num, _ := d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum+1, num)
+
+	// and blocks with state roots are refused
+	d.TestFeatureInactive(t, constants.FeatureStateRoot, func() error {
+		root, err := d.TrxPool().GetStateRoot()
+		if err != nil {
+			return err
+		}
+		_, err = d.PushBlockWithStateRoot(root)
+		return err
+	})
+	num, _ = d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum+1, num)
+}
+
+func (tester *BlockTester) stateProof(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	api := rpc.NewAPIService(nil, nil, d.Database(), nil)
+
+	name := &prototype.AccountName{Value: "actor1"}
+	resp, err := api.GetAccountProof(context.Background(), &grpcpb.GetAccountProofRequest{AccountName: name})
+	a.NoError(err)
+	nobody := &prototype.AccountName{Value: "nobody"}
+	resp2, err := api.GetAccountProof(context.Background(), &grpcpb.GetAccountProofRequest{AccountName: nobody})
+	a.NoError(err)
+	balance := d.Account(name.Value).GetBalance().Value
+
+	// the state root is committed by the next block
+	root, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+	block, err := d.PushBlockWithStateRoot(root)
+	a.NoError(err)
+
+	account, err := lightclient.VerifyAccountProof(block.SignedHeader, name, resp)
+	a.NoError(err)
+	a.NotNil(account)
+	a.Equal(name.Value, account.Name.Value)
+	a.Equal(balance, account.Balance.Value)
+
+	account, err = lightclient.VerifyAccountProof(block.SignedHeader, nobody, resp2)
+	a.NoError(err)
+	a.Nil(account)
+
+	_, err = lightclient.VerifyAccountProof(block.SignedHeader, nobody, resp)
+	a.Error(err)
+}
@@ -1,10 +1,16 @@
 package common
 
 import (
+	"github.com/coschain/contentos-go/common/constants"
 	"github.com/coschain/contentos-go/dandelion"
 	"testing"
 )
 
 func TestCommons(t *testing.T) {
 	t.Run("trx", dandelion.NewDandelionTest(new(TrxTester).Test, 3))
+	t.Run("block", dandelion.NewDandelionTestWithFeatures(map[string]uint64{constants.FeatureStateRoot: 0}, new(BlockTester).Test, 3))
+	t.Run("block inactive", dandelion.NewDandelionTest(new(BlockTester).TestInactive, 3))
+	t.Run("trx nonce", dandelion.NewDandelionTestWithFeatures(map[string]uint64{constants.FeatureTrxNonce: 0}, new(TrxNonceTester).Test, 3))
+	t.Run("trx nonce inactive", dandelion.NewDandelionTest(new(TrxNonceTester).TestInactive, 3))
+	t.Run("parallel", NewParallelTester(10).Test)
 }
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/stretchr/testify/assert"
+)
+
+type ParallelTester struct {
+	actors int
+}
+
+func NewParallelTester(actors int) *ParallelTester {
+	return &ParallelTester{actors: actors}
+}
+
+func (tester *ParallelTester) Test(t *testing.T) {
+	// blocks are signed and kept for replaying.
+	d := NewDandelion(nil)
+	d.SignBlocks(true)
+	d.KeepBlocks(true)
+	d.SetFeatureHeight(constants.FeatureStateRoot, 0)
+	if err := d.Start(); err != nil {
+		t.Fatalf("dandelion start failed: %s", err.Error())
+	}
+	defer func() {
+		_ = d.Stop()
+	}()
+	if err := d.CreateAndFund("actor", tester.actors, 100000 * constants.COSTokenDecimals, constants.DefaultAccountCreateFee); err != nil {
+		t.Fatalf("dandelion createAndFund failed: %s", err.Error())
+	}
+	d.TrxPool().(*app.TrxPool).SetTrxApplyMode(app.TrxApplyOptimistic)
+
+	t.Run("conflicts", d.Test(tester.conflicts))
+	t.Run("determinism", d.Test(tester.determinism))
+}
+
+func (tester *ParallelTester) actor(i int) string {
+	return fmt.Sprintf("actor%d", i % tester.actors)
+}
+
+func (tester *ParallelTester) balances(d *Dandelion) []uint64 {
+	b := make([]uint64, tester.actors)
+	for i := range b {
+		b[i] = d.Account(tester.actor(i)).GetBalance().Value
+	}
+	return b
+}
+
+// conflicts sends transfers touching the same accounts in one block, and checks that none of them is lost.
+func (tester *ParallelTester) conflicts(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	prev := tester.balances(d)
+	expected := tester.balances(d)
+	count := 0
+	for round := 1; round <= 3; round++ {
+		for i := 0; i < tester.actors; i++ {
+			// everybody pays some of the others.
+			for _, to := range []int{i + round, i + tester.actors - round} {
+				if from := i; from != to % tester.actors {
+					amount := uint64(round * (from + 1))
+					a.NoError(d.SendTrxByAccount(tester.actor(from), Transfer(tester.actor(from), tester.actor(to), amount, "")))
+					expected[from] -= amount
+					expected[to % tester.actors] += amount
+					count++
+				}
+			}
+		}
+	}
+	a.NoError(d.ProduceBlocks(1))
+	blocks := d.Blocks()
+	a.Equal(count, len(blocks[len(blocks) - 1].Transactions))
+	a.Equal(expected, tester.balances(d))
+	a.NotEqual(prev, expected)
+}
+
+// determinism replays all blocks on dandelions using different trx apply modes, and checks they have the same state.
+func (tester *ParallelTester) determinism(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	// a transfer that can't succeed, and a chain of transfers of which later ones rely on earlier ones.
+	a.NoError(d.SendTrxByAccount(tester.actor(0), Transfer(tester.actor(0), tester.actor(1), d.Account(tester.actor(0)).GetBalance().Value + 1, "")))
+	for i := 1; i < tester.actors; i++ {
+		amount := d.Account(tester.actor(i)).GetBalance().Value + uint64(i)
+		a.NoError(d.SendTrxByAccount(tester.actor(i), Transfer(tester.actor(i), tester.actor(i + 1), amount, "")))
+	}
+	a.NoError(d.ProduceBlocks(1))
+
+	root, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+	blocks := d.Blocks()
+	for _, mode := range []app.TrxApplyMode{app.TrxApplySequential, app.TrxApplyGrouped, app.TrxApplyOptimistic} {
+		r := NewDandelion(nil)
+		r.SignBlocks(true)
+		r.SetFeatureHeight(constants.FeatureStateRoot, 0)
+		r.SetGenesisTime(d.GenesisTime())
+		a.NoError(r.Start())
+		r.TrxPool().(*app.TrxPool).SetTrxApplyMode(mode)
+		for _, b := range blocks[1:] {
+			// block receipts are checked against the ones produced by d.
+			if !a.NoError(r.PushSignedBlock(b), "mode %d, block %d", mode, b.Id().BlockNum()) {
+				break
+			}
+		}
+		rroot, err := r.TrxPool().GetStateRoot()
+		a.NoError(err)
+		a.Equal(root, rroot, "mode %d", mode)
+		a.Equal(tester.balances(d), tester.balances(r), "mode %d", mode)
+		_ = r.Stop()
+	}
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type TrxNonceTester struct{}
+
+func (tester *TrxNonceTester) Test(t *testing.T, d *Dandelion) {
+	t.Run("reserved", d.Test(tester.reserved))
+	t.Run("cancellation_in_block", d.Test(tester.cancellationInBlock))
+	t.Run("dup_nonce_inside_block", d.Test(tester.dupNonceInsideBlock))
+}
+
+func (tester *TrxNonceTester) TestInactive(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	// trxs of nonces or cancellations are refused before the feature, by the pool and by blocks
+	d.TestFeatureInactive(t, constants.FeatureTrxNonce, func() error {
+		return tester.send(d, "actor0", 1, false, "")
+	})
+	d.TestFeatureInactive(t, constants.FeatureTrxNonce, func() error {
+		return tester.send(d, "actor0", 1, true, "")
+	})
+	_, err := d.PushBlock(tester.wrapper(d, "actor0", 1, false, ""))
+	a.Error(err)
+	a.NoError(d.ProduceBlocks(1))
+	a.False(d.TrxNonce("actor0", 1).CheckExist())
+}
+
+func (tester *TrxNonceTester) reserved(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance := d.Account("actor0").GetBalance().Value
+	a.NoError(tester.send(d, "actor0", 1, false, "first"))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance-1, d.Account("actor0").GetBalance().Value)
+	rec := d.TrxNonce("actor0", 1)
+	a.True(rec.CheckExist())
+	expiration := rec.GetExpiration().UtcSeconds
+
+	// another trx of the same nonce is refused by the pool, and by blocks
+	a.Error(tester.send(d, "actor0", 1, false, "second"))
+	_, err := d.PushBlock(tester.wrapper(d, "actor0", 1, false, "second"))
+	a.Error(err)
+
+	// nonces of other signers are not affected
+	a.NoError(tester.send(d, "actor1", 1, false, ""))
+	a.NoError(d.ProduceBlocks(1))
+	a.True(d.TrxNonce("actor1", 1).CheckExist())
+
+	// the reservation is released after the trx expires
+	for d.GlobalProps().GetTime().GetUtcSeconds() <= expiration {
+		a.NoError(d.ProduceBlocks(1))
+	}
+	a.False(d.TrxNonce("actor0", 1).CheckExist())
+	a.Equal(balance-1, d.Account("actor0").GetBalance().Value)
+}
+
+func (tester *TrxNonceTester) cancellationInBlock(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	headNum, _ := d.TrxPool().GetHeadBlockNum()
+	_, err := d.PushBlock(tester.wrapper(d, "actor0", 2, true, ""))
+	a.Error(err)
+	num, _ := d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum, num)
+}
+
+func (tester *TrxNonceTester) dupNonceInsideBlock(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	headNum, _ := d.TrxPool().GetHeadBlockNum()
+	_, err := d.PushBlock(tester.wrapper(d, "actor0", 3, false, "a"), tester.wrapper(d, "actor0", 3, false, "b"))
+	a.Error(err)
+	num, _ := d.TrxPool().GetHeadBlockNum()
+	a.Equal(headNum, num)
+	a.False(d.TrxNonce("actor0", 3).CheckExist())
+}
+
+func (tester *TrxNonceTester) trx(d *Dandelion, signer string, nonce uint64, cancel bool, memo string) *prototype.SignedTransaction {
+	key := d.GetAccountKey(signer)
+	trx, _ := d.NewSignedTransaction(key, Transfer(signer, constants.COSInitMiner, 1, memo))
+	trx.Trx.Nonce, trx.Trx.Cancel = nonce, cancel
+	trx.Signature.Sig = trx.Sign(key, d.ChainId())
+	return trx
+}
+
+func (tester *TrxNonceTester) send(d *Dandelion, signer string, nonce uint64, cancel bool, memo string) error {
+	_, err := d.SendRawTrx(tester.trx(d, signer, nonce, cancel, memo))
+	return err
+}
+
+func (tester *TrxNonceTester) wrapper(d *Dandelion, signer string, nonce uint64, cancel bool, memo string) *prototype.TransactionWrapper {
+	return &prototype.TransactionWrapper{
+		SigTrx:  tester.trx(d, signer, nonce, cancel, memo),
+		Receipt: &prototype.TransactionReceipt{Status: prototype.StatusSuccess},
+	}
+}
@@ -2,6 +2,8 @@ package db
 
 import (
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/coschain/contentos-go/db/blocklog"
@@ -38,3 +40,32 @@ func TestBlockLog(t *testing.T) {
 	assert.Equal(msb.Data(), "hello1")
 
 }
+
+func TestBlockLogBase(t *testing.T) {
+	assert := assert.New(t)
+	var blog blocklog.BLog
+	dir, err := ioutil.TempDir("", "blog")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(blog.Open(dir))
+	assert.NoError(blog.SetBase(100))
+	var msb MockSignedBlock
+	msb.Payload = []byte("hello100")
+	assert.NoError(blog.Append(&msb))
+	assert.Error(blog.SetBase(200))
+	assert.Equal(int64(101), blog.Size())
+
+	assert.NoError(blog.ReadBlock(&msb, 100))
+	assert.Equal(msb.Data(), "hello100")
+	assert.Error(blog.ReadBlock(&msb, 99))
+
+	// base survives reopening
+	blog.Close()
+	assert.NoError(blog.Open(dir))
+	assert.Equal(int64(100), blog.Base())
+	assert.Equal(int64(101), blog.Size())
+	assert.NoError(blog.ReadBlock(&msb, 100))
+	assert.Equal(msb.Data(), "hello100")
+	blog.Close()
+}
@@ -19,6 +19,9 @@ func TestEconomist(t *testing.T) {
 	t.Run("vote", dandelion.NewDandelionTest(new(VoteTester).Test, 5))
 	t.Run("decay", dandelion.NewDandelionTest(new(DecayTester).Test, 3))
 	t.Run("util", dandelion.NewDandelionTest(new(UtilTester).Test, 3))
+	t.Run("reward policy", dandelion.NewDandelionTestWithRewardPolicy(quadraticRewardPolicy, new(RewardPolicyTester).Test, 3))
+	t.Run("default reward policy replay", new(DefaultRewardPolicyReplayTester).Test)
+	t.Run("simulation", dandelion.NewDandelionTest(new(SimulationTester).Test, 3))
 }
 
 
@@ -0,0 +1,134 @@
+package economist
+
+import (
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/app/annual_mint"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/iservices/service-configs"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+var quadraticRewardPolicy = &service_configs.RewardPolicyConfig{
+	Weighting:   constants.RewardWeightingQuadratic,
+	CreatorRate: 7000,
+	DappRate:    1000,
+	AuthorRate:  5000,
+	ReplyRate:   1500,
+	VoterRate:   3500,
+}
+
+type RewardPolicyTester struct {
+	acc0,acc1,acc2 *DandelionAccount
+}
+
+func (tester *RewardPolicyTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+
+	registerBlockProducer(tester.acc2, t)
+
+	const VEST = 1000
+	SelfTransferToVesting([]*DandelionAccount{tester.acc0, tester.acc1}, VEST, t)
+
+	t.Run("genesis", d.Test(tester.genesis))
+	t.Run("quadratic cashout", d.Test(tester.quadraticCashout))
+}
+
+func (tester *RewardPolicyTester) genesis(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	policy := d.GlobalProps().GetRewardPolicy()
+	a.NotNil(policy)
+	a.Equal(quadraticRewardPolicy.Weighting, policy.GetWeighting())
+	a.Equal(quadraticRewardPolicy.CreatorRate, policy.GetCreatorRate())
+	a.Equal(quadraticRewardPolicy.AuthorRate, policy.GetAuthorRate())
+}
+
+func (tester *RewardPolicyTester) quadraticCashout(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 1
+
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	vest0 := d.Account(tester.acc0.Name).GetVest().Value
+	a.NoError(tester.acc1.SendTrx(Vote(tester.acc1.Name, POST)))
+	a.NoError(d.ProduceBlocks(constants.PostCashOutDelayBlock - 2))
+
+	// posts are weighted by square roots of their weighted vps
+	postWeight := new(big.Int).Sqrt(StringToBigInt(d.Post(POST).GetWeightedVp()))
+	a.NotEqual(postWeight.Int64(), int64(0))
+	globalPostReward := new(big.Int).SetUint64(d.GlobalProps().GetPoolPostRewards().Value)
+	decayedPostWeight := bigDecay(StringToBigInt(d.GlobalProps().GetWeightedVpsPost()))
+	exceptNextBlockPostWeightedVps := decayedPostWeight.Add(decayedPostWeight, postWeight)
+	bigGlobalPostReward := globalPostReward.Add(globalPostReward, new(big.Int).SetUint64(quadraticPerBlockPostReward(d)))
+	reward := ProportionAlgorithm(postWeight, exceptNextBlockPostWeightedVps, bigGlobalPostReward)
+
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(exceptNextBlockPostWeightedVps.String(), d.GlobalProps().GetWeightedVpsPost())
+	a.Equal(reward.Uint64(), d.Account(tester.acc0.Name).GetVest().Value - vest0)
+	a.Equal(reward.Uint64(), d.Post(POST).GetRewards().Value)
+}
+
+func quadraticPerBlockPostReward(d *Dandelion) uint64 {
+	ith := d.GlobalProps().GetIthYear()
+	annualBudget := annual_mint.CalculateBudget(ith)
+	blockCurrency := annual_mint.CalculatePerBlockBudget(annualBudget)
+
+	creatorReward := blockCurrency * uint64(quadraticRewardPolicy.CreatorRate) / constants.PERCENT
+	return creatorReward * uint64(quadraticRewardPolicy.AuthorRate) / constants.PERCENT
+}
+
+// DefaultRewardPolicyReplayTester replays a chain with the default reward policy forced, which must not change the state.
+type DefaultRewardPolicyReplayTester struct{}
+
+func (tester *DefaultRewardPolicyReplayTester) Test(t *testing.T) {
+	a := assert.New(t)
+
+	// blocks are signed and kept for replaying.
+	d := NewDandelion(nil)
+	d.SignBlocks(true)
+	d.KeepBlocks(true)
+	d.SetFeatureHeight(constants.FeatureStateRoot, 0)
+	a.NoError(d.Start())
+	defer func() {
+		_ = d.Stop()
+	}()
+	a.NoError(d.CreateAndFund("actor", 3, 100000 * constants.COSTokenDecimals, constants.DefaultAccountCreateFee))
+	acc0, acc1 := d.Account("actor0"), d.Account("actor1")
+	registerBlockProducer(d.Account("actor2"), t)
+	SelfTransferToVesting([]*DandelionAccount{acc0, acc1}, 1000, t)
+
+	const POST = 1
+	a.NoError(acc0.SendTrxAndProduceBlock(Post(POST, acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(acc1.SendTrxAndProduceBlock(Vote(acc1.Name, POST)))
+	a.NoError(d.ProduceBlocks(constants.PostCashOutDelayBlock))
+	a.NotZero(d.Post(POST).GetRewards().Value)
+
+	root, err := d.TrxPool().GetStateRoot()
+	a.NoError(err)
+
+	r := NewDandelion(nil)
+	r.SignBlocks(true)
+	r.SetFeatureHeight(constants.FeatureStateRoot, 0)
+	r.SetGenesisTime(d.GenesisTime())
+	a.NoError(r.Start())
+	defer func() {
+		_ = r.Stop()
+	}()
+	r.TrxPool().(*app.TrxPool).SetRewardPolicy(app.NewRewardPolicy(prototype.DefaultRewardPolicy()))
+	for _, b := range d.Blocks()[1:] {
+		if !a.NoError(r.PushSignedBlock(b), "block %d", b.Id().BlockNum()) {
+			break
+		}
+	}
+	rroot, err := r.TrxPool().GetStateRoot()
+	a.NoError(err)
+	a.Equal(root, rroot)
+	a.Equal(d.Post(POST).GetRewards().Value, r.Post(POST).GetRewards().Value)
+	a.Equal(d.GlobalProps().GetAnnualMinted().Value, r.GlobalProps().GetAnnualMinted().Value)
+}
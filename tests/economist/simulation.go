@@ -0,0 +1,63 @@
+package economist
+
+import (
+	"github.com/coschain/contentos-go/app"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/iservices"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type SimulationTester struct {
+	acc0,acc1,acc2 *DandelionAccount
+}
+
+func (tester *SimulationTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+
+	registerBlockProducer(tester.acc2, t)
+
+	const VEST = 1000
+	SelfTransferToVesting([]*DandelionAccount{tester.acc0, tester.acc1}, VEST, t)
+
+	t.Run("cashout", d.Test(tester.cashout))
+}
+
+func (tester *SimulationTester) cashout(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 1
+
+	pool := d.TrxPool().(*app.TrxPool)
+	policy := prototype.DefaultRewardPolicy()
+	policy.Weighting = constants.RewardWeightingQuadratic
+	current := app.NewRewardSimulation(nil, pool.HardFork, nil)
+	alternative := app.NewRewardSimulation(app.NewRewardPolicy(policy), pool.HardFork, nil)
+	pool.SetEconomistHook(func(db iservices.IDatabaseService) {
+		current.Simulate(db)
+		alternative.Simulate(db)
+	})
+	defer pool.SetEconomistHook(nil)
+
+	vest0 := d.Account(tester.acc0.Name).GetVest().Value
+	vest1 := d.Account(tester.acc1.Name).GetVest().Value
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(Vote(tester.acc1.Name, POST)))
+	a.NoError(d.ProduceBlocks(constants.PostCashOutDelayBlock))
+	a.NotEqual(uint64(0), d.Post(POST).GetRewards().Value)
+
+	// simulations never change the chain, and the one of current policy matches the chain.
+	reward0 := d.Account(tester.acc0.Name).GetVest().Value - vest0
+	reward1 := d.Account(tester.acc1.Name).GetVest().Value - vest1
+	a.Equal(d.Post(POST).GetRewards().Value, reward0)
+	a.Equal(reward0, current.Rewards()[tester.acc0.Name].Uint64())
+	a.Equal(reward1, current.Rewards()[tester.acc1.Name].Uint64())
+	a.Equal(uint64(constants.PostCashOutDelayBlock + 2), current.Blocks())
+
+	a.NotEqual(reward0, alternative.Rewards()[tester.acc0.Name].Uint64())
+	a.Equal(current.Blocks(), alternative.Blocks())
+}
@@ -9,4 +9,19 @@ import (
 func stakeSelf( user *dandelion.DandelionAccount, t *testing.T )  {
 	a := assert.New(t)
 	a.NoError( user.SendTrxAndProduceBlock( dandelion.Stake(user.Name, user.Name, 1) ) )
-}
\ No newline at end of file
+}
+
+// timedTransferTester is the shared setup of testers for time bound transfers, e.g. scheduled transfers and escrows.
+type timedTransferTester struct {
+	acc0, acc1, acc2 *dandelion.DandelionAccount
+}
+
+func (tester *timedTransferTester) init(d *dandelion.Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+}
+
+func (tester *timedTransferTester) headTime(d *dandelion.Dandelion) uint32 {
+	return d.GlobalProps().GetTime().GetUtcSeconds()
+}
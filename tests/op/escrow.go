@@ -0,0 +1,186 @@
+package op
+
+import (
+	"context"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/coschain/contentos-go/rpc"
+	"github.com/coschain/contentos-go/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type EscrowTester struct {
+	timedTransferTester
+}
+
+func (tester *EscrowTester) Test(t *testing.T, d *Dandelion) {
+	tester.init(d)
+
+	t.Run("normal", d.Test(tester.normal))
+	t.Run("reject", d.Test(tester.reject))
+	t.Run("dispute", d.Test(tester.dispute))
+	t.Run("reclaim", d.Test(tester.reclaim))
+	t.Run("list", d.Test(tester.list))
+}
+
+func (tester *EscrowTester) TestInactive(t *testing.T, d *Dandelion) {
+	now := tester.headTime(d)
+	d.TestFeatureInactive(t, constants.FeatureEscrow, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(EscrowTransfer("actor0", "actor1", "actor2", 100, 5, "", now + 100, now + 1000))
+	})
+}
+
+// newEscrow creates an escrow from actor0 to actor1 with actor2 as the agent, and returns its id.
+func (tester *EscrowTester) newEscrow(t *testing.T, d *Dandelion, amount, fee uint64, deadline, expiration uint32) uint64 {
+	a := assert.New(t)
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(EscrowTransfer(tester.acc0.Name, tester.acc1.Name, tester.acc2.Name, amount, fee, "", deadline, expiration)))
+	escrowId := d.CurrentRecordID()
+	a.True(d.Escrow(escrowId).CheckExist())
+	return escrowId
+}
+
+func (tester *EscrowTester) normal(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+	balance2 := tester.acc2.GetBalance().Value
+
+	now := tester.headTime(d)
+	escrowId := tester.newEscrow(t, d, 100, 5, now + 100, now + 1000)
+	a.Equal(balance0 - 105, tester.acc0.GetBalance().Value)
+
+	// funds can't move before ratification
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc1.Name, 10)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowApprove(tester.acc0.Name, escrowId, true)))
+
+	// the agent is paid after both receiver and agent approve
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(EscrowApprove(tester.acc1.Name, escrowId, true)))
+	a.Error(tester.acc1.SendTrxAndProduceBlock(EscrowApprove(tester.acc1.Name, escrowId, true)))
+	a.Equal(balance2, tester.acc2.GetBalance().Value)
+	a.NoError(tester.acc2.SendTrxAndProduceBlock(EscrowApprove(tester.acc2.Name, escrowId, true)))
+	a.Equal(balance2 + 5, tester.acc2.GetBalance().Value)
+
+	// before expiration, funds only go to the counterparty
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc0.Name, 10)))
+	a.Error(tester.acc2.SendTrxAndProduceBlock(EscrowRelease(tester.acc2.Name, escrowId, tester.acc1.Name, 10)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc1.Name, 101)))
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc1.Name, 60)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(EscrowRelease(tester.acc1.Name, escrowId, tester.acc0.Name, 10)))
+	a.Equal(uint64(30), d.Escrow(escrowId).GetAmount().Value)
+	a.Equal(balance1 + 60, tester.acc1.GetBalance().Value)
+
+	// the escrow is removed when drained
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc1.Name, 30)))
+	a.False(d.Escrow(escrowId).CheckExist())
+	a.Equal(balance0 - 95, tester.acc0.GetBalance().Value)
+	a.Equal(balance1 + 90, tester.acc1.GetBalance().Value)
+}
+
+func (tester *EscrowTester) reject(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	now := tester.headTime(d)
+	escrowId := tester.newEscrow(t, d, 100, 5, now + 100, now + 1000)
+
+	// a rejection refunds everything
+	a.NoError(tester.acc2.SendTrxAndProduceBlock(EscrowApprove(tester.acc2.Name, escrowId, false)))
+	a.False(d.Escrow(escrowId).CheckExist())
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+}
+
+func (tester *EscrowTester) dispute(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+	now := tester.headTime(d)
+	escrowId := tester.newEscrow(t, d, 100, 0, now + 100, now + 1000)
+
+	// disputes need ratification
+	a.Error(tester.acc1.SendTrxAndProduceBlock(EscrowDispute(tester.acc1.Name, escrowId)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(EscrowApprove(tester.acc1.Name, escrowId, true)))
+	a.NoError(tester.acc2.SendTrxAndProduceBlock(EscrowApprove(tester.acc2.Name, escrowId, true)))
+
+	// only parties can dispute, and only once
+	a.Error(tester.acc2.SendTrxAndProduceBlock(EscrowDispute(tester.acc2.Name, escrowId)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(EscrowDispute(tester.acc1.Name, escrowId)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowDispute(tester.acc0.Name, escrowId)))
+	a.True(d.Escrow(escrowId).GetDisputed())
+
+	// the agent arbitrates a disputed escrow
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc1.Name, 10)))
+	a.NoError(tester.acc2.SendTrxAndProduceBlock(EscrowRelease(tester.acc2.Name, escrowId, tester.acc0.Name, 70)))
+	a.NoError(tester.acc2.SendTrxAndProduceBlock(EscrowRelease(tester.acc2.Name, escrowId, tester.acc1.Name, 30)))
+	a.False(d.Escrow(escrowId).CheckExist())
+	a.Equal(balance0 - 30, tester.acc0.GetBalance().Value)
+	a.Equal(balance1 + 30, tester.acc1.GetBalance().Value)
+}
+
+func (tester *EscrowTester) reclaim(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	now := tester.headTime(d)
+	deadline := now + 3 * constants.BlockInterval
+	escrowId := tester.newEscrow(t, d, 100, 5, deadline, now + 1000)
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(EscrowApprove(tester.acc1.Name, escrowId, true)))
+
+	// an unratified escrow can't be reclaimed before the deadline
+	a.Error(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc0.Name, 100)))
+	for tester.headTime(d) < deadline {
+		a.NoError(d.ProduceBlocks(1))
+	}
+	// nor approved after the deadline
+	a.Error(tester.acc2.SendTrxAndProduceBlock(EscrowApprove(tester.acc2.Name, escrowId, true)))
+
+	// the sender takes back both the amount and agent fee
+	a.Error(tester.acc1.SendTrxAndProduceBlock(EscrowRelease(tester.acc1.Name, escrowId, tester.acc1.Name, 100)))
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(EscrowRelease(tester.acc0.Name, escrowId, tester.acc0.Name, 100)))
+	a.False(d.Escrow(escrowId).CheckExist())
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+}
+
+func (tester *EscrowTester) list(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	api := rpc.NewAPIService(nil, nil, d.Database(), nil)
+
+	now := tester.headTime(d)
+	escrow1 := tester.newEscrow(t, d, 10, 0, now + 100, now + 1000)
+	escrow2 := tester.newEscrow(t, d, 20, 0, now + 100, now + 1000)
+
+	listIds := func(account string, party grpcpb.EscrowParty, limit uint32, last uint64) (ids []uint64) {
+		resp, err := api.GetEscrowList(context.Background(), &grpcpb.GetEscrowListRequest{
+			Account: prototype.NewAccountName(account),
+			Party: party,
+			Limit: limit,
+			LastEscrowId: last,
+		})
+		a.NoError(err)
+		for _, e := range resp.Escrows {
+			ids = append(ids, e.Id)
+		}
+		return
+	}
+
+	// escrows are listed in reverse order by any party
+	a.Equal([]uint64{escrow2, escrow1}, listIds(tester.acc0.Name, grpcpb.EscrowParty_ESCROW_FROM, 10, 0))
+	a.Equal([]uint64{escrow2, escrow1}, listIds(tester.acc1.Name, grpcpb.EscrowParty_ESCROW_TO, 10, 0))
+	a.Equal([]uint64{escrow2, escrow1}, listIds(tester.acc2.Name, grpcpb.EscrowParty_ESCROW_AGENT, 10, 0))
+	a.Empty(listIds(tester.acc1.Name, grpcpb.EscrowParty_ESCROW_FROM, 10, 0))
+
+	// and paged by the last escrow id
+	a.Equal([]uint64{escrow2}, listIds(tester.acc0.Name, grpcpb.EscrowParty_ESCROW_FROM, 1, 0))
+	a.Equal([]uint64{escrow1}, listIds(tester.acc0.Name, grpcpb.EscrowParty_ESCROW_FROM, 1, escrow2))
+	a.Empty(listIds(tester.acc0.Name, grpcpb.EscrowParty_ESCROW_FROM, 1, escrow1))
+
+	_, err := api.GetEscrowList(context.Background(), &grpcpb.GetEscrowListRequest{
+		Account: prototype.NewAccountName(tester.acc0.Name),
+		Party: grpcpb.EscrowParty(100),
+		Limit: 10,
+	})
+	a.Error(err)
+}
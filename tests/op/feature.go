@@ -0,0 +1,56 @@
+package op
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const featureTestVestDelegationHeight = 50
+
+type FeatureTester struct {
+	acc0, acc1 *DandelionAccount
+}
+
+func (tester *FeatureTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+
+	t.Run("schedule", d.Test(tester.schedule))
+	t.Run("unknown", d.Test(tester.unknown))
+}
+
+func (tester *FeatureTester) schedule(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	var height uint64
+	for _, f := range d.TrxPool().Features() {
+		if f.Id == constants.FeatureVestDelegation {
+			height = f.Height
+		}
+	}
+	a.Equal(uint64(featureTestVestDelegationHeight), height)
+
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(TransferToVest(tester.acc0.Name, tester.acc0.Name, 10000000000, "")))
+	opDelegation := DelegateVest(tester.acc0.Name, tester.acc1.Name, constants.MinVestDelegationAmount, constants.MinVestDelegationInBlocks)
+
+	// delegation is refused before its activation height
+	a.False(d.TrxPool().FeatureActive(constants.FeatureVestDelegation))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(opDelegation))
+
+	a.NoError(d.ProduceBlocks(featureTestVestDelegationHeight - int(d.GlobalProps().GetHeadBlockNumber())))
+	a.True(d.TrxPool().FeatureActive(constants.FeatureVestDelegation))
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(opDelegation))
+
+	// other features keep the default schedule
+	a.False(d.TrxPool().FeatureActive(constants.FeatureForbidBadAccounts))
+}
+
+func (tester *FeatureTester) unknown(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	d2 := NewDandelion(nil)
+	d2.SetFeatureHeight("unknown_feature", 1)
+	a.Error(d2.Start())
+}
@@ -0,0 +1,194 @@
+package op
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type MultiSigTester struct {
+	acc0, acc1, acc2 *DandelionAccount
+	keys             []*prototype.PrivateKeyType
+}
+
+func (tester *MultiSigTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+
+	t.Run("set authority", d.Test(tester.setAuthority))
+	t.Run("insufficient weight", d.Test(tester.insufficientWeight))
+	t.Run("enough weight", d.Test(tester.enoughWeight))
+	t.Run("signature replay", d.Test(tester.signatureReplay))
+	t.Run("unknown co-signer", d.Test(tester.unknownCoSigner))
+	t.Run("invalid authority", d.Test(tester.invalidAuthority))
+	t.Run("revert to single key", d.Test(tester.revertToSingleKey))
+}
+
+func (tester *MultiSigTester) TestInactive(t *testing.T, d *Dandelion) {
+	priv, _ := prototype.GenerateNewKey()
+	pub, _ := priv.PubKey()
+
+	d.TestFeatureInactive(t, constants.FeatureMultiSig, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(AccountUpdateWithAuthority("actor0", pub, prototype.NewSingleKeyAuthority(pub)))
+	})
+	d.TestFeatureInactive(t, constants.FeatureMultiSig, func() error {
+		return d.SendMultiSigTrx([]*prototype.PrivateKeyType{d.GetAccountKey("actor0"), priv}, Transfer("actor0", "actor1", 10, ""))
+	})
+}
+
+// setAuthority makes actor0 a 2-of-3 account: key0 weighs 2, key1 and key2 weigh 1 each, threshold is 2.
+func (tester *MultiSigTester) setAuthority(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	authority := &prototype.Authority{WeightThreshold: 2}
+	for i := 0; i < 3; i++ {
+		priv, _ := prototype.GenerateNewKey()
+		pub, _ := priv.PubKey()
+		tester.keys = append(tester.keys, priv)
+		weight := uint32(1)
+		if i == 0 {
+			weight = 2
+		}
+		authority.KeyAuths = append(authority.KeyAuths, &prototype.KeyWeight{Key: pub, Weight: weight})
+	}
+	pub0, _ := tester.keys[0].PubKey()
+	a.NoError(tester.acc0.SendTrx(AccountUpdateWithAuthority(tester.acc0.Name, pub0, authority)))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(authority.WeightThreshold, tester.acc0.GetAuthority().GetWeightThreshold())
+	a.Equal(len(authority.KeyAuths), len(tester.acc0.GetAuthority().GetKeyAuths()))
+	d.PutAccount(tester.acc0.Name, tester.keys[0])
+}
+
+func (tester *MultiSigTester) insufficientWeight(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+
+	// key1 alone weighs 1 < 2
+	a.Error(d.SendMultiSigTrx([]*prototype.PrivateKeyType{tester.keys[1]}, Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+	a.Equal(balance1, tester.acc1.GetBalance().Value)
+}
+
+func (tester *MultiSigTester) enoughWeight(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+	var amount uint64 = 10
+
+	// key0 alone weighs 2
+	a.NoError(tester.acc0.SendTrx(Transfer(tester.acc0.Name, tester.acc1.Name, amount, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-amount, tester.acc0.GetBalance().Value)
+	a.Equal(balance1+amount, tester.acc1.GetBalance().Value)
+
+	// key1 + key2 weigh 2
+	receipt, err := d.SendMultiSigTrxEx(tester.keys[1:], Transfer(tester.acc0.Name, tester.acc1.Name, amount, ""))
+	a.NoError(err)
+	a.True(receipt.IsSuccess())
+	a.Equal(balance0-amount*2, tester.acc0.GetBalance().Value)
+	a.Equal(balance1+amount*2, tester.acc1.GetBalance().Value)
+}
+
+// signatureReplay resends a packed transfer under other signature sets of the same authority.
+func (tester *MultiSigTester) signatureReplay(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+	var amount uint64 = 10
+
+	trx, err := d.NewMultiSignedTransaction(tester.keys[1:], Transfer(tester.acc0.Name, tester.acc1.Name, amount, ""))
+	a.NoError(err)
+	// co-signatures reordered
+	reordered := &prototype.SignedTransaction{
+		Trx:        trx.Trx,
+		Signature:  trx.Signatures[0],
+		Signatures: []*prototype.SignatureType{trx.Signature},
+	}
+	// key0 alone also weighs 2
+	resigned := &prototype.SignedTransaction{Trx: trx.Trx, Signature: new(prototype.SignatureType)}
+	resigned.Signature.Sig = resigned.Sign(tester.keys[0], d.ChainId())
+
+	_, err = d.SendRawTrx(trx)
+	a.NoError(err)
+	_, err = d.SendRawTrx(reordered)
+	a.Error(err)
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-amount, tester.acc0.GetBalance().Value)
+
+	_, err = d.SendRawTrx(reordered)
+	a.Error(err)
+	_, err = d.SendRawTrx(resigned)
+	a.Error(err)
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-amount, tester.acc0.GetBalance().Value)
+}
+
+func (tester *MultiSigTester) unknownCoSigner(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+
+	// key1 plus a key out of authority still weighs 1
+	a.Error(d.SendMultiSigTrx(
+		[]*prototype.PrivateKeyType{tester.keys[1], d.GetAccountKey(tester.acc2.Name)},
+		Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+
+	// duplicated signatures are refused
+	a.Error(d.SendMultiSigTrx(
+		[]*prototype.PrivateKeyType{tester.keys[1], tester.keys[1]},
+		Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+}
+
+func (tester *MultiSigTester) invalidAuthority(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	priv, _ := prototype.GenerateNewKey()
+	pub, _ := priv.PubKey()
+	oldPub := tester.acc1.GetPubKey()
+
+	// threshold unreachable
+	a.Error(tester.acc1.SendTrx(AccountUpdateWithAuthority(tester.acc1.Name, pub, &prototype.Authority{
+		WeightThreshold: 3,
+		KeyAuths:        []*prototype.KeyWeight{{Key: pub, Weight: 2}},
+	})))
+	// duplicated keys
+	a.Error(tester.acc1.SendTrx(AccountUpdateWithAuthority(tester.acc1.Name, pub, &prototype.Authority{
+		WeightThreshold: 1,
+		KeyAuths:        []*prototype.KeyWeight{{Key: pub, Weight: 1}, {Key: pub, Weight: 1}},
+	})))
+	// zero threshold
+	a.Error(tester.acc1.SendTrx(AccountUpdateWithAuthority(tester.acc1.Name, pub, &prototype.Authority{
+		KeyAuths: []*prototype.KeyWeight{{Key: pub, Weight: 1}},
+	})))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(oldPub.Data, tester.acc1.GetPubKey().Data)
+	a.Nil(tester.acc1.GetAuthority())
+}
+
+func (tester *MultiSigTester) revertToSingleKey(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	priv, _ := prototype.GenerateNewKey()
+	pub, _ := priv.PubKey()
+
+	// the update itself needs enough weight
+	receipt, err := d.SendMultiSigTrxEx(tester.keys[1:], AccountUpdate(tester.acc0.Name, pub))
+	a.NoError(err)
+	a.True(receipt.IsSuccess())
+	a.Nil(tester.acc0.GetAuthority())
+	d.PutAccount(tester.acc0.Name, priv)
+
+	// old authority keys no longer work
+	balance0 := tester.acc0.GetBalance().Value
+	a.Error(d.SendMultiSigTrx(tester.keys, Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+
+	// the new single key works
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.Equal(balance0-10, tester.acc0.GetBalance().Value)
+}
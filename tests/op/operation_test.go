@@ -9,6 +9,21 @@ import (
 
 func TestOperations(t *testing.T) {
 	t.Run("transfer", dandelion.NewDandelionTest(new(TransferTester).Test, 3))
+	t.Run("scheduled transfer", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeatureScheduledTransfer: 0,
+	}, new(ScheduledTransferTester).Test, 3))
+	t.Run("scheduled transfer inactive", dandelion.NewDandelionTest(new(ScheduledTransferTester).TestInactive, 3))
+	t.Run("escrow", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeatureEscrow: 0,
+	}, new(EscrowTester).Test, 3))
+	t.Run("escrow inactive", dandelion.NewDandelionTest(new(EscrowTester).TestInactive, 3))
+	t.Run("proposal", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeatureGovernance: 0,
+	}, new(ProposalTester).Test, 3))
+	t.Run("proposal inactive", dandelion.NewDandelionTest(new(ProposalTester).TestInactive, 3))
+	t.Run("feature", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeatureVestDelegation: featureTestVestDelegationHeight,
+	}, new(FeatureTester).Test, 3))
 	t.Run("bp", dandelion.NewDandelionTest(new(BpTest).TestNormal, 0))
 	t.Run("bp", dandelion.NewDandelionTest(new(BpTest).TestDuplicate, 0))
 	t.Run("bp", dandelion.NewDandelionTest(new(BpTest).TestGlobalProperty, 0))
@@ -27,12 +42,24 @@ func TestOperations(t *testing.T) {
 	t.Run("convert vest", dandelion.NewDandelionTest(new(ConvertVestTester).TestHardFork2Clear, 7))
 	t.Run("convert vest", dandelion.NewDandelionTest(new(ConvertVestTester).TestHardFork2DoNothing, 7))
 	t.Run("update account", dandelion.NewDandelionTest(new(AccountUpdateTester).Test, 3))
+	t.Run("multisig", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeatureMultiSig: 0,
+	}, new(MultiSigTester).Test, 3))
+	t.Run("multisig inactive", dandelion.NewDandelionTest(new(MultiSigTester).TestInactive, 3))
+	t.Run("permission", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeaturePermissionLevels: 0,
+	}, new(PermissionTester).Test, 3))
+	t.Run("permission inactive", dandelion.NewDandelionTest(new(PermissionTester).TestInactive, 3))
 	t.Run("stake", dandelion.NewDandelionTest(new(StakeTester).Test, 3))
 	t.Run("unStake", dandelion.NewDandelionTest(new(UnStakeTester).Test, 3))
 	t.Run("ticket", dandelion.NewDandelionTest(new(TicketTester).Test, 3))
 	t.Run("ticket_bp_bonus", dandelion.NewDandelionTest(new(TicketBpBonusTester).Test, TicketBpBonusActors))
 	t.Run("post", dandelion.NewDandelionTest(new(PostTest).Test, 3))
 	t.Run("reply", dandelion.NewDandelionTest(new(ReplyTest).Test, 3))
+	t.Run("post edit", dandelion.NewDandelionTestWithFeatures(map[string]uint64{
+		constants.FeaturePostEdit: 0,
+	}, new(PostEditTester).Test, 3))
+	t.Run("post edit inactive", dandelion.NewDandelionTest(new(PostEditTester).TestInactive, 3))
 	t.Run("reputation", NewDandelionContractTest(new(ReputationTester).Test, 8, "actor0.reputation"))
 	t.Run("copyright", NewDandelionContractTest(new(CopyrightTester).Test, 7, "actor0.copyright"))
 	t.Run("freeze", NewDandelionContractTest(new(FreezeTester).Test, 7, "actor0.freeze"))
@@ -0,0 +1,134 @@
+package op
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type PermissionTester struct {
+	acc0, acc1, acc2       *DandelionAccount
+	owner, active, posting *prototype.PrivateKeyType
+}
+
+func (tester *PermissionTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+
+	t.Run("set permissions", d.Test(tester.setPermissions))
+	t.Run("posting key", d.Test(tester.postingKey))
+	t.Run("active key", d.Test(tester.activeKey))
+	t.Run("owner key", d.Test(tester.ownerKey))
+	t.Run("mixed permissions", d.Test(tester.mixedPermissions))
+	t.Run("inherited permissions", d.Test(tester.inheritedPermissions))
+}
+
+func (tester *PermissionTester) TestInactive(t *testing.T, d *Dandelion) {
+	priv, _ := prototype.GenerateNewKey()
+	pub, _ := priv.PubKey()
+	ownerPub := d.Account("actor0").GetPubKey()
+
+	d.TestFeatureInactive(t, constants.FeaturePermissionLevels, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(AccountUpdateWithAuthorities("actor0", ownerPub, nil,
+			prototype.NewSingleKeyAuthority(pub), nil))
+	})
+}
+
+func (tester *PermissionTester) setPermissions(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	tester.owner, _ = prototype.GenerateNewKey()
+	tester.active, _ = prototype.GenerateNewKey()
+	tester.posting, _ = prototype.GenerateNewKey()
+	ownerPub, _ := tester.owner.PubKey()
+	activePub, _ := tester.active.PubKey()
+	postingPub, _ := tester.posting.PubKey()
+
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(AccountUpdateWithAuthorities(tester.acc0.Name, ownerPub, nil,
+		prototype.NewSingleKeyAuthority(activePub), prototype.NewSingleKeyAuthority(postingPub))))
+	a.Equal(ownerPub.Data, tester.acc0.GetPubKey().Data)
+	a.Nil(tester.acc0.GetAuthority())
+	a.NotNil(tester.acc0.GetActiveAuthority())
+	a.NotNil(tester.acc0.GetPostingAuthority())
+}
+
+func (tester *PermissionTester) postingKey(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+
+	// posting key is able to follow
+	a.NoError(d.SendTrx(tester.posting, Follow(tester.acc0.Name, tester.acc1.Name, false)))
+	// but not able to move tokens
+	a.Error(d.SendTrx(tester.posting, Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.Error(d.SendTrx(tester.posting, TransferToVest(tester.acc0.Name, tester.acc0.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+}
+
+func (tester *PermissionTester) activeKey(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+
+	// active key is able to move tokens and to do social activities
+	a.NoError(d.SendTrx(tester.active, Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.SendTrx(tester.active, Follow(tester.acc0.Name, tester.acc2.Name, false)))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-10, tester.acc0.GetBalance().Value)
+	a.Equal(balance1+10, tester.acc1.GetBalance().Value)
+
+	// but not able to change authorities
+	priv, _ := prototype.GenerateNewKey()
+	pub, _ := priv.PubKey()
+	a.Error(d.SendTrx(tester.active, AccountUpdate(tester.acc0.Name, pub)))
+	a.NoError(d.ProduceBlocks(1))
+	ownerPub, _ := tester.owner.PubKey()
+	a.Equal(ownerPub.Data, tester.acc0.GetPubKey().Data)
+}
+
+func (tester *PermissionTester) ownerKey(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+
+	// owner key is able to sign anything
+	a.NoError(d.SendTrx(tester.owner, Follow(tester.acc0.Name, tester.acc1.Name, true)))
+	a.NoError(d.SendTrx(tester.owner, Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-10, tester.acc0.GetBalance().Value)
+}
+
+func (tester *PermissionTester) mixedPermissions(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+	balance0 := tester.acc0.GetBalance().Value
+
+	// a transaction requires the highest permission level of its operations
+	a.Error(d.SendTrx(tester.posting,
+		Follow(tester.acc0.Name, tester.acc2.Name, true),
+		Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+
+	a.NoError(d.SendTrx(tester.active,
+		Follow(tester.acc0.Name, tester.acc2.Name, true),
+		Transfer(tester.acc0.Name, tester.acc1.Name, 10, "")))
+	a.NoError(d.ProduceBlocks(1))
+	a.Equal(balance0-10, tester.acc0.GetBalance().Value)
+}
+
+func (tester *PermissionTester) inheritedPermissions(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	// drop the posting authority, posting permission falls back to the active one.
+	ownerPub, _ := tester.owner.PubKey()
+	activePub, _ := tester.active.PubKey()
+	a.NoError(d.SendTrx(tester.owner, AccountUpdateWithAuthorities(tester.acc0.Name, ownerPub, nil,
+		prototype.NewSingleKeyAuthority(activePub), nil)))
+	a.NoError(d.ProduceBlocks(1))
+	a.Nil(tester.acc0.GetPostingAuthority())
+
+	a.Error(d.SendTrx(tester.posting, Follow(tester.acc0.Name, tester.acc1.Name, false)))
+	a.NoError(d.SendTrx(tester.active, Follow(tester.acc0.Name, tester.acc1.Name, false)))
+	a.NoError(d.ProduceBlocks(1))
+}
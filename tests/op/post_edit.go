@@ -0,0 +1,200 @@
+package op
+
+import (
+	"fmt"
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type PostEditTester struct {
+	acc0, acc1, acc2 *DandelionAccount
+}
+
+func (tester *PostEditTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+	tester.acc1 = d.Account("actor1")
+	tester.acc2 = d.Account("actor2")
+
+	t.Run("edit", d.Test(tester.edit))
+	t.Run("patch", d.Test(tester.patch))
+	t.Run("not author", d.Test(tester.notAuthor))
+	t.Run("delete", d.Test(tester.delete))
+	t.Run("delete voted", d.Test(tester.deleteVoted))
+	t.Run("delete reply", d.Test(tester.deleteReply))
+	t.Run("after cashout", d.Test(tester.afterCashout))
+	t.Run("revision cap", d.Test(tester.revisionCap))
+}
+
+func (tester *PostEditTester) TestInactive(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 1
+	a.NoError(d.Account("actor0").SendTrxAndProduceBlock(Post(POST, "actor0", "title", "content", []string{"1"}, nil)))
+	d.TestFeatureInactive(t, constants.FeaturePostEdit, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(PostEdit(POST, "actor0", "", "new content"))
+	})
+	d.TestFeatureInactive(t, constants.FeaturePostEdit, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(PostDelete(POST, "actor0"))
+	})
+	a.Equal("content", d.Post(POST).GetBody())
+}
+
+func (tester *PostEditTester) edit(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 1
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	created := d.Post(POST).GetCreated()
+
+	editTime := d.GlobalProps().Time
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "", "content v1")))
+	a.Equal("title", d.Post(POST).GetTitle())
+	a.Equal("content v1", d.Post(POST).GetBody())
+	a.Equal(uint32(1), d.Post(POST).GetVersion())
+	a.Equal(editTime.UtcSeconds, d.Post(POST).GetLastEdited().UtcSeconds)
+
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "title v2", "")))
+	a.Equal("title v2", d.Post(POST).GetTitle())
+	a.Equal("content v1", d.Post(POST).GetBody())
+	a.Equal(uint32(2), d.Post(POST).GetVersion())
+
+	// nothing changed
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "title v2", "content v1")))
+	a.Equal(uint32(2), d.Post(POST).GetVersion())
+
+	// previous versions are kept as revisions
+	rev0, rev1 := d.PostRevision(POST, 0), d.PostRevision(POST, 1)
+	a.True(rev0.CheckExist())
+	a.Equal("title", rev0.GetTitle())
+	a.Equal("content", rev0.GetBody())
+	a.Equal(created.UtcSeconds, rev0.GetCreated().UtcSeconds)
+	a.True(rev1.CheckExist())
+	a.Equal("title", rev1.GetTitle())
+	a.Equal("content v1", rev1.GetBody())
+	a.False(d.PostRevision(POST, 2).CheckExist())
+}
+
+func (tester *PostEditTester) patch(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 2
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "hello world", []string{"1"}, nil)))
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(PostPatch(POST, tester.acc0.Name,
+		&prototype.BodyPatch{Offset: 6, DeleteLen: 5, Insert: "there"},
+		&prototype.BodyPatch{Offset: 0, DeleteLen: 0, Insert: "oh, "},
+	)))
+	a.Equal("oh, hello there", d.Post(POST).GetBody())
+	a.Equal(uint32(1), d.Post(POST).GetVersion())
+	a.Equal("hello world", d.PostRevision(POST, 0).GetBody())
+
+	// out of range
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostPatch(POST, tester.acc0.Name,
+		&prototype.BodyPatch{Offset: 10, DeleteLen: 10, Insert: "x"},
+	)))
+	// empty body
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostPatch(POST, tester.acc0.Name,
+		&prototype.BodyPatch{Offset: 0, DeleteLen: 15},
+	)))
+	a.Equal("oh, hello there", d.Post(POST).GetBody())
+}
+
+func (tester *PostEditTester) notAuthor(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 3
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.Error(tester.acc1.SendTrxAndProduceBlock(PostEdit(POST, tester.acc1.Name, "", "hacked")))
+	a.Error(tester.acc1.SendTrxAndProduceBlock(PostDelete(POST, tester.acc1.Name)))
+	a.Equal("content", d.Post(POST).GetBody())
+	a.False(d.Post(POST).GetDeleted())
+}
+
+func (tester *PostEditTester) delete(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 4
+	const REPLY = 5
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(PostDelete(POST, tester.acc0.Name)))
+	a.True(d.Post(POST).GetDeleted())
+	a.Equal("", d.Post(POST).GetTitle())
+	a.Equal("", d.Post(POST).GetBody())
+	a.Equal(uint32(1), d.Post(POST).GetVersion())
+	a.Equal("content", d.PostRevision(POST, 0).GetBody())
+
+	// deleted posts can't be voted, replied, edited or deleted again
+	a.Error(tester.acc1.SendTrxAndProduceBlock(Vote(tester.acc1.Name, POST)))
+	a.Error(tester.acc1.SendTrxAndProduceBlock(Reply(REPLY, POST, tester.acc1.Name, "reply", nil)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "", "content")))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostDelete(POST, tester.acc0.Name)))
+}
+
+func (tester *PostEditTester) deleteVoted(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 6
+	const REPLY = 7
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(Vote(tester.acc1.Name, POST)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostDelete(POST, tester.acc0.Name)))
+	a.False(d.Post(POST).GetDeleted())
+
+	// voted posts can still be edited
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "", "content v1")))
+
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(Reply(REPLY, POST, tester.acc1.Name, "reply", nil)))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostDelete(POST, tester.acc0.Name)))
+	a.False(d.Post(POST).GetDeleted())
+}
+
+func (tester *PostEditTester) deleteReply(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 8
+	const REPLY = 9
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(Reply(REPLY, POST, tester.acc1.Name, "reply", nil)))
+	a.Equal(uint32(1), d.Post(POST).GetChildren())
+
+	// replies have no titles
+	a.Error(tester.acc1.SendTrxAndProduceBlock(PostEdit(REPLY, tester.acc1.Name, "title", "")))
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(PostEdit(REPLY, tester.acc1.Name, "", "reply v1")))
+
+	a.NoError(tester.acc1.SendTrxAndProduceBlock(PostDelete(REPLY, tester.acc1.Name)))
+	a.True(d.Post(REPLY).GetDeleted())
+	a.Equal(uint32(0), d.Post(POST).GetChildren())
+	a.Equal(uint32(2), d.Post(REPLY).GetVersion())
+}
+
+func (tester *PostEditTester) afterCashout(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 10
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	a.NoError(d.ProduceBlocks(constants.PostCashOutDelayBlock))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "", "content v1")))
+	a.Error(tester.acc0.SendTrxAndProduceBlock(PostDelete(POST, tester.acc0.Name)))
+	a.Equal("content", d.Post(POST).GetBody())
+	a.False(d.Post(POST).GetDeleted())
+}
+
+func (tester *PostEditTester) revisionCap(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	const POST = 11
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(Post(POST, tester.acc0.Name, "title", "content", []string{"1"}, nil)))
+	edits := uint32(constants.MaxPostRevisions + 2)
+	for i := uint32(1); i <= edits; i++ {
+		a.NoError(tester.acc0.SendTrxAndProduceBlock(PostEdit(POST, tester.acc0.Name, "", fmt.Sprintf("content v%d", i))))
+	}
+	a.Equal(edits, d.Post(POST).GetVersion())
+
+	// only the latest revisions are kept
+	for v := uint32(0); v < edits; v++ {
+		a.Equal(v + constants.MaxPostRevisions >= edits, d.PostRevision(POST, v).CheckExist())
+	}
+	a.Equal(fmt.Sprintf("content v%d", edits - 1), d.PostRevision(POST, edits - 1).GetBody())
+}
@@ -0,0 +1,181 @@
+package op
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/coschain/contentos-go/prototype"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const proposalTestEpochDuration = 10
+
+type ProposalTester struct {
+	acc0, bp0, bp1 *DandelionAccount
+}
+
+func (tester *ProposalTester) Test(t *testing.T, d *Dandelion) {
+	tester.acc0 = d.Account("actor0")
+
+	t.Run("boot", d.Test(tester.boot))
+	t.Run("normal", d.Test(tester.normal))
+	t.Run("feature", d.Test(tester.feature))
+	t.Run("reward policy", d.Test(tester.rewardPolicy))
+	t.Run("disabled bp", d.Test(tester.disabledBp))
+}
+
+func (tester *ProposalTester) TestInactive(t *testing.T, d *Dandelion) {
+	activation := d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+	d.TestFeatureInactive(t, constants.FeatureGovernance, func() error {
+		return d.Account(constants.COSInitMiner).SendTrxAndProduceBlock(ProposalCreate(constants.COSInitMiner, "test", "", nil, constants.FeatureVestDelegation, activation))
+	})
+}
+
+// boot registers 2 block producers with a short epoch, and waits for them to take over block production.
+func (tester *ProposalTester) boot(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	makeBp("proposalbp0", t, d)
+	makeBp("proposalbp1", t, d)
+	tester.bp0 = d.Account("proposalbp0")
+	tester.bp1 = d.Account("proposalbp1")
+
+	props := makeBPChainProperty()
+	props.EpochDuration = proposalTestEpochDuration
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(BpUpdate(tester.bp0.Name, props)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(BpUpdate(tester.bp1.Name, props)))
+	a.NoError(d.Account(constants.COSInitMiner).SendTrxAndProduceBlock(BpUpdate(constants.COSInitMiner, props)))
+	a.NoError(d.ProduceBlocks(constants.MaxBlockProducerCount * 2))
+	a.True(d.GlobalProps().GetBlockProducerBootCompleted())
+	a.Equal(uint64(proposalTestEpochDuration), d.GlobalProps().GetEpochDuration())
+}
+
+func (tester *ProposalTester) newProposal(t *testing.T, d *Dandelion, props *prototype.ChainProperties, feature string, activation uint64) uint64 {
+	a := assert.New(t)
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(ProposalCreate(tester.bp0.Name, "test", "", props, feature, activation)))
+	proposalId := d.CurrentRecordID()
+	a.True(d.Proposal(proposalId).CheckExist())
+	return proposalId
+}
+
+func (tester *ProposalTester) normal(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	props := makeBPChainProperty()
+	props.EpochDuration = proposalTestEpochDuration
+	props.TpsExpected = constants.MinTPSExpected + 10
+	activation := d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+
+	// only block producers can create proposals
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ProposalCreate(tester.acc0.Name, "test", "", props, "", activation)))
+	// activation block must be in the future
+	a.Error(tester.bp0.SendTrxAndProduceBlock(ProposalCreate(tester.bp0.Name, "test", "", props, "", 1)))
+
+	approved := tester.newProposal(t, d, props, "", activation)
+	props.TpsExpected = constants.MinTPSExpected + 20
+	rejected := tester.newProposal(t, d, props, "", activation)
+
+	// only block producers can vote, and each vote only once
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ProposalVote(tester.acc0.Name, approved, true)))
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(ProposalVote(tester.bp0.Name, approved, true)))
+	a.Error(tester.bp0.SendTrxAndProduceBlock(ProposalVote(tester.bp0.Name, approved, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, approved, true)))
+	a.Equal([]string{tester.bp0.Name, tester.bp1.Name}, d.Proposal(approved).GetApprovals())
+
+	// an approval can be cancelled
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(ProposalVote(tester.bp0.Name, rejected, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, rejected, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, rejected, false)))
+	a.Error(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, rejected, false)))
+
+	// proposals are tallied at epoch boundaries
+	a.NoError(d.ProduceBlocks(proposalTestEpochDuration + 1))
+	a.Equal(uint32(constants.ProposalStatusApproved), d.Proposal(approved).GetStatus())
+	a.Equal(uint32(constants.ProposalStatusPending), d.Proposal(rejected).GetStatus())
+	a.Error(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, approved, false)))
+	a.False(d.GlobalProps().GetPropsGoverned())
+
+	// approved proposals take effect at the activation block
+	head := d.GlobalProps().GetHeadBlockNumber()
+	a.NoError(d.ProduceBlocks(int(activation - head)))
+	a.Equal(uint32(constants.ProposalStatusActivated), d.Proposal(approved).GetStatus())
+	a.Equal(uint32(constants.ProposalStatusRejected), d.Proposal(rejected).GetStatus())
+	a.True(d.GlobalProps().GetPropsGoverned())
+	a.Equal(uint64(constants.MinTPSExpected + 10), d.GlobalProps().GetTpsExpected())
+
+	// bp proposed values are no longer accepted
+	props.TpsExpected = constants.MinTPSExpected + 30
+	a.Error(tester.bp0.SendTrxAndProduceBlock(BpUpdate(tester.bp0.Name, props)))
+	a.Error(tester.bp1.SendTrxAndProduceBlock(BpUpdate(tester.bp1.Name, props)))
+	a.NoError(d.ProduceBlocks(constants.MaxBlockProducerCount * 2))
+	a.Equal(uint64(constants.MinTPSExpected + 10), d.GlobalProps().GetTpsExpected())
+}
+
+func (tester *ProposalTester) feature(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	activation := d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+	a.Error(tester.bp0.SendTrxAndProduceBlock(ProposalCreate(tester.bp0.Name, "test", "", nil, "", activation)))
+	a.Error(tester.bp0.SendTrxAndProduceBlock(ProposalCreate(tester.bp0.Name, "test", "", nil, "bad-feature", activation)))
+	a.Error(tester.bp0.SendTrxAndProduceBlock(ProposalCreate(tester.bp0.Name, "test", "", nil, "unknown_feature", activation)))
+
+	approved := tester.newProposal(t, d, nil, constants.FeatureVestDelegation, activation)
+	rejected := tester.newProposal(t, d, nil, constants.FeatureForbidBadAccounts, activation)
+	// a feature can't be proposed twice
+	a.Error(tester.bp1.SendTrxAndProduceBlock(ProposalCreate(tester.bp1.Name, "test", "", nil, constants.FeatureVestDelegation, activation)))
+
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(ProposalVote(tester.bp0.Name, approved, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, approved, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, rejected, true)))
+
+	head := d.GlobalProps().GetHeadBlockNumber()
+	a.NoError(d.ProduceBlocks(int(activation - head)))
+	a.Equal(uint32(constants.ProposalStatusActivated), d.Proposal(approved).GetStatus())
+	a.Equal(uint32(constants.ProposalStatusRejected), d.Proposal(rejected).GetStatus())
+	// activated features take effect regardless of their default schedule
+	a.True(d.TrxPool().FeatureActive(constants.FeatureVestDelegation))
+	a.False(d.TrxPool().FeatureActive(constants.FeatureForbidBadAccounts))
+
+	// rejected features can be proposed again
+	activation = d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+	a.Error(tester.bp1.SendTrxAndProduceBlock(ProposalCreate(tester.bp1.Name, "test", "", nil, constants.FeatureVestDelegation, activation)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalCreate(tester.bp1.Name, "test", "", nil, constants.FeatureForbidBadAccounts, activation)))
+}
+
+func (tester *ProposalTester) rewardPolicy(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	activation := d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+	policy := prototype.DefaultRewardPolicy()
+	policy.Weighting = "unknown"
+	a.Error(tester.bp0.SendTrxAndProduceBlock(RewardPolicyProposal(tester.bp0.Name, "test", "", policy, activation)))
+	policy.Weighting = constants.RewardWeightingQuadratic
+	policy.AuthorRate = 5000
+	a.Error(tester.bp0.SendTrxAndProduceBlock(RewardPolicyProposal(tester.bp0.Name, "test", "", policy, activation)))
+	policy.VoterRate = constants.PERCENT - policy.AuthorRate - policy.ReplyRate
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(RewardPolicyProposal(tester.bp0.Name, "test", "", policy, activation)))
+	proposalId := d.CurrentRecordID()
+
+	a.NoError(tester.bp0.SendTrxAndProduceBlock(ProposalVote(tester.bp0.Name, proposalId, true)))
+	a.NoError(tester.bp1.SendTrxAndProduceBlock(ProposalVote(tester.bp1.Name, proposalId, true)))
+	a.Nil(d.GlobalProps().GetRewardPolicy())
+
+	head := d.GlobalProps().GetHeadBlockNumber()
+	a.NoError(d.ProduceBlocks(int(activation - head)))
+	a.Equal(uint32(constants.ProposalStatusActivated), d.Proposal(proposalId).GetStatus())
+	a.Equal(constants.RewardWeightingQuadratic, d.GlobalProps().GetRewardPolicy().GetWeighting())
+	a.Equal(uint32(5000), d.GlobalProps().GetRewardPolicy().GetAuthorRate())
+}
+
+func (tester *ProposalTester) disabledBp(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	makeBp("proposalbp2", t, d)
+	bp2 := d.Account("proposalbp2")
+	a.NoError(bp2.SendTrxAndProduceBlock(BpDisable(bp2.Name)))
+
+	activation := d.GlobalProps().GetHeadBlockNumber() + proposalTestEpochDuration * 4
+	a.Error(bp2.SendTrxAndProduceBlock(ProposalCreate(bp2.Name, "test", "", nil, constants.FeatureFastPowerDown, activation)))
+	a.NoError(bp2.SendTrxAndProduceBlock(BpEnable(bp2.Name)))
+	a.NoError(bp2.SendTrxAndProduceBlock(ProposalCreate(bp2.Name, "test", "", nil, constants.FeatureFastPowerDown, activation)))
+}
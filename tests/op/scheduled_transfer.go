@@ -0,0 +1,96 @@
+package op
+
+import (
+	"github.com/coschain/contentos-go/common/constants"
+	. "github.com/coschain/contentos-go/dandelion"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type ScheduledTransferTester struct {
+	timedTransferTester
+}
+
+func (tester *ScheduledTransferTester) Test(t *testing.T, d *Dandelion) {
+	tester.init(d)
+
+	t.Run("normal", d.Test(tester.normal))
+	t.Run("cancel", d.Test(tester.cancel))
+	t.Run("invalid", d.Test(tester.invalid))
+}
+
+func (tester *ScheduledTransferTester) TestInactive(t *testing.T, d *Dandelion) {
+	due := tester.headTime(d) + 5 * constants.BlockInterval
+	d.TestFeatureInactive(t, constants.FeatureScheduledTransfer, func() error {
+		return d.Account("actor0").SendTrxAndProduceBlock(ScheduledTransfer("actor0", "actor1", 10, "", due))
+	})
+}
+
+func (tester *ScheduledTransferTester) normal(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+
+	// the amount is escrowed at once
+	due := tester.headTime(d) + 5 * constants.BlockInterval
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc1.Name, 10, "later", due)))
+	transferId := d.CurrentRecordID()
+	a.True(d.ScheduledTransfer(transferId).CheckExist())
+	a.Equal(balance0 - 10, tester.acc0.GetBalance().Value)
+	a.Equal(balance1, tester.acc1.GetBalance().Value)
+
+	// and paid to the receiver when due
+	for tester.headTime(d) < due {
+		a.Equal(balance1, tester.acc1.GetBalance().Value)
+		a.NoError(d.ProduceBlocks(1))
+	}
+	a.Equal(balance0 - 10, tester.acc0.GetBalance().Value)
+	a.Equal(balance1 + 10, tester.acc1.GetBalance().Value)
+	a.False(d.ScheduledTransfer(transferId).CheckExist())
+}
+
+func (tester *ScheduledTransferTester) cancel(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	balance1 := tester.acc1.GetBalance().Value
+
+	due := tester.headTime(d) + 100 * constants.BlockInterval
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc1.Name, 20, "", due)))
+	transferId := d.CurrentRecordID()
+	a.Equal(balance0 - 20, tester.acc0.GetBalance().Value)
+
+	// only the sender can cancel
+	a.Error(tester.acc1.SendTrxAndProduceBlock(CancelScheduledTransfer(tester.acc1.Name, transferId)))
+	a.True(d.ScheduledTransfer(transferId).CheckExist())
+
+	// cancellation returns the escrowed amount
+	a.NoError(tester.acc0.SendTrxAndProduceBlock(CancelScheduledTransfer(tester.acc0.Name, transferId)))
+	a.False(d.ScheduledTransfer(transferId).CheckExist())
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+	a.Equal(balance1, tester.acc1.GetBalance().Value)
+
+	// no more cancellation
+	a.Error(tester.acc0.SendTrxAndProduceBlock(CancelScheduledTransfer(tester.acc0.Name, transferId)))
+}
+
+func (tester *ScheduledTransferTester) invalid(t *testing.T, d *Dandelion) {
+	a := assert.New(t)
+
+	balance0 := tester.acc0.GetBalance().Value
+	now := tester.headTime(d)
+
+	// due time passed
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc1.Name, 10, "", now)))
+	// due time too far
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc1.Name, 10, "", now + constants.MaxScheduledTransferDelay + 100)))
+	// insufficient balance
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc1.Name, balance0 + 1, "", now + 100)))
+	// to self
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, tester.acc0.Name, 10, "", now + 100)))
+	// to unknown account
+	a.Error(tester.acc0.SendTrxAndProduceBlock(ScheduledTransfer(tester.acc0.Name, "noexist1", 10, "", now + 100)))
+
+	a.Equal(balance0, tester.acc0.GetBalance().Value)
+}
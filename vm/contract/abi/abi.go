@@ -269,6 +269,19 @@ func (t *abiTable) SecondaryIndices() []int {
 	return t.secondary
 }
 
+type abiEvent struct {
+	name string
+	data *ABIStructType
+}
+
+func (e *abiEvent) Name() string {
+	return e.name
+}
+
+func (e *abiEvent) Data() IContractStruct {
+	return e.data
+}
+
 type abiTypeAlias struct {
 	name string
 	origin IContractType
@@ -372,6 +385,8 @@ type abi struct {
 	methodByName map[string]int
 	tables []*abiTable
 	tableByName map[string]int
+	events []*abiEvent
+	eventByName map[string]int
 }
 
 func (abi *abi) TypesCount() int {
@@ -428,6 +443,24 @@ func (abi *abi) TableByName(name string) IContractTable {
 	return nil
 }
 
+func (abi *abi) EventsCount() int {
+	return len(abi.events)
+}
+
+func (abi *abi) EventByIndex(i int) IContractEvent {
+	if i >= 0 && i < abi.EventsCount() {
+		return abi.events[i]
+	}
+	return nil
+}
+
+func (abi *abi) EventByName(name string) IContractEvent {
+	if i, ok := abi.eventByName[name]; ok {
+		return abi.EventByIndex(i)
+	}
+	return nil
+}
+
 func (abi *abi) Marshal() ([]byte, error) {
 	ja := &JsonABI{
 		Types: []jsonAbiTypedef{},
@@ -479,6 +512,12 @@ func (abi *abi) Marshal() ([]byte, error) {
 			Secondary: si,
 		})
 	}
+	for _, e := range abi.events {
+		ja.Events = append(ja.Events, jsonAbiEvent{
+			Name: e.name,
+			Type: e.data.name,
+		})
+	}
 	return ja.Marshal()
 }
 
@@ -507,6 +546,9 @@ func (abi *abi) Unmarshal(data []byte) error {
 	for _, t := range ja.Tables {
 		b.Table(t.Name, t.Type, t.Primary, t.Secondary...)
 	}
+	for _, e := range ja.Events {
+		b.Event(e.Name, e.Type)
+	}
 
 	if newAbi, err := b.Build(); err != nil {
 		return err
@@ -17,6 +17,7 @@ type abiBuilder struct {
 	tables map[string]string					// tables: table_name -> record_struct
 	primaries map[string]string					// primary keys of tables: table_name -> primary_index_field
 	secondaries map[string]map[string]bool		// secondary keys of tables: table_name -> indexing_fields
+	events map[string]string					// events: event_name -> data_struct
 }
 
 // abiField is a field of a struct
@@ -35,6 +36,7 @@ func NewAbiBuilder() *abiBuilder {
 		tables: make(map[string]string),
 		primaries: make(map[string]string),
 		secondaries: make(map[string]map[string]bool),
+		events: make(map[string]string),
 	}
 }
 
@@ -83,6 +85,12 @@ func (b *abiBuilder) Table(name string, record string, primary string, secondari
 	return b
 }
 
+// Event() adds an event.
+func (b *abiBuilder) Event(name string, data string) *abiBuilder {
+	b.events[name] = data
+	return b
+}
+
 // Build() validates inputs and creates an ABI.
 func (b *abiBuilder) Build() (*abi, error) {
 	// context will cache mediate processing results, and help validate input data.
@@ -141,6 +149,13 @@ func (b *abiBuilder) Build() (*abi, error) {
 		abi.tableByName[t.name] = i
 	}
 
+	// events and their by_name indices
+	abi.events = b.buildEvents(ctx)
+	abi.eventByName = make(map[string]int)
+	for i, e := range abi.events {
+		abi.eventByName[e.name] = i
+	}
+
 	// validate names in abi
 	if err := checkAbiNames(abi); err != nil {
 		return nil, err
@@ -170,6 +185,15 @@ func checkAbiNames(abi *abi) error {
 			return fmt.Errorf("invaid record type of table %s: %s", tab.Name(), err.Error())
 		}
 	}
+	for i := 0; i < abi.EventsCount(); i++ {
+		if ev := abi.EventByIndex(i); ev == nil {
+			return fmt.Errorf("abi event #%d not found", i)
+		} else if err := prototype.ValidContractEventName(ev.Name()); err != nil {
+			return fmt.Errorf("invaid abi event name: %s, %s", ev.Name(), err.Error())
+		} else if err := checkStructFieldNames(ev.Data()); err != nil {
+			return fmt.Errorf("invaid data type of event %s: %s", ev.Name(), err.Error())
+		}
+	}
 	return nil
 }
 
@@ -245,6 +269,20 @@ func (b *abiBuilder) buildTables(ctx *abiBuildContext) []*abiTable {
 	return result
 }
 
+// buildEvents() returns events based on context data.
+func (b *abiBuilder) buildEvents(ctx *abiBuildContext) []*abiEvent {
+	result := make([]*abiEvent, len(b.events))
+	idx := 0
+	for name, t := range b.events {
+		result[idx] = &abiEvent{
+			name: name,
+			data: ctx.resolvedTypes[t].(*ABIStructType),
+		}
+		idx++
+	}
+	return result
+}
+
 // building context
 type abiBuildContext struct {
 	b *abiBuilder								// the builder
@@ -293,6 +331,10 @@ func (ctx *abiBuildContext) prepare() error {
 	for _, t := range ctx.b.tables {
 		types[t] = true
 	}
+	// event data types.
+	for _, t := range ctx.b.events {
+		types[t] = true
+	}
 	// check if we're building primary index on non-existent tables.
 	for t := range ctx.b.primaries {
 		if _, ok := ctx.b.tables[t]; !ok {
@@ -592,6 +634,12 @@ func (ctx *abiBuildContext) validate() error {
 			return errors.New(fmt.Sprintf("abiBuilder: arguments type of method %s is not a struct: %s", name, t))
 		}
 	}
+	// event's data type must be a struct.
+	for name, t := range ctx.b.events {
+		if !ctx.resolvedTypes[t].IsStruct() {
+			return errors.New(fmt.Sprintf("abiBuilder: data type of event %s is not a struct: %s", name, t))
+		}
+	}
 	// table's record type must be a struct, and indexing fields must be valid.
 	for name, t := range ctx.b.tables {
 		// record type must be a struct
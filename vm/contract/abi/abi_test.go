@@ -201,6 +201,12 @@ func TestInvalidAbis(t *testing.T) {
 	// table: secondary index member is not comparable
 	a.Error(unmarshalTestAbi("table_secondary_noncmp.abi"))
 
+	// event: unknown data type
+	a.Error(unmarshalTestAbi("event_data_unknown_type.abi"))
+
+	// event: data type is not a struct
+	a.Error(unmarshalTestAbi("event_data_non_struct.abi"))
+
 	// duplicated typedef's: allowed but only the last one is taken
 	a.NoError(unmarshalTestAbi("typedef_dup.abi"))
 }
@@ -221,4 +227,46 @@ func TestInvalidNames(t *testing.T) {
 	a.Error(unmarshalTestAbi("invalid_name_table_charset.abi"))
 	a.Error(unmarshalTestAbi("invalid_name_table_bad_varname.abi"))
 	a.Error(unmarshalTestAbi("invalid_name_table_record_member.abi"))
+
+	// event name
+	a.Error(unmarshalTestAbi("invalid_name_event_charset.abi"))
+}
+
+func TestAbiEvents(t *testing.T) {
+	r := assert.New(t)
+
+	data, _ := ioutil.ReadFile("testdata/events.abi")
+	abi, err := UnmarshalABI(data)
+	r.NoError(err)
+	data, err = abi.Marshal()
+	r.NoError(err)
+	abi, err = UnmarshalABI(data)
+	r.NoError(err)
+
+	r.Equal(2, abi.EventsCount())
+	r.NotNil(abi.EventByIndex(0))
+	r.NotNil(abi.EventByIndex(1))
+	r.Nil(abi.EventByIndex(2))
+	r.Nil(abi.EventByName("send"))
+
+	sent := abi.EventByName("sent")
+	r.NotNil(sent)
+	r.Equal("transfer", sent.Data().Name())
+	r.Equal(3, sent.Data().FieldNum())
+
+	jsonStr := `["alice","bob",100]`
+	data, err = vme.EncodeFromJson([]byte(jsonStr), sent.Data().Type())
+	r.NoError(err)
+	data, err = vme.DecodeToJson(data, sent.Data().Type(), true)
+	r.NoError(err)
+	r.Equal(jsonStr, string(data))
+
+	// abi's without events are marshaled as before
+	data, _ = ioutil.ReadFile("testdata/hello.abi")
+	abi, err = UnmarshalABI(data)
+	r.NoError(err)
+	r.Equal(0, abi.EventsCount())
+	data, err = abi.Marshal()
+	r.NoError(err)
+	r.NotContains(string(data), "events")
 }
@@ -122,6 +122,17 @@ type IContractTable interface {
 	SecondaryIndices() []int
 }
 
+//
+// IContractEvent is an event type emitted by a contract.
+//
+type IContractEvent interface {
+	// Name() returns name of the event.
+	Name() string
+
+	// Data() returns a struct type representing the data carried by the event.
+	Data() IContractStruct
+}
+
 //
 // IContractABI is a full ABI definition of a contract.
 //
@@ -152,6 +163,15 @@ type IContractABI interface {
 
 	// TableByName(name) returns the table of given @name.
 	TableByName(name string) IContractTable
+
+	// EventsCount() returns total number of events declared in a contract.
+	EventsCount() int
+
+	// EventByIndex(i) returns the i-th event.
+	EventByIndex(i int) IContractEvent
+
+	// EventByName(name) returns the event of given @name.
+	EventByName(name string) IContractEvent
 }
 
 //
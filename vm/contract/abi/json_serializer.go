@@ -8,6 +8,7 @@ type JsonABI struct {
 	Structs 	[]jsonAbiStruct		`json:"structs"`
 	Methods 	[]jsonAbiMethod		`json:"actions"`
 	Tables 		[]jsonAbiTable		`json:"tables"`
+	Events 		[]jsonAbiEvent		`json:"events,omitempty"`
 }
 
 type jsonAbiTypedef struct {
@@ -38,6 +39,11 @@ type jsonAbiTable struct {
 	Secondary	[]string	`json:"secondary"`
 }
 
+type jsonAbiEvent struct {
+	Name 	string		`json:"name"`
+	Type 	string		`json:"type"`
+}
+
 func (abi *JsonABI) Marshal() ([]byte, error) {
 	return json.Marshal(abi)
 }
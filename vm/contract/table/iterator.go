@@ -0,0 +1,134 @@
+package table
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/coschain/contentos-go/common/encoding/kope"
+	"github.com/coschain/contentos-go/common/encoding/vme"
+)
+
+// TableIterator is a cursor over a primary or secondary index of a contract table.
+// It points either to an index entry, or to the end position which is past the last entry.
+type TableIterator struct {
+	table *ContractTable
+	prefix kope.Key			// key prefix of the index
+	secondary bool			// whether it's a secondary index
+	key kope.Key			// current index key, nil means the end position
+}
+
+// LowerBound() returns an iterator pointing to the first record whose indexing field is not less than the given value.
+func (t *ContractTable) LowerBound(field string, encodedValue []byte) (*TableIterator, error) {
+	it, v, err := t.newIterator(field, encodedValue)
+	if err != nil {
+		return nil, err
+	}
+	it.seek(kope.AppendKey(it.prefix, v), false)
+	return it, nil
+}
+
+// UpperBound() returns an iterator pointing to the first record whose indexing field is greater than the given value.
+func (t *ContractTable) UpperBound(field string, encodedValue []byte) (*TableIterator, error) {
+	it, v, err := t.newIterator(field, encodedValue)
+	if err != nil {
+		return nil, err
+	}
+	it.seek(kope.AppendKey(it.prefix, v, kope.MaximumKey), false)
+	return it, nil
+}
+
+// End() returns an iterator pointing to the end position of the index on given field.
+func (t *ContractTable) End(field string) (*TableIterator, error) {
+	it, _, err := t.newIterator(field, nil)
+	return it, err
+}
+
+func (t *ContractTable) newIterator(field string, encodedValue []byte) (*TableIterator, interface{}, error) {
+	var (
+		it *TableIterator
+		idx = -1
+	)
+	st := t.abiTable.Record()
+	if st.Field(t.abiTable.PrimaryIndex()).Name() == field {
+		idx = t.abiTable.PrimaryIndex()
+		it = &TableIterator{table: t, prefix: t.primary}
+	} else {
+		for i, j := range t.abiTable.SecondaryIndices() {
+			if st.Field(j).Name() == field {
+				idx = j
+				it = &TableIterator{table: t, prefix: t.secondaries[i], secondary: true}
+				break
+			}
+		}
+	}
+	if idx < 0 {
+		return nil, nil, errors.New("unknown index field: " + field)
+	}
+	if encodedValue == nil {
+		return it, nil, nil
+	}
+	v, err := vme.DecodeWithType(encodedValue, st.Field(idx).Type().Type())
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("invalid value of index field %s: %s", field, err.Error()))
+	}
+	return it, v, nil
+}
+
+// seek() moves the iterator to the first index key not less than (or greater than, if exclusive is set) the given key.
+// The iterator is moved to the end position if no such key.
+func (it *TableIterator) seek(start kope.Key, exclusive bool) {
+	it.key = nil
+	it.table.db.Iterate(start, kope.MaxKey(it.prefix), false, func(key, value []byte) bool {
+		if exclusive && bytes.Compare(key, start) == 0 {
+			return true
+		}
+		it.key = copyKey(key)
+		return false
+	})
+}
+
+// End() checks if the iterator is at the end position.
+func (it *TableIterator) End() bool {
+	return it.key == nil
+}
+
+// Next() moves the iterator to next index entry, and returns false if it reaches the end position.
+// Calling Next() on an end iterator does nothing but returning false.
+func (it *TableIterator) Next() bool {
+	if it.key != nil {
+		it.seek(it.key, true)
+	}
+	return it.key != nil
+}
+
+// Prev() moves the iterator to previous index entry and returns true.
+// If there's no previous entry, the iterator stays unchanged and false is returned.
+func (it *TableIterator) Prev() bool {
+	limit := it.key
+	if limit == nil {
+		limit = kope.MaxKey(it.prefix)
+	}
+	found := false
+	it.table.db.Iterate(kope.MinKey(it.prefix), limit, true, func(key, value []byte) bool {
+		it.key = copyKey(key)
+		found = true
+		return false
+	})
+	return found
+}
+
+// Record() returns the encoded record the iterator points to.
+func (it *TableIterator) Record() ([]byte, error) {
+	if it.key == nil {
+		return nil, errors.New("reading record at end position")
+	}
+	pk := it.key
+	if it.secondary {
+		pk = kope.IndexedPrimaryKey(it.key)
+	}
+	return it.table.db.Get(pk)
+}
+
+func copyKey(key []byte) []byte {
+	return append([]byte(nil), key...)
+}
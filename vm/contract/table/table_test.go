@@ -161,3 +161,93 @@ func testTableGreetings(a *assert.Assertions, table *ContractTable) {
 	// deleting non-existent records must return success.
 	a.NoError(table.DeleteRecord(encodedPrimaryKey(a, table, `"sdfsdfsdf"`)))
 }
+
+func TestContractTableIterators(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "contract_table")
+	a.NoError(err)
+	defer os.RemoveAll(dir)
+
+	fn := filepath.Join(dir, randomString(8))
+	db, err := storage.NewDatabase(fn)
+	a.NoError(err)
+	a.NotNil(db)
+	a.NoError(db.Start(nil))
+	defer db.Close()
+
+	data, err := ioutil.ReadFile("testdata/hello.abi")
+	a.NoError(err)
+	helloAbi, err := abi.UnmarshalABI(data)
+	a.NoError(err)
+
+	table := NewContractTables("someone", "hello", helloAbi, db).Table("table_greetings")
+	a.NotNil(table)
+	for i := 0; i < 10; i++ {
+		jsonStr := fmt.Sprintf(`["account%d",%d,%d]`, i, i / 2, i)
+		a.NoError(table.NewRecord(encodedRecord(a, table, jsonStr)))
+	}
+	encodedCount := func(c uint32) []byte {
+		data, err := vme.EncodeFromJson([]byte(fmt.Sprintf("%d", c)), reflect.TypeOf(c))
+		a.NoError(err)
+		return data
+	}
+	collect := func(it *TableIterator, forward bool, max int) string {
+		var result []string
+		for i := 0; i < max && !it.End(); i++ {
+			data, err := it.Record()
+			a.NoError(err)
+			result = append(result, decodeRecord(a, table, data))
+			if forward {
+				it.Next()
+			} else if !it.Prev() {
+				break
+			}
+		}
+		return strings.Join(result, ",")
+	}
+
+	// primary index
+	it, err := table.LowerBound("name", encodedPrimaryKey(a, table, `"account3"`))
+	a.NoError(err)
+	a.Equal(`["account3",1,3],["account4",2,4]`, collect(it, true, 2))
+	it, err = table.UpperBound("name", encodedPrimaryKey(a, table, `"account3"`))
+	a.NoError(err)
+	a.Equal(`["account4",2,4],["account5",2,5]`, collect(it, true, 2))
+	it, err = table.LowerBound("name", encodedPrimaryKey(a, table, `"account99"`))
+	a.NoError(err)
+	a.True(it.End())
+	a.False(it.Next())
+	_, err = it.Record()
+	a.Error(err)
+
+	// secondary index, in both directions
+	it, err = table.LowerBound("count", encodedCount(2))
+	a.NoError(err)
+	a.Equal(`["account4",2,4],["account5",2,5],["account6",3,6]`, collect(it, true, 3))
+	it, err = table.UpperBound("count", encodedCount(2))
+	a.NoError(err)
+	a.True(it.Prev())
+	a.Equal(`["account5",2,5],["account4",2,4],["account3",1,3]`, collect(it, false, 3))
+
+	// backward from the end, and stop at the beginning
+	it, err = table.End("count")
+	a.NoError(err)
+	a.True(it.End())
+	a.True(it.Prev())
+	a.Equal(`["account9",4,9],["account8",4,8]`, collect(it, false, 2))
+	it, err = table.LowerBound("count", encodedCount(0))
+	a.NoError(err)
+	a.False(it.Prev())
+	a.Equal(`["account0",0,0]`, collect(it, false, 1))
+
+	// invalid fields and values
+	_, err = table.LowerBound("xxxx", encodedCount(0))
+	a.Error(err)
+	it, err = table.End("last_seen")
+	a.NoError(err)
+	a.True(it.Prev())
+	a.Equal(`["account9",4,9]`, collect(it, false, 1))
+	_, err = table.UpperBound("count", []byte{})
+	a.Error(err)
+}
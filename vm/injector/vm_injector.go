@@ -9,6 +9,7 @@ import (
 type Injector interface {
 	Error(code uint32, msg string)
 	Log(msg string)
+	EmitEvent(owner, contract, name, data string)
 	RequireAuth(name string) error
 	RecordStaminaFee(caller string, spent uint64)
 	GetVmRemainCpuStamina(name string) uint64
@@ -20,6 +21,7 @@ type Injector interface {
 	ContractCall(caller, fromOwner, fromContract, fromMethod, toOwner, toContract, toMethod string, params []byte, coins, remainGas uint64,preVm *exec.VM)
 	ContractABI(owner, contract string) string
 	GetBlockProducers() []string
+	FeatureActive(id string) bool
 	DiscardAccountCache(name string)
 	VmCache() *vmcache.VmCache
 	StateChangeContext() *blocklog.StateChangeContext
@@ -45,6 +45,7 @@ func (w *CosVM) initNativeFuncs() {
 	w.register("print_str", e_printString, 100)
 	w.register("print_int", e_printInt64, 100)
 	w.register("print_uint", e_printUint64, 100)
+	w.register("emit_event", e_emitEvent, 500)
 	w.register("require_auth", e_requiredAuth, 200)
 	w.register("get_user_balance", e_getUserBalance, 100)
 	w.register("user_exist", e_userExist, 100)
@@ -69,8 +70,16 @@ func (w *CosVM) initNativeFuncs() {
 	w.register("table_update_record", e_tableUpdateRecord, 1200)
 	w.register("table_delete_record", e_tableDeleteRecord, 1000)
 	w.register("table_get_record_ex", e_tableGetRecordEx, 1500)
+	w.register("table_lower_bound", e_tableLowerBound, 800)
+	w.register("table_upper_bound", e_tableUpperBound, 800)
+	w.register("table_end", e_tableEnd, 500)
+	w.register("table_iterator_next", e_tableIteratorNext, 500)
+	w.register("table_iterator_prev", e_tableIteratorPrev, 500)
+	w.register("table_iterator_is_end", e_tableIteratorIsEnd, 100)
+	w.register("table_iterator_record", e_tableIteratorRecord, 800)
 
 	w.register("get_block_producers", e_getBlockProducers, 500)
+	w.register("feature_active", e_featureActive, 200)
 
 	w.register("set_reputation_admin", e_setReputationAdmin, 0)
 	w.register("get_reputation_admin", e_getReputationAdmin, 100)
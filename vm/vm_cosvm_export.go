@@ -39,6 +39,16 @@ func e_getBlockProducers(proc *exec.Process, pDst int32, dstSize int32) (length
 	return w.cosVM.write(proc, []byte(w.GetBlockProducers()), pDst, dstSize, "getBlockProducers()")
 }
 
+func e_featureActive(proc *exec.Process, pName int32, lenName int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	if w.FeatureActive(string(w.cosVM.read(proc, pName, lenName, "featureActive()"))) {
+		return 1
+	} else {
+		return 0
+	}
+}
+
 func e_printString(proc *exec.Process, pStr int32, lenStr int32) {
 	w := proc.GetTag().(*CosVMNative)
 
@@ -57,6 +67,15 @@ func e_printUint64(proc *exec.Process, value int64) {
 	w.PrintUint64(uint64(value))
 }
 
+func e_emitEvent(proc *exec.Process, pName int32, lenName int32, pData int32, lenData int32) {
+	w := proc.GetTag().(*CosVMNative)
+
+	w.EmitEvent(
+		string(w.cosVM.read(proc, pName, lenName, "emitEvent().name")),
+		w.cosVM.read(proc, pData, lenData, "emitEvent().data"),
+	)
+}
+
 func e_requiredAuth(proc *exec.Process, pStr int32, pLen int32) {
 	w := proc.GetTag().(*CosVMNative)
 
@@ -243,6 +262,71 @@ func e_tableGetRecordEx(proc *exec.Process, ownerName, ownerNameLen int32, contr
 		value, valueLen, "tableGetRecordEx()")
 }
 
+func e_tableLowerBound(proc *exec.Process, tableName, tableNameLen int32, field, fieldLen int32, value, valueLen int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	return w.TableLowerBound(
+		string(w.cosVM.read(proc, tableName, tableNameLen, "tableLowerBound().table_name")),
+		string(w.cosVM.read(proc, field, fieldLen, "tableLowerBound().field")),
+		w.cosVM.read(proc, value, valueLen, "tableLowerBound().value"),
+	)
+}
+
+func e_tableUpperBound(proc *exec.Process, tableName, tableNameLen int32, field, fieldLen int32, value, valueLen int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	return w.TableUpperBound(
+		string(w.cosVM.read(proc, tableName, tableNameLen, "tableUpperBound().table_name")),
+		string(w.cosVM.read(proc, field, fieldLen, "tableUpperBound().field")),
+		w.cosVM.read(proc, value, valueLen, "tableUpperBound().value"),
+	)
+}
+
+func e_tableEnd(proc *exec.Process, tableName, tableNameLen int32, field, fieldLen int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	return w.TableEnd(
+		string(w.cosVM.read(proc, tableName, tableNameLen, "tableEnd().table_name")),
+		string(w.cosVM.read(proc, field, fieldLen, "tableEnd().field")),
+	)
+}
+
+func e_tableIteratorNext(proc *exec.Process, it int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	if w.TableIteratorNext(it) {
+		return 1
+	} else {
+		return 0
+	}
+}
+
+func e_tableIteratorPrev(proc *exec.Process, it int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	if w.TableIteratorPrev(it) {
+		return 1
+	} else {
+		return 0
+	}
+}
+
+func e_tableIteratorIsEnd(proc *exec.Process, it int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	if w.TableIteratorIsEnd(it) {
+		return 1
+	} else {
+		return 0
+	}
+}
+
+func e_tableIteratorRecord(proc *exec.Process, it int32, value, valueLen int32) int32 {
+	w := proc.GetTag().(*CosVMNative)
+
+	return w.cosVM.write(proc, w.TableIteratorRecord(it), value, valueLen, "tableIteratorRecord()")
+}
+
 func e_setCopyrightAdmin(proc *exec.Process, name, nameLen int32) {
 	w := proc.GetTag().(*CosVMNative)
 
@@ -5,6 +5,7 @@ import (
 	"github.com/coschain/contentos-go/app/blocklog"
 	"github.com/coschain/contentos-go/app/table"
 	"github.com/coschain/contentos-go/common/constants"
+	"github.com/coschain/contentos-go/common/encoding/vme"
 	"github.com/coschain/contentos-go/iservices/itype"
 	"github.com/coschain/contentos-go/prototype"
 	"github.com/coschain/contentos-go/vm/contract/abi"
@@ -33,9 +34,11 @@ import (
 type CosVMNative struct {
 	cosVM *CosVM
 	tablesCache *lru.Cache
+	tableIterators []*table2.TableIterator
 }
 
 const tablesCacheMaxSize = 64
+const tableIteratorsMaxCount = 256
 
 func NewCosVMNative(vm *CosVM) *CosVMNative {
 	tabCache, _ := lru.New(tablesCacheMaxSize)
@@ -65,6 +68,10 @@ func (w *CosVMNative) GetBlockProducers() string {
 	return strings.Join(w.cosVM.ctx.Injector.GetBlockProducers(), " ")
 }
 
+func (w *CosVMNative) FeatureActive(name string) bool {
+	return w.cosVM.ctx.Injector.FeatureActive(name)
+}
+
 func (w *CosVMNative) PrintString(str string) {
 	w.cosVM.ctx.Injector.Log(str)
 }
@@ -77,6 +84,18 @@ func (w *CosVMNative) PrintUint64(value uint64) {
 	w.cosVM.ctx.Injector.Log(fmt.Sprintf("%d", value))
 }
 
+func (w *CosVMNative) EmitEvent(name string, data []byte) {
+	w.CosAssert(w.FeatureActive(constants.FeatureContractEvent), "EmitEvent(): contract events not activated")
+	w.CosAssert(len(data) <= constants.MaxContractEventSize, fmt.Sprintf("EmitEvent(): event data too large, %d > %d", len(data), constants.MaxContractEventSize))
+	contractAbi := w.cosVM.ctx.AbiInterface
+	w.CosAssert(contractAbi != nil, "EmitEvent(): context abi not ready.")
+	ev := contractAbi.EventByName(name)
+	w.CosAssert(ev != nil, fmt.Sprintf("EmitEvent(): unknown event '%s'", name))
+	jsonData, err := vme.DecodeToJson(data, ev.Data().Type(), false)
+	w.CosAssert(err == nil, fmt.Sprintf("EmitEvent(): invalid data of event '%s': %v", name, err))
+	w.cosVM.ctx.Injector.EmitEvent(w.ReadContractOwner(), w.ReadContractName(), name, string(jsonData))
+}
+
 func (w *CosVMNative) RequiredAuth(name string) {
 	err := w.cosVM.ctx.Injector.RequireAuth(name)
 	w.CosAssert(err == nil, "require auth error")
@@ -232,6 +251,68 @@ func (w *CosVMNative) TableDeleteRecord(tableName string, primary []byte) {
 	w.CosAssert(err == nil, fmt.Sprintf("TableDeleteRecord(): table.DeleteRecord() failed. %v", err))
 }
 
+func (w *CosVMNative) contractTable(caller, tableName string) *table2.ContractTable {
+	tables := w.cosVM.ctx.Tables
+	w.CosAssert(tables != nil, fmt.Sprintf("%s: context tables not ready.", caller))
+	t := tables.Table(tableName)
+	w.CosAssert(t != nil, fmt.Sprintf("%s: unknown table '%s'", caller, tableName))
+	return t
+}
+
+// checkTableIterator asserts that table iterators are activated.
+func (w *CosVMNative) checkTableIterator(caller string) {
+	w.CosAssert(w.FeatureActive(constants.FeatureTableIterator), fmt.Sprintf("%s: table iterators not activated", caller))
+}
+
+func (w *CosVMNative) addTableIterator(caller string, it *table2.TableIterator, err error) int32 {
+	w.CosAssert(err == nil, fmt.Sprintf("%s: %v", caller, err))
+	w.CosAssert(len(w.tableIterators) < tableIteratorsMaxCount, fmt.Sprintf("%s: too many iterators", caller))
+	w.tableIterators = append(w.tableIterators, it)
+	return int32(len(w.tableIterators) - 1)
+}
+
+func (w *CosVMNative) tableIterator(caller string, id int32) *table2.TableIterator {
+	w.checkTableIterator(caller)
+	w.CosAssert(id >= 0 && int(id) < len(w.tableIterators), fmt.Sprintf("%s: invalid iterator %d", caller, id))
+	return w.tableIterators[id]
+}
+
+func (w *CosVMNative) TableLowerBound(tableName, field string, value []byte) int32 {
+	w.checkTableIterator("TableLowerBound()")
+	it, err := w.contractTable("TableLowerBound()", tableName).LowerBound(field, value)
+	return w.addTableIterator("TableLowerBound()", it, err)
+}
+
+func (w *CosVMNative) TableUpperBound(tableName, field string, value []byte) int32 {
+	w.checkTableIterator("TableUpperBound()")
+	it, err := w.contractTable("TableUpperBound()", tableName).UpperBound(field, value)
+	return w.addTableIterator("TableUpperBound()", it, err)
+}
+
+func (w *CosVMNative) TableEnd(tableName, field string) int32 {
+	w.checkTableIterator("TableEnd()")
+	it, err := w.contractTable("TableEnd()", tableName).End(field)
+	return w.addTableIterator("TableEnd()", it, err)
+}
+
+func (w *CosVMNative) TableIteratorNext(id int32) bool {
+	return w.tableIterator("TableIteratorNext()", id).Next()
+}
+
+func (w *CosVMNative) TableIteratorPrev(id int32) bool {
+	return w.tableIterator("TableIteratorPrev()", id).Prev()
+}
+
+func (w *CosVMNative) TableIteratorIsEnd(id int32) bool {
+	return w.tableIterator("TableIteratorIsEnd()", id).End()
+}
+
+func (w *CosVMNative) TableIteratorRecord(id int32) []byte {
+	data, err := w.tableIterator("TableIteratorRecord()", id).Record()
+	w.CosAssert(err == nil, fmt.Sprintf("TableIteratorRecord(): %v", err))
+	return data
+}
+
 func (w *CosVMNative) TableGetRecordEx(ownerName, contractName, tableName string, primary []byte) []byte {
 	var tables *table2.ContractTables
 